@@ -0,0 +1,488 @@
+package app
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"gnd.la/form/input"
+	"gnd.la/i18n"
+	"gnd.la/util/structs"
+)
+
+var (
+	apiTags       = []string{"form", "gondola"}
+	contextType   = reflect.TypeOf((*Context)(nil))
+	errorType     = reflect.TypeOf((*error)(nil)).Elem()
+	parserType    = reflect.TypeOf((*input.Parser)(nil)).Elem()
+	unmarshalType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	apiMaxMemory  = int64(32 << 20) // 32 MiB, as stdlib
+	apiHasBody    = map[string]bool{"POST": true, "PUT": true, "PATCH": true, "DELETE": true}
+	apiInvalidMsg = i18n.String("invalid request")
+)
+
+// APIFieldError represents an error in a field of a request
+// received by a handler created with APIHandler.
+type APIFieldError struct {
+	// Field is the path to the field, using the JSON names of the
+	// fields and separating nested fields with dots (e.g. address.city).
+	Field string `json:"field"`
+	// Message is the error message, translated to the language
+	// of the request.
+	Message string `json:"message"`
+}
+
+// APIError is the error sent back to the client by the handlers
+// created with APIHandler. It's serialized as JSON, inside an
+// object with an "error" key:
+//
+//  {"error": {"status": 400, "message": "invalid request", "fields": [{"field": "name", "message": "name is required"}]}}
+//
+// Since APIError implements Error, API handlers might also return an
+// *APIError to fully control the error sent to the client.
+type APIError struct {
+	Status  int              `json:"status"`
+	Message string           `json:"message"`
+	Fields  []*APIFieldError `json:"fields,omitempty"`
+}
+
+// StatusCode implements the Error interface.
+func (e *APIError) StatusCode() int {
+	return e.Status
+}
+
+func (e *APIError) Error() string {
+	if len(e.Fields) > 0 {
+		msgs := make([]string, len(e.Fields))
+		for ii, v := range e.Fields {
+			msgs[ii] = fmt.Sprintf("%s: %s", v.Field, v.Message)
+		}
+		return fmt.Sprintf("%s (%s)", e.Message, strings.Join(msgs, ", "))
+	}
+	return e.Message
+}
+
+// apiField represents a non-struct field in the input type
+// of an API handler.
+type apiField struct {
+	// path using JSON names, e.g. address.city
+	path string
+	// name of the field in its struct, used for
+	// calling its validation function
+	name string
+	// indexes of each field to traverse, starting
+	// from the input struct
+	indexes []int
+	typ     reflect.Type
+	tag     *structs.Tag
+	// only used for OpenAPI generation
+	help string
+}
+
+// value returns the value for the field as well as the struct which
+// contains it. If alloc is true, nil pointers to intermediate structs
+// are allocated. Otherwise, if a nil pointer is found, it returns
+// invalid values.
+func (f *apiField) value(v reflect.Value, alloc bool) (reflect.Value, reflect.Value) {
+	var parent reflect.Value
+	for _, idx := range f.indexes {
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !alloc {
+					return reflect.Value{}, reflect.Value{}
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		parent = v
+		v = v.Field(idx)
+	}
+	return v, parent
+}
+
+type apiHandler struct {
+	fn     reflect.Value
+	in     reflect.Type
+	out    reflect.Type
+	fields []*apiField
+}
+
+func newAPIHandler(fn interface{}) (*apiHandler, error) {
+	val := reflect.ValueOf(fn)
+	if !val.IsValid() || val.Kind() != reflect.Func {
+		return nil, fmt.Errorf("API handler must be a function, not %T", fn)
+	}
+	typ := val.Type()
+	if typ.NumIn() != 2 || typ.In(0) != contextType || typ.In(1).Kind() != reflect.Ptr || typ.In(1).Elem().Kind() != reflect.Struct ||
+		typ.NumOut() != 2 || typ.Out(1) != errorType {
+		return nil, fmt.Errorf("API handler must be of the form func(*app.Context, *Request) (Response, error), not %s", typ)
+	}
+	h := &apiHandler{
+		fn:  val,
+		in:  typ.In(1).Elem(),
+		out: typ.Out(0),
+	}
+	if err := h.initializeFields(h.in, "", nil, make(map[reflect.Type]bool)); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// isAPILeafStruct returns true iff the given struct type is
+// parsed from a single form value rather than having its fields
+// decoded individually (e.g. time.Time).
+func isAPILeafStruct(typ reflect.Type) bool {
+	ptr := reflect.PtrTo(typ)
+	return ptr.Implements(parserType) || ptr.Implements(unmarshalType)
+}
+
+// initializeFields adds the fields in typ to h.fields, recursing into
+// nested structs. visiting contains the struct types being initialized,
+// to avoid recursing forever into self-referential types.
+func (h *apiHandler) initializeFields(typ reflect.Type, prefix string, indexes []int, visiting map[reflect.Type]bool) error {
+	visiting[typ] = true
+	defer delete(visiting, typ)
+	for ii := 0; ii < typ.NumField(); ii++ {
+		field := typ.Field(ii)
+		if field.PkgPath != "" {
+			// Unexported
+			continue
+		}
+		name := field.Name
+		jsonName := field.Tag.Get("json")
+		if p := strings.IndexByte(jsonName, ','); p >= 0 {
+			jsonName = jsonName[:p]
+		}
+		if jsonName == "-" {
+			continue
+		}
+		idx := make([]int, len(indexes)+1)
+		copy(idx, indexes)
+		idx[len(indexes)] = ii
+		ft := field.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Struct && !isAPILeafStruct(ft) {
+			if visiting[ft] {
+				// Self-referential type, it can only be
+				// decoded from a JSON body.
+				continue
+			}
+			if !hasExportedFields(ft) {
+				return fmt.Errorf("field %s in %s has type %s, which can't be decoded from a form, implement input.Parser or encoding.TextUnmarshaler", name, typ, field.Type)
+			}
+			p := prefix
+			if jsonName != "" || !field.Anonymous {
+				// Embedded structs without a name are
+				// flattened, like encoding/json does.
+				if jsonName == "" {
+					jsonName = name
+				}
+				p += jsonName + "."
+			}
+			if err := h.initializeFields(ft, p, idx, visiting); err != nil {
+				return err
+			}
+			continue
+		}
+		if jsonName == "" {
+			jsonName = name
+		}
+		if _, err := structs.ValidationFunction(reflect.New(typ).Interface(), name); err != nil {
+			return err
+		}
+		h.fields = append(h.fields, &apiField{
+			path:    prefix + jsonName,
+			name:    name,
+			indexes: idx,
+			typ:     field.Type,
+			tag:     structs.NewTag(field, apiTags),
+			help:    field.Tag.Get("help"),
+		})
+	}
+	return nil
+}
+
+func hasExportedFields(typ reflect.Type) bool {
+	for ii := 0; ii < typ.NumField(); ii++ {
+		if typ.Field(ii).PkgPath == "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *apiHandler) handle(ctx *Context) {
+	in := reflect.New(h.in)
+	if err := h.decode(ctx, in); err != nil {
+		writeAPIError(ctx, err)
+		return
+	}
+	if err := h.validate(ctx, in); err != nil {
+		writeAPIError(ctx, err)
+		return
+	}
+	res := h.fn.Call([]reflect.Value{reflect.ValueOf(ctx), in})
+	if err, _ := res[1].Interface().(error); err != nil {
+		writeAPIError(ctx, err)
+		return
+	}
+	out := res[0]
+	if (out.Kind() == reflect.Ptr || out.Kind() == reflect.Interface) && out.IsNil() {
+		ctx.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if _, err := ctx.WriteJSON(out.Interface()); err != nil {
+		panic(err)
+	}
+}
+
+func (h *apiHandler) decode(ctx *Context, in reflect.Value) error {
+	r := ctx.R
+	if r == nil {
+		return nil
+	}
+	if apiHasBody[r.Method] && r.Body != nil {
+		ct, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if ct == "application/json" || strings.HasSuffix(ct, "+json") {
+			return h.decodeJSON(ctx, in)
+		}
+		if ct == "multipart/form-data" {
+			if err := r.ParseMultipartForm(apiMaxMemory); err != nil {
+				return &APIError{Status: http.StatusBadRequest, Message: err.Error()}
+			}
+		}
+	}
+	if err := r.ParseForm(); err != nil {
+		return &APIError{Status: http.StatusBadRequest, Message: err.Error()}
+	}
+	var fields []*APIFieldError
+	for _, v := range h.fields {
+		values := r.Form[v.path]
+		if len(values) == 0 {
+			continue
+		}
+		val, _ := v.value(in, true)
+		if err := parseAPIValue(val, values); err != nil {
+			fields = append(fields, &APIFieldError{
+				Field:   v.path,
+				Message: i18n.TranslatedError(err, ctx).Error(),
+			})
+		}
+	}
+	return apiFieldsError(ctx, fields)
+}
+
+func (h *apiHandler) decodeJSON(ctx *Context, in reflect.Value) error {
+	if err := ctx.DecodeJSON(in.Interface()); err != nil && err != io.EOF {
+		if e, ok := err.(*json.UnmarshalTypeError); ok && e.Field != "" {
+			return apiFieldsError(ctx, []*APIFieldError{
+				{Field: e.Field, Message: i18n.Sprintf(ctx, "invalid value, expecting %s", e.Type)},
+			})
+		}
+		return &APIError{
+			Status:  http.StatusBadRequest,
+			Message: i18n.Sprintf(ctx, "invalid JSON: %s", err),
+		}
+	}
+	return nil
+}
+
+func (h *apiHandler) validate(ctx *Context, in reflect.Value) error {
+	var fields []*APIFieldError
+	for _, v := range h.fields {
+		val, parent := v.value(in, false)
+		if !val.IsValid() {
+			// Inside a nil pointer to a struct
+			continue
+		}
+		err := validateAPIValue(v, val)
+		if err == nil {
+			err = structs.Validate(parent.Addr().Interface(), v.name, ctx)
+		}
+		if err != nil {
+			fields = append(fields, &APIFieldError{
+				Field:   v.path,
+				Message: i18n.TranslatedError(err, ctx).Error(),
+			})
+		}
+	}
+	return apiFieldsError(ctx, fields)
+}
+
+func parseAPIValue(val reflect.Value, values []string) error {
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			val.Set(reflect.New(val.Type().Elem()))
+		}
+		val = val.Elem()
+	}
+	if val.Kind() == reflect.Slice && !reflect.PtrTo(val.Type()).Implements(parserType) {
+		slice := reflect.MakeSlice(val.Type(), len(values), len(values))
+		for ii, v := range values {
+			if err := parseAPIString(v, slice.Index(ii)); err != nil {
+				return err
+			}
+		}
+		val.Set(slice)
+		return nil
+	}
+	return parseAPIString(values[0], val)
+}
+
+// parseAPIString parses s into val, using form/input unless
+// val implements encoding.TextUnmarshaler but not input.Parser
+// (e.g. time.Time, which is parsed as RFC 3339).
+func parseAPIString(s string, val reflect.Value) error {
+	ptr := val.Addr()
+	if ptr.Type().Implements(unmarshalType) && !ptr.Type().Implements(parserType) {
+		if s == "" {
+			val.Set(reflect.Zero(val.Type()))
+			return nil
+		}
+		return ptr.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+	return input.Parse(s, ptr.Interface())
+}
+
+// validateAPIValue checks the constraints in the field tag, reusing the
+// same checks and error messages used by form/input.
+func validateAPIValue(f *apiField, val reflect.Value) error {
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			if f.tag.Required() {
+				return input.RequiredInputError(f.path)
+			}
+			return nil
+		}
+		val = val.Elem()
+	}
+	switch val.Kind() {
+	case reflect.String:
+		var s string
+		return input.InputNamed(f.path, val.String(), &s, f.tag, false)
+	case reflect.Bool:
+		return nil
+	case reflect.Slice, reflect.Map:
+		if f.tag.Required() && val.Len() == 0 {
+			return input.RequiredInputError(f.path)
+		}
+	default:
+		if f.tag.Required() && reflect.DeepEqual(val.Interface(), reflect.Zero(val.Type()).Interface()) {
+			return input.RequiredInputError(f.path)
+		}
+	}
+	return nil
+}
+
+func apiFieldsError(ctx *Context, fields []*APIFieldError) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	return &APIError{
+		Status:  http.StatusBadRequest,
+		Message: apiInvalidMsg.TranslatedString(ctx),
+		Fields:  fields,
+	}
+}
+
+func writeAPIError(ctx *Context, err error) {
+	aerr, ok := err.(*APIError)
+	if !ok {
+		aerr = &APIError{Status: http.StatusInternalServerError}
+		if e, ok := err.(Error); ok {
+			aerr.Status = e.StatusCode()
+		}
+		if aerr.Status >= http.StatusInternalServerError {
+			ctx.Logger().Errorf("error in API handler: %s", err)
+		}
+		if aerr.Status >= http.StatusInternalServerError && !ctx.app.cfg.Debug {
+			// Don't leak internal errors to the client
+			if msg, ok := defaultMessages[aerr.Status]; ok {
+				aerr.Message = msg.TranslatedString(ctx)
+			} else {
+				aerr.Message = http.StatusText(aerr.Status)
+			}
+		} else {
+			aerr.Message = i18n.TranslatedError(err, ctx).Error()
+		}
+	}
+	data, merr := json.Marshal(map[string]*APIError{"error": aerr})
+	if merr != nil {
+		panic(merr)
+	}
+	header := ctx.Header()
+	header.Set("Content-Type", "application/json")
+	header.Del("Content-Length")
+	ctx.WriteHeader(aerr.Status)
+	ctx.Write(data)
+}
+
+// APIHandler returns a Handler from a typed API function, which must be
+// of the form:
+//
+//  func(ctx *app.Context, in *Request) (Response, error)
+//
+// Request must be a struct type, while Response might be any type which
+// can be encoded as JSON. Before calling the function, the request is
+// decoded into a new *Request. Requests with a JSON body (for POST, PUT,
+// PATCH and DELETE) are decoded using encoding/json, while the rest of them
+// are decoded from the form and query values, using form/input to parse
+// each field. Field names are taken from their json struct tags (or the
+// field name, if there's no tag), while nested fields use dots to separate
+// their names (e.g. address.city). Fields implementing encoding.TextUnmarshaler
+// are parsed from a single value (e.g. time.Time, which must use RFC 3339).
+// Self-referential fields can only be decoded from a JSON body.
+//
+// Once decoded, each field is validated using the constraints in its form
+// or gondola tag (required, max_length, min_length and alphanumeric) as well
+// as its validation function, if any (see gnd.la/util/structs.Validate).
+// Validation functions receive the *app.Context as their only argument.
+//
+//  type CreateUserRequest struct {
+//	Username string `json:"username" form:",required,alphanumeric,max_length=32"`
+//	Email    string `json:"email" form:",required"`
+//  }
+//
+//  func (r *CreateUserRequest) ValidateEmail(ctx *app.Context) error {...}
+//
+//  func CreateUser(ctx *app.Context, in *CreateUserRequest) (*User, error) {...}
+//
+//  myapp.Handle("^/api/users/$", app.APIHandler(CreateUser), app.MethodHandler("POST"))
+//
+// If the function returns a non-nil Response, it's encoded as JSON and sent
+// to the client. A nil Response sends a 204 (No Content). Errors are sent as
+// JSON too (see APIError), using the status code from the error if it implements
+// Error or 500 otherwise. Messages are translated using i18n.TranslatedError,
+// but the messages of 5xx errors are not sent to the client unless the App is
+// in debug mode.
+//
+// APIHandler panics if fn does not have the required signature. See also
+// App.HandleAPI.
+func APIHandler(fn interface{}) Handler {
+	h, err := newAPIHandler(fn)
+	if err != nil {
+		panic(err)
+	}
+	return h.handle
+}
+
+// HandleAPI is a shorthand for registering a Handler created with
+// APIHandler(fn) using App.Handle. Additionally, handlers registered
+// with HandleAPI include their request and response types in the
+// documents generated by App.OpenAPI.
+func (app *App) HandleAPI(pattern string, fn interface{}, opts ...HandlerOption) {
+	h, err := newAPIHandler(fn)
+	if err != nil {
+		panic(err)
+	}
+	app.Handle(pattern, h.handle, opts...)
+	app.handlers[len(app.handlers)-1].api = h
+}
@@ -0,0 +1,211 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+type apiAddress struct {
+	City string `json:"city" form:",required"`
+}
+
+type apiRequest struct {
+	Name    string      `json:"name" form:",required,max_length=8"`
+	Age     int         `json:"age"`
+	Tags    []string    `json:"tags"`
+	Address *apiAddress `json:"address"`
+	Ignored string      `json:"-"`
+}
+
+func (r *apiRequest) ValidateAge(ctx *Context) error {
+	if r.Age < 0 {
+		return errors.New("age can't be negative")
+	}
+	return nil
+}
+
+type apiResponse struct {
+	Greeting string   `json:"greeting"`
+	Tags     []string `json:"tags,omitempty"`
+	City     string   `json:"city,omitempty"`
+}
+
+func TestAPIHandler(t *testing.T) {
+	a := New()
+	a.Handle("^/greet/$", APIHandler(func(ctx *Context, in *apiRequest) (*apiResponse, error) {
+		switch in.Name {
+		case "nobody":
+			return nil, nil
+		case "missing":
+			return nil, &NotFoundError{}
+		case "fail":
+			return nil, errors.New("secret")
+		}
+		resp := &apiResponse{Greeting: "hello " + in.Name, Tags: in.Tags}
+		if in.Address != nil {
+			resp.City = in.Address.City
+		}
+		return resp, nil
+	}))
+	if err := a.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	serve := func(method string, query string, body string, ct string) (int, map[string]interface{}) {
+		var r *http.Request
+		var err error
+		if body != "" {
+			r, err = http.NewRequest(method, "http://localhost/greet/?"+query, strings.NewReader(body))
+			r.Header.Set("Content-Type", ct)
+		} else {
+			r, err = http.NewRequest(method, "http://localhost/greet/?"+query, nil)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, r)
+		var res map[string]interface{}
+		if w.Body.Len() > 0 {
+			if ct := w.Header().Get("Content-Type"); ct != "application/json" {
+				t.Errorf("expecting JSON response, got %q", ct)
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return w.Code, res
+	}
+	fieldErrors := func(res map[string]interface{}) []string {
+		var fields []string
+		if e, ok := res["error"].(map[string]interface{}); ok {
+			f, _ := e["fields"].([]interface{})
+			for _, v := range f {
+				fields = append(fields, v.(map[string]interface{})["field"].(string))
+			}
+		}
+		return fields
+	}
+	code, res := serve("GET", "name=bob&tags=a&tags=b", "", "")
+	if code != http.StatusOK || res["greeting"] != "hello bob" || len(res["tags"].([]interface{})) != 2 {
+		t.Errorf("unexpected response %d %v", code, res)
+	}
+	form := url.Values{"name": {"alice"}, "address.city": {"Madrid"}}
+	code, res = serve("POST", "", form.Encode(), "application/x-www-form-urlencoded")
+	if code != http.StatusOK || res["greeting"] != "hello alice" || res["city"] != "Madrid" {
+		t.Errorf("unexpected response %d %v", code, res)
+	}
+	code, res = serve("POST", "", `{"name": "carol", "address": {"city": "Paris"}}`, "application/json; charset=utf-8")
+	if code != http.StatusOK || res["greeting"] != "hello carol" || res["city"] != "Paris" {
+		t.Errorf("unexpected response %d %v", code, res)
+	}
+	if code, _ = serve("GET", "name=nobody", "", ""); code != http.StatusNoContent {
+		t.Errorf("expecting 204, got %d", code)
+	}
+	errTests := []struct {
+		method string
+		query  string
+		body   string
+		code   int
+		fields []string
+	}{
+		{"GET", "", "", http.StatusBadRequest, []string{"name"}},
+		{"GET", "name=toolongname&age=-1", "", http.StatusBadRequest, []string{"name", "age"}},
+		{"GET", "name=bob&age=foo", "", http.StatusBadRequest, []string{"age"}},
+		{"POST", "", `{"name": "bob", "address": {}}`, http.StatusBadRequest, []string{"address.city"}},
+		{"POST", "", `{"name": "bob", "age": "foo"}`, http.StatusBadRequest, []string{"age"}},
+		{"POST", "", `{"name": `, http.StatusBadRequest, nil},
+		{"GET", "name=missing", "", http.StatusNotFound, nil},
+		{"GET", "name=fail", "", http.StatusInternalServerError, nil},
+	}
+	for _, v := range errTests {
+		code, res := serve(v.method, v.query, v.body, "application/json")
+		if code != v.code {
+			t.Errorf("%s %q %q returned %d, expecting %d", v.method, v.query, v.body, code, v.code)
+		}
+		e, ok := res["error"].(map[string]interface{})
+		if !ok {
+			t.Errorf("%s %q %q returned no error object: %v", v.method, v.query, v.body, res)
+			continue
+		}
+		if int(e["status"].(float64)) != v.code {
+			t.Errorf("%s %q %q returned status %v in error, expecting %d", v.method, v.query, v.body, e["status"], v.code)
+		}
+		if msg := e["message"].(string); msg == "" || strings.Contains(msg, "secret") {
+			t.Errorf("%s %q %q returned unexpected message %q", v.method, v.query, v.body, msg)
+		}
+		if fields := fieldErrors(res); strings.Join(fields, ",") != strings.Join(v.fields, ",") {
+			t.Errorf("%s %q %q returned field errors %v, expecting %v", v.method, v.query, v.body, fields, v.fields)
+		}
+	}
+}
+
+func TestInvalidAPIHandler(t *testing.T) {
+	for _, v := range []interface{}{
+		nil,
+		func(ctx *Context) (int, error) { return 0, nil },
+		func(ctx *Context, in int) (int, error) { return 0, nil },
+		func(ctx *Context, in *apiRequest) int { return 0 },
+	} {
+		if _, err := newAPIHandler(v); err == nil {
+			t.Errorf("expecting an error for API handler %T", v)
+		}
+	}
+}
+
+type apiNode struct {
+	Name  string   `json:"name"`
+	Child *apiNode `json:"child"`
+}
+
+type apiEventRequest struct {
+	When time.Time `json:"when" form:",required"`
+	Node *apiNode  `json:"node"`
+}
+
+func TestAPIHandlerFieldTypes(t *testing.T) {
+	h, err := newAPIHandler(func(ctx *Context, in *apiEventRequest) (*apiEventRequest, error) {
+		return in, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	for _, v := range h.fields {
+		paths = append(paths, v.path)
+	}
+	if p := strings.Join(paths, ","); p != "when,node.name" {
+		t.Errorf("expecting fields when,node.name, got %s", p)
+	}
+	a := New()
+	a.Handle("^/event/$", h.handle)
+	if err := a.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	get := func(query string) (int, string) {
+		r, err := http.NewRequest("GET", "http://localhost/event/?"+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, r)
+		return w.Code, w.Body.String()
+	}
+	if code, body := get("when=2015-06-01T10:00:00Z&node.name=root"); code != http.StatusOK ||
+		!strings.Contains(body, `"when":"2015-06-01T10:00:00Z"`) || !strings.Contains(body, `"name":"root"`) {
+		t.Errorf("unexpected response %d %s", code, body)
+	}
+	for _, v := range []string{"", "when=yesterday"} {
+		if code, body := get(v); code != http.StatusBadRequest || !strings.Contains(body, `"field":"when"`) {
+			t.Errorf("query %q: expecting 400 with an error for when, got %d %s", v, code, body)
+		}
+	}
+	if _, err := newAPIHandler(func(ctx *Context, in *struct{ Bad struct{ x int } }) (int, error) { return 0, nil }); err == nil {
+		t.Error("expecting an error for a struct field without exported fields")
+	}
+}
@@ -6,6 +6,7 @@ package app
 
 import (
 	"bytes"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
@@ -16,9 +17,11 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"text/template/parse"
 	"time"
 
@@ -82,19 +85,39 @@ type ErrorHandler func(*Context, string, int) bool
 type LanguageHandler func(*Context) string
 
 type handlerInfo struct {
-	host      string
-	name      string
-	path      string
-	pathMatch []int
-	re        *regexp.Regexp
-	rc        *regexpCache
-	handler   Handler
+	host    *hostPattern
+	name    string
+	methods []string
+	re      *regexp.Regexp
+	rc      *regexpCache
+	typed   *typedPattern
+	handler Handler
+	// Only used for OpenAPI generation
+	summary     string
+	description string
+	tags        []string
+	api         *apiHandler
+}
+
+// acceptsMethod returns true iff the handler should
+// respond to requests with the given method.
+func (h *handlerInfo) acceptsMethod(method string) bool {
+	if len(h.methods) == 0 {
+		return true
+	}
+	for _, v := range h.methods {
+		if v == method || (method == "HEAD" && v == "GET") {
+			return true
+		}
+	}
+	return false
 }
 
 type includedApp struct {
 	app       *App
 	name      string
 	prefix    string
+	host      *hostPattern
 	container string
 	renames   map[string]string
 }
@@ -165,11 +188,14 @@ type App struct {
 	cfg *Config
 
 	handlers           []*handlerInfo
+	routing            atomic.Value // *routing
+	transformers       []Transformer
 	trustXHeaders      bool
 	appendSlash        bool
 	errorHandler       ErrorHandler
 	languageHandler    LanguageHandler
 	userFunc           UserFunc
+	authenticators     []Authenticator
 	assetsManager      *assets.Manager
 	templatesFS        vfs.VFS
 	templatesMutex     sync.RWMutex
@@ -185,6 +211,16 @@ type App struct {
 	store              *blobstore.Blobstore
 	kv                 kvs.KVS
 	prepared           bool
+	server             *http.Server
+	redirectServer     *http.Server
+	shutdown           chan struct{}
+	shutdownErr        error
+	background         sync.WaitGroup
+	metrics            *metrics
+	healthChecks       []*healthCheck
+	healthRunning      map[string]bool
+	sessions           SessionStore
+	flashStore         FlashStore
 
 	// Used for included apps
 	included  []*includedApp
@@ -198,28 +234,72 @@ type App struct {
 // A named handler can be be reversed using Context.Reverse or
 // the "reverse" template function. Use NamedHandler() to set a name.
 //
-// To add a host-specific Handler, use HostHandler().
+// To add a host-specific Handler, use HostHandler(). To restrict
+// the Handler to some HTTP methods, use MethodHandler().
+//
+// Besides regular expressions, patterns might also be declared using
+// a typed syntax, like /users/{id:int}/{slug}. Parameters are enclosed
+// in braces and might optionally specify a type after a colon. The
+// available types are:
+//
+//  string (the default): any characters except /
+//  slug: letters, digits, underscores and dashes
+//  path: any characters, including /
+//  int: digits, converted to int
+//  uint: digits, converted to uint
+//  float: digits with an optional fractional part, converted to float64
+//
+// Typed patterns always match the whole path. Parameters are converted
+// before the Handler runs, and a InvalidParameterError is returned to
+// the client if any of them can't be converted. Use Context.TypedParamValue
+// to retrieve the converted values. Literal braces might be included in
+// typed patterns by escaping them with a backslash (e.g. /a/\{b\}/{id}).
+// Note that patterns starting with ^ or
+// without any parameters in braces are always interpreted as regular
+// expressions.
 func (app *App) Handle(pattern string, handler Handler, opts ...HandlerOption) {
 	if handler == nil {
 		panic(fmt.Errorf("handler for pattern %q can't be nil", pattern))
 	}
+	var typed *typedPattern
+	if isTypedPattern(pattern) {
+		var err error
+		if typed, err = parseTypedPattern(pattern); err != nil {
+			panic(err)
+		}
+		pattern = typed.regexp()
+		handler = typed.wrap(handler)
+	}
 	re := regexp.MustCompile(pattern)
 	handlerOpts := HandlerOptions{}
 	for _, v := range opts {
 		handlerOpts = v(handlerOpts)
 	}
+	if handlerOpts.Timeout > 0 {
+		handler = timeoutHandler(handler, handlerOpts.Timeout)
+	}
+	host, err := newHostPattern(handlerOpts.Host)
+	if err != nil {
+		panic(err)
+	}
 	info := &handlerInfo{
-		host:    handlerOpts.Host,
+		host:    host,
 		name:    handlerOpts.Name,
+		methods: handlerOpts.Methods,
 		re:      re,
 		rc:      newRegexpCache(re),
+		typed:   typed,
 		handler: handler,
+
+		summary:     handlerOpts.Summary,
+		description: handlerOpts.Description,
+		tags:        handlerOpts.Tags,
 	}
-	if p := literalRegexp(re); p != "" {
-		info.path = p
-		info.pathMatch = []int{0, len(p)}
-	}
-	app.handlers = append(app.handlers, info)
+	app.locked(func() {
+		app.handlers = append(app.handlers, info)
+		// Force the routing tree to be rebuilt
+		app.routing.Store((*routing)(nil))
+	})
 }
 
 // AddContextProcessor adds context processor to the App.
@@ -267,10 +347,39 @@ func (app *App) AddRecoverHandler(rh RecoverHandler) {
 	app.RecoverHandlers = append(app.RecoverHandlers, rh)
 }
 
+// IncludeOptions represent the different options which might be
+// specified when including an App into another one.
+type IncludeOptions struct {
+	// Host restricts the included App to the requests for the given
+	// host pattern. See HostHandler for the pattern syntax. When Host
+	// is non-empty, the prefix might be empty, so the included App is
+	// mounted at the root of the host.
+	Host string
+}
+
+// An IncludeOption represents a function which receives an
+// IncludeOptions, modifies and returns them. They're used in
+// App.Include to set the options for the included App.
+type IncludeOption func(IncludeOptions) IncludeOptions
+
+// HostInclude sets the IncludeOptions.Host field. See IncludeOptions
+// for more information.
+func HostInclude(host string) IncludeOption {
+	return func(opts IncludeOptions) IncludeOptions {
+		opts.Host = host
+		return opts
+	}
+}
+
 // Include should be considered a private API. It's only exported so
-// gnd.la/app/reusableapp can call into it.
-func (app *App) Include(prefix string, name string, included *App, containerTemplate string) {
-	if err := app.include(prefix, name, included, containerTemplate); err != nil {
+// gnd.la/app/reusableapp can call into it. See IncludeOption for
+// the available options.
+func (app *App) Include(prefix string, name string, included *App, containerTemplate string, opts ...IncludeOption) {
+	includeOpts := IncludeOptions{}
+	for _, v := range opts {
+		includeOpts = v(includeOpts)
+	}
+	if err := app.include(prefix, name, included, containerTemplate, includeOpts); err != nil {
 		panic(err)
 	}
 	if app.namespace == nil {
@@ -287,26 +396,30 @@ func (app *App) Include(prefix string, name string, included *App, containerTemp
 	app.namespace.vars["Apps"] = apps
 }
 
-func (app *App) include(prefix string, name string, child *App, containerTemplate string) error {
+func (app *App) include(prefix string, name string, child *App, containerTemplate string, opts IncludeOptions) error {
 	if child.parent != nil {
 		return fmt.Errorf("app %+v already has been included in another app", child)
 	}
 	if name == "" {
 		return fmt.Errorf("included app %+v can't have an empty name", child)
 	}
-	if prefix == "" {
-		return fmt.Errorf("can't include app %s with empty prefix", name)
+	host, err := newHostPattern(opts.Host)
+	if err != nil {
+		return err
 	}
 	// prefix must start with / and end without /,
 	// fix it if it doesn't match
-	if prefix[0] != '/' {
+	if prefix != "" && prefix[0] != '/' {
 		prefix = "/" + prefix
 	}
-	for prefix[len(prefix)-1] == '/' {
+	for prefix != "" && prefix[len(prefix)-1] == '/' {
 		prefix = prefix[:len(prefix)-1]
 	}
+	if prefix == "" && host == nil {
+		return fmt.Errorf("can't include app %s with empty prefix", name)
+	}
 	for _, v := range app.included {
-		if v.prefix == prefix {
+		if v.prefix == prefix && v.host.String() == host.String() {
 			return fmt.Errorf("can't include app at prefix %q, app %q is already using it", prefix, v.name)
 		}
 		if v.name == name {
@@ -321,6 +434,7 @@ func (app *App) include(prefix string, name string, child *App, containerTemplat
 		app:       child,
 		name:      name,
 		prefix:    prefix,
+		host:      host,
 		container: containerTemplate,
 	}
 	if containerTemplate != "" {
@@ -355,7 +469,7 @@ func (app *App) include(prefix string, name string, child *App, containerTemplat
 		}
 	}
 	// All checks passed, add the included app handler
-	app.Handle("^"+prefix, includedAppHandler(child, prefix))
+	app.Handle("^"+prefix, includedAppHandler(child, prefix), HostHandler(opts.Host))
 	return nil
 }
 
@@ -533,11 +647,15 @@ func (app *App) LoadTemplatePlugin(name string, pos assets.Position) error {
 	tmpl := template.New(app.templatesFS, app.assetsManager)
 	// Functions defined by gnd.la/app.Template
 	tmpl.RawFuncs(map[string]interface{}{
-		"t":       nop,
-		"tn":      nop,
-		"tc":      nop,
-		"tnc":     nop,
-		"reverse": nop,
+		"t":              nop,
+		"tn":             nop,
+		"tc":             nop,
+		"tnc":            nop,
+		"reverse":        nop,
+		"flashes":        nop,
+		"render_flashes": nop,
+		"has_permission": nop,
+		"has_role":       nop,
 	})
 	if err := tmpl.Parse(name); err != nil {
 		return err
@@ -779,17 +897,33 @@ func (app *App) MustReverse(name string, args ...interface{}) string {
 // e.g. given the pattern ^/article/\d+/[\w\-]+/$, you should provide
 // 2 arguments and passing 42 and "the-ultimate-answer-to-life-the-universe-and-everything"
 // would return "/article/42/the-ultimate-answer-to-life-the-universe-and-everything/"
+// For handlers registered with a typed pattern (e.g. /article/{id:int}/{slug}),
+// the arguments must be provided in the same order the parameters appear in
+// the pattern and each one must be valid for its parameter type.
 // If the handler is also restricted to a given hostname, the return value
 // will be a scheme relative url e.g. //www.example.com/article/...
+//
+// For handlers restricted to a host pattern with parameters (e.g.
+// {tenant}.example.com, see HostHandler), the host parameters must be
+// provided first, followed by the path parameters. e.g. given the host
+// pattern {tenant}.example.com and the path pattern /article/{id:int}/,
+// reversing with the arguments "acme" and 42 would return
+// "//acme.example.com/article/42/". To build URLs for the host
+// which matched the current request, use Context.ReverseSameHost.
 func (app *App) Reverse(name string, args ...interface{}) (string, error) {
-	return app.reverse(name, args)
+	return app.reverse(name, args, nil)
 }
 
-func (app *App) reverse(name string, args []interface{}) (string, error) {
+// reverse reverses the handler with the given name. If cur is non-nil
+// and the handler is restricted to a host pattern with parameters,
+// the host from cur is used and args must include only the path
+// parameters. In that case, the host of cur must have been matched
+// by the same host pattern as the handler.
+func (app *App) reverse(name string, args []interface{}, cur *regexpProvider) (string, error) {
 	if name == "" {
 		return "", errors.New("can't reverse, no handler name specified")
 	}
-	found, s, err := app.reverseHandler(name, args)
+	found, s, err := app.reverseHandler(name, args, cur)
 	if err != nil {
 		return "", err
 	}
@@ -799,10 +933,41 @@ func (app *App) reverse(name string, args []interface{}) (string, error) {
 	return s, nil
 }
 
-func (app *App) reverseHandler(name string, args []interface{}) (bool, string, error) {
+func (app *App) reverseHandler(name string, args []interface{}, cur *regexpProvider) (bool, string, error) {
 	for _, v := range app.handlers {
 		if v.name == name {
-			reversed, err := formatRegexp(v.rc, args)
+			host := v.host
+			if host == nil && app.childInfo != nil {
+				host = app.childInfo.host
+			}
+			var hostName string
+			if host != nil {
+				if cur != nil && host.numParams() > 0 {
+					if cur.host == nil || cur.host.pattern != host.pattern {
+						return true, "", fmt.Errorf("handler %q requires host pattern %q, which did not match the current request",
+							name, host.pattern)
+					}
+					hostName = cur.hostName
+				} else {
+					n := host.numParams()
+					if len(args) < n {
+						return true, "", fmt.Errorf("handler %q requires at least %d host arguments, %d received instead",
+							name, n, len(args))
+					}
+					var err error
+					if hostName, err = host.format(args[:n]); err != nil {
+						return true, "", fmt.Errorf("error reversing host for handler %q: %s", name, err)
+					}
+					args = args[n:]
+				}
+			}
+			var reversed string
+			var err error
+			if v.typed != nil {
+				reversed, err = v.typed.format(args)
+			} else {
+				reversed, err = formatRegexp(v.rc, args)
+			}
 			if err != nil {
 				if acerr, ok := err.(*argumentCountError); ok {
 					if acerr.Min == acerr.Max {
@@ -820,14 +985,14 @@ func (app *App) reverseHandler(name string, args []interface{}) (bool, string, e
 				// Include, we can just prepend it.
 				reversed = app.childInfo.prefix + reversed
 			}
-			if v.host != "" {
-				reversed = fmt.Sprintf("//%s%s", v.host, reversed)
+			if hostName != "" {
+				reversed = fmt.Sprintf("//%s%s", hostName, reversed)
 			}
 			return true, reversed, nil
 		}
 	}
 	for _, v := range app.included {
-		if found, s, err := v.app.reverseHandler(name, args); found {
+		if found, s, err := v.app.reverseHandler(name, args, cur); found {
 			return found, s, err
 		}
 	}
@@ -835,7 +1000,15 @@ func (app *App) reverseHandler(name string, args []interface{}) (bool, string, e
 }
 
 // ListenAndServe starts listening on the configured address and
-// port (see Address() and Port).
+// port (see Address() and Port). When the process receives SIGINT
+// or SIGTERM, the App is gracefully stopped and ListenAndServe
+// returns. See App.Shutdown for more details.
+//
+// If Config.TLSCertificate or Config.TLSCertificatesDir are set,
+// the App serves HTTPS on its port, and optionally also accepts
+// plain HTTP requests on Config.HTTPPort, redirecting them to HTTPS.
+// Certificates are reloaded when the process receives SIGHUP or when
+// their files are modified.
 func (app *App) ListenAndServe() error {
 	if err := app.Prepare(); err != nil {
 		return err
@@ -858,20 +1031,67 @@ func (app *App) ListenAndServe() error {
 		}
 	} else {
 		if app.Logger != nil {
+			scheme := "HTTP"
+			if app.usesTLS() {
+				scheme = "HTTPS"
+			}
 			if app.address != "" {
-				app.Logger.Infof("Listening on %s, port %d", app.address, app.cfg.Port)
+				app.Logger.Infof("Listening on %s, port %d (%s)", app.address, app.cfg.Port, scheme)
 			} else {
-				app.Logger.Infof("Listening on port %d", app.cfg.Port)
+				app.Logger.Infof("Listening on port %d (%s)", app.cfg.Port, scheme)
+			}
+		}
+	}
+	srv := &http.Server{
+		Addr:    app.address + ":" + strconv.Itoa(app.cfg.Port),
+		Handler: app,
+	}
+	done := make(chan struct{})
+	defer close(done)
+	var redirectSrv *http.Server
+	if app.usesTLS() {
+		certs, err := newCertificateStore(app.cfg)
+		if err != nil {
+			return err
+		}
+		srv.TLSConfig = &tls.Config{GetCertificate: certs.getCertificate}
+		go certs.watch(app, done)
+		if p := app.cfg.HTTPPort; p > 0 {
+			redirectSrv = &http.Server{
+				Addr:    app.address + ":" + strconv.Itoa(p),
+				Handler: app.httpsRedirectHandler(),
 			}
 		}
 	}
+	app.locked(func() {
+		app.server = srv
+		app.redirectServer = redirectSrv
+		app.shutdown = make(chan struct{})
+	})
+	go app.trapSignals(done)
 	var err error
 	time.AfterFunc(500*time.Millisecond, func() {
 		if err == nil {
 			Signals.DidListen.emit(app)
 		}
 	})
-	err = http.ListenAndServe(app.address+":"+strconv.Itoa(app.cfg.Port), app)
+	if redirectSrv != nil {
+		go func() {
+			if rerr := redirectSrv.ListenAndServe(); rerr != nil && rerr != http.ErrServerClosed && app.Logger != nil {
+				app.Logger.Errorf("error listening on HTTP port %d: %s", app.cfg.HTTPPort, rerr)
+			}
+		}()
+	}
+	if srv.TLSConfig != nil {
+		err = srv.ListenAndServeTLS("", "")
+	} else {
+		err = srv.ListenAndServe()
+	}
+	if err == http.ErrServerClosed {
+		// Shutdown was called, wait until it finishes
+		<-app.shutdown
+		return app.shutdownErr
+	}
 	return err
 }
 
@@ -1155,6 +1375,11 @@ func (app *App) errorPage(ctx *Context, elapsed time.Duration, skip int, stackSk
 // to call this function
 func (app *App) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := app.newContext(w, r)
+	if r.TLS != nil {
+		if hsts := app.hstsHeader(); hsts != "" {
+			w.Header().Set("Strict-Transport-Security", hsts)
+		}
+	}
 	if profile.On && shouldProfile(ctx) {
 		profile.Begin()
 		defer profile.End(0)
@@ -1164,24 +1389,53 @@ func (app *App) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if app.runProcessors(ctx) {
 		return
 	}
-	app.serveOrNotFound(r.URL.Path, ctx)
+	app.serveTransformed(r.URL.Path, ctx)
+}
+
+// serveTransformed serves the given path, running the Transformers
+// added with AddTransformer.
+func (app *App) serveTransformed(path string, ctx *Context) {
+	rt := app.routes()
+	if rt.transformed == nil {
+		app.serveOrNotFound(rt.router, path, ctx)
+		return
+	}
+	ctx.routePath = path
+	rt.transformed(ctx)
 }
 
-func (app *App) serveOrNotFound(path string, ctx *Context) {
-	if !app.serve(path, ctx) {
+func (app *App) serveOrNotFound(r *router, path string, ctx *Context) {
+	if !app.serve(r, path, ctx) {
 		// Not Found
 		app.handleHTTPError(ctx, "Not Found", http.StatusNotFound)
 	}
 }
 
-func (app *App) serve(path string, ctx *Context) bool {
-	if handler := app.matchHandler(path, ctx); handler != nil {
+func (app *App) serve(r *router, path string, ctx *Context) bool {
+	handler, allowed := app.matchHandler(r, path, ctx)
+	if handler != nil {
+		if m := app.handlerMetrics(ctx.handlerName); m != nil {
+			// When serving an included App, the handler in the
+			// parent App has already started recording the
+			// request, so only the innermost handler records it.
+			if ctx.metrics != nil {
+				ctx.metrics.cancel()
+			}
+			m.begin()
+			ctx.metrics = m
+		}
 		handler(ctx)
+		if ctx.stream != nil {
+			// Close the stream before any Transformers get to
+			// finish the response, so background writes (e.g.
+			// heartbeats) don't land on a finished writer.
+			ctx.stream.Close()
+		}
 		return true
 	}
 
 	if app.appendSlash && (ctx.R.Method == "GET" || ctx.R.Method == "HEAD") && !strings.HasSuffix(path, "/") {
-		if app.matchHandler(path+"/", ctx) != nil {
+		if h, _ := app.matchHandler(r, path+"/", ctx); h != nil {
 			prevPath := ctx.R.URL.Path
 			ctx.R.URL.Path += "/"
 			ctx.Redirect(ctx.R.URL.String(), true)
@@ -1189,31 +1443,100 @@ func (app *App) serve(path string, ctx *Context) bool {
 			return true
 		}
 	}
+	if len(allowed) > 0 {
+		app.serveMethodNotAllowed(ctx, allowed)
+		return true
+	}
 	return false
 }
 
-func (app *App) matchHandler(path string, ctx *Context) Handler {
-	for _, v := range app.handlers {
-		if v.host != "" && v.host != ctx.R.Host {
-			continue
+// matchHandler returns the Handler which should serve the given path. If
+// no Handler matches, but there are handlers matching the path which do
+// not accept the request method, the methods accepted by them are returned
+// in allowed.
+func (app *App) matchHandler(r *router, path string, ctx *Context) (handler Handler, allowed []string) {
+	info, matches, allowed := r.match(path, ctx.R.Host, ctx.R.Method)
+	if info != nil {
+		ctx.reProvider.reset(info.re, path, matches)
+		if info.host != nil {
+			ctx.reProvider.setHost(info.host, ctx.R.Host)
 		}
-		if v.path != "" {
-			if v.path == path {
-				ctx.reProvider.reset(v.re, path, v.pathMatch)
-				ctx.handlerName = v.name
-				return v.handler
-			}
-		} else {
-			// Use FindStringSubmatchIndex, since this way we can
-			// reuse the slices used to store context arguments
-			if m := v.re.FindStringSubmatchIndex(path); m != nil {
-				ctx.reProvider.reset(v.re, path, m)
-				ctx.handlerName = v.name
-				return v.handler
-			}
+		ctx.handlerName = info.name
+		return info.handler, nil
+	}
+	return nil, allowed
+}
+
+// serveMethodNotAllowed answers OPTIONS requests with the allowed
+// methods and any other methods with a 405 error.
+func (app *App) serveMethodNotAllowed(ctx *Context, allowed []string) {
+	methods := map[string]bool{"OPTIONS": true}
+	for _, v := range allowed {
+		methods[v] = true
+		if v == "GET" {
+			methods["HEAD"] = true
 		}
 	}
-	return nil
+	values := make([]string, 0, len(methods))
+	for k := range methods {
+		values = append(values, k)
+	}
+	sort.Strings(values)
+	ctx.Header().Set("Allow", strings.Join(values, ", "))
+	if ctx.R.Method == "OPTIONS" {
+		ctx.Header().Set("Content-Length", "0")
+		ctx.WriteHeader(http.StatusOK)
+		return
+	}
+	ctx.Error(http.StatusMethodNotAllowed)
+}
+
+// routing holds the routing tree for the App handlers and the
+// chain of app-wide Transformers. Once published, it's never
+// modified, so requests can use it without holding any locks.
+type routing struct {
+	router      *router
+	transformed Handler
+}
+
+// routes returns the current routing, building it if handlers or
+// Transformers have been added since it was last built. Callers
+// should load it once per request and use that value until
+// the request finishes.
+func (app *App) routes() *routing {
+	if rt, _ := app.routing.Load().(*routing); rt != nil {
+		return rt
+	}
+	app.mu.Lock()
+	defer app.mu.Unlock()
+	// Another goroutine might have built it while
+	// we were waiting for the lock.
+	rt, _ := app.routing.Load().(*routing)
+	if rt == nil {
+		rt = app.prepareRouter()
+	}
+	return rt
+}
+
+// prepareRouter builds and publishes the routing tree for the
+// registered handlers as well as the chain of app-wide Transformers.
+// It's called from Prepare, but handlers added after the App has been
+// prepared (or included apps, which are not prepared) will cause
+// the tree to be built again on demand by routes. It must be
+// called with app.mu held.
+func (app *App) prepareRouter() *routing {
+	rt := &routing{router: newRouter(app.handlers)}
+	if len(app.transformers) > 0 {
+		handler := func(ctx *Context) {
+			app.serveOrNotFound(rt.router, ctx.routePath, ctx)
+		}
+		for ii := len(app.transformers) - 1; ii >= 0; ii-- {
+			handler = app.transformers[ii](handler)
+		}
+		rt.transformed = handler
+	}
+	app.routing.Store(rt)
+	return rt
 }
 
 // newContext returns a new context, using the
@@ -1250,6 +1573,7 @@ func (app *App) NewContext(p ContextProvider) *Context {
 // don't call NewContext() yourself, you don't need to call
 // CloseContext().
 func (app *App) CloseContext(ctx *Context) {
+	saveSession(ctx)
 	for _, v := range app.ContextFinalizers {
 		v(ctx)
 	}
@@ -1274,6 +1598,9 @@ func (app *App) CloseContext(ctx *Context) {
 // closeContext calls CloseContexts and stores the context in
 // in the pool for reusing it.
 func (app *App) closeContext(ctx *Context) {
+	if ctx.metrics != nil {
+		ctx.metrics.end(ctx)
+	}
 	app.CloseContext(ctx)
 }
 
@@ -1404,6 +1731,9 @@ func (app *App) Prepare() error {
 	// Initialize the ORM first, so admin commands
 	// run with the ORM ready to be used.
 	if app.parent == nil {
+		if err := app.prepareSessions(); err != nil {
+			return err
+		}
 		err := app.prepareOrm()
 		if err != nil && err != errNoDefaultDatabase && err != errNoAppOrm {
 			return err
@@ -1423,6 +1753,7 @@ func (app *App) Prepare() error {
 		child.Cipherer = app.Cipherer
 		child.languageHandler = app.languageHandler
 		child.userFunc = app.userFunc
+		child.authenticators = app.authenticators
 		child.Logger = app.Logger
 	}
 	// Add template plugins from each included app to all the other apps
@@ -1444,6 +1775,15 @@ func (app *App) Prepare() error {
 			}
 		}
 	}
+	if app.parent == nil && app.cfg.MetricsPath != "" {
+		app.enableMetrics()
+		app.Handle("^"+regexp.QuoteMeta(app.cfg.MetricsPath)+"$", metricsHandler)
+	}
+	app.locked(func() { app.prepareRouter() })
+	for _, v := range app.included {
+		child := v.app
+		child.locked(func() { child.prepareRouter() })
+	}
 	app.prepared = true
 	Signals.DidPrepare.emit(app)
 	return nil
@@ -1467,6 +1807,21 @@ func (app *App) Transform(tr Transformer) {
 	}
 }
 
+// AddTransformer adds a Transformer which wraps every request served
+// by the App, including the ones which don't match any handler. Transformers
+// run in the same order they were added, after the ContextProcessors and
+// before the request is matched to a handler. Unlike Transform, it also
+// applies to handlers registered after this call. It's safe to call
+// it while the App is serving requests: requests already in flight
+// finish with the previous chain, while new ones use the new one.
+func (app *App) AddTransformer(tr Transformer) {
+	app.locked(func() {
+		app.transformers = append(app.transformers, tr)
+		// Force the handler chain to be rebuilt
+		app.routing.Store((*routing)(nil))
+	})
+}
+
 // New returns a new App initialized with the default config.
 func New() *App {
 	return NewWithConfig(nil)
@@ -1495,6 +1850,7 @@ func NewWithConfig(config *Config) *App {
 		a.Handle("^/debug/pprof/profile", wrap(pprof.Profile))
 		a.Handle("^/debug/pprof", wrap(pprof.Index))
 		a.Handle("^/debug/profile", profileInfoHandler)
+		a.enableMetrics()
 		a.Handle(monitorAPIPage, monitorAPIHandler)
 		a.Handle(monitorPage, monitorHandler)
 		a.addAssetsManager(internalAssetsManager, false)
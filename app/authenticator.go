@@ -0,0 +1,250 @@
+package app
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// AuthMethodCookie is the Authentication.Method for users
+	// authenticated with the signed cookie set by Context.SignIn.
+	AuthMethodCookie = "cookie"
+	// AuthMethodBearer is the Authentication.Method for users
+	// authenticated with an Authorization: Bearer header.
+	AuthMethodBearer = "bearer"
+	// AuthMethodAPIKey is the Authentication.Method for users
+	// authenticated with an X-API-Key header.
+	AuthMethodAPIKey = "api-key"
+
+	// APIKeyHeaderName is the header used by the Authenticator
+	// returned from APIKeyAuthenticator.
+	APIKeyHeaderName = "X-API-Key"
+)
+
+// Authentication represents the result of successfully
+// authenticating the user making a request. Use
+// Context.Authentication to retrieve it.
+type Authentication struct {
+	// User is the authenticated user. It must be non-nil.
+	User User
+	// Method indicates how the user was authenticated
+	// (e.g. AuthMethodCookie or AuthMethodBearer).
+	Method string
+	// Scopes optionally lists the scopes granted to the request
+	// (e.g. when using a token with limited scopes). A nil Scopes
+	// grants every scope. Authentications with limited scopes
+	// (see Authentication.Restricted) only pass RequireScope and
+	// RequirePermission when they include the required scope or
+	// permission, and never pass SignedIn nor RequireRole.
+	Scopes []string
+	// Expires is the time when the credentials used for the
+	// request expire. The zero value indicates that they
+	// don't expire.
+	Expires time.Time
+}
+
+// HasScope returns true iff the authentication grants the given scope,
+// either because it has no restrictions, it explicitly includes
+// the scope or it includes the "*" scope.
+func (a *Authentication) HasScope(scope string) bool {
+	if a.Scopes == nil {
+		return true
+	}
+	for _, v := range a.Scopes {
+		if v == scope || v == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// Restricted returns true iff the authentication has limited scopes,
+// id est, if it doesn't grant every scope.
+func (a *Authentication) Restricted() bool {
+	return !a.HasScope("*")
+}
+
+// Authenticator is the interface implemented by types which can
+// determine the user making a request. Authenticate must return
+// nil and no error when the request doesn't include the credentials
+// it handles (e.g. when there's no Authorization header), so the
+// next Authenticator gets a chance to run. See App.SetAuthenticators.
+type Authenticator interface {
+	Authenticate(ctx *Context) (*Authentication, error)
+}
+
+// AuthenticatorFunc is an adapter to allow the use of ordinary
+// functions as an Authenticator.
+type AuthenticatorFunc func(ctx *Context) (*Authentication, error)
+
+// Authenticate implements the Authenticator interface.
+func (f AuthenticatorFunc) Authenticate(ctx *Context) (*Authentication, error) {
+	return f(ctx)
+}
+
+// TokenFunc is used by BearerAuthenticator and APIKeyAuthenticator to
+// resolve a token to an Authentication. If the token is not valid, it
+// must return nil and no error. The Method field of the returned
+// Authentication is set by the Authenticator when it's empty.
+type TokenFunc func(ctx *Context, token string) (*Authentication, error)
+
+type cookieAuthenticator struct{}
+
+func (cookieAuthenticator) Authenticate(ctx *Context) (*Authentication, error) {
+	if ctx.app.userFunc == nil {
+		return nil, nil
+	}
+	var id int64
+	if err := ctx.Cookies().GetSecure(USER_COOKIE_NAME, &id); err != nil {
+		return nil, nil
+	}
+	if user := ctx.app.userFunc(ctx, id); user != nil {
+		return &Authentication{User: user, Method: AuthMethodCookie}, nil
+	}
+	return nil, nil
+}
+
+// CookieAuthenticator returns an Authenticator which uses the signed
+// cookie set by Context.SignIn and the App UserFunc to authenticate
+// the user. This is the only Authenticator used by default.
+func CookieAuthenticator() Authenticator {
+	return cookieAuthenticator{}
+}
+
+type tokenAuthenticator struct {
+	method string
+	token  func(r *http.Request) string
+	fn     TokenFunc
+}
+
+func (t *tokenAuthenticator) Authenticate(ctx *Context) (*Authentication, error) {
+	if ctx.R == nil {
+		return nil, nil
+	}
+	token := t.token(ctx.R)
+	if token == "" {
+		return nil, nil
+	}
+	auth, err := t.fn(ctx, token)
+	if err != nil || auth == nil || auth.User == nil {
+		return nil, err
+	}
+	if auth.Method == "" {
+		auth.Method = t.method
+	}
+	return auth, nil
+}
+
+// BearerAuthenticator returns an Authenticator which reads the token
+// sent in the Authorization header using the Bearer scheme
+// (RFC 6750) and resolves it using fn.
+func BearerAuthenticator(fn TokenFunc) Authenticator {
+	return &tokenAuthenticator{
+		method: AuthMethodBearer,
+		token: func(r *http.Request) string {
+			value := r.Header.Get("Authorization")
+			if p := strings.IndexByte(value, ' '); p > 0 && strings.EqualFold(value[:p], "Bearer") {
+				return strings.TrimSpace(value[p+1:])
+			}
+			return ""
+		},
+		fn: fn,
+	}
+}
+
+// APIKeyAuthenticator returns an Authenticator which reads the token
+// sent in the X-API-Key header and resolves it using fn.
+func APIKeyAuthenticator(fn TokenFunc) Authenticator {
+	return &tokenAuthenticator{
+		method: AuthMethodAPIKey,
+		token: func(r *http.Request) string {
+			return strings.TrimSpace(r.Header.Get(APIKeyHeaderName))
+		},
+		fn: fn,
+	}
+}
+
+// Authenticators returns the Authenticators used by this App, in
+// the order they're consulted by Context.User. If none have been
+// set, it returns a slice with only a CookieAuthenticator.
+func (app *App) Authenticators() []Authenticator {
+	if app.authenticators == nil {
+		return []Authenticator{CookieAuthenticator()}
+	}
+	return app.authenticators
+}
+
+// SetAuthenticators sets the Authenticators used to determine the
+// user making a request. They're consulted in order by Context.User
+// and the first one returning a non-nil Authentication wins. If an
+// Authenticator returns an error, it's logged and the request is
+// considered anonymous. To keep the cookie based sign in, include a
+// CookieAuthenticator e.g.
+//
+//  myapp.SetAuthenticators(app.CookieAuthenticator(), app.BearerAuthenticator(fn), app.APIKeyAuthenticator(fn))
+func (app *App) SetAuthenticators(auths ...Authenticator) {
+	app.authenticators = auths
+	for _, v := range app.included {
+		v.app.authenticators = auths
+	}
+}
+
+// Authentication returns the Authentication for the current request,
+// or nil if the user is anonymous. The App Authenticators are only
+// consulted once per request. Since the response might depend on the
+// user, Authorization and X-API-Key are added to the Vary header.
+func (c *Context) Authentication() *Authentication {
+	if !c.authenticated {
+		c.authenticated = true
+		if c.ResponseWriter != nil {
+			h := c.Header()
+			h.Add("Vary", "Authorization")
+			h.Add("Vary", APIKeyHeaderName)
+		}
+		for _, v := range c.app.Authenticators() {
+			auth, err := v.Authenticate(c)
+			if err != nil {
+				c.Logger().Warningf("error authenticating request: %s", err)
+				break
+			}
+			if auth != nil {
+				c.auth = auth
+				c.user = auth.User
+				break
+			}
+		}
+	}
+	return c.auth
+}
+
+// HasScope returns true iff there's an authenticated user and
+// its Authentication grants the given scope. See Authentication.HasScope
+// for more details.
+func (c *Context) HasScope(scope string) bool {
+	auth := c.Authentication()
+	return auth != nil && auth.HasScope(scope)
+}
+
+// RequireScope returns a Transformer which requires an authenticated
+// user whose Authentication grants the given scope. Anonymous requests
+// receive a 401 (Unauthorized) error, while requests authenticated
+// without the scope (e.g. using a token with limited scopes) receive
+// a 403 (Forbidden) error.
+func RequireScope(scope string) Transformer {
+	return func(handler Handler) Handler {
+		return func(ctx *Context) {
+			auth := ctx.Authentication()
+			if auth == nil {
+				ctx.Header().Set("WWW-Authenticate", "Bearer")
+				ctx.Error(http.StatusUnauthorized)
+				return
+			}
+			if !auth.HasScope(scope) {
+				ctx.Forbidden()
+				return
+			}
+			handler(ctx)
+		}
+	}
+}
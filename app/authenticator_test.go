@@ -0,0 +1,80 @@
+package app_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"gnd.la/app"
+	"gnd.la/app/tester"
+)
+
+type authTestUser int64
+
+func (u authTestUser) Id() int64     { return int64(u) }
+func (u authTestUser) IsAdmin() bool { return false }
+
+func authTestTokenFunc(ctx *app.Context, token string) (*app.Authentication, error) {
+	// Tokens are of the form <user id>:<scope1>,<scope2>...
+	p := strings.SplitN(token, ":", 2)
+	id, err := strconv.ParseInt(p[0], 10, 64)
+	if err != nil {
+		return nil, nil
+	}
+	auth := &app.Authentication{User: authTestUser(id)}
+	if len(p) == 2 {
+		auth.Scopes = strings.Split(p[1], ",")
+	}
+	return auth, nil
+}
+
+func TestAuthenticators(t *testing.T) {
+	a := app.New()
+	a.SetUserFunc(func(ctx *app.Context, id int64) app.User {
+		return authTestUser(id)
+	})
+	a.SetAuthenticators(
+		app.CookieAuthenticator(),
+		app.BearerAuthenticator(authTestTokenFunc),
+		app.APIKeyAuthenticator(authTestTokenFunc),
+	)
+	a.Handle("^/user/$", func(ctx *app.Context) {
+		if user := ctx.User(); user != nil {
+			ctx.WriteString(strconv.FormatInt(user.Id(), 10) + " " + ctx.Authentication().Method)
+			return
+		}
+		ctx.WriteString("anonymous")
+	})
+	a.Handle("^/write/$", app.RequireScope("write")(func(ctx *app.Context) {
+		ctx.WriteString("ok")
+	}))
+	tt := tester.New(t, a)
+	tt.Get("/user/", nil).Expect("anonymous")
+	tt.Get("/user/", nil).AddHeader("Authorization", "Bearer 1").Expect("1 bearer")
+	tt.Get("/user/", nil).AddHeader("Authorization", "bearer 2:read").Expect("2 bearer")
+	tt.Get("/user/", nil).AddHeader("Authorization", "Basic Zm9vOmJhcg==").Expect("anonymous")
+	tt.Get("/user/", nil).AddHeader("Authorization", "Bearer invalid").Expect("anonymous")
+	tt.Get("/user/", nil).AddHeader("X-API-Key", "3").Expect("3 api-key")
+	tt.Get("/write/", nil).Expect(401).ExpectHeader("WWW-Authenticate", "Bearer")
+	tt.Get("/write/", nil).AddHeader("Authorization", "Bearer 1").Expect("ok")
+	tt.Get("/write/", nil).AddHeader("Authorization", "Bearer 1:read,write").Expect("ok")
+	tt.Get("/write/", nil).AddHeader("X-API-Key", "1:*").Expect("ok")
+	tt.Get("/write/", nil).AddHeader("Authorization", "Bearer 1:read").Expect(403)
+}
+
+func TestDefaultAuthenticators(t *testing.T) {
+	a := app.New()
+	a.SetUserFunc(func(ctx *app.Context, id int64) app.User {
+		return authTestUser(id)
+	})
+	a.Handle("^/user/$", func(ctx *app.Context) {
+		if ctx.User() != nil {
+			ctx.WriteString("user")
+			return
+		}
+		ctx.WriteString("anonymous")
+	})
+	tt := tester.New(t, a)
+	tt.Get("/user/", nil).AddHeader("Authorization", "Bearer 1").Expect("anonymous")
+	tt.Get("/user/", nil).AddHeader("X-API-Key", "1").Expect("anonymous")
+}
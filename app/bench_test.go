@@ -3,6 +3,7 @@ package app
 import (
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 )
 
@@ -71,3 +72,68 @@ func BenchmarkDirectNoLog(b *testing.B) {
 func BenchmarkDirectReNoLog(b *testing.B) {
 	benchmarkDirect(b, "article/7", true)
 }
+
+// linearMatch is the matcher used before the routing tree
+// was introduced. It's kept here to compare both.
+func linearMatch(handlers []*handlerInfo, path string, host string) (*handlerInfo, []int) {
+	for _, v := range handlers {
+		if v.host != nil && !v.host.matches(host) {
+			continue
+		}
+		if m := v.re.FindStringSubmatchIndex(path); m != nil {
+			return v, m
+		}
+	}
+	return nil, nil
+}
+
+func benchmarkHandlers(count int) []*handlerInfo {
+	var handlers []*handlerInfo
+	add := func(pattern string) {
+		re := regexp.MustCompile(pattern)
+		handlers = append(handlers, &handlerInfo{re: re, rc: newRegexpCache(re)})
+	}
+	for ii := 0; ii < count; ii++ {
+		add(fmt.Sprintf("^/section%d/$", ii))
+		add(fmt.Sprintf("^/section%d/(\\d+)/$", ii))
+		add(fmt.Sprintf("^/section%d/(?P<id>\\d+)/(?P<slug>[\\w\\-]+)/$", ii))
+		add(fmt.Sprintf("^/static%d/", ii))
+	}
+	add("^/$")
+	return handlers
+}
+
+var benchmarkPaths = []string{
+	"/",
+	"/section0/",
+	"/section50/42/",
+	"/section99/42/the-ultimate-answer/",
+	"/static75/css/style.css",
+	"/not-found/",
+}
+
+func benchmarkMatch(b *testing.B, match func([]*handlerInfo, string, string) (*handlerInfo, []int)) {
+	handlers := benchmarkHandlers(100)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for ii := 0; ii < b.N; ii++ {
+		for _, p := range benchmarkPaths {
+			match(handlers, p, "localhost")
+		}
+	}
+}
+
+func BenchmarkMatchLinear(b *testing.B) {
+	benchmarkMatch(b, linearMatch)
+}
+
+func BenchmarkMatchRouter(b *testing.B) {
+	var r *router
+	benchmarkMatch(b, func(handlers []*handlerInfo, path string, host string) (*handlerInfo, []int) {
+		if r == nil {
+			r = newRouter(handlers)
+		}
+		info, matches, _ := r.match(path, host, "GET")
+		return info, matches
+	})
+}
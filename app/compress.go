@@ -0,0 +1,421 @@
+package app
+
+import (
+	"bufio"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	defaultCompressMinSize = 1024
+)
+
+var (
+	defaultCompressEncodings = []string{"gzip", "deflate"}
+	defaultCompressTypes     = []string{
+		"text/html",
+		"text/css",
+		"text/plain",
+		"text/xml",
+		"text/javascript",
+		"application/javascript",
+		"application/json",
+		"application/xml",
+		"image/svg+xml",
+	}
+	errResponseFinished = errors.New("response has been already finished")
+	errNoHijacker       = errors.New("http.ResponseWriter does not implement http.Hijacker")
+
+	encodersMu sync.RWMutex
+	encoders   = map[string]EncoderFunc{
+		"gzip": func(w io.Writer, level int) (io.WriteCloser, error) {
+			return gzip.NewWriterLevel(w, level)
+		},
+		"deflate": func(w io.Writer, level int) (io.WriteCloser, error) {
+			return zlib.NewWriterLevel(w, level)
+		},
+	}
+)
+
+// EncoderFunc returns an io.WriteCloser which compresses the data written
+// to it using the given level and writes it to w. A level of -1 indicates
+// the default level for the encoding. If the returned value also has a
+// Flush() error method, it's called when the response is flushed.
+type EncoderFunc func(w io.Writer, level int) (io.WriteCloser, error)
+
+// RegisterEncoder registers an encoding which might be used by the
+// Compress Transformer. gzip and deflate are registered by default.
+// Use this function to add support for additional encodings, which
+// must also be included in CompressOptions.Encodings. e.g. using
+// github.com/andybalholm/brotli:
+//
+//  app.RegisterEncoder("br", func(w io.Writer, level int) (io.WriteCloser, error) {
+//	if level < 0 {
+//	    level = brotli.DefaultCompression
+//	}
+//	return brotli.NewWriterLevel(w, level), nil
+//  })
+//
+// Registering an encoding with the same name as a previous one replaces
+// it, while passing a nil EncoderFunc removes it.
+func RegisterEncoder(encoding string, f EncoderFunc) {
+	encodersMu.Lock()
+	defer encodersMu.Unlock()
+	if f == nil {
+		delete(encoders, encoding)
+	} else {
+		encoders[encoding] = f
+	}
+}
+
+func encoderFunc(encoding string) EncoderFunc {
+	encodersMu.RLock()
+	defer encodersMu.RUnlock()
+	return encoders[encoding]
+}
+
+// CompressOptions specify the options for the Compress Transformer.
+type CompressOptions struct {
+	// Level indicates the compression level passed to the encoder.
+	// If zero, the default level for each encoding is used.
+	Level int
+	// MinSize indicates the minimum size for a response to be
+	// compressed. Smaller responses are sent uncompressed. If zero,
+	// it defaults to 1024. Use a negative value to compress
+	// responses regardless of their size.
+	MinSize int
+	// ContentTypes lists the media types which are compressed. An
+	// entry ending with /* (e.g. text/*) matches all the subtypes.
+	// If empty, HTML, CSS, JavaScript, JSON, XML, SVG and plain text
+	// are compressed.
+	ContentTypes []string
+	// Encodings lists the encodings which might be used, in order
+	// of preference. If empty, it defaults to gzip and deflate.
+	// Note that encodings which haven't been registered are ignored,
+	// so additional encodings (e.g. br) must be registered with
+	// RegisterEncoder and listed here.
+	Encodings []string
+}
+
+type compressor struct {
+	level     int
+	minSize   int
+	types     map[string]bool
+	prefixes  []string
+	encodings []string
+}
+
+func newCompressor(opts *CompressOptions) *compressor {
+	var o CompressOptions
+	if opts != nil {
+		o = *opts
+	}
+	c := &compressor{level: o.Level, minSize: o.MinSize, types: make(map[string]bool), encodings: o.Encodings}
+	if c.level == 0 {
+		c.level = -1
+	}
+	if c.minSize == 0 {
+		c.minSize = defaultCompressMinSize
+	}
+	types := o.ContentTypes
+	if len(types) == 0 {
+		types = defaultCompressTypes
+	}
+	for _, v := range types {
+		v = strings.ToLower(v)
+		if strings.HasSuffix(v, "/*") {
+			c.prefixes = append(c.prefixes, v[:len(v)-1])
+		} else {
+			c.types[v] = true
+		}
+	}
+	if len(c.encodings) == 0 {
+		c.encodings = defaultCompressEncodings
+	}
+	return c
+}
+
+// negotiate returns the preferred encoding accepted by the client
+// which sent the given Accept-Encoding header, or an empty string if
+// none of them is acceptable.
+func (c *compressor) negotiate(accept string) string {
+	if accept == "" {
+		return ""
+	}
+	accepted := make(map[string]float64)
+	for _, v := range strings.Split(accept, ",") {
+		name := v
+		q := 1.0
+		if sep := strings.IndexByte(v, ';'); sep >= 0 {
+			name = v[:sep]
+			param := strings.TrimSpace(v[sep+1:])
+			if strings.HasPrefix(param, "q=") {
+				if val, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = val
+				}
+			}
+		}
+		accepted[strings.ToLower(strings.TrimSpace(name))] = q
+	}
+	for _, v := range c.encodings {
+		q, ok := accepted[v]
+		if !ok {
+			q, ok = accepted["*"]
+		}
+		if ok && q > 0 && encoderFunc(v) != nil {
+			return v
+		}
+	}
+	return ""
+}
+
+func (c *compressor) compressible(contentType string) bool {
+	if sep := strings.IndexByte(contentType, ';'); sep >= 0 {
+		contentType = contentType[:sep]
+	}
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+	if c.types[contentType] {
+		return true
+	}
+	for _, v := range c.prefixes {
+		if strings.HasPrefix(contentType, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// compressWriter is the http.ResponseWriter used by Compress. Data is
+// buffered until it reaches the minimum size for compression, the
+// response is flushed or it's finished. Then, the headers are written
+// and the writer decides if the response will be compressed.
+type compressWriter struct {
+	http.ResponseWriter
+	c        *compressor
+	encoding string
+	code     int
+	buf      []byte
+	decided  bool
+	finished bool
+	out      io.Writer
+	enc      io.WriteCloser
+	capture  io.Writer
+	cw       *captureWriter
+}
+
+// captureWriter writes to w and, if capture is non-nil, also
+// to capture. See compressWriter.Capture.
+type captureWriter struct {
+	w       io.Writer
+	capture io.Writer
+}
+
+func (c *captureWriter) Write(data []byte) (int, error) {
+	n, err := c.w.Write(data)
+	if n > 0 && c.capture != nil {
+		c.capture.Write(data[:n])
+	}
+	return n, err
+}
+
+func (w *compressWriter) shouldCompress(streaming bool) bool {
+	if w.code < http.StatusOK || w.code == http.StatusNoContent || w.code == http.StatusNotModified {
+		return false
+	}
+	if !streaming && len(w.buf) < w.c.minSize {
+		return false
+	}
+	header := w.Header()
+	if header.Get("Content-Encoding") != "" {
+		return false
+	}
+	return w.c.compressible(header.Get("Content-Type"))
+}
+
+func (w *compressWriter) decide(streaming bool) error {
+	w.decided = true
+	if w.code == 0 {
+		w.code = http.StatusOK
+	}
+	header := w.Header()
+	if _, ok := header["Content-Type"]; !ok && len(w.buf) > 0 {
+		// Sniff the type now, otherwise net/http would
+		// sniff it from the compressed data.
+		header.Set("Content-Type", http.DetectContentType(w.buf))
+	}
+	w.cw = &captureWriter{w: w.ResponseWriter, capture: w.capture}
+	w.out = w.cw
+	if w.shouldCompress(streaming) {
+		enc, err := encoderFunc(w.encoding)(w.out, w.c.level)
+		if err != nil {
+			return err
+		}
+		w.enc = enc
+		header.Del("Content-Length")
+		header.Set("Content-Encoding", w.encoding)
+	}
+	w.ResponseWriter.WriteHeader(w.code)
+	buf := w.buf
+	w.buf = nil
+	if len(buf) > 0 {
+		if _, err := w.write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *compressWriter) write(data []byte) (int, error) {
+	if w.enc != nil {
+		return w.enc.Write(data)
+	}
+	return w.out.Write(data)
+}
+
+func (w *compressWriter) WriteHeader(code int) {
+	if !w.decided && w.code == 0 {
+		w.code = code
+	}
+}
+
+func (w *compressWriter) Write(data []byte) (int, error) {
+	if w.finished {
+		return 0, errResponseFinished
+	}
+	if !w.decided {
+		w.buf = append(w.buf, data...)
+		if len(w.buf) < w.c.minSize {
+			return len(data), nil
+		}
+		if err := w.decide(false); err != nil {
+			return 0, err
+		}
+		return len(data), nil
+	}
+	return w.write(data)
+}
+
+// Flush implements http.Flusher. If the response is being compressed,
+// the data written so far is compressed and sent to the client. Flushed
+// responses are never cached, so it also stops capturing the data.
+func (w *compressWriter) Flush() {
+	if w.finished {
+		return
+	}
+	w.Capture(nil)
+	if !w.decided {
+		if err := w.decide(true); err != nil {
+			return
+		}
+	}
+	if f, ok := w.enc.(interface {
+		Flush() error
+	}); ok {
+		f.Flush()
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker, by calling the Hijack
+// method in the underlying http.ResponseWriter.
+func (w *compressWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if h, ok := w.ResponseWriter.(http.Hijacker); ok {
+		w.finished = true
+		return h.Hijack()
+	}
+	return nil, nil, errNoHijacker
+}
+
+// Encoding implements internal.CompressWriter
+func (w *compressWriter) Encoding() string {
+	return w.encoding
+}
+
+// Capture implements internal.CompressWriter
+func (w *compressWriter) Capture(c io.Writer) {
+	w.capture = c
+	if w.cw != nil {
+		w.cw.capture = c
+	}
+}
+
+// Finish implements internal.CompressWriter
+func (w *compressWriter) Finish() error {
+	if w.finished {
+		return nil
+	}
+	if !w.decided {
+		if err := w.decide(false); err != nil {
+			return err
+		}
+	}
+	w.finished = true
+	if w.enc != nil {
+		return w.enc.Close()
+	}
+	return nil
+}
+
+// Compress returns a Transformer which compresses the responses using
+// the best encoding supported by both the server and the client, as
+// indicated by the Accept-Encoding header. Only responses with a
+// compressible Content-Type and a size of at least opts.MinSize are
+// compressed. Responses which already have a Content-Encoding are not
+// altered. opts might be nil, which uses the default options.
+//
+// Data written to the Context is buffered until it reaches the minimum
+// size for compression. Streamed responses (e.g. event streams) are
+// compressed as they're flushed, but only if their content type is
+// compressible. Requests for upgrading the connection (e.g. websockets)
+// and HEAD requests are served without compression.
+//
+// The Compress Transformer might be added to the whole App or to some
+// handlers. When using it in conjunction with gnd.la/cache/layer, the
+// Transformer must wrap the Layer (e.g. by adding it to the App), so
+// the Layer stores the compressed responses.
+//
+//  myapp.AddTransformer(app.Compress(&app.CompressOptions{MinSize: 512}))
+func Compress(opts *CompressOptions) Transformer {
+	c := newCompressor(opts)
+	return func(handler Handler) Handler {
+		return func(ctx *Context) {
+			if ctx.R == nil || ctx.R.Method == "HEAD" || ctx.R.Header.Get("Upgrade") != "" {
+				handler(ctx)
+				return
+			}
+			ctx.Header().Add("Vary", "Accept-Encoding")
+			encoding := c.negotiate(ctx.R.Header.Get("Accept-Encoding"))
+			if encoding == "" {
+				handler(ctx)
+				return
+			}
+			rw := ctx.ResponseWriter
+			w := &compressWriter{ResponseWriter: rw, c: c, encoding: encoding}
+			ctx.ResponseWriter = w
+			done := false
+			defer func() {
+				if !done {
+					// The handler panicked. Let the App
+					// write the error using the original
+					// http.ResponseWriter.
+					ctx.ResponseWriter = rw
+				}
+			}()
+			handler(ctx)
+			ctx.ResponseWriter = rw
+			done = true
+			if err := w.Finish(); err != nil {
+				ctx.Logger().Errorf("error compressing response: %s", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package app
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCompressNegotiate(t *testing.T) {
+	c := newCompressor(nil)
+	tests := []struct {
+		accept   string
+		encoding string
+	}{
+		{"", ""},
+		{"identity", ""},
+		{"gzip", "gzip"},
+		{"deflate, gzip", "gzip"},
+		{"gzip;q=0, deflate", "deflate"},
+		{"*", "gzip"},
+		{"*, gzip;q=0", "deflate"},
+		// br is neither registered nor used by default
+		{"br", ""},
+	}
+	for _, v := range tests {
+		if enc := c.negotiate(v.accept); enc != v.encoding {
+			t.Errorf("expecting encoding %q for Accept-Encoding %q, got %q", v.encoding, v.accept, enc)
+		}
+	}
+}
+
+func TestCompress(t *testing.T) {
+	large := strings.Repeat("<p>Hello world</p>", 100)
+	a := New()
+	a.AddTransformer(Compress(nil))
+	a.Handle("^/large/$", func(ctx *Context) {
+		ctx.SetHeader("Content-Type", "text/html; charset=utf-8")
+		ctx.SetHeader("Content-Length", "1800")
+		ctx.WriteString(large[:len(large)/2])
+		ctx.WriteString(large[len(large)/2:])
+	})
+	a.Handle("^/small/$", func(ctx *Context) {
+		ctx.WriteString("<p>Hello</p>")
+	})
+	a.Handle("^/image/$", func(ctx *Context) {
+		ctx.SetHeader("Content-Type", "image/png")
+		ctx.WriteString(large)
+	})
+	a.Handle("^/encoded/$", func(ctx *Context) {
+		ctx.SetHeader("Content-Encoding", "identity")
+		ctx.WriteString(large)
+	})
+	a.Handle("^/stream/$", func(ctx *Context) {
+		ctx.SetHeader("Content-Type", "text/plain")
+		ctx.WriteString("first")
+		ctx.ResponseWriter.(http.Flusher).Flush()
+		ctx.WriteString("second")
+	})
+	var captured bytes.Buffer
+	var capturedBeforeFlush int
+	a.Handle("^/capture/$", func(ctx *Context) {
+		ctx.ResponseWriter.(*compressWriter).Capture(&captured)
+		ctx.SetHeader("Content-Type", "text/plain")
+		ctx.WriteString(large)
+		capturedBeforeFlush = captured.Len()
+		ctx.ResponseWriter.(http.Flusher).Flush()
+		ctx.WriteString(large)
+	})
+	if err := a.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	serve := func(method string, path string, header map[string]string) *httptest.ResponseRecorder {
+		r, err := http.NewRequest(method, "http://localhost"+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Header.Set("Accept-Encoding", "gzip, deflate")
+		for k, v := range header {
+			r.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, r)
+		return w
+	}
+	gunzip := func(w *httptest.ResponseRecorder) string {
+		if enc := w.Header().Get("Content-Encoding"); enc != "gzip" {
+			t.Errorf("expecting gzip encoding, got %q", enc)
+			return ""
+		}
+		r, err := gzip.NewReader(w.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	w := serve("GET", "/large/", nil)
+	if body := gunzip(w); body != large {
+		t.Errorf("unexpected uncompressed body %q", body)
+	}
+	if cl := w.Header().Get("Content-Length"); cl != "" {
+		t.Errorf("Content-Length %q was not removed", cl)
+	}
+	if vary := w.Header().Get("Vary"); vary != "Accept-Encoding" {
+		t.Errorf("expecting Vary: Accept-Encoding, got %q", vary)
+	}
+	w = serve("GET", "/stream/", nil)
+	if body := gunzip(w); body != "firstsecond" {
+		t.Errorf("unexpected streamed body %q", body)
+	}
+	w = serve("GET", "/capture/", nil)
+	if body := gunzip(w); body != large+large {
+		t.Errorf("unexpected captured body %q", body)
+	}
+	if capturedBeforeFlush == 0 || captured.Len() != capturedBeforeFlush {
+		t.Errorf("expecting capture to stop after flushing with %d bytes, got %d", capturedBeforeFlush, captured.Len())
+	}
+	uncompressed := []struct {
+		method string
+		path   string
+		header map[string]string
+	}{
+		{"GET", "/small/", nil},
+		{"GET", "/image/", nil},
+		{"GET", "/encoded/", nil},
+		{"GET", "/large/", map[string]string{"Accept-Encoding": "br"}},
+		{"GET", "/large/", map[string]string{"Upgrade": "websocket"}},
+		{"HEAD", "/large/", nil},
+	}
+	for _, v := range uncompressed {
+		w := serve(v.method, v.path, v.header)
+		if enc := w.Header().Get("Content-Encoding"); enc != "" && enc != "identity" {
+			t.Errorf("%s %s with headers %v should not be compressed, got encoding %q", v.method, v.path, v.header, enc)
+		}
+		if v.method == "GET" && !bytes.Contains(w.Body.Bytes(), []byte("Hello")) {
+			t.Errorf("%s %s with headers %v returned unexpected body %q", v.method, v.path, v.header, w.Body.String())
+		}
+	}
+	if ct := serve("GET", "/small/", nil).Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/html") {
+		t.Errorf("expecting sniffed text/html Content-Type, got %q", ct)
+	}
+}
@@ -0,0 +1,148 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"gnd.la/internal/httpserve"
+)
+
+// SetETag sets the ETag header for the response. If etag is not
+// quoted, quotes are added to it. Note that the conditional headers
+// sent by the client are only evaluated by handlers wrapped with
+// ConditionalGet.
+func (c *Context) SetETag(etag string) {
+	if !strings.HasPrefix(etag, "\"") && !strings.HasPrefix(etag, "W/\"") {
+		etag = "\"" + etag + "\""
+	}
+	c.Header().Set("ETag", etag)
+}
+
+// SetLastModified sets the Last-Modified header for the response. If
+// t is zero, the header is removed. See ConditionalGet.
+func (c *Context) SetLastModified(t time.Time) {
+	if t.IsZero() {
+		c.Header().Del("Last-Modified")
+		return
+	}
+	c.Header().Set("Last-Modified", t.UTC().Format(http.TimeFormat))
+}
+
+// conditionalWriter buffers the response, so ConditionalGet
+// can compute its ETag. When the response is flushed, the
+// buffered data is sent and buffering stops.
+type conditionalWriter struct {
+	http.ResponseWriter
+	code      int
+	buf       bytes.Buffer
+	streaming bool
+}
+
+func (w *conditionalWriter) WriteHeader(code int) {
+	if w.streaming {
+		w.ResponseWriter.WriteHeader(code)
+		return
+	}
+	if w.code == 0 {
+		w.code = code
+	}
+}
+
+func (w *conditionalWriter) Write(data []byte) (int, error) {
+	if w.streaming {
+		return w.ResponseWriter.Write(data)
+	}
+	if w.code == 0 {
+		w.code = http.StatusOK
+	}
+	return w.buf.Write(data)
+}
+
+// Flush implements http.Flusher. Flushed responses
+// are sent without an ETag.
+func (w *conditionalWriter) Flush() {
+	if !w.streaming {
+		w.streaming = true
+		if w.code != 0 {
+			w.ResponseWriter.WriteHeader(w.code)
+		}
+		if w.buf.Len() > 0 {
+			w.ResponseWriter.Write(w.buf.Bytes())
+			w.buf.Reset()
+		}
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker, by calling the Hijack
+// method in the underlying http.ResponseWriter.
+func (w *conditionalWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if h, ok := w.ResponseWriter.(http.Hijacker); ok {
+		w.streaming = true
+		return h.Hijack()
+	}
+	return nil, nil, errNoHijacker
+}
+
+// ConditionalGet is a Transformer which adds support for conditional
+// GET and HEAD requests. The response is buffered and, if the handler
+// didn't set an ETag (see Context.SetETag), a weak one is computed from
+// the response body. Then, if the If-None-Match or If-Modified-Since
+// headers sent by the client match the ETag or the Last-Modified header
+// (see Context.SetLastModified), a 304 (Not Modified) is sent without
+// a body. Only responses with a 200 status code are considered.
+// Responses which are flushed while being written (e.g. event streams)
+// are sent as they're written, without an ETag.
+//
+//  myapp.AddTransformer(app.ConditionalGet)
+//
+// Note that when using it with Compress, ConditionalGet must be added
+// after it, so the ETag is computed from the uncompressed data.
+func ConditionalGet(handler Handler) Handler {
+	return func(ctx *Context) {
+		if ctx.R == nil || (ctx.R.Method != "GET" && ctx.R.Method != "HEAD") || ctx.R.Header.Get("Upgrade") != "" {
+			handler(ctx)
+			return
+		}
+		rw := ctx.ResponseWriter
+		w := &conditionalWriter{ResponseWriter: rw}
+		ctx.ResponseWriter = w
+		done := false
+		defer func() {
+			if !done {
+				// See Compress
+				ctx.ResponseWriter = rw
+			}
+		}()
+		handler(ctx)
+		ctx.ResponseWriter = rw
+		done = true
+		if w.streaming || w.code == 0 {
+			return
+		}
+		if w.code == http.StatusOK {
+			header := rw.Header()
+			etag := header.Get("ETag")
+			if etag == "" {
+				etag = httpserve.WeakETag(w.buf.Bytes())
+				header.Set("ETag", etag)
+			}
+			modified, _ := http.ParseTime(header.Get("Last-Modified"))
+			if httpserve.NotModified(ctx.R, etag, modified) {
+				ctx.statusCode = http.StatusNotModified
+				httpserve.WriteNotModified(rw)
+				return
+			}
+		}
+		rw.WriteHeader(w.code)
+		if w.buf.Len() > 0 {
+			rw.Write(w.buf.Bytes())
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestConditionalGet(t *testing.T) {
+	modified := time.Date(2015, 3, 1, 12, 0, 0, 0, time.UTC)
+	a := New()
+	a.AddTransformer(ConditionalGet)
+	a.Handle("^/auto/$", func(ctx *Context) {
+		ctx.WriteString("hello")
+	})
+	a.Handle("^/etag/$", func(ctx *Context) {
+		ctx.SetETag("v1")
+		ctx.WriteString("hello")
+	})
+	a.Handle("^/modified/$", func(ctx *Context) {
+		ctx.SetLastModified(modified)
+		ctx.SetETag("v2")
+		ctx.WriteString("hello")
+	})
+	if err := a.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	serve := func(method string, path string, header map[string]string) *httptest.ResponseRecorder {
+		r, err := http.NewRequest(method, "http://localhost"+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for k, v := range header {
+			r.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, r)
+		return w
+	}
+	w := serve("GET", "/auto/", nil)
+	etag := w.Header().Get("ETag")
+	if w.Code != http.StatusOK || w.Body.String() != "hello" {
+		t.Errorf("unexpected response %d %q", w.Code, w.Body.String())
+	}
+	if len(etag) < 2 || etag[:2] != "W/" {
+		t.Errorf("expecting a weak ETag, got %q", etag)
+	}
+	if e := serve("GET", "/etag/", nil).Header().Get("ETag"); e != `"v1"` {
+		t.Errorf("expecting ETag \"v1\", got %q", e)
+	}
+	tests := []struct {
+		method string
+		path   string
+		header map[string]string
+		code   int
+	}{
+		{"GET", "/auto/", map[string]string{"If-None-Match": etag}, http.StatusNotModified},
+		{"HEAD", "/auto/", map[string]string{"If-None-Match": etag}, http.StatusNotModified},
+		{"POST", "/auto/", map[string]string{"If-None-Match": etag}, http.StatusOK},
+		{"GET", "/auto/", map[string]string{"If-None-Match": `W/"foo", ` + etag}, http.StatusNotModified},
+		{"GET", "/auto/", map[string]string{"If-None-Match": "*"}, http.StatusNotModified},
+		{"GET", "/etag/", map[string]string{"If-None-Match": `W/"v1"`}, http.StatusNotModified},
+		{"GET", "/etag/", map[string]string{"If-None-Match": `"v2"`}, http.StatusOK},
+		{"GET", "/modified/", map[string]string{"If-Modified-Since": modified.Format(http.TimeFormat)}, http.StatusNotModified},
+		{"GET", "/modified/", map[string]string{"If-Modified-Since": modified.Add(time.Hour).Format(http.TimeFormat)}, http.StatusNotModified},
+		{"GET", "/modified/", map[string]string{"If-Modified-Since": modified.Add(-time.Hour).Format(http.TimeFormat)}, http.StatusOK},
+		// If-None-Match takes precedence
+		{"GET", "/modified/", map[string]string{"If-None-Match": `"v1"`, "If-Modified-Since": modified.Format(http.TimeFormat)}, http.StatusOK},
+		{"GET", "/missing/", map[string]string{"If-None-Match": "*"}, http.StatusNotFound},
+	}
+	for _, v := range tests {
+		w := serve(v.method, v.path, v.header)
+		if w.Code != v.code {
+			t.Errorf("%s %s with headers %v returned %d, expecting %d", v.method, v.path, v.header, w.Code, v.code)
+		}
+		if w.Code == http.StatusNotModified {
+			if w.Body.Len() != 0 {
+				t.Errorf("304 response for %s %s has body %q", v.method, v.path, w.Body.String())
+			}
+			if ct := w.Header().Get("Content-Type"); ct != "" {
+				t.Errorf("304 response for %s %s has Content-Type %q", v.method, v.path, ct)
+			}
+		}
+	}
+}
@@ -19,8 +19,31 @@ type Config struct {
 	// translating strings when there's no LanguageHandler
 	// or when it returns an empty string.
 	Language string `help:"Set the default language for translating strings"`
-	// Port indicates the port to listen on.
-	Port      int         `default:"8888" help:"Port to listen on"`
+	// Port indicates the port to listen on. When TLS is enabled,
+	// this is the port for HTTPS.
+	Port int `default:"8888" help:"Port to listen on"`
+	// TLSCertificate is the path to the PEM encoded certificate used to
+	// serve TLS. If TLSKey is empty, the key is assumed to be in the
+	// same file.
+	TLSCertificate string `help:"Certificate file for serving TLS"`
+	// TLSKey is the path to the PEM encoded private key for TLSCertificate.
+	TLSKey string `help:"Key file for the TLS certificate"`
+	// TLSCertificatesDir is a directory which contains several certificates,
+	// with the extension .crt or .pem, and their keys, with the same name
+	// but using the .key extension. The certificate sent to each client is
+	// selected using the server name indicated by the client (SNI).
+	TLSCertificatesDir string `help:"Directory with certificates for serving TLS, selected by SNI"`
+	// HTTPPort, when serving TLS, indicates an additional port which
+	// accepts plain HTTP requests and redirects them to HTTPS.
+	HTTPPort int `help:"Port for plain HTTP requests, which are redirected to HTTPS"`
+	// HSTSMaxAge, when non-zero, makes the App send a Strict-Transport-Security
+	// header with the given max-age in responses sent over TLS.
+	HSTSMaxAge int `help:"Max age in seconds for the Strict-Transport-Security header"`
+	// HSTSIncludeSubdomains adds includeSubDomains to the HSTS header.
+	HSTSIncludeSubdomains bool `help:"Include subdomains in the Strict-Transport-Security header"`
+	// HSTSPreload adds preload to the HSTS header.
+	HSTSPreload bool `help:"Add preload to the Strict-Transport-Security header"`
+
 	Database  *config.URL `help:"Default database to use, used by Context.Orm()"`
 	Cache     *config.URL `help:"Default cache, returned by Context.Cache()"`
 	Blobstore *config.URL `help:"Default blobstore, returned by Context.Blobstore()"`
@@ -33,11 +56,46 @@ type Config struct {
 	// app for, among other things, encrypted cookies. It should
 	// be a random string of 16 or 24 or 32 characters.
 	EncryptionKey string `help:"Key used for encryption (e.g. encrypted cookies)"`
+	// ShutdownTimeout indicates the maximum number of seconds
+	// to wait for active requests and background contexts when
+	// the App is shutting down. If <= 0, the App waits until
+	// all of them finish.
+	ShutdownTimeout int `default:"30" help:"Seconds to wait for active requests to finish when shutting down"`
+	// HealthCheckTimeout is the maximum number of seconds each
+	// health check might take before considering it failed. See
+	// ReadinessHandler. If <= 0, health checks don't time out.
+	HealthCheckTimeout int `default:"5" help:"Seconds to wait for each health check"`
+	// MetricsPath, when non-empty, makes the App record metrics for
+	// each named handler and serve them at the given path, using the
+	// Prometheus text format.
+	MetricsPath string `help:"Path for serving handler metrics in Prometheus format, empty disables metrics"`
+	// MetricsSecret, when non-empty, requires HTTP Basic authentication
+	// using this value as the password to access MetricsPath. The user
+	// name is ignored.
+	MetricsSecret string `help:"Password required to access the metrics, using HTTP Basic authentication"`
+	// SessionStore indicates where the sessions returned by Context.Session
+	// are stored. Valid values are "cookie" (the whole session is stored in
+	// an encrypted cookie, which requires an EncryptionKey), "cache" (the
+	// App cache) and "orm" (the table gondola_sessions in the App ORM).
+	SessionStore string `default:"cookie" help:"Where to store sessions: cookie, cache or orm"`
+	// SessionIdleTimeout is the number of seconds after which a
+	// session expires if it's not used. If <= 0, sessions don't
+	// expire because of inactivity.
+	SessionIdleTimeout int `default:"1800" help:"Seconds of inactivity after which a session expires"`
+	// SessionMaxAge is the maximum number of seconds a session might be
+	// used since its creation, regardless of its activity. If <= 0,
+	// sessions don't have an absolute expiration.
+	SessionMaxAge int `default:"604800" help:"Seconds after which a session expires, regardless of its activity"`
 }
 
 var (
 	defaultConfig = Config{
-		Port: 8888,
+		Port:               8888,
+		ShutdownTimeout:    30,
+		HealthCheckTimeout: 5,
+		SessionStore:       "cookie",
+		SessionIdleTimeout: 1800,
+		SessionMaxAge:      604800,
 	}
 )
 
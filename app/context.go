@@ -3,6 +3,7 @@ package app
 import (
 	"compress/gzip"
 	"compress/zlib"
+	"context"
 	"encoding/json"
 	"io"
 	"net"
@@ -42,15 +43,27 @@ type ContextFinalizer func(*Context)
 type Context struct {
 	http.ResponseWriter
 	R               *http.Request
+	stdCtx          context.Context
 	bodyReader      io.Reader
 	provider        ContextProvider
 	reProvider      *regexpProvider
+	params          map[string]interface{}
+	routePath       string
+	stream          *EventStream
+	session         *Session
+	flashes         []*FlashMessage
+	flashesLoaded   bool
+	cspNonce        string
+	metrics         *handlerMetrics
+	written         int64
 	handlerName     string
 	app             *App
 	statusCode      int
 	started         time.Time
 	cookies         *cookies.Cookies
 	user            User
+	auth            *Authentication
+	authenticated   bool
 	translations    *table.Table
 	hasTranslations bool
 	background      bool
@@ -61,11 +74,22 @@ type Context struct {
 func (c *Context) reset() {
 	c.ResponseWriter = nil
 	c.R = nil
+	c.stdCtx = nil
 	c.bodyReader = nil
+	c.params = nil
+	c.stream = nil
+	c.session = nil
+	c.flashes = nil
+	c.flashesLoaded = false
+	c.cspNonce = ""
+	c.metrics = nil
+	c.written = 0
 	c.statusCode = 0
 	c.started = time.Now()
 	c.cookies = nil
 	c.user = nil
+	c.auth = nil
+	c.authenticated = false
 	c.translations = nil
 	c.hasTranslations = false
 	c.kv.Clear()
@@ -122,6 +146,16 @@ func (c *Context) ParamValue(name string) string {
 	return val
 }
 
+// TypedParamValue returns the converted value for the parameter
+// with the given name, when the handler was registered using a typed
+// pattern (e.g. /users/{id:int}/). The returned value has the Go type
+// corresponding to the parameter type (e.g. int for {id:int}). If there's
+// no such parameter, nil is returned. See App.Handle for the available
+// parameter types.
+func (c *Context) TypedParamValue(name string) interface{} {
+	return c.params[name]
+}
+
 // ParseParamValue uses the named captured parameter
 // with the given name and tries to parse it into
 // the given argument. See Context.ParseFormValue
@@ -277,6 +311,39 @@ func (c *Context) App() *App {
 	return c.app
 }
 
+// Context returns the context.Context associated with this Context.
+// For Contexts originating from an HTTP request, it's derived from
+// the request context, so it's cancelled when the client disconnects
+// and it might have a deadline if the handler was registered with
+// a timeout (see TimeoutHandler). Contexts created by Go and
+// Contexts without a request use context.Background().
+//
+// The context.Context is automatically used by the Orm returned from
+// Context.Orm and by gnd.la/net/httpclient clients created from this
+// Context. It should also be passed to any other functions which
+// accept a context.Context, so cancellation stops all the work
+// associated with the request.
+func (c *Context) Context() context.Context {
+	if c.stdCtx != nil {
+		return c.stdCtx
+	}
+	if c.R != nil {
+		return c.R.Context()
+	}
+	return context.Background()
+}
+
+// SetContext sets the context.Context associated with this
+// Context. It's usually used to derive a new context.Context
+// from the current one. e.g.
+//
+//  stdCtx, cancel := context.WithTimeout(ctx.Context(), time.Second)
+//  defer cancel()
+//  ctx.SetContext(stdCtx)
+func (c *Context) SetContext(ctx context.Context) {
+	c.stdCtx = ctx
+}
+
 // Request returns the *http.Request associated with this
 // context. Note that users should access the Context.R
 // field directly, rather than using this method (it solely
@@ -301,8 +368,25 @@ func (c *Context) MustReverse(name string, args ...interface{}) string {
 // return a protocol-relative URL (e.g. //www.gondolaweb.com) while Context.Reverse
 // can return an absolute URL (e.g. http://www.gondolaweb.com) if the Context
 // has a Request associated with it.
+//
+// As with App.Reverse, the host parameters for handlers restricted to
+// a host pattern (e.g. {tenant}.example.com) must always be provided.
+// Use ReverseSameHost to reuse the host of the current request.
 func (c *Context) Reverse(name string, args ...interface{}) (string, error) {
-	r, err := c.app.Reverse(name, args...)
+	return c.reverse(name, args, nil)
+}
+
+// ReverseSameHost works like Reverse, but for handlers restricted to a
+// host pattern with parameters (e.g. {tenant}.example.com) it uses the
+// host of the current request, so only the path parameters must be
+// provided. If the current request host was not matched by the same
+// host pattern, an error is returned.
+func (c *Context) ReverseSameHost(name string, args ...interface{}) (string, error) {
+	return c.reverse(name, args, c.reProvider)
+}
+
+func (c *Context) reverse(name string, args []interface{}, cur *regexpProvider) (string, error) {
+	r, err := c.app.reverse(name, args, cur)
 	if err == nil && strings.HasPrefix(r, "//") {
 		if s := c.requestScheme(); s != "" {
 			r = s + ":" + r
@@ -369,7 +453,9 @@ func (c *Context) requestScheme() string {
 // URL return the absolute URL for the current request. Note that
 // if your app is running behind a proxy, you might need to properly
 // configure the App as well as the appropriate X-headers in your
-// proxy and your app (X-Forwarded-For, X-Scheme, etc...).
+// proxy and your app (X-Forwarded-For, X-Scheme, etc...). When the
+// App serves TLS itself, the scheme will be https without any
+// additional configuration.
 func (c *Context) URL() *url.URL {
 	if c.R != nil {
 		u := *c.R.URL
@@ -408,9 +494,15 @@ func (c *Context) Blobstore() *blobstore.Blobstore {
 }
 
 // Orm is a shorthand for ctx.App().Orm(), but panics in case
-// of error, rather than returning it.
+// of error, rather than returning it. The returned Orm uses the
+// Context's context.Context (see Context.Context), so any queries
+// are aborted when the request is cancelled or times out.
 func (c *Context) Orm() *orm.Orm {
-	return c.orm()
+	o := c.orm()
+	if stdCtx := c.Context(); stdCtx.Done() != nil {
+		return o.WithContext(stdCtx)
+	}
+	return o
 }
 
 // Execute loads the template with the given name using the
@@ -510,7 +602,11 @@ func (c *Context) IsXHR() bool {
 // It's automatically called by the App, so you
 // don't need to call it manually
 func (c *Context) Close() {
-	// currently a no-op
+	if c.stream != nil {
+		// The stream is closed when the Handler returns,
+		// this only matters if the Handler panicked.
+		c.stream.Close()
+	}
 }
 
 // BackgroundContext returns a copy of the given Context
@@ -521,6 +617,9 @@ func (c *Context) Close() {
 func (c *Context) backgroundContext() *Context {
 	ctx := c.app.NewContext(nil)
 	ctx.R = c.R
+	// Background contexts outlive the request, so
+	// they must not be cancelled when it finishes.
+	ctx.stdCtx = context.Background()
 	ctx.background = true
 	ctx.provider = c.provider
 	ctx.reProvider = c.reProvider
@@ -550,6 +649,7 @@ func (c *Context) finalize(wg *sync.WaitGroup) {
 		c.app.recoverErr(c, err)
 	}
 	c.app.CloseContext(c)
+	c.app.root().background.Done()
 }
 
 // Go spawns a new goroutine using a copy of the given Context
@@ -558,7 +658,9 @@ func (c *Context) finalize(wg *sync.WaitGroup) {
 // might outlast the Handler's lifetime). Additionaly, Go also
 // handles error recovering and profiling in the spawned
 // goroutine. The initial Context can also wait for all
-// background contexts to finish by calling Wait().
+// background contexts to finish by calling Wait(). Background
+// contexts are also waited for when the App is shut down (see
+// App.Shutdown).
 //
 // In the following example, the handler finishes and returns the
 // executed template while CrunchData is still potentially running.
@@ -575,6 +677,8 @@ func (c *Context) Go(f func(*Context)) {
 		c.wg = new(sync.WaitGroup)
 	}
 	c.wg.Add(1)
+	// Track it in the App too, so Shutdown can wait for it
+	c.app.root().background.Add(1)
 	bg := c.backgroundContext()
 	var id int
 	if profile.On {
@@ -653,6 +757,11 @@ func (c *Context) WriteHeader(code int) {
 		header := profileHeader(c)
 		c.Header().Set(profile.HeaderName, header)
 	}
+	if c.session != nil {
+		if err := c.session.writeCookie(); err != nil {
+			c.Logger().Errorf("error writing session cookie: %s", err)
+		}
+	}
 	c.ResponseWriter.WriteHeader(code)
 }
 
@@ -665,7 +774,9 @@ func (c *Context) Write(data []byte) (int, error) {
 		// code will be overriden if < 0
 		c.WriteHeader(http.StatusOK)
 	}
-	return c.ResponseWriter.Write(data)
+	n, err := c.ResponseWriter.Write(data)
+	c.written += int64(n)
+	return n, err
 }
 
 func urlHost(u string) string {
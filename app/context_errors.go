@@ -28,6 +28,7 @@ var (
 		http.StatusRequestedRangeNotSatisfiable: i18n.String("request range not satisfiable"),
 		http.StatusExpectationFailed:            i18n.String("expectation failed"),
 		http.StatusTeapot:                       i18n.String("i'm a teapot"),
+		http.StatusTooManyRequests:              i18n.String("too many requests"),
 
 		http.StatusInternalServerError:     i18n.String("internal server error"),
 		http.StatusNotImplemented:          i18n.String("status not implemented"),
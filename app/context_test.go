@@ -0,0 +1,56 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/app/tester"
+)
+
+func TestTimeoutHandler(t *testing.T) {
+	a := app.New()
+	a.Handle("^/slow/$", func(ctx *app.Context) {
+		select {
+		case <-ctx.Context().Done():
+			ctx.WriteString(ctx.Context().Err().Error())
+		case <-time.After(time.Second):
+			ctx.WriteString("not cancelled")
+		}
+	}, app.TimeoutHandler(10*time.Millisecond))
+	a.Handle("^/fast/$", func(ctx *app.Context) {
+		if _, ok := ctx.Context().Deadline(); ok {
+			ctx.WriteString("deadline")
+			return
+		}
+		ctx.WriteString("no deadline")
+	})
+	tt := tester.New(t, a)
+	tt.Get("/slow/", nil).Expect(200).Expect(context.DeadlineExceeded.Error())
+	tt.Get("/fast/", nil).Expect(200).Expect("no deadline")
+}
+
+func TestBackgroundContext(t *testing.T) {
+	a := app.New()
+	errs := make(chan error, 1)
+	a.Handle("^/$", func(ctx *app.Context) {
+		reqCtx := ctx.Context()
+		ctx.Go(func(bg *app.Context) {
+			// Wait for the request context to expire
+			<-reqCtx.Done()
+			errs <- bg.Context().Err()
+		})
+		ctx.WriteString("ok")
+	}, app.TimeoutHandler(10*time.Millisecond))
+	tt := tester.New(t, a)
+	tt.Get("/", nil).Expect(200).Expect("ok")
+	select {
+	case err := <-errs:
+		if err != nil {
+			t.Errorf("background context was cancelled: %s", err)
+		}
+	case <-time.After(time.Second):
+		t.Error("background context not finished")
+	}
+}
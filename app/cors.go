@@ -0,0 +1,215 @@
+package app
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+var (
+	defaultCORSMethods = []string{"GET", "HEAD", "POST"}
+	defaultCORSHeaders = []string{"Accept", "Accept-Language", "Content-Language", "Content-Type", "X-Requested-With"}
+)
+
+// CORSOptions specify the options for the CORS Transformer.
+type CORSOptions struct {
+	// AllowedOrigins lists the origins which are allowed to perform
+	// cross-origin requests. Each entry might be an exact origin
+	// (e.g. https://www.example.com), an origin with a wildcard
+	// subdomain (e.g. https://*.example.com, which matches any
+	// subdomain of example.com, but not example.com itself) or
+	// "*", which allows any origin.
+	AllowedOrigins []string
+	// AllowOriginFunc, if non-nil, is called for origins which
+	// don't match AllowedOrigins. The origin is allowed if it
+	// returns true.
+	AllowOriginFunc func(ctx *Context, origin string) bool
+	// AllowedMethods lists the methods the client might use in
+	// cross-origin requests. If empty, GET, HEAD and POST are allowed.
+	AllowedMethods []string
+	// AllowedHeaders lists the headers the client might send in
+	// cross-origin requests. If empty, Accept, Accept-Language,
+	// Content-Language, Content-Type and X-Requested-With are allowed.
+	// "*" allows any header.
+	AllowedHeaders []string
+	// ExposedHeaders lists the response headers, besides the simple
+	// ones, which the client is allowed to read.
+	ExposedHeaders []string
+	// AllowCredentials indicates if the requests might include
+	// credentials, like cookies or HTTP authentication. Note that
+	// when it's true, the origin is always sent back to the client,
+	// even if any origin is allowed.
+	AllowCredentials bool
+	// MaxAge indicates how many seconds the client might cache the
+	// results of a preflight request. If <= 0, no Access-Control-Max-Age
+	// header is sent.
+	MaxAge int
+}
+
+type corsPolicy struct {
+	opts      CORSOptions
+	anyOrigin bool
+	origins   map[string]bool
+	wildcards [][2]string
+	methods   []string
+	headers   map[string]bool
+	anyHeader bool
+}
+
+func newCORSPolicy(opts *CORSOptions) *corsPolicy {
+	p := &corsPolicy{origins: make(map[string]bool), headers: make(map[string]bool)}
+	if opts != nil {
+		p.opts = *opts
+	}
+	for _, v := range p.opts.AllowedOrigins {
+		v = strings.ToLower(v)
+		if v == "*" {
+			p.anyOrigin = true
+		} else if star := strings.IndexByte(v, '*'); star >= 0 {
+			p.wildcards = append(p.wildcards, [2]string{v[:star], v[star+1:]})
+		} else {
+			p.origins[v] = true
+		}
+	}
+	methods := p.opts.AllowedMethods
+	if len(methods) == 0 {
+		methods = defaultCORSMethods
+	}
+	for _, v := range methods {
+		p.methods = append(p.methods, strings.ToUpper(v))
+	}
+	headers := p.opts.AllowedHeaders
+	if len(headers) == 0 {
+		headers = defaultCORSHeaders
+	}
+	for _, v := range headers {
+		if v == "*" {
+			p.anyHeader = true
+		} else {
+			p.headers[http.CanonicalHeaderKey(v)] = true
+		}
+	}
+	return p
+}
+
+func (p *corsPolicy) originAllowed(ctx *Context, origin string) bool {
+	if p.anyOrigin {
+		return true
+	}
+	lower := strings.ToLower(origin)
+	if p.origins[lower] {
+		return true
+	}
+	for _, v := range p.wildcards {
+		if len(lower) > len(v[0])+len(v[1]) && strings.HasPrefix(lower, v[0]) && strings.HasSuffix(lower, v[1]) {
+			return true
+		}
+	}
+	return p.opts.AllowOriginFunc != nil && p.opts.AllowOriginFunc(ctx, origin)
+}
+
+func (p *corsPolicy) methodAllowed(method string) bool {
+	for _, v := range p.methods {
+		if v == method {
+			return true
+		}
+	}
+	return false
+}
+
+// requestHeaders returns the headers requested in a preflight
+// request, or false if any of them is not allowed.
+func (p *corsPolicy) requestHeaders(ctx *Context) ([]string, bool) {
+	var headers []string
+	for _, v := range strings.Split(ctx.GetHeader("Access-Control-Request-Headers"), ",") {
+		if v = strings.TrimSpace(v); v == "" {
+			continue
+		}
+		v = http.CanonicalHeaderKey(v)
+		if !p.anyHeader && !p.headers[v] {
+			return nil, false
+		}
+		headers = append(headers, v)
+	}
+	return headers, true
+}
+
+func (p *corsPolicy) setOrigin(h http.Header, origin string) {
+	if p.anyOrigin && !p.opts.AllowCredentials {
+		h.Set("Access-Control-Allow-Origin", "*")
+	} else {
+		h.Set("Access-Control-Allow-Origin", origin)
+	}
+	if p.opts.AllowCredentials {
+		h.Set("Access-Control-Allow-Credentials", "true")
+	}
+}
+
+func (p *corsPolicy) preflight(ctx *Context, origin string) {
+	h := ctx.Header()
+	h.Add("Vary", "Access-Control-Request-Method")
+	h.Add("Vary", "Access-Control-Request-Headers")
+	method := strings.ToUpper(ctx.GetHeader("Access-Control-Request-Method"))
+	headers, ok := p.requestHeaders(ctx)
+	if ok && p.methodAllowed(method) && p.originAllowed(ctx, origin) {
+		p.setOrigin(h, origin)
+		h.Set("Access-Control-Allow-Methods", strings.Join(p.methods, ", "))
+		if len(headers) > 0 {
+			h.Set("Access-Control-Allow-Headers", strings.Join(headers, ", "))
+		}
+		if p.opts.MaxAge > 0 {
+			h.Set("Access-Control-Max-Age", strconv.Itoa(p.opts.MaxAge))
+		}
+	}
+	h.Set("Content-Length", "0")
+	ctx.WriteHeader(http.StatusNoContent)
+}
+
+// CORS returns a Transformer which implements Cross-Origin Resource
+// Sharing using the given options. Preflight requests (OPTIONS requests
+// with an Access-Control-Request-Method header) are answered directly,
+// without running the wrapped Handler. Requests from allowed origins
+// receive the Access-Control-* headers, while requests from other
+// origins are served without them, so the browser will block them.
+// Every response includes Origin in the Vary header, so caches which
+// honor it, like the cache layer (gnd.la/cache/layer), store a
+// different response for each origin.
+// opts might be nil, but then no origins are allowed.
+//
+// Note that handlers restricted to some methods using MethodHandler
+// don't receive OPTIONS requests unless it's included in their methods.
+// Alternatively, the CORS Transformer might be added to the whole App.
+//
+//  cors := app.CORS(&app.CORSOptions{
+//	AllowedOrigins: []string{"https://*.example.com"},
+//	AllowedMethods: []string{"GET", "POST", "DELETE"},
+//	MaxAge:         3600,
+//  })
+//  myapp.Handle("^/api/items/$", cors(app.JSONHandler(ItemsHandler)))
+//  // Or, for all the handlers
+//  myapp.AddTransformer(cors)
+func CORS(opts *CORSOptions) Transformer {
+	p := newCORSPolicy(opts)
+	return func(handler Handler) Handler {
+		return func(ctx *Context) {
+			h := ctx.Header()
+			h.Add("Vary", "Origin")
+			origin := ctx.GetHeader("Origin")
+			if origin == "" {
+				handler(ctx)
+				return
+			}
+			if ctx.R.Method == "OPTIONS" && ctx.GetHeader("Access-Control-Request-Method") != "" {
+				p.preflight(ctx, origin)
+				return
+			}
+			if p.originAllowed(ctx, origin) {
+				p.setOrigin(h, origin)
+				if len(p.opts.ExposedHeaders) > 0 {
+					h.Set("Access-Control-Expose-Headers", strings.Join(p.opts.ExposedHeaders, ", "))
+				}
+			}
+			handler(ctx)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package app_test
+
+import (
+	"testing"
+
+	"gnd.la/app"
+	"gnd.la/app/tester"
+)
+
+func TestCORS(t *testing.T) {
+	a := app.New()
+	cors := app.CORS(&app.CORSOptions{
+		AllowedOrigins: []string{"https://www.example.com", "https://*.example.org"},
+		AllowOriginFunc: func(ctx *app.Context, origin string) bool {
+			return origin == "https://func.example.net"
+		},
+		AllowedMethods:   []string{"get", "post", "delete"},
+		ExposedHeaders:   []string{"X-Total"},
+		AllowCredentials: true,
+		MaxAge:           600,
+	})
+	a.Handle("^/api/$", cors(func(ctx *app.Context) {
+		ctx.WriteString("api")
+	}))
+	tt := tester.New(t, a)
+	tt.Get("/api/", nil).Expect("api").
+		ExpectHeader("Vary", "Origin").
+		ExpectHeader("Access-Control-Allow-Origin", "")
+	for _, origin := range []string{"https://www.example.com", "https://api.example.org", "https://func.example.net"} {
+		tt.Get("/api/", nil).AddHeader("Origin", origin).Expect("api").
+			ExpectHeader("Access-Control-Allow-Origin", origin).
+			ExpectHeader("Access-Control-Allow-Credentials", "true").
+			ExpectHeader("Access-Control-Expose-Headers", "X-Total")
+	}
+	for _, origin := range []string{"https://example.org", "http://api.example.org", "https://www.example.net"} {
+		tt.Get("/api/", nil).AddHeader("Origin", origin).Expect("api").
+			ExpectHeader("Access-Control-Allow-Origin", "")
+	}
+	tt.Request("OPTIONS", "/api/", nil).
+		AddHeader("Origin", "https://www.example.com").
+		AddHeader("Access-Control-Request-Method", "DELETE").
+		AddHeader("Access-Control-Request-Headers", "content-type").
+		Expect(204).Expect("").
+		ExpectHeader("Access-Control-Allow-Origin", "https://www.example.com").
+		ExpectHeader("Access-Control-Allow-Methods", "GET, POST, DELETE").
+		ExpectHeader("Access-Control-Allow-Headers", "Content-Type").
+		ExpectHeader("Access-Control-Max-Age", "600")
+	// Method not allowed
+	tt.Request("OPTIONS", "/api/", nil).
+		AddHeader("Origin", "https://www.example.com").
+		AddHeader("Access-Control-Request-Method", "PUT").
+		Expect(204).
+		ExpectHeader("Access-Control-Allow-Origin", "")
+	// Header not allowed
+	tt.Request("OPTIONS", "/api/", nil).
+		AddHeader("Origin", "https://www.example.com").
+		AddHeader("Access-Control-Request-Method", "POST").
+		AddHeader("Access-Control-Request-Headers", "X-Secret").
+		Expect(204).
+		ExpectHeader("Access-Control-Allow-Origin", "")
+}
+
+func TestCORSAnyOrigin(t *testing.T) {
+	a := app.New()
+	a.AddTransformer(app.CORS(&app.CORSOptions{AllowedOrigins: []string{"*"}, AllowedHeaders: []string{"*"}}))
+	a.Handle("^/$", func(ctx *app.Context) {
+		ctx.WriteString("ok")
+	}, app.MethodHandler("POST"))
+	tt := tester.New(t, a)
+	tt.Request("OPTIONS", "/", nil).
+		AddHeader("Origin", "https://any.example.com").
+		AddHeader("Access-Control-Request-Method", "POST").
+		AddHeader("Access-Control-Request-Headers", "X-Custom").
+		Expect(204).
+		ExpectHeader("Access-Control-Allow-Origin", "*").
+		ExpectHeader("Access-Control-Allow-Headers", "X-Custom")
+	tt.Post("/", nil).AddHeader("Origin", "https://any.example.com").Expect("ok").
+		ExpectHeader("Access-Control-Allow-Origin", "*")
+}
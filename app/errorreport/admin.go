@@ -0,0 +1,180 @@
+package errorreport
+
+import (
+	"bytes"
+	"html/template"
+	"net/http"
+	"net/url"
+	"time"
+
+	"gnd.la/app"
+)
+
+const (
+	// AdminPage is the path where the admin UI is mounted
+	// by Reporter.Attach.
+	AdminPage = "/_gondola_errors"
+	// AdminPermission is the permission required for accessing
+	// the admin UI when the App is not in debug mode. See
+	// app.HasPermission.
+	AdminPermission = "gondola.errors"
+)
+
+var (
+	adminTemplate = template.Must(template.New("admin").Funcs(template.FuncMap{
+		"time": formatTime,
+	}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Errors</title>
+<style>
+body { font-family: sans-serif; font-size: 14px; margin: 20px; }
+table { border-collapse: collapse; width: 100%; }
+th, td { text-align: left; padding: 4px 8px; border-bottom: 1px solid #ddd; vertical-align: top; }
+pre { background: #f6f6f6; padding: 8px; overflow: auto; }
+.count { text-align: right; }
+</style>
+</head>
+<body>
+{{ define "groups" }}
+<h1>Errors</h1>
+{{ if .Groups }}
+<table>
+<tr><th>Error</th><th>Location</th><th class="count">Count</th><th>First seen</th><th>Last seen</th></tr>
+{{ range .Groups }}
+<tr>
+<td><a href="{{ $.Base }}/{{ .Fingerprint }}/">{{ .Error }}</a><br><small>{{ .Type }}</small></td>
+<td>{{ .Location }}</td>
+<td class="count">{{ .Count }}</td>
+<td>{{ time .FirstSeen }}</td>
+<td>{{ time .LastSeen }}</td>
+</tr>
+{{ end }}
+</table>
+{{ else }}
+<p>No errors have been reported.</p>
+{{ end }}
+{{ end }}
+{{ define "group" }}
+<p><a href="{{ .Base }}/">&larr; All errors</a></p>
+<h1>{{ .Group.Error }}</h1>
+<table>
+<tr><th>Type</th><td>{{ .Group.Type }}</td></tr>
+<tr><th>Location</th><td>{{ .Group.Location }}</td></tr>
+<tr><th>Occurrences</th><td>{{ .Group.Count }}</td></tr>
+<tr><th>First seen</th><td>{{ time .Group.FirstSeen }}</td></tr>
+<tr><th>Last seen</th><td>{{ time .Group.LastSeen }}</td></tr>
+<tr><th>Last notified</th><td>{{ time .Group.LastNotified }}</td></tr>
+<tr><th>Fingerprint</th><td>{{ .Group.Fingerprint }}</td></tr>
+</table>
+<form method="post"><button type="submit" name="action" value="resolve">Resolve</button></form>
+{{ range .Occurrences }}
+<h2>{{ time .Time }} on {{ .Server }}</h2>
+<p>{{ .Error }}</p>
+{{ if .URL }}<p>{{ .Method }} {{ .URL }}{{ if .Handler }} ({{ .Handler }}){{ end }} from {{ .RemoteAddress }}{{ if .UserId }} - user {{ .UserId }}{{ end }}</p>{{ end }}
+<pre>{{ .Stack }}</pre>
+{{ if .Request }}<pre>{{ .Request }}</pre>{{ end }}
+{{ end }}
+{{ end }}
+{{ template "content" . }}
+</body>
+</html>`))
+	adminTemplates = map[string]*template.Template{
+		"groups": adminPageTemplate("groups"),
+		"group":  adminPageTemplate("group"),
+	}
+)
+
+func adminPageTemplate(name string) *template.Template {
+	t := template.Must(adminTemplate.Clone())
+	return template.Must(t.New("content").Parse(`{{ template "` + name + `" . }}`))
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Format("2006-01-02 15:04:05 MST")
+}
+
+// adminHandler returns a Handler which checks the permissions for
+// accessing the admin UI before calling handler.
+func (r *Reporter) adminHandler(handler app.Handler) app.Handler {
+	return func(ctx *app.Context) {
+		if !ctx.App().Config().Debug && !ctx.HasPermission(AdminPermission) {
+			ctx.Forbidden()
+			return
+		}
+		ctx.Header().Add("Cache-Control", "private")
+		handler(ctx)
+	}
+}
+
+func (r *Reporter) groupsHandler(ctx *app.Context) {
+	groups, err := r.opts.Store.Groups(ctx)
+	if err != nil {
+		panic(err)
+	}
+	executeAdminTemplate(ctx, "groups", map[string]interface{}{
+		"Base":   AdminPage,
+		"Groups": groups,
+	})
+}
+
+func (r *Reporter) groupHandler(ctx *app.Context) {
+	fingerprint := ctx.ParamValue("fingerprint")
+	store := r.opts.Store
+	if ctx.R.Method == "POST" {
+		if !sameOrigin(ctx.R) {
+			ctx.Forbidden()
+			return
+		}
+		if err := store.Delete(ctx, fingerprint); err != nil {
+			panic(err)
+		}
+		ctx.Redirect(AdminPage+"/", false)
+		return
+	}
+	g, err := store.Group(ctx, fingerprint)
+	if err != nil {
+		panic(err)
+	}
+	if g == nil {
+		ctx.NotFound()
+		return
+	}
+	occurrences, err := store.Occurrences(ctx, fingerprint)
+	if err != nil {
+		panic(err)
+	}
+	executeAdminTemplate(ctx, "group", map[string]interface{}{
+		"Base":        AdminPage,
+		"Group":       g,
+		"Occurrences": occurrences,
+	})
+}
+
+func executeAdminTemplate(ctx *app.Context, name string, data interface{}) {
+	var buf bytes.Buffer
+	if err := adminTemplates[name].ExecuteTemplate(&buf, "admin", data); err != nil {
+		panic(err)
+	}
+	ctx.SetHeader("Content-Type", "text/html; charset=utf-8")
+	ctx.Write(buf.Bytes())
+}
+
+// sameOrigin returns false if the request includes an Origin
+// or a Referer header pointing to a different host, to avoid
+// cross-site form submissions.
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		origin = r.Referer()
+	}
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && u.Host == r.Host
+}
@@ -0,0 +1,35 @@
+// Package errorreport implements error reporting for Gondola apps,
+// aggregating panics into groups and notifying about them without
+// flooding the recipients.
+//
+// Each reported error is fingerprinted using its type and the
+// topmost frames of the stack where it happened, so repeated
+// occurrences of the same bug are grouped together regardless of
+// their message (which might contain ids or other variable data).
+// Occurrences are stored, alongside the request which caused them,
+// in a Store (see NewMemoryStore, NewFileStore and NewOrmStore) and
+// notifications are sent to the configured Sinks (see MailSink and
+// WebhookSink) at most once per Options.NotifyInterval for each group.
+//
+// Use New to create a Reporter and then attach it to an app.App, which
+// adds it as a RecoverHandler and mounts a small admin UI at AdminPage.
+//
+//  reporter, err := errorreport.New(&errorreport.Options{
+//      Store: errorreport.NewOrmStore(),
+//      Sinks: []errorreport.Sink{errorreport.MailSink(), errorreport.WebhookSink("https://hooks.example.com/errors")},
+//  })
+//  if err != nil {
+//      panic(err)
+//  }
+//  reporter.Attach(myapp)
+//
+// The admin UI is available to any user with the AdminPermission
+// permission (see app.HasPermission), which administrators always
+// have. When the App is in debug mode, it's available to everyone,
+// like the monitor.
+//
+// Errors which implement app.Error (e.g. the ones raised by
+// app.Context.NotFound) are not reported, since they don't
+// indicate a bug. Errors outside of panics might also be reported
+// explicitly using Reporter.Report.
+package errorreport
@@ -0,0 +1,102 @@
+package errorreport
+
+import (
+	"reflect"
+	"sync"
+
+	"gnd.la/app"
+	"gnd.la/orm"
+)
+
+var (
+	groupType      = reflect.TypeOf(Group{})
+	occurrenceType = reflect.TypeOf(Occurrence{})
+	registerOrm    sync.Once
+)
+
+// NewOrmStore returns a Store which saves the errors using the
+// App ORM, in the tables gondola_error_groups and
+// gondola_error_occurrences. Since the ORM is obtained from the
+// *app.Context, errors reported without a context can't be
+// stored and return an error.
+//
+// Note that NewOrmStore must be called before the App ORM is
+// initialized, since it registers the models used by the Store.
+func NewOrmStore() Store {
+	registerOrm.Do(func() {
+		orm.Register(&Group{}, &orm.Options{Table: "gondola_error_groups"})
+		orm.Register(&Occurrence{}, &orm.Options{Table: "gondola_error_occurrences"})
+	})
+	return ormStore{}
+}
+
+type ormStore struct{}
+
+func (s ormStore) orm(ctx *app.Context) *orm.Orm {
+	if ctx == nil {
+		panic("can't use the ORM store without an *app.Context")
+	}
+	return ctx.Orm()
+}
+
+func (s ormStore) Group(ctx *app.Context, fingerprint string) (*Group, error) {
+	var g Group
+	ok, err := s.orm(ctx).One(orm.Eq("Fingerprint", fingerprint), &g)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return &g, nil
+}
+
+func (s ormStore) SaveGroup(ctx *app.Context, g *Group) error {
+	_, err := s.orm(ctx).Save(g)
+	return err
+}
+
+func (s ormStore) AddOccurrence(ctx *app.Context, o *Occurrence, max int) error {
+	o.Id = 0
+	db := s.orm(ctx)
+	if _, err := db.Insert(o); err != nil {
+		return err
+	}
+	// Find the oldest occurrence we're keeping and
+	// remove the ones before it.
+	var last Occurrence
+	table := db.TypeTable(occurrenceType)
+	q := db.Table(table).Filter(orm.Eq("Fingerprint", o.Fingerprint)).Sort("Id", orm.DESC).Offset(max - 1)
+	ok, err := q.One(&last)
+	if err != nil || !ok {
+		return err
+	}
+	_, err = db.DeleteFrom(table, orm.And(orm.Eq("Fingerprint", o.Fingerprint), orm.Lt("Id", last.Id)))
+	return err
+}
+
+func (s ormStore) Groups(ctx *app.Context) ([]*Group, error) {
+	o := s.orm(ctx)
+	var groups []*Group
+	if err := o.Table(o.TypeTable(groupType)).Sort("LastSeen", orm.DESC).All(&groups); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+func (s ormStore) Occurrences(ctx *app.Context, fingerprint string) ([]*Occurrence, error) {
+	o := s.orm(ctx)
+	var occurrences []*Occurrence
+	q := o.Table(o.TypeTable(occurrenceType)).Filter(orm.Eq("Fingerprint", fingerprint)).Sort("Id", orm.DESC)
+	if err := q.All(&occurrences); err != nil {
+		return nil, err
+	}
+	return occurrences, nil
+}
+
+func (s ormStore) Delete(ctx *app.Context, fingerprint string) error {
+	o := s.orm(ctx)
+	q := orm.Eq("Fingerprint", fingerprint)
+	if _, err := o.DeleteFrom(o.TypeTable(occurrenceType), q); err != nil {
+		return err
+	}
+	_, err := o.DeleteFrom(o.TypeTable(groupType), q)
+	return err
+}
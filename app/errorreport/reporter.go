@@ -0,0 +1,280 @@
+package errorreport
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http/httputil"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/internal/runtimeutil"
+	"gnd.la/util/stringutil"
+)
+
+const (
+	// DefaultFrames is the default number of stack
+	// frames used for fingerprinting errors.
+	DefaultFrames = 5
+	// DefaultNotifyInterval is the default minimum interval
+	// between notifications for the same error group.
+	DefaultNotifyInterval = time.Hour
+	// DefaultMaxOccurrences is the default number of
+	// occurrences stored for each error group.
+	DefaultMaxOccurrences = 20
+
+	stackFrames    = 32
+	maxRequestSize = 10000
+)
+
+var (
+	// sensitiveHeaders are removed from the stored requests
+	sensitiveHeaders = []string{"Authorization", "Cookie", app.APIKeyHeaderName}
+)
+
+// Options specify the options used when creating a Reporter. See
+// the documentation on each field for its default value.
+type Options struct {
+	// Store is used to persist the error groups and their occurrences.
+	// If nil, a memory Store is used. See NewMemoryStore.
+	Store Store
+	// Sinks receive the notifications about errors. If empty,
+	// errors are stored but no notifications are sent.
+	Sinks []Sink
+	// Frames is the number of stack frames used for fingerprinting
+	// errors. If zero, DefaultFrames is used.
+	Frames int
+	// NotifyInterval is the minimum interval between notifications
+	// for the same error group. If zero, DefaultNotifyInterval is used.
+	// A negative value sends a notification for every occurrence.
+	NotifyInterval time.Duration
+	// MaxOccurrences is the maximum number of occurrences stored
+	// for each group. Older occurrences are removed when this
+	// limit is exceeded. If zero, DefaultMaxOccurrences is used.
+	MaxOccurrences int
+}
+
+// Reporter aggregates errors into groups, storing them and sending
+// notifications to its Sinks. See the package documentation
+// for more information.
+type Reporter struct {
+	opts Options
+	// mu serializes the updates to the groups
+	mu sync.Mutex
+}
+
+// New returns a new Reporter with the given options, which
+// might be nil.
+func New(opts *Options) (*Reporter, error) {
+	r := &Reporter{}
+	if opts != nil {
+		r.opts = *opts
+	}
+	if r.opts.Store == nil {
+		r.opts.Store = NewMemoryStore()
+	}
+	if r.opts.Frames <= 0 {
+		r.opts.Frames = DefaultFrames
+	}
+	if r.opts.NotifyInterval == 0 {
+		r.opts.NotifyInterval = DefaultNotifyInterval
+	}
+	if r.opts.MaxOccurrences <= 0 {
+		r.opts.MaxOccurrences = DefaultMaxOccurrences
+	}
+	return r, nil
+}
+
+// Store returns the Store used by the Reporter.
+func (r *Reporter) Store() Store {
+	return r.opts.Store
+}
+
+// Attach adds the Reporter as a RecoverHandler to the given App and
+// mounts the admin UI at AdminPage.
+func (r *Reporter) Attach(a *app.App) {
+	a.AddRecoverHandler(r.RecoverHandler)
+	a.Handle("^"+AdminPage+"/$", r.adminHandler(r.groupsHandler))
+	a.Handle("^"+AdminPage+"/(?P<fingerprint>[0-9a-f]+)/$", r.adminHandler(r.groupHandler))
+}
+
+// RecoverHandler reports the panic represented by err, unless it
+// implements app.Error. It always returns err, so the App keeps
+// logging the error and sending the error page to the client.
+func (r *Reporter) RecoverHandler(ctx *app.Context, err interface{}) interface{} {
+	if _, ok := err.(app.Error); !ok {
+		if rerr := r.Report(ctx, err); rerr != nil {
+			ctx.Logger().Errorf("error reporting %v: %s", err, rerr)
+		}
+	}
+	return err
+}
+
+// Report records an occurrence of the given error, which is usually
+// the value recovered from a panic. When called outside of a panic,
+// the error is fingerprinted using the stack of the caller. The ctx
+// argument might be nil. If there's no notification pending for the
+// error group, the Sinks are notified in the background.
+func (r *Reporter) Report(ctx *app.Context, err interface{}) (rerr error) {
+	defer func() {
+		// Never let a broken Store or request
+		// cause another panic while recovering.
+		if e := recover(); e != nil {
+			rerr = fmt.Errorf("panic reporting error: %v", e)
+		}
+	}()
+	frames := runtimeutil.PanicFrames(stackFrames)
+	if frames == nil {
+		frames = callerFrames(stackFrames)
+	}
+	typ := fmt.Sprintf("%T", err)
+	now := time.Now().UTC()
+	o := &Occurrence{
+		Fingerprint: fingerprint(typ, frames, r.opts.Frames),
+		Time:        now,
+		Error:       fmt.Sprintf("%v", err),
+		Stack:       formatFrames(frames),
+	}
+	if len(frames) > 0 {
+		o.Location = fmt.Sprintf("%s:%d", frames[0].File, frames[0].Line)
+	}
+	o.Server, _ = os.Hostname()
+	if ctx != nil {
+		fillRequest(ctx, o)
+	}
+	g, notify, serr := r.record(ctx, typ, o)
+	if serr != nil {
+		return serr
+	}
+	if notify && len(r.opts.Sinks) > 0 {
+		if ctx != nil {
+			ctx.Go(func(bg *app.Context) {
+				r.notify(bg, g, o)
+			})
+		} else {
+			go r.notify(nil, g, o)
+		}
+	}
+	return nil
+}
+
+// record updates the group for the given Occurrence and stores
+// both of them. It returns a copy of the updated group and whether
+// a notification should be sent.
+func (r *Reporter) record(ctx *app.Context, typ string, o *Occurrence) (*Group, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	store := r.opts.Store
+	g, err := store.Group(ctx, o.Fingerprint)
+	if err != nil {
+		return nil, false, err
+	}
+	if g == nil {
+		g = &Group{
+			Fingerprint: o.Fingerprint,
+			Type:        typ,
+			Location:    o.Location,
+			FirstSeen:   o.Time,
+		}
+	}
+	g.Error = o.Error
+	g.Count++
+	g.LastSeen = o.Time
+	notify := g.LastNotified.IsZero() || o.Time.Sub(g.LastNotified) >= r.opts.NotifyInterval
+	if notify {
+		g.LastNotified = o.Time
+	}
+	if err := store.SaveGroup(ctx, g); err != nil {
+		return nil, false, err
+	}
+	if err := store.AddOccurrence(ctx, o, r.opts.MaxOccurrences); err != nil {
+		return nil, false, err
+	}
+	cpy := *g
+	return &cpy, notify, nil
+}
+
+func (r *Reporter) notify(ctx *app.Context, g *Group, o *Occurrence) {
+	for _, v := range r.opts.Sinks {
+		if err := v.Notify(ctx, g, o); err != nil && ctx != nil {
+			ctx.Logger().Errorf("error sending notification for error %s: %s", g.Fingerprint, err)
+		}
+	}
+}
+
+func callerFrames(n int) []runtime.Frame {
+	callers := make([]uintptr, n)
+	// Skip runtime.Callers, callerFrames and Report
+	callers = callers[:runtime.Callers(3, callers)]
+	frames := runtime.CallersFrames(callers)
+	var ret []runtime.Frame
+	for {
+		frame, more := frames.Next()
+		ret = append(ret, frame)
+		if !more {
+			break
+		}
+	}
+	return ret
+}
+
+// fingerprint returns the fingerprint for an error with the
+// given type and stack frames, using at most n frames. Line
+// numbers are ignored, so errors are still grouped together
+// when unrelated code in the same functions changes.
+func fingerprint(typ string, frames []runtime.Frame, n int) string {
+	h := sha1.New()
+	h.Write([]byte(typ))
+	for ii, v := range frames {
+		if ii == n {
+			break
+		}
+		h.Write([]byte{'\n'})
+		h.Write([]byte(v.Function))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func formatFrames(frames []runtime.Frame) string {
+	var lines []string
+	for _, v := range frames {
+		lines = append(lines, fmt.Sprintf("%s\n\t%s:%d", v.Function, v.File, v.Line))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func fillRequest(ctx *app.Context, o *Occurrence) {
+	o.Handler = ctx.HandlerName()
+	if user := ctx.User(); user != nil {
+		o.UserId = user.Id()
+	}
+	req := ctx.R
+	if req == nil {
+		return
+	}
+	o.Method = req.Method
+	o.URL = ctx.URL().String()
+	o.RemoteAddress = ctx.RemoteAddress()
+	o.UserAgent = req.UserAgent()
+	o.Referer = req.Referer()
+	// Remove sensitive headers from the dump, restoring
+	// them afterwards, since the request is still in use.
+	removed := make(map[string][]string)
+	for _, v := range sensitiveHeaders {
+		if values, ok := req.Header[v]; ok {
+			removed[v] = values
+			req.Header.Del(v)
+		}
+	}
+	dump, err := httputil.DumpRequest(req, false)
+	for k, v := range removed {
+		req.Header[k] = v
+	}
+	if err == nil {
+		o.Request = stringutil.Lines(string(dump), 0, maxRequestSize, true)
+	}
+}
@@ -0,0 +1,179 @@
+package errorreport
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/app/tester"
+)
+
+type notification struct {
+	group      *Group
+	occurrence *Occurrence
+}
+
+func newTestApp(t *testing.T, config *app.Config, opts *Options) (*app.App, *Reporter) {
+	var a *app.App
+	if config != nil {
+		a = app.NewWithConfig(config)
+	} else {
+		a = app.New()
+	}
+	r, err := New(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Attach(a)
+	a.Handle("^/a/$", func(ctx *app.Context) {
+		panic(errors.New("a failed: " + ctx.FormValue("id")))
+	})
+	a.Handle("^/b/$", func(ctx *app.Context) {
+		panic(errors.New("b failed"))
+	})
+	a.Handle("^/not-found/$", func(ctx *app.Context) {
+		panic(&app.NotFoundError{})
+	})
+	return a, r
+}
+
+func TestReporter(t *testing.T) {
+	notifications := make(chan *notification, 10)
+	sink := SinkFunc(func(ctx *app.Context, g *Group, o *Occurrence) error {
+		notifications <- &notification{g, o}
+		return nil
+	})
+	a, r := newTestApp(t, nil, &Options{Sinks: []Sink{sink}})
+	tt := tester.New(t, a)
+	tt.Get("/a/", map[string]interface{}{"id": 1}).Expect(500)
+	tt.Get("/a/", map[string]interface{}{"id": 2}).Expect(500)
+	tt.Get("/b/", nil).Expect(500)
+	tt.Get("/not-found/", nil).Expect(404)
+	groups, err := r.Store().Groups(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 2 {
+		t.Fatalf("expecting 2 groups, got %d", len(groups))
+	}
+	// Groups are sorted by last seen, so b comes first
+	b, ga := groups[0], groups[1]
+	if ga.Count != 2 || ga.Error != "a failed: 2" {
+		t.Errorf("expecting group a with 2 occurrences and last error \"a failed: 2\", got %d and %q", ga.Count, ga.Error)
+	}
+	if b.Count != 1 || b.Error != "b failed" {
+		t.Errorf("expecting group b with 1 occurrence and error \"b failed\", got %d and %q", b.Count, b.Error)
+	}
+	if ga.Fingerprint == b.Fingerprint {
+		t.Errorf("expecting different fingerprints for a and b, got %s", ga.Fingerprint)
+	}
+	occurrences, err := r.Store().Occurrences(nil, ga.Fingerprint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(occurrences) != 2 {
+		t.Fatalf("expecting 2 occurrences, got %d", len(occurrences))
+	}
+	if o := occurrences[0]; o.Method != "GET" || o.URL == "" || o.Stack == "" {
+		t.Errorf("expecting occurrence with request details and stack, got %+v", o)
+	}
+	// Only the first occurrence of each group is notified
+	seen := make(map[string]bool)
+	for ii := 0; ii < 2; ii++ {
+		select {
+		case n := <-notifications:
+			seen[n.group.Fingerprint] = true
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for notification %d", ii+1)
+		}
+	}
+	if !seen[ga.Fingerprint] || !seen[b.Fingerprint] {
+		t.Errorf("expecting notifications for both groups, got %v", seen)
+	}
+	select {
+	case n := <-notifications:
+		t.Errorf("unexpected notification for %s", n.occurrence.Error)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func reportError(r *Reporter, msg string) error {
+	return r.Report(nil, errors.New(msg))
+}
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "errorreport")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := New(&Options{Store: store, MaxOccurrences: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []string{"first", "second", "third"} {
+		if err := reportError(r, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Reopen the store, to check the data was persisted
+	store, err = NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups, err := store.Groups(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 1 {
+		t.Fatalf("expecting 1 group, got %d", len(groups))
+	}
+	g := groups[0]
+	if g.Count != 3 || g.Error != "third" {
+		t.Errorf("expecting 3 occurrences and last error \"third\", got %d and %q", g.Count, g.Error)
+	}
+	occurrences, err := store.Occurrences(nil, g.Fingerprint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(occurrences) != 2 || occurrences[0].Error != "third" || occurrences[1].Error != "second" {
+		t.Fatalf("expecting occurrences \"third\" and \"second\", got %+v", occurrences)
+	}
+	if err := store.Delete(nil, g.Fingerprint); err != nil {
+		t.Fatal(err)
+	}
+	if g, err := store.Group(nil, g.Fingerprint); err != nil || g != nil {
+		t.Errorf("expecting no group after Delete, got %v (error %v)", g, err)
+	}
+}
+
+func TestAdmin(t *testing.T) {
+	a, _ := newTestApp(t, nil, nil)
+	tt := tester.New(t, a)
+	tt.Get("/a/", nil).Expect(500)
+	tt.Get(AdminPage+"/", nil).Expect(403)
+
+	a, r := newTestApp(t, &app.Config{Debug: true}, nil)
+	tt = tester.New(t, a)
+	// The debug error page can't be rendered in tests,
+	// so report the error directly.
+	if err := reportError(r, "failed: <x>"); err != nil {
+		t.Fatal(err)
+	}
+	tt.Get(AdminPage+"/", nil).Expect(200).Contains("failed: &lt;x&gt;")
+	groups, err := r.Store().Groups(nil)
+	if err != nil || len(groups) != 1 {
+		t.Fatalf("expecting 1 group, got %d (error %v)", len(groups), err)
+	}
+	page := AdminPage + "/" + groups[0].Fingerprint + "/"
+	tt.Get(page, nil).Expect(200).Contains("reportError")
+	tt.Post(page, nil).Expect(302)
+	tt.Get(page, nil).Expect(404)
+}
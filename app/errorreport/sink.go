@@ -0,0 +1,129 @@
+package errorreport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gnd.la/app"
+	"gnd.la/net/httpclient"
+	"gnd.la/net/mail"
+)
+
+// Sink is the interface implemented by types which receive
+// notifications about reported errors. Notify receives the
+// updated Group and the Occurrence which triggered the
+// notification. Note that ctx might be nil when an error
+// is reported outside of a request.
+type Sink interface {
+	Notify(ctx *app.Context, g *Group, o *Occurrence) error
+}
+
+// SinkFunc is an adapter to allow the use of ordinary
+// functions as a Sink.
+type SinkFunc func(ctx *app.Context, g *Group, o *Occurrence) error
+
+// Notify implements the Sink interface.
+func (f SinkFunc) Notify(ctx *app.Context, g *Group, o *Occurrence) error {
+	return f(ctx, g, o)
+}
+
+// MailSink returns a Sink which sends an email with the error
+// details to the given addresses. If no addresses are provided,
+// the email is sent to the administrator (see mail.Admin).
+func MailSink(to ...string) Sink {
+	return SinkFunc(func(ctx *app.Context, g *Group, o *Occurrence) error {
+		var dest interface{} = mail.Admin
+		if len(to) > 0 {
+			dest = to
+		}
+		msg := &mail.Message{
+			To:       dest,
+			Subject:  fmt.Sprintf("[%s] %s", o.Server, truncate(g.Error, 80)),
+			TextBody: formatText(g, o),
+		}
+		if ctx != nil {
+			return ctx.SendMail("", nil, msg)
+		}
+		return mail.Send(msg)
+	})
+}
+
+// webhookPayload is the JSON object sent by WebhookSink.
+type webhookPayload struct {
+	Group      *Group      `json:"group"`
+	Occurrence *Occurrence `json:"occurrence"`
+	// Text is a plain text summary, which allows using
+	// the webhook directly with some chat services.
+	Text string `json:"text"`
+}
+
+// WebhookSink returns a Sink which sends a POST request with a JSON
+// body to the given URL for each notification. The body is an object
+// with the keys "group" and "occurrence", containing the JSON encoded
+// Group and Occurrence, and "text", with a plain text summary of the
+// error. Any non-2xx response is considered an error.
+func WebhookSink(url string) Sink {
+	return SinkFunc(func(ctx *app.Context, g *Group, o *Occurrence) error {
+		payload := &webhookPayload{
+			Group:      g,
+			Occurrence: o,
+			Text:       fmt.Sprintf("%s (%d occurrences) at %s", g.Error, g.Count, g.Location),
+		}
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		var client *httpclient.Client
+		if ctx != nil {
+			client = httpclient.New(ctx)
+		} else {
+			client = httpclient.New(nil)
+		}
+		resp, err := client.Post(url, "application/json", bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		defer resp.Close()
+		if !resp.IsOK() {
+			return fmt.Errorf("webhook %s returned status %d", url, resp.StatusCode)
+		}
+		return nil
+	})
+}
+
+func formatText(g *Group, o *Occurrence) string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s\n\n", o.Error)
+	fmt.Fprintf(&buf, "Type: %s\n", g.Type)
+	fmt.Fprintf(&buf, "Location: %s\n", g.Location)
+	fmt.Fprintf(&buf, "Occurrences: %d (first seen %s, last seen %s)\n", g.Count, g.FirstSeen, g.LastSeen)
+	fmt.Fprintf(&buf, "Fingerprint: %s\n", g.Fingerprint)
+	fmt.Fprintf(&buf, "Server: %s\n", o.Server)
+	if o.URL != "" {
+		fmt.Fprintf(&buf, "\nRequest: %s %s", o.Method, o.URL)
+		if o.Handler != "" {
+			fmt.Fprintf(&buf, " (handler %s)", o.Handler)
+		}
+		fmt.Fprintf(&buf, "\nRemote address: %s\n", o.RemoteAddress)
+		if o.UserId != 0 {
+			fmt.Fprintf(&buf, "User: %d\n", o.UserId)
+		}
+	}
+	fmt.Fprintf(&buf, "\nStack:\n%s\n", o.Stack)
+	if o.Request != "" {
+		fmt.Fprintf(&buf, "\nHeaders:\n%s\n", o.Request)
+	}
+	return buf.String()
+}
+
+func truncate(s string, n int) string {
+	if p := strings.IndexByte(s, '\n'); p >= 0 {
+		s = s[:p]
+	}
+	if len(s) > n {
+		s = s[:n-3] + "..."
+	}
+	return s
+}
@@ -0,0 +1,290 @@
+package errorreport
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gnd.la/app"
+)
+
+// Group represents all the occurrences of an error
+// with the same fingerprint.
+type Group struct {
+	Fingerprint string `orm:",primary_key,max_length=40" json:"fingerprint"`
+	// Type is the Go type of the error (e.g. *errors.errorString).
+	Type string `json:"type"`
+	// Error is the message of the most recent occurrence.
+	Error string `json:"error"`
+	// Location is the file and line where the error happened.
+	Location     string    `json:"location"`
+	Count        int64     `json:"count"`
+	FirstSeen    time.Time `json:"first_seen"`
+	LastSeen     time.Time `json:"last_seen"`
+	LastNotified time.Time `json:"last_notified"`
+}
+
+// Occurrence represents each time an error was reported,
+// including the request being served when it happened.
+type Occurrence struct {
+	Id          int64     `orm:",primary_key,auto_increment" json:"id"`
+	Fingerprint string    `orm:",index,max_length=40" json:"fingerprint"`
+	Time        time.Time `json:"time"`
+	Error       string    `json:"error"`
+	Location    string    `json:"location"`
+	Stack       string    `json:"stack"`
+	// Server is the hostname of the machine running the App.
+	Server string `json:"server"`
+	// Handler is the name of the handler which was being
+	// executed, if it had one.
+	Handler       string `json:"handler,omitempty"`
+	Method        string `json:"method,omitempty"`
+	URL           string `json:"url,omitempty"`
+	RemoteAddress string `json:"remote_address,omitempty"`
+	UserAgent     string `json:"user_agent,omitempty"`
+	Referer       string `json:"referer,omitempty"`
+	UserId        int64  `json:"user_id,omitempty"`
+	// Request contains the request headers, without the
+	// ones which might contain credentials.
+	Request string `json:"request,omitempty"`
+}
+
+// Store is the interface implemented by types which persist
+// error groups and their occurrences. The Reporter serializes the
+// calls to Group, SaveGroup and AddOccurrence, so Stores shared among
+// several instances of an App might occasionally lose updates to the
+// group counters. Note that ctx might be nil when an error is reported
+// outside of a request.
+type Store interface {
+	// Group returns the group with the given fingerprint,
+	// or nil if there's no such group.
+	Group(ctx *app.Context, fingerprint string) (*Group, error)
+	// SaveGroup creates or updates the given group.
+	SaveGroup(ctx *app.Context, g *Group) error
+	// AddOccurrence stores a new occurrence, removing the older
+	// ones for the same group when there are more than max.
+	AddOccurrence(ctx *app.Context, o *Occurrence, max int) error
+	// Groups returns all the groups, sorted by the
+	// time of their last occurrence, newest first.
+	Groups(ctx *app.Context) ([]*Group, error)
+	// Occurrences returns the stored occurrences for the
+	// given group, newest first.
+	Occurrences(ctx *app.Context, fingerprint string) ([]*Occurrence, error)
+	// Delete removes a group and all its occurrences.
+	Delete(ctx *app.Context, fingerprint string) error
+}
+
+type groupsByLastSeen []*Group
+
+func (g groupsByLastSeen) Len() int           { return len(g) }
+func (g groupsByLastSeen) Less(i, j int) bool { return g[i].LastSeen.After(g[j].LastSeen) }
+func (g groupsByLastSeen) Swap(i, j int)      { g[i], g[j] = g[j], g[i] }
+
+// prependOccurrence returns occurrences with o at its
+// start, limited to max elements.
+func prependOccurrence(occurrences []*Occurrence, o *Occurrence, max int) []*Occurrence {
+	occurrences = append([]*Occurrence{o}, occurrences...)
+	if len(occurrences) > max {
+		occurrences = occurrences[:max]
+	}
+	return occurrences
+}
+
+// NewMemoryStore returns a Store which keeps the errors in
+// memory. It's mostly useful for development and tests,
+// since errors are lost when the process exits.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		groups:      make(map[string]*Group),
+		occurrences: make(map[string][]*Occurrence),
+	}
+}
+
+type memoryStore struct {
+	mu          sync.RWMutex
+	groups      map[string]*Group
+	occurrences map[string][]*Occurrence
+}
+
+func (s *memoryStore) Group(ctx *app.Context, fingerprint string) (*Group, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if g := s.groups[fingerprint]; g != nil {
+		cpy := *g
+		return &cpy, nil
+	}
+	return nil, nil
+}
+
+func (s *memoryStore) SaveGroup(ctx *app.Context, g *Group) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cpy := *g
+	s.groups[g.Fingerprint] = &cpy
+	return nil
+}
+
+func (s *memoryStore) AddOccurrence(ctx *app.Context, o *Occurrence, max int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.occurrences[o.Fingerprint] = prependOccurrence(s.occurrences[o.Fingerprint], o, max)
+	return nil
+}
+
+func (s *memoryStore) Groups(ctx *app.Context) ([]*Group, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	groups := make([]*Group, 0, len(s.groups))
+	for _, v := range s.groups {
+		cpy := *v
+		groups = append(groups, &cpy)
+	}
+	sort.Sort(groupsByLastSeen(groups))
+	return groups, nil
+}
+
+func (s *memoryStore) Occurrences(ctx *app.Context, fingerprint string) ([]*Occurrence, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]*Occurrence(nil), s.occurrences[fingerprint]...), nil
+}
+
+func (s *memoryStore) Delete(ctx *app.Context, fingerprint string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.groups, fingerprint)
+	delete(s.occurrences, fingerprint)
+	return nil
+}
+
+// NewFileStore returns a Store which saves the errors as JSON
+// files in the given directory, which is created if it doesn't
+// exist. Each group uses two files, named <fingerprint>.json and
+// <fingerprint>.occurrences.json.
+func NewFileStore(dir string) (Store, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &fileStore{dir: dir}, nil
+}
+
+const (
+	groupExt       = ".json"
+	occurrencesExt = ".occurrences.json"
+)
+
+type fileStore struct {
+	mu  sync.RWMutex
+	dir string
+}
+
+func (s *fileStore) path(fingerprint string, ext string) string {
+	return filepath.Join(s.dir, fingerprint+ext)
+}
+
+func (s *fileStore) read(p string, out interface{}) (bool, error) {
+	data, err := ioutil.ReadFile(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, json.Unmarshal(data, out)
+}
+
+func (s *fileStore) write(p string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file and then rename it, so
+	// readers never see a partially written file.
+	tmp := p + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, p)
+}
+
+func (s *fileStore) Group(ctx *app.Context, fingerprint string) (*Group, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var g Group
+	found, err := s.read(s.path(fingerprint, groupExt), &g)
+	if err != nil || !found {
+		return nil, err
+	}
+	return &g, nil
+}
+
+func (s *fileStore) SaveGroup(ctx *app.Context, g *Group) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.write(s.path(g.Fingerprint, groupExt), g)
+}
+
+func (s *fileStore) AddOccurrence(ctx *app.Context, o *Occurrence, max int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.path(o.Fingerprint, occurrencesExt)
+	var occurrences []*Occurrence
+	if _, err := s.read(p, &occurrences); err != nil {
+		return err
+	}
+	var id int64
+	if len(occurrences) > 0 {
+		id = occurrences[0].Id
+	}
+	o.Id = id + 1
+	return s.write(p, prependOccurrence(occurrences, o, max))
+}
+
+func (s *fileStore) Groups(ctx *app.Context) ([]*Group, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	entries, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	var groups []*Group
+	for _, v := range entries {
+		name := v.Name()
+		if v.IsDir() || !strings.HasSuffix(name, groupExt) || strings.HasSuffix(name, occurrencesExt) {
+			continue
+		}
+		var g Group
+		if _, err := s.read(filepath.Join(s.dir, name), &g); err != nil {
+			return nil, err
+		}
+		groups = append(groups, &g)
+	}
+	sort.Sort(groupsByLastSeen(groups))
+	return groups, nil
+}
+
+func (s *fileStore) Occurrences(ctx *app.Context, fingerprint string) ([]*Occurrence, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var occurrences []*Occurrence
+	if _, err := s.read(s.path(fingerprint, occurrencesExt), &occurrences); err != nil {
+		return nil, err
+	}
+	return occurrences, nil
+}
+
+func (s *fileStore) Delete(ctx *app.Context, fingerprint string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, ext := range []string{groupExt, occurrencesExt} {
+		if err := os.Remove(s.path(fingerprint, ext)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// EventStreamContentType is the Content-Type used for
+	// Server-Sent Events responses.
+	EventStreamContentType = "text/event-stream"
+)
+
+var (
+	errStreamingNotSupported = errors.New("the ResponseWriter does not support streaming")
+	errHeadersWritten        = errors.New("can't start an event stream after writing the headers")
+	errEventStreamClosed     = errors.New("event stream is closed")
+	eventFieldReplacer       = strings.NewReplacer("\r", "", "\n", "")
+)
+
+// EventStream implements Server-Sent Events (SSE) on top of a
+// Context. Use Context.EventStream to obtain an EventStream. All
+// its methods are safe for concurrent use.
+type EventStream struct {
+	w           http.ResponseWriter
+	flusher     http.Flusher
+	lastEventID string
+	mu          sync.Mutex
+	closed      bool
+	done        chan struct{}
+}
+
+// EventStream starts a Server-Sent Events stream, returning an
+// *EventStream which can be used to send events to the client. It
+// sets the required headers, disables buffering in the response
+// (including any reverse proxy which honors X-Accel-Buffering) and
+// sends the headers to the client. Note that responses which stream
+// events are never cached by the cache layer (gnd.la/cache/layer).
+//
+// Calling EventStream multiple times returns the same stream. The stream
+// is automatically closed when the Handler returns, so Handlers should
+// not return until they're done sending events. Use EventStream.Done to
+// be notified when the client disconnects. A typical handler looks like:
+//
+//  func EventsHandler(ctx *app.Context) {
+//	stream, err := ctx.EventStream()
+//	if err != nil {
+//	    panic(err)
+//	}
+//	stream.Heartbeat(15 * time.Second)
+//	for {
+//	    select {
+//	    case n := <-notifications:
+//		if err := stream.Send("notification", n.ID, n); err != nil {
+//		    return
+//		}
+//	    case <-stream.Done():
+//		return
+//	    }
+//	}
+//  }
+func (c *Context) EventStream() (*EventStream, error) {
+	if c.stream != nil {
+		return c.stream, nil
+	}
+	if c.statusCode > 0 {
+		return nil, errHeadersWritten
+	}
+	// Keep the ResponseWriter the stream was started with, since
+	// Transformers might replace ctx.ResponseWriter once the
+	// Handler returns.
+	w := c.ResponseWriter
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, errStreamingNotSupported
+	}
+	header := c.Header()
+	header.Set("Content-Type", EventStreamContentType)
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	header.Del("Content-Length")
+	c.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	s := &EventStream{
+		w:           w,
+		flusher:     flusher,
+		lastEventID: c.GetHeader("Last-Event-ID"),
+		done:        make(chan struct{}),
+	}
+	if c.R != nil {
+		go func() {
+			select {
+			case <-c.R.Context().Done():
+				s.Close()
+			case <-s.done:
+			}
+		}()
+	}
+	c.stream = s
+	return s, nil
+}
+
+// LastEventID returns the value of the Last-Event-ID header sent
+// by the client when reconnecting, which corresponds to the id of
+// the last event it received. Handlers might use it to resume the
+// stream, sending any events the client missed. If the client is
+// not reconnecting, it returns an empty string.
+func (s *EventStream) LastEventID() string {
+	return s.lastEventID
+}
+
+// Send sends an event to the client. If event is empty, the event
+// will be dispatched to the onmessage handler in the client. If id
+// is not empty, it will be sent back by the client in the Last-Event-ID
+// header when reconnecting. If data is a string or a []byte, it's sent
+// as is. Otherwise, it's encoded as JSON. Send returns an error if the
+// stream has been closed or the client has disconnected.
+func (s *EventStream) Send(event string, id string, data interface{}) error {
+	var payload []byte
+	switch x := data.(type) {
+	case nil:
+	case string:
+		payload = []byte(x)
+	case []byte:
+		payload = x
+	default:
+		var err error
+		if payload, err = json.Marshal(data); err != nil {
+			return err
+		}
+	}
+	var buf bytes.Buffer
+	if event != "" {
+		buf.WriteString("event: ")
+		buf.WriteString(eventFieldReplacer.Replace(event))
+		buf.WriteByte('\n')
+	}
+	if id != "" {
+		buf.WriteString("id: ")
+		buf.WriteString(eventFieldReplacer.Replace(id))
+		buf.WriteByte('\n')
+	}
+	lines := strings.Split(strings.Replace(string(payload), "\r\n", "\n", -1), "\n")
+	for _, v := range lines {
+		buf.WriteString("data: ")
+		buf.WriteString(v)
+		buf.WriteByte('\n')
+	}
+	buf.WriteByte('\n')
+	return s.write(buf.Bytes())
+}
+
+// Comment sends a comment to the client, which is ignored by
+// the browser but might be used to keep the connection alive.
+func (s *EventStream) Comment(text string) error {
+	var buf bytes.Buffer
+	for _, v := range strings.Split(text, "\n") {
+		buf.WriteString(": ")
+		buf.WriteString(v)
+		buf.WriteByte('\n')
+	}
+	buf.WriteByte('\n')
+	return s.write(buf.Bytes())
+}
+
+// SetRetry indicates the client how long it should wait before
+// reconnecting if the connection is lost.
+func (s *EventStream) SetRetry(d time.Duration) error {
+	return s.write([]byte("retry: " + strconv.FormatInt(int64(d/time.Millisecond), 10) + "\n\n"))
+}
+
+// Heartbeat starts sending a comment to the client at the given
+// interval until the stream is closed. This prevents proxies from
+// closing idle connections and allows noticing client disconnects
+// even when there are no events to send. Calling Heartbeat again
+// starts an additional heartbeat, so it should be called only once.
+func (s *EventStream) Heartbeat(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := s.Comment("heartbeat"); err != nil {
+					return
+				}
+			case <-s.done:
+				return
+			}
+		}
+	}()
+}
+
+// Done returns a channel which is closed when the stream is
+// closed, either because the client disconnected, a write
+// failed or the Handler finished.
+func (s *EventStream) Done() <-chan struct{} {
+	return s.done
+}
+
+// Close closes the stream. Any further attempts to write to it
+// will fail. Note that the stream is automatically closed when
+// the Handler returns, so there's usually no need to call Close.
+func (s *EventStream) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closeLocked()
+	return nil
+}
+
+func (s *EventStream) closeLocked() {
+	if !s.closed {
+		s.closed = true
+		close(s.done)
+	}
+}
+
+func (s *EventStream) write(data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return errEventStreamClosed
+	}
+	if _, err := s.w.Write(data); err != nil {
+		s.closeLocked()
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
@@ -0,0 +1,73 @@
+package app_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/app/tester"
+)
+
+func TestEventStream(t *testing.T) {
+	a := app.New()
+	a.Handle("^/events/$", func(ctx *app.Context) {
+		stream, err := ctx.EventStream()
+		if err != nil {
+			panic(err)
+		}
+		start := 0
+		if id := stream.LastEventID(); id != "" {
+			start, _ = strconv.Atoi(id)
+			start++
+		}
+		stream.Comment("hello")
+		for ii := start; ii < 3; ii++ {
+			stream.Send("count", strconv.Itoa(ii), map[string]int{"value": ii})
+		}
+		stream.Send("", "", "multiple\nlines")
+	})
+	a.Handle("^/written/$", func(ctx *app.Context) {
+		ctx.WriteString("foo")
+		if _, err := ctx.EventStream(); err != nil {
+			ctx.WriteString(" " + err.Error())
+		}
+	})
+	tt := tester.New(t, a)
+	tt.Get("/events/", nil).Expect(200).
+		ExpectHeader("Content-Type", app.EventStreamContentType).
+		ExpectHeader("Cache-Control", "no-cache").
+		ExpectEvents(
+			&tester.Event{Event: "count", ID: "0", Data: `{"value":0}`},
+			&tester.Event{Event: "count", ID: "1", Data: `{"value":1}`},
+			&tester.Event{Event: "count", ID: "2", Data: `{"value":2}`},
+			&tester.Event{Data: "multiple\nlines"},
+		)
+	tt.Get("/events/", nil).AddHeader("Last-Event-ID", "1").ExpectEvents(
+		&tester.Event{Event: "count", ID: "2", Data: `{"value":2}`},
+		&tester.Event{Data: "multiple\nlines"},
+	)
+	tt.Get("/written/", nil).Expect(200).Contains("foo can't start")
+}
+
+func TestEventStreamHeartbeat(t *testing.T) {
+	a := app.New()
+	// ConditionalGet restores ctx.ResponseWriter after the
+	// handler returns, while the heartbeat is still running.
+	a.AddTransformer(app.ConditionalGet)
+	a.Handle("^/heartbeat/$", func(ctx *app.Context) {
+		stream, err := ctx.EventStream()
+		if err != nil {
+			panic(err)
+		}
+		stream.Heartbeat(time.Millisecond)
+		stream.Send("", "", "hello")
+		time.Sleep(5 * time.Millisecond)
+	})
+	tt := tester.New(t, a)
+	for ii := 0; ii < 3; ii++ {
+		tt.Get("/heartbeat/", nil).Expect(200).ExpectEvents(&tester.Event{Data: "hello"})
+	}
+	// Give any leftover heartbeats a chance to run
+	time.Sleep(20 * time.Millisecond)
+}
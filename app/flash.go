@@ -0,0 +1,207 @@
+package app
+
+import (
+	"fmt"
+	"html/template"
+	"time"
+
+	"gnd.la/app/cookies"
+	"gnd.la/html"
+	"gnd.la/i18n"
+)
+
+const (
+	// The name of the cookie used to store the pending flash
+	// messages when using the default FlashStore. The cookie
+	// is signed using the gnd.la/app.App secret.
+	FLASH_COOKIE_NAME = "flash"
+)
+
+// FlashLevel indicates the severity of a flash message.
+type FlashLevel string
+
+const (
+	FlashSuccess FlashLevel = "success"
+	FlashInfo    FlashLevel = "info"
+	FlashWarning FlashLevel = "warning"
+	FlashError   FlashLevel = "error"
+)
+
+// FlashMessage represents a message added with Context.Flash which
+// is pending to be displayed to the user.
+type FlashMessage struct {
+	Level FlashLevel
+	// Message is the message text, already translated.
+	Message string
+}
+
+// FlashStore is the interface implemented by types which store
+// the pending flash messages between requests. The default store
+// uses a signed cookie. Use App.SetFlashStore to change it.
+type FlashStore interface {
+	// Load returns the pending messages for the client
+	// which sent the request.
+	Load(ctx *Context) ([]*FlashMessage, error)
+	// Save replaces the pending messages for the client. An
+	// empty messages argument removes all of them.
+	Save(ctx *Context, messages []*FlashMessage) error
+}
+
+type cookieFlashStore struct{}
+
+func (s cookieFlashStore) Load(ctx *Context) ([]*FlashMessage, error) {
+	jar := ctx.Cookies()
+	if !jar.Has(FLASH_COOKIE_NAME) {
+		return nil, nil
+	}
+	var messages []*FlashMessage
+	if err := jar.GetSecure(FLASH_COOKIE_NAME, &messages); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+func (s cookieFlashStore) Save(ctx *Context, messages []*FlashMessage) error {
+	jar := ctx.Cookies()
+	if len(messages) == 0 {
+		if jar.Has(FLASH_COOKIE_NAME) {
+			jar.Delete(FLASH_COOKIE_NAME)
+		}
+		return nil
+	}
+	// Use the default options, but make the cookie
+	// expire when the browser is closed.
+	var opts cookies.Options
+	if o := ctx.app.CookieOptions; o != nil {
+		opts = *o
+	} else {
+		opts = *cookies.Defaults()
+	}
+	opts.Expires = time.Time{}
+	opts.MaxAge = 0
+	opts.HttpOnly = true
+	return jar.SetSecureOpts(FLASH_COOKIE_NAME, messages, &opts)
+}
+
+// FlashRenderer is the interface implemented by types which
+// render flash messages as HTML. See SetDefaultFlashRenderer.
+type FlashRenderer interface {
+	// Root returns the node which will contain all the messages.
+	Root() *html.Node
+	// Node returns the node for a message. The message has been
+	// already translated and escaped.
+	Node(level FlashLevel, message string) *html.Node
+}
+
+type defaultFlashRenderer struct{}
+
+func (r defaultFlashRenderer) Root() *html.Node {
+	return html.Div().AddClass("flashes")
+}
+
+func (r defaultFlashRenderer) Node(level FlashLevel, message string) *html.Node {
+	return html.Div(html.Text(message)).AddClass("flash").AddClass("flash-" + string(level))
+}
+
+var (
+	flashRendererFunc = defaultFlashRendererFunc
+)
+
+func defaultFlashRendererFunc() FlashRenderer {
+	return defaultFlashRenderer{}
+}
+
+// SetDefaultFlashRenderer sets the function which returns the FlashRenderer
+// used by the "render_flashes" template function. The default renderer
+// wraps all the messages in a div with the class "flashes", and each message
+// in a div with the classes "flash" and "flash-<level>". Passing nil restores
+// the default renderer. Note that importing gnd.la/frontend/bootstrap3 sets
+// a renderer which uses bootstrap alerts.
+func SetDefaultFlashRenderer(f func() FlashRenderer) {
+	if f == nil {
+		f = defaultFlashRendererFunc
+	}
+	flashRendererFunc = f
+}
+
+// SetFlashStore sets the FlashStore used by the App. If store is
+// nil, the default store, which uses a signed cookie, is used.
+// Included apps always use the store of their parent.
+func (app *App) SetFlashStore(store FlashStore) {
+	app.root().flashStore = store
+}
+
+func (app *App) flashStoreOrDefault() FlashStore {
+	if store := app.root().flashStore; store != nil {
+		return store
+	}
+	return cookieFlashStore{}
+}
+
+func (c *Context) loadFlashes() {
+	if !c.flashesLoaded {
+		messages, err := c.app.flashStoreOrDefault().Load(c)
+		if err != nil {
+			c.Logger().Warningf("error loading flash messages: %s", err)
+		}
+		c.flashes = messages
+		c.flashesLoaded = true
+	}
+}
+
+// Flash adds a message which will be displayed to the user the next time
+// a page renders the pending flash messages, usually after a redirect. The
+// message is translated using the current language and, if any args are
+// provided, formatted using fmt.Sprintf. Messages are saved immediately,
+// so Flash must be called before writing the response body.
+//
+//  ctx.Flash(app.FlashSuccess, i18n.String("Your profile has been saved"))
+//  ctx.RedirectReverse(false, "profile")
+func (c *Context) Flash(level FlashLevel, message i18n.String, args ...interface{}) {
+	c.loadFlashes()
+	text := message.TranslatedString(c)
+	if len(args) > 0 {
+		text = fmt.Sprintf(text, args...)
+	}
+	c.flashes = append(c.flashes, &FlashMessage{Level: level, Message: text})
+	if err := c.app.flashStoreOrDefault().Save(c, c.flashes); err != nil {
+		c.Logger().Errorf("error saving flash messages: %s", err)
+	}
+}
+
+// Flashes returns the pending flash messages and removes them from
+// the store, so they're only displayed once. Templates usually call
+// the "flashes" or "render_flashes" functions rather than this one.
+func (c *Context) Flashes() []*FlashMessage {
+	c.loadFlashes()
+	messages := c.flashes
+	if len(messages) > 0 {
+		c.flashes = nil
+		if err := c.app.flashStoreOrDefault().Save(c, nil); err != nil {
+			c.Logger().Errorf("error removing flash messages: %s", err)
+		}
+	}
+	return messages
+}
+
+// renderFlashes returns the HTML for the pending flash messages,
+// using the default FlashRenderer. See SetDefaultFlashRenderer.
+func renderFlashes(messages []*FlashMessage) template.HTML {
+	if len(messages) == 0 {
+		return template.HTML("")
+	}
+	r := flashRendererFunc()
+	root := r.Root()
+	for _, v := range messages {
+		root.AppendChild(r.Node(v.Level, html.Escape(v.Message)))
+	}
+	return root.HTML()
+}
+
+func template_flashes(ctx *Context) []*FlashMessage {
+	return ctx.Flashes()
+}
+
+func template_render_flashes(ctx *Context) template.HTML {
+	return renderFlashes(ctx.Flashes())
+}
@@ -0,0 +1,70 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"gnd.la/i18n"
+)
+
+func TestFlash(t *testing.T) {
+	c := newSessionTestApp(t, &Config{})
+	c.app.Handle("^/flash/$", func(ctx *Context) {
+		ctx.Flash(FlashSuccess, i18n.String("saved %s"), ctx.FormValue("v"))
+		ctx.Flash(FlashError, i18n.String("<failed>"))
+		ctx.WriteString("ok")
+	})
+	c.app.Handle("^/show/$", func(ctx *Context) {
+		ctx.Write([]byte(renderFlashes(ctx.Flashes())))
+	})
+	c.expect("/show/", "")
+	c.expect("/flash/?v=foo", "ok")
+	if c.cookies[FLASH_COOKIE_NAME] == nil {
+		t.Fatal("flash cookie was not set")
+	}
+	expected := `<div class="flashes"><div class="flash flash-success">saved foo</div><div class="flash flash-error">&lt;failed&gt;</div></div>`
+	c.expect("/show/", expected)
+	if c.cookies[FLASH_COOKIE_NAME] != nil {
+		t.Error("flash cookie was not removed")
+	}
+	c.expect("/show/", "")
+}
+
+type testFlashStore struct {
+	messages []*FlashMessage
+}
+
+func (s *testFlashStore) Load(ctx *Context) ([]*FlashMessage, error) {
+	return s.messages, nil
+}
+
+func (s *testFlashStore) Save(ctx *Context, messages []*FlashMessage) error {
+	s.messages = messages
+	return nil
+}
+
+func TestFlashStore(t *testing.T) {
+	c := newSessionTestApp(t, &Config{})
+	store := &testFlashStore{}
+	c.app.SetFlashStore(store)
+	c.app.Handle("^/flash/$", func(ctx *Context) {
+		ctx.Flash(FlashInfo, i18n.String("hello"))
+	})
+	c.app.Handle("^/show/$", func(ctx *Context) {
+		for _, v := range ctx.Flashes() {
+			ctx.WriteString(string(v.Level) + ":" + v.Message)
+		}
+	})
+	c.expect("/flash/", "")
+	if len(c.cookies) != 0 {
+		t.Errorf("unexpected cookies %v", c.cookies)
+	}
+	if len(store.messages) != 1 {
+		t.Fatalf("expecting 1 stored message, got %d", len(store.messages))
+	}
+	c.expect("/show/", "info:hello")
+	c.expect("/show/", "")
+	if !strings.Contains(string(renderFlashes([]*FlashMessage{{Level: FlashWarning, Message: "w"}})), "flash-warning") {
+		t.Error("invalid flash rendering")
+	}
+}
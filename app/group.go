@@ -0,0 +1,103 @@
+package app
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Group represents a set of handlers which share a path prefix
+// and a list of Transformers. Groups are created with App.Group
+// and might be nested using Group.Group. Handlers added to a Group
+// are registered in its App, so they can be reversed like any
+// other named handler.
+type Group struct {
+	app          *App
+	prefix       string
+	transformers []Transformer
+}
+
+// Group returns a new *Group which registers its handlers in this
+// App, prepending the given prefix to their patterns and wrapping
+// them with the given Transformers. Transformers are applied in
+// the order they're provided, so the first one will be the first
+// to run when a request is served. See Group.Handle for the
+// details about how the prefix is prepended to patterns.
+func (app *App) Group(prefix string, transformers ...Transformer) *Group {
+	return &Group{
+		app:          app,
+		prefix:       strings.TrimSuffix(prefix, "/"),
+		transformers: transformers,
+	}
+}
+
+// App returns the App the handlers in this group are
+// registered into.
+func (g *Group) App() *App {
+	return g.app
+}
+
+// Prefix returns the prefix prepended to the patterns of
+// the handlers in this group, including any prefixes from
+// its parent groups.
+func (g *Group) Prefix() string {
+	return g.prefix
+}
+
+// Group returns a nested *Group. Its prefix is appended to this
+// group's prefix and its Transformers run after the ones from
+// this group.
+func (g *Group) Group(prefix string, transformers ...Transformer) *Group {
+	trs := make([]Transformer, 0, len(g.transformers)+len(transformers))
+	trs = append(trs, g.transformers...)
+	trs = append(trs, transformers...)
+	return &Group{
+		app:          g.app,
+		prefix:       g.prefix + strings.TrimSuffix(prefix, "/"),
+		transformers: trs,
+	}
+}
+
+// Handle works like App.Handle, but prepends the group prefix to the
+// pattern and wraps the handler with the group Transformers. For
+// typed patterns (e.g. /users/{id:int}) the prefix is just prepended,
+// so it might also contain typed parameters. For regular expressions,
+// the prefix is inserted after the initial ^ or, if the pattern is not
+// anchored, the pattern is anchored at the prefix. Note that a prefix
+// with typed parameters can't be combined with a pattern starting with
+// ^, since the prefix can't be expressed as a regular expression. In
+// that case, Handle panics.
+func (g *Group) Handle(pattern string, handler Handler, opts ...HandlerOption) {
+	g.app.Handle(g.pattern(pattern), g.transform(handler), opts...)
+}
+
+// HandleWebsocket works like App.HandleWebsocket, with the same
+// considerations as Group.Handle. Note that the group Transformers
+// run before the websocket connection is established, so they can
+// still redirect or reject the request.
+func (g *Group) HandleWebsocket(pattern string, handler Handler, opts ...HandlerOption) {
+	g.app.Handle(g.pattern(pattern), g.transform(websocketHandler(pattern, handler)), opts...)
+}
+
+func (g *Group) pattern(pattern string) string {
+	if g.prefix == "" {
+		return pattern
+	}
+	if strings.HasPrefix(pattern, "^") {
+		if isTypedPattern(g.prefix) {
+			panic(fmt.Errorf("can't add regular expression %q to group with typed prefix %q", pattern, g.prefix))
+		}
+		return "^" + regexp.QuoteMeta(g.prefix) + pattern[1:]
+	}
+	if isTypedPattern(g.prefix + pattern) {
+		return g.prefix + pattern
+	}
+	return "^" + regexp.QuoteMeta(g.prefix) + pattern
+}
+
+func (g *Group) transform(handler Handler) Handler {
+	for ii := len(g.transformers) - 1; ii >= 0; ii-- {
+		handler = g.transformers[ii](handler)
+	}
+	return handler
+}
@@ -0,0 +1,58 @@
+package app_test
+
+import (
+	"testing"
+
+	"gnd.la/app"
+	"gnd.la/app/tester"
+)
+
+func writeValue(value string) app.Transformer {
+	return func(handler app.Handler) app.Handler {
+		return func(ctx *app.Context) {
+			ctx.WriteString(value + " ")
+			handler(ctx)
+		}
+	}
+}
+
+func TestGroup(t *testing.T) {
+	a := app.New()
+	a.AddTransformer(func(handler app.Handler) app.Handler {
+		return func(ctx *app.Context) {
+			ctx.SetHeader("X-Global", "yes")
+			handler(ctx)
+		}
+	})
+	api := a.Group("/api/", writeValue("api1"), writeValue("api2"))
+	api.Handle("^/users/$", func(ctx *app.Context) {
+		ctx.WriteString("users")
+	}, app.NamedHandler("users"))
+	v1 := api.Group("/v1", writeValue("v1"))
+	v1.Handle("/users/{id:int}/", func(ctx *app.Context) {
+		ctx.WriteString(ctx.ParamValue("id"))
+	}, app.NamedHandler("v1-user"))
+	tenant := a.Group("/t/{tenant:slug}")
+	tenant.Handle("/home/{page}", func(ctx *app.Context) {
+		ctx.WriteString(ctx.ParamValue("tenant") + " " + ctx.ParamValue("page"))
+	}, app.NamedHandler("tenant-home"))
+	tt := tester.New(t, a)
+	tt.Get("/api/users/", nil).Expect("api1 api2 users").ExpectHeader("X-Global", "yes")
+	tt.Get("/api/v1/users/42/", nil).Expect("api1 api2 v1 42")
+	tt.Get("/t/acme/home/about", nil).Expect("acme about")
+	tt.Get("/users/", nil).Expect(404).ExpectHeader("X-Global", "yes")
+	testReverse(t, "/api/users/", a, "users")
+	testReverse(t, "/api/v1/users/7/", a, "v1-user", 7)
+	testReverse(t, "/t/acme/home/about", a, "tenant-home", "acme", "about")
+}
+
+func TestGroupTypedPrefixRegexp(t *testing.T) {
+	a := app.New()
+	tenant := a.Group("/t/{tenant:slug}")
+	defer func() {
+		if recover() == nil {
+			t.Error("expecting a panic when adding a regular expression to a group with a typed prefix")
+		}
+	}()
+	tenant.Handle("^/items/(\\d+)/$", func(ctx *app.Context) {})
+}
@@ -1,6 +1,11 @@
 package app
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
 
 // Handler is the function type used to satisfy a request
 // (not necessarily HTTP) with a given *Context.
@@ -17,8 +22,28 @@ type HandlerOptions struct {
 	// template function.
 	Name string
 	// Host specifies the host the Handler will match. If non-empty,
-	// only requests to this specific host will match the Handler.
+	// only requests to hosts matching this pattern will match the
+	// Handler. See HostHandler for the pattern syntax.
 	Host string
+	// Methods specifies the HTTP methods the Handler will respond
+	// to. If empty, the Handler will match any method. Note that
+	// handlers which accept GET also respond to HEAD requests. See
+	// MethodHandler for more information.
+	Methods []string
+	// Timeout indicates the maximum time the Handler might spend
+	// serving a request. If non-zero, the context.Context returned by
+	// Context.Context will be cancelled once the Timeout expires. See
+	// TimeoutHandler for more information.
+	Timeout time.Duration
+	// Summary is a short summary of what the Handler does. It's
+	// only used when generating OpenAPI documents (see App.OpenAPI).
+	Summary string
+	// Description is a longer description of the Handler, which
+	// might include CommonMark. Like Summary, it's only used when
+	// generating OpenAPI documents.
+	Description string
+	// Tags are used to group handlers in OpenAPI documents.
+	Tags []string
 }
 
 // A HandlerOption represents a function which receives a
@@ -35,8 +60,18 @@ func NamedHandler(name string) HandlerOption {
 	}
 }
 
-// HostHandler sets the HandlerOptions.Host field. See HandlerOptions
-// for more information.
+// HostHandler sets the HandlerOptions.Host field. The host might be
+// either an exact host or a pattern:
+//
+//  www.example.com: matches only the exact host (including the port, if any)
+//  {tenant}.example.com: each parameter matches one label of the host
+//  *.example.com: like parameters, but the matched label is not captured
+//  ^(?P<domain>.+)$: patterns starting with ^ are regular expressions
+//
+// Non-exact patterns are matched against the host without the port,
+// and the values of their named parameters are available through
+// Context.ParamValue. See App.Reverse and Context.Reverse to learn
+// how to reverse handlers with host parameters.
 func HostHandler(host string) HandlerOption {
 	return func(opts HandlerOptions) HandlerOptions {
 		opts.Host = host
@@ -44,6 +79,55 @@ func HostHandler(host string) HandlerOption {
 	}
 }
 
+// MethodHandler sets the HandlerOptions.Methods field, restricting the
+// Handler to the given HTTP methods. When a request path matches only
+// handlers which don't accept its method, the App responds with a
+// 405 (Method Not Allowed) and an Allow header listing the accepted
+// methods. OPTIONS requests for such paths are answered automatically
+// with the Allow header, unless a Handler explicitly accepts OPTIONS.
+func MethodHandler(methods ...string) HandlerOption {
+	return func(opts HandlerOptions) HandlerOptions {
+		for _, v := range methods {
+			opts.Methods = append(opts.Methods, strings.ToUpper(v))
+		}
+		return opts
+	}
+}
+
+// TimeoutHandler sets the HandlerOptions.Timeout field. Note that
+// the Handler is not interrupted when the timeout expires. Instead,
+// the context.Context associated with the *Context is cancelled, so
+// any operations using it (e.g. ORM queries or HTTP requests made
+// with gnd.la/net/httpclient) are aborted and return an error.
+// Handlers doing any long running work by themselves should check
+// Context.Context().Done() periodically.
+func TimeoutHandler(timeout time.Duration) HandlerOption {
+	return func(opts HandlerOptions) HandlerOptions {
+		opts.Timeout = timeout
+		return opts
+	}
+}
+
+// DescribedHandler sets the HandlerOptions.Summary and
+// HandlerOptions.Description fields. See HandlerOptions
+// for more information.
+func DescribedHandler(summary string, description string) HandlerOption {
+	return func(opts HandlerOptions) HandlerOptions {
+		opts.Summary = summary
+		opts.Description = description
+		return opts
+	}
+}
+
+// TaggedHandler adds the given tags to the HandlerOptions.Tags
+// field. See HandlerOptions for more information.
+func TaggedHandler(tags ...string) HandlerOption {
+	return func(opts HandlerOptions) HandlerOptions {
+		opts.Tags = append(opts.Tags, tags...)
+		return opts
+	}
+}
+
 // HandlerFromHTTPFunc returns a Handler from an http.HandlerFunc.
 func HandlerFromHTTPFunc(f http.HandlerFunc) Handler {
 	return func(ctx *Context) {
@@ -58,6 +142,19 @@ func HandlerFromHTTPHandler(h http.Handler) Handler {
 	}
 }
 
+func timeoutHandler(handler Handler, timeout time.Duration) Handler {
+	return func(ctx *Context) {
+		prev := ctx.stdCtx
+		stdCtx, cancel := context.WithTimeout(ctx.Context(), timeout)
+		defer func() {
+			cancel()
+			ctx.stdCtx = prev
+		}()
+		ctx.stdCtx = stdCtx
+		handler(ctx)
+	}
+}
+
 func includedAppHandler(app *App, prefix string) Handler {
 	prefixLen := len(prefix)
 	return func(ctx *Context) {
@@ -69,6 +166,6 @@ func includedAppHandler(app *App, prefix string) Handler {
 		defer func() {
 			ctx.app = app
 		}()
-		app.serveOrNotFound(ctx.R.URL.Path[prefixLen:], ctx)
+		app.serveTransformed(ctx.R.URL.Path[prefixLen:], ctx)
 	}
 }
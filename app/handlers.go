@@ -81,6 +81,7 @@ type DataHandler func(*Context) (interface{}, error)
 // JSONHandler returns a Handler which executes the given DataHandler
 // to obtain the data and, if it succeeds, serializes the data using
 // JSON and returns it back to the client.
+// See also NegotiatedHandler and APIHandler.
 func JSONHandler(dataHandler DataHandler) Handler {
 	return func(ctx *Context) {
 		data, err := dataHandler(ctx)
@@ -93,6 +94,21 @@ func JSONHandler(dataHandler DataHandler) Handler {
 	}
 }
 
+// NegotiatedHandler works like JSONHandler, but serializes the data
+// using Context.WriteNegotiated with the given options, which might
+// be nil. If there's no acceptable content type, it replies with a 406.
+func NegotiatedHandler(dataHandler DataHandler, opts *NegotiateOptions) Handler {
+	return func(ctx *Context) {
+		data, err := dataHandler(ctx)
+		if err != nil {
+			panic(err)
+		}
+		if _, err := ctx.WriteNegotiated(data, opts); err != nil && err != ErrNotAcceptable {
+			panic(err)
+		}
+	}
+}
+
 // ExecuteHandler returns a Handler which executes the given DataHandler
 // to obtain the data and, if it succeeds, executes the given template
 // passing it the obtained data.
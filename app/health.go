@@ -0,0 +1,221 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// HealthCheck is a function which checks the status of a dependency
+// of the App, like a database or an external service. It should
+// return a non-nil error if the dependency is not available.
+// Panics are recovered and reported as errors. The Context
+// received by the check is not the one for the request and
+// its context.Context expires after Config.HealthCheckTimeout,
+// so checks should pass it to any blocking operations.
+type HealthCheck func(ctx *Context) error
+
+type healthCheck struct {
+	name     string
+	required bool
+	check    HealthCheck
+}
+
+// healthCheckResult is the result of running a
+// HealthCheck, as reported by ReadinessHandler.
+type healthCheckResult struct {
+	Required bool    `json:"required"`
+	Latency  float64 `json:"latency_ms"`
+	Error    string  `json:"error,omitempty"`
+}
+
+// healthStatus is the response sent by LivenessHandler
+// and ReadinessHandler.
+type healthStatus struct {
+	Status string                        `json:"status"`
+	Checks map[string]*healthCheckResult `json:"checks,omitempty"`
+}
+
+// AddHealthCheck registers a named HealthCheck, which will be run by
+// ReadinessHandler. If required is true, the App won't be considered
+// ready while the check fails. Otherwise, its failure is reported but
+// the App is still considered ready. Registering a check with the same
+// name as a previous one replaces it. Checks added to included apps
+// are registered in their parent.
+//
+// Note that the App automatically checks its database, cache and
+// blobstore (when they're configured) as the required checks named
+// "database", "cache" and "blobstore", respectivelly.
+func (app *App) AddHealthCheck(name string, required bool, check HealthCheck) {
+	root := app.root()
+	hc := &healthCheck{name: name, required: required, check: check}
+	for ii, v := range root.healthChecks {
+		if v.name == name {
+			root.healthChecks[ii] = hc
+			return
+		}
+	}
+	root.healthChecks = append(root.healthChecks, hc)
+}
+
+// builtinHealthChecks returns the checks for the backends
+// configured in the App.
+func (app *App) builtinHealthChecks() []*healthCheck {
+	var checks []*healthCheck
+	if app.cfg.Database != nil {
+		checks = append(checks, &healthCheck{"database", true, func(ctx *Context) error {
+			return ctx.Orm().Ping()
+		}})
+	}
+	if app.cfg.Cache != nil {
+		checks = append(checks, &healthCheck{"cache", true, func(ctx *Context) error {
+			return ctx.Cache().Ping()
+		}})
+	}
+	if app.cfg.Blobstore != nil {
+		checks = append(checks, &healthCheck{"blobstore", true, func(ctx *Context) error {
+			return ctx.Blobstore().Ping()
+		}})
+	}
+	return checks
+}
+
+// runHealthCheck runs hc in a new Context, bound to a context.Context
+// derived from the request one which expires after timeout. Checks
+// which honor it (e.g. the ones using the Orm or an httpclient.Client
+// created from the Context) are cancelled when it expires, while the
+// ones ignoring it are abandoned. In that case, hc won't run again
+// until the previous run finishes, so a hung backend doesn't leak a
+// goroutine on every request.
+func (app *App) runHealthCheck(ctx *Context, hc *healthCheck, timeout time.Duration) *healthCheckResult {
+	res := &healthCheckResult{Required: hc.required}
+	if !app.beginHealthCheck(hc.name) {
+		res.Error = "previous check still running"
+		return res
+	}
+	var stdCtx context.Context
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		stdCtx, cancel = context.WithTimeout(ctx.Context(), timeout)
+	} else {
+		stdCtx, cancel = context.WithCancel(ctx.Context())
+	}
+	c := app.NewContext(nil)
+	c.background = true
+	c.SetContext(stdCtx)
+	start := time.Now()
+	ch := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				ch <- fmt.Errorf("panic: %v", r)
+			}
+			cancel()
+			app.CloseContext(c)
+			app.endHealthCheck(hc.name)
+		}()
+		ch <- hc.check(c)
+	}()
+	var err error
+	select {
+	case err = <-ch:
+	case <-stdCtx.Done():
+		err = stdCtx.Err()
+		if err == context.DeadlineExceeded {
+			err = fmt.Errorf("timed out after %s", timeout)
+		}
+	}
+	res.Latency = float64(time.Since(start)) / float64(time.Millisecond)
+	if err != nil {
+		res.Error = err.Error()
+	}
+	return res
+}
+
+// beginHealthCheck marks the check with the given name as running,
+// returning false if it was already running.
+func (app *App) beginHealthCheck(name string) bool {
+	started := false
+	app.locked(func() {
+		if app.healthRunning == nil {
+			app.healthRunning = make(map[string]bool)
+		}
+		if !app.healthRunning[name] {
+			app.healthRunning[name] = true
+			started = true
+		}
+	})
+	return started
+}
+
+func (app *App) endHealthCheck(name string) {
+	app.locked(func() {
+		delete(app.healthRunning, name)
+	})
+}
+
+// checkHealth runs all the health checks concurrently and returns
+// their results, as well as whether all the required ones passed.
+func (app *App) checkHealth(ctx *Context) (map[string]*healthCheckResult, bool) {
+	root := app.root()
+	checks := append(root.builtinHealthChecks(), root.healthChecks...)
+	timeout := time.Duration(root.cfg.HealthCheckTimeout) * time.Second
+	type namedResult struct {
+		name string
+		res  *healthCheckResult
+	}
+	ch := make(chan namedResult, len(checks))
+	for _, v := range checks {
+		go func(hc *healthCheck) {
+			ch <- namedResult{hc.name, root.runHealthCheck(ctx, hc, timeout)}
+		}(v)
+	}
+	results := make(map[string]*healthCheckResult, len(checks))
+	ok := true
+	for range checks {
+		r := <-ch
+		results[r.name] = r.res
+		if r.res.Required && r.res.Error != "" {
+			ok = false
+		}
+	}
+	return results, ok
+}
+
+func writeHealthStatus(ctx *Context, code int, status *healthStatus) {
+	ctx.Header().Set("Cache-Control", "no-cache")
+	ctx.WriteHeader(code)
+	if _, err := ctx.WriteJSON(status); err != nil {
+		panic(err)
+	}
+}
+
+// LivenessHandler is a Handler which always responds with a 200 and
+// a JSON body, indicating that the App is running and able to serve
+// requests. It's intended to be used as a liveness probe, so it does
+// not check any backends. See ReadinessHandler.
+//
+//  myapp.Handle("^/healthz$", app.LivenessHandler)
+func LivenessHandler(ctx *Context) {
+	writeHealthStatus(ctx, http.StatusOK, &healthStatus{Status: "ok"})
+}
+
+// ReadinessHandler is a Handler which runs all the health checks in the
+// App concurrently (see App.AddHealthCheck), each one limited by
+// Config.HealthCheckTimeout. Each check receives its own Context, whose
+// context.Context expires after the timeout (see Context.Context). The results are returned as JSON, including
+// the latency and the error (if any) for every check. If any of the
+// required checks fails, the response status is 503 (Service Unavailable).
+//
+//  myapp.Handle("^/readyz$", app.ReadinessHandler)
+func ReadinessHandler(ctx *Context) {
+	results, ok := ctx.app.checkHealth(ctx)
+	status := &healthStatus{Status: "ok", Checks: results}
+	code := http.StatusOK
+	if !ok {
+		status.Status = "error"
+		code = http.StatusServiceUnavailable
+	}
+	writeHealthStatus(ctx, code, status)
+}
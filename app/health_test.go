@@ -0,0 +1,57 @@
+package app_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/app/tester"
+)
+
+func TestHealth(t *testing.T) {
+	a := app.NewWithConfig(&app.Config{HealthCheckTimeout: 1})
+	a.Handle("^/healthz$", app.LivenessHandler)
+	a.Handle("^/readyz$", app.ReadinessHandler)
+	a.AddHealthCheck("service", true, func(ctx *app.Context) error {
+		return nil
+	})
+	a.AddHealthCheck("optional", false, func(ctx *app.Context) error {
+		return errors.New("optional failed")
+	})
+	tt := tester.New(t, a)
+	tt.Get("/healthz", nil).Expect(200).Contains(`"status":"ok"`)
+	tt.Get("/readyz", nil).Expect(200).
+		Contains(`"status":"ok"`).
+		Contains(`"error":"optional failed"`)
+	a.AddHealthCheck("service", true, func(ctx *app.Context) error {
+		panic("service down")
+	})
+	tt.Get("/readyz", nil).Expect(503).
+		Contains(`"status":"error"`).
+		Contains(`"error":"panic: service down"`)
+	cancelled := make(chan struct{})
+	a.AddHealthCheck("service", true, func(ctx *app.Context) error {
+		<-ctx.Context().Done()
+		close(cancelled)
+		return ctx.Context().Err()
+	})
+	tt.Get("/readyz", nil).Expect(503).Contains(`"error":"timed out after 1s"`)
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Error("expecting the check context to be cancelled after the timeout")
+	}
+	// Checks ignoring their context are abandoned, but
+	// not started again until they finish.
+	release := make(chan struct{})
+	a.AddHealthCheck("service", true, func(ctx *app.Context) error {
+		<-release
+		return nil
+	})
+	tt.Get("/readyz", nil).Expect(503).Contains(`"error":"timed out after 1s"`)
+	tt.Get("/readyz", nil).Expect(503).Contains(`"error":"previous check still running"`)
+	close(release)
+	time.Sleep(50 * time.Millisecond)
+	tt.Get("/readyz", nil).Expect(200)
+}
@@ -0,0 +1,120 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	hostParamRe = regexp.MustCompile(`\{[A-Za-z_]\w*\}|\*`)
+)
+
+// hostPattern matches the host of a request. Host patterns might be
+// exact (www.example.com), use named parameters ({tenant}.example.com),
+// wildcards (*.example.com) or regular expressions (^(?P<domain>.+)$).
+type hostPattern struct {
+	pattern string
+	// non-empty only for exact hosts
+	exact    string
+	re       *regexp.Regexp
+	rc       *regexpCache
+	wildcard bool
+}
+
+// newHostPattern parses the given host pattern. Patterns starting
+// with ^ are interpreted as regular expressions, while patterns
+// containing parameters in braces or * are converted to a regular
+// expression where each parameter or wildcard matches exactly one
+// label of the host name. Any other pattern matches only the exact
+// host. If pattern is empty, it returns nil.
+func newHostPattern(pattern string) (*hostPattern, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	h := &hostPattern{pattern: pattern}
+	var expr string
+	if strings.HasPrefix(pattern, "^") {
+		expr = pattern
+	} else if hostParamRe.MatchString(pattern) {
+		var buf bytes.Buffer
+		buf.WriteByte('^')
+		pos := 0
+		for _, m := range hostParamRe.FindAllStringIndex(pattern, -1) {
+			buf.WriteString(regexp.QuoteMeta(strings.ToLower(pattern[pos:m[0]])))
+			if param := pattern[m[0]:m[1]]; param == "*" {
+				buf.WriteString(`[^.]+`)
+				h.wildcard = true
+			} else {
+				fmt.Fprintf(&buf, `(?P<%s>[^.]+)`, param[1:len(param)-1])
+			}
+			pos = m[1]
+		}
+		buf.WriteString(regexp.QuoteMeta(strings.ToLower(pattern[pos:])))
+		buf.WriteByte('$')
+		expr = buf.String()
+	} else {
+		h.exact = pattern
+		return h, nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid host pattern %q: %s", pattern, err)
+	}
+	h.re = re
+	h.rc = newRegexpCache(re)
+	return h, nil
+}
+
+func (h *hostPattern) String() string {
+	if h == nil {
+		return ""
+	}
+	return h.pattern
+}
+
+// matches returns true iff the host matches the pattern.
+func (h *hostPattern) matches(host string) bool {
+	if h.exact != "" {
+		return host == h.exact
+	}
+	return h.re.MatchString(matchedHost(host))
+}
+
+// numParams returns the number of arguments required
+// for formatting the host.
+func (h *hostPattern) numParams() int {
+	if h.rc == nil {
+		return 0
+	}
+	return h.rc.max
+}
+
+// format returns the host for the given arguments, which must
+// provide all the parameters in the pattern.
+func (h *hostPattern) format(args []interface{}) (string, error) {
+	if h.exact != "" {
+		return h.exact, nil
+	}
+	if h.wildcard {
+		return "", fmt.Errorf("can't reverse host pattern %q with wildcards", h.pattern)
+	}
+	host, err := formatRegexp(h.rc, args)
+	if err != nil {
+		return "", err
+	}
+	if !h.re.MatchString(host) {
+		return "", fmt.Errorf("host %q does not match pattern %q", host, h.pattern)
+	}
+	return host, nil
+}
+
+// matchedHost returns the host without the port, lowercased, since
+// that's the host used for matching non-exact host patterns.
+func matchedHost(host string) string {
+	if p := strings.LastIndexByte(host, ':'); p > strings.LastIndexByte(host, ']') {
+		host = host[:p]
+	}
+	return strings.ToLower(host)
+}
@@ -0,0 +1,58 @@
+package app_test
+
+import (
+	"testing"
+
+	"gnd.la/app"
+	"gnd.la/app/tester"
+)
+
+func TestHostPatterns(t *testing.T) {
+	const tenantHost = "{tenant}.example.com"
+	a := app.New()
+	a.Handle("^/$", func(ctx *app.Context) {
+		// Reverse requires the host arguments on every host
+		if _, err := ctx.Reverse("page", 3); err == nil {
+			ctx.WriteString("www no error")
+			return
+		}
+		if _, err := ctx.ReverseSameHost("page", 3); err == nil {
+			ctx.WriteString("www no same host error")
+			return
+		}
+		ctx.WriteString("www " + ctx.MustReverse("page", "acme", 3))
+	}, app.HostHandler("www.example.com"))
+	a.Handle("^/$", func(ctx *app.Context) {
+		same, err := ctx.ReverseSameHost("page", 3)
+		if err != nil {
+			same = err.Error()
+		}
+		ctx.WriteString(ctx.ParamValue("tenant") + " " + same + " " + ctx.MustReverse("page", "globex", 3))
+	}, app.HostHandler(tenantHost))
+	a.Handle("/page/{n:int}/", func(ctx *app.Context) {
+		ctx.WriteString(ctx.ParamValue("tenant") + " " + ctx.ParamValue("n"))
+	}, app.NamedHandler("page"), app.HostHandler(tenantHost))
+	a.Handle("^/$", func(ctx *app.Context) {
+		ctx.WriteString("wildcard")
+	}, app.NamedHandler("wildcard"), app.HostHandler("*.example.org"))
+	a.Handle("^/$", func(ctx *app.Context) {
+		ctx.WriteString(ctx.ParamValue("domain"))
+	}, app.HostHandler("^(?P<domain>[\\w\\-]+\\.com)$"))
+	tt := tester.New(t, a)
+	tt.Get("/", nil).AddHeader("Host", "www.example.com").Expect("www http://acme.example.com/page/3/")
+	tt.Get("/", nil).AddHeader("Host", "acme.example.com:8080").Expect("acme http://acme.example.com:8080/page/3/ http://globex.example.com/page/3/")
+	tt.Get("/", nil).AddHeader("Host", "ACME.example.com").Expect("acme http://ACME.example.com/page/3/ http://globex.example.com/page/3/")
+	tt.Get("/page/5/", nil).AddHeader("Host", "acme.example.com").Expect("acme 5")
+	tt.Get("/", nil).AddHeader("Host", "foo.example.org").Expect("wildcard")
+	tt.Get("/", nil).AddHeader("Host", "custom.com").Expect("custom.com")
+	tt.Get("/", nil).AddHeader("Host", "a.b.example.org").Expect(404)
+	tt.Get("/page/5/", nil).AddHeader("Host", "custom.com").Expect(404)
+
+	testReverse(t, "//globex.example.com/page/7/", a, "page", "globex", 7)
+	if rev, err := a.Reverse("page", 7); err == nil {
+		t.Errorf("expecting an error when reversing without host arguments, got %q", rev)
+	}
+	if rev, err := a.Reverse("wildcard"); err == nil {
+		t.Errorf("expecting an error when reversing a wildcard host, got %q", rev)
+	}
+}
@@ -0,0 +1,87 @@
+package hub
+
+import (
+	"sync"
+)
+
+// Message represents a broadcast sent through a Backplane.
+type Message struct {
+	// Room is the room the message is sent to. An empty
+	// room means all the connections in the Hub.
+	Room string `json:"room"`
+	// Data is the message payload.
+	Data []byte `json:"data"`
+}
+
+// Subscription is the interface returned by Backplane.Subscribe.
+// Call Close to stop receiving messages.
+type Subscription interface {
+	Close() error
+}
+
+// Backplane is the interface implemented by types which distribute
+// the broadcasts sent by a Hub to all its instances, usually running
+// in different processes or machines. Hubs are identified by their name
+// (see Options.Name) and each message published for a given name must
+// be delivered to all the subscribers for that name, including the
+// instance which published it.
+type Backplane interface {
+	// Publish sends the message to all the subscribers for the
+	// given hub name.
+	Publish(hub string, msg *Message) error
+	// Subscribe calls f for each message published for the given
+	// hub name, until the returned Subscription is closed. Note
+	// that f might be called from any goroutine.
+	Subscribe(hub string, f func(msg *Message)) (Subscription, error)
+}
+
+// NewMemoryBackplane returns a Backplane which delivers the messages
+// to the hubs subscribed to it in the same process. Each Hub created
+// without a Backplane uses its own memory Backplane. Sharing a memory
+// Backplane among several hubs with the same name makes them share
+// their broadcasts.
+func NewMemoryBackplane() Backplane {
+	return &memoryBackplane{subs: make(map[string][]*memorySubscription)}
+}
+
+type memoryBackplane struct {
+	mu   sync.RWMutex
+	subs map[string][]*memorySubscription
+}
+
+type memorySubscription struct {
+	b   *memoryBackplane
+	hub string
+	f   func(*Message)
+}
+
+func (s *memorySubscription) Close() error {
+	s.b.mu.Lock()
+	defer s.b.mu.Unlock()
+	subs := s.b.subs[s.hub]
+	for ii, v := range subs {
+		if v == s {
+			s.b.subs[s.hub] = append(subs[:ii:ii], subs[ii+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (b *memoryBackplane) Publish(hub string, msg *Message) error {
+	b.mu.RLock()
+	subs := b.subs[hub]
+	b.mu.RUnlock()
+	for _, v := range subs {
+		v.f(msg)
+	}
+	return nil
+}
+
+func (b *memoryBackplane) Subscribe(hub string, f func(*Message)) (Subscription, error) {
+	s := &memorySubscription{b: b, hub: hub, f: f}
+	b.mu.Lock()
+	b.subs[hub] = append(b.subs[hub], s)
+	b.mu.Unlock()
+	return s, nil
+}
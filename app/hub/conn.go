@@ -0,0 +1,244 @@
+package hub
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"gnd.la/app"
+)
+
+var (
+	// ErrClosed is returned when sending a message to a
+	// connection which has been closed.
+	ErrClosed = errors.New("connection is closed")
+	// ErrQueueFull is returned by Conn.Send when the send
+	// queue for the connection is full, which usually means
+	// the client is not reading messages fast enough.
+	ErrQueueFull = errors.New("connection send queue is full")
+
+	errNoHijacker = errors.New("the ResponseWriter does not implement http.Hijacker")
+)
+
+// Conn represents a websocket connection managed by a Hub. All its
+// methods are safe for concurrent use.
+type Conn struct {
+	hub       *Hub
+	id        string
+	ctx       *app.Context
+	ws        *websocket.Conn
+	tc        *trackedConn
+	send      chan []byte
+	done      chan struct{}
+	closeOnce sync.Once
+	// Protected by hub.mu
+	rooms map[string]struct{}
+}
+
+// ID returns the connection identifier, which is unique
+// among the connections of its Hub.
+func (c *Conn) ID() string {
+	return c.id
+}
+
+// Hub returns the Hub this connection belongs to.
+func (c *Conn) Hub() *Hub {
+	return c.hub
+}
+
+// Context returns the *app.Context for the request which
+// started the connection. Note that Context.Websocket should
+// not be used to read or write from the connection, since
+// that's managed by the Hub.
+func (c *Conn) Context() *app.Context {
+	return c.ctx
+}
+
+// Send queues data to be sent as a text message to the client. If the
+// connection has been closed it returns ErrClosed, while if the send
+// queue is full, the data is discarded and ErrQueueFull is returned.
+func (c *Conn) Send(data []byte) error {
+	select {
+	case <-c.done:
+		return ErrClosed
+	default:
+	}
+	select {
+	case c.send <- data:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// SendJSON encodes v as JSON and sends it using Send.
+func (c *Conn) SendJSON(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return c.Send(data)
+}
+
+// Join adds the connection to the given room. Joining a room
+// the connection is already in does nothing.
+func (c *Conn) Join(room string) {
+	if c.hub.join(c, room) {
+		Signals.Joined.emit(c, room)
+	}
+}
+
+// Leave removes the connection from the given room. Leaving a
+// room the connection is not in does nothing.
+func (c *Conn) Leave(room string) {
+	if c.hub.leave(c, room) {
+		Signals.Left.emit(c, room)
+	}
+}
+
+// Rooms returns the rooms the connection is in, sorted
+// alphabetically.
+func (c *Conn) Rooms() []string {
+	c.hub.mu.RLock()
+	rooms := make([]string, 0, len(c.rooms))
+	for k := range c.rooms {
+		rooms = append(rooms, k)
+	}
+	c.hub.mu.RUnlock()
+	sort.Strings(rooms)
+	return rooms
+}
+
+// Done returns a channel which is closed when the
+// connection is closed.
+func (c *Conn) Done() <-chan struct{} {
+	return c.done
+}
+
+// Close closes the connection. Any queued messages which
+// have not been sent yet are discarded.
+func (c *Conn) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		close(c.done)
+		err = c.ws.Close()
+	})
+	return err
+}
+
+func (c *Conn) readLoop(handler MessageHandler) {
+	for {
+		var data []byte
+		if err := websocket.Message.Receive(c.ws, &data); err != nil {
+			return
+		}
+		if handler != nil {
+			handler(c, data)
+		}
+	}
+}
+
+func (c *Conn) writeLoop() {
+	opts := &c.hub.opts
+	var ping <-chan time.Time
+	if opts.PingInterval > 0 {
+		ticker := time.NewTicker(opts.PingInterval)
+		defer ticker.Stop()
+		ping = ticker.C
+	}
+	for {
+		select {
+		case data := <-c.send:
+			c.ws.SetWriteDeadline(time.Now().Add(opts.WriteTimeout))
+			if err := websocket.Message.Send(c.ws, string(data)); err != nil {
+				c.Close()
+				return
+			}
+		case <-ping:
+			// Any data received from the client (including pongs)
+			// updates the last read time.
+			if time.Since(c.tc.lastRead()) > opts.PingInterval+opts.PongTimeout {
+				c.Close()
+				return
+			}
+			c.ws.SetWriteDeadline(time.Now().Add(opts.WriteTimeout))
+			c.ws.PayloadType = websocket.PingFrame
+			_, err := c.ws.Write(nil)
+			c.ws.PayloadType = websocket.TextFrame
+			if err != nil {
+				c.Close()
+				return
+			}
+		case <-c.done:
+			return
+		}
+	}
+}
+
+func newConnID(n uint64) string {
+	return strconv.FormatUint(n, 36)
+}
+
+// trackedConn records the last time data was read from the
+// connection. Since golang.org/x/net/websocket handles pong
+// frames internally, this is the only way to know if the client
+// is still responding to our pings.
+type trackedConn struct {
+	net.Conn
+	last int64
+}
+
+func (c *trackedConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		c.touch()
+	}
+	return n, err
+}
+
+func (c *trackedConn) touch() {
+	atomic.StoreInt64(&c.last, time.Now().UnixNano())
+}
+
+func (c *trackedConn) lastRead() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&c.last))
+}
+
+// hijackWriter wraps the http.ResponseWriter used by the websocket
+// handshake, so the hijacked connection is a *trackedConn.
+type hijackWriter struct {
+	http.ResponseWriter
+	conn *trackedConn
+}
+
+func (w *hijackWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hj, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errNoHijacker
+	}
+	conn, rw, err := hj.Hijack()
+	if err != nil {
+		return nil, nil, err
+	}
+	tc := &trackedConn{Conn: conn}
+	tc.touch()
+	var r io.Reader = tc
+	if n := rw.Reader.Buffered(); n > 0 {
+		// Don't lose any data already read from the connection
+		buffered, _ := rw.Reader.Peek(n)
+		r = io.MultiReader(bytes.NewReader(append([]byte(nil), buffered...)), tc)
+	}
+	w.conn = tc
+	return tc, bufio.NewReadWriter(bufio.NewReader(r), bufio.NewWriter(tc)), nil
+}
@@ -0,0 +1,34 @@
+// Package hub implements a websocket hub for Gondola apps, which
+// manages a set of connections that might join named rooms and
+// receive broadcasts sent to them.
+//
+// Use New to create a Hub and register the app.Handler returned by
+// Hub.Handler in your App. Then, use Signals.Connected to add new
+// connections to their rooms and Hub.Broadcast to send messages to
+// all the connections in a room.
+//
+//  chat, err := hub.New(nil)
+//  if err != nil {
+//	panic(err)
+//  }
+//  hub.Signals.Connected.Listen(func(c *hub.Conn) {
+//	c.Join("lobby")
+//  })
+//  myapp.Handle("^/chat/$", chat.Handler(func(c *hub.Conn, data []byte) {
+//	chat.Broadcast("lobby", data)
+//  }))
+//
+// Each connection has its own send queue (see Options.QueueSize), so
+// slow clients don't block broadcasts. When a queue is full, the
+// connection is either closed or the message is discarded for that
+// connection, depending on Options.DropMessages. Connections are kept
+// alive by sending pings periodically and closed if the client stops
+// responding (see Options.PingInterval and Options.PongTimeout).
+//
+// Broadcasts are sent through a Backplane, so they reach the clients
+// connected to any instance of the Hub, even in other processes. By
+// default, each Hub uses an in-process Backplane which only delivers
+// broadcasts to its own connections. To share broadcasts among several
+// instances of an App, use a shared Backplane, like the one implemented
+// by gnd.la/app/hub/redis, and the same Options.Name in all of them.
+package hub
@@ -0,0 +1,316 @@
+package hub
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"gnd.la/app"
+)
+
+const (
+	// DefaultQueueSize is the default size of the send
+	// queue for each connection.
+	DefaultQueueSize = 64
+	// DefaultPingInterval is the default interval between
+	// pings sent to each connection.
+	DefaultPingInterval = 30 * time.Second
+	// DefaultPongTimeout is the default additional time
+	// given to clients to respond to a ping.
+	DefaultPongTimeout = 10 * time.Second
+	// DefaultWriteTimeout is the default timeout for
+	// writing a message to a connection.
+	DefaultWriteTimeout = 10 * time.Second
+)
+
+var (
+	// ErrHubClosed is returned when broadcasting from
+	// a Hub which has been closed.
+	ErrHubClosed = errors.New("hub is closed")
+)
+
+// MessageHandler is the function type called by a Hub
+// for each message received from a connection.
+type MessageHandler func(c *Conn, data []byte)
+
+// Options specify the options used when creating a Hub. See
+// the documentation on each field for its default value.
+type Options struct {
+	// Name identifies the Hub in its Backplane, so all the
+	// instances of a Hub must use the same name. If empty,
+	// "default" is used.
+	Name string
+	// Backplane is used to distribute broadcasts among all
+	// the instances of the Hub. If nil, a memory Backplane is
+	// used, which only delivers broadcasts to the connections
+	// in this Hub. See NewMemoryBackplane.
+	Backplane Backplane
+	// QueueSize is the maximum number of messages queued for
+	// each connection. If zero, DefaultQueueSize is used.
+	QueueSize int
+	// DropMessages indicates what to do when a broadcast is
+	// sent to a connection with a full queue. If true, the message
+	// is discarded for that connection. Otherwise, the connection is
+	// considered too slow and it's closed.
+	DropMessages bool
+	// PingInterval is the interval between the pings sent to each
+	// connection. If zero, DefaultPingInterval is used. A negative
+	// value disables pings.
+	PingInterval time.Duration
+	// PongTimeout is the additional time given to the client to reply
+	// to a ping. If nothing is received from the client in PingInterval
+	// + PongTimeout, the connection is closed. If zero,
+	// DefaultPongTimeout is used.
+	PongTimeout time.Duration
+	// WriteTimeout is the timeout for sending a message to a client. If
+	// zero, DefaultWriteTimeout is used.
+	WriteTimeout time.Duration
+	// MaxMessageSize is the maximum size of the messages received
+	// from the clients. If zero, the default limit in
+	// golang.org/x/net/websocket is used.
+	MaxMessageSize int
+}
+
+// Hub manages a set of websocket connections, which might
+// join any number of rooms. See the package documentation
+// for more information.
+type Hub struct {
+	opts   Options
+	sub    Subscription
+	mu     sync.RWMutex
+	conns  map[*Conn]struct{}
+	rooms  map[string]map[*Conn]struct{}
+	nextID uint64
+	closed bool
+}
+
+// New returns a new Hub with the given options, which might
+// be nil. It returns an error only if subscribing to the
+// Backplane fails.
+func New(opts *Options) (*Hub, error) {
+	h := &Hub{
+		conns: make(map[*Conn]struct{}),
+		rooms: make(map[string]map[*Conn]struct{}),
+	}
+	if opts != nil {
+		h.opts = *opts
+	}
+	if h.opts.Name == "" {
+		h.opts.Name = "default"
+	}
+	if h.opts.Backplane == nil {
+		h.opts.Backplane = NewMemoryBackplane()
+	}
+	if h.opts.QueueSize <= 0 {
+		h.opts.QueueSize = DefaultQueueSize
+	}
+	if h.opts.PingInterval == 0 {
+		h.opts.PingInterval = DefaultPingInterval
+	}
+	if h.opts.PongTimeout <= 0 {
+		h.opts.PongTimeout = DefaultPongTimeout
+	}
+	if h.opts.WriteTimeout <= 0 {
+		h.opts.WriteTimeout = DefaultWriteTimeout
+	}
+	sub, err := h.opts.Backplane.Subscribe(h.opts.Name, h.deliver)
+	if err != nil {
+		return nil, err
+	}
+	h.sub = sub
+	return h, nil
+}
+
+// Name returns the Hub name. See Options.Name.
+func (h *Hub) Name() string {
+	return h.opts.Name
+}
+
+// Handler returns an app.Handler which upgrades the request to a
+// websocket and adds the connection to the Hub. The handler function
+// is called for each message received from the connection, and might
+// be nil if the clients are not expected to send any messages. Note
+// that the returned app.Handler must be registered with App.Handle,
+// rather than with App.HandleWebsocket.
+//
+// Use Signals.Connected to be notified when a connection is added to
+// the Hub (e.g. to make it join its initial rooms).
+func (h *Hub) Handler(handler MessageHandler) app.Handler {
+	return func(ctx *app.Context) {
+		w := &hijackWriter{ResponseWriter: ctx.ResponseWriter}
+		ctx.ResponseWriter = w
+		app.WebsocketHandler(func(ctx *app.Context) {
+			h.serve(ctx, ctx.Websocket(), w.conn, handler)
+		})(ctx)
+	}
+}
+
+func (h *Hub) serve(ctx *app.Context, ws *websocket.Conn, tc *trackedConn, handler MessageHandler) {
+	if h.opts.MaxMessageSize > 0 {
+		ws.MaxPayloadBytes = h.opts.MaxMessageSize
+	}
+	c := &Conn{
+		hub:   h,
+		ctx:   ctx,
+		ws:    ws,
+		tc:    tc,
+		send:  make(chan []byte, h.opts.QueueSize),
+		done:  make(chan struct{}),
+		rooms: make(map[string]struct{}),
+	}
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		ws.Close()
+		return
+	}
+	h.nextID++
+	c.id = newConnID(h.nextID)
+	h.conns[c] = struct{}{}
+	h.mu.Unlock()
+	Signals.Connected.emit(c)
+	go c.writeLoop()
+	c.readLoop(handler)
+	c.Close()
+	h.mu.Lock()
+	delete(h.conns, c)
+	rooms := make([]string, 0, len(c.rooms))
+	for k := range c.rooms {
+		h.removeFromRoom(c, k)
+		rooms = append(rooms, k)
+	}
+	h.mu.Unlock()
+	for _, v := range rooms {
+		Signals.Left.emit(c, v)
+	}
+	Signals.Disconnected.emit(c)
+}
+
+func (h *Hub) join(c *Conn, room string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := c.rooms[room]; ok {
+		return false
+	}
+	if _, ok := h.conns[c]; !ok {
+		// Already disconnected
+		return false
+	}
+	conns := h.rooms[room]
+	if conns == nil {
+		conns = make(map[*Conn]struct{})
+		h.rooms[room] = conns
+	}
+	conns[c] = struct{}{}
+	c.rooms[room] = struct{}{}
+	return true
+}
+
+func (h *Hub) leave(c *Conn, room string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := c.rooms[room]; !ok {
+		return false
+	}
+	h.removeFromRoom(c, room)
+	return true
+}
+
+// removeFromRoom must be called with h.mu held
+func (h *Hub) removeFromRoom(c *Conn, room string) {
+	delete(c.rooms, room)
+	if conns := h.rooms[room]; conns != nil {
+		delete(conns, c)
+		if len(conns) == 0 {
+			delete(h.rooms, room)
+		}
+	}
+}
+
+// Conns returns the connections to this Hub instance in the given
+// room. If room is empty, all the connections are returned. Note
+// that connections to other instances of the Hub are not included.
+func (h *Hub) Conns(room string) []*Conn {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	conns := h.conns
+	if room != "" {
+		conns = h.rooms[room]
+	}
+	ret := make([]*Conn, 0, len(conns))
+	for k := range conns {
+		ret = append(ret, k)
+	}
+	return ret
+}
+
+// Count returns the number of connections to this Hub instance in
+// the given room. If room is empty, all the connections are counted.
+func (h *Hub) Count(room string) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if room != "" {
+		return len(h.rooms[room])
+	}
+	return len(h.conns)
+}
+
+// Broadcast sends data to all the connections in the given room,
+// in all the instances of the Hub. If room is empty, data is sent
+// to all the connections. See Options.DropMessages to learn what
+// happens with connections which can't keep up with the broadcasts.
+func (h *Hub) Broadcast(room string, data []byte) error {
+	h.mu.RLock()
+	closed := h.closed
+	h.mu.RUnlock()
+	if closed {
+		return ErrHubClosed
+	}
+	return h.opts.Backplane.Publish(h.opts.Name, &Message{Room: room, Data: data})
+}
+
+// BroadcastJSON encodes v as JSON and sends it using Broadcast.
+func (h *Hub) BroadcastJSON(room string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return h.Broadcast(room, data)
+}
+
+// deliver sends a message received from the Backplane to
+// the local connections.
+func (h *Hub) deliver(msg *Message) {
+	conns := h.Conns(msg.Room)
+	for _, v := range conns {
+		if err := v.Send(msg.Data); err == ErrQueueFull && !h.opts.DropMessages {
+			v.Close()
+		}
+	}
+}
+
+// Close stops receiving broadcasts from the Backplane and closes
+// all the connections to this Hub instance. Since websocket
+// connections are not waited for when the App shuts down,
+// apps should usually close their hubs on shutdown:
+//
+//  app.Signals.WillShutdown.Listen(func(_ *app.App) {
+//	myHub.Close()
+//  })
+func (h *Hub) Close() error {
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		return nil
+	}
+	h.closed = true
+	h.mu.Unlock()
+	err := h.sub.Close()
+	for _, v := range h.Conns("") {
+		v.Close()
+	}
+	return err
+}
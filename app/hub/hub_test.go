@@ -0,0 +1,224 @@
+package hub
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"gnd.la/app"
+)
+
+type testServer struct {
+	*httptest.Server
+	hub *Hub
+}
+
+func newTestServer(t *testing.T, opts *Options) *testServer {
+	h, err := New(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := app.New()
+	a.Handle("^/ws/$", h.Handler(func(c *Conn, data []byte) {
+		msg := string(data)
+		switch {
+		case strings.HasPrefix(msg, "join "):
+			c.Join(msg[5:])
+			c.Send([]byte("joined " + msg[5:]))
+		case strings.HasPrefix(msg, "leave "):
+			c.Leave(msg[6:])
+			c.Send([]byte("left " + msg[6:]))
+		default:
+			p := strings.SplitN(msg, " ", 2)
+			h.Broadcast(p[0], []byte(p[1]))
+		}
+	}))
+	if err := a.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	return &testServer{Server: httptest.NewServer(a), hub: h}
+}
+
+func (s *testServer) dial(t *testing.T) *websocket.Conn {
+	u := "ws" + strings.TrimPrefix(s.URL, "http") + "/ws/"
+	ws, err := websocket.Dial(u, "", s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ws
+}
+
+func send(t *testing.T, ws *websocket.Conn, msg string) {
+	if err := websocket.Message.Send(ws, msg); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func expect(t *testing.T, ws *websocket.Conn, msg string) {
+	ws.SetReadDeadline(time.Now().Add(2 * time.Second))
+	var got string
+	if err := websocket.Message.Receive(ws, &got); err != nil {
+		t.Fatalf("error receiving %q: %s", msg, err)
+	}
+	if got != msg {
+		t.Fatalf("expecting message %q, got %q", msg, got)
+	}
+}
+
+func TestRooms(t *testing.T) {
+	s := newTestServer(t, nil)
+	defer s.Close()
+	defer s.hub.Close()
+	c1 := s.dial(t)
+	defer c1.Close()
+	c2 := s.dial(t)
+	defer c2.Close()
+	send(t, c1, "join a")
+	expect(t, c1, "joined a")
+	send(t, c2, "join b")
+	expect(t, c2, "joined b")
+	if n := s.hub.Count("a"); n != 1 {
+		t.Errorf("expecting 1 connection in room a, got %d", n)
+	}
+	send(t, c2, "a hello a")
+	expect(t, c1, "hello a")
+	send(t, c1, "b hello b")
+	expect(t, c2, "hello b")
+	send(t, c1, " hello all")
+	expect(t, c1, "hello all")
+	expect(t, c2, "hello all")
+	send(t, c1, "leave a")
+	expect(t, c1, "left a")
+	if n := s.hub.Count("a"); n != 0 {
+		t.Errorf("expecting no connections in room a, got %d", n)
+	}
+}
+
+func TestBackplane(t *testing.T) {
+	bp := NewMemoryBackplane()
+	s1 := newTestServer(t, &Options{Backplane: bp})
+	defer s1.Close()
+	s2 := newTestServer(t, &Options{Backplane: bp})
+	defer s2.Close()
+	c1 := s1.dial(t)
+	defer c1.Close()
+	c2 := s2.dial(t)
+	defer c2.Close()
+	send(t, c2, "join room")
+	expect(t, c2, "joined room")
+	send(t, c1, "room from another instance")
+	expect(t, c2, "from another instance")
+	s2.hub.Close()
+	if err := s2.hub.Broadcast("room", nil); err != ErrHubClosed {
+		t.Errorf("expecting ErrHubClosed, got %v", err)
+	}
+	s1.hub.Close()
+}
+
+func TestSignals(t *testing.T) {
+	s := newTestServer(t, nil)
+	defer s.Close()
+	defer s.hub.Close()
+	connected := make(chan *Conn, 1)
+	disconnected := make(chan *Conn, 1)
+	left := make(chan string, 1)
+	listeners := []interface {
+		Remove()
+	}{
+		Signals.Connected.Listen(func(c *Conn) {
+			if c.Hub() == s.hub {
+				c.Join("initial")
+				connected <- c
+			}
+		}),
+		Signals.Disconnected.Listen(func(c *Conn) {
+			if c.Hub() == s.hub {
+				disconnected <- c
+			}
+		}),
+		Signals.Left.Listen(func(c *Conn, room string) {
+			if c.Hub() == s.hub {
+				left <- room
+			}
+		}),
+	}
+	defer func() {
+		for _, v := range listeners {
+			v.Remove()
+		}
+	}()
+	ws := s.dial(t)
+	var c *Conn
+	select {
+	case c = <-connected:
+	case <-time.After(2 * time.Second):
+		t.Fatal("connected signal not emitted")
+	}
+	if rooms := c.Rooms(); len(rooms) != 1 || rooms[0] != "initial" {
+		t.Errorf("expecting rooms [initial], got %v", rooms)
+	}
+	ws.Close()
+	select {
+	case room := <-left:
+		if room != "initial" {
+			t.Errorf("expecting to leave room initial, got %q", room)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("left signal not emitted")
+	}
+	select {
+	case dc := <-disconnected:
+		if dc != c {
+			t.Error("disconnected signal emitted with another connection")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("disconnected signal not emitted")
+	}
+	if err := c.Send([]byte("foo")); err != ErrClosed {
+		t.Errorf("expecting ErrClosed, got %v", err)
+	}
+	if n := s.hub.Count(""); n != 0 {
+		t.Errorf("expecting no connections, got %d", n)
+	}
+}
+
+func TestKeepalive(t *testing.T) {
+	s := newTestServer(t, &Options{
+		PingInterval: 20 * time.Millisecond,
+		PongTimeout:  20 * time.Millisecond,
+	})
+	defer s.Close()
+	defer s.hub.Close()
+	// This client reads continuously, so it replies to pings
+	alive := s.dial(t)
+	defer alive.Close()
+	go func() {
+		var msg string
+		for websocket.Message.Receive(alive, &msg) == nil {
+		}
+	}()
+	// This one never reads, so it never replies to pings
+	dead := s.dial(t)
+	defer dead.Close()
+	deadline := time.Now().Add(2 * time.Second)
+	for s.hub.Count("") != 1 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if n := s.hub.Count(""); n != 1 {
+		t.Errorf("expecting 1 live connection, got %d", n)
+	}
+}
+
+func TestBackpressure(t *testing.T) {
+	c := &Conn{send: make(chan []byte, 1), done: make(chan struct{})}
+	if err := c.Send([]byte("1")); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Send([]byte("2")); err != ErrQueueFull {
+		t.Errorf("expecting ErrQueueFull, got %v", err)
+	}
+}
@@ -0,0 +1,191 @@
+// Package redis implements a gnd.la/app/hub.Backplane using redis
+// pub/sub, so broadcasts reach the clients connected to any instance
+// of a Hub.
+//
+// The URL format for this backplane is:
+//
+//  - redis://host[:port][#password={pw}&prefix={prefix}]
+//
+// Each Hub publishes its messages in the channel named {prefix}{hub name},
+// where prefix defaults to DefaultPrefix.
+//
+//  u, err := config.ParseURL("redis://localhost")
+//  if err != nil {
+//	panic(err)
+//  }
+//  bp, err := redis.New(u)
+//  if err != nil {
+//	panic(err)
+//  }
+//  chat, err := hub.New(&hub.Options{Name: "chat", Backplane: bp})
+package redis
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"gnd.la/app/hub"
+	"gnd.la/cache/driver"
+	"gnd.la/config"
+	"gnd.la/log"
+
+	"github.com/garyburd/redigo/redis"
+)
+
+const (
+	// DefaultPrefix is the default prefix for the channel names.
+	DefaultPrefix = "gondola-hub:"
+	// reconnectInterval is the time to wait before reconnecting
+	// a subscription after an error.
+	reconnectInterval = time.Second
+)
+
+// Backplane implements gnd.la/app/hub.Backplane using redis pub/sub.
+// Use New to create a Backplane.
+type Backplane struct {
+	pool   *redis.Pool
+	dial   func() (redis.Conn, error)
+	prefix string
+}
+
+// New returns a new *Backplane from the given URL. See the package
+// documentation for the URL format.
+func New(url *config.URL) (*Backplane, error) {
+	password := url.Fragment.Get("password")
+	prefix := url.Fragment.Get("prefix")
+	if prefix == "" {
+		prefix = DefaultPrefix
+	}
+	server := driver.DefaultPort(url.Value, 6379)
+	dial := func() (redis.Conn, error) {
+		c, err := redis.Dial("tcp", server)
+		if err != nil {
+			return nil, err
+		}
+		if password != "" {
+			if _, err := c.Do("AUTH", password); err != nil {
+				c.Close()
+				return nil, err
+			}
+		}
+		return c, nil
+	}
+	return &Backplane{
+		pool: &redis.Pool{
+			Dial:        dial,
+			MaxIdle:     2,
+			IdleTimeout: 300 * time.Second,
+		},
+		dial:   dial,
+		prefix: prefix,
+	}, nil
+}
+
+// Publish implements gnd.la/app/hub.Backplane.
+func (b *Backplane) Publish(name string, msg *hub.Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	conn := b.pool.Get()
+	_, err = conn.Do("PUBLISH", b.prefix+name, data)
+	conn.Close()
+	return err
+}
+
+// Subscribe implements gnd.la/app/hub.Backplane. Each subscription
+// uses its own connection to redis, which is automatically
+// reestablished if it fails.
+func (b *Backplane) Subscribe(name string, f func(msg *hub.Message)) (hub.Subscription, error) {
+	s := &subscription{
+		b:       b,
+		channel: b.prefix + name,
+		f:       f,
+	}
+	if err := s.connect(); err != nil {
+		return nil, err
+	}
+	go s.receive()
+	return s, nil
+}
+
+// Close closes the connection pool used for publishing messages.
+// Subscriptions must be closed independently.
+func (b *Backplane) Close() error {
+	return b.pool.Close()
+}
+
+type subscription struct {
+	b       *Backplane
+	channel string
+	f       func(*hub.Message)
+	mu      sync.Mutex
+	psc     *redis.PubSubConn
+	closed  bool
+}
+
+func (s *subscription) connect() error {
+	conn, err := s.b.dial()
+	if err != nil {
+		return err
+	}
+	psc := &redis.PubSubConn{Conn: conn}
+	if err := psc.Subscribe(s.channel); err != nil {
+		conn.Close()
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return psc.Close()
+	}
+	s.psc = psc
+	return nil
+}
+
+func (s *subscription) receive() {
+	for {
+		s.mu.Lock()
+		psc := s.psc
+		s.mu.Unlock()
+		switch v := psc.Receive().(type) {
+		case redis.Message:
+			var msg hub.Message
+			if err := json.Unmarshal(v.Data, &msg); err != nil {
+				log.Errorf("error decoding hub message from channel %s: %s", v.Channel, err)
+				continue
+			}
+			s.f(&msg)
+		case error:
+			psc.Close()
+			for {
+				s.mu.Lock()
+				closed := s.closed
+				s.mu.Unlock()
+				if closed {
+					return
+				}
+				log.Warningf("error receiving from channel %s, reconnecting: %s", s.channel, v)
+				time.Sleep(reconnectInterval)
+				err := s.connect()
+				if err == nil {
+					break
+				}
+				v = err
+			}
+		}
+	}
+}
+
+// Close implements gnd.la/app/hub.Subscription.
+func (s *subscription) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	s.psc.Unsubscribe()
+	return s.psc.Close()
+}
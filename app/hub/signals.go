@@ -0,0 +1,61 @@
+package hub
+
+import (
+	"gnd.la/signals"
+)
+
+type connSignal struct {
+	signal *signals.Signal
+}
+
+func (s *connSignal) Listen(handler func(c *Conn)) signals.Listener {
+	return s.signal.Listen(func(data interface{}) {
+		handler(data.(*Conn))
+	})
+}
+
+func (s *connSignal) emit(c *Conn) {
+	s.signal.Emit(c)
+}
+
+type roomEvent struct {
+	conn *Conn
+	room string
+}
+
+type roomSignal struct {
+	signal *signals.Signal
+}
+
+func (s *roomSignal) Listen(handler func(c *Conn, room string)) signals.Listener {
+	return s.signal.Listen(func(data interface{}) {
+		ev := data.(*roomEvent)
+		handler(ev.conn, ev.room)
+	})
+}
+
+func (s *roomSignal) emit(c *Conn, room string) {
+	s.signal.Emit(&roomEvent{conn: c, room: room})
+}
+
+// Signals declares the signals emitted by this package. Use Conn.Hub
+// to determine the Hub which emitted the signal. See gnd.la/signals
+// for more information.
+var Signals = struct {
+	// Connected is emitted after a new connection has been
+	// added to a Hub, before any messages are received from it.
+	Connected *connSignal
+	// Disconnected is emitted after a connection has been
+	// closed and removed from its Hub.
+	Disconnected *connSignal
+	// Joined is emitted when a connection joins a room.
+	Joined *roomSignal
+	// Left is emitted when a connection leaves a room, either
+	// explicitly or because it was closed.
+	Left *roomSignal
+}{
+	Connected:    &connSignal{signals.New("connected")},
+	Disconnected: &connSignal{signals.New("disconnected")},
+	Joined:       &roomSignal{signals.New("joined")},
+	Left:         &roomSignal{signals.New("left")},
+}
@@ -0,0 +1,46 @@
+package app_test
+
+import (
+	"testing"
+
+	"gnd.la/app"
+	"gnd.la/app/tester"
+)
+
+func TestMethods(t *testing.T) {
+	a := app.New()
+	a.Handle("^/article/(\\d+)/$", func(ctx *app.Context) {
+		ctx.WriteString("get " + ctx.IndexValue(0))
+	}, app.NamedHandler("article"), app.MethodHandler("GET"))
+	a.Handle("^/article/(\\d+)/$", func(ctx *app.Context) {
+		ctx.WriteString("post " + ctx.IndexValue(0))
+	}, app.NamedHandler("article"), app.MethodHandler("POST"))
+	a.Handle("^/article/(\\d+)/$", func(ctx *app.Context) {
+		ctx.WriteString("delete " + ctx.IndexValue(0))
+	}, app.NamedHandler("delete-article"), app.MethodHandler("delete"))
+	a.Handle("^/any/$", func(ctx *app.Context) {
+		ctx.WriteString(ctx.R.Method)
+	})
+	tt := tester.New(t, a)
+	tt.Get("/article/1/", nil).Expect(200).Expect("get 1")
+	tt.Request("HEAD", "/article/1/", nil).Expect(200)
+	tt.Post("/article/2/", nil).Expect(200).Expect("post 2")
+	tt.Request("DELETE", "/article/3/", nil).Expect(200).Expect("delete 3")
+	allow := "DELETE, GET, HEAD, OPTIONS, POST"
+	tt.Request("PUT", "/article/4/", nil).Expect(405).ExpectHeader("Allow", allow)
+	tt.Request("OPTIONS", "/article/4/", nil).Expect(200).ExpectHeader("Allow", allow)
+	tt.Request("PUT", "/article/foo/", nil).Expect(404)
+	tt.Request("PUT", "/any/", nil).Expect(200).Expect("PUT")
+	tt.Request("OPTIONS", "/any/", nil).Expect(200).Expect("OPTIONS")
+	testReverse(t, "/article/5/", a, "article", 5)
+	testReverse(t, "/article/6/", a, "delete-article", 6)
+}
+
+func testReverse(t *testing.T, expected string, a *app.App, name string, args ...interface{}) {
+	rev, err := a.Reverse(name, args...)
+	if err != nil {
+		t.Error(err)
+	} else if rev != expected {
+		t.Errorf("expecting %q reversing %s, got %q", expected, name, rev)
+	}
+}
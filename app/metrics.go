@@ -0,0 +1,279 @@
+package app
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// unnamedHandler is the name used in metrics for
+	// handlers registered without a name.
+	unnamedHandler = "(unnamed)"
+)
+
+var (
+	// latencyBuckets are the upper bounds, in seconds, of the
+	// buckets used for the request latency histograms.
+	latencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+	statusClasses  = []string{"1xx", "2xx", "3xx", "4xx", "5xx"}
+)
+
+// handlerMetrics holds the metrics for a handler. All its
+// fields are updated atomically, so recording a request
+// never blocks.
+type handlerMetrics struct {
+	name      string
+	inFlight  int64
+	requests  [5]uint64
+	fromCache uint64
+	bytes     uint64
+	// latency holds the non-cumulative count for each
+	// bucket, with an additional one for +Inf.
+	latency    [12]uint64
+	latencySum uint64 // in nanoseconds
+}
+
+func (h *handlerMetrics) begin() {
+	atomic.AddInt64(&h.inFlight, 1)
+}
+
+// cancel undoes a previous call to begin, without
+// recording the request.
+func (h *handlerMetrics) cancel() {
+	atomic.AddInt64(&h.inFlight, -1)
+}
+
+func (h *handlerMetrics) end(ctx *Context) {
+	atomic.AddInt64(&h.inFlight, -1)
+	code := ctx.statusCode
+	if code < 0 {
+		code = -code
+	}
+	if code == 0 {
+		code = http.StatusOK
+	}
+	if class := code/100 - 1; class >= 0 && class < len(h.requests) {
+		atomic.AddUint64(&h.requests[class], 1)
+	}
+	if ctx.ServedFromCache() {
+		atomic.AddUint64(&h.fromCache, 1)
+	}
+	atomic.AddUint64(&h.bytes, uint64(ctx.written))
+	elapsed := ctx.Elapsed()
+	atomic.AddUint64(&h.latencySum, uint64(elapsed))
+	secs := elapsed.Seconds()
+	bucket := len(latencyBuckets)
+	for ii, v := range latencyBuckets {
+		if secs <= v {
+			bucket = ii
+			break
+		}
+	}
+	atomic.AddUint64(&h.latency[bucket], 1)
+}
+
+// handlerSnapshot is a point in time copy of the metrics for
+// a handler. It's used for exporting the metrics.
+type handlerSnapshot struct {
+	Name      string            `json:"name"`
+	InFlight  int64             `json:"in_flight"`
+	Requests  map[string]uint64 `json:"requests"`
+	Total     uint64            `json:"total"`
+	FromCache uint64            `json:"from_cache"`
+	Bytes     uint64            `json:"bytes"`
+	// Buckets are cumulative, as in Prometheus
+	Buckets []uint64      `json:"-"`
+	Latency time.Duration `json:"latency"`
+}
+
+func (h *handlerMetrics) snapshot() *handlerSnapshot {
+	s := &handlerSnapshot{
+		Name:      h.name,
+		InFlight:  atomic.LoadInt64(&h.inFlight),
+		Requests:  make(map[string]uint64, len(statusClasses)),
+		FromCache: atomic.LoadUint64(&h.fromCache),
+		Bytes:     atomic.LoadUint64(&h.bytes),
+		Latency:   time.Duration(atomic.LoadUint64(&h.latencySum)),
+	}
+	for ii, v := range statusClasses {
+		count := atomic.LoadUint64(&h.requests[ii])
+		s.Requests[v] = count
+		s.Total += count
+	}
+	var cumulative uint64
+	for ii := range h.latency {
+		cumulative += atomic.LoadUint64(&h.latency[ii])
+		s.Buckets = append(s.Buckets, cumulative)
+	}
+	return s
+}
+
+// metrics records the metrics for all the handlers in an App.
+type metrics struct {
+	mu       sync.RWMutex
+	handlers map[string]*handlerMetrics
+}
+
+func newMetrics() *metrics {
+	return &metrics{handlers: make(map[string]*handlerMetrics)}
+}
+
+func (m *metrics) handler(name string) *handlerMetrics {
+	if name == "" {
+		name = unnamedHandler
+	}
+	m.mu.RLock()
+	h := m.handlers[name]
+	m.mu.RUnlock()
+	if h == nil {
+		m.mu.Lock()
+		if h = m.handlers[name]; h == nil {
+			h = &handlerMetrics{name: name}
+			m.handlers[name] = h
+		}
+		m.mu.Unlock()
+	}
+	return h
+}
+
+// snapshot returns the metrics for all the handlers
+// which have served at least one request, sorted
+// by name.
+func (m *metrics) snapshot() []*handlerSnapshot {
+	m.mu.RLock()
+	snapshots := make([]*handlerSnapshot, 0, len(m.handlers))
+	for _, v := range m.handlers {
+		snapshots = append(snapshots, v.snapshot())
+	}
+	m.mu.RUnlock()
+	sort.Sort(handlerSnapshots(snapshots))
+	return snapshots
+}
+
+type handlerSnapshots []*handlerSnapshot
+
+func (h handlerSnapshots) Len() int           { return len(h) }
+func (h handlerSnapshots) Less(i, j int) bool { return h[i].Name < h[j].Name }
+func (h handlerSnapshots) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+// enableMetrics starts recording metrics for the handlers
+// in this App, if they weren't already being recorded.
+func (app *App) enableMetrics() {
+	if app.metrics == nil {
+		app.metrics = newMetrics()
+	}
+}
+
+// handlerMetrics returns the metrics for the handler with the given
+// name, or nil if metrics are not enabled. Included apps record
+// their metrics in their parent.
+func (app *App) handlerMetrics(name string) *handlerMetrics {
+	if m := app.root().metrics; m != nil {
+		return m.handler(name)
+	}
+	return nil
+}
+
+// ormQueryCount returns the number of queries performed
+// by the App Orm, or -1 if the Orm hasn't been opened.
+func (app *App) ormQueryCount() int64 {
+	var count int64 = -1
+	app.locked(func() {
+		if app.o != nil {
+			count = int64(app.o.QueryCount())
+		}
+	})
+	return count
+}
+
+func writeMetricsHeader(buf *bytes.Buffer, name string, typ string, help string) {
+	fmt.Fprintf(buf, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+}
+
+func quoteLabel(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, "\n", `\n`, -1)
+	return strings.Replace(s, `"`, `\"`, -1)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// writeMetrics writes the given handler snapshots using
+// the Prometheus text exposition format.
+func writeMetrics(buf *bytes.Buffer, snapshots []*handlerSnapshot, ormQueries int64) {
+	writeMetricsHeader(buf, "gondola_requests_total", "counter", "Number of requests served by each handler, by status class.")
+	for _, v := range snapshots {
+		label := quoteLabel(v.Name)
+		for _, c := range statusClasses {
+			fmt.Fprintf(buf, "gondola_requests_total{handler=\"%s\",code=\"%s\"} %d\n", label, c, v.Requests[c])
+		}
+	}
+	writeMetricsHeader(buf, "gondola_requests_in_flight", "gauge", "Number of requests currently being served by each handler.")
+	for _, v := range snapshots {
+		fmt.Fprintf(buf, "gondola_requests_in_flight{handler=\"%s\"} %d\n", quoteLabel(v.Name), v.InFlight)
+	}
+	writeMetricsHeader(buf, "gondola_request_duration_seconds", "histogram", "Time spent serving requests by each handler.")
+	for _, v := range snapshots {
+		label := quoteLabel(v.Name)
+		for ii, b := range latencyBuckets {
+			fmt.Fprintf(buf, "gondola_request_duration_seconds_bucket{handler=\"%s\",le=\"%s\"} %d\n", label, formatFloat(b), v.Buckets[ii])
+		}
+		count := v.Buckets[len(v.Buckets)-1]
+		fmt.Fprintf(buf, "gondola_request_duration_seconds_bucket{handler=\"%s\",le=\"+Inf\"} %d\n", label, count)
+		fmt.Fprintf(buf, "gondola_request_duration_seconds_sum{handler=\"%s\"} %s\n", label, formatFloat(v.Latency.Seconds()))
+		fmt.Fprintf(buf, "gondola_request_duration_seconds_count{handler=\"%s\"} %d\n", label, count)
+	}
+	writeMetricsHeader(buf, "gondola_response_bytes_total", "counter", "Number of bytes written by each handler.")
+	for _, v := range snapshots {
+		fmt.Fprintf(buf, "gondola_response_bytes_total{handler=\"%s\"} %d\n", quoteLabel(v.Name), v.Bytes)
+	}
+	writeMetricsHeader(buf, "gondola_cache_layer_hits_total", "counter", "Number of requests served from the cache layer by each handler.")
+	for _, v := range snapshots {
+		fmt.Fprintf(buf, "gondola_cache_layer_hits_total{handler=\"%s\"} %d\n", quoteLabel(v.Name), v.FromCache)
+	}
+	if ormQueries >= 0 {
+		writeMetricsHeader(buf, "gondola_orm_queries_total", "counter", "Number of queries performed by the ORM.")
+		fmt.Fprintf(buf, "gondola_orm_queries_total %d\n", ormQueries)
+	}
+}
+
+// metricsAuthorized returns true iff the request includes the
+// credentials required by Config.MetricsSecret, if any.
+func metricsAuthorized(ctx *Context) bool {
+	secret := ctx.app.cfg.MetricsSecret
+	if secret == "" {
+		return true
+	}
+	_, password, ok := ctx.R.BasicAuth()
+	return ok && subtle.ConstantTimeCompare([]byte(password), []byte(secret)) == 1
+}
+
+func metricsHandler(ctx *Context) {
+	if !metricsAuthorized(ctx) {
+		ctx.Header().Set("WWW-Authenticate", `Basic realm="metrics"`)
+		ctx.Error(http.StatusUnauthorized)
+		return
+	}
+	app := ctx.app.root()
+	var snapshots []*handlerSnapshot
+	if app.metrics != nil {
+		snapshots = app.metrics.snapshot()
+	}
+	var buf bytes.Buffer
+	writeMetrics(&buf, snapshots, app.ormQueryCount())
+	header := ctx.Header()
+	header.Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	header.Set("Content-Length", strconv.Itoa(buf.Len()))
+	header.Set("Cache-Control", "no-cache")
+	ctx.Write(buf.Bytes())
+}
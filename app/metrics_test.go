@@ -0,0 +1,57 @@
+package app_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"gnd.la/app"
+	"gnd.la/app/tester"
+
+	"github.com/rainycape/vfs"
+)
+
+func TestMetrics(t *testing.T) {
+	a := app.NewWithConfig(&app.Config{MetricsPath: "/_metrics", MetricsSecret: "secret"})
+	a.Handle("^/hello/$", func(ctx *app.Context) {
+		ctx.WriteString("hello")
+	}, app.NamedHandler("hello"))
+	a.Handle("^/missing/$", func(ctx *app.Context) {
+		ctx.NotFound()
+	}, app.NamedHandler("missing"))
+	tt := tester.New(t, a)
+	tt.Get("/hello/", nil).Expect(200)
+	tt.Get("/hello/", nil).Expect(200)
+	tt.Get("/missing/", nil).Expect(404)
+	tt.Get("/_metrics", nil).Expect(401).ExpectHeader("WWW-Authenticate", `Basic realm="metrics"`)
+	auth := "Basic " + base64.StdEncoding.EncodeToString([]byte("prometheus:secret"))
+	tt.Get("/_metrics", nil).AddHeader("Authorization", auth).Expect(200).
+		ContainsHeader("Content-Type", "text/plain").
+		Contains(`gondola_requests_total{handler="hello",code="2xx"} 2`).
+		Contains(`gondola_requests_total{handler="missing",code="4xx"} 1`).
+		Contains(`gondola_response_bytes_total{handler="hello"} 10`).
+		Contains(`gondola_request_duration_seconds_count{handler="hello"} 2`).
+		Contains(`gondola_request_duration_seconds_bucket{handler="hello",le="+Inf"} 2`).
+		Contains(`gondola_requests_in_flight{handler="(unnamed)"} 1`)
+}
+
+func TestIncludedMetrics(t *testing.T) {
+	a := app.NewWithConfig(&app.Config{MetricsPath: "/_metrics"})
+	fs, err := vfs.Map(map[string]*vfs.File{"container.html": &vfs.File{Data: []byte("{{ app }}")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.SetTemplatesFS(fs)
+	child := app.New()
+	child.Handle("^/hello/$", func(ctx *app.Context) {
+		ctx.WriteString("hello")
+	}, app.NamedHandler("child-hello"))
+	a.Include("/child", "child", child, "container.html")
+	tt := tester.New(t, a)
+	for ii := 0; ii < 3; ii++ {
+		tt.Get("/child/hello/", nil).Expect(200)
+	}
+	// Only the metrics handler itself is in flight
+	tt.Get("/_metrics", nil).Expect(200).
+		Contains(`gondola_requests_total{handler="child-hello",code="2xx"} 3`).
+		Contains(`gondola_requests_in_flight{handler="(unnamed)"} 1`)
+}
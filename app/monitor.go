@@ -23,6 +23,13 @@ func monitorAPIHandler(ctx *Context) {
 	data := map[string]interface{}{
 		"mem": &stats,
 	}
+	app := ctx.app.root()
+	if app.metrics != nil {
+		data["handlers"] = app.metrics.snapshot()
+	}
+	if queries := app.ormQueryCount(); queries >= 0 {
+		data["orm_queries"] = queries
+	}
 	if _, err := ctx.WriteJSON(data); err != nil {
 		panic(err)
 	}
@@ -0,0 +1,201 @@
+package app
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"gnd.la/app/serialize"
+	"gnd.la/encoding/codec"
+)
+
+const (
+	htmlContentType = "text/html"
+)
+
+var (
+	// ErrNotAcceptable is returned from Context.WriteNegotiated when
+	// none of the available content types is acceptable by the client.
+	// In that case, a 406 response has already been sent.
+	ErrNotAcceptable = errors.New("no acceptable content type")
+)
+
+// NegotiateOptions specify the options used by
+// Context.WriteNegotiated.
+type NegotiateOptions struct {
+	// Template, if non-empty, is the template executed with the data
+	// when the client prefers text/html.
+	Template string
+	// ContentTypes restricts the content types offered to the client,
+	// in order of preference. If empty, all the available content
+	// types are offered (see Context.WriteNegotiated).
+	ContentTypes []string
+}
+
+// acceptRange represents a media range in
+// an Accept header.
+type acceptRange struct {
+	typ     string
+	subtype string
+	q       float64
+}
+
+func (r *acceptRange) matches(contentType string) (bool, int) {
+	if r.typ == "*" {
+		return true, 0
+	}
+	slash := strings.IndexByte(contentType, '/')
+	if slash < 0 || contentType[:slash] != r.typ {
+		return false, 0
+	}
+	if r.subtype == "*" {
+		return true, 1
+	}
+	return contentType[slash+1:] == r.subtype, 2
+}
+
+// parseAccept parses the media ranges in an Accept header.
+// Invalid ranges are ignored.
+func parseAccept(accept string) []*acceptRange {
+	var ranges []*acceptRange
+	for _, v := range strings.Split(accept, ",") {
+		params := strings.Split(v, ";")
+		mediaType := strings.ToLower(strings.TrimSpace(params[0]))
+		slash := strings.IndexByte(mediaType, '/')
+		if slash <= 0 || slash == len(mediaType)-1 {
+			continue
+		}
+		r := &acceptRange{typ: mediaType[:slash], subtype: mediaType[slash+1:], q: 1}
+		for _, p := range params[1:] {
+			p = strings.TrimSpace(p)
+			if strings.HasPrefix(p, "q=") {
+				if q, err := strconv.ParseFloat(p[2:], 64); err == nil && q >= 0 && q <= 1 {
+					r.q = q
+				}
+			}
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges
+}
+
+// negotiate returns the offered content type with the highest quality
+// according to the given Accept header, or the empty string if none of
+// them is acceptable. When several content types have the same quality,
+// the first one offered is returned. An empty Accept header accepts
+// any content type.
+func negotiate(accept string, offers []string) string {
+	if strings.TrimSpace(accept) == "" {
+		if len(offers) > 0 {
+			return offers[0]
+		}
+		return ""
+	}
+	ranges := parseAccept(accept)
+	best := ""
+	bestQ := 0.0
+	for _, offer := range offers {
+		// The most specific matching range determines the quality
+		q := 0.0
+		specificity := -1
+		for _, r := range ranges {
+			if ok, s := r.matches(offer); ok && s > specificity {
+				q = r.q
+				specificity = s
+			}
+		}
+		if q > bestQ {
+			best = offer
+			bestQ = q
+		}
+	}
+	return best
+}
+
+// negotiatedWriter writes data using a given
+// content type.
+type negotiatedWriter func(c *Context, data interface{}) (int, error)
+
+func serializeWriter(f serialize.Format) negotiatedWriter {
+	return func(c *Context, data interface{}) (int, error) {
+		return serialize.Write(c, data, f)
+	}
+}
+
+func codecWriter(co *codec.Codec) negotiatedWriter {
+	return func(c *Context, data interface{}) (int, error) {
+		encoded, err := co.Encode(data)
+		if err != nil {
+			return 0, err
+		}
+		header := c.Header()
+		header.Set("Content-Type", co.ContentType)
+		header.Set("Content-Length", strconv.Itoa(len(encoded)))
+		return c.Write(encoded)
+	}
+}
+
+// negotiatedWriters returns the available content types, in order
+// of preference, with their writers.
+func negotiatedWriters(opts *NegotiateOptions) ([]string, map[string]negotiatedWriter) {
+	var offers []string
+	writers := make(map[string]negotiatedWriter)
+	add := func(contentType string, w negotiatedWriter) {
+		if _, ok := writers[contentType]; !ok {
+			offers = append(offers, contentType)
+			writers[contentType] = w
+		}
+	}
+	for _, v := range []serialize.Format{serialize.JSON, serialize.XML} {
+		add(v.ContentType(), serializeWriter(v))
+	}
+	for _, v := range codec.Names() {
+		if co := codec.Get(v); co.ContentType != "" {
+			add(co.ContentType, codecWriter(co))
+		}
+	}
+	if opts != nil && opts.Template != "" {
+		tmpl := opts.Template
+		add(htmlContentType, func(c *Context, data interface{}) (int, error) {
+			return 0, c.Execute(tmpl, data)
+		})
+	}
+	if opts != nil && len(opts.ContentTypes) > 0 {
+		var restricted []string
+		for _, v := range opts.ContentTypes {
+			if writers[v] != nil {
+				restricted = append(restricted, v)
+			}
+		}
+		offers = restricted
+	}
+	return offers, writers
+}
+
+// WriteNegotiated serializes the given data using the content type
+// preferred by the client, according to the request Accept header
+// (including its quality values). The offered content types are, in
+// order of preference:
+//
+//  application/json and application/xml, from gnd.la/app/serialize
+//  any codec registered in gnd.la/encoding/codec with a ContentType
+//  text/html, if opts.Template is non-empty
+//
+// Note that the application/x-msgpack content type is only available
+// when gnd.la/encoding/codec/msgpack has been imported. Use opts.ContentTypes
+// to restrict and reorder the offered content types. opts might be nil.
+//
+// WriteNegotiated always adds Accept to the Vary header. If no content type
+// is acceptable, it replies with a 406 (Not Acceptable) and returns
+// ErrNotAcceptable.
+func (c *Context) WriteNegotiated(data interface{}, opts *NegotiateOptions) (int, error) {
+	c.Header().Add("Vary", "Accept")
+	offers, writers := negotiatedWriters(opts)
+	contentType := negotiate(c.GetHeader("Accept"), offers)
+	if contentType == "" {
+		c.Error(http.StatusNotAcceptable)
+		return 0, ErrNotAcceptable
+	}
+	return writers[contentType](c, data)
+}
@@ -0,0 +1,27 @@
+package app
+
+import (
+	"testing"
+)
+
+func TestNegotiate(t *testing.T) {
+	offers := []string{"application/json", "application/xml", "application/x-msgpack", "text/html"}
+	cases := map[string]string{
+		"":                                    "application/json",
+		"*/*":                                 "application/json",
+		"application/xml":                     "application/xml",
+		"application/*;q=0.5, text/html":      "text/html",
+		"application/x-msgpack, */*;q=0.1":    "application/x-msgpack",
+		"text/html;q=0.9, application/xml":    "application/xml",
+		"application/*, application/json;q=0": "application/xml",
+		"image/png":                           "",
+		"text/*;q=0.2, */*;q=0.1":             "text/html",
+		"invalid, application/xml;q=0.3":      "application/xml",
+		"text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8": "text/html",
+	}
+	for k, v := range cases {
+		if ct := negotiate(k, offers); ct != v {
+			t.Errorf("expecting %q for Accept %q, got %q", v, k, ct)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package app_test
+
+import (
+	"testing"
+
+	"gnd.la/app"
+	"gnd.la/app/tester"
+)
+
+type negotiatedData struct {
+	Name  string `json:"name" xml:"name"`
+	Value int    `json:"value" xml:"value"`
+}
+
+func TestWriteNegotiated(t *testing.T) {
+	a := app.New()
+	a.Handle("^/data/$", app.NegotiatedHandler(func(ctx *app.Context) (interface{}, error) {
+		return &negotiatedData{Name: "foo", Value: 42}, nil
+	}, nil))
+	a.Handle("^/json/$", app.NegotiatedHandler(func(ctx *app.Context) (interface{}, error) {
+		return &negotiatedData{Name: "bar", Value: 1}, nil
+	}, &app.NegotiateOptions{ContentTypes: []string{"application/json"}}))
+	tt := tester.New(t, a)
+	tt.Get("/data/", nil).Expect(200).
+		ExpectHeader("Content-Type", "application/json").
+		ExpectHeader("Vary", "Accept").
+		Expect(`{"name":"foo","value":42}`)
+	tt.Get("/data/", nil).AddHeader("Accept", "application/json;q=0.5, application/xml").Expect(200).
+		ExpectHeader("Content-Type", "application/xml").
+		Expect("<negotiatedData><name>foo</name><value>42</value></negotiatedData>")
+	tt.Get("/data/", nil).AddHeader("Accept", "image/png").Expect(406)
+	tt.Get("/json/", nil).AddHeader("Accept", "application/xml").Expect(406)
+	tt.Get("/json/", nil).AddHeader("Accept", "*/*").Expect(200).Expect(`{"name":"bar","value":1}`)
+}
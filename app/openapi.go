@@ -0,0 +1,460 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"reflect"
+	"regexp/syntax"
+	"strings"
+	"time"
+
+	"gnd.la/util/structs"
+	"gnd.la/util/yaml"
+)
+
+const (
+	// OpenAPIVersion is the version of the OpenAPI specification
+	// used by the documents generated by App.OpenAPI.
+	OpenAPIVersion = "3.0.3"
+
+	alphanumericPattern = "^[a-zA-Z0-9]+$"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	apiErrorType = reflect.TypeOf(APIError{})
+)
+
+// OpenAPIOptions are used to specify the document information
+// when generating OpenAPI documents. See App.OpenAPI.
+type OpenAPIOptions struct {
+	// Title is the title of the API. If empty, "API"
+	// is used.
+	Title string
+	// Version is the version of the API (not the OpenAPI
+	// specification version). If empty, "1.0.0" is used.
+	Version string
+	// Description is an optional description of the API.
+	Description string
+	// Servers is an optional list of base URLs for the API.
+	Servers []string
+}
+
+// openAPIGenerator holds the state while generating an
+// OpenAPI document, mainly the schemas which end up in
+// the components section.
+type openAPIGenerator struct {
+	paths   map[string]map[string]interface{}
+	schemas map[string]interface{}
+	names   map[reflect.Type]string
+}
+
+func (g *openAPIGenerator) addApp(app *App, prefix string) {
+	if app.childInfo != nil {
+		prefix += app.childInfo.prefix
+	}
+	for _, v := range app.handlers {
+		if v.name == "" && v.api == nil {
+			continue
+		}
+		g.addHandler(v, prefix)
+	}
+	for _, v := range app.included {
+		g.addApp(v.app, prefix)
+	}
+}
+
+func (g *openAPIGenerator) addHandler(h *handlerInfo, prefix string) {
+	var p string
+	var params []interface{}
+	pathParams := make(map[string]bool)
+	if h.typed != nil {
+		var buf bytes.Buffer
+		for _, v := range h.typed.parts {
+			if v.typ == nil {
+				buf.WriteString(v.literal)
+				continue
+			}
+			fmt.Fprintf(&buf, "{%s}", v.name)
+			params = append(params, openAPIParameter(v.name, "path", g.schema(v.typ.kind), true, ""))
+			pathParams[v.name] = true
+		}
+		p = buf.String()
+	} else {
+		var names []string
+		p, names = regexpTemplate(h.rc)
+		for _, v := range names {
+			params = append(params, openAPIParameter(v, "path", map[string]interface{}{"type": "string"}, true, ""))
+			pathParams[v] = true
+		}
+	}
+	p = prefix + p
+	methods := h.methods
+	if len(methods) == 0 {
+		methods = []string{"GET"}
+	}
+	item := g.paths[p]
+	if item == nil {
+		item = make(map[string]interface{})
+		g.paths[p] = item
+	}
+	for _, m := range methods {
+		method := strings.ToLower(m)
+		op := make(map[string]interface{})
+		if h.name != "" {
+			op["operationId"] = h.name
+			if len(methods) > 1 {
+				op["operationId"] = h.name + "_" + method
+			}
+		}
+		if h.summary != "" {
+			op["summary"] = h.summary
+		}
+		if h.description != "" {
+			op["description"] = h.description
+		}
+		if len(h.tags) > 0 {
+			op["tags"] = h.tags
+		}
+		opParams := params
+		responses := map[string]interface{}{
+			"default": map[string]interface{}{"description": "Response"},
+		}
+		if h.api != nil {
+			if apiHasBody[m] {
+				op["requestBody"] = map[string]interface{}{
+					"required": true,
+					"content": map[string]interface{}{
+						"application/json": map[string]interface{}{"schema": g.schema(h.api.in)},
+					},
+				}
+			} else {
+				for _, v := range h.api.fields {
+					if pathParams[v.path] {
+						continue
+					}
+					schema := g.schema(v.typ)
+					g.addTagConstraints(schema, v.typ, v.tag)
+					opParams = append(opParams, openAPIParameter(v.path, "query", schema, v.tag.Required(), v.help))
+				}
+			}
+			errResponse := map[string]interface{}{
+				"description": "Error",
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{
+						"schema": map[string]interface{}{
+							"type":       "object",
+							"properties": map[string]interface{}{"error": g.schema(apiErrorType)},
+						},
+					},
+				},
+			}
+			responses = map[string]interface{}{
+				"200": map[string]interface{}{
+					"description": "OK",
+					"content": map[string]interface{}{
+						"application/json": map[string]interface{}{"schema": g.schema(h.api.out)},
+					},
+				},
+				"204":     map[string]interface{}{"description": "No Content"},
+				"400":     errResponse,
+				"default": errResponse,
+			}
+		}
+		if len(opParams) > 0 {
+			op["parameters"] = opParams
+		}
+		op["responses"] = responses
+		item[method] = op
+	}
+}
+
+// schema returns the schema for the given type. Named struct
+// types are added to the components section and a reference to
+// them is returned.
+func (g *openAPIGenerator) schema(typ reflect.Type) map[string]interface{} {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == timeType {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+	switch typ.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case reflect.Float32:
+		return map[string]interface{}{"type": "number", "format": "float"}
+	case reflect.Float64:
+		return map[string]interface{}{"type": "number", "format": "double"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "format": "byte"}
+		}
+		return map[string]interface{}{"type": "array", "items": g.schema(typ.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": g.schema(typ.Elem())}
+	case reflect.Struct:
+		if typ.Name() == "" {
+			return g.structSchema(typ)
+		}
+		name, ok := g.names[typ]
+		if !ok {
+			name = typ.Name()
+			if _, dup := g.schemas[name]; dup {
+				name = path.Base(typ.PkgPath()) + "." + name
+			}
+			g.names[typ] = name
+			// Add the name before generating the schema,
+			// so recursive types work.
+			g.schemas[name] = nil
+			g.schemas[name] = g.structSchema(typ)
+		}
+		return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+	}
+	// Interfaces and any other types, accept anything
+	return map[string]interface{}{}
+}
+
+func (g *openAPIGenerator) structSchema(typ reflect.Type) map[string]interface{} {
+	properties := make(map[string]interface{})
+	var required []string
+	g.addStructFields(typ, properties, &required)
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+func (g *openAPIGenerator) addStructFields(typ reflect.Type, properties map[string]interface{}, required *[]string) {
+	for ii := 0; ii < typ.NumField(); ii++ {
+		field := typ.Field(ii)
+		if field.PkgPath != "" {
+			// Unexported
+			continue
+		}
+		name := field.Tag.Get("json")
+		if p := strings.IndexByte(name, ','); p >= 0 {
+			name = name[:p]
+		}
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			ft := field.Type
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if field.Anonymous && ft.Kind() == reflect.Struct {
+				// Flattened, like encoding/json does
+				g.addStructFields(ft, properties, required)
+				continue
+			}
+			name = field.Name
+		}
+		tag := structs.NewTag(field, apiTags)
+		schema := g.schema(field.Type)
+		g.addTagConstraints(schema, field.Type, tag)
+		if help := field.Tag.Get("help"); help != "" {
+			if _, ok := schema["$ref"]; !ok {
+				schema["description"] = help
+			}
+		}
+		properties[name] = schema
+		if tag.Required() {
+			*required = append(*required, name)
+		}
+	}
+}
+
+func (g *openAPIGenerator) addTagConstraints(schema map[string]interface{}, typ reflect.Type, tag *structs.Tag) {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.String {
+		return
+	}
+	if maxlen, ok := tag.MaxLength(); ok {
+		schema["maxLength"] = maxlen
+	}
+	if minlen, ok := tag.MinLength(); ok {
+		schema["minLength"] = minlen
+	}
+	if tag.Alphanumeric() {
+		schema["pattern"] = alphanumericPattern
+	}
+}
+
+func openAPIParameter(name string, in string, schema map[string]interface{}, required bool, description string) map[string]interface{} {
+	param := map[string]interface{}{
+		"name":   name,
+		"in":     in,
+		"schema": schema,
+	}
+	if required {
+		param["required"] = true
+	}
+	if description != "" {
+		param["description"] = description
+	}
+	return param
+}
+
+// regexpTemplate returns the OpenAPI path template for the given
+// regular expression, as well as the names of its parameters. Named
+// groups use their own name, while unnamed groups are named after
+// their index (e.g. param1). Optional parts of the pattern are omitted.
+func regexpTemplate(re *regexpCache) (string, []string) {
+	var buf bytes.Buffer
+	var names []string
+	var stack []*syntax.Regexp
+	walk(re.re, func(r *syntax.Regexp) bool {
+		if r == nil {
+			stack = stack[:len(stack)-1]
+			return false
+		}
+		skip := false
+		for _, v := range stack {
+			if v.Op == syntax.OpCapture || v.Op == syntax.OpQuest || v.Op == syntax.OpStar ||
+				(v.Op == syntax.OpRepeat && v.Min == 0) {
+				skip = true
+				break
+			}
+		}
+		stack = append(stack, r)
+		if skip {
+			return false
+		}
+		switch r.Op {
+		case syntax.OpLiteral:
+			for _, ru := range r.Rune {
+				buf.WriteRune(ru)
+			}
+		case syntax.OpCapture:
+			name := r.Name
+			if name == "" {
+				name = fmt.Sprintf("param%d", r.Cap)
+			}
+			fmt.Fprintf(&buf, "{%s}", name)
+			names = append(names, name)
+		}
+		return false
+	})
+	return buf.String(), names
+}
+
+// OpenAPI returns an OpenAPI 3 document describing the handlers in the App
+// (including the ones in its included apps), which might be encoded as JSON
+// or YAML. Only named handlers and handlers registered with App.HandleAPI are
+// included. Handlers might be documented with DescribedHandler and TaggedHandler.
+//
+// Paths are generated from the handler patterns. Typed patterns generate typed
+// parameters, while capturing groups in regular expressions generate string
+// parameters, named after the group name (e.g. (?P<id>\d+)) or its index (e.g.
+// param1). Handlers without any HTTP methods are documented as GET.
+//
+// For API handlers, the request and response types are converted to JSON schemas
+// by reflection, honoring their json struct tags as well as the required,
+// max_length, min_length and alphanumeric options in their form or gondola tags.
+// Fields might be documented using a help tag. Requests for POST, PUT, PATCH and
+// DELETE are documented as JSON bodies, while the rest use query parameters.
+// See APIHandler for more information.
+//
+// opts might be nil, in which case the default values are used. See
+// OpenAPIOptions for more information.
+func (app *App) OpenAPI(opts *OpenAPIOptions) map[string]interface{} {
+	if opts == nil {
+		opts = &OpenAPIOptions{}
+	}
+	info := map[string]interface{}{
+		"title":   opts.Title,
+		"version": opts.Version,
+	}
+	if opts.Title == "" {
+		info["title"] = "API"
+	}
+	if opts.Version == "" {
+		info["version"] = "1.0.0"
+	}
+	if opts.Description != "" {
+		info["description"] = opts.Description
+	}
+	g := &openAPIGenerator{
+		paths:   make(map[string]map[string]interface{}),
+		schemas: make(map[string]interface{}),
+		names:   make(map[reflect.Type]string),
+	}
+	g.addApp(app, "")
+	doc := map[string]interface{}{
+		"openapi": OpenAPIVersion,
+		"info":    info,
+		"paths":   g.paths,
+	}
+	if len(opts.Servers) > 0 {
+		servers := make([]interface{}, len(opts.Servers))
+		for ii, v := range opts.Servers {
+			servers[ii] = map[string]interface{}{"url": v}
+		}
+		doc["servers"] = servers
+	}
+	if len(g.schemas) > 0 {
+		doc["components"] = map[string]interface{}{"schemas": g.schemas}
+	}
+	return doc
+}
+
+// MarshalOpenAPI returns the document returned by App.OpenAPI encoded
+// with the given format, which must be either "json" or "yaml".
+func (app *App) MarshalOpenAPI(opts *OpenAPIOptions, format string) ([]byte, error) {
+	doc := app.OpenAPI(opts)
+	switch format {
+	case "json":
+		return json.MarshalIndent(doc, "", "  ")
+	case "yaml":
+		return yaml.Marshal(doc)
+	}
+	return nil, fmt.Errorf("invalid OpenAPI format %q, must be json or yaml", format)
+}
+
+// OpenAPIHandler returns a Handler which serves the OpenAPI document
+// for the App, generated with the given options. The document is
+// encoded as YAML if the request path ends with .yaml or .yml, or
+// as JSON otherwise.
+//
+//  myapp.Handle("^/openapi\\.(json|yaml)$", app.OpenAPIHandler(&app.OpenAPIOptions{Title: "My API"}))
+func OpenAPIHandler(opts *OpenAPIOptions) Handler {
+	return func(ctx *Context) {
+		a := ctx.App()
+		for a.parent != nil {
+			a = a.parent
+		}
+		format := "json"
+		contentType := "application/json"
+		if ctx.R != nil {
+			if ext := path.Ext(ctx.R.URL.Path); ext == ".yaml" || ext == ".yml" {
+				format = "yaml"
+				contentType = "application/x-yaml"
+			}
+		}
+		data, err := a.MarshalOpenAPI(opts, format)
+		if err != nil {
+			panic(err)
+		}
+		ctx.SetHeader("Content-Type", contentType)
+		ctx.WriteHeader(http.StatusOK)
+		ctx.Write(data)
+	}
+}
@@ -0,0 +1,109 @@
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+type openAPIItem struct {
+	ID       int64          `json:"id"`
+	Name     string         `json:"name" form:",required,max_length=16" help:"Name of the item"`
+	Children []*openAPIItem `json:"children,omitempty"`
+}
+
+func TestRegexpTemplate(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		template string
+		params   []string
+	}{
+		{"^/$", "/", nil},
+		{"^/articles/(\\d+)/([\\w\\-]+)/$", "/articles/{param1}/{param2}/", []string{"param1", "param2"}},
+		{"^/users/(?P<id>\\d+)/(?:edit/)?$", "/users/{id}/", []string{"id"}},
+	}
+	for _, v := range tests {
+		a := New()
+		a.Handle(v.pattern, func(ctx *Context) {})
+		template, params := regexpTemplate(a.handlers[0].rc)
+		if template != v.template || !reflect.DeepEqual(params, v.params) {
+			t.Errorf("expecting template %q with params %v for %q, got %q and %v", v.template, v.params, v.pattern, template, params)
+		}
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	a := New()
+	a.HandleAPI("/items/{id:int}/", func(ctx *Context, in *struct {
+		Depth int `json:"depth"`
+	}) (*openAPIItem, error) {
+		return nil, nil
+	}, NamedHandler("item"), MethodHandler("GET"), DescribedHandler("Get an item", ""), TaggedHandler("items"))
+	a.HandleAPI("^/items/$", func(ctx *Context, in *openAPIItem) (*openAPIItem, error) {
+		return in, nil
+	}, NamedHandler("create-item"), MethodHandler("POST"))
+	a.Handle("^/about/$", func(ctx *Context) {}, NamedHandler("about"))
+	a.Handle("^/unnamed/$", func(ctx *Context) {})
+	a.Handle("^/openapi\\.json$", OpenAPIHandler(&OpenAPIOptions{Title: "Test"}))
+	if err := a.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	r, err := http.NewRequest("GET", "http://localhost/openapi.json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	var doc map[string]interface{}
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc["openapi"] != OpenAPIVersion || doc["info"].(map[string]interface{})["title"] != "Test" {
+		t.Errorf("unexpected document header %v", doc)
+	}
+	paths := doc["paths"].(map[string]interface{})
+	var keys []string
+	for k := range paths {
+		keys = append(keys, k)
+	}
+	if len(paths) != 3 || paths["/unnamed/"] != nil {
+		t.Fatalf("unexpected paths %v", keys)
+	}
+	get := paths["/items/{id}/"].(map[string]interface{})["get"].(map[string]interface{})
+	if get["operationId"] != "item" || get["summary"] != "Get an item" {
+		t.Errorf("unexpected operation %v", get)
+	}
+	params := get["parameters"].([]interface{})
+	if len(params) != 2 {
+		t.Fatalf("expecting 2 parameters, got %v", params)
+	}
+	id := params[0].(map[string]interface{})
+	if id["name"] != "id" || id["in"] != "path" || id["schema"].(map[string]interface{})["type"] != "integer" {
+		t.Errorf("unexpected path parameter %v", id)
+	}
+	if depth := params[1].(map[string]interface{}); depth["name"] != "depth" || depth["in"] != "query" {
+		t.Errorf("unexpected query parameter %v", depth)
+	}
+	post := paths["/items/"].(map[string]interface{})["post"].(map[string]interface{})
+	if _, ok := post["requestBody"]; !ok {
+		t.Errorf("POST operation has no request body: %v", post)
+	}
+	schemas := doc["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+	item := schemas["openAPIItem"].(map[string]interface{})
+	if !reflect.DeepEqual(item["required"], []interface{}{"name"}) {
+		t.Errorf("unexpected required fields %v", item["required"])
+	}
+	name := item["properties"].(map[string]interface{})["name"].(map[string]interface{})
+	if name["maxLength"] != float64(16) || name["description"] != "Name of the item" {
+		t.Errorf("unexpected name schema %v", name)
+	}
+	children := item["properties"].(map[string]interface{})["children"].(map[string]interface{})
+	if ref := children["items"].(map[string]interface{})["$ref"]; ref != "#/components/schemas/openAPIItem" {
+		t.Errorf("unexpected children items reference %v", ref)
+	}
+	if _, ok := schemas["APIError"]; !ok {
+		t.Error("APIError schema is missing")
+	}
+}
@@ -54,3 +54,78 @@ func TestParameters(t *testing.T) {
 	tester.Get("/parse-index-value/", nil).Expect(200).Expect("-1")
 	tester.Get("/parse-index-value/42", nil).Expect(200).Expect("42")
 }
+
+func TestTypedParameters(t *testing.T) {
+	a := app.New()
+	a.Handle("/users/{id:int}/{slug}", func(ctx *app.Context) {
+		id := ctx.TypedParamValue("id").(int)
+		ctx.WriteString(strconv.Itoa(id+1) + " " + ctx.ParamValue("slug"))
+	}, app.NamedHandler("user"))
+	a.Handle("/price/{value:float}/", func(ctx *app.Context) {
+		value := ctx.TypedParamValue("value").(float64)
+		ctx.WriteString(strconv.FormatFloat(value*2, 'f', -1, 64))
+	}, app.NamedHandler("price"))
+	a.Handle("^/legacy/(\\d+)/$", func(ctx *app.Context) {
+		ctx.WriteString("legacy " + ctx.IndexValue(0))
+	}, app.NamedHandler("legacy"))
+	a.Handle("/letters/(\\p{L}+)/$", func(ctx *app.Context) {
+		ctx.WriteString("letters " + ctx.IndexValue(0))
+	})
+	a.Handle("/greek/\\p{Greek}+/(\\P{Greek}+)/$", func(ctx *app.Context) {
+		ctx.WriteString("greek " + ctx.IndexValue(0))
+	})
+	a.Handle("/files/{name:path}", func(ctx *app.Context) {
+		ctx.WriteString(ctx.ParamValue("name"))
+	}, app.NamedHandler("file"))
+	a.Handle("/a/\\{x\\}/{id:int}", func(ctx *app.Context) {
+		ctx.WriteString("escaped " + ctx.ParamValue("id"))
+	}, app.NamedHandler("escaped"))
+	a.Handle("/b/\\{{id:int}\\}/", func(ctx *app.Context) {
+		ctx.WriteString("braced " + ctx.ParamValue("id"))
+	}, app.NamedHandler("braced"))
+	tester := tester.New(t, a)
+	tester.Get("/users/41/john", nil).Expect(200).Expect("42 john")
+	tester.Get("/users/41/john/", nil).Expect(404)
+	tester.Get("/users/foo/john", nil).Expect(404)
+	// Matches the pattern, but it can't be converted to an int
+	tester.Get("/users/99999999999999999999999/john", nil).Expect(400)
+	tester.Get("/price/1.25/", nil).Expect(200).Expect("2.5")
+	tester.Get("/legacy/7/", nil).Expect(200).Expect("legacy 7")
+	tester.Get("/letters/abc/", nil).Expect(200).Expect("letters abc")
+	tester.Get("/letters/123/", nil).Expect(404)
+	tester.Get("/greek/αβ/abc/", nil).Expect(200).Expect("greek abc")
+	tester.Get("/files/a/b/c.txt", nil).Expect(200).Expect("a/b/c.txt")
+	tester.Get("/a/{x}/5", nil).Expect(200).Expect("escaped 5")
+	tester.Get("/a/x/5", nil).Expect(404)
+	tester.Get("/b/{5}/", nil).Expect(200).Expect("braced 5")
+
+	reverseTests := []struct {
+		name     string
+		args     []interface{}
+		expected string
+	}{
+		{"user", []interface{}{42, "john"}, "/users/42/john"},
+		{"user", []interface{}{"foo", "john"}, ""},
+		{"user", []interface{}{42}, ""},
+		{"user", []interface{}{42, "jo/hn"}, ""},
+		{"price", []interface{}{1.5}, "/price/1.5/"},
+		{"legacy", []interface{}{7}, "/legacy/7/"},
+		{"file", []interface{}{"a/b"}, "/files/a/b"},
+		{"escaped", []interface{}{5}, "/a/{x}/5"},
+		{"braced", []interface{}{5}, "/b/{5}/"},
+	}
+	for _, v := range reverseTests {
+		rev, err := a.Reverse(v.name, v.args...)
+		if v.expected == "" {
+			if err == nil {
+				t.Errorf("expecting an error reversing %s with %v, got %q", v.name, v.args, rev)
+			}
+			continue
+		}
+		if err != nil {
+			t.Error(err)
+		} else if rev != v.expected {
+			t.Errorf("expecting %q reversing %s with %v, got %q", v.expected, v.name, v.args, rev)
+		}
+	}
+}
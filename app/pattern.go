@@ -0,0 +1,248 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	typedParamRe = regexp.MustCompile(`\{[A-Za-z_]\w*(?::\w+)?\}`)
+	identRe      = regexp.MustCompile(`^[A-Za-z_]\w*$`)
+)
+
+// paramType represents a type which might be used in
+// typed patterns, like the int in /users/{id:int}/.
+type paramType struct {
+	pattern string
+	re      *regexp.Regexp
+	kind    reflect.Type
+	parse   func(string) (interface{}, error)
+}
+
+func newParamType(pattern string, kind interface{}, parse func(string) (interface{}, error)) *paramType {
+	return &paramType{
+		pattern: pattern,
+		re:      regexp.MustCompile("^(?:" + pattern + ")$"),
+		kind:    reflect.TypeOf(kind),
+		parse:   parse,
+	}
+}
+
+func parseString(s string) (interface{}, error) {
+	return s, nil
+}
+
+var paramTypes = map[string]*paramType{
+	"string": newParamType(`[^/]+`, "", parseString),
+	"slug":   newParamType(`[\w\-]+`, "", parseString),
+	"path":   newParamType(`.+`, "", parseString),
+	"int": newParamType(`\d+`, int(0), func(s string) (interface{}, error) {
+		val, err := strconv.ParseInt(s, 10, 0)
+		return int(val), err
+	}),
+	"uint": newParamType(`\d+`, uint(0), func(s string) (interface{}, error) {
+		val, err := strconv.ParseUint(s, 10, 0)
+		return uint(val), err
+	}),
+	"float": newParamType(`\d+(?:\.\d+)?`, float64(0), func(s string) (interface{}, error) {
+		return strconv.ParseFloat(s, 64)
+	}),
+}
+
+// patternPart is either a literal or a parameter
+// in a typed pattern.
+type patternPart struct {
+	literal string
+	name    string
+	typ     *paramType
+}
+
+// typedPattern represents a pattern declared using the typed
+// syntax (e.g. /users/{id:int}/{slug}). Besides the regexp it
+// compiles to, it's used to convert the parameters before the
+// handler runs and as an exact template when reversing.
+type typedPattern struct {
+	pattern string
+	parts   []*patternPart
+	params  []*patternPart
+}
+
+// isTypedPattern returns true iff the pattern should be parsed
+// using the typed syntax. Patterns starting with ^ are always
+// considered regular expressions, as well as patterns whose
+// braces are all escaped or part of an escape sequence (e.g. \p{L}).
+func isTypedPattern(pattern string) bool {
+	if strings.HasPrefix(pattern, "^") {
+		return false
+	}
+	for _, m := range typedParamRe.FindAllStringIndex(pattern, -1) {
+		if !isEscapedBrace(pattern, m[0]) {
+			return true
+		}
+	}
+	return false
+}
+
+// isEscapedBrace returns true iff the brace at the given position
+// is escaped (\{) or follows an escape which takes an argument
+// in braces (\p{...}, \P{...} or \x{...}).
+func isEscapedBrace(s string, pos int) bool {
+	if isEscaped(s, pos) {
+		return true
+	}
+	return pos > 0 && strings.IndexByte("pPx", s[pos-1]) >= 0 && isEscaped(s, pos-1)
+}
+
+// isEscaped returns true iff there's an odd number of
+// backslashes before the given position in s.
+func isEscaped(s string, pos int) bool {
+	n := 0
+	for ii := pos - 1; ii >= 0 && s[ii] == '\\'; ii-- {
+		n++
+	}
+	return n%2 == 1
+}
+
+// indexBrace returns the index of the first occurrence of the
+// brace c in s at or after from which is not escaped, as
+// determined by isEscapedBrace, or -1 if there's none.
+func indexBrace(s string, c byte, from int) int {
+	for ii := from; ii < len(s); ii++ {
+		if s[ii] == c && !isEscapedBrace(s, ii) {
+			return ii
+		}
+	}
+	return -1
+}
+
+// unescapeLiteral removes the backslashes from the escaped
+// braces and backslashes in a literal part of a typed pattern,
+// so \{x\} matches {x}. Any other backslashes are left as is.
+func unescapeLiteral(s string) string {
+	if strings.IndexByte(s, '\\') < 0 {
+		return s
+	}
+	var buf bytes.Buffer
+	for ii := 0; ii < len(s); ii++ {
+		if s[ii] == '\\' && ii+1 < len(s) && strings.IndexByte(`\{}`, s[ii+1]) >= 0 {
+			ii++
+		}
+		buf.WriteByte(s[ii])
+	}
+	return buf.String()
+}
+
+func parseTypedPattern(pattern string) (*typedPattern, error) {
+	tp := &typedPattern{pattern: pattern}
+	pos := 0
+	for pos < len(pattern) {
+		start := indexBrace(pattern, '{', pos)
+		if start < 0 {
+			tp.parts = append(tp.parts, &patternPart{literal: unescapeLiteral(pattern[pos:])})
+			break
+		}
+		if start > pos {
+			tp.parts = append(tp.parts, &patternPart{literal: unescapeLiteral(pattern[pos:start])})
+		}
+		end := indexBrace(pattern, '}', start+1)
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated parameter in pattern %q", pattern)
+		}
+		name := pattern[start+1 : end]
+		typeName := "string"
+		if sep := strings.IndexByte(name, ':'); sep >= 0 {
+			name, typeName = name[:sep], name[sep+1:]
+		}
+		if !identRe.MatchString(name) {
+			return nil, fmt.Errorf("invalid parameter name %q in pattern %q", name, pattern)
+		}
+		typ := paramTypes[typeName]
+		if typ == nil {
+			return nil, fmt.Errorf("unknown parameter type %q in pattern %q", typeName, pattern)
+		}
+		for _, v := range tp.params {
+			if v.name == name {
+				return nil, fmt.Errorf("duplicate parameter %q in pattern %q", name, pattern)
+			}
+		}
+		part := &patternPart{name: name, typ: typ}
+		tp.parts = append(tp.parts, part)
+		tp.params = append(tp.params, part)
+		pos = end + 1
+	}
+	return tp, nil
+}
+
+// regexp returns the regular expression equivalent to
+// the typed pattern.
+func (tp *typedPattern) regexp() string {
+	var buf bytes.Buffer
+	buf.WriteByte('^')
+	for _, v := range tp.parts {
+		if v.typ == nil {
+			buf.WriteString(regexp.QuoteMeta(v.literal))
+			continue
+		}
+		fmt.Fprintf(&buf, "(?P<%s>%s)", v.name, v.typ.pattern)
+	}
+	buf.WriteByte('$')
+	return buf.String()
+}
+
+// convert parses the parameters received in the Context and stores
+// them, returning an error if any of them can't be converted.
+func (tp *typedPattern) convert(ctx *Context) error {
+	values := make(map[string]interface{}, len(tp.params))
+	for _, v := range tp.params {
+		s, _ := ctx.provider.Param(v.name)
+		val, err := v.typ.parse(s)
+		if err != nil {
+			return &InvalidParameterError{
+				Index: -1,
+				Name:  v.name,
+				Type:  v.typ.kind,
+				Err:   err,
+			}
+		}
+		values[v.name] = val
+	}
+	ctx.params = values
+	return nil
+}
+
+// format returns the path for the given arguments, which must
+// be provided in the same order as the parameters appear in
+// the pattern.
+func (tp *typedPattern) format(args []interface{}) (string, error) {
+	if len(args) != len(tp.params) {
+		return "", &argumentCountError{len(args), len(tp.params), len(tp.params)}
+	}
+	var buf bytes.Buffer
+	ii := 0
+	for _, v := range tp.parts {
+		if v.typ == nil {
+			buf.WriteString(v.literal)
+			continue
+		}
+		val := fmt.Sprintf("%v", args[ii])
+		if !v.typ.re.MatchString(val) {
+			return "", fmt.Errorf("Invalid replacement for parameter %q. Format is %q, replacement is %q.", v.name, v.typ.pattern, val)
+		}
+		buf.WriteString(val)
+		ii++
+	}
+	return buf.String(), nil
+}
+
+func (tp *typedPattern) wrap(handler Handler) Handler {
+	return func(ctx *Context) {
+		if err := tp.convert(ctx); err != nil {
+			panic(err)
+		}
+		handler(ctx)
+	}
+}
@@ -0,0 +1,117 @@
+package app
+
+// RolesUser is an optional interface which might be implemented
+// by User types which have roles assigned to them. See HasRole.
+type RolesUser interface {
+	User
+	// Roles returns the names of the roles assigned to the user.
+	Roles() []string
+}
+
+// PermissionsUser is an optional interface which might be implemented
+// by User types which support fine grained permissions. See HasPermission.
+type PermissionsUser interface {
+	User
+	// HasPermission returns true iff the user has been granted
+	// the given permission (e.g. "articles.edit").
+	HasPermission(perm string) bool
+}
+
+// HasRole returns true iff user is non-nil, implements RolesUser
+// and the given role is included in its roles.
+func HasRole(user User, role string) bool {
+	if ru, ok := user.(RolesUser); ok {
+		for _, v := range ru.Roles() {
+			if v == role {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// HasPermission returns true iff user is non-nil and has the given
+// permission. Administrators (see User.IsAdmin) have all the permissions,
+// while other users must implement PermissionsUser.
+func HasPermission(user User, perm string) bool {
+	if user == nil {
+		return false
+	}
+	if user.IsAdmin() {
+		return true
+	}
+	if pu, ok := user.(PermissionsUser); ok {
+		return pu.HasPermission(perm)
+	}
+	return false
+}
+
+// HasRole returns true iff there's a signed in user and it
+// has the given role. See the HasRole function for more details.
+// Users authenticated with limited scopes (see Authentication.Restricted)
+// don't have any roles.
+func (c *Context) HasRole(role string) bool {
+	if auth := c.Authentication(); auth != nil && auth.Restricted() {
+		return false
+	}
+	return HasRole(c.User(), role)
+}
+
+// HasPermission returns true iff there's a signed in user and
+// it has the given permission. See the HasPermission function
+// for more details. If the user was authenticated with limited
+// scopes (see Authentication.Scopes), the permission must also
+// be included in them, even for administrators.
+func (c *Context) HasPermission(perm string) bool {
+	if auth := c.Authentication(); auth != nil && !auth.HasScope(perm) {
+		return false
+	}
+	return HasPermission(c.User(), perm)
+}
+
+// RequirePermission returns a Transformer which requires the signed
+// in user to have the given permission (see Context.HasPermission).
+// Anonymous users are redirected to the handler named "sign-in" (see
+// SignedIn), while users without the permission receive a 403 (Forbidden)
+// error.
+//
+//  app.Handle("^/articles/(\\d+)/edit/$", app.RequirePermission("articles.edit")(EditArticleHandler))
+func RequirePermission(perm string) Transformer {
+	return func(handler Handler) Handler {
+		return func(ctx *Context) {
+			if !requireUser(ctx) {
+				return
+			}
+			if !ctx.HasPermission(perm) {
+				ctx.Forbidden()
+				return
+			}
+			handler(ctx)
+		}
+	}
+}
+
+// RequireRole works like RequirePermission, but requires the signed
+// in user to have the given role (see Context.HasRole).
+func RequireRole(role string) Transformer {
+	return func(handler Handler) Handler {
+		return func(ctx *Context) {
+			if !requireUser(ctx) {
+				return
+			}
+			if !ctx.HasRole(role) {
+				ctx.Forbidden()
+				return
+			}
+			handler(ctx)
+		}
+	}
+}
+
+func template_has_permission(ctx *Context, perm string) bool {
+	return ctx.HasPermission(perm)
+}
+
+func template_has_role(ctx *Context, role string) bool {
+	return ctx.HasRole(role)
+}
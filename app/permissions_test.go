@@ -0,0 +1,119 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type permissionsTestUser struct {
+	admin bool
+	roles []string
+	perms []string
+}
+
+func (u *permissionsTestUser) Id() int64     { return 1 }
+func (u *permissionsTestUser) IsAdmin() bool { return u.admin }
+func (u *permissionsTestUser) Roles() []string {
+	return u.roles
+}
+
+func (u *permissionsTestUser) HasPermission(perm string) bool {
+	for _, v := range u.perms {
+		if v == perm {
+			return true
+		}
+	}
+	return false
+}
+
+func TestPermissions(t *testing.T) {
+	users := map[string]User{
+		"admin":  &permissionsTestUser{admin: true},
+		"editor": &permissionsTestUser{roles: []string{"editor"}, perms: []string{"articles.edit"}},
+		"reader": &permissionsTestUser{roles: []string{"reader"}},
+		"plain":  sessionTestUser(2),
+	}
+	withUser := func(handler Handler) Handler {
+		return func(ctx *Context) {
+			if u := users[ctx.FormValue("user")]; u != nil {
+				ctx.user = u
+				if scopes := ctx.FormValue("scopes"); scopes != "" {
+					// Simulate a token with limited scopes
+					ctx.auth = &Authentication{User: u, Scopes: strings.Split(scopes, ",")}
+					ctx.authenticated = true
+				}
+			}
+			handler(ctx)
+		}
+	}
+	ok := func(ctx *Context) { ctx.WriteString("ok") }
+	a := New()
+	a.Handle("^/sign-in/$", ok, NamedHandler(SignInHandlerName))
+	a.Handle("^/edit/$", withUser(RequirePermission("articles.edit")(ok)))
+	a.Handle("^/editors/$", withUser(RequireRole("editor")(ok)))
+	a.Handle("^/signed-in/$", withUser(SignedIn(ok)))
+	if err := a.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	status := func(path string) int {
+		r, err := http.NewRequest("GET", "http://localhost"+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, r)
+		return w.Code
+	}
+	for _, path := range []string{"/edit/", "/editors/", "/signed-in/"} {
+		if code := status(path); code != http.StatusFound {
+			t.Errorf("GET %s for anonymous user returned %d, expecting 302", path, code)
+		}
+	}
+	tests := []struct {
+		path string
+		user string
+		code int
+	}{
+		{"/edit/", "admin", 200},
+		{"/edit/", "editor", 200},
+		{"/edit/", "reader", 403},
+		{"/edit/", "plain", 403},
+		{"/editors/", "admin", 403},
+		{"/editors/", "editor", 200},
+		{"/editors/", "reader", 403},
+		{"/editors/", "plain", 403},
+		{"/signed-in/", "plain", 200},
+		// Scoped authentications
+		{"/edit/", "admin&scopes=users.tokens", 403},
+		{"/edit/", "admin&scopes=articles.edit", 200},
+		{"/edit/", "editor&scopes=users.tokens,articles.edit", 200},
+		{"/edit/", "editor&scopes=*", 200},
+		{"/edit/", "reader&scopes=articles.edit", 403},
+		{"/editors/", "editor&scopes=articles.edit", 403},
+		{"/editors/", "editor&scopes=*", 200},
+		{"/signed-in/", "plain&scopes=users.tokens", 403},
+		{"/signed-in/", "plain&scopes=*", 200},
+	}
+	for _, v := range tests {
+		path := v.path + "?user=" + v.user
+		if code := status(path); code != v.code {
+			t.Errorf("GET %s returned %d, expecting %d", path, code, v.code)
+		}
+	}
+}
+
+func TestPermissionsTemplateFuncs(t *testing.T) {
+	ctx := New().NewContext(nil)
+	if template_has_permission(ctx, "articles.edit") || template_has_role(ctx, "editor") {
+		t.Error("anonymous user has permissions")
+	}
+	ctx.user = &permissionsTestUser{roles: []string{"editor"}, perms: []string{"articles.edit"}}
+	if !template_has_permission(ctx, "articles.edit") || !template_has_role(ctx, "editor") {
+		t.Error("editor has no permissions")
+	}
+	if template_has_permission(ctx, "articles.delete") {
+		t.Error("editor has unexpected permission")
+	}
+}
@@ -35,6 +35,11 @@ type regexpProvider struct {
 	arguments   []string
 	notProvided map[int]bool
 	params      map[string]string
+	// host is the pattern which matched the request host,
+	// if the handler (or its included app) has a host pattern.
+	host       *hostPattern
+	hostName   string
+	hostParams map[string]string
 }
 
 func (r *regexpProvider) buildArguments() {
@@ -71,6 +76,9 @@ func (r *regexpProvider) Arg(idx int) (string, bool) {
 
 func (r *regexpProvider) Param(name string) (string, bool) {
 	val, found := r.params[name]
+	if !found && r.hostParams != nil {
+		val, found = r.hostParams[name]
+	}
 	return val, found
 }
 
@@ -87,3 +95,26 @@ func (r *regexpProvider) reset(re *regexp.Regexp, path string, matches []int) {
 	r.params = make(map[string]string)
 	r.buildArguments()
 }
+
+// setHost stores the named parameters captured by the host pattern
+// which matched the given host. Since the path is matched again for
+// included apps, these are not cleared by reset.
+func (r *regexpProvider) setHost(h *hostPattern, host string) {
+	r.host = h
+	r.hostName = host
+	r.hostParams = nil
+	if h.re == nil {
+		return
+	}
+	matched := matchedHost(host)
+	m := h.re.FindStringSubmatchIndex(matched)
+	for ii, name := range h.re.SubexpNames() {
+		if name == "" || 2*ii >= len(m) || m[2*ii] < 0 {
+			continue
+		}
+		if r.hostParams == nil {
+			r.hostParams = make(map[string]string)
+		}
+		r.hostParams[name] = matched[m[2*ii]:m[2*ii+1]]
+	}
+}
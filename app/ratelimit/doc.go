@@ -0,0 +1,25 @@
+// Package ratelimit implements request rate limiting for Gondola
+// apps, using a token bucket stored in a gnd.la/cache.Cache.
+//
+// Use New to create a Limiter and then call Wrap on any app.Handler
+// to obtain a new app.Handler which responds with a 429 (Too Many
+// Requests) when the client exceeds the limit. Limiter.Wrap might
+// also be used directly as an app.Transformer.
+//
+// By default, requests are limited per client IP address, as returned
+// by app.Context.RemoteAddress (which honors app.App.TrustsXHeaders).
+// Use ByUser or a custom KeyFunc to limit requests by other criteria.
+//
+//  // Allow 5 sign in attempts per minute from each IP
+//  limiter := ratelimit.New(5, time.Minute, nil)
+//  myapp.Handle("^/sign-in/$", limiter.Wrap(SignInHandler))
+//
+// The bucket state is stored in the App cache, so limits are shared by
+// all the instances of the App when the cache uses a shared backend (e.g.
+// redis or memcache). Buckets are updated atomically using
+// gnd.la/cache.Cache.CompareAndSwapBytes, so concurrent requests served
+// by different instances can't exceed the limit. Cache drivers which
+// don't support it (e.g. the file driver) only enforce the limit
+// within each instance. If the App has no cache configured, a process
+// local memory cache is used instead.
+package ratelimit
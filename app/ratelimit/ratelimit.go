@@ -0,0 +1,308 @@
+package ratelimit
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/cache"
+	"gnd.la/cache/driver"
+	"gnd.la/config"
+)
+
+const (
+	stateSize = 16
+	// lockStripes is the number of mutexes used by
+	// each Limiter. See Limiter.lockFor.
+	lockStripes = 64
+	// maxSwapAttempts is the maximum number of times Allow
+	// tries to update a bucket which is being concurrently
+	// updated by other instances.
+	maxSwapAttempts = 10
+)
+
+var (
+	errTooManyConflicts = errors.New("ratelimit: too many concurrent updates")
+
+	memoryCache struct {
+		sync.Once
+		cache *cache.Cache
+		err   error
+	}
+)
+
+// KeyFunc returns the key used for limiting the given
+// request. Requests with the same key share the same
+// limit. If a KeyFunc returns an empty string, the
+// request is not limited.
+type KeyFunc func(ctx *app.Context) string
+
+// ByIP is a KeyFunc which limits requests per client
+// IP address. This is the default KeyFunc.
+func ByIP(ctx *app.Context) string {
+	return "ip:" + ctx.RemoteAddress()
+}
+
+// ByUser is a KeyFunc which limits requests per signed in user.
+// Requests from anonymous users are limited per IP address.
+func ByUser(ctx *app.Context) string {
+	if user := ctx.User(); user != nil {
+		return "user:" + strconv.FormatInt(user.Id(), 10)
+	}
+	return ByIP(ctx)
+}
+
+// Options specify the optional parameters for a Limiter.
+type Options struct {
+	// Name is used as a prefix for the keys stored in the cache.
+	// Limiters with the same Name share their buckets, so a client
+	// exceeding the limit in one handler will also be limited in the
+	// rest of them. If empty, "default" is used.
+	Name string
+	// Key returns the key used for limiting each request.
+	// If nil, ByIP is used.
+	Key KeyFunc
+	// Burst indicates the maximum number of requests which can be
+	// made at once by a client which has not made any request for
+	// a while. If <= 0, the number of requests per period is used.
+	Burst int
+	// Cache is the cache used for storing the buckets. If nil, the
+	// App cache is used, or a process local memory cache when the App
+	// has no cache configured.
+	Cache *cache.Cache
+}
+
+// Status represents the state of the limit for
+// a request.
+type Status struct {
+	// Allowed indicates if the request is allowed.
+	Allowed bool
+	// Limit is the maximum number of requests which can
+	// be made at once.
+	Limit int
+	// Remaining is the number of requests which can still
+	// be made right now.
+	Remaining int
+	// RetryAfter indicates how long the client must wait
+	// before making another request. It's zero for allowed
+	// requests.
+	RetryAfter time.Duration
+	// Reset indicates how long it will take until the client
+	// can make Limit requests again.
+	Reset time.Duration
+}
+
+// Limiter implements rate limiting using a token bucket, which
+// is refilled at a constant rate. Use New to create a Limiter.
+type Limiter struct {
+	requests int
+	period   time.Duration
+	name     string
+	key      KeyFunc
+	burst    int
+	cache    *cache.Cache
+	// locks serialize the requests with the same key served
+	// by this instance, without serializing the requests with
+	// unrelated keys. Updates from other instances are detected
+	// using Cache.CompareAndSwapBytes.
+	locks [lockStripes]sync.Mutex
+}
+
+// New returns a new Limiter which allows the given number of requests
+// in each period. opts might be nil, in which case the default options
+// are used (see Options).
+func New(requests int, period time.Duration, opts *Options) *Limiter {
+	if requests <= 0 {
+		panic("ratelimit: requests must be > 0")
+	}
+	if period <= 0 {
+		panic("ratelimit: period must be > 0")
+	}
+	l := &Limiter{
+		requests: requests,
+		period:   period,
+		name:     "default",
+		key:      ByIP,
+		burst:    requests,
+	}
+	if opts != nil {
+		if opts.Name != "" {
+			l.name = opts.Name
+		}
+		if opts.Key != nil {
+			l.key = opts.Key
+		}
+		if opts.Burst > 0 {
+			l.burst = opts.Burst
+		}
+		l.cache = opts.Cache
+	}
+	return l
+}
+
+// rate returns the number of tokens added to the
+// bucket each second.
+func (l *Limiter) rate() float64 {
+	return float64(l.requests) / l.period.Seconds()
+}
+
+func (l *Limiter) cacheFor(ctx *app.Context) (*cache.Cache, error) {
+	if l.cache != nil {
+		return l.cache, nil
+	}
+	if ctx.App().Config().Cache != nil {
+		return ctx.App().Cache()
+	}
+	memoryCache.Do(func() {
+		var u *config.URL
+		if u, memoryCache.err = config.ParseURL("memory://"); memoryCache.err == nil {
+			memoryCache.cache, memoryCache.err = cache.New(u)
+		}
+	})
+	return memoryCache.cache, memoryCache.err
+}
+
+// bucket is the state stored in the cache for each key.
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+func decodeBucket(data []byte) (*bucket, bool) {
+	if len(data) != stateSize {
+		return nil, false
+	}
+	return &bucket{
+		tokens:  math.Float64frombits(binary.BigEndian.Uint64(data)),
+		updated: time.Unix(0, int64(binary.BigEndian.Uint64(data[8:]))),
+	}, true
+}
+
+func (b *bucket) encode() []byte {
+	data := make([]byte, stateSize)
+	binary.BigEndian.PutUint64(data, math.Float64bits(b.tokens))
+	binary.BigEndian.PutUint64(data[8:], uint64(b.updated.UnixNano()))
+	return data
+}
+
+// take tries to remove a token from the bucket, updating
+// its state and returning the resulting Status.
+func (l *Limiter) take(b *bucket, now time.Time) *Status {
+	rate := l.rate()
+	if elapsed := now.Sub(b.updated).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(float64(l.burst), b.tokens+elapsed*rate)
+	}
+	b.updated = now
+	st := &Status{Limit: l.burst}
+	if b.tokens >= 1 {
+		b.tokens--
+		st.Allowed = true
+	} else {
+		st.RetryAfter = seconds((1 - b.tokens) / rate)
+	}
+	st.Remaining = int(b.tokens)
+	st.Reset = seconds((float64(l.burst) - b.tokens) / rate)
+	return st
+}
+
+// lockFor returns the mutex which protects the bucket
+// for the given key.
+func (l *Limiter) lockFor(key string) *sync.Mutex {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return &l.locks[h.Sum32()%lockStripes]
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+// Allow consumes a request from the limit for the given Context,
+// returning its Status. Handlers might use it directly to limit
+// only some requests (e.g. failed sign in attempts).
+func (l *Limiter) Allow(ctx *app.Context) (*Status, error) {
+	key := l.key(ctx)
+	if key == "" {
+		return &Status{Allowed: true, Limit: l.burst, Remaining: l.burst}, nil
+	}
+	c, err := l.cacheFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	key = "gondola-ratelimit-" + l.name + "-" + key
+	mu := l.lockFor(key)
+	mu.Lock()
+	defer mu.Unlock()
+	for ii := 0; ii < maxSwapAttempts; ii++ {
+		data, err := c.GetBytes(key)
+		if err != nil && err != cache.ErrNotFound {
+			return nil, err
+		}
+		now := time.Now()
+		b, ok := decodeBucket(data)
+		if !ok {
+			b = &bucket{tokens: float64(l.burst), updated: now}
+		}
+		st := l.take(b, now)
+		// Once the bucket is full, its state is not needed anymore
+		timeout := int(math.Ceil(st.Reset.Seconds())) + 1
+		swapped, err := c.CompareAndSwapBytes(key, data, b.encode(), timeout)
+		if err == driver.ErrNotImplemented {
+			// The driver can't update the bucket atomically, so
+			// the limit is only enforced within each instance.
+			if err := c.SetBytes(key, b.encode(), timeout); err != nil {
+				return nil, err
+			}
+			return st, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if swapped {
+			return st, nil
+		}
+		// Another instance updated the bucket. Wait for a random
+		// interval before trying again, so instances updating the
+		// same bucket don't keep conflicting with each other.
+		time.Sleep(time.Duration(rand.Int63n(int64(ii+1) * int64(time.Millisecond))))
+	}
+	return nil, errTooManyConflicts
+}
+
+func durationHeader(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
+
+// Wrap takes an app.Handler and returns a new app.Handler which
+// limits the requests using the Limiter. All responses include the
+// X-RateLimit-Limit, X-RateLimit-Remaining and X-RateLimit-Reset (in
+// seconds) headers. Requests exceeding the limit receive a 429 (Too
+// Many Requests) response with the Retry-After header. If the cache
+// fails, the error is logged and the request is allowed.
+func (l *Limiter) Wrap(handler app.Handler) app.Handler {
+	return func(ctx *app.Context) {
+		st, err := l.Allow(ctx)
+		if err != nil {
+			ctx.Logger().Errorf("error checking rate limit: %s", err)
+			handler(ctx)
+			return
+		}
+		header := ctx.Header()
+		header.Set("X-RateLimit-Limit", strconv.Itoa(st.Limit))
+		header.Set("X-RateLimit-Remaining", strconv.Itoa(st.Remaining))
+		header.Set("X-RateLimit-Reset", durationHeader(st.Reset))
+		if !st.Allowed {
+			header.Set("Retry-After", durationHeader(st.RetryAfter))
+			ctx.Error(http.StatusTooManyRequests)
+			return
+		}
+		handler(ctx)
+	}
+}
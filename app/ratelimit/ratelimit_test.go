@@ -0,0 +1,157 @@
+package ratelimit
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/app/tester"
+	"gnd.la/cache"
+	"gnd.la/cache/driver"
+	"gnd.la/config"
+)
+
+func TestBucket(t *testing.T) {
+	l := New(2, time.Second, &Options{Burst: 3})
+	now := time.Now()
+	b := &bucket{tokens: 3, updated: now}
+	for ii := 0; ii < 3; ii++ {
+		if st := l.take(b, now); !st.Allowed || st.Remaining != 2-ii {
+			t.Fatalf("request %d: expecting allowed with %d remaining, got %+v", ii, 2-ii, st)
+		}
+	}
+	st := l.take(b, now)
+	if st.Allowed {
+		t.Fatal("expecting request to be limited")
+	}
+	if st.RetryAfter != 500*time.Millisecond {
+		t.Errorf("expecting RetryAfter = 500ms, got %s", st.RetryAfter)
+	}
+	if st := l.take(b, now.Add(500*time.Millisecond)); !st.Allowed {
+		t.Errorf("expecting request to be allowed after refill, got %+v", st)
+	}
+	dec, ok := decodeBucket(b.encode())
+	if !ok || dec.tokens != b.tokens || !dec.updated.Equal(b.updated) {
+		t.Errorf("bucket %+v was decoded as %+v", b, dec)
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	a := app.New()
+	a.SetTrustXHeaders(true)
+	l := New(2, time.Hour, &Options{Name: "test"})
+	a.Handle("^/$", l.Wrap(func(ctx *app.Context) {
+		ctx.WriteString("ok")
+	}))
+	tt := tester.New(t, a)
+	tt.Get("/", nil).AddHeader("X-Real-IP", "10.0.0.1").Expect(200).
+		ExpectHeader("X-RateLimit-Limit", "2").
+		ExpectHeader("X-RateLimit-Remaining", "1")
+	tt.Get("/", nil).AddHeader("X-Real-IP", "10.0.0.1").Expect(200).
+		ExpectHeader("X-RateLimit-Remaining", "0")
+	tt.Get("/", nil).AddHeader("X-Real-IP", "10.0.0.1").Expect(429).
+		ExpectHeader("X-RateLimit-Remaining", "0").
+		ExpectHeader("Retry-After", "1800")
+	// Different IP
+	tt.Get("/", nil).AddHeader("X-Real-IP", "10.0.0.2").Expect(200).
+		ExpectHeader("X-RateLimit-Remaining", "1")
+}
+
+func TestConcurrentLimiter(t *testing.T) {
+	const requests = 50
+	a := app.New()
+	l := New(requests, time.Hour, &Options{
+		Name: "concurrent",
+		Key:  func(ctx *app.Context) string { return "same" },
+	})
+	var wg sync.WaitGroup
+	var allowed int32
+	for ii := 0; ii < requests*2; ii++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx := a.NewContext(nil)
+			defer a.CloseContext(ctx)
+			st, err := l.Allow(ctx)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if st.Allowed {
+				atomic.AddInt32(&allowed, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	if allowed != requests {
+		t.Errorf("expecting %d allowed requests, got %d", requests, allowed)
+	}
+	if l.lockFor("same") == l.lockFor("other") {
+		t.Error("expecting different locks for different keys")
+	}
+}
+
+// yieldingDriver works like the memory driver, but yields the
+// processor after every Get, so concurrent read-modify-write cycles
+// interleave even when running with GOMAXPROCS=1.
+type yieldingDriver struct {
+	*driver.MemoryDriver
+}
+
+func (d yieldingDriver) Get(key string) ([]byte, error) {
+	data, err := d.MemoryDriver.Get(key)
+	runtime.Gosched()
+	return data, err
+}
+
+func init() {
+	driver.Register("yielding", func(url *config.URL) (driver.Driver, error) {
+		return yieldingDriver{&driver.MemoryDriver{}}, nil
+	})
+}
+
+func TestSharedLimiter(t *testing.T) {
+	const requests = 50
+	c, err := cache.New(config.MustParseURL("yielding://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Two Limiters sharing a cache behave like two instances
+	// of the App using a shared cache backend, since they don't
+	// share their locks.
+	opts := &Options{
+		Name:  "shared",
+		Key:   func(ctx *app.Context) string { return "same" },
+		Cache: c,
+	}
+	limiters := []*Limiter{New(requests, time.Hour, opts), New(requests, time.Hour, opts)}
+	a := app.New()
+	var wg sync.WaitGroup
+	var allowed int32
+	start := make(chan struct{})
+	for ii := 0; ii < requests*4; ii++ {
+		wg.Add(1)
+		go func(l *Limiter) {
+			defer wg.Done()
+			<-start
+			ctx := a.NewContext(nil)
+			defer a.CloseContext(ctx)
+			st, err := l.Allow(ctx)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if st.Allowed {
+				atomic.AddInt32(&allowed, 1)
+			}
+		}(limiters[ii%len(limiters)])
+	}
+	close(start)
+	wg.Wait()
+	if allowed != requests {
+		t.Errorf("expecting %d allowed requests, got %d", requests, allowed)
+	}
+}
@@ -19,6 +19,7 @@ type App struct {
 	app.App
 	name                  string
 	Prefix                string
+	Host                  string
 	ContainerTemplateName string
 	opts                  *Options
 	// The directory for the source file which called New(), used
@@ -57,9 +58,15 @@ func (a *App) relativePath(rel string) string {
 	return filepath.Join(a.dir, filepath.FromSlash(rel))
 }
 
-// Attach attaches a reusable app into its parent app.
+// Attach attaches a reusable app into its parent app. If
+// a.Host is non-empty, the app is only available on the hosts
+// matching it (see gnd.la/app.HostInclude).
 func (a *App) Attach(parent *app.App) {
-	parent.Include(a.Prefix, a.name, &a.App, a.ContainerTemplateName)
+	var opts []app.IncludeOption
+	if a.Host != "" {
+		opts = append(opts, app.HostInclude(a.Host))
+	}
+	parent.Include(a.Prefix, a.name, &a.App, a.ContainerTemplateName, opts...)
 }
 
 // Data returns the Data field from the Options struct, as passed in to New.
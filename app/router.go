@@ -0,0 +1,412 @@
+package app
+
+import (
+	"regexp/syntax"
+	"strings"
+	"unicode/utf8"
+)
+
+// noRouteIndex is used as the initial index when looking
+// for the route with the lowest index.
+const noRouteIndex = int(^uint(0) >> 1)
+
+// routeKind indicates how a route element matches the path.
+type routeKind int
+
+const (
+	routeLiteral routeKind = iota
+	routeCapture
+)
+
+// routeElem is an element of a converted pattern. Patterns which
+// can be represented as a sequence of literals and simple capture
+// groups are matched by the routing tree without using regexps.
+type routeElem struct {
+	kind    routeKind
+	literal string
+	class   []rune // pairs of ranges, as in syntax.Regexp.Rune
+	min     int
+	max     int // -1 means unlimited
+}
+
+func (e *routeElem) matches(r rune) bool {
+	for ii := 0; ii < len(e.class); ii += 2 {
+		if r >= e.class[ii] && r <= e.class[ii+1] {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *routeElem) sameCapture(o *routeElem) bool {
+	if e.min != o.min || e.max != o.max || len(e.class) != len(o.class) {
+		return false
+	}
+	for ii, v := range e.class {
+		if o.class[ii] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// route represents a handler stored in the routing tree.
+type route struct {
+	index int
+	info  *handlerInfo
+}
+
+// routeCaptureEdge is an edge in the routing tree which matches a
+// capture group.
+type routeCaptureEdge struct {
+	elem *routeElem
+	node *routeNode
+}
+
+// routeNode is a node in the routing tree. Literal edges are
+// compressed (radix tree), so each node might match several bytes
+// of the path.
+type routeNode struct {
+	prefix   string
+	children []*routeNode
+	captures []*routeCaptureEdge
+	// routes which match only when the full path has been consumed
+	// (i.e. patterns ending with $)
+	routes []*route
+	// routes which match regardless of the remaining path (i.e.
+	// patterns without a trailing $)
+	prefixRoutes []*route
+	// lowest route index in this node or any of its descendants,
+	// used to prune the search.
+	minIndex int
+}
+
+func (n *routeNode) insert(elems []*routeElem, rt *route, anchored bool) {
+	if rt.index < n.minIndex {
+		n.minIndex = rt.index
+	}
+	if len(elems) == 0 {
+		if anchored {
+			n.routes = append(n.routes, rt)
+		} else {
+			n.prefixRoutes = append(n.prefixRoutes, rt)
+		}
+		return
+	}
+	elem := elems[0]
+	if elem.kind == routeCapture {
+		var edge *routeCaptureEdge
+		for _, v := range n.captures {
+			if v.elem.sameCapture(elem) {
+				edge = v
+				break
+			}
+		}
+		if edge == nil {
+			edge = &routeCaptureEdge{elem: elem, node: newRouteNode("")}
+			n.captures = append(n.captures, edge)
+		}
+		edge.node.insert(elems[1:], rt, anchored)
+		return
+	}
+	lit := elem.literal
+	for ii, child := range n.children {
+		if child.prefix[0] != lit[0] {
+			continue
+		}
+		common := commonPrefix(child.prefix, lit)
+		if common < len(child.prefix) {
+			// Split the child
+			split := newRouteNode(child.prefix[:common])
+			split.minIndex = child.minIndex
+			child.prefix = child.prefix[common:]
+			split.children = []*routeNode{child}
+			n.children[ii] = split
+			child = split
+		}
+		rest := elems[1:]
+		if lit = lit[common:]; lit != "" {
+			rest = append([]*routeElem{{kind: routeLiteral, literal: lit}}, rest...)
+		}
+		child.insert(rest, rt, anchored)
+		return
+	}
+	child := newRouteNode(lit)
+	n.children = append(n.children, child)
+	child.insert(elems[1:], rt, anchored)
+}
+
+// routeMatch holds the best match found so far while walking
+// the routing tree.
+type routeMatch struct {
+	info    *handlerInfo
+	index   int
+	matches []int
+	host    string
+	method  string
+	caps    []int
+	// methods accepted by routes which matched
+	// the path but not the method
+	allowed []string
+}
+
+func (m *routeMatch) candidate(rt *route, end int) bool {
+	if rt.index >= m.index {
+		return false
+	}
+	if rt.info.host != nil && !rt.info.host.matches(m.host) {
+		return false
+	}
+	if !rt.info.acceptsMethod(m.method) {
+		m.allowed = append(m.allowed, rt.info.methods...)
+		return false
+	}
+	m.index = rt.index
+	m.info = rt.info
+	matches := make([]int, 2*(rt.info.re.NumSubexp()+1))
+	matches[0] = 0
+	matches[1] = end
+	copy(matches[2:], m.caps)
+	m.matches = matches
+	return true
+}
+
+func (n *routeNode) match(path string, pos int, m *routeMatch) {
+	if n.minIndex >= m.index {
+		return
+	}
+	for _, rt := range n.prefixRoutes {
+		if m.candidate(rt, pos) {
+			break
+		}
+	}
+	if pos == len(path) {
+		for _, rt := range n.routes {
+			if m.candidate(rt, pos) {
+				break
+			}
+		}
+	}
+	rem := path[pos:]
+	for _, child := range n.children {
+		if strings.HasPrefix(rem, child.prefix) {
+			child.match(path, pos+len(child.prefix), m)
+			// Radix tree, at most one child can match
+			break
+		}
+	}
+	for _, edge := range n.captures {
+		elem := edge.elem
+		end := pos
+		count := 0
+		for end < len(path) && (elem.max < 0 || count < elem.max) {
+			r, size := utf8.DecodeRuneInString(path[end:])
+			if !elem.matches(r) {
+				break
+			}
+			end += size
+			count++
+		}
+		if count < elem.min {
+			continue
+		}
+		m.caps = append(m.caps, pos, end)
+		edge.node.match(path, end, m)
+		m.caps = m.caps[:len(m.caps)-2]
+	}
+}
+
+func newRouteNode(prefix string) *routeNode {
+	return &routeNode{prefix: prefix, minIndex: noRouteIndex}
+}
+
+// fallbackRoute is a route which couldn't be converted into
+// tree elements, so it's matched using its regexp. To avoid
+// running the regexp when it can't possibly match, the literal
+// prefix of the pattern (if any) is checked first.
+type fallbackRoute struct {
+	index  int
+	prefix string
+	info   *handlerInfo
+}
+
+// router matches paths to handlers using a routing tree for
+// the patterns which can be converted and regexps for the rest.
+// When several handlers match, the one which was added first
+// wins, like in the linear matcher.
+type router struct {
+	root     *routeNode
+	fallback []*fallbackRoute
+}
+
+func newRouter(handlers []*handlerInfo) *router {
+	r := &router{root: newRouteNode("")}
+	for ii, v := range handlers {
+		elems, anchored, ok := routeElems(v.re.String())
+		if !ok {
+			r.fallback = append(r.fallback, &fallbackRoute{
+				index:  ii,
+				prefix: literalPrefix(v.re.String()),
+				info:   v,
+			})
+			continue
+		}
+		r.root.insert(elems, &route{index: ii, info: v}, anchored)
+	}
+	return r
+}
+
+// match returns the handler which matches the given path, host and method
+// as well as the matched indexes. If no handler matches but there are
+// handlers which match the path and host, the methods they accept are
+// returned in allowed.
+func (r *router) match(path string, host string, method string) (info *handlerInfo, matches []int, allowed []string) {
+	m := &routeMatch{index: noRouteIndex, host: host, method: method}
+	r.root.match(path, 0, m)
+	for _, v := range r.fallback {
+		if v.index >= m.index {
+			break
+		}
+		if v.info.host != nil && !v.info.host.matches(host) {
+			continue
+		}
+		if !strings.HasPrefix(path, v.prefix) {
+			continue
+		}
+		if matches := v.info.re.FindStringSubmatchIndex(path); matches != nil {
+			if !v.info.acceptsMethod(method) {
+				m.allowed = append(m.allowed, v.info.methods...)
+				continue
+			}
+			return v.info, matches, nil
+		}
+	}
+	if m.info == nil {
+		return nil, nil, m.allowed
+	}
+	return m.info, m.matches, nil
+}
+
+// routeElems converts the given regexp pattern into a list of
+// routeElem. If the pattern can't be converted, ok is false. The
+// anchored return value indicates if the pattern must match the
+// whole path.
+func routeElems(pattern string) (elems []*routeElem, anchored bool, ok bool) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, false, false
+	}
+	var subs []*syntax.Regexp
+	if re.Op == syntax.OpConcat {
+		subs = re.Sub
+	} else {
+		subs = []*syntax.Regexp{re}
+	}
+	if len(subs) == 0 || subs[0].Op != syntax.OpBeginText {
+		// Unanchored patterns might match anywhere
+		return nil, false, false
+	}
+	subs = subs[1:]
+	if len(subs) > 0 && subs[len(subs)-1].Op == syntax.OpEndText {
+		anchored = true
+		subs = subs[:len(subs)-1]
+	}
+	for _, v := range subs {
+		switch v.Op {
+		case syntax.OpLiteral:
+			if v.Flags&syntax.FoldCase != 0 {
+				return nil, false, false
+			}
+			lit := string(v.Rune)
+			if n := len(elems); n > 0 && elems[n-1].kind == routeLiteral {
+				elems[n-1].literal += lit
+				continue
+			}
+			elems = append(elems, &routeElem{kind: routeLiteral, literal: lit})
+		case syntax.OpCapture:
+			elem := captureElem(v.Sub[0])
+			if elem == nil {
+				return nil, false, false
+			}
+			elems = append(elems, elem)
+		default:
+			return nil, false, false
+		}
+	}
+	// Captures are matched greedily without backtracking, so
+	// they must be followed by the end of the pattern or by
+	// a literal which can't be matched by the capture.
+	for ii, v := range elems {
+		if v.kind != routeCapture || ii == len(elems)-1 {
+			continue
+		}
+		next := elems[ii+1]
+		if next.kind != routeLiteral {
+			return nil, false, false
+		}
+		r, _ := utf8.DecodeRuneInString(next.literal)
+		if v.matches(r) {
+			return nil, false, false
+		}
+	}
+	return elems, anchored, true
+}
+
+func captureElem(re *syntax.Regexp) *routeElem {
+	min, max := 1, 1
+	switch re.Op {
+	case syntax.OpStar:
+		min, max = 0, -1
+	case syntax.OpPlus:
+		min, max = 1, -1
+	case syntax.OpQuest:
+		min, max = 0, 1
+	case syntax.OpRepeat:
+		min, max = re.Min, re.Max
+	}
+	if min != 1 || max != 1 {
+		if re.Flags&syntax.NonGreedy != 0 {
+			return nil
+		}
+		re = re.Sub[0]
+	}
+	var class []rune
+	switch re.Op {
+	case syntax.OpCharClass:
+		if re.Flags&syntax.FoldCase != 0 {
+			return nil
+		}
+		class = re.Rune
+	case syntax.OpAnyCharNotNL:
+		class = []rune{0, '\n' - 1, '\n' + 1, utf8.MaxRune}
+	case syntax.OpAnyChar:
+		class = []rune{0, utf8.MaxRune}
+	default:
+		return nil
+	}
+	return &routeElem{kind: routeCapture, class: class, min: min, max: max}
+}
+
+// literalPrefix returns the literal string which any path matched
+// by the given pattern must start with.
+func literalPrefix(pattern string) string {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return ""
+	}
+	if re.Op != syntax.OpConcat || len(re.Sub) < 2 || re.Sub[0].Op != syntax.OpBeginText {
+		return ""
+	}
+	if lit := re.Sub[1]; lit.Op == syntax.OpLiteral && lit.Flags&syntax.FoldCase == 0 {
+		return string(lit.Rune)
+	}
+	return ""
+}
+
+func commonPrefix(a, b string) int {
+	ii := 0
+	for ii < len(a) && ii < len(b) && a[ii] == b[ii] {
+		ii++
+	}
+	return ii
+}
@@ -0,0 +1,175 @@
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"sync"
+	"testing"
+)
+
+var (
+	routerPatterns = []string{
+		"^/$",
+		"^/foobar/$",
+		"^/foobar/(\\d+)/$",
+		"^/foobar/(?P<slug>[\\w\\-]+)/$",
+		"^/foobar/(\\d+)/(\\w+)$",
+		"^/article/(\\d)$",
+		"^/article/(\\d{2,4})/",
+		"^/static/",
+		"^/static/special$",
+		"^/image/(\\w+)\\.(\\w+)$",
+		"^/program/(\\d+)/(?:version/(\\d+)/)?$",
+		"^/page/(.*)$",
+		"^/(?i)case/$",
+		"^/slug/(.+)/edit/$",
+		"/unanchored/",
+		"^/ñandú/(\\w+)$",
+		"^/host/$",
+	}
+	routerPaths = []string{
+		"/",
+		"",
+		"/foobar/",
+		"/foobar",
+		"/foobar/42/",
+		"/foobar/42",
+		"/foobar/the-slug/",
+		"/foobar/42/x",
+		"/foobar/42/x_y",
+		"/foobar/42/x-y",
+		"/article/7",
+		"/article/77",
+		"/article/123/",
+		"/article/12345/",
+		"/article/1/",
+		"/static/",
+		"/static/css/style.css",
+		"/static/special",
+		"/image/test.png",
+		"/image/test.png.gz",
+		"/program/1/",
+		"/program/1/version/2/",
+		"/page/",
+		"/page/foo/bar",
+		"/CASE/",
+		"/case/",
+		"/slug/foo/bar/edit/",
+		"/some/unanchored/path",
+		"/ñandú/foo",
+		"/ñandú/",
+		"/host/",
+	}
+)
+
+func routerHandlers() []*handlerInfo {
+	var handlers []*handlerInfo
+	for ii, v := range routerPatterns {
+		re := regexp.MustCompile(v)
+		info := &handlerInfo{
+			name: v,
+			re:   re,
+			rc:   newRegexpCache(re),
+		}
+		if v == "^/host/$" {
+			info.host, _ = newHostPattern("www.example.com")
+		}
+		handlers = append(handlers, info)
+		if ii == 0 {
+			// Duplicate pattern, must never be matched
+			handlers = append(handlers, &handlerInfo{name: "dup", re: re, rc: info.rc})
+		}
+	}
+	return handlers
+}
+
+func TestRouterConversion(t *testing.T) {
+	converted := map[string]bool{
+		"^/$":                                    true,
+		"^/foobar/(\\d+)/$":                      true,
+		"^/foobar/(?P<slug>[\\w\\-]+)/$":         true,
+		"^/article/(\\d{2,4})/":                  true,
+		"^/static/":                              true,
+		"^/page/(.*)$":                           true,
+		"^/program/(\\d+)/(?:version/(\\d+)/)?$": false,
+		"^/(?i)case/$":                           false,
+		"^/slug/(.+)/edit/$":                     false,
+		"/unanchored/":                           false,
+	}
+	for k, v := range converted {
+		if _, _, ok := routeElems(k); ok != v {
+			t.Errorf("expecting conversion of %q = %v, got %v", k, v, ok)
+		}
+	}
+}
+
+func TestRouter(t *testing.T) {
+	handlers := routerHandlers()
+	r := newRouter(handlers)
+	for _, host := range []string{"localhost", "www.example.com"} {
+		for _, p := range routerPaths {
+			info, matches, _ := r.match(p, host, "GET")
+			linfo, lmatches := linearMatch(handlers, p, host)
+			if info != linfo {
+				var name, lname string
+				if info != nil {
+					name = info.name
+				}
+				if linfo != nil {
+					lname = linfo.name
+				}
+				t.Errorf("path %q (host %s) matched %q, expecting %q", p, host, name, lname)
+				continue
+			}
+			if !reflect.DeepEqual(matches, lmatches) {
+				t.Errorf("path %q (host %s) produced matches %v, expecting %v", p, host, matches, lmatches)
+			}
+		}
+	}
+}
+
+func TestRouterConcurrentHandle(t *testing.T) {
+	a := New()
+	a.Handle("^/$", func(ctx *Context) {})
+	identity := func(handler Handler) Handler { return handler }
+	a.AddTransformer(identity)
+	if err := a.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	get := func(path string) int {
+		req, err := http.NewRequest("GET", path, nil)
+		if err != nil {
+			panic(err)
+		}
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, req)
+		return w.Code
+	}
+	var wg sync.WaitGroup
+	for ii := 0; ii < 4; ii++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for jj := 0; jj < 50; jj++ {
+				if code := get("/"); code != http.StatusOK {
+					t.Errorf("expecting code 200, got %d", code)
+				}
+			}
+		}()
+	}
+	// Add handlers and transformers while serving requests,
+	// which forces the router to be rebuilt.
+	for ii := 0; ii < 50; ii++ {
+		a.Handle(fmt.Sprintf("^/h%d/$", ii), func(ctx *Context) {})
+		if ii%10 == 0 {
+			a.AddTransformer(identity)
+		}
+	}
+	wg.Wait()
+	if code := get("/h49/"); code != http.StatusOK {
+		t.Errorf("expecting code 200 for handler added after Prepare, got %d", code)
+	}
+}
@@ -0,0 +1,368 @@
+package app
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gnd.la/util/stringutil"
+)
+
+const (
+	// CSPReportPath is the path used for receiving Content-Security-Policy
+	// violation reports when ContentSecurityPolicy.ReportURI is empty and
+	// the policy is in report-only mode. See SecurityHeaders.
+	CSPReportPath = "/_gondola_csp_report"
+
+	// Common Content-Security-Policy sources. Note that
+	// keyword sources must be enclosed in single quotes.
+	CSPSelf          = "'self'"
+	CSPNone          = "'none'"
+	CSPUnsafeInline  = "'unsafe-inline'"
+	CSPUnsafeEval    = "'unsafe-eval'"
+	CSPStrictDynamic = "'strict-dynamic'"
+
+	cspNonceLength   = 16
+	maxCSPReportSize = 64 * 1024
+)
+
+// ContentSecurityPolicy represents a Content-Security-Policy. Each
+// field corresponds to the directive with the same name and contains
+// its sources (e.g. CSPSelf or https://cdn.example.com). Directives
+// without any sources are omitted.
+type ContentSecurityPolicy struct {
+	DefaultSrc     []string
+	ScriptSrc      []string
+	StyleSrc       []string
+	ImgSrc         []string
+	ConnectSrc     []string
+	FontSrc        []string
+	ObjectSrc      []string
+	MediaSrc       []string
+	FrameSrc       []string
+	FrameAncestors []string
+	BaseURI        []string
+	FormAction     []string
+	// Directives contains any additional directives, keyed by
+	// their name (e.g. "worker-src").
+	Directives map[string][]string
+	// UpgradeInsecureRequests adds the upgrade-insecure-requests
+	// directive to the policy.
+	UpgradeInsecureRequests bool
+	// Nonce enables generating a random nonce for every request, which
+	// is added to the script-src and style-src directives (or to a copy
+	// of default-src, if they're empty). The template assets renderer
+	// automatically adds the nonce to every <script> and <style> element
+	// it generates and templates might retrieve it using the "csp_nonce"
+	// function. Note that, in browsers which support nonces, their
+	// presence makes CSPUnsafeInline ineffective.
+	Nonce bool
+	// ReportOnly makes the policy use the
+	// Content-Security-Policy-Report-Only header, so violations
+	// are reported but resources are not blocked.
+	ReportOnly bool
+	// ReportURI indicates where violation reports are sent. If it's
+	// empty and ReportOnly is true, CSPReportPath is used.
+	ReportURI string
+}
+
+func (p *ContentSecurityPolicy) reportURI() string {
+	if p.ReportURI == "" && p.ReportOnly {
+		return CSPReportPath
+	}
+	return p.ReportURI
+}
+
+func (p *ContentSecurityPolicy) headerName() string {
+	if p.ReportOnly {
+		return "Content-Security-Policy-Report-Only"
+	}
+	return "Content-Security-Policy"
+}
+
+// withNonce returns the sources for a fetch directive which
+// falls back to default-src, including the given nonce.
+func (p *ContentSecurityPolicy) withNonce(sources []string, nonce string) []string {
+	if nonce == "" {
+		return sources
+	}
+	if len(sources) == 0 {
+		sources = p.DefaultSrc
+		if len(sources) == 0 {
+			return nil
+		}
+	}
+	withNonce := make([]string, len(sources), len(sources)+1)
+	copy(withNonce, sources)
+	return append(withNonce, "'nonce-"+nonce+"'")
+}
+
+// header returns the value for the header with the policy
+// using the given nonce, which might be empty.
+func (p *ContentSecurityPolicy) header(nonce string) string {
+	var directives []string
+	add := func(name string, sources []string) {
+		if len(sources) > 0 {
+			directives = append(directives, name+" "+strings.Join(sources, " "))
+		}
+	}
+	add("default-src", p.DefaultSrc)
+	add("script-src", p.withNonce(p.ScriptSrc, nonce))
+	add("style-src", p.withNonce(p.StyleSrc, nonce))
+	add("img-src", p.ImgSrc)
+	add("connect-src", p.ConnectSrc)
+	add("font-src", p.FontSrc)
+	add("object-src", p.ObjectSrc)
+	add("media-src", p.MediaSrc)
+	add("frame-src", p.FrameSrc)
+	add("frame-ancestors", p.FrameAncestors)
+	add("base-uri", p.BaseURI)
+	add("form-action", p.FormAction)
+	names := make([]string, 0, len(p.Directives))
+	for k := range p.Directives {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, v := range names {
+		add(v, p.Directives[v])
+	}
+	if p.UpgradeInsecureRequests {
+		directives = append(directives, "upgrade-insecure-requests")
+	}
+	if uri := p.reportURI(); uri != "" {
+		add("report-uri", []string{uri})
+	}
+	return strings.Join(directives, "; ")
+}
+
+// SecurityHeadersOptions specify the headers sent by the
+// SecurityHeaders Transformer. Empty fields are omitted.
+type SecurityHeadersOptions struct {
+	// CSP is the Content-Security-Policy sent with every response.
+	CSP *ContentSecurityPolicy
+	// FrameOptions is the value for the X-Frame-Options header,
+	// either "DENY" or "SAMEORIGIN".
+	FrameOptions string
+	// ReferrerPolicy is the value for the Referrer-Policy header
+	// (e.g. "strict-origin-when-cross-origin").
+	ReferrerPolicy string
+	// PermissionsPolicy maps features (e.g. "geolocation") to the
+	// origins allowed to use them, used to build the
+	// Permissions-Policy header. Besides origins, "self" and "*"
+	// are also accepted. An empty list disables the feature.
+	PermissionsPolicy map[string][]string
+	// NoSniff sends the X-Content-Type-Options: nosniff header.
+	NoSniff bool
+}
+
+func permissionsPolicyHeader(policy map[string][]string) string {
+	features := make([]string, 0, len(policy))
+	for k := range policy {
+		features = append(features, k)
+	}
+	sort.Strings(features)
+	values := make([]string, len(features))
+	for ii, f := range features {
+		var origins []string
+		for _, v := range policy[f] {
+			if v != "self" && v != "*" {
+				v = strconv.Quote(v)
+			}
+			origins = append(origins, v)
+		}
+		values[ii] = f + "=(" + strings.Join(origins, " ") + ")"
+	}
+	return strings.Join(values, ", ")
+}
+
+// SecurityHeaders returns a Transformer which adds the security related
+// headers specified by opts to every response. If opts.CSP has Nonce
+// enabled, a random nonce is generated for every request. It's available
+// via Context.CSPNonce and it's automatically added to the inline scripts
+// and styles generated by the template assets.
+//
+// When the policy reports violations to CSPReportPath (see
+// ContentSecurityPolicy.ReportURI), the Transformer also handles the
+// reports sent by browsers, using CSPReportHandler. Note that this only
+// works when the Transformer is added to the whole App. Otherwise,
+// CSPReportHandler must be registered explicitely.
+//
+// Keep in mind that responses stored by the cache layer (gnd.la/cache/layer)
+// are sent with the nonce they were generated with.
+//
+//  myapp.AddTransformer(app.SecurityHeaders(&app.SecurityHeadersOptions{
+//	CSP: &app.ContentSecurityPolicy{
+//		DefaultSrc: []string{app.CSPSelf},
+//		ImgSrc:     []string{app.CSPSelf, "data:"},
+//		Nonce:      true,
+//		ReportOnly: true,
+//	},
+//	FrameOptions:      "DENY",
+//	ReferrerPolicy:    "strict-origin-when-cross-origin",
+//	PermissionsPolicy: map[string][]string{"geolocation": nil, "camera": {"self"}},
+//  }))
+func SecurityHeaders(opts *SecurityHeadersOptions) Transformer {
+	var o SecurityHeadersOptions
+	if opts != nil {
+		o = *opts
+	}
+	var permissions string
+	if len(o.PermissionsPolicy) > 0 {
+		permissions = permissionsPolicyHeader(o.PermissionsPolicy)
+	}
+	var csp string
+	var handleReports bool
+	if o.CSP != nil {
+		if !o.CSP.Nonce {
+			csp = o.CSP.header("")
+		}
+		handleReports = o.CSP.reportURI() == CSPReportPath
+	}
+	return func(handler Handler) Handler {
+		return func(ctx *Context) {
+			if handleReports && ctx.R != nil && ctx.R.URL.Path == CSPReportPath {
+				CSPReportHandler(ctx)
+				return
+			}
+			h := ctx.Header()
+			if o.CSP != nil {
+				if o.CSP.Nonce {
+					ctx.cspNonce = base64.StdEncoding.EncodeToString(stringutil.RandomBytes(cspNonceLength))
+					h.Set(o.CSP.headerName(), o.CSP.header(ctx.cspNonce))
+				} else {
+					h.Set(o.CSP.headerName(), csp)
+				}
+			}
+			if o.FrameOptions != "" {
+				h.Set("X-Frame-Options", o.FrameOptions)
+			}
+			if o.ReferrerPolicy != "" {
+				h.Set("Referrer-Policy", o.ReferrerPolicy)
+			}
+			if permissions != "" {
+				h.Set("Permissions-Policy", permissions)
+			}
+			if o.NoSniff {
+				h.Set("X-Content-Type-Options", "nosniff")
+			}
+			handler(ctx)
+		}
+	}
+}
+
+// CSPNonce returns the Content-Security-Policy nonce for the current
+// request, or an empty string if there's none. See SecurityHeaders.
+func (c *Context) CSPNonce() string {
+	return c.cspNonce
+}
+
+// cspViolation contains the fields logged by CSPReportHandler,
+// which are sent with different names depending on the report
+// format.
+type cspViolation struct {
+	DocumentURI string
+	BlockedURI  string
+	Directive   string
+	SourceFile  string
+	Line        int
+}
+
+// cspReport is a report sent to a report-uri
+// with Content-Type application/csp-report.
+type cspReport struct {
+	Report struct {
+		DocumentURI        string `json:"document-uri"`
+		BlockedURI         string `json:"blocked-uri"`
+		ViolatedDirective  string `json:"violated-directive"`
+		EffectiveDirective string `json:"effective-directive"`
+		SourceFile         string `json:"source-file"`
+		LineNumber         int    `json:"line-number"`
+	} `json:"csp-report"`
+}
+
+// reportingAPIReport is a report sent by the Reporting API,
+// with Content-Type application/reports+json.
+type reportingAPIReport struct {
+	Type string `json:"type"`
+	Body struct {
+		DocumentURL        string `json:"documentURL"`
+		BlockedURL         string `json:"blockedURL"`
+		EffectiveDirective string `json:"effectiveDirective"`
+		SourceFile         string `json:"sourceFile"`
+		LineNumber         int    `json:"lineNumber"`
+	} `json:"body"`
+}
+
+func parseCSPReports(data []byte) ([]*cspViolation, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var reports []*reportingAPIReport
+		if err := json.Unmarshal(data, &reports); err != nil {
+			return nil, err
+		}
+		var violations []*cspViolation
+		for _, v := range reports {
+			if v.Type != "csp-violation" {
+				continue
+			}
+			violations = append(violations, &cspViolation{
+				DocumentURI: v.Body.DocumentURL,
+				BlockedURI:  v.Body.BlockedURL,
+				Directive:   v.Body.EffectiveDirective,
+				SourceFile:  v.Body.SourceFile,
+				Line:        v.Body.LineNumber,
+			})
+		}
+		return violations, nil
+	}
+	var report cspReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, err
+	}
+	directive := report.Report.EffectiveDirective
+	if directive == "" {
+		directive = report.Report.ViolatedDirective
+	}
+	return []*cspViolation{{
+		DocumentURI: report.Report.DocumentURI,
+		BlockedURI:  report.Report.BlockedURI,
+		Directive:   directive,
+		SourceFile:  report.Report.SourceFile,
+		Line:        report.Report.LineNumber,
+	}}, nil
+}
+
+// CSPReportHandler is a Handler which receives Content-Security-Policy
+// violation reports, either in the report-uri or in the Reporting API
+// formats, and logs them using the App Logger. It always responds
+// with a 204 (No Content), unless the request is not a POST, in which
+// case it responds with a 405 (Method Not Allowed). There's usually
+// no need to register it, since SecurityHeaders handles reports sent to
+// CSPReportPath.
+func CSPReportHandler(ctx *Context) {
+	if ctx.R.Method != "POST" {
+		ctx.Header().Set("Allow", "POST")
+		ctx.Error(http.StatusMethodNotAllowed)
+		return
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(ctx, maxCSPReportSize))
+	if err == nil {
+		var violations []*cspViolation
+		if violations, err = parseCSPReports(data); err == nil {
+			for _, v := range violations {
+				ctx.Logger().Warningf("CSP violation in %s: %s blocked by %s (%s:%d)",
+					v.DocumentURI, v.BlockedURI, v.Directive, v.SourceFile, v.Line)
+			}
+		}
+	}
+	if err != nil {
+		ctx.Logger().Warningf("invalid CSP report from %s: %s", ctx.RemoteAddress(), err)
+	}
+	ctx.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,135 @@
+package app
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gnd.la/log"
+)
+
+func TestContentSecurityPolicyHeader(t *testing.T) {
+	tests := []struct {
+		policy *ContentSecurityPolicy
+		nonce  string
+		header string
+	}{
+		{&ContentSecurityPolicy{DefaultSrc: []string{CSPSelf}}, "", "default-src 'self'"},
+		{&ContentSecurityPolicy{DefaultSrc: []string{CSPSelf}, ImgSrc: []string{CSPSelf, "data:"}, UpgradeInsecureRequests: true},
+			"", "default-src 'self'; img-src 'self' data:; upgrade-insecure-requests"},
+		{&ContentSecurityPolicy{DefaultSrc: []string{CSPSelf}, StyleSrc: []string{CSPSelf, CSPUnsafeInline}}, "abc",
+			"default-src 'self'; script-src 'self' 'nonce-abc'; style-src 'self' 'unsafe-inline' 'nonce-abc'"},
+		{&ContentSecurityPolicy{ObjectSrc: []string{CSPNone}}, "abc", "object-src 'none'"},
+		{&ContentSecurityPolicy{DefaultSrc: []string{CSPSelf}, ReportOnly: true, Directives: map[string][]string{"worker-src": {CSPNone}}},
+			"", "default-src 'self'; worker-src 'none'; report-uri " + CSPReportPath},
+		{&ContentSecurityPolicy{DefaultSrc: []string{CSPSelf}, ReportURI: "https://csp.example.com"},
+			"", "default-src 'self'; report-uri https://csp.example.com"},
+	}
+	for _, v := range tests {
+		if h := v.policy.header(v.nonce); h != v.header {
+			t.Errorf("expecting CSP %q, got %q", v.header, h)
+		}
+	}
+}
+
+func TestPermissionsPolicyHeader(t *testing.T) {
+	policy := map[string][]string{
+		"geolocation": nil,
+		"camera":      {"self", "https://example.com"},
+		"fullscreen":  {"*"},
+	}
+	expected := `camera=(self "https://example.com"), fullscreen=(*), geolocation=()`
+	if h := permissionsPolicyHeader(policy); h != expected {
+		t.Errorf("expecting Permissions-Policy %q, got %q", expected, h)
+	}
+}
+
+func TestParseCSPReports(t *testing.T) {
+	const report = `{"csp-report": {"document-uri": "https://example.com/", "blocked-uri": "inline",
+		"violated-directive": "script-src-elem", "source-file": "https://example.com/", "line-number": 7}}`
+	const reports = `[{"type": "csp-violation", "body": {"documentURL": "https://example.com/",
+		"blockedURL": "https://evil.com/x.js", "effectiveDirective": "script-src-elem"}},
+		{"type": "deprecation", "body": {}}]`
+	violations, err := parseCSPReports([]byte(report))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(violations) != 1 || violations[0].BlockedURI != "inline" || violations[0].Directive != "script-src-elem" || violations[0].Line != 7 {
+		t.Errorf("unexpected violations %+v", violations)
+	}
+	violations, err = parseCSPReports([]byte(reports))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(violations) != 1 || violations[0].BlockedURI != "https://evil.com/x.js" {
+		t.Errorf("unexpected violations %+v", violations)
+	}
+	if _, err := parseCSPReports([]byte("not json")); err == nil {
+		t.Error("expecting an error when parsing an invalid report")
+	}
+}
+
+func TestSecurityHeaders(t *testing.T) {
+	var buf bytes.Buffer
+	a := New()
+	a.Logger = log.New(log.NewIOWriter(&buf, log.LDebug), 0, log.LDebug)
+	a.AddTransformer(SecurityHeaders(&SecurityHeadersOptions{
+		CSP: &ContentSecurityPolicy{
+			DefaultSrc: []string{CSPSelf},
+			Nonce:      true,
+			ReportOnly: true,
+		},
+		FrameOptions:      "DENY",
+		ReferrerPolicy:    "no-referrer",
+		PermissionsPolicy: map[string][]string{"geolocation": nil},
+		NoSniff:           true,
+	}))
+	a.Handle("^/$", func(ctx *Context) {
+		ctx.WriteString(ctx.CSPNonce())
+	})
+	if err := a.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	serve := func(method string, body string) *httptest.ResponseRecorder {
+		path := "/"
+		if body != "" {
+			path = CSPReportPath
+		}
+		r, err := http.NewRequest(method, "http://localhost"+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, r)
+		return w
+	}
+	w := serve("GET", "")
+	nonce := w.Body.String()
+	if nonce == "" {
+		t.Fatal("empty CSP nonce")
+	}
+	expected := map[string]string{
+		"Content-Security-Policy-Report-Only": "default-src 'self'; script-src 'self' 'nonce-" + nonce + "'; style-src 'self' 'nonce-" + nonce + "'; report-uri " + CSPReportPath,
+		"X-Frame-Options":                     "DENY",
+		"Referrer-Policy":                     "no-referrer",
+		"Permissions-Policy":                  "geolocation=()",
+		"X-Content-Type-Options":              "nosniff",
+	}
+	for k, v := range expected {
+		if h := w.Header().Get(k); h != v {
+			t.Errorf("expecting header %s = %q, got %q", k, v, h)
+		}
+	}
+	if w := serve("GET", ""); w.Body.String() == nonce {
+		t.Error("CSP nonce was reused")
+	}
+	w = serve("POST", `{"csp-report": {"document-uri": "http://localhost/", "blocked-uri": "inline", "violated-directive": "script-src"}}`)
+	if w.Code != http.StatusNoContent {
+		t.Errorf("expecting code %d from report endpoint, got %d", http.StatusNoContent, w.Code)
+	}
+	if out := buf.String(); !strings.Contains(out, "CSP violation in http://localhost/: inline blocked by script-src") {
+		t.Errorf("CSP violation was not logged, log is %q", out)
+	}
+}
@@ -23,6 +23,18 @@ const (
 	XML
 )
 
+// ContentType returns the MIME type used for
+// the given Format.
+func (f Format) ContentType() string {
+	switch f {
+	case JSON:
+		return "application/json"
+	case XML:
+		return "application/xml"
+	}
+	return ""
+}
+
 // JSONWriter is the interface implemented by types which
 // can write themselves as JSON into an io.Writer. You can
 // use the gondola command for generating the code to implement
@@ -67,7 +79,6 @@ func putBuffer(buf *bytes.Buffer) {
 // occur while serializing or writing the serialized data.
 func Write(w io.Writer, value interface{}, f Format) (int, error) {
 	var data []byte
-	var contentType string
 	var err error
 	switch f {
 	case JSON:
@@ -87,7 +98,6 @@ func Write(w io.Writer, value interface{}, f Format) (int, error) {
 			// empty interface boxing.
 			data, err = json.Marshal(value)
 		}
-		contentType = "application/json"
 	case XML:
 		switch v := value.(type) {
 		case []byte:
@@ -95,7 +105,6 @@ func Write(w io.Writer, value interface{}, f Format) (int, error) {
 		default:
 			data, err = xml.Marshal(value)
 		}
-		contentType = "application/xml"
 	default:
 		panic("Invalid serialization format")
 	}
@@ -104,7 +113,7 @@ func Write(w io.Writer, value interface{}, f Format) (int, error) {
 	}
 	if rw, ok := w.(http.ResponseWriter); ok {
 		header := rw.Header()
-		header.Set("Content-Type", contentType)
+		header.Set("Content-Type", f.ContentType())
 		header.Set("Content-Length", strconv.Itoa(len(data)))
 	}
 	return w.Write(data)
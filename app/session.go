@@ -0,0 +1,532 @@
+package app
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"gnd.la/app/cookies"
+	"gnd.la/cache"
+	"gnd.la/encoding/codec"
+	"gnd.la/orm"
+	"gnd.la/util/stringutil"
+	"gnd.la/util/types"
+)
+
+const (
+	// The name of the cookie used to store the session. When using
+	// a server side SessionStore, the cookie only contains the
+	// session ID and it's signed using the gnd.la/app.App secret.
+	// Otherwise, it contains the whole session and it's encrypted
+	// using the gnd.la/app.App encryption key.
+	SESSION_COOKIE_NAME = "session"
+
+	sessionIDLength = 32
+	// sessionTouchInterval is the minimum time between updates
+	// to the last access time of a session which has not been
+	// modified, to avoid saving the session on every request.
+	sessionTouchInterval = time.Minute
+)
+
+var (
+	sessionCodec = codec.Get("gob")
+)
+
+// SessionStore is the interface implemented by server side session
+// backends. Stores receive and return the session already encoded, so
+// they don't need to know anything about its contents. Use Config.SessionStore
+// to select one of the builtin stores or App.SetSessionStore to use a custom one.
+type SessionStore interface {
+	// Load returns the data for the session with the given ID. If
+	// the session does not exist, it must return nil data and no error.
+	Load(ctx *Context, id string) ([]byte, error)
+	// Save stores the data for the session with the given ID. If
+	// maxAge is non-zero, the store might discard the session
+	// after it has elapsed.
+	Save(ctx *Context, id string, data []byte, maxAge time.Duration) error
+	// Delete removes the session with the given ID. Deleting
+	// a non-existent session must not return an error.
+	Delete(ctx *Context, id string) error
+}
+
+// cacheSessionStore stores the sessions in the App cache.
+type cacheSessionStore struct{}
+
+func (s cacheSessionStore) key(id string) string {
+	return "gondola-session-" + id
+}
+
+func (s cacheSessionStore) Load(ctx *Context, id string) ([]byte, error) {
+	data, err := ctx.Cache().GetBytes(s.key(id))
+	if err == cache.ErrNotFound {
+		return nil, nil
+	}
+	return data, err
+}
+
+func (s cacheSessionStore) Save(ctx *Context, id string, data []byte, maxAge time.Duration) error {
+	return ctx.Cache().SetBytes(s.key(id), data, int(maxAge/time.Second))
+}
+
+func (s cacheSessionStore) Delete(ctx *Context, id string) error {
+	return ctx.Cache().Delete(s.key(id))
+}
+
+// ormSession is the model used by ormSessionStore.
+type ormSession struct {
+	Id   string `orm:",primary_key"`
+	Data []byte
+	// Expires is an Unix timestamp, zero
+	// means the session never expires.
+	Expires int64 `orm:",index"`
+}
+
+var (
+	ormSessionType     = reflect.TypeOf(ormSession{})
+	registerOrmSession sync.Once
+)
+
+// ormSessionStore stores the sessions in the App ORM, using
+// the table gondola_sessions. Expired sessions are ignored
+// when loading and removed from time to time when saving.
+type ormSessionStore struct{}
+
+func (s ormSessionStore) register() {
+	registerOrmSession.Do(func() {
+		orm.Register(&ormSession{}, &orm.Options{Table: "gondola_sessions"})
+	})
+}
+
+func (s ormSessionStore) Load(ctx *Context, id string) ([]byte, error) {
+	var sess ormSession
+	ok, err := ctx.Orm().One(orm.Eq("Id", id), &sess)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if sess.Expires > 0 && sess.Expires < time.Now().Unix() {
+		return nil, nil
+	}
+	return sess.Data, nil
+}
+
+func (s ormSessionStore) Save(ctx *Context, id string, data []byte, maxAge time.Duration) error {
+	sess := &ormSession{Id: id, Data: data}
+	if maxAge > 0 {
+		sess.Expires = time.Now().Add(maxAge).Unix()
+	}
+	o := ctx.Orm()
+	if _, err := o.Save(sess); err != nil {
+		return err
+	}
+	// Remove expired sessions in ~1% of the saves
+	if stringutil.RandomBytes(1)[0] < 3 {
+		table := o.TypeTable(ormSessionType)
+		q := orm.And(orm.Gt("Expires", 0), orm.Lt("Expires", time.Now().Unix()))
+		if _, err := o.DeleteFrom(table, q); err != nil {
+			ctx.Logger().Errorf("error removing expired sessions: %s", err)
+		}
+	}
+	return nil
+}
+
+func (s ormSessionStore) Delete(ctx *Context, id string) error {
+	_, err := ctx.Orm().DeleteFrom(ctx.Orm().TypeTable(ormSessionType), orm.Eq("Id", id))
+	return err
+}
+
+// sessionData is the encoded representation of a Session.
+type sessionData struct {
+	ID       string
+	Values   map[string]interface{}
+	Created  time.Time
+	Accessed time.Time
+}
+
+// Session represents the data associated with a client across
+// requests. Use Context.Session to obtain the Session for the
+// current request.
+//
+// Sessions are encoded using the App CookieCodec (gob by default),
+// so any custom types stored in a Session must be registered with
+// encoding/gob. Sessions are only saved when they're modified (or
+// their last access time needs to be updated). The session cookie is
+// sent with the response headers, so new sessions (as well as any
+// session when using the cookie store) must be modified before
+// writing the response body.
+type Session struct {
+	ctx      *Context
+	id       string
+	values   map[string]interface{}
+	created  time.Time
+	accessed time.Time
+	// previous is the ID of the session
+	// before calling Regenerate, if any.
+	previous string
+	// stored indicates if the session
+	// was loaded from the store.
+	stored        bool
+	modified      bool
+	touched       bool
+	rotated       bool
+	cookieWritten bool
+}
+
+// ID returns the session ID. Note that sessions using the
+// cookie store also have an ID, but it's only stored inside
+// the cookie.
+func (s *Session) ID() string {
+	return s.id
+}
+
+// Created returns the time when the session was created.
+func (s *Session) Created() time.Time {
+	return s.created
+}
+
+// Accessed returns the last time the session was accessed
+// before the current request.
+func (s *Session) Accessed() time.Time {
+	return s.accessed
+}
+
+// IsNew returns true iff the session has not been saved yet.
+func (s *Session) IsNew() bool {
+	return !s.stored
+}
+
+// Get returns the value associated with the given key,
+// or nil if there's no such key.
+func (s *Session) Get(key string) interface{} {
+	return s.values[key]
+}
+
+// Has returns true iff the session has a value
+// for the given key.
+func (s *Session) Has(key string) bool {
+	_, ok := s.values[key]
+	return ok
+}
+
+// GetString returns the value associated with the given key
+// converted to a string, or the empty string if there's no
+// such key.
+func (s *Session) GetString(key string) string {
+	if v, ok := s.values[key]; ok {
+		return types.ToString(v)
+	}
+	return ""
+}
+
+// GetInt returns the value associated with the given key
+// converted to an int, or 0 if there's no such key or it
+// can't be converted.
+func (s *Session) GetInt(key string) int {
+	val, _ := types.ToInt(s.values[key])
+	return val
+}
+
+// GetInt64 works like GetInt, but returns an int64.
+func (s *Session) GetInt64(key string) int64 {
+	val, _ := types.ToInt64(s.values[key])
+	return val
+}
+
+// GetBool returns the value associated with the given key
+// converted to a bool, or false if there's no such key.
+func (s *Session) GetBool(key string) bool {
+	truth, _ := types.IsTrue(s.values[key])
+	return truth
+}
+
+// Keys returns the keys in the session, sorted
+// alphabetically.
+func (s *Session) Keys() []string {
+	keys := make([]string, 0, len(s.values))
+	for k := range s.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Set associates the given value with the given key,
+// replacing any previous value.
+func (s *Session) Set(key string, value interface{}) {
+	s.values[key] = value
+	s.modified = true
+}
+
+// Delete removes the given key from the session. If the
+// key does not exist, it does nothing.
+func (s *Session) Delete(key string) {
+	if _, ok := s.values[key]; ok {
+		delete(s.values, key)
+		s.modified = true
+	}
+}
+
+// Clear removes all the values from the session.
+func (s *Session) Clear() {
+	if len(s.values) > 0 {
+		s.values = make(map[string]interface{})
+		s.modified = true
+	}
+}
+
+// Regenerate assigns a new ID to the session, keeping its values,
+// and removes the session stored with the previous ID. It should be
+// called every time the privilege level changes, to prevent session
+// fixation attacks. Note that Context.SignIn and Context.SignOut
+// already call it.
+func (s *Session) Regenerate() {
+	if s.stored && s.previous == "" {
+		s.previous = s.id
+	}
+	s.id = newSessionID()
+	s.created = time.Now()
+	s.rotated = true
+	s.modified = true
+}
+
+func (s *Session) dirty() bool {
+	return s.modified || s.touched
+}
+
+func (s *Session) data() *sessionData {
+	return &sessionData{
+		ID:       s.id,
+		Values:   s.values,
+		Created:  s.created,
+		Accessed: time.Now(),
+	}
+}
+
+// maxAge returns the time the session should be kept
+// in the store, taking into account both the idle and
+// absolute timeouts. Zero means no expiration.
+func (s *Session) maxAge() time.Duration {
+	cfg := s.ctx.app.cfg
+	idle := time.Duration(cfg.SessionIdleTimeout) * time.Second
+	var remaining time.Duration
+	if cfg.SessionMaxAge > 0 {
+		remaining = s.created.Add(time.Duration(cfg.SessionMaxAge) * time.Second).Sub(time.Now())
+		if remaining <= 0 {
+			remaining = time.Second
+		}
+	}
+	if idle > 0 && (remaining == 0 || idle < remaining) {
+		return idle
+	}
+	return remaining
+}
+
+func (s *Session) expired(data *sessionData, now time.Time) bool {
+	cfg := s.ctx.app.cfg
+	if cfg.SessionIdleTimeout > 0 && now.Sub(data.Accessed) > time.Duration(cfg.SessionIdleTimeout)*time.Second {
+		return true
+	}
+	if cfg.SessionMaxAge > 0 && now.Sub(data.Created) > time.Duration(cfg.SessionMaxAge)*time.Second {
+		return true
+	}
+	return false
+}
+
+func (s *Session) cookieOptions() *cookies.Options {
+	var opts cookies.Options
+	if o := s.ctx.app.CookieOptions; o != nil {
+		opts = *o
+	} else {
+		opts = *cookies.Defaults()
+	}
+	opts.HttpOnly = true
+	opts.MaxAge = 0
+	if maxAge := s.ctx.app.cfg.SessionMaxAge; maxAge > 0 {
+		opts.Expires = s.created.Add(time.Duration(maxAge) * time.Second)
+	}
+	return &opts
+}
+
+// writeCookie sends the session cookie, if required. It's
+// called just before the response headers are written.
+func (s *Session) writeCookie() error {
+	if s.cookieWritten || !s.dirty() {
+		return nil
+	}
+	s.cookieWritten = true
+	if s.ctx.app.sessionStore() == nil {
+		return s.ctx.Cookies().SetEncryptedOpts(SESSION_COOKIE_NAME, s.data(), s.cookieOptions())
+	}
+	if !s.stored || s.rotated {
+		return s.ctx.Cookies().SetSecureOpts(SESSION_COOKIE_NAME, s.id, s.cookieOptions())
+	}
+	return nil
+}
+
+// save stores the session if it's been modified or
+// touched. It's called when the Context is closed.
+func (s *Session) save() error {
+	if err := s.writeCookie(); err != nil {
+		return err
+	}
+	store := s.ctx.app.sessionStore()
+	if store == nil || !s.dirty() {
+		return nil
+	}
+	if s.previous != "" {
+		if err := store.Delete(s.ctx, s.previous); err != nil {
+			return err
+		}
+		s.previous = ""
+	}
+	data, err := s.ctx.app.sessionCodec().Encode(s.data())
+	if err != nil {
+		return err
+	}
+	if err := store.Save(s.ctx, s.id, data, s.maxAge()); err != nil {
+		return err
+	}
+	s.stored = true
+	s.modified = false
+	s.touched = false
+	return nil
+}
+
+func newSessionID() string {
+	return stringutil.Random(sessionIDLength)
+}
+
+// loadSessionData returns the stored data for the session
+// sent by the client, if any.
+func (c *Context) loadSessionData() (*sessionData, error) {
+	cookies := c.Cookies()
+	if !cookies.Has(SESSION_COOKIE_NAME) {
+		return nil, nil
+	}
+	var data sessionData
+	store := c.app.sessionStore()
+	if store == nil {
+		if err := cookies.GetEncrypted(SESSION_COOKIE_NAME, &data); err != nil {
+			return nil, err
+		}
+		return &data, nil
+	}
+	var id string
+	if err := cookies.GetSecure(SESSION_COOKIE_NAME, &id); err != nil {
+		return nil, err
+	}
+	b, err := store.Load(c, id)
+	if err != nil || b == nil {
+		return nil, err
+	}
+	if err := c.app.sessionCodec().Decode(b, &data); err != nil {
+		return nil, err
+	}
+	if data.ID != id {
+		return nil, fmt.Errorf("session ID mismatch (%q != %q)", data.ID, id)
+	}
+	return &data, nil
+}
+
+// Session returns the Session for the current request. The session is
+// loaded on the first call, creating a new one if the client didn't
+// send a session or it expired (see Config.SessionIdleTimeout and
+// Config.SessionMaxAge). Sessions are stored using the App SessionStore
+// (see Config.SessionStore and App.SetSessionStore) and saved after the
+// request is processed, only when they've been modified.
+func (c *Context) Session() *Session {
+	if c.session == nil {
+		now := time.Now()
+		s := &Session{ctx: c}
+		data, err := c.loadSessionData()
+		if err != nil {
+			c.Logger().Warningf("error loading session: %s", err)
+		}
+		if data != nil && s.expired(data, now) {
+			if store := c.app.sessionStore(); store != nil {
+				if err := store.Delete(c, data.ID); err != nil {
+					c.Logger().Errorf("error deleting expired session: %s", err)
+				}
+			}
+			data = nil
+		}
+		if data != nil {
+			s.id = data.ID
+			s.values = data.Values
+			s.created = data.Created
+			s.accessed = data.Accessed
+			s.stored = true
+			s.touched = now.Sub(data.Accessed) > sessionTouchInterval
+		} else {
+			s.id = newSessionID()
+			s.created = now
+			s.accessed = now
+		}
+		if s.values == nil {
+			s.values = make(map[string]interface{})
+		}
+		c.session = s
+	}
+	return c.session
+}
+
+// hasSession returns true if the session has been loaded or
+// the client sent a session cookie.
+func (c *Context) hasSession() bool {
+	return c.session != nil || c.Cookies().Has(SESSION_COOKIE_NAME)
+}
+
+// saveSession is the ContextFinalizer which stores the
+// session, if it has been loaded.
+func saveSession(ctx *Context) {
+	if ctx.session != nil {
+		if err := ctx.session.save(); err != nil {
+			ctx.Logger().Errorf("error saving session: %s", err)
+		}
+	}
+}
+
+// SetSessionStore sets the SessionStore used by the App, overriding
+// the one selected by Config.SessionStore. If store is nil, sessions
+// are stored in an encrypted cookie. Included apps always use the
+// store of their parent.
+func (app *App) SetSessionStore(store SessionStore) {
+	app.root().sessions = store
+}
+
+// sessionStore returns the SessionStore used by the App or
+// nil when sessions are stored in a cookie.
+func (app *App) sessionStore() SessionStore {
+	root := app.root()
+	if root.sessions != nil {
+		return root.sessions
+	}
+	switch root.cfg.SessionStore {
+	case "cache":
+		return cacheSessionStore{}
+	case "orm":
+		return ormSessionStore{}
+	}
+	return nil
+}
+
+func (app *App) sessionCodec() *codec.Codec {
+	if c := app.CookieCodec; c != nil {
+		return c
+	}
+	return sessionCodec
+}
+
+// prepareSessions validates Config.SessionStore and registers
+// the ORM model when sessions are stored in the ORM.
+func (app *App) prepareSessions() error {
+	switch app.cfg.SessionStore {
+	case "", "cookie", "cache":
+	case "orm":
+		ormSessionStore{}.register()
+	default:
+		return fmt.Errorf("invalid session store %q, must be cookie, cache or orm", app.cfg.SessionStore)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"gnd.la/config"
+)
+
+type sessionTestUser int64
+
+func (u sessionTestUser) Id() int64     { return int64(u) }
+func (u sessionTestUser) IsAdmin() bool { return false }
+
+// sessionClient performs requests against an App,
+// sending back the cookies it receives.
+type sessionClient struct {
+	t       *testing.T
+	app     *App
+	cookies map[string]*http.Cookie
+}
+
+func (c *sessionClient) get(path string) (string, []*http.Cookie) {
+	r, err := http.NewRequest("GET", "http://localhost"+path, nil)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	for _, v := range c.cookies {
+		r.AddCookie(v)
+	}
+	w := httptest.NewRecorder()
+	c.app.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		c.t.Fatalf("GET %s returned %d", path, w.Code)
+	}
+	resp := &http.Response{Header: w.Header()}
+	received := resp.Cookies()
+	for _, v := range received {
+		if v.MaxAge < 0 {
+			delete(c.cookies, v.Name)
+		} else {
+			c.cookies[v.Name] = v
+		}
+	}
+	return w.Body.String(), received
+}
+
+func (c *sessionClient) expect(path string, body string) {
+	if got, _ := c.get(path); got != body {
+		c.t.Errorf("GET %s returned %q, expecting %q", path, got, body)
+	}
+}
+
+func newSessionTestApp(t *testing.T, cfg *Config) *sessionClient {
+	cfg.Secret = strings.Repeat("s", 32)
+	cfg.EncryptionKey = strings.Repeat("k", 32)
+	a := NewWithConfig(cfg)
+	a.SetUserFunc(func(ctx *Context, id int64) User {
+		return sessionTestUser(id)
+	})
+	a.Handle("^/set/$", func(ctx *Context) {
+		ctx.Session().Set("value", ctx.FormValue("v"))
+		ctx.WriteString("ok")
+	})
+	a.Handle("^/get/$", func(ctx *Context) {
+		ctx.WriteString(ctx.Session().GetString("value"))
+	})
+	a.Handle("^/id/$", func(ctx *Context) {
+		ctx.WriteString(ctx.Session().ID())
+	})
+	a.Handle("^/signin/$", func(ctx *Context) {
+		ctx.MustSignIn(sessionTestUser(1))
+	})
+	a.Handle("^/signout/$", func(ctx *Context) {
+		ctx.SignOut()
+	})
+	if err := a.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	return &sessionClient{t: t, app: a, cookies: make(map[string]*http.Cookie)}
+}
+
+func testSessions(t *testing.T, cfg *Config) {
+	c := newSessionTestApp(t, cfg)
+	if _, cookies := c.get("/get/"); len(cookies) != 0 {
+		t.Errorf("unmodified session sent cookies %v", cookies)
+	}
+	c.expect("/set/?v=foo", "ok")
+	if c.cookies[SESSION_COOKIE_NAME] == nil {
+		t.Fatal("session cookie was not set")
+	}
+	if !c.cookies[SESSION_COOKIE_NAME].HttpOnly {
+		t.Error("session cookie is not HttpOnly")
+	}
+	c.expect("/get/", "foo")
+	id, _ := c.get("/id/")
+	c.expect("/signin/", "")
+	c.expect("/get/", "foo")
+	if newID, _ := c.get("/id/"); newID == id {
+		t.Errorf("session ID was not rotated on sign in")
+	}
+	c.expect("/signout/", "")
+	c.expect("/get/", "")
+}
+
+func TestCookieSessions(t *testing.T) {
+	testSessions(t, &Config{SessionStore: "cookie"})
+}
+
+func TestCacheSessions(t *testing.T) {
+	u, err := config.ParseURL("memory://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &Config{SessionStore: "cache", Cache: u}
+	testSessions(t, cfg)
+	// Sessions stored in the server should be
+	// removed when the ID is rotated.
+	c := newSessionTestApp(t, cfg)
+	c.expect("/set/?v=bar", "ok")
+	id, _ := c.get("/id/")
+	c.expect("/signin/", "")
+	cache, err := c.app.Cache()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cache.GetBytes(cacheSessionStore{}.key(id)); err == nil {
+		t.Errorf("session %s was not removed after rotating its ID", id)
+	}
+}
+
+func TestSessionExpiration(t *testing.T) {
+	ctx := &Context{app: NewWithConfig(&Config{SessionIdleTimeout: 60, SessionMaxAge: 3600})}
+	s := &Session{ctx: ctx}
+	now := time.Now()
+	tests := []struct {
+		created  time.Duration
+		accessed time.Duration
+		expired  bool
+	}{
+		{0, 0, false},
+		{-time.Minute * 30, -time.Second * 30, false},
+		{-time.Minute * 30, -time.Minute * 2, true},
+		{-time.Hour * 2, -time.Second, true},
+	}
+	for _, v := range tests {
+		data := &sessionData{Created: now.Add(v.created), Accessed: now.Add(v.accessed)}
+		if exp := s.expired(data, now); exp != v.expired {
+			t.Errorf("expecting expired = %v for created %s, accessed %s, got %v", v.expired, v.created, v.accessed, exp)
+		}
+	}
+}
+
+func TestInvalidSessionStore(t *testing.T) {
+	a := NewWithConfig(&Config{SessionStore: "invalid"})
+	if err := a.Prepare(); err == nil {
+		t.Error("expecting an error with an invalid session store")
+	}
+}
@@ -0,0 +1,127 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+var (
+	errNotListening = errors.New("app is not listening")
+)
+
+// root returns the top level App, the one which has no
+// parent.
+func (app *App) root() *App {
+	for app.parent != nil {
+		app = app.parent
+	}
+	return app
+}
+
+// trapSignals calls Shutdown when the process receives SIGINT
+// or SIGTERM, until the App stops listening.
+func (app *App) trapSignals(done <-chan struct{}) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(ch)
+	select {
+	case sig := <-ch:
+		if app.Logger != nil {
+			app.Logger.Infof("received %s, shutting down", sig)
+		}
+		app.Shutdown()
+	case <-done:
+	}
+}
+
+// Shutdown gracefully stops an App which is listening via ListenAndServe.
+// It stops accepting new connections and then waits for active requests
+// as well as any background contexts started with Context.Go to finish,
+// up to the time specified by Config.ShutdownTimeout. Finally, it closes
+// the App Orm, Cache and Blobstore (if they were opened) and makes
+// ListenAndServe return. Note that ListenAndServe automatically calls
+// Shutdown when the process receives SIGINT or SIGTERM.
+//
+// Signals.WillShutdown is emitted before the App stops accepting
+// connections, while Signals.DidShutdown is emitted after all
+// resources have been closed, before ListenAndServe returns.
+func (app *App) Shutdown() error {
+	var srv, redirectSrv *http.Server
+	app.locked(func() {
+		srv = app.server
+		redirectSrv = app.redirectServer
+		app.server = nil
+		app.redirectServer = nil
+	})
+	if srv == nil {
+		return errNotListening
+	}
+	Signals.WillShutdown.emit(app)
+	ctx := context.Background()
+	if timeout := app.cfg.ShutdownTimeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+		defer cancel()
+	}
+	if redirectSrv != nil {
+		redirectSrv.Shutdown(ctx)
+	}
+	err := srv.Shutdown(ctx)
+	if err == nil {
+		err = app.waitBackground(ctx)
+	}
+	if cerr := app.closeResources(); err == nil {
+		err = cerr
+	}
+	app.shutdownErr = err
+	// Emit DidShutdown before making ListenAndServe return,
+	// otherwise the process might exit before the listeners
+	// run.
+	Signals.DidShutdown.emit(app)
+	close(app.shutdown)
+	return err
+}
+
+// waitBackground waits until all background contexts have
+// finished or ctx expires.
+func (app *App) waitBackground(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		app.background.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (app *App) closeResources() error {
+	var err error
+	app.locked(func() {
+		if app.o != nil {
+			err = app.o.Close()
+			app.o = nil
+		}
+		if app.c != nil {
+			if cerr := app.c.Close(); err == nil {
+				err = cerr
+			}
+			app.c = nil
+		}
+		if app.store != nil {
+			if serr := app.store.Close(); err == nil {
+				err = serr
+			}
+			app.store = nil
+		}
+	})
+	return err
+}
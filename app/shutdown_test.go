@@ -0,0 +1,98 @@
+package app
+
+import (
+	"net"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func freePort(t *testing.T) int {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+func testShutdown(t *testing.T, shutdown func(a *App)) {
+	a := NewWithConfig(&Config{Port: freePort(t)})
+	a.Logger = nil
+	events := make(chan string, 10)
+	listening := make(chan struct{})
+	for _, v := range []struct {
+		signal *appSignal
+		event  string
+	}{
+		{Signals.WillShutdown, "will-shutdown"},
+		{Signals.DidShutdown, "did-shutdown"},
+	} {
+		event := v.event
+		listener := v.signal.Listen(func(app *App) {
+			if app == a {
+				// Give ListenAndServe a chance to return
+				// before the listener finishes, to check
+				// the listeners run first.
+				time.Sleep(50 * time.Millisecond)
+				events <- event
+			}
+		})
+		defer listener.Remove()
+	}
+	listener := Signals.DidListen.Listen(func(app *App) {
+		if app == a {
+			close(listening)
+		}
+	})
+	defer listener.Remove()
+	go func() {
+		if err := a.ListenAndServe(); err != nil {
+			t.Error(err)
+		}
+		events <- "return"
+	}()
+	select {
+	case <-listening:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the App to listen")
+	}
+	shutdown(a)
+	var got []string
+	for len(got) < 3 {
+		select {
+		case ev := <-events:
+			got = append(got, ev)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for shutdown, got events %v", got)
+		}
+	}
+	if s := strings.Join(got, ","); s != "will-shutdown,did-shutdown,return" {
+		t.Errorf("expecting events will-shutdown,did-shutdown,return, got %s", s)
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	testShutdown(t, func(a *App) {
+		if err := a.Shutdown(); err != nil {
+			t.Error(err)
+		}
+		if err := a.Shutdown(); err != errNotListening {
+			t.Errorf("expecting errNotListening when shutting down twice, got %v", err)
+		}
+	})
+}
+
+func TestShutdownSignal(t *testing.T) {
+	testShutdown(t, func(a *App) {
+		p, err := os.FindProcess(os.Getpid())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := p.Signal(syscall.SIGTERM); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
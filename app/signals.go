@@ -31,9 +31,17 @@ var Signals = struct {
 	WillPrepare *appSignal
 	// DidPrepare is emitted when App.Prepare ends without errors.
 	DidPrepare *appSignal
+	// WillShutdown is emitted when a *gnd.la/app.App starts shutting
+	// down, before it stops accepting new connections.
+	WillShutdown *appSignal
+	// DidShutdown is emitted after a *gnd.la/app.App has finished
+	// serving its active requests and closed its resources.
+	DidShutdown *appSignal
 }{
-	WillListen:  &appSignal{signals.New("will-listen")},
-	DidListen:   &appSignal{signals.New("did-listen")},
-	WillPrepare: &appSignal{signals.New("will-prepare")},
-	DidPrepare:  &appSignal{signals.New("did-prepare")},
+	WillListen:   &appSignal{signals.New("will-listen")},
+	DidListen:    &appSignal{signals.New("did-listen")},
+	WillPrepare:  &appSignal{signals.New("will-prepare")},
+	DidPrepare:   &appSignal{signals.New("did-prepare")},
+	WillShutdown: &appSignal{signals.New("will-shutdown")},
+	DidShutdown:  &appSignal{signals.New("did-shutdown")},
 }
@@ -25,6 +25,10 @@ var (
 		{Name: "tn", Fn: template_tn, Traits: template.FuncTraitContext},
 		{Name: "tc", Fn: template_tc, Traits: template.FuncTraitContext},
 		{Name: "tnc", Fn: template_tnc, Traits: template.FuncTraitContext},
+		{Name: "flashes", Fn: template_flashes, Traits: template.FuncTraitContext},
+		{Name: "render_flashes", Fn: template_render_flashes, Traits: template.FuncTraitContext},
+		{Name: "has_permission", Fn: template_has_permission, Traits: template.FuncTraitContext},
+		{Name: "has_role", Fn: template_has_role, Traits: template.FuncTraitContext},
 		{Name: "app", Fn: nop},
 		{Name: templateutil.BeginTranslatableBlock, Fn: nop},
 		{Name: templateutil.EndTranslatableBlock, Fn: nop},
@@ -38,6 +42,11 @@ type TemplateProcessor func(*template.Template) (*template.Template, error)
 // reversing and translations, and always passes the current *Context
 // as the template Context.
 //
+// Pending flash messages (see Context.Flash) can be displayed using the
+// "render_flashes" function, which renders them using the default
+// FlashRenderer, or iterated over with the "flashes" function. Both of
+// them remove the messages, so they're only displayed once.
+//
 // When executing these templates, at least the @Ctx variable is always passed
 // to the template, representing the current *app.Context.
 // To define additional variables, use App.AddTemplateVars.
@@ -84,7 +93,7 @@ func (t *Template) prepare() error {
 // reverse is passed as a template function without context, to allow
 // calling reverse from asset templates
 func (t *Template) reverse(name string, args ...interface{}) (string, error) {
-	return t.app.reverse(name, args)
+	return t.app.reverse(name, args, nil)
 }
 
 // Execute executes the template, writing its result to the given
@@ -0,0 +1,133 @@
+package tester
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gnd.la/app"
+)
+
+// Event represents an event received in a Server-Sent Events
+// response (see app.Context.EventStream). Use Request.Events
+// or Request.ExpectEvents to test handlers which stream events.
+type Event struct {
+	// Event is the event name. Empty for events sent
+	// without a name.
+	Event string
+	// ID is the event id.
+	ID string
+	// Data contains the event payload. When the event has
+	// multiple data lines, they're joined using \n.
+	Data string
+	// Retry is the reconnection time sent to the client
+	// before this event, if any.
+	Retry time.Duration
+}
+
+func (e *Event) String() string {
+	return fmt.Sprintf("{event: %q, id: %q, data: %q}", e.Event, e.ID, e.Data)
+}
+
+// parseEvents parses a text/event-stream response body, ignoring
+// any comments (e.g. heartbeats).
+func parseEvents(data []byte) ([]*Event, error) {
+	var events []*Event
+	var cur *Event
+	var retry time.Duration
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			if cur != nil {
+				cur.Data = strings.Join(lines, "\n")
+				cur.Retry = retry
+				events = append(events, cur)
+			}
+			cur = nil
+			lines = nil
+			continue
+		}
+		if line[0] == ':' {
+			// Comment
+			continue
+		}
+		field, value := line, ""
+		if sep := strings.IndexByte(line, ':'); sep >= 0 {
+			field, value = line[:sep], strings.TrimPrefix(line[sep+1:], " ")
+		}
+		if field == "retry" {
+			ms, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid retry value %q", value)
+			}
+			retry = time.Duration(ms) * time.Millisecond
+			continue
+		}
+		if cur == nil {
+			cur = new(Event)
+		}
+		switch field {
+		case "event":
+			cur.Event = value
+		case "id":
+			cur.ID = value
+		case "data":
+			lines = append(lines, value)
+		default:
+			return nil, fmt.Errorf("invalid event field %q", field)
+		}
+	}
+	return events, scanner.Err()
+}
+
+// Events sends the request (if it hasn't been sent yet) and parses
+// the response as a Server-Sent Events stream, returning the received
+// events. If the response is not an event stream or it can't be parsed,
+// an error is reported and nil is returned. Note that when testing
+// against the compiled App, the Handler must return for the request
+// to finish, so streaming handlers should stop after sending the events
+// required by the test.
+func (r *Request) Events() []*Event {
+	if !r.do() {
+		return nil
+	}
+	if ct := r.resp.Header().Get("Content-Type"); !strings.HasPrefix(ct, app.EventStreamContentType) {
+		r.errorf("expecting Content-Type %q, got %q instead", app.EventStreamContentType, ct)
+		return nil
+	}
+	events, err := parseEvents(r.resp.body.Bytes())
+	if err != nil {
+		r.errorf("error parsing events: %s", err)
+		return nil
+	}
+	return events
+}
+
+// ExpectEvents checks that the response is a Server-Sent Events stream
+// containing exactly the given events. Only the Event, ID and Data
+// fields are compared. It returns the same *Request, to allow chaining.
+func (r *Request) ExpectEvents(events ...*Event) *Request {
+	received := r.Events()
+	if r.err != nil {
+		return r
+	}
+	if len(received) != len(events) {
+		r.errorf("expecting %d events, got %d instead (%v)", len(events), len(received), received)
+		return r
+	}
+	for ii, v := range events {
+		got := &Event{Event: received[ii].Event, ID: received[ii].ID, Data: received[ii].Data}
+		exp := &Event{Event: v.Event, ID: v.ID, Data: v.Data}
+		if !reflect.DeepEqual(got, exp) {
+			r.errorf("expecting event %d = %v, got %v instead", ii, exp, got)
+			return r
+		}
+	}
+	return r
+}
@@ -157,6 +157,11 @@ func (r *response) WriteHeader(code int) {
 	r.code = code
 }
 
+// Flush implements http.Flusher, so handlers which stream
+// their responses can be tested. Since the whole response
+// is kept in memory, it does nothing.
+func (r *response) Flush() {}
+
 // benchResponse is used for benchmarks. It does nothing
 // on WriteHeader() and Write(), since this removes the
 // noise caused by copying the response bytes to the
@@ -168,6 +173,7 @@ type benchResponse struct {
 func (r *benchResponse) Header() http.Header         { return r.header }
 func (r *benchResponse) Write(b []byte) (int, error) { return len(b), nil }
 func (r *benchResponse) WriteHeader(code int)        {}
+func (r *benchResponse) Flush()                      {}
 
 // A Request represents a request to be sent to
 // the app. Users won't usually need to construct
@@ -0,0 +1,258 @@
+package app
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	// certificateCheckInterval is the interval used to check
+	// if the certificate files have been modified.
+	certificateCheckInterval = 30 * time.Second
+)
+
+var (
+	errNoCertificates = errors.New("no TLS certificates found")
+)
+
+// certificateStore holds the certificates used by an App
+// serving TLS, which might be reloaded without restarting
+// the App.
+type certificateStore struct {
+	certFile string
+	keyFile  string
+	dir      string
+
+	mu       sync.RWMutex
+	def      *tls.Certificate
+	byName   map[string]*tls.Certificate
+	modTimes map[string]time.Time
+}
+
+func newCertificateStore(cfg *Config) (*certificateStore, error) {
+	s := &certificateStore{
+		certFile: cfg.TLSCertificate,
+		keyFile:  cfg.TLSKey,
+		dir:      cfg.TLSCertificatesDir,
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// files returns the certificate and key file pairs
+// used by the store.
+func (s *certificateStore) files() ([][2]string, error) {
+	var pairs [][2]string
+	if s.certFile != "" {
+		keyFile := s.keyFile
+		if keyFile == "" {
+			// Assume both are in the same file
+			keyFile = s.certFile
+		}
+		pairs = append(pairs, [2]string{s.certFile, keyFile})
+	}
+	if s.dir != "" {
+		infos, err := ioutil.ReadDir(s.dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range infos {
+			name := v.Name()
+			ext := filepath.Ext(name)
+			if v.IsDir() || (ext != ".crt" && ext != ".pem") {
+				continue
+			}
+			certFile := filepath.Join(s.dir, name)
+			keyFile := filepath.Join(s.dir, strings.TrimSuffix(name, ext)+".key")
+			pairs = append(pairs, [2]string{certFile, keyFile})
+		}
+	}
+	return pairs, nil
+}
+
+func (s *certificateStore) load() error {
+	pairs, err := s.files()
+	if err != nil {
+		return err
+	}
+	var def *tls.Certificate
+	byName := make(map[string]*tls.Certificate)
+	modTimes := make(map[string]time.Time)
+	for _, v := range pairs {
+		cert, err := tls.LoadX509KeyPair(v[0], v[1])
+		if err != nil {
+			return fmt.Errorf("error loading certificate %s: %s", v[0], err)
+		}
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			return fmt.Errorf("error parsing certificate %s: %s", v[0], err)
+		}
+		cert.Leaf = leaf
+		if def == nil {
+			def = &cert
+		}
+		// Don't append to leaf.DNSNames, since it might
+		// write into the certificate's own slice.
+		names := make([]string, len(leaf.DNSNames), len(leaf.DNSNames)+1)
+		copy(names, leaf.DNSNames)
+		if leaf.Subject.CommonName != "" {
+			names = append(names, leaf.Subject.CommonName)
+		}
+		for _, n := range names {
+			n = strings.ToLower(n)
+			if _, ok := byName[n]; !ok {
+				byName[n] = &cert
+			}
+		}
+		for _, f := range v {
+			if st, err := os.Stat(f); err == nil {
+				modTimes[f] = st.ModTime()
+			}
+		}
+	}
+	if def == nil {
+		return errNoCertificates
+	}
+	s.mu.Lock()
+	s.def = def
+	s.byName = byName
+	s.modTimes = modTimes
+	s.mu.Unlock()
+	return nil
+}
+
+// changed returns true iff any of the certificate files
+// has been modified since the certificates were loaded.
+func (s *certificateStore) changed() bool {
+	pairs, err := s.files()
+	if err != nil {
+		return false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	count := 0
+	for _, v := range pairs {
+		for _, f := range v {
+			st, err := os.Stat(f)
+			if err != nil {
+				return false
+			}
+			if mt, ok := s.modTimes[f]; !ok || !mt.Equal(st.ModTime()) {
+				return true
+			}
+			count++
+		}
+	}
+	return count != len(s.modTimes)
+}
+
+// getCertificate implements tls.Config.GetCertificate, selecting
+// the certificate using the SNI sent by the client.
+func (s *certificateStore) getCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if name := strings.ToLower(hello.ServerName); name != "" {
+		if cert := s.byName[name]; cert != nil {
+			return cert, nil
+		}
+		if dot := strings.IndexByte(name, '.'); dot >= 0 {
+			if cert := s.byName["*"+name[dot:]]; cert != nil {
+				return cert, nil
+			}
+		}
+	}
+	return s.def, nil
+}
+
+// watch reloads the certificates when the process receives
+// SIGHUP or when any of the files is modified, until done
+// is closed.
+func (s *certificateStore) watch(app *App, done <-chan struct{}) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+	defer signal.Stop(ch)
+	ticker := time.NewTicker(certificateCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ch:
+		case <-ticker.C:
+			if !s.changed() {
+				continue
+			}
+		case <-done:
+			return
+		}
+		if err := s.load(); err != nil {
+			if app.Logger != nil {
+				app.Logger.Errorf("error reloading TLS certificates: %s", err)
+			}
+			continue
+		}
+		if app.Logger != nil {
+			app.Logger.Infof("reloaded TLS certificates")
+		}
+	}
+}
+
+// usesTLS returns true iff the App has been configured
+// to serve TLS.
+func (app *App) usesTLS() bool {
+	return app.cfg.TLSCertificate != "" || app.cfg.TLSCertificatesDir != ""
+}
+
+// hstsHeader returns the value for the Strict-Transport-Security
+// header or the empty string if HSTS is disabled.
+func (app *App) hstsHeader() string {
+	if app.cfg.HSTSMaxAge <= 0 {
+		return ""
+	}
+	value := "max-age=" + strconv.Itoa(app.cfg.HSTSMaxAge)
+	if app.cfg.HSTSIncludeSubdomains {
+		value += "; includeSubDomains"
+	}
+	if app.cfg.HSTSPreload {
+		value += "; preload"
+	}
+	return value
+}
+
+// httpsRedirectHandler returns an http.Handler which redirects
+// every request to the same URL using https.
+func (app *App) httpsRedirectHandler() http.Handler {
+	port := app.cfg.Port
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		} else if strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]") {
+			// IPv6 without a port
+			host = host[1 : len(host)-1]
+		}
+		if port != 443 {
+			host = net.JoinHostPort(host, strconv.Itoa(port))
+		} else if strings.IndexByte(host, ':') >= 0 {
+			// IPv6
+			host = "[" + host + "]"
+		}
+		u := *r.URL
+		u.Scheme = "https"
+		u.Host = host
+		http.Redirect(w, r, u.String(), http.StatusMovedPermanently)
+	})
+}
@@ -0,0 +1,117 @@
+package app
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeTestCertificate(t *testing.T, dir string, name string, hosts ...string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: hosts[0]},
+		DNSNames:     hosts,
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyData := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	if err := ioutil.WriteFile(filepath.Join(dir, name+".crt"), certData, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, name+".key"), keyData, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCertificateStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gondola-tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeTestCertificate(t, dir, "a", "www.example.com")
+	writeTestCertificate(t, dir, "b", "*.example.org")
+	s, err := newCertificateStore(&Config{TLSCertificatesDir: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]string{
+		"www.example.com": "www.example.com",
+		"WWW.EXAMPLE.COM": "www.example.com",
+		"foo.example.org": "*.example.org",
+		"unknown.com":     "www.example.com",
+	}
+	for k, v := range tests {
+		cert, err := s.getCertificate(&tls.ClientHelloInfo{ServerName: k})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cn := cert.Leaf.Subject.CommonName; cn != v {
+			t.Errorf("expecting certificate for %s with server name %s, got %s", v, k, cn)
+		}
+	}
+	if s.changed() {
+		t.Error("certificates should not have changed")
+	}
+	writeTestCertificate(t, dir, "c", "www.example.net")
+	if !s.changed() {
+		t.Error("certificates should have changed")
+	}
+}
+
+func TestHTTPSRedirect(t *testing.T) {
+	redirectTests := []struct {
+		port     int
+		host     string
+		expected string
+	}{
+		{8443, "www.example.com", "https://www.example.com:8443/foo?bar=1"},
+		{8443, "www.example.com:8080", "https://www.example.com:8443/foo?bar=1"},
+		{443, "www.example.com:8080", "https://www.example.com/foo?bar=1"},
+		{8443, "[::1]", "https://[::1]:8443/foo?bar=1"},
+		{8443, "[::1]:8080", "https://[::1]:8443/foo?bar=1"},
+		{443, "[::1]", "https://[::1]/foo?bar=1"},
+		{443, "[::1]:8080", "https://[::1]/foo?bar=1"},
+	}
+	for _, v := range redirectTests {
+		a := NewWithConfig(&Config{Port: v.port})
+		req, _ := http.NewRequest("GET", "http://www.example.com/foo?bar=1", nil)
+		req.Host = v.host
+		w := httptest.NewRecorder()
+		a.httpsRedirectHandler().ServeHTTP(w, req)
+		if loc := w.Header().Get("Location"); loc != v.expected {
+			t.Errorf("expecting redirect from %s with port %d to %q, got %q", v.host, v.port, v.expected, loc)
+		}
+	}
+	a := NewWithConfig(nil)
+	a.cfg.HSTSMaxAge = 3600
+	a.cfg.HSTSIncludeSubdomains = true
+	if h := a.hstsHeader(); h != "max-age=3600; includeSubDomains" {
+		t.Errorf("unexpected HSTS header %q", h)
+	}
+}
@@ -14,28 +14,43 @@ type Transformer func(Handler) Handler
 // user to be executed. If there's no signed in user, it returns
 // a redirect to the handler named "sign-in", indicating the
 // previous url in the "from" parameter. If there's no handler
-// named "sign-in", it panics. It also adds "Cookie" to the Vary
-// header, and "private" to the Cache-Control header.
+// named "sign-in", it panics. Users authenticated with limited
+// scopes (see Authentication.Restricted) receive a 403 (Forbidden)
+// error. It also adds "Cookie" to the Vary header, and "private"
+// to the Cache-Control header.
 func SignedIn(handler Handler) Handler {
 	return func(ctx *Context) {
-		h := ctx.Header()
-		h.Add("Vary", "Cookie")
-		h.Add("Cache-Control", "private")
-		if ctx.User() == nil {
-			signIn := ctx.MustReverse("sign-in")
-			u, err := url.Parse(signIn)
-			if err != nil {
-				panic(err)
-			}
-			from := ctx.URL().String()
-			u.RawQuery += fmt.Sprintf("%s=%s", SignInFromParameterName, url.QueryEscape(from))
-			ctx.Redirect(u.String(), false)
+		if !requireUser(ctx) {
+			return
+		}
+		if auth := ctx.Authentication(); auth != nil && auth.Restricted() {
+			ctx.Forbidden()
 			return
 		}
 		handler(ctx)
 	}
 }
 
+// requireUser redirects anonymous users to the sign in handler,
+// returning false. See SignedIn.
+func requireUser(ctx *Context) bool {
+	h := ctx.Header()
+	h.Add("Vary", "Cookie")
+	h.Add("Cache-Control", "private")
+	if ctx.User() == nil {
+		signIn := ctx.MustReverse("sign-in")
+		u, err := url.Parse(signIn)
+		if err != nil {
+			panic(err)
+		}
+		from := ctx.URL().String()
+		u.RawQuery += fmt.Sprintf("%s=%s", SignInFromParameterName, url.QueryEscape(from))
+		ctx.Redirect(u.String(), false)
+		return false
+	}
+	return true
+}
+
 // Anonymous returns a new handler which redirects signed in users
 // to the previous page (or the root page if there's no referrer).
 func Anonymous(handler Handler) Handler {
@@ -30,21 +30,20 @@ type User interface {
 type UserFunc func(ctx *Context, id int64) User
 
 // User returns the currently signed in user, or nil if there's
-// no user. In order to find the user, the App must have a
-// UserFunc defined.
+// no user. The user is determined by the App Authenticators (see
+// App.SetAuthenticators), which by default only include a
+// CookieAuthenticator. In order to find users signed in with a
+// cookie, the App must have a UserFunc defined.
 func (c *Context) User() User {
-	if c.user == nil && c.app.userFunc != nil {
-		var id int64
-		err := c.Cookies().GetSecure(USER_COOKIE_NAME, &id)
-		if err == nil {
-			c.user = c.app.userFunc(c, id)
-		}
+	if c.user == nil {
+		c.Authentication()
 	}
 	return c.user
 }
 
 // SignIn sets the cookie for signin in the given user. The default
-// cookie options for the App are used.
+// cookie options for the App are used. If there's a Session, its
+// ID is regenerated (see Session.Regenerate).
 func (c *Context) SignIn(user User) error {
 	if c.app.userFunc == nil {
 		return errNoUserFunc
@@ -54,6 +53,11 @@ func (c *Context) SignIn(user User) error {
 		return err
 	}
 	c.user = user
+	c.auth = &Authentication{User: user, Method: AuthMethodCookie}
+	c.authenticated = true
+	if c.hasSession() {
+		c.Session().Regenerate()
+	}
 	return nil
 }
 
@@ -65,7 +69,13 @@ func (c *Context) MustSignIn(user User) {
 }
 
 // SignOut deletes the signed in cookie for the current user. If there's
-// no current signed in user, it does nothing.
+// no current signed in user, it does nothing. If there's a Session, its
+// values are removed and its ID is regenerated (see Session.Regenerate).
 func (c *Context) SignOut() {
 	c.Cookies().Delete(USER_COOKIE_NAME)
+	if c.hasSession() {
+		s := c.Session()
+		s.Clear()
+		s.Regenerate()
+	}
 }
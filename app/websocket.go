@@ -24,6 +24,23 @@ const (
 // requests rather than normal HTTP(S) requests. Use Context.Websocket to retrieve
 // the *websocket.Conn in the Handler.
 func (app *App) HandleWebsocket(pattern string, handler Handler, opts ...HandlerOption) {
+	app.Handle(pattern, websocketHandler(pattern, handler), opts...)
+}
+
+// WebsocketHandler returns a Handler which upgrades the connection
+// to a websocket and then calls the given handler. Most users should
+// use App.HandleWebsocket instead, this function is only exported
+// for packages which need to wrap the Handler (e.g. gnd.la/app/hub).
+func WebsocketHandler(handler Handler) Handler {
+	if handler == nil {
+		panic(errors.New("websocket handler can't be nil"))
+	}
+	return websocketHandler("", handler)
+}
+
+// websocketHandler returns a Handler which upgrades the connection
+// to a websocket and then calls the given handler.
+func websocketHandler(pattern string, handler Handler) Handler {
 	if handler == nil {
 		panic(fmt.Errorf("handler for websocket pattern %q can't be nil", pattern))
 	}
@@ -32,7 +49,7 @@ func (app *App) HandleWebsocket(pattern string, handler Handler, opts ...Handler
 		ctx.Set(websocketKey, ws)
 		handler(ctx)
 	})
-	reqHandler := func(ctx *Context) {
+	return func(ctx *Context) {
 		req := ctx.Request()
 		newCtx := context.WithValue(req.Context(), ctxKey, ctx)
 		newReq := ctx.Request().WithContext(newCtx)
@@ -40,7 +57,6 @@ func (app *App) HandleWebsocket(pattern string, handler Handler, opts ...Handler
 		ctx.ResponseWriter = nil
 		wsHandler.ServeHTTP(rw, newReq)
 	}
-	app.Handle(pattern, reqHandler, opts...)
 }
 
 // Websocket returns the *websocket.Conn assocciated with the current request. If the handler
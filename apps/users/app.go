@@ -29,6 +29,13 @@ type App struct {
 
 func (a *App) Attach(parent *app.App) {
 	parent.SetUserFunc(userFunc)
+	if d, _ := a.Data().(*appData); d != nil && !d.opts.DisableTokens {
+		parent.SetAuthenticators(
+			app.CookieAuthenticator(),
+			app.BearerAuthenticator(AuthenticateToken),
+			app.APIKeyAuthenticator(AuthenticateToken),
+		)
+	}
 	a.App.Attach(parent)
 }
 
@@ -143,6 +150,10 @@ type Options struct {
 	// DisableRegistration can be used to disable user registration. Only existing users
 	// and social accounts will be able to log in.
 	DisableRegistration bool
+	// DisableTokens disables personal API tokens. By default, users can
+	// issue tokens (see IssueToken) to authenticate requests using either
+	// an Authorization: Bearer or an X-API-Key header.
+	DisableTokens bool
 }
 
 func (o *Options) googleScopes() []string {
@@ -253,6 +264,11 @@ func New(opts Options) *App {
 	a.Handle("^/reset/$", ResetHandler, app.NamedHandler(ResetHandlerName))
 	a.Handle("^/js/sign-in/$", JSSignInHandler, app.NamedHandler(JSSignInHandlerName))
 	a.Handle("^/js/sign-up/$", JSSignUpHandler, app.NamedHandler(JSSignUpHandlerName))
+	if !opts.DisableTokens {
+		a.HandleAPI("^/tokens/$", listTokensHandler, app.NamedHandler(TokensHandlerName), app.MethodHandler("GET"))
+		a.HandleAPI("^/tokens/$", issueTokenHandler, app.NamedHandler(TokensHandlerName), app.MethodHandler("POST"))
+		a.HandleAPI("^/tokens/(?P<id>\\d+)/$", revokeTokenHandler, app.NamedHandler(RevokeTokenHandlerName), app.MethodHandler("DELETE"))
+	}
 	template.AddFuncs([]*template.Func{
 		{Name: "user_image", Fn: Image, Traits: template.FuncTraitContext},
 		{Name: "__users_get_social", Fn: getSocial},
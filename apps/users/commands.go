@@ -3,7 +3,10 @@ package users
 import (
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 	"text/tabwriter"
+	"time"
 
 	"gnd.la/app"
 	"gnd.la/commands"
@@ -67,6 +70,132 @@ func listUsers(ctx *app.Context) {
 	}
 }
 
+func commandUserId(ctx *app.Context, username string) int64 {
+	userVal, _ := newEmptyUser(ctx)
+	if !ctx.Orm().MustOne(ByUsername(username), userVal.Interface()) {
+		panic(fmt.Errorf("no user named %q", username))
+	}
+	return asGondolaUser(userVal).Id()
+}
+
+func grantRole(ctx *app.Context) {
+	username := ctx.RequireIndexValue(0)
+	role := ctx.RequireIndexValue(1)
+	if err := GrantRole(ctx, commandUserId(ctx, username), role); err != nil {
+		panic(err)
+	}
+	ctx.Logger().Infof("granted role %s to user %s", role, username)
+}
+
+func revokeRole(ctx *app.Context) {
+	username := ctx.RequireIndexValue(0)
+	role := ctx.RequireIndexValue(1)
+	if err := RevokeRole(ctx, commandUserId(ctx, username), role); err != nil {
+		panic(err)
+	}
+	ctx.Logger().Infof("revoked role %s from user %s", role, username)
+}
+
+func grantPermission(ctx *app.Context) {
+	role := ctx.RequireIndexValue(0)
+	perm := ctx.RequireIndexValue(1)
+	if err := GrantPermission(ctx, role, perm); err != nil {
+		panic(err)
+	}
+	ctx.Logger().Infof("granted permission %s to role %s", perm, role)
+}
+
+func revokePermission(ctx *app.Context) {
+	role := ctx.RequireIndexValue(0)
+	perm := ctx.RequireIndexValue(1)
+	if err := RevokePermission(ctx, role, perm); err != nil {
+		panic(err)
+	}
+	ctx.Logger().Infof("revoked permission %s from role %s", perm, role)
+}
+
+func listRoles(ctx *app.Context) {
+	roles, err := RolePermissions(ctx)
+	if err != nil {
+		panic(err)
+	}
+	names := make([]string, 0, len(roles))
+	for k := range roles {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, '\t', tabwriter.Debug)
+	fmt.Fprint(w, "Role\tPermissions\n")
+	for _, v := range names {
+		fmt.Fprintf(w, "%s\t%s\n", v, strings.Join(roles[v], ", "))
+	}
+	if err := w.Flush(); err != nil {
+		panic(err)
+	}
+}
+
+func issueToken(ctx *app.Context) {
+	username := ctx.RequireIndexValue(0)
+	var name, scopes, expires string
+	ctx.ParseParamValue("n", &name)
+	ctx.ParseParamValue("s", &scopes)
+	ctx.ParseParamValue("e", &expires)
+	var ttl time.Duration
+	if expires != "" {
+		var err error
+		if ttl, err = time.ParseDuration(expires); err != nil {
+			panic(fmt.Errorf("invalid expiration %q: %s", expires, err))
+		}
+	}
+	var scopeList []string
+	if scopes != "" {
+		scopeList = strings.Split(scopes, ",")
+	}
+	value, token, err := IssueToken(ctx, commandUserId(ctx, username), name, scopeList, ttl)
+	if err != nil {
+		panic(err)
+	}
+	ctx.Logger().Infof("issued token %d to user %s", token.Id, username)
+	fmt.Println(value)
+}
+
+func listTokens(ctx *app.Context) {
+	username := ctx.RequireIndexValue(0)
+	tokens, err := Tokens(ctx, commandUserId(ctx, username))
+	if err != nil {
+		panic(err)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, '\t', tabwriter.Debug)
+	fmt.Fprint(w, "ID\tName\tPrefix\tScopes\tExpires\tLast Used\n")
+	for _, v := range tokens {
+		expires := "never"
+		if !v.Expires.IsZero() {
+			expires = v.Expires.Format(time.RFC3339)
+			if v.Expired() {
+				expires += " (expired)"
+			}
+		}
+		lastUsed := "never"
+		if !v.LastUsed.IsZero() {
+			lastUsed = v.LastUsed.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n", v.Id, v.Name, v.Prefix, strings.Join(v.Scopes, ", "), expires, lastUsed)
+	}
+	if err := w.Flush(); err != nil {
+		panic(err)
+	}
+}
+
+func revokeToken(ctx *app.Context) {
+	username := ctx.RequireIndexValue(0)
+	var id int64
+	ctx.MustParseIndexValue(1, &id)
+	if err := RevokeToken(ctx, commandUserId(ctx, username), id); err != nil {
+		panic(err)
+	}
+	ctx.Logger().Infof("revoked token %d from user %s", id, username)
+}
+
 func init() {
 	commands.MustRegister(registerUser,
 		commands.Usage("[-s | -p | -e email ] <username>"),
@@ -78,4 +207,38 @@ func init() {
 	commands.MustRegister(listUsers,
 		commands.Help("List all registered users"),
 	)
+	commands.MustRegister(grantRole,
+		commands.Usage("<username> <role>"),
+		commands.Help("Assigns a role to a user"),
+	)
+	commands.MustRegister(revokeRole,
+		commands.Usage("<username> <role>"),
+		commands.Help("Removes a role from a user"),
+	)
+	commands.MustRegister(grantPermission,
+		commands.Usage("<role> <permission>"),
+		commands.Help("Grants a permission to all the users with the given role"),
+	)
+	commands.MustRegister(revokePermission,
+		commands.Usage("<role> <permission>"),
+		commands.Help("Revokes a permission from a role"),
+	)
+	commands.MustRegister(listRoles,
+		commands.Help("List all roles and their permissions"),
+	)
+	commands.MustRegister(issueToken,
+		commands.Usage("[-n name] [-s scope1,scope2...] [-e expiration] <username>"),
+		commands.Help("Issues a new API token for a user and prints it"),
+		commands.StringFlag("n", "", "Token name"),
+		commands.StringFlag("s", "", "Comma separated list of scopes - if empty, the token has unrestricted access"),
+		commands.StringFlag("e", "", "Token expiration as a duration (e.g. 720h) - if empty, the token never expires"),
+	)
+	commands.MustRegister(listTokens,
+		commands.Usage("<username>"),
+		commands.Help("List the API tokens issued to a user"),
+	)
+	commands.MustRegister(revokeToken,
+		commands.Usage("<username> <token-id>"),
+		commands.Help("Revokes an API token"),
+	)
 }
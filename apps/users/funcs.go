@@ -19,6 +19,7 @@ var (
 func userFunc(ctx *app.Context, id int64) app.User {
 	user, _ := Get(ctx, id)
 	if user != nil {
+		setUserAccess(ctx, user)
 		return user
 	}
 	return nil
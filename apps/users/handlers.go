@@ -36,6 +36,8 @@ const (
 	SignOutHandlerName        = "users-sign-out"
 	ForgotHandlerName         = "users-forgot"
 	ResetHandlerName          = "users-reset"
+	TokensHandlerName         = "users-tokens"
+	RevokeTokenHandlerName    = "users-revoke-token"
 
 	FacebookChannelHandlerName = "users-facebook-channel"
 	ImageHandlerName           = "users-image-handler"
@@ -87,7 +87,7 @@ func UserImageHandler(ctx *app.Context) {
 	ctx.SetHeader("Content-Type", "image/"+format)
 	httpserve.NeverExpires(ctx)
 	bs := ctx.Blobstore()
-	if err := bs.Serve(ctx, id, nil); err != nil {
+	if err := bs.ServeRequest(ctx, ctx.R, id); err != nil {
 		panic(err)
 	}
 }
@@ -0,0 +1,210 @@
+package users
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gnd.la/app"
+	"gnd.la/orm"
+)
+
+var (
+	userRoleType       = reflect.TypeOf(userRole{})
+	rolePermissionType = reflect.TypeOf(rolePermission{})
+)
+
+// userRole assigns a role to a user.
+type userRole struct {
+	UserId int64
+	Role   string
+}
+
+// rolePermission grants a permission to all the
+// users with the given role.
+type rolePermission struct {
+	Role       string
+	Permission string
+}
+
+// userAccess holds the roles and permissions for a given
+// user. It's stored in the cache, so its fields must be
+// exported.
+type userAccess struct {
+	Roles       []string
+	Permissions []string
+}
+
+// Roles returns the roles assigned to the user. Roles are
+// loaded when the user is retrieved from the current signed
+// in user (see app.Context.User) and can be assigned using
+// GrantRole or the grant-role command.
+//
+// This method implements the app.RolesUser interface.
+func (u *User) Roles() []string {
+	if u.access != nil {
+		return u.access.Roles
+	}
+	return nil
+}
+
+// HasPermission returns true iff any of the roles of the user
+// has been granted the given permission. Granted permissions
+// might end with a wildcard, so "articles.*" grants both
+// "articles.edit" and "articles.delete", while "*" grants
+// every permission. Note that this method doesn't take
+// User.Admin into account, use app.HasPermission for that.
+//
+// This method implements the app.PermissionsUser interface.
+func (u *User) HasPermission(perm string) bool {
+	if u.access != nil {
+		for _, v := range u.access.Permissions {
+			if permissionMatches(v, perm) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func permissionMatches(granted string, perm string) bool {
+	if granted == "*" || granted == perm {
+		return true
+	}
+	return strings.HasSuffix(granted, ".*") && strings.HasPrefix(perm, granted[:len(granted)-1])
+}
+
+func userAccessKey(id int64) string {
+	return "gnd:la:user-access:" + strconv.FormatInt(id, 10)
+}
+
+func loadUserAccess(ctx *app.Context, id int64) (*userAccess, error) {
+	key := userAccessKey(id)
+	access := new(userAccess)
+	if ctx.Cache().Get(key, access) == nil {
+		return access, nil
+	}
+	o := ctx.Orm()
+	var roles []*userRole
+	if err := o.Table(o.TypeTable(userRoleType)).Filter(orm.Eq("UserId", id)).All(&roles); err != nil {
+		return nil, err
+	}
+	for _, v := range roles {
+		access.Roles = append(access.Roles, v.Role)
+	}
+	if len(access.Roles) > 0 {
+		var perms []*rolePermission
+		if err := o.Table(o.TypeTable(rolePermissionType)).Filter(orm.In("Role", access.Roles)).All(&perms); err != nil {
+			return nil, err
+		}
+		for _, v := range perms {
+			access.Permissions = append(access.Permissions, v.Permission)
+		}
+	}
+	ctx.Cache().Set(key, access, 300)
+	return access, nil
+}
+
+// setUserAccess loads the roles and permissions of the given user,
+// which must be an instance of the app user type.
+func setUserAccess(ctx *app.Context, user app.User) {
+	access, err := loadUserAccess(ctx, user.Id())
+	if err != nil {
+		ctx.Logger().Errorf("error loading roles for user %d: %s", user.Id(), err)
+		return
+	}
+	inner := reflect.ValueOf(user).Elem().FieldByName("User").Addr().Interface().(*User)
+	inner.access = access
+}
+
+// GrantRole assigns the given role to the user with the given id.
+// If the user already has the role, it does nothing.
+func GrantRole(ctx *app.Context, userId int64, role string) error {
+	if _, err := ctx.Orm().Save(&userRole{UserId: userId, Role: role}); err != nil {
+		return err
+	}
+	return ctx.Cache().Delete(userAccessKey(userId))
+}
+
+// RevokeRole removes the given role from the user with the given id.
+func RevokeRole(ctx *app.Context, userId int64, role string) error {
+	o := ctx.Orm()
+	q := orm.And(orm.Eq("UserId", userId), orm.Eq("Role", role))
+	if _, err := o.DeleteFrom(o.TypeTable(userRoleType), q); err != nil {
+		return err
+	}
+	return ctx.Cache().Delete(userAccessKey(userId))
+}
+
+// GrantPermission grants the given permission to every user with
+// the given role. See User.HasPermission for the permissions syntax.
+func GrantPermission(ctx *app.Context, role string, perm string) error {
+	if _, err := ctx.Orm().Save(&rolePermission{Role: role, Permission: perm}); err != nil {
+		return err
+	}
+	return invalidateRole(ctx, role)
+}
+
+// RevokePermission revokes a permission previously granted to the
+// given role with GrantPermission.
+func RevokePermission(ctx *app.Context, role string, perm string) error {
+	o := ctx.Orm()
+	q := orm.And(orm.Eq("Role", role), orm.Eq("Permission", perm))
+	if _, err := o.DeleteFrom(o.TypeTable(rolePermissionType), q); err != nil {
+		return err
+	}
+	return invalidateRole(ctx, role)
+}
+
+// RolePermissions returns the permissions granted to each role,
+// keyed by role name. Roles without permissions but assigned to
+// at least one user are also included.
+func RolePermissions(ctx *app.Context) (map[string][]string, error) {
+	o := ctx.Orm()
+	roles := make(map[string][]string)
+	var userRoles []*userRole
+	if err := o.Table(o.TypeTable(userRoleType)).All(&userRoles); err != nil {
+		return nil, err
+	}
+	for _, v := range userRoles {
+		if _, ok := roles[v.Role]; !ok {
+			roles[v.Role] = nil
+		}
+	}
+	var perms []*rolePermission
+	if err := o.Table(o.TypeTable(rolePermissionType)).All(&perms); err != nil {
+		return nil, err
+	}
+	for _, v := range perms {
+		roles[v.Role] = append(roles[v.Role], v.Permission)
+	}
+	return roles, nil
+}
+
+// invalidateRole removes the cached access for all
+// the users with the given role.
+func invalidateRole(ctx *app.Context, role string) error {
+	o := ctx.Orm()
+	var roles []*userRole
+	if err := o.Table(o.TypeTable(userRoleType)).Filter(orm.Eq("Role", role)).All(&roles); err != nil {
+		return err
+	}
+	c := ctx.Cache()
+	for _, v := range roles {
+		if err := c.Delete(userAccessKey(v.UserId)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func init() {
+	orm.Register(&userRole{}, &orm.Options{
+		Table:      "gondola_users_roles",
+		PrimaryKey: []string{"UserId", "Role"},
+	})
+	orm.Register(&rolePermission{}, &orm.Options{
+		Table:      "gondola_users_role_permissions",
+		PrimaryKey: []string{"Role", "Permission"},
+	})
+}
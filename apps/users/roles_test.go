@@ -0,0 +1,131 @@
+// +build !appengine
+
+package users
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gnd.la/app"
+	"gnd.la/config"
+	_ "gnd.la/orm/driver/sqlite"
+)
+
+func TestPermissionMatches(t *testing.T) {
+	tests := []struct {
+		granted string
+		perm    string
+		matches bool
+	}{
+		{"*", "articles.edit", true},
+		{"*", "articles", true},
+		{"articles.edit", "articles.edit", true},
+		{"articles.edit", "articles.delete", false},
+		{"articles.*", "articles.edit", true},
+		{"articles.*", "articles.comments.delete", true},
+		{"articles.*", "articles", false},
+		{"articles.*", "articlesx.edit", false},
+		{"articles", "articles.edit", false},
+		{"articles*", "articles.edit", false},
+	}
+	for _, v := range tests {
+		if m := permissionMatches(v.granted, v.perm); m != v.matches {
+			t.Errorf("expecting permissionMatches(%q, %q) = %v, got %v", v.granted, v.perm, v.matches, m)
+		}
+	}
+}
+
+func newRolesContext(t *testing.T) (*app.Context, func()) {
+	f, err := ioutil.TempFile("", "users-roles-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	cleanup := func() {
+		files, _ := filepath.Glob(f.Name() + "*")
+		for _, v := range files {
+			os.Remove(v)
+		}
+	}
+	a := app.NewWithConfig(&app.Config{Database: config.MustParseURL("sqlite://" + f.Name())})
+	o, err := a.Orm()
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	ctx := a.NewContext(nil)
+	return ctx, func() {
+		a.CloseContext(ctx)
+		o.Close()
+		cleanup()
+	}
+}
+
+func accessUser(t *testing.T, ctx *app.Context, id int64) *User {
+	access, err := loadUserAccess(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &User{UserId: id, access: access}
+}
+
+func TestGrantRevoke(t *testing.T) {
+	ctx, cleanup := newRolesContext(t)
+	defer cleanup()
+	const userId = 1
+	if u := accessUser(t, ctx, userId); len(u.Roles()) != 0 || u.HasPermission("articles.edit") {
+		t.Fatalf("expecting no roles nor permissions, got %v", u.Roles())
+	}
+	if err := GrantRole(ctx, userId, "editor"); err != nil {
+		t.Fatal(err)
+	}
+	// Granting the same role again does nothing
+	if err := GrantRole(ctx, userId, "editor"); err != nil {
+		t.Fatal(err)
+	}
+	if u := accessUser(t, ctx, userId); len(u.Roles()) != 1 || u.Roles()[0] != "editor" {
+		t.Fatalf("expecting roles [editor], got %v", u.Roles())
+	}
+	// Access is now cached, so granting a permission must
+	// invalidate it for every user with the role.
+	if err := GrantPermission(ctx, "editor", "articles.*"); err != nil {
+		t.Fatal(err)
+	}
+	u := accessUser(t, ctx, userId)
+	if !u.HasPermission("articles.edit") || !u.HasPermission("articles.delete") {
+		t.Errorf("expecting articles.* to grant articles.edit and articles.delete")
+	}
+	if u.HasPermission("users.edit") {
+		t.Errorf("expecting articles.* not to grant users.edit")
+	}
+	if !app.HasPermission(u, "articles.edit") {
+		t.Errorf("expecting app.HasPermission to use the user permissions")
+	}
+	perms, err := RolePermissions(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := perms["editor"]; len(p) != 1 || p[0] != "articles.*" {
+		t.Errorf("expecting editor permissions [articles.*], got %v", p)
+	}
+	if err := RevokePermission(ctx, "editor", "articles.*"); err != nil {
+		t.Fatal(err)
+	}
+	if u := accessUser(t, ctx, userId); u.HasPermission("articles.edit") {
+		t.Errorf("expecting no articles.edit permission after RevokePermission")
+	}
+	if err := GrantPermission(ctx, "editor", "*"); err != nil {
+		t.Fatal(err)
+	}
+	if u := accessUser(t, ctx, userId); !u.HasPermission("users.edit") {
+		t.Errorf("expecting * to grant users.edit")
+	}
+	if err := RevokeRole(ctx, userId, "editor"); err != nil {
+		t.Fatal(err)
+	}
+	if u := accessUser(t, ctx, userId); len(u.Roles()) != 0 || u.HasPermission("users.edit") {
+		t.Errorf("expecting no roles nor permissions after RevokeRole, got %v", u.Roles())
+	}
+}
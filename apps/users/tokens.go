@@ -0,0 +1,268 @@
+package users
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"reflect"
+	"time"
+
+	"gnd.la/app"
+	"gnd.la/i18n"
+	"gnd.la/orm"
+	"gnd.la/util/stringutil"
+)
+
+const (
+	// TokensScope is the scope required for managing API tokens
+	// using the handlers in this app. Requests authenticated with
+	// a cookie or a token without scopes are always allowed.
+	TokensScope = "users.tokens"
+
+	tokenPrefix       = "gnd_"
+	tokenLength       = 40
+	tokenShownLength  = len(tokenPrefix) + 6
+	tokenUsedInterval = time.Minute
+)
+
+var (
+	tokenType       = reflect.TypeOf(Token{})
+	errNoSuchToken  = i18n.NewError("no such token")
+	errTokenExpired = i18n.NewError("token expired")
+
+	errTokenScopesRequired = i18n.NewError("tokens issued using a scoped token must have scopes")
+	errTokenScope          = i18n.NewError("can't grant a scope not granted to the current token")
+)
+
+// Token represents a personal API token issued to a user. Tokens
+// are sent by clients either in the Authorization header, using
+// the Bearer scheme, or in the X-API-Key header. Only a hash of
+// the token is stored, so its value is only available when it's
+// issued (see IssueToken).
+type Token struct {
+	Id     int64 `orm:",primary_key,auto_increment" json:"id"`
+	UserId int64 `orm:",index" json:"-"`
+	// Name is a user provided description of the token.
+	Name string `json:"name"`
+	// Prefix holds the first characters of the token, which
+	// help users identify their tokens.
+	Prefix string `json:"prefix"`
+	Hash   string `orm:",unique" json:"-"`
+	// Scopes lists the scopes granted by the token. A token
+	// without scopes is granted every scope. See
+	// app.Authentication.Scopes for how they're enforced.
+	Scopes  []string  `orm:",codec=json" json:"scopes,omitempty"`
+	Created time.Time `json:"created"`
+	// Expires is the time when the token expires. The zero
+	// value indicates that the token never expires.
+	Expires time.Time `json:"expires"`
+	// LastUsed is updated (with a precision of one minute)
+	// every time the token is used to authenticate a request.
+	LastUsed time.Time `json:"last_used"`
+}
+
+// Expired returns true iff the token has an expiration
+// date and it's in the past.
+func (t *Token) Expired() bool {
+	return !t.Expires.IsZero() && t.Expires.Before(time.Now())
+}
+
+func hashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// IssueToken creates a new API token for the user with the given id. If
+// ttl is non-zero, the token expires after that time. It returns the
+// token value, which must be sent back to the user since it can't be
+// recovered later, as well as the stored Token.
+func IssueToken(ctx *app.Context, userId int64, name string, scopes []string, ttl time.Duration) (string, *Token, error) {
+	value := tokenPrefix + stringutil.Random(tokenLength)
+	now := time.Now().UTC()
+	token := &Token{
+		UserId:  userId,
+		Name:    name,
+		Prefix:  value[:tokenShownLength],
+		Hash:    hashToken(value),
+		Scopes:  scopes,
+		Created: now,
+	}
+	if ttl > 0 {
+		token.Expires = now.Add(ttl)
+	}
+	if _, err := ctx.Orm().Insert(token); err != nil {
+		return "", nil, err
+	}
+	return value, token, nil
+}
+
+// Tokens returns the API tokens issued to the user with the
+// given id, including the expired ones.
+func Tokens(ctx *app.Context, userId int64) ([]*Token, error) {
+	var tokens []*Token
+	o := ctx.Orm()
+	q := o.Table(o.TypeTable(tokenType)).Filter(orm.Eq("UserId", userId)).Sort("Id", orm.ASC)
+	if err := q.All(&tokens); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// RevokeToken removes the token with the given id, which must have
+// been issued to the user with the given userId.
+func RevokeToken(ctx *app.Context, userId int64, id int64) error {
+	o := ctx.Orm()
+	q := orm.And(orm.Eq("Id", id), orm.Eq("UserId", userId))
+	res, err := o.DeleteFrom(o.TypeTable(tokenType), q)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return errNoSuchToken
+	}
+	return nil
+}
+
+func findToken(ctx *app.Context, value string) (*Token, error) {
+	var token Token
+	ok, err := ctx.Orm().One(orm.Eq("Hash", hashToken(value)), &token)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errNoSuchToken
+	}
+	if token.Expired() {
+		return nil, errTokenExpired
+	}
+	return &token, nil
+}
+
+// AuthenticateToken is an app.TokenFunc which resolves API tokens issued
+// by IssueToken. This app installs it as a Bearer and an API key
+// authenticator (see app.BearerAuthenticator and app.APIKeyAuthenticator)
+// unless Options.DisableTokens is true.
+func AuthenticateToken(ctx *app.Context, value string) (*app.Authentication, error) {
+	token, err := findToken(ctx, value)
+	if err != nil {
+		if err == errNoSuchToken || err == errTokenExpired {
+			return nil, nil
+		}
+		return nil, err
+	}
+	user, err := Get(ctx, token.UserId)
+	if err != nil {
+		if err == errNoSuchUser {
+			return nil, nil
+		}
+		return nil, err
+	}
+	setUserAccess(ctx, user)
+	if now := time.Now().UTC(); now.Sub(token.LastUsed) > tokenUsedInterval {
+		token.LastUsed = now
+		if _, err := ctx.Orm().Save(token); err != nil {
+			ctx.Logger().Errorf("error updating token %d: %s", token.Id, err)
+		}
+	}
+	auth := &app.Authentication{User: user, Expires: token.Expires}
+	if len(token.Scopes) > 0 {
+		auth.Scopes = token.Scopes
+	}
+	return auth, nil
+}
+
+type listTokensRequest struct{}
+
+type issueTokenRequest struct {
+	Name   string   `json:"name" form:",required,max_length=64"`
+	Scopes []string `json:"scopes"`
+	// ExpiresIn is the token duration in seconds
+	ExpiresIn int64 `json:"expires_in"`
+}
+
+type issuedToken struct {
+	// Value is the token to be sent by the client
+	Value string `json:"token"`
+	*Token
+}
+
+type revokeTokenRequest struct{}
+
+func tokensUser(ctx *app.Context) (app.User, error) {
+	user := ctx.User()
+	if user == nil {
+		return nil, &app.APIError{Status: http.StatusUnauthorized, Message: http.StatusText(http.StatusUnauthorized)}
+	}
+	if !ctx.HasScope(TokensScope) {
+		return nil, &app.APIError{Status: http.StatusForbidden, Message: http.StatusText(http.StatusForbidden)}
+	}
+	return user, nil
+}
+
+func errForbidden(ctx *app.Context, err error) error {
+	return &app.APIError{Status: http.StatusForbidden, Message: i18n.TranslatedError(err, ctx).Error()}
+}
+
+func listTokensHandler(ctx *app.Context, in *listTokensRequest) ([]*Token, error) {
+	user, err := tokensUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return Tokens(ctx, user.Id())
+}
+
+func issueTokenHandler(ctx *app.Context, in *issueTokenRequest) (*issuedToken, error) {
+	user, err := tokensUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ttl := time.Duration(in.ExpiresIn) * time.Second
+	// Tokens issued by a scoped request can't grant more
+	// access nor live longer than the one being used.
+	auth := ctx.Authentication()
+	if auth.Scopes != nil {
+		if len(in.Scopes) == 0 {
+			return nil, errForbidden(ctx, errTokenScopesRequired)
+		}
+		for _, v := range in.Scopes {
+			if !auth.HasScope(v) {
+				return nil, errForbidden(ctx, errTokenScope)
+			}
+		}
+	}
+	if !auth.Expires.IsZero() {
+		remaining := auth.Expires.Sub(time.Now())
+		if remaining <= 0 {
+			return nil, errForbidden(ctx, errTokenExpired)
+		}
+		if ttl <= 0 || ttl > remaining {
+			ttl = remaining
+		}
+	}
+	value, token, err := IssueToken(ctx, user.Id(), in.Name, in.Scopes, ttl)
+	if err != nil {
+		return nil, err
+	}
+	return &issuedToken{Value: value, Token: token}, nil
+}
+
+func revokeTokenHandler(ctx *app.Context, in *revokeTokenRequest) (interface{}, error) {
+	user, err := tokensUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var id int64
+	if ctx.ParseParamValue("id", &id) != nil {
+		err = errNoSuchToken
+	} else {
+		err = RevokeToken(ctx, user.Id(), id)
+	}
+	if err == errNoSuchToken {
+		return nil, &app.APIError{Status: http.StatusNotFound, Message: i18n.TranslatedError(err, ctx).Error()}
+	}
+	return nil, err
+}
+
+func init() {
+	orm.Register(&Token{}, &orm.Options{Table: "gondola_users_tokens"})
+}
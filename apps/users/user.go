@@ -47,6 +47,9 @@ type User struct {
 	Admin              bool              `form:"-" orm:",default=false" json:"admin"`
 	Image              string            `form:"-" orm:",omitempty,nullempty" json:"-"`
 	ImageFormat        string            `form:"-" orm:",omitempty,nullempty" json:"-"`
+	// access is populated by the app UserFunc, see Roles
+	// and HasPermission.
+	access *userAccess
 }
 
 func (u *User) Id() int64 {
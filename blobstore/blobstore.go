@@ -8,10 +8,12 @@ import (
 	"os"
 	"reflect"
 	"strings"
+	"time"
 
 	"gnd.la/blobstore/driver"
 	_ "gnd.la/blobstore/driver/file"
 	"gnd.la/config"
+	"gnd.la/internal/httpserve"
 	"gnd.la/log"
 )
 
@@ -183,8 +185,9 @@ func (s *Blobstore) Driver() driver.Driver {
 
 // Serve servers the given file by writing it to the given http.ResponseWriter.
 // Some drivers might be able to serve the file directly from their backend. Otherwise,
-// the file will be read from the blobstore and written to w. The rng parameter might be
-// used for sending a partial response to the client.
+// the file will be read from the blobstore and written to w, including its ETag (see
+// RFile.ETag). The rng parameter might be used for sending a partial response to the
+// client. To also handle conditional requests, use ServeRequest.
 func (s *Blobstore) Serve(w http.ResponseWriter, id string, rng *Range) error {
 	if s.srv != nil {
 		if ok, err := s.srv.Serve(w, id, rng); ok || err != nil {
@@ -196,10 +199,45 @@ func (s *Blobstore) Serve(w http.ResponseWriter, id string, rng *Range) error {
 		return err
 	}
 	defer f.Close()
+	return s.serveFile(w, f, rng)
+}
+
+// ServeRequest works like Serve, but it also handles conditional requests,
+// responding with a 304 (Not Modified) when the If-None-Match header sent
+// by the client matches the file ETag. The range is parsed from the
+// request, see ParseRange.
+func (s *Blobstore) ServeRequest(w http.ResponseWriter, r *http.Request, id string) error {
+	f, err := s.Open(id)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	etag, err := f.ETag()
+	if err != nil {
+		return err
+	}
+	if httpserve.NotModified(r, etag, time.Time{}) {
+		w.Header().Set("ETag", etag)
+		httpserve.WriteNotModified(w)
+		return nil
+	}
+	rng := ParseRange(r)
+	if s.srv != nil {
+		if ok, err := s.srv.Serve(w, id, rng); ok || err != nil {
+			return err
+		}
+	}
+	return s.serveFile(w, f, rng)
+}
+
+func (s *Blobstore) serveFile(w http.ResponseWriter, f *RFile, rng *Range) error {
 	size, err := f.Size()
 	if err != nil {
 		return err
 	}
+	if etag, err := f.ETag(); err == nil {
+		w.Header().Set("ETag", etag)
+	}
 	var r io.Reader = f
 	if rng.IsValid() {
 		if rng.Start != nil {
@@ -240,6 +278,16 @@ func (s *Blobstore) Close() error {
 	return s.drv.Close()
 }
 
+// Ping checks the connectivity with the blobstore backend. Drivers
+// which don't implement gnd.la/blobstore/driver.Pinger are assumed
+// to be always reachable.
+func (s *Blobstore) Ping() error {
+	if p, ok := s.drv.(driver.Pinger); ok {
+		return p.Ping()
+	}
+	return nil
+}
+
 func (s *Blobstore) metaName(id string) string {
 	return id + metaSuffix
 }
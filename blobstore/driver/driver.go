@@ -52,6 +52,14 @@ type Server interface {
 	Serve(w http.ResponseWriter, id string, rng Range) (bool, error)
 }
 
+// Pinger is an optional interface which might be implemented by
+// drivers which can check the connectivity with their backend.
+type Pinger interface {
+	// Ping returns an error if the blobstore backend
+	// can't be reached.
+	Ping() error
+}
+
 func Register(name string, o Opener) {
 	registry[name] = o
 }
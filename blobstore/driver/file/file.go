@@ -92,6 +92,11 @@ func (f *fsDriver) Close() error {
 	return nil
 }
 
+func (f *fsDriver) Ping() error {
+	_, err := os.Stat(f.tmpDir)
+	return err
+}
+
 func (f *fsDriver) Iter() (driver.Iter, error) {
 	res, err := ioutil.ReadDir(f.dir)
 	if err != nil {
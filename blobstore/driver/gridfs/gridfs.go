@@ -90,6 +90,10 @@ func (d *gridfsDriver) Remove(id string) error {
 	return d.fs.RemoveId(bson.ObjectIdHex(id))
 }
 
+func (d *gridfsDriver) Ping() error {
+	return d.session.Ping()
+}
+
 func (d *gridfsDriver) Close() error {
 	d.session.Close()
 	return nil
@@ -118,6 +118,15 @@ func (r *RFile) Size() (uint64, error) {
 	return r.dataLength, nil
 }
 
+// ETag returns a strong ETag for the file, derived
+// from the hash of its data stored in the metadata.
+func (r *RFile) ETag() (string, error) {
+	if err := r.decodeMeta(); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("\"%016x\"", r.dataHash), nil
+}
+
 func (r *RFile) decodeMeta() error {
 	if !r.hasMeta {
 		if !r.store.drvNoMeta {
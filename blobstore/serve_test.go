@@ -0,0 +1,64 @@
+package blobstore
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"gnd.la/config"
+)
+
+func TestServeRequest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "serve-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	u, err := config.ParseURL("file://" + dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := New(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	data := []byte("hello blobstore")
+	id, err := store.Store(data, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serve := func(header map[string]string) *httptest.ResponseRecorder {
+		r, err := http.NewRequest("GET", "http://localhost/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for k, v := range header {
+			r.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		if err := store.ServeRequest(w, r, id); err != nil {
+			t.Fatal(err)
+		}
+		return w
+	}
+	w := serve(nil)
+	etag := w.Header().Get("ETag")
+	if w.Code != http.StatusOK || w.Body.String() != string(data) {
+		t.Errorf("unexpected response %d %q", w.Code, w.Body.String())
+	}
+	if etag == "" {
+		t.Fatal("no ETag in response")
+	}
+	if w := serve(map[string]string{"If-None-Match": etag}); w.Code != http.StatusNotModified || w.Body.Len() != 0 {
+		t.Errorf("expecting an empty 304 response, got %d %q", w.Code, w.Body.String())
+	}
+	if w := serve(map[string]string{"If-None-Match": `"foo"`}); w.Code != http.StatusOK {
+		t.Errorf("expecting 200 with a different ETag, got %d", w.Code)
+	}
+	if w := serve(map[string]string{"Range": "bytes=0-4"}); w.Code != http.StatusPartialContent || w.Body.String() != "hello" {
+		t.Errorf("unexpected partial response %d %q", w.Code, w.Body.String())
+	}
+}
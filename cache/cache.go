@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"reflect"
@@ -239,6 +240,70 @@ func (c *Cache) GetBytes(key string) ([]byte, error) {
 	return b, nil
 }
 
+// CompareAndSwapBytes atomically stores b associated with the given key,
+// but only if the value currently stored is equal to old or, when old
+// is nil, only if the key is not present in the cache. It returns true
+// iff the value was stored. See the documentation for Set for an
+// explanation of the timeout parameter. If the driver doesn't implement
+// gnd.la/cache/driver.CompareAndSwapper, driver.ErrNotImplemented is
+// returned.
+func (c *Cache) CompareAndSwapBytes(key string, old []byte, b []byte, timeout int) (bool, error) {
+	if profile.On && profile.Profiling() {
+		defer profile.Start(cache).Note("CAS", key).End()
+	}
+	cas, ok := c.driver.(driver.CompareAndSwapper)
+	if !ok {
+		return false, driver.ErrNotImplemented
+	}
+	k := c.backendKey(key)
+	prev := old
+	if c.pipe != nil {
+		if old != nil {
+			// Encoding with the pipe might not be deterministic, so
+			// compare the decoded value and swap the stored one.
+			stored, err := c.driver.Get(k)
+			if err != nil {
+				gerr := &cacheError{
+					op:  "getting key",
+					key: key,
+					err: err,
+				}
+				c.error(gerr)
+				return false, gerr
+			}
+			if stored == nil {
+				return false, nil
+			}
+			if dec, err := c.pipe.Decode(stored); err != nil || !bytes.Equal(dec, old) {
+				return false, nil
+			}
+			prev = stored
+		}
+		var err error
+		if b, err = c.pipe.Encode(b); err != nil {
+			perr := &cacheError{
+				op:  "encoding data with pipe",
+				key: key,
+				err: err,
+			}
+			c.error(perr)
+			return false, perr
+		}
+	}
+	swapped, err := cas.CompareAndSwap(k, prev, b, timeout)
+	if err != nil {
+		serr := &cacheError{
+			op:  "swapping key",
+			key: key,
+			err: err,
+		}
+		c.error(serr)
+		return false, serr
+	}
+	c.debugf("CAS key %s (%d bytes), expiring in %d: %v", k, len(b), timeout, swapped)
+	return swapped, nil
+}
+
 // Delete removes the key from the cache. An error is returned only
 // if the item was found but couldn't be deleted. Deleting a non-existant
 // item is always successful.
@@ -279,6 +344,18 @@ func (c *Cache) Connection() interface{} {
 	return c.driver.Connection()
 }
 
+// Ping checks the connectivity with the cache backend. If the driver
+// implements gnd.la/cache/driver.Pinger, its Ping method is used.
+// Otherwise, a key is requested from the cache to check that it
+// can be reached.
+func (c *Cache) Ping() error {
+	if p, ok := c.driver.(driver.Pinger); ok {
+		return p.Ping()
+	}
+	_, err := c.driver.Get(c.backendKey("gondola-ping"))
+	return err
+}
+
 func (c *Cache) debugf(format string, arg ...interface{}) {
 	if c.Logger != nil {
 		c.Logger.Debugf(format, arg...)
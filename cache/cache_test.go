@@ -30,6 +30,7 @@ var (
 		testSetExpires,
 		testDelete,
 		testBytes,
+		testCompareAndSwap,
 	}
 	benchmarks = []func(T, *Cache){
 		testSetGet,
@@ -274,6 +275,35 @@ func testBytes(t T, c *Cache) {
 	}
 }
 
+func testCompareAndSwap(t T, c *Cache) {
+	const key = "cas"
+	if err := c.Delete(key); err != nil {
+		t.Error(err)
+	}
+	swap := func(old string, b string, expected bool) {
+		var ob []byte
+		if old != "" {
+			ob = []byte(old)
+		}
+		swapped, err := c.CompareAndSwapBytes(key, ob, []byte(b), 0)
+		if err != nil {
+			t.Error(err)
+		} else if swapped != expected {
+			t.Errorf("expecting CompareAndSwapBytes(%q, %q) = %v, got %v", old, b, expected, swapped)
+		}
+	}
+	swap("", "a", true)
+	// Key already exists
+	swap("", "b", false)
+	swap("b", "c", false)
+	swap("a", "c", true)
+	if b, err := c.GetBytes(key); err != nil {
+		t.Error(err)
+	} else if string(b) != "c" {
+		t.Errorf("expecting value \"c\" after CompareAndSwapBytes, got %q", string(b))
+	}
+}
+
 func testCache(t *testing.T, url string) {
 	if testing.Verbose() {
 		log.SetLevel(log.LDebug)
@@ -52,6 +52,27 @@ type Driver interface {
 	Flush() error
 }
 
+// Pinger is an optional interface which might be implemented by
+// drivers which can check the connectivity with their backend.
+type Pinger interface {
+	// Ping returns an error if the cache backend
+	// can't be reached.
+	Ping() error
+}
+
+// CompareAndSwapper is an optional interface which might be implemented
+// by drivers which can atomically replace a value, even when the
+// cache is shared by several processes.
+type CompareAndSwapper interface {
+	// CompareAndSwap sets the given key to b only if its current
+	// value is equal to old or, when old is nil, only if the key is
+	// not present in the cache. It returns true iff the value was
+	// stored. Drivers must return false and no error when the value
+	// was not stored because it didn't match old. The timeout should
+	// be interpreted as in Set.
+	CompareAndSwap(key string, old []byte, b []byte, timeout int) (bool, error)
+}
+
 // Register registers a new cache driver with the
 // given protocol and opener function. This function
 // is not thread safe, as it's only intended to be
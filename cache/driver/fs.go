@@ -97,6 +97,15 @@ func (f *FileSystemDriver) Flush() error {
 	return ErrNotImplemented
 }
 
+func (f *FileSystemDriver) Ping() error {
+	_, err := os.Stat(f.Root)
+	if os.IsNotExist(err) {
+		// Directory is created on the first Set
+		return nil
+	}
+	return err
+}
+
 func fsOpener(url *config.URL) (Driver, error) {
 	value := filepath.FromSlash(url.Value)
 	if !filepath.IsAbs(value) {
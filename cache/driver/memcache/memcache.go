@@ -3,6 +3,7 @@
 package memcache
 
 import (
+	"bytes"
 	"net"
 	"strings"
 	"time"
@@ -22,6 +23,30 @@ func (c *memcacheDriver) Set(key string, b []byte, timeout int) error {
 	return c.error(c.Client.Set(&item))
 }
 
+func (c *memcacheDriver) CompareAndSwap(key string, old []byte, b []byte, timeout int) (bool, error) {
+	if old == nil {
+		err := c.Client.Add(&memcache.Item{Key: key, Value: b, Expiration: int32(timeout)})
+		if err == memcache.ErrNotStored {
+			return false, nil
+		}
+		return err == nil, c.error(err)
+	}
+	item, err := c.Client.Get(key)
+	if err != nil || item == nil {
+		return false, c.error(err)
+	}
+	if !bytes.Equal(item.Value, old) {
+		return false, nil
+	}
+	item.Value = b
+	item.Expiration = int32(timeout)
+	err = c.Client.CompareAndSwap(item)
+	if err == memcache.ErrCASConflict || err == memcache.ErrNotStored {
+		return false, nil
+	}
+	return err == nil, c.error(err)
+}
+
 func (c *memcacheDriver) Get(key string) ([]byte, error) {
 	item, err := c.Client.Get(key)
 	if err != nil {
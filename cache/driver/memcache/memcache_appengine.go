@@ -3,6 +3,7 @@
 package memcache
 
 import (
+	"bytes"
 	"time"
 
 	"appengine"
@@ -21,6 +22,34 @@ func (c *memcacheDriver) Set(key string, b []byte, timeout int) error {
 	return memcache.Set(c.c, item)
 }
 
+func (c *memcacheDriver) CompareAndSwap(key string, old []byte, b []byte, timeout int) (bool, error) {
+	expiration := time.Duration(timeout) * time.Second
+	if old == nil {
+		err := memcache.Add(c.c, &memcache.Item{Key: key, Value: b, Expiration: expiration})
+		if err == memcache.ErrNotStored {
+			return false, nil
+		}
+		return err == nil, err
+	}
+	item, err := memcache.Get(c.c, key)
+	if err != nil {
+		if err == memcache.ErrCacheMiss {
+			return false, nil
+		}
+		return false, err
+	}
+	if !bytes.Equal(item.Value, old) {
+		return false, nil
+	}
+	item.Value = b
+	item.Expiration = expiration
+	err = memcache.CompareAndSwap(c.c, item)
+	if err == memcache.ErrCASConflict || err == memcache.ErrNotStored {
+		return false, nil
+	}
+	return err == nil, err
+}
+
 func (c *memcacheDriver) Get(key string) ([]byte, error) {
 	item, err := memcache.Get(c.c, key)
 	if err != nil && err != memcache.ErrCacheMiss {
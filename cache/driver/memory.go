@@ -1,6 +1,7 @@
 package driver
 
 import (
+	"bytes"
 	"fmt"
 	"runtime"
 	"sort"
@@ -59,13 +60,35 @@ type MemoryDriver struct {
 }
 
 func (d *MemoryDriver) Set(key string, b []byte, timeout int) error {
+	d.set(key, b, timeout, nil)
+	return nil
+}
+
+func (d *MemoryDriver) CompareAndSwap(key string, old []byte, b []byte, timeout int) (bool, error) {
+	return d.set(key, b, timeout, func(prev *item) bool {
+		if prev == nil || (prev.expires != 0 && prev.expires < time.Now().Unix()) {
+			return old == nil
+		}
+		return old != nil && bytes.Equal(prev.data, old)
+	}), nil
+}
+
+// set stores the given data, but only if check is nil or returns
+// true when called with the current item (which might be nil) while
+// holding the cache lock. It returns true iff the data was stored.
+func (d *MemoryDriver) set(key string, b []byte, timeout int, check func(prev *item) bool) bool {
 	var expires int64
 	if timeout != 0 {
 		expires = time.Now().Unix() + int64(timeout)
 	}
 	prevSize := uint64(0)
 	cache.Lock()
-	if prev := cache.items[key]; prev != nil {
+	prev := cache.items[key]
+	if check != nil && !check(prev) {
+		cache.Unlock()
+		return false
+	}
+	if prev != nil {
 		prevSize = uint64(len(prev.data))
 	}
 	cache.items[key] = &item{
@@ -83,10 +106,10 @@ func (d *MemoryDriver) Set(key string, b []byte, timeout int) error {
 		cache.Unlock()
 		d.prune <- struct{}{}
 		d.mu.Unlock()
-		return nil
+		return true
 	}
 	cache.Unlock()
-	return nil
+	return true
 }
 
 func (d *MemoryDriver) Get(key string) ([]byte, error) {
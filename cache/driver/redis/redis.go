@@ -32,6 +32,26 @@ const (
 	DefaultIdleTimeout = 300
 )
 
+// casScript implements CompareAndSwap. ARGV[1] is "1" when
+// an existing value is expected, ARGV[2] is the expected value,
+// ARGV[3] the new one and ARGV[4] the timeout in seconds.
+var casScript = redis.NewScript(1, `
+local v = redis.call('GET', KEYS[1])
+if ARGV[1] == '1' then
+	if v ~= ARGV[2] then
+		return 0
+	end
+elseif v then
+	return 0
+end
+if ARGV[4] == '0' then
+	redis.call('SET', KEYS[1], ARGV[3])
+else
+	redis.call('SETEX', KEYS[1], ARGV[4], ARGV[3])
+end
+return 1
+`)
+
 type redisDriver struct {
 	pool *redis.Pool
 }
@@ -48,6 +68,20 @@ func (r *redisDriver) Set(key string, b []byte, timeout int) error {
 	return err
 }
 
+func (r *redisDriver) CompareAndSwap(key string, old []byte, b []byte, timeout int) (bool, error) {
+	exists := "0"
+	if old != nil {
+		exists = "1"
+	}
+	conn := r.pool.Get()
+	reply, err := redis.Int(casScript.Do(conn, key, exists, old, b, timeout))
+	conn.Close()
+	if err != nil {
+		return false, err
+	}
+	return reply == 1, nil
+}
+
 func (r *redisDriver) Get(key string) ([]byte, error) {
 	conn := r.pool.Get()
 	reply, err := conn.Do("GET", key)
@@ -115,6 +149,13 @@ func (r *redisDriver) Flush() error {
 	return err
 }
 
+func (r *redisDriver) Ping() error {
+	conn := r.pool.Get()
+	_, err := conn.Do("PING")
+	conn.Close()
+	return err
+}
+
 func redisOpener(url *config.URL) (driver.Driver, error) {
 	password := url.Fragment.Get("password")
 	db := -1
@@ -14,6 +14,12 @@
 // Users with more advanced requirements should write their own Mediator
 // implementation.
 //
+// When responses are compressed using gnd.la/app.Compress, the Layer
+// stores the compressed data, using a different key for each encoding,
+// so responses served from the cache are not compressed again. Note
+// that this requires the Compress Transformer to wrap the Layer (e.g.
+// by adding it to the App with App.AddTransformer).
+//
 //  cache, err := myapp.Cache()
 //  if err != nil {
 //	panic(err)
@@ -1,30 +1,87 @@
 package layer
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/gob"
 	"errors"
 	"net/http"
 	"os"
+	"sort"
+	"strings"
 
 	"gnd.la/app"
 	"gnd.la/cache"
+	"gnd.la/crypto/hashutil"
 	"gnd.la/encoding/codec"
 	"gnd.la/internal"
+	"gnd.la/internal/httpserve"
 	"gnd.la/log"
 )
 
 var (
-	fromLayer     = []string{"true"}
-	layerCodec    = codec.Get("gob")
-	errNoCache    = errors.New("nil cache passed to cache layer")
-	errNoMediator = errors.New("nil mediator passed to cache layer")
-	noCacheLayer  = os.Getenv("GONDOLA_NO_CACHE_LAYER") != ""
+	fromLayer          = []string{"true"}
+	layerCodec         = codec.Get("gob")
+	errNoCache         = errors.New("nil cache passed to cache layer")
+	errNoMediator      = errors.New("nil mediator passed to cache layer")
+	errInvalidResponse = errors.New("invalid cached response")
+	layerMagic         = []byte("GL\x01")
+	noCacheLayer       = os.Getenv("GONDOLA_NO_CACHE_LAYER") != ""
 )
 
 type cachedResponse struct {
 	Header     http.Header
 	StatusCode int
-	Data       []byte
+	ETag       string
+	// Vary is only set in the entries stored at the key
+	// returned by the Mediator for responses including a
+	// Vary header. It contains the request headers the
+	// response varies on, while the response itself is
+	// stored at the key returned by varyKey.
+	Vary []string
+	// Data is not encoded with the rest of the fields,
+	// see encodeResponse.
+	Data []byte
+}
+
+// encodeResponse encodes the response as the layerMagic prefix,
+// followed by the length of the encoded fields (except Data) as
+// an uvarint, the encoded fields and then the raw Data. This
+// allows answering conditional requests and serving responses
+// without decoding the whole payload.
+func encodeResponse(r *cachedResponse) ([]byte, error) {
+	meta, err := layerCodec.Encode(&cachedResponse{
+		Header:     r.Header,
+		StatusCode: r.StatusCode,
+		ETag:       r.ETag,
+		Vary:       r.Vary,
+	})
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, len(layerMagic)+binary.MaxVarintLen64+len(meta)+len(r.Data))
+	n := copy(buf, layerMagic)
+	n += binary.PutUvarint(buf[n:], uint64(len(meta)))
+	n += copy(buf[n:], meta)
+	n += copy(buf[n:], r.Data)
+	return buf[:n], nil
+}
+
+func decodeResponse(data []byte) (*cachedResponse, error) {
+	if !bytes.HasPrefix(data, layerMagic) {
+		return nil, errInvalidResponse
+	}
+	data = data[len(layerMagic):]
+	size, n := binary.Uvarint(data)
+	if n <= 0 || uint64(len(data)-n) < size {
+		return nil, errInvalidResponse
+	}
+	var response *cachedResponse
+	if err := layerCodec.Decode(data[n:n+int(size)], &response); err != nil {
+		return nil, err
+	}
+	response.Data = data[n+int(size):]
+	return response, nil
 }
 
 // Layer allows caching complete responses to requests.
@@ -63,7 +120,11 @@ func (la *Layer) Mediator() Mediator {
 // empty, Wrap returns the same app.Handler that was
 // received (id est, it does nothing). This is done in
 // order to simplify profiling Gondola apps (gondola dev
-// -profile sets this environment variable).
+// -profile sets this environment variable). Responses which
+// are flushed while being written (e.g. event streams started
+// with app.Context.EventStream) are never cached. Responses
+// with a Vary header are stored separately for each combination
+// of the values of the request headers they vary on.
 func (la *Layer) Wrap(handler app.Handler) app.Handler {
 	if noCacheLayer {
 		return handler
@@ -74,35 +135,88 @@ func (la *Layer) Wrap(handler app.Handler) app.Handler {
 			return
 		}
 		key := la.mediator.Key(ctx)
-		data, _ := la.cache.GetBytes(key)
-		if data != nil {
-			// has cached data
-			var response *cachedResponse
-			err := layerCodec.Decode(data, &response)
-			if err == nil {
-				ctx.Set(internal.LayerServedFromCacheKey, true)
-				header := ctx.Header()
-				for k, v := range response.Header {
-					header[k] = v
+		// When the response is compressed by app.Compress, store
+		// the compressed data, using a different key for each
+		// encoding.
+		cw, _ := ctx.ResponseWriter.(internal.CompressWriter)
+		if cw != nil {
+			key += "-" + cw.Encoding()
+		}
+		if response := la.cached(key, ctx.R); response != nil {
+			ctx.Set(internal.LayerServedFromCacheKey, true)
+			header := ctx.Header()
+			for k, v := range response.Header {
+				header[k] = v
+			}
+			header["X-Gondola-From-Layer"] = fromLayer
+			if response.StatusCode == http.StatusOK {
+				modified, _ := http.ParseTime(response.Header.Get("Last-Modified"))
+				if httpserve.NotModified(ctx.R, response.ETag, modified) {
+					httpserve.WriteNotModified(ctx)
+					return
 				}
-				header["X-Gondola-From-Layer"] = fromLayer
-				ctx.WriteHeader(response.StatusCode)
-				ctx.Write(response.Data)
-				return
 			}
+			ctx.WriteHeader(response.StatusCode)
+			ctx.Write(response.Data)
+			return
 		}
 
 		rw := ctx.ResponseWriter
 		w := newWriter(rw)
+		var compressed bytes.Buffer
+		if cw != nil {
+			// Only capture the compressed data when the
+			// response can be cached. Otherwise, streamed
+			// responses would be buffered forever.
+			w.onHeaders = func(code int, header http.Header) {
+				if la.mediator.Cache(ctx, code, header) {
+					cw.Capture(&compressed)
+				}
+			}
+		}
 		ctx.ResponseWriter = w
 		handler(ctx)
 		ctx.ResponseWriter = rw
-		if la.mediator.Cache(ctx, w.statusCode, w.header) {
-			response := &cachedResponse{w.header, w.statusCode, w.buf.Bytes()}
-			data, err := layerCodec.Encode(response)
+		if !w.streaming && la.mediator.Cache(ctx, w.statusCode, w.header) {
+			response := &cachedResponse{Header: w.header, StatusCode: w.statusCode, Data: w.buf.Bytes()}
+			if cw != nil {
+				if err := cw.Finish(); err != nil {
+					log.Errorf("Error compressing cached response: %v", err)
+					return
+				}
+				response.Data = compressed.Bytes()
+				if w.header != nil {
+					response.Header = http.Header{}
+					for k, v := range cw.Header() {
+						response.Header[k] = v
+					}
+				}
+			}
+			if response.Header == nil {
+				response.Header = http.Header{}
+			}
+			if response.ETag = response.Header.Get("ETag"); response.ETag == "" {
+				response.ETag = httpserve.WeakETag(response.Data)
+				response.Header.Set("ETag", response.ETag)
+			}
+			vary, ok := responseVary(response.Header, cw != nil)
+			if !ok {
+				// Vary: *, can't be cached
+				return
+			}
+			expiration := la.mediator.Expires(ctx, w.statusCode, w.header)
+			if len(vary) > 0 {
+				index, err := encodeResponse(&cachedResponse{Vary: vary})
+				if err != nil {
+					log.Errorf("Error encoding cached response: %v", err)
+					return
+				}
+				la.cache.SetBytes(key, index, expiration)
+				key = varyKey(key, ctx.R, vary)
+			}
+			data, err := encodeResponse(response)
 			if err == nil {
 				ctx.Set(internal.LayerCachedKey, true)
-				expiration := la.mediator.Expires(ctx, w.statusCode, w.header)
 				la.cache.SetBytes(key, data, expiration)
 			} else {
 				log.Errorf("Error encoding cached response: %v", err)
@@ -111,6 +225,61 @@ func (la *Layer) Wrap(handler app.Handler) app.Handler {
 	}
 }
 
+// cached returns the cached response for the given key
+// and request, or nil if there's no such response.
+func (la *Layer) cached(key string, r *http.Request) *cachedResponse {
+	data, _ := la.cache.GetBytes(key)
+	if data == nil {
+		return nil
+	}
+	response, err := decodeResponse(data)
+	if err != nil {
+		return nil
+	}
+	if len(response.Vary) > 0 {
+		if r == nil {
+			return nil
+		}
+		return la.cached(varyKey(key, r, response.Vary), nil)
+	}
+	return response
+}
+
+// responseVary returns the canonicalized and sorted header names
+// in the Vary header. Accept-Encoding is omitted when compressed
+// is true, since the key already includes the encoding. If the
+// response can't be cached because it varies on "*", ok is false.
+func responseVary(header http.Header, compressed bool) (vary []string, ok bool) {
+	for _, v := range header["Vary"] {
+		for _, name := range strings.Split(v, ",") {
+			name = strings.TrimSpace(name)
+			if name == "*" {
+				return nil, false
+			}
+			name = http.CanonicalHeaderKey(name)
+			if name == "" || (compressed && name == "Accept-Encoding") {
+				continue
+			}
+			vary = append(vary, name)
+		}
+	}
+	sort.Strings(vary)
+	return vary, true
+}
+
+// varyKey returns the key used for storing a response to
+// the given request which varies on the given headers.
+func varyKey(key string, r *http.Request, vary []string) string {
+	var buf bytes.Buffer
+	for _, v := range vary {
+		buf.WriteString(v)
+		buf.WriteByte(':')
+		buf.WriteString(strings.Join(r.Header[v], ","))
+		buf.WriteByte('\n')
+	}
+	return key + "-" + hashutil.Md5(buf.String())
+}
+
 func init() {
 	gob.Register(&cachedResponse{})
 }
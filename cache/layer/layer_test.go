@@ -0,0 +1,307 @@
+package layer
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gnd.la/app"
+	"gnd.la/cache"
+	"gnd.la/config"
+)
+
+func TestCompressedLayer(t *testing.T) {
+	u, err := config.ParseURL("memory://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := cache.New(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	la, err := New(c, &SimpleMediator{Expiration: 60})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := strings.Repeat("<p>Hello world</p>", 100)
+	calls := 0
+	a := app.New()
+	a.AddTransformer(app.Compress(nil))
+	a.Handle("^/$", la.Wrap(func(ctx *app.Context) {
+		calls++
+		ctx.SetHeader("Content-Type", "text/html")
+		ctx.WriteString(body)
+	}))
+	streamCalls := 0
+	a.Handle("^/stream/$", la.Wrap(func(ctx *app.Context) {
+		streamCalls++
+		ctx.SetHeader("Content-Type", "text/plain")
+		ctx.WriteString(body)
+		ctx.ResponseWriter.(http.Flusher).Flush()
+		ctx.WriteString(body)
+	}))
+	if err := a.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	get := func(gzipped bool) string {
+		r, err := http.NewRequest("GET", "http://localhost/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gzipped {
+			r.Header.Set("Accept-Encoding", "gzip")
+		}
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, r)
+		if !gzipped {
+			return w.Body.String()
+		}
+		if enc := w.Header().Get("Content-Encoding"); enc != "gzip" {
+			t.Fatalf("expecting gzip encoding, got %q", enc)
+		}
+		gr, err := gzip.NewReader(w.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := ioutil.ReadAll(gr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	for ii := 0; ii < 2; ii++ {
+		r, err := http.NewRequest("GET", "http://localhost/stream/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Header.Set("Accept-Encoding", "gzip")
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, r)
+		if w.Header().Get("X-Gondola-From-Layer") != "" {
+			t.Errorf("streamed response %d was served from the cache", ii)
+		}
+	}
+	if streamCalls != 2 {
+		t.Errorf("expecting 2 calls to the streaming handler, got %d", streamCalls)
+	}
+	for ii, v := range []struct {
+		gzipped bool
+		calls   int
+	}{
+		{true, 1},
+		{true, 1},
+		{false, 2},
+		{false, 2},
+	} {
+		if got := get(v.gzipped); got != body {
+			t.Errorf("request %d returned unexpected body %q", ii, got)
+		}
+		if calls != v.calls {
+			t.Errorf("expecting %d handler calls after request %d, got %d", v.calls, ii, calls)
+		}
+	}
+}
+
+func TestConditionalLayer(t *testing.T) {
+	u, err := config.ParseURL("memory://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := cache.New(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	la, err := New(c, &SimpleMediator{Expiration: 60})
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := 0
+	a := app.New()
+	a.Handle("^/conditional/$", la.Wrap(func(ctx *app.Context) {
+		calls++
+		ctx.WriteString("hello")
+	}))
+	if err := a.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	get := func(etag string) *httptest.ResponseRecorder {
+		r, err := http.NewRequest("GET", "http://localhost/conditional/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if etag != "" {
+			r.Header.Set("If-None-Match", etag)
+		}
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, r)
+		return w
+	}
+	get("")
+	w := get("")
+	etag := w.Header().Get("ETag")
+	if etag == "" || w.Header().Get("X-Gondola-From-Layer") == "" {
+		t.Fatalf("expecting a cached response with an ETag, got headers %v", w.Header())
+	}
+	w = get(etag)
+	if w.Code != http.StatusNotModified || w.Body.Len() != 0 {
+		t.Errorf("expecting an empty 304 response, got %d %q", w.Code, w.Body.String())
+	}
+	if w = get(`"foo"`); w.Code != http.StatusOK || w.Body.String() != "hello" {
+		t.Errorf("unexpected response %d %q", w.Code, w.Body.String())
+	}
+	if calls != 1 {
+		t.Errorf("expecting 1 handler call, got %d", calls)
+	}
+}
+
+func TestVaryLayer(t *testing.T) {
+	u, err := config.ParseURL("memory://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := cache.New(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	la, err := New(c, &SimpleMediator{Expiration: 60})
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := 0
+	a := app.New()
+	a.AddTransformer(app.CORS(&app.CORSOptions{AllowedOrigins: []string{"https://*.example.com"}}))
+	a.Handle("^/vary/$", la.Wrap(func(ctx *app.Context) {
+		calls++
+		ctx.WriteString("hello")
+	}))
+	if err := a.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	for ii, v := range []struct {
+		origin  string
+		allowed string
+		calls   int
+	}{
+		{"https://a.example.com", "https://a.example.com", 1},
+		{"https://a.example.com", "https://a.example.com", 1},
+		{"https://b.example.com", "https://b.example.com", 2},
+		{"https://example.org", "", 3},
+		{"https://b.example.com", "https://b.example.com", 3},
+		{"https://example.org", "", 3},
+	} {
+		r, err := http.NewRequest("GET", "http://localhost/vary/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Header.Set("Origin", v.origin)
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, r)
+		if allowed := w.Header().Get("Access-Control-Allow-Origin"); allowed != v.allowed {
+			t.Errorf("request %d from %s: expecting allowed origin %q, got %q", ii, v.origin, v.allowed, allowed)
+		}
+		if calls != v.calls {
+			t.Errorf("expecting %d handler calls after request %d, got %d", v.calls, ii, calls)
+		}
+	}
+}
+
+type layerTestUser int64
+
+func (u layerTestUser) Id() int64     { return int64(u) }
+func (u layerTestUser) IsAdmin() bool { return false }
+
+func TestAuthenticatedLayer(t *testing.T) {
+	u, err := config.ParseURL("memory://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := cache.New(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	la, err := New(c, &SimpleMediator{Expiration: 60})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenFunc := func(ctx *app.Context, token string) (*app.Authentication, error) {
+		id, err := strconv.ParseInt(token, 10, 64)
+		if err != nil {
+			return nil, nil
+		}
+		return &app.Authentication{User: layerTestUser(id)}, nil
+	}
+	calls := 0
+	a := app.New()
+	a.SetAuthenticators(app.BearerAuthenticator(tokenFunc), app.APIKeyAuthenticator(tokenFunc))
+	a.Handle("^/user/$", la.Wrap(func(ctx *app.Context) {
+		calls++
+		if user := ctx.User(); user != nil {
+			ctx.WriteString(strconv.FormatInt(user.Id(), 10))
+			return
+		}
+		ctx.WriteString("anonymous")
+	}))
+	if err := a.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	for ii, v := range []struct {
+		header   string
+		value    string
+		expected string
+		calls    int
+	}{
+		{"", "", "anonymous", 1},
+		{"Authorization", "Bearer 1", "1", 2},
+		{"", "", "anonymous", 2},
+		{app.APIKeyHeaderName, "2", "2", 3},
+		{"Authorization", "Bearer 1", "1", 4},
+		{"", "", "anonymous", 4},
+	} {
+		r, err := http.NewRequest("GET", "http://localhost/user/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.header != "" {
+			r.Header.Set(v.header, v.value)
+		}
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, r)
+		if body := w.Body.String(); body != v.expected {
+			t.Errorf("request %d: expecting body %q, got %q", ii, v.expected, body)
+		}
+		if vary := strings.Join(w.Header()["Vary"], ", "); !strings.Contains(vary, "Authorization") || !strings.Contains(vary, app.APIKeyHeaderName) {
+			t.Errorf("request %d: expecting Vary to include Authorization and %s, got %q", ii, app.APIKeyHeaderName, vary)
+		}
+		if calls != v.calls {
+			t.Errorf("expecting %d handler calls after request %d, got %d", v.calls, ii, calls)
+		}
+	}
+}
+
+func TestEncodeResponse(t *testing.T) {
+	r := &cachedResponse{
+		Header:     http.Header{"Content-Type": {"text/plain"}},
+		StatusCode: http.StatusOK,
+		ETag:       `"foo"`,
+		Data:       []byte("hello"),
+	}
+	data, err := encodeResponse(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dec, err := decodeResponse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(r, dec) {
+		t.Errorf("expecting %+v after decoding, got %+v", r, dec)
+	}
+	if _, err := decodeResponse(data[:len(data)-len(r.Data)-2]); err == nil {
+		t.Error("expecting an error when decoding a truncated response")
+	}
+}
@@ -23,8 +23,11 @@ type Mediator interface {
 
 // SimpleMediator implements a Mediator which caches GET and HEAD
 // request with a 200 response code for a fixed time and skips
-// the cache if any of the indicated cookies are present. Cache keys
-// are generated by hashing the request method and its URL.
+// the cache if any of the indicated cookies are present or if
+// the request includes credentials in the Authorization or
+// X-API-Key headers (see app.BearerAuthenticator and
+// app.APIKeyAuthenticator). Cache keys are generated by hashing
+// the request method and its URL.
 type SimpleMediator struct {
 	// SkipCookies includes any cookie which should make the request
 	// skip the cache Layer when the cookie is present.
@@ -37,6 +40,9 @@ func (m *SimpleMediator) Skip(ctx *app.Context) bool {
 	if m := ctx.R.Method; m != "GET" && m != "HEAD" {
 		return true
 	}
+	if ctx.R.Header.Get("Authorization") != "" || ctx.R.Header.Get(app.APIKeyHeaderName) != "" {
+		return true
+	}
 	c := ctx.Cookies()
 	for _, v := range m.SkipCookies {
 		if c.Has(v) {
@@ -10,6 +10,12 @@ type writer struct {
 	buf        *bytes.Buffer
 	statusCode int
 	header     http.Header
+	// streaming is set when the response is flushed,
+	// since streamed responses can't be cached.
+	streaming bool
+	// onHeaders, if non-nil, is called with the response
+	// code and headers before the first write.
+	onHeaders func(code int, header http.Header)
 }
 
 func (w *writer) copyHeaders() {
@@ -30,16 +36,29 @@ func (w *writer) WriteHeader(code int) {
 }
 
 func (w *writer) Write(data []byte) (int, error) {
+	if w.header == nil && !w.streaming && len(data) > 0 {
+		w.copyHeaders()
+		if w.onHeaders != nil {
+			w.onHeaders(w.statusCode, w.header)
+		}
+	}
 	n, err := w.ResponseWriter.Write(data)
-	if err == nil && n > 0 {
+	if err == nil && n > 0 && !w.streaming {
 		w.buf.Write(data)
-		if w.header == nil {
-			w.copyHeaders()
-		}
 	}
 	return n, err
 }
 
+// Flush implements http.Flusher, allowing handlers to stream
+// their responses (e.g. Server-Sent Events) through the Layer.
+func (w *writer) Flush() {
+	w.streaming = true
+	w.buf.Reset()
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 func newWriter(rw http.ResponseWriter) *writer {
 	return &writer{
 		ResponseWriter: rw,
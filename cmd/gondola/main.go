@@ -42,6 +42,12 @@ var (
 			Options: &bakeOptions{},
 			Func:    bakeCommand,
 		},
+		{
+			Name:    "openapi",
+			Help:    "Generate the OpenAPI document for the app handlers (requires the app to import gnd.la/commands)",
+			Func:    openAPICommand,
+			Options: &openAPIOptions{Dir: ".", Format: "json"},
+		},
 		{
 			Name:    "random-string",
 			Help:    "Generates a random string suitable for use as the app secret",
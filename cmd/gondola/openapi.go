@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"gnd.la/log"
+
+	"github.com/rainycape/command"
+)
+
+type openAPIOptions struct {
+	Dir     string `help:"Project directory"`
+	Config  string `help:"Configuration file. If empty, dev.conf and app.conf are tried in that order"`
+	Format  string `help:"Document format, either json or yaml"`
+	Output  string `name:"o" help:"Output file. If empty or -, outputs to stdout"`
+	Title   string `help:"API title"`
+	Version string `help:"API version"`
+	Tags    string `help:"Build tags to pass to the Go compiler"`
+}
+
+func openAPICommand(_ *command.Args, opts *openAPIOptions) error {
+	dir, err := filepath.Abs(opts.Dir)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempDir("", "gondola-openapi")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	bin := filepath.Join(tmp, filepath.Base(dir))
+	buildArgs := []string{"build", "-o", bin}
+	if opts.Tags != "" {
+		buildArgs = append(buildArgs, "-tags", opts.Tags)
+	}
+	log.Debugf("building %s", dir)
+	build := exec.Command("go", buildArgs...)
+	build.Dir = dir
+	if err := runCmd(build); err != nil {
+		return err
+	}
+	args := []string{"-log-debug=false"}
+	if configPath := findConfig(dir, opts.Config); configPath != "" {
+		args = append(args, "-config", configPath)
+	}
+	output := opts.Output
+	if output != "" && output != "-" {
+		if output, err = filepath.Abs(output); err != nil {
+			return err
+		}
+	}
+	args = append(args, "openapi",
+		fmt.Sprintf("-format=%s", opts.Format),
+		fmt.Sprintf("-o=%s", output),
+		fmt.Sprintf("-title=%s", opts.Title),
+		fmt.Sprintf("-version=%s", opts.Version),
+	)
+	cmd := exec.Command(bin, args...)
+	cmd.Dir = dir
+	return runCmd(cmd)
+}
@@ -108,6 +108,29 @@ func renderTemplate(ctx *app.Context) {
 	}
 }
 
+func openAPI(ctx *app.Context) {
+	var format, output, title, version string
+	ctx.ParseParamValue("format", &format)
+	ctx.ParseParamValue("o", &output)
+	ctx.ParseParamValue("title", &title)
+	ctx.ParseParamValue("version", &version)
+	opts := &app.OpenAPIOptions{
+		Title:   title,
+		Version: version,
+	}
+	data, err := ctx.App().MarshalOpenAPI(opts, format)
+	if err != nil {
+		panic(err)
+	}
+	if output == "" || output == "-" {
+		fmt.Println(string(data))
+	} else {
+		if err := ioutil.WriteFile(output, data, 0644); err != nil {
+			panic(err)
+		}
+	}
+}
+
 func init() {
 	MustRegister(catFile,
 		Help("Prints a file from the blobstore to the stdout"),
@@ -120,4 +143,12 @@ func init() {
 		Help("Render a template and print its output"),
 		StringFlag("o", "", "Output file. If empty or -, outputs to stdout"),
 	)
+	MustRegister(openAPI,
+		Name("openapi"),
+		Help("Print the OpenAPI document for the app handlers"),
+		StringFlag("format", "json", "Document format, either json or yaml"),
+		StringFlag("o", "", "Output file. If empty or -, outputs to stdout"),
+		StringFlag("title", "", "API title"),
+		StringFlag("version", "", "API version"),
+	)
 }
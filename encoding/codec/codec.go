@@ -14,6 +14,8 @@
 package codec
 
 import (
+	"sort"
+
 	"gnd.la/util/structs"
 )
 
@@ -37,6 +39,10 @@ type Codec struct {
 	Decode func(data []byte, v interface{}) error
 	// Binary indicates if the codec returns binary or text data
 	Binary bool
+	// ContentType is the MIME type of the encoded data. It's
+	// used for content negotiation, codecs without a ContentType
+	// are never offered to HTTP clients.
+	ContentType string
 }
 
 // Register registers a codec to be made available for
@@ -54,6 +60,17 @@ func Get(name string) *Codec {
 	return codecs[name]
 }
 
+// Names returns the names of all the registered
+// codecs, sorted alphabetically.
+func Names() []string {
+	names := make([]string, 0, len(codecs))
+	for k := range codecs {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // FromTag returns the pipe for a given field tag.
 func FromTag(t *structs.Tag) *Codec {
 	return codecs[t.CodecName()]
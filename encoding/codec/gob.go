@@ -6,7 +6,7 @@ import (
 )
 
 var (
-	gobCodec = &Codec{Encode: gobMarshal, Decode: gobUnmarshal, Binary: true}
+	gobCodec = &Codec{Encode: gobMarshal, Decode: gobUnmarshal, Binary: true, ContentType: "application/x-gob"}
 )
 
 func gobMarshal(v interface{}) ([]byte, error) {
@@ -5,7 +5,7 @@ import (
 )
 
 var (
-	jsonCodec = &Codec{Encode: json.Marshal, Decode: json.Unmarshal}
+	jsonCodec = &Codec{Encode: json.Marshal, Decode: json.Unmarshal, ContentType: "application/json"}
 )
 
 func init() {
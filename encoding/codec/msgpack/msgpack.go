@@ -13,7 +13,7 @@ import (
 )
 
 var (
-	msgpackCodec = &codec.Codec{Encode: msgpackMarshal, Decode: msgpackUnmarshal, Binary: true}
+	msgpackCodec = &codec.Codec{Encode: msgpackMarshal, Decode: msgpackUnmarshal, Binary: true, ContentType: "application/x-msgpack"}
 	handle       = &gocodec.MsgpackHandle{}
 )
 
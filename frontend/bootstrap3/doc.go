@@ -17,6 +17,7 @@
 // about template functions and the assets pipeline.
 //
 // Importing this package will also register FormRenderer as the default
-// gnd.la/form renderer and PaginatorRenderer as the default
-// gnd.la/html/paginator renderer.
+// gnd.la/form renderer, PaginatorRenderer as the default
+// gnd.la/html/paginator renderer and FlashRenderer as the default
+// gnd.la/app flash messages renderer.
 package bootstrap3
@@ -0,0 +1,48 @@
+package bootstrap3
+
+import (
+	"gnd.la/app"
+	"gnd.la/html"
+)
+
+// FlashRenderer implements a gnd.la/app.FlashRenderer using
+// bootstrap alerts. If Dismissible is true, each alert includes
+// a button for closing it, which requires bootstrap's javascript.
+type FlashRenderer struct {
+	Dismissible bool
+}
+
+func (r *FlashRenderer) Root() *html.Node {
+	return html.Div().AddClass("flashes")
+}
+
+func (r *FlashRenderer) Node(level app.FlashLevel, message string) *html.Node {
+	div := html.Div()
+	div.AddClass("alert")
+	div.AddClass("alert-" + flashAlertClass(level))
+	div.SetAttr("role", "alert")
+	if r.Dismissible {
+		div.AddClass("alert-dismissible")
+		button := &html.Node{Tag: "button", Children: html.Span(html.Text("&times;")).SetAttr("aria-hidden", "true")}
+		button.SetAttr("type", "button").AddClass("close").SetAttr("data-dismiss", "alert").SetAttr("aria-label", "Close")
+		div.AppendChild(button)
+	}
+	div.AppendChild(html.Text(message))
+	return div
+}
+
+func flashAlertClass(level app.FlashLevel) string {
+	switch level {
+	case app.FlashError:
+		return "danger"
+	case app.FlashSuccess, app.FlashWarning:
+		return string(level)
+	}
+	return "info"
+}
+
+func init() {
+	app.SetDefaultFlashRenderer(func() app.FlashRenderer {
+		return &FlashRenderer{Dismissible: true}
+	})
+}
@@ -59,10 +59,38 @@ function initializeGraphs() {
                 graph.series.addData(graphData);
                 graph.render();
             }
+            updateHandlers(data);
         }, 'json');
     }, INTERVAL);
 }
 
+function updateHandlers(data) {
+    var tbody = document.getElementById('handlers');
+    var handlers = data.handlers || [];
+    var rows = [];
+    for (var ii = 0; ii < handlers.length; ii++) {
+        var h = handlers[ii];
+        var avg = h.total > 0 ? (h.latency / h.total / 1e6).toFixed(2) + ' ms' : '-';
+        var cells = [h.name, h.in_flight, h.requests['2xx'], h.requests['3xx'],
+            h.requests['4xx'], h.requests['5xx'], h.from_cache, h.bytes, avg];
+        var row = document.createElement('tr');
+        for (var jj = 0; jj < cells.length; jj++) {
+            var cell = document.createElement('td');
+            cell.appendChild(document.createTextNode(cells[jj]));
+            row.appendChild(cell);
+        }
+        rows.push(row);
+    }
+    while (tbody.firstChild) {
+        tbody.removeChild(tbody.firstChild);
+    }
+    for (var ii = 0; ii < rows.length; ii++) {
+        tbody.appendChild(rows[ii]);
+    }
+    var queries = document.getElementById('orm-queries');
+    queries.textContent = data.orm_queries !== undefined ? 'ORM queries: ' + data.orm_queries : '';
+}
+
 function getDottedKey(data, k) {
     var keys = k.split('.');
     for (var ii = 0; ii < keys.length; ii++) {
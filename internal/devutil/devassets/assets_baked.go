@@ -4,4 +4,4 @@ package devassets
 
 import "gnd.la/util/vfsutil"
 
-var AssetsFS = vfsutil.MustOpenBaked("\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xec\xbd\xe1\x96\xdb6\xb2 <\xbf\xfd\x14e͎C\xb6(\xb6\xa8nۉ\xbae\x9f\xc4q&ٵ\x9d\xac\xedɝ\xb9=\xbd}(\x12\x92\xe8\xa6H\x9a\xa4\xba%'\xbd\u007f\xf6\x99\xee\v\xdc\x17\xd8W\xfaN\x15\x00\x12 A\xb6ڎ33\xdf\xde\xcc\x19\xb7\b\x14\n\x85B\xa1P\x00\n\x05?\xcb\xdcw\xc5\x1f>\xeb\u007fco\x8c\xff\xfda<6\xfe\x9d<z\xf4H\xfe\xe6\xe9\xde\xf8h2\xfe\x03\x8c\xff\xf0;\xfc\xb7)J?\xff\xc3\xf8\x93\xebj6\xee_\xe4\xbf+?\x87\xbf\xbe|\xf1}Yf\xdf\xf9A\x99\xe6\x11+`\x06g\xf7\x00\x00\x16\x9b$(\xa34\x01ˆ_rVn\xf2\x04\x12v-\v\xbcf\xef7\xac(-\xfb\xc6\xe9\x05\xff:(\xa3+\xf6\xd7\x1f\xe7\xefXPZ\x83\x97\xc5v\x1dO\\D\xf2\xf6\xedO\x83\x8f)}\xf4ѥ\xa3 O\x8btQ*\b\ue75fܻW\x95\x0fr\xe6\x97L\xe4\x8ab6\xfcB\x95 \xb3\xb6\xebxU\x96\x19\xcc \xd9\xc4\xf1\t\xaf<\xcd\xc1\xc2\xcc(\x82\x19\x8cO\xf0\xefi\x8b\xadn̒e\xb9\xc2\xdc\xe1P\xa2\xc4\xff\xca|\xa7|\xe1\u007fu%M$gQtn\xd9'\x15\xf4M\xf5+\xf0\xcb`\x05\x16\xb3\x1b\xb8\x824)\xa3d\xc3Le\xe69\xf3/y\x06O\x14\x9c\x13\xf5\x9fܻQ8S\xb0$\x94]\xbe\xc9c\aB\xbf\xf4\x1d\b\xfc8\x9e\xfb\xc1\xa5ʤ\x9c\xbd\x87\x99\x99\x95\xbc\xb6h\x01\xd6\xfd\x9c\xbdW\x89\xe5u\xab\xd4 \xaa5+Wi\b3\xaa\r\x9e\xc2\xe0\xa7\x1f\u07fc\x1d\xc0\x14\x06\u007f~\xfevp\"\xa8~\xef\xa6\x19K,\x0e\xec\x00\x91W\xe6\x1b\xa6T\x87\xe5\xf5\xea\u07bb\x05+E\x8b\xbeg~\xc8r\xeb\x8bgiR\xb2\xa4\x1c\x95\xbb\x8c}\xe1|\xe1gY\x1c\x05>\xb6\xffp;\xba\xbe\xbe\x1e-\xd2|=\xda\xe41K\x824d\xe1\x17\xb6ƽ\xf7n\x9a\xe4\xcc\x0fwE\xe9\x97,X\xf9ɒ\xc1L\x97Ί\x02\xa4\n\x8bP\x817X\x00f38nu\xa0`0¶z\xfe\xe6^\a\xa6\xfb\rL\xbc\xb9I\xc8\xf9 \xb9\xac\xf6o\xe6\xe7\x05\xfb\xefE\x9aX%ۖ\xb2,\xa2\xfe\xefo~|\x05\x0f\x1e\x00\xfeu\t\xac\xddoJ&/o\x10+v\xe5\xc7\xd6\x17\xd6\x170\x04\x04\x81!|a\u007fa\xebR\xe6g\x196`SXM\xc1R\xe5\xef\xcf?\xbe\xfa\xf6\xc7\x17__|\xfb\xfc\xe7\x8b7\xcf_\xff\xfc\xfc\xf5ś\xb7_\xbf\xfd\xcb\x1b\x87\x86\xa5\xa3p\xbc!e\\<\xe3\xd4G\x99Z\xf8q\xa1\f\f\xc9Ƃ\b\xa0\xce\x18ww\aV\xa4\xf6\a\xb0\xb8`\r`^[\x81#\xb9f/\xef\xa9\"K\x93\x82\xbd\xadYe\xe8\xef\"kw8\xf2\xeb\xb7\xd3\xffs\xbf`\xee\xaa\\\xc7\xff\xb8\xf9\xff\xe1㇓\xc6\xfc\xef\x8d\xc7\xde\u007f\xcd\xff\xbf\xc7\u007f\xbf\xfcrxp\x0f J\x82x\x13\xb2)p{\xd0\x11C\xe4b͊\xc2_r\t\xb9wpxss\xef\xf4\xfe\xb7?>{\xfb\xb7\x9f\x9e\x03\xa6=\xb9w*\xff0?|rﴌʘ=\xf9\xe5\x17(\xd9:\x8bQ\x11\r(i\x00\xee\xcd\xcd\xe9!ϾwZ\x94\xbb\x98\x01*\xd9\xd9\x00U\xc1aP\x14\x83'\xf7\x0e\x0f\x00\xe7\x9d\xe9\xe1\xe1\x9a\xedX~\xcd\xe6n\x90\xae\x0fY\x1e\x05\x87e\x9a\xc6\x05\xc2\x1d\xe6\xac`\xe5!\x8e\x86\xab\x89;\x86_a\x82\x12\xe3M\x1eaҋ(`I\xc1\xa6\x90\xa4\t\x03+\xdb\xcc\xe3(\x800]\xfbQb\xdf;8\xbcw\x0f\tv`\x9e\x86;\a\xc2\xe8ʁ\"\xf3\x13\a[\x1e\xb3ҁ\x94&*\a\xa2E\uebd9so\xe59\xb0\x9a8\xb0:r`u\xec\xc0\xea\xa1\x03\xabG\x0ed\x0e\xcc\xe34\xb8|\xbfIK\xe6@\x963\xe7\x9e\xef\x80?\x9f\xe7\x0e\xf8A\x9e&\xbb\xb5\x03~\x18\xe6\xac(\x1c\x98GK\a\x82\bAq\xe2p\xee\x85\f\xa7\xd0E\xe2\x00[;\x10\xad\x97\x0eDI\xe1\xc0\xe5<t\xe0\xbd\x03\x85\x03\x85\xbfΜ{\xc5\xdaG\x8dV\x94yt\xc9\xe8o\x9a,\x1d(6s\xfc's\xa0,\x1dT4ν\xb9\x03\x1b\a\"\a\x02\x96\x94,w\xee\x85XE\xe9@\x18:\x90\xc6\x0elb\a\xe2ȹ\xb7\x88X\x1c\x16\xd8Z\x9c\xce\x1c\x88\xfd9R\x13\xb3%KB\xe7^\xe9\xcfc\xa4\xd3\xcfP\x85:Prf\x95\x8b4-\x1d(\xb1\xabqzş\x0e\x94\xa1s\xcf\xcf\xcb(\xc0\"~\x11\x85T2\xb9\xf2\v\aBV\xfaQ\\`\x13\xe7,\xc4z\x97\x9b\x9c9\xb0\x88\x96\x15rD\xcar\aV4\x03;\xb0Z\xe6\xe9&s\xee\xadY\xb2q \xf1\xaf\x1cH7e\xb6)\x1d\xc87\xf3\x9d\x03\x05\vx\xc9b\xb3^\xfb\xf9ιWFk\xe6\xc0\xda\xcf/\x1d\xf07a\x94:p\x15\x85,%e\xbc\xf6\xf3e\x94La\x8c\x9a4\xf3\xc30J\x96\xe2k\x9e\xe6!\xcb\xc5\xc7\"M\xcaQ\x11}`S\xf0\xc6\xe3?ɤ)DɊ\xe5Q\x89\tW\f\x1b\xea\xc7#?\x8e\x96\xc9\x14P{\xc6Q\xc2p\n;<\x80\xef\u07fe|\xf1\x10¨\xc8b\u007f7\xcaӘ\x01\xc9*\x19\x88i\x1c\xb2\x1c\xe6yz]\xb0\xbc\x80\x83\xc3\x16\xd7*ni\xec\xe1,\xbb\xd7\xc1&P\xd8$\x18C\xad\x16DL\xb9\x8c\"}؇\x94\x85\x04\x8fV,Z\xae\xca)x\x98\xc5ECd\x16\xe5\x88F'\x1fATR\x11\xf3\xf7\x04E\x1f\x85\tb:g\x8b\x14\xbbXI\xf2\x17$\x8c\ufafc\xf7<\x89P\x05\xdc\xe0\x9a\xc2\x17_\x9c\xa8\x9f\x125\xc9\"A\xf2\xde\x1a\x05i\x1c\xfb\x19\x8ep\xf9\xab\xee\xcaQ\x91\xf9\x81\xec_\xea\x92\xe7I\b\xe9Bt\xc3\xc1a\xcd\x05\xea\ue17f\x8e\xe2\xdd\x14~fy\xe8'~S\f\x1ee[\xc2\xed\a\x97\xc8\xed$\x9c\xc2\x1f\xbf}\x86\xffC\xec+\xafFt-\xd89O㰁\xe5ẖp9\x1c\xcdӲL\xd7(c\x98|souTc\xe1\x05&\"\xc7ey\x9er&i\x14|\xf7ݳ\xf1\xb3o\b\xe2\xdaϓ(Y\x9a`\xbe\xfb\xfa;\xe2\x81\x1b%\x8b\xd4\x00\xf0\f\xffG\x00\xa8\x8e\f\x00\xe3\xef\xbe\xfc\xee\xbb\n\xc0]o\xe22\x9a&\xe5j\x14\xac\xa28\xb4\xd20\xb4{\xeb\xe5\x82J ը\x9b\b^\x88ުx\x91m\xa1H\xe3(T\xd9\xeb\x16\x9b `EѮ\xe4\xdb\xef\xbe\x1b\u007f\xfb%\xc2d\xb9&\x1a\xb9\x1fF\x9bb\n\x0fy-\xcd1\x80\xd2\x15\xa7\xf9\x14\xfe\xf8\xec\xbbo\xbfy\xfe\xac\x1e\xe2I\x9a\xaf\xfd\x18\xbcI\xb6=\x9cL\xb2-\xbcL\x13?H\x9d\x97i\x92\xa2H1\xb8\x1f\xad\xb34/\xfd\xa4\xd4%b$QN\x16\xf8\xbfFf\xb4\xf6\x97l\n\xa3k6\xbf\x8c\xcaQ\xce2\xe6\x97Q\xb2\x1c\xe1\x18\xf4\xf3\xd1\x12)fIi\x95i\xe6\xc0\x1f\x8f\x8f\x8fa\x9cm\xc5/$đ\x88\x1b_\xc7\xc7\xd9\xd6\xee\xa8l\x9d~\xf8\xbdj*~\xa7\x8a\xd2ߧ\x9e\xcfPI=\xe1\x80\xf7PU\x04S\xf0ؚ\xcf<\xe9\x15\xcb\x17qz=\x05\u007fS\xa6(\xd74\xe57\xf5\x82\xe7u\x895ZPrJ\xe2S\xbfV\x8f\xd44\x1e\xd4x+$Q\xc2'\xb0zp<z\xf4\xe8D\x9f7\xf9@s\x83M\x9e\xb3\xa4l\x0f\xc8/\xbfA\x93W\xc5!\xd5K\xab\xa2QE\xf4u\x14\x96\xabz\xae\x95\x13\x12\xf2\xf1\x16ƍaē8]hq\xa9Z@\xd5*aZ\x96,T\xe9\t6y\x81$fi\xc4\x19us\xcf\r\xd9ը`\xf9\x15\xcbG\xb5\xce53U6\xb0nrE\x95{$;T\x9fa݇lm\xae\x06\rO\xe3l-j/s?)\xd0B\x9b\xc2&\xcbX\x1e\xf8\x05͉\xa7\x874C?\xb9wz(\xccn\x9c֞\xdc\xfb\xe5\x17`ے%!\xa0\xa1~\xc8\x13O\x0f\xb9\x85ޱ\xfe\xdbD1\xf2\xf9s\xae\x01o\xdb\xff=\xf2\x8e\x9b\xeb\xbfG\x0f\x8f\xfek\xfd\xf7;\xae\xff\xb8\xd8\x14ܢ\xe5\xab=eQXdQ\xe2\xbe+\xf8\x02\xf0\x97_ d\x8b(\xa9Wv77\xdf\b)\x82_~\x01\xf7\x95\xbffps\xe3\xba.ʣ\x10\xc60\xba\x82 \xf6\x8bb6\x10\x86\x81\x98\xde\aO\xee\x01h\xebE\xc3\xd2s\x00.b\x018]yO:*;=\\y\x88\x8b\xaa\xa2\xf1\x81U\t\xa52F\x1d\bYZDh!\xa3\xa6\x8f\xfd2\xbab'T\xbd(\x14\x85\xb3\x0165a\xf9\xe0\xc9\xe9a\x18]\x11>\xfeC\xfe)\x82<\xcaJu\xe9\xfaο\xf2yꀆ`\xdd\x12\xc17\xe4н+?\x874+\v\x98UFx1\x05\xefȁ\xc3Cx\xbbb\x90l\xd6s\x96\xa3\xb1JyP\xa6\x10\xe6\xfe5\xc2Ҿ1\xb6\xa2\x02\xe6I\b\xcc\xfc`E%T\x95Z\xc3a?\x95\xab(\xb8LXQ\xdc\x03\x90&\xd2Q\rÓ\x10W\xb9b@͇ ʃ\x98\x91\xbe\xcb\x13\x96#\xa5\x04\xff\x8c>\x81\x14?b\x04k캞\x8d\x88\xd3\xd2/\xd9\x14\x14\xbc\x98\x82\xeb\x91t\xb1(XIj.\xe7K\x14\x89ΛB\x80\x1a\xef:*\x98\x03#\xfcL7\xa8j\xab\xd4Z\xe5~\xf1\xc7\xf1x\xfc\x05\x15\xfbc\xbe\x9cC\x9a\xc3\x1f\xf3|\xb9\x9c\xd3O?\xcf\xfd\x1d\xb6\x81\xa0\xb1\xa5E\xc6X(+z\x8d\x04\x17\x90\xa1ܱ MBT\xb1\xb9\x1f\xc5Sx\xc4)\xfe\x1aW$\xcb8\xbdF \xd4\xf7\xfe\x12+/V~\x883s\x99o\x18\xc1\xfdۊ\x95+\x96c\xf7\xe4,AA\xf6\x05\x10Nb\xd7~\x10\xb0\xd8\f\xbe)\x18\xac\xfc<\xbc\xf6q\xd2B8\x96\x13\x83\xb0\x8d84P\x96\xa7\xf0\x85\x10\xc1/*F>{\xf3\x86\x03 \x16\xbf(\xa2e\x82\xbf\xb0\xb7\x04\xec=\x80\x0f?$!\xdbNa¾\xaa\n~\x18E\x98\bV\xc8\x16\xfe&.\t\xc1\xa4R\xb4\xd8me\x9aM\u12c7\xe3?\x89\xeaҬ\x1a%\xd5 \xc1R\x99\x8fS?\t\xe3\xa2\x14%\xb0\xc0\v\xb6({Kܜ\x90\xe8\x97~\xbed%\xcc L\x83͚%\xa5\xbbd\xe5\xf3\x98\xe1\xcfov?\x84V\xd5l\x9b\x17\x10\x9f0\xa3c\x9c7\xfc\xcb\xc2\x11d\xbb\x98gq\x8c\xf6\tΆ4\xfa\x9e\xfc\xe1\xbf\xfe\xbb\xf3\u007f\xe1\x91{5\xf9\xcc'\xc0\xbd\xf3\xff\xe3\xc9\xf1\xf8\xf1\xa4u\xfe\xfb\xf8\xd1\u007f\xcd\xff\xbf\xc7\u007f\x96<(\x11'SչIxtA:\xc7\n\xca4w \xcbӌ\xe5e\xc4\ny\x1e\xa2\x9eZV\a\xa0\x97l\aQb\x80\xc6\xff\xf8\x11\xa0˭\x87\x9f8Ȏ\xf0\xbbY\x9e\x96)Ω\x0e\xa2p\xb4S\x94+?ް\xa9\x82\xf3\xec\x92\xed\xce\x1d\x05\x82%\x9b5\xcb\xfdy̦\xfcH\xa7>3\xa9\xceO\xc4\xe9\x89\xe1\x98T'\x00fJM\xf5\x19\xd6M\x8374\xdf<K\xb3\x9d\x95\x15l\x13\xa6\xf4\xad\x1e\x81F0\x83\x91\xe7@\x82\bk\x10q\b\xec\x88\tk\x06g缒\xebU\x143\xb0\x86\xc3\bN!\xb1y\xbe\x9bm\x8a\x95Z\xc3Ytn\x9f\xa8Gj\x94z\xd2E\xe0\x9b8\n\x98\x89BQ\xfak^\x89l\xbb[ \xbc\x1b\xf8q\xac\x152\xe1\u007f\xe9g(2\xcd\xe4(dI\x19\x95;+lT\x15\x9a\x90\x94\xab\xa8\xb0\x1a\x80\x98f\x84\xcd7\xac\x05\x9b\xf3s\xed&,\xfdNs\xeb\xaa\t\xbf\xcbX\xba\x80+\x98\xcdf0\x90%\x06\xf0\x14\xae`\n\x8d\xa1\xa0\x94\xbb\x12\x82`\xaa+g\xf3(\t\xc5t\xe4@\x91n\xf2\x809\xe2ܺQ\xbf\xa1\x06\x94\x15\x92p\x98\x892\xae\x9fe\xf1Β\x88\xfc|I\x13fQ\x89r\xd5\xf5\"C\xc8\x14<\x95\xb3\xec\x94c\xec!\x9a\x1b\x9bֶA\xdf\x16\xees\xbf\x06x\xf0\x00\xeeG\xc5+\xff\x95\xb55v\xff\x87({A\xb5\xb6;Z\xfa9\x18J\x15Y\x1c\x95%\xcbۅ`V;T4K\xc9}^\xabh\x94*\xdc2\x8f֖\xed\xe6,\x8b\xfd\x80Y\x87\u007f/\x86\x87K\a\x0600R\x9d\xfbɒ]\xe0\xc2\u007f\xc9\xf27\x81\x1f\xb3\x9a\x05\xa4\xbe`\x06\x9e6\x1e\xb7p\x00\x97\xf0'\xf0l\xb8\x84\x83\x19xcm\xf8]\x9aj\xc1\xd5<j\x19\xd3\xf8\x90y\x17\xec\x8a%\xa5%?%\x11\x15(\xcf\xd6\xc5\xe4\x03\xcch\u007f\x9e\xa1E\xeeh\x1a\xe6C\xa5Wb)%M\x85\x12-\xc0\x8a\x11\xf4,:w\xd3ĆX\b\x1a\x0e\xb8\x1e1\x93$6\x1d3*JH\x899\xd5\xf77;Z\x93q\xbb\x8d+\n^\x94\x9a䦉\xe2\x0fa%\xfe\x9a\xd5E\xf5\xe6\xc6Js9R\xb4\x19\xa9\x88\xed@$\xc9\xc4v\xb5\x86\xc2)Ll\xd9\x00\x12\xe6\xd8M\x13\xb5D\xacNL1\xa7\xaav\xe8\xa1D\xa5}\xd5o\xae!\xad1\xe7~\x9dLv\xf6\x8f\v+\xb6m7H\x93\xc0/\xadf\x99\b\x86\xe0\xd9v\xbb\x02Ѷ\x9c\xad\xd3+ƛ\xa7OZ\x82\xe2\x8aI5f\x9a\x1e\x1ax\n\xc1#u\x12\xc5\x15\x97\x04\x93\xa8\xed[:\xfaD\xf7\xdc`|绥i\xd3|\xed\x97\x17Y\u0382\xa8\xc0\x1e\xdd:\x905\x86i\x06#\x1cHO\xc1\x83!\xbc\xf4˕\xbb\x88\xd34\xb7\xe8g\x9c.\xad\xadL\xcf\xd2k\vW\xaf\x9d\x806\x1c\xf2\x8c\x17\xaf\xbc\xb1\r#\xc8\xecF\xd2\x14<\xbb\x87P\x9c\x01\xbe\xe5\xab!\x83\xf6\x1b\xc2`\xd0S\x98\x8e\xdc,ҭͩ\x9e\x12\xdd\xd8/\xca\x1f\x84(\f܁\xed\xc0\x02f\x10\xc1\x93\x19\x8cq\x92!\xa0b3/\xca<J\x96V\x84\xe4ZJq1\x86\aX\xb2l\x1b\a\x11<\x81\xb1\r%\xef\xf7\x166\x18\xcd\xe0\b%s\bG\xb6n&\x94nήX^0\xcbvߥQb\r\x9c\x81\rCXt\xb7\xf6\xa7\x9c-\xa2\xad\x15:\x105U\xa4\xd6W\xf4\xe1\xcf\v\xebK\x18!\xe8\x01\x1c\xe9uK1,P\xdfN\x91\x1b\xf0%<\xadu@hp&\n\xe1\x10.\xabQ\xa0\xce\xcdf\xe8\x03\x05ZZ\x86\xc5n=O\xe3)\x84\xdd\xd3 \xf3\v\x86f\xee:\xb3\x16]Suٲ\x06J8\xe5\xfd9\x86)\x94ع\x1e\t\xf7\x14\x16V\xe5\xf7\xd4Y\x9d\xec\x88;T\xe8\xc1\b\x16\x16\xfe\xbb\x0f\xfaÊ\xf2.\xe8݇p\x00V\t\xa7\xf8\xeb),\xac\t\x1c@\x89\xb29\xa1\x8a\xf1_\x9etk\xed\x95\xf9W6-\xaf\xce\"Y\x1a\xefjs\xfcvj+\xe1+\x1d`\xb7\x12TDI\x8b\x16d$a\t\xd2\xc2*\xe1\x80\u007f\xfc\xf4\x03\x1c\xc2\xc4\xee\xc4ĶY\vSE\xcb\xc4\x01o̹8\x92Z\xde,l\xb4\xb1\xd6MR\xf1>/yO\x13û\xa9AU\x13\x05\x96\xaf\xa8[\xda6\xa9\x1d\x1e͓b\x063p\x8f\x1f\xf6\x82y5\xd3\xfd\xda\"\x02\xc0\xa90\x83C8\x16\\\xe7\x1eo2\x95\xc4F\xb0Җ?}\xec\x00\x0f\x0e\xc1וB\xaf\xb4\x0f\xc1\x97\xe5U\xe6\x8e\xca\n-b%^\x17\x984\xd1\xfa0\xbbU(Ȼ\xaeP=\x1c\xef\x176\xb5\xc3s\x1f\x8f\xbd\x87_\x9e\xec\xad\t\x0e\xe8\xff\x96U\xd0\xecN\x9fHԭ\x14\xa4\x9b$h\x8b\x01\x8eAd\xd6\xc4}\x8c#\xf1\xb1\xfb\xf0\xd1䡨\xa3$es\n\x13C>\xb2\x02\x89\u007f(\xf28!C@0Q\xaa\xcak\x97\x9b\xb8\x93V\xc1\xaf\x8e\xa8h\v\xf2\x91\x01\xf4\xcb\xe3\xa3\xc7\x0f\x8dME\xabᙟ\x04,\xaeL\xd9\xf0\xc8\xe5\x86`Q\xa6\xd9Oy\x9a\xf9K\x9f/\x89N\xf4\xfc,\xa7\xbfr\xb2\xb6;+xC\v%\xcdI\x1b\xcdO\x89ȑcBL\xa2\xd8\xcd\xcc嫫\xe7\b`\x13x\xa1\xdb;\x9d\xb5}+\x8d{\xb1\xff\xa8\xd4*\r\xa8\xda\xf6\x95)\xdc\\\x1cs[\xbd9\xe0\xcc\xeb\u007fY\xf4\xac\x02?\x8b\xceϩ]\x9d\x8b\b\xc1A\xf1\xe9\xa6\v\xd5\xd4.Wч\xda\xcc\xffВ\xe5\n\x90y}\xce\xe9\xc4\xde1\xf2\xd0S\x99\xa80\\\xf5fe\x9e[\xed\xfc\xf2\x1fj\xae,Aش\f\xd9x,\xbf\xcb\xd8y\xbdT\xd1ڠz\xdf.\xa2ďc\x9dX\xb5\x82q\xdb\x01\xfe\xc6d\xea\xaafp{\x0fB\x1c\xc8Zk\xcd\xdd\x1d\xf9q\x06k\xd7w`\xed\xce\xe1܁\xdc\x13I\x01&\x85\x98t\xb9\xe5\xbdW!yE^'\xd1\af\xe5cہ\xcb\x0f\x8d\xfco\xd3\xd2\xcaǈ\vsw\x9d\xa5\xd5\xd4g\xe9z\x1e%\xcc\xca=\a\xb0\xec\xe8\xf2\x83mï\xbf¸\xd6\xf8\xf9\xf8l|\x0e\a\x90{g\xde9\x9c\xe2_\xfe=>\xf3\xce\x15\xb9\xe0`\xb8\xc8<\xa9\x93\xbcF\xd2\xe5\xb6\xf1\xfdA\xfd\xe6\x9c\xc6\x15\xa6\xcb\x0f\x88`\x06\xd6%\xae\x05\xf8\xecP\xfa\xc9\xc4\"\xac\x0e\xaf\x0f\xad\x0e%kD\xb49\x9cT\x1bU\x8e\xc6 \xb6̙ܥ\xa3J(+\xe6\xf5 \xf7\x19r\u007f\x01\xe7\n\b\x99\xa1\x94}\xb9%\xb6j\x99\x97\xec\x1af\x98\xaaQx\xf9\x01!;\xaa\a\xe1@\xd8),؏\xbe\x03\xf3\x86\xb2\xf79\xd7\xe7\xf8g\b>q\x16\xe6g\xdey/\xb2\xba\xdb}\xa3YN\x06\x84\xa1v\xdfV\xeeB\\*\x13<V\u007f8\xab\xedg\"\xa4N\xb8\xb9u\xbb\xa3%{\xd8V\a\xaa:\xa8\x86\xe1\f.EcO\xeeU\xd5T\xa9\u07b9\xbe\xabi\xaa\x87\xfc5\xb2\x14{\x97/u\xf9ZYgjB{\x0f3\x18TT\r\xe0)\xea\x01\xa5\xf4[\x99\x05\xd3F\x8e\xa9\xdaM\xa2\x00\xbc\xe2\xfbfjo\xcea\x06s\\ᢩ4\xf4mZ\n\x1c\x82\x85i\xbe-\x85\xa3\xadf\xb7-\rlmy\x91\x03\x98\xf7\xd8\x0f\x1a=\xcfh\xed\xf2y\xc8!qZ\xfb[K.\xf2\xd6h\xca9*\x99\xbd\v\x82gi\x9c\xe6\xa6=\xb0|9\xb7r\a\x96\xed!!\xa6\xcd\xd7\n\x80\t\xf3\xeb6\x02\xaeb`\x06\xb92\x9a\x970\x83\xa5\xf2M\xbc1\xee\v.\xe7\x17+\xb6m\xed\x17_\xa1=\xf6\b\x9e\xc2`<\x90[\x12\x82#W\xb6[\xa6o\xf8\xca\xdb{T\xa9-\xe4\xd1\xe4\xe1\xc3V~W\xad\xfc\xc2\f_q;\x90/\xe7\x0e\xac\x8aX\x9b\\\xb8\xe5\xb0\xe4\u007f\xe6\xfc\xcf\xdas`=qH\xdcy\x03\xd78\xe1\x1cZg\xfe\xe8\xc3\xf9\xd0\xfe\xbbe\xb9\a\xf6\xdf\xed\xc3\xc8e[\x16\x88\n\x14\r\xb0V&\xf9\xf5\x04f\xb0\xf6\xce&\xe7.m\xd0\xd2\xe6@e\xf3_Gt`\xb2\xf6\xb4\xc9\x01\x02\xbf`0X\x15\xf1`Zͫ\xea\xd4+8\xb8*b\x8b\xda\xf8]\x9c\xfa\xa5\xb5\x9e\xa0\x8ew@OBć\xe0\x8d\xc7͌\x89\xcc0\xddx\xe3\x14\xe4\xcby?\x05(m*\xb3\xc5\x10\x96\x94\x98\xb3\xbc\xee\xacɹm\xa0\xa6y\x1b\x8b\xeb!\x89\x02\xbf\nڽ\x14\x1da\xab\xd4%\xb4\xfd\xc7;\xd3]\x8a\xbfs\xa5\xb3x!uo\x9e\xa7\xb8\xc1\xcaϿ.\xad\xb1\xcd\x0f4\xfe8\xa8;\xa8.'\xd7u\xb3\xe6\xe52\x94,\x1d\x91\xa7\xb4,G\xed\x9c\xd7\xdf\xcb\x16\xf4\xc4Vs\x87\xd5X\x93jH\x87>\xb2\xd5\xdc\xe1L*\xb9j\x1di\xa6\xf8q\a\xc5\xf5\xbe\x97\xe7\xc0\x91m\xa4\xb3\x869r\xe0\xa1m\xa4\xae\x86y\xe8\xc0\xe3\xd6\xcek.on\xfd\x90\x94\xa8s\xc4P\x96\x15UYK-k\xaef͕,m6\xcdoQs\xa4\x95\x8a\xb8\xa9\xea\xe8Vd\x94\xc8\t\x1f5N\x8e\x136\xa9\x9de\xf5k.~\xd9\x0e\xac\xfdm\x05\xedo+|\x0e\x848\xf0}\xd4\xe7\xeb(q`E\xb7Nb\xb4\xd10u\x88\xa98\x00'\xca-ʺ;hc\\n\x1a\x858\xc7(\x85\xa6<e\x82\xa8e\x05\xb6\xba\x01\x9f\xe3,\xbd\xf6\xb76\xe0z\xc9Z\xc2\b[x\b!\f\xf1\xeb\x14\xe6\xf0\x14\x1e\xe1|e\x9f\xd4\xf2\xb1\xd4Kᤖ\xcbR\x13\x01G99\x8c`)s\x8ee\xc5+\xb4M\x1f\x8d\xb5\xed\v\xb59+\xba\xbe\xdb\xee\xa9\xf0\x88:B𧳳b\xbf=\xb1\xd5J`\xbb\xfb`\xe5\x82\aK=y)\x92\xe7z\xb2\xd4\x01t\xef\x98gmw\x1f\xa8\x1a\xcb=\xf6&\xc7\x0f\x1f\x1d\xa3ݎ\xab𣇏\x1f>~\xe4\xc1\x01\x0eEp\xbd/Ǹ*\xa7I\x1a\xb9p\x84\xa5.\xfej;\xb0k\"\x9ax\x93G\x8f'_ID\x8f\xbd\x87\xde\xc3\xc9D\"\x1a?\x9ex-<\u007f\xb3\x1d\xf8\xd0\xc43\xf6\xbe:::\xaa\xf0x\xdeW\xdeW\x15\x9a\xaf\x1e\x8e\x8f\xc6\xc7^\x03Ͽ\xeb\x9bB<\xd5\xf2\xbcGp\x00;\x18\x81\xf7ȁ\x87c\xdaXC\x19\xda\xd9\x0eL\xf8'\xe6~\xb0;{\x82\xf3Z\x9f̫Qb\xc3\xe9\f\xdc\xf1\xf1\xf8\x18%7\xc7\x19f\xe2~5\x91Sx\x96^[\x165a\x8c\xb0\x87\xe0\xe1\x0f\a&\xeeq\xff4.\xe6\x88@\x1d\xa8\v\xa9\t\xf8\x9c\x16\xe8\r\x0e\xa4r\f\xa4\xca\x1b\x81'\x94\xf9\x9f\x06r\rB\xdev\xd6\x02\x0e`\xe2\"ES\xf3\xb6\xbd*\xa1F\xb3\xea\xfb[D\xf8\xfb6\x022\x9bpT\xac\xd4ER\xbd]B\xdftP\xd7A\x11\x19{\r\xa4\x15ȕ\xb5\xd2'\xac\x15<\x81\xa3Gc\x1cݣ\x19\xfeR\x86\xfe\nN\x81r\x86<G+v\nXJ4w\xed\xa1\x0eY\x93\xee\xa1=\xb9\x15\x1cB\xbb\x84\xf7\xa5Rd\xd2̝\x1c\xf7 \xb4&\xc7c\x18\xc1\xca\xd6\x10WЪ\x06\xa9\x1b\xab\xb5V\xb5\xb1y\xffR\xf6\x01\xc9g\xeb\x0e<Y{<\x95:\x03\xfeT\xb3\xa0\x937\xd4M\x98!N*\nxR\x1dT\x88\ue2c5\n\x97 \xb1\x02\"\x8eB\xc98\x8ci\xc4\xe0h\x89\xb1\xf5Ȏ\xc2&\xf8!\x140\xa2\xd4B\xb1Cq\xd8\xc7ȭIs|\xa38 '`\b\xdedl;\x9c-\xfc\x0f\n\xffdl\x1eԫ\x80d3\xe8\x16n\x05\xc0(\xdcm\x04F\xe1\x0e`\x06\xc1^\xc2\x1dĤ\xad\xccT\tU\x16;\xf5I\x03M>\xf565\x89\xdf\x01\x96\xadv\xb4I\x00\x02#\xf9\x02\x99߹fz\xa1\x00\x98\x10\xbch#\xd0\xdb'\xbeq\xd9\xe8\xef\xb3fB\xfd\x8d\xbd\xd9@\x8a\xf2\x8aS\x8c\x85\xb2\x81\v\xa3C\xf0P\x8d\xe3\xfc\xb5\xa3\x9d\xfdCT\xe9|\xfe@->G\x03c,dV\xccr\x88\x1bU\xf8Vl\xb9\xd0\xdc\xc5!v:\xc4N\x81\xf8\x1b\x87\xf8\xa0C|P \xfe\xdd$\x8eb\x12ßG\xee\x04g\x85c\x94_\x9cp<\xf7\xe1\xd1c\xef\xe8ˇb>r\x8f\xbf\xfa\xf2ᑇ\x93\xee\a\xbe@\x90%G\xeeW\x8f\xbe\x9a<zD\x05\x87\xe0\xb9_>~4\xf6\xc6_RA\x9cJ\x8e\xbd\x87\x0f\x1f\xb5\xcb\xe1\xd4\xf2\xe8\xf8\xe8XT\xe8N\xc6\xc7\xe3\xc9ïD1\x9cy\x1eO&\x0f'T\xb0K0hp\x98\x85C\x8c\x1ce+k\xee\xe0\xda\xfd\xb0\x12\xc4\x03\x14DG\xd9;\xf2\xe1\x00|\x18\u009c\xe6\xea\xae\xe1\xa4\xf4P\xe3\f\xfd\t6\xe2ї_\x1d\x8d\x8f\x8e\xe1)l\xa9e\xf8\xff)\x9fď\xb1\xbf\xbfB\x12\x1e\xbb\x8f\xbf|<>zl\xc2/\xcd\n#\xfe\xf1\xf8\xcb/\x1f>\x92\xd3#\xce\xd7[\x87v9\x8el:\xb3\xe0hEW\xf0\xfa\xba\xea \x1b\xdct\xaaǕ\xf2\xe4!\x1d~\xe4\xdcb@\x13\x06\xb5#Y\vh\xe6L\xb9m\xa0\x1eV\xe5\x8e8\xc19\xb6\xb1?ф02\xb0`1\xf7\x85\xb7ȫ\xa0\xa8\xcfG\x84\xeb\xdef\xce\xfd/y\xb6\xa3\x95\xf9Iz\xec\xe9\xe6\x04\a\xed\xadM\xfcJsK\xfe\xb8\xddE\xad\x96%^\xa6*ꐊ\xe8\xdb\x03j\xd7\xfbu\x1c\u007fB\xd5j\xe9\xbb\xd4\xee\x97e.\xbcb4\xf7\x8d:\xecHY\xe6\xaf6q\xac\xd4ͷ\x94\xc8+\xe7\xeb\xb2̣\xf9\xa6\xd4\xdcsn\xcc\x18^\xbd\xb9\x05ǫ7|\xc5O\x17K\xc5j?N\x03?\xee\xc6\xfb,M\x8a\xd2\xd7\x1c¸%\xc6J\x9d4ټ[1\xb5\xa9Tqu\x92x+\xfe\xef\xcc\x1e\x8e\xdb\xcaǥ\xdf\xe7\f\x8d\x99\xad\xf4\x05\xb4\xfb\xba\x80\x1b\x86]\\\xd8\xdeN\xa1Ɓߘƞ.\ue83b\x87\xe3z[\xaa\x8d%7)\xdc\xf7\x1b?\x8e\x16;U*\xe5\xce%w)\x15;FO\x15\x84\xf0T\x11U\x98V\x1f0\xad\xdccEن\x8bl\vE\xcdI\x81F&\xc0\xb4\r\\\v\x9e\x00\x96\t\xfd\x03\x97t \v_\x9b7\xfb\xd95\xbcf\xcb\xe7\xdb\xcc\x1aXO\xa7\xff\xeb\u05ff\xff\xbd\x18\xda\x03\x18\"{rF\xd1\aD\xb9! \x04\xe6\xff\xfa\xdf\xec\x81\x03\x83\xe5\xc0ޫ\xe6^\xad!`\fóv\x01\xef\xf2\xc7D\xbcgѹ\x10\xb3\x9ea%*\xe9\x18Y\xd2\vt_\xe9\xed'\xc3(kԗ\xc2Ӗo\x10\x93\x9f\xad\xed\xae\xfd\xcc2\xf1\xecU-\x8eHb\"q\xa8\xe7\xdd\r\x8fl\xb3\xc85\x9a\x0e\xd3&\xc7\xf7\xea\xc2\xd6Y\x11\x8f\xca\xc4\xcd\xc4NQ3\x1f\x93$iؔ\x06\xae\x11p݀\x99.\xa1y\x11\x15\xa5\xad\x8fŧ\x10\xb8~\x18\nB\xa6\x10\x18=>\x914\x1d\xd5+\x92\xbf\x00\xed\xf0\xc0\x9d\xfb\x05\xfbُ\xe5V\xb0\x03\xc1\x15\xa6\xcf\t\xbb̜\xca\x05\f\xa7\xacA,\xb6\xa9vT\xac7\xbc\x94\xb0lnɊ\xd2\n\xael=\xf6\x15ե\xfac\aW8\xae\x00\a\x9c\u0588\x8a's[!k\x06\xc1\x95P\u007fz\xebxN{K\xbd^\xfb\aW*%\x06:*\x0f\xf0\x9cq\xefo[o\xd4\xc7Pr\xb3\x97YS\xeebm\xeau ˣ4\x8f\xca]K[\x10\xac\xc9Ƞ\f!\x0eս\x98nK\x83\xc0;M\x02\xc2U\xb0RC\xd4&\xae\x1b\xf3g\x98\xc2{\x1a\xa8·\x1d\xa4o;\xc8\xee\x98\xea*6\xdf:\xa3iͅ\xa9\xce\xd8\xfe~\xcf\f\xdcmu\xb8\x04j\xf4y\xc8bV\xf26\x9fa\xf1sc_\xc8\xc2\x1d\x1d\xcdK\xca>\xe9\xc5\xf0\xdbvh\x9b\xfa\xba\v+\xa2\xb6{\xf4\x93ʝ[\xbb\xaa\xd9\x16\x98\xb6\x18\xd4\xdfa\xa1_\xfa\xafҐiq \x1b\x1e\xca\x17\x04uq1\xa5`\x93\xfb\f\xffE\x14\x97\xecS\xd6R/\xfd2X\xb1B0\xbf³ߒ*\xcd\xcbg\xe9:\xf3s\x1f\x17tA\xf5S\xf3ll\xba\x9b\xd9P\x03rC\xd2/\x02\x96\x84Q\xb24Oy\xea\xb6B\xbds]\xd7\xebÃ\a໒y\x0e\xcc1a^%\xecט4\xb1\xf8\xfd?yO\x81\xc7\x1f\xdb\xe4\xeda\x95&\xaf\xf9̩\xcb\xf3u\xeeg\x19\xdd\x18n\x8e,\xce\t\x91\xaf9\xb7ն;y\xb1\xbd\x10u\vRD\x89\xea\x87\xfbߔi\xa5c\x14\xebG\xc1\n\xcd_\x87\xa1Bp\x95!P\xebq[)X\x80ѥN\xf5h\xabS\xfd|Y\x9c\x8dϥ\xdd 9\u007f\xd2\xe9\xc5'\x99,\x86>\xb7j\x10\xcb]\\\xeaҮcpa\xf6\xa5!\x13\x9d\xc1q\xab\xbe\x8f\xb2\xa8\xecK~\xe9PY\xd0\xcb\xed\xc804\xf5\x8c\xa6l\xda݄\xf3\xb8\x10\x9e\xca\xee\x15y\x17ʽ\x9d\xe6>\xbb\xb0w\a\x17\x17\xa8t\x86\xc0\xebB\v\x9bnGF\xca͎z\xef]^\xca\xe0wG\xf95\xca\xeaLy\xec@\xa4[\x92\xb2nx\xcae\x02\xa6\x15\x0f\xfa\a\b\xf3\x83U\xb5\x19Ԍ\x8dZ]\xc0}'\xfcC`&v\x83\xaa\xa8\xc3\xef\xe0\x14\xd6'\xf0N\x8d=\\\x15#ت\xccٻs\xcdc\x95R\xab\xdb)د'@ˇ\x13h\x842&\xd7\a\xde\xefT\xe8,:\xb7\x95\x88\xa9$f\x91\x03\xefl\xd3`\xb9\xc3.\x16\xc5㱪\xef\xceͳ\n\xc21\x94\xef\xd8E\xab\xa0:\x9d\xdd\"e\xdf\u0381(t\xa0\x8c\xea\xd5E\xdf\x0e^]\xbcY9\x05I\xb8f,)\xb4\xabs\x0e\xb0ڽ\xb6\xbaX8(J?/q\x19˒p`;\xc0\xfc\x82)\xfe\xa1T\xc3s_ƶ\x15\x92\x10\x850\x83(\xd4Ґp\x14\xdbh\xad\xc3\x12)\xed\x9bz\x94\xac/|z\xef\xde\xf1\x16\xd5\xf7\xf6T},\xaa\xa8\fE\x0e\xaa-\x8a\x84e\xc1s\xea\x8bm\x9c\x8aƱ[-6\x95G\xafh\x8b`N\xd5\x14\xc3\xea\xcd0KJOp\xbf\x8e\x10,\x10\xf5[*<\x99<\v\x11^h\xd8\xf0\xa8\xe7\x8ae\x1f\xe9\xe4N^;qkS\xe4\x1d:B\x93\f\xd2%\\\xe3J\xd9T$\xb1\xbe\xa9٬\xeev\xb2\xc3#\x92\xa8\xbc\x0e+\xc0h\x89\x16v[@-\xdd\xd6Xk\x93\xbaPT\x8c\xb2X\xf1\U000f237f\xba/\x99\x06\x97\xaeO\xe1\xdf\xe1\tDaŊ\xa2L3K[\xab\xaa\xa0\xf5\b\x11\xb3\x10\x17\xbeE\x9a?G\"+\xe2(LAki\xad\xad\xb9+\xfb\x9a8\xcd[C\xd7\xe9\x9a%D-,T.\xf65\x82B\xdf(_7Z\x9e\xbcM\xe1\xe7e\xab\xa2\xe6\x82\xfc~\x19\x05\x97\x15\xcb캻0\xddAuO\x8a\xec\xa4\xedE\xe7\x99\xc2\xc7W]\xa1\xa1\xed\xed\x89\xfb\xb3Y\u007f_\x90\x1e\x84\x19Ht0BK\xcbߑ\xd7Ȇ\a\xccq\xe8\xc6\x06\x8e.\xab\xb4\xf9\x14%\x19\xa8n1i\x1b^\t\x9f\xa6uƋRg\xa3Qr\xae2Og\xc1M\xa3=\xfc\xca_\x13W\xbb)\xa0\x8f\xba\x1f\u0096p\xc9\xdecI\xd8\xdbw&Lc\x1d\xa0\xddO*\xe17\xa6\xe1\x93fV\xbb\xb3\xee\x8fF\xd4_\x14\x88\xa9Z\xed\t\xb3RQ!\x17\xfb\nI5\xb1\xb1P\xdc\x14\xa7\xfe\x94\xb6*}8U\xd7V\xc9UW#5(\x0f¬\xa0\\\xfc\xc7n\xd0\x03\xbf\xfeʁ\x9a\xe93\xad\x8d\\\x0e\xa70V\x03\x88Pc\xa70V\xe2\x86p\xe7\xb8\x0esz8\xacy\xa4\xad\b\xfc\x1d\x9c\xce@\x8a\xeeӦ\x8e\x9a\xd6#\x8er\x04/\x1a\xc3N\x8e\xef\x9b戼\xd5@j\xa8x\xcb\xf46\x03Y\x01\rq\xea6 \xea\xdeҲ\xbem\xf5\x9a\x9e-\xd2\xe5\xa4Д]2\xed\xe5Ph\xd5)\x01h\x80W\xd7\u009a\xd3\x05_W\xf4N\x16m\x925\xa9;1\x82u\b\xa2\x04\x96,\xadǫ*\x94\x8e.1\x9a\xb1{cw\xb1\xa3\x8f\x13\xb5\xfdal\x8dҐ\xaef\xe8-\xb8-\xca\n\x8eTگ\n\x89\xf8\xe6V\x89\x0f\xf7g0\x18\xe0\n_Bw/^([\xdc\u0558\xf3C\xad\x96\u007f\x02\xb741\xbb\xfb\x8a\x87\U00064186\xd1EQ\xb2L\xbf\xfa\xc7\xc7\x1av\xc45\xcc\xe0[\xbfĞ\xb9\xb6l\aJO\xc8)\x95|\xbfar\xdfLL\x11\xa5\xa2\xd3\xe5\x10\x9e\x11\x9e\x11\x94\x9e[\xae\x98\x16BB\x82<\x99a\xae\x98\xa4J\xcf]ě\x02\x8d\xb6\xd2s\xab\x95\x8f\xd4\x00\xd5\xda\xd6\xe3\x00\tۖ\xcdU\xa8:\xe0\x88RBh\xd90BZ\x14W\\\x82{\x02\x93c\xdd\x0e\x8c\x8a\xef\xa2$*\x19\aЌ\x8d f~\xfe6Z\xb3t\xc3/\t\x11\xfe\x92'\xa8{\x1b\x8d,\x98A\xc1\xcaVAd\xbeP\x10-\xc7\xe9\n\x88\xfa\xf4\x8av\xdd͎\xb7&H酪I\xd1\xf8\xf5\xaa\xed\xee\xf0L:\xe3j\xe9(\xc7 ON\f\xd2@\xb1\xedq\xc8\xfc\x90,\x90\x85\xbbN\xf1 3@t\xb5\xca`1]Vh\xe7\xbb\x1f\xc23E\x10\xdc(<WveH\n\xc6\xf0\x14\xca1\x89B-\x144U\xa8\xb45\xe5\xc5\xf0\xe0\x88 \xbe\xf2\n\xc7oGJ.\fk)mP`\x11WJ\xcf\xd6ѷW\xe6r\x044\xb9\xbdN7\x05\xfb)\x8d\x92\xd2\nҤ\xf4#ھӎۊ\xab%̠\xcau\xd3\xeb\x84\xe5o~\xfe\xb3\b\x01\x88\x93w\x95Y\x8bxq\xb5t\xf9+Bo~\xfe3U\xa0o\xf9Q\x10_\x14\xce\x16\x9c\xa5-5%\x8d\x17\xf3\xcd\xf2\xf8x\xfc\xe5\x11\xf9<>x\x00\xd6u\x94\x84\xe9\xb5[\x04y\x1a\xc7\u007fE2\xb4\x94\xbfiÇ7\"<r\x85\x1fL\x15\xcbp\x9e\x86;\x1bWz,\t\xadAq\xb5\x1c\xd8\xfc4\xc3\x1a\xc8؈\xb8X\xf7\xe7E\x1aoJV\xe7\x96i6p`\\}\xc7lQR\u0089f?\x05嚷\xf2l|~6>\xc7\xf5\xf4\x9b g,y\xf6\xf6\xa5\xa5\x8f\xdbF;gp\xdf\nʵ\xbb \x0e\x97kW5m\x91mb\xbdm\f9\xd3B\xa72\x83x\xefn\xe96u\xe6/\xd9_O\x1aY\xbb*\xebo\x9d\"\xab\"\t\xe2\x88%e\a\x1a\x9e\xf9\xb7&\x95R\x008\xe8\xda/\xf3h[]鉶\xb1\xc107JD0\x94\xe6\x92\xf6LR\xe4T\xb5\x9f7\xf5s\u0382R\x93\xe5%+\xbfI7\xb4\x83\xfe\x8c\xc8|\xad\xbctU!\xae\x1a\b#B\xe1b_\xc3H\xc1\xc3\xf3_\xb0E\xe9\xd4-\x96\xd0e\x9a\x19\x80ߦ\x19\x18\xef\x8b&ij\x8c\xcfF\xb7_\x9foK\xba7\xce\x1fEQG)\x1a\xa5\x14\xa4\x13s\xe8\xe6-.\x13\xea\x14\xfeGqV\xd7\xefm\xf2\xf2\xa7\xbc\xd0S8\x03a\xe5\xd2\xf79L)%\xcd\x1c\x01h\xa4\x9c(|\xed'Kf\xd1\xcff\xcc/Ls)\x90\x17o\x06Z\xd9\xcd4\x8b\x9b\xdaZk\x15 \xab\xc3%\x0f!.\x92(`\x825\x0e\xe0\x87f<\x8f\xf9\xaeh\xe4U<Q\x98\xe1\xc0v\\\xf3*B\xf6m=%\xc1\xc3\xc5϶Vo[\x0fNa\xab\xacJC\x1c\t\x11\xe2G<\x91'+ڞ\xa8\x00۱\xa8h\xeb\xc9\n\xb4\xf36ڄ\x8d\x022L\xb11[\x0fFX\x8dROE\xa1\x80\x11\x81\x96\xb6\xb5\xe2\xa9i\x96 \x01\x8bbk뙎`9p?O\xab@\x0emg\xcb\xee\x82<\x0e\u007f\xd5\x1d\xd4{\x0e(f\xa2\x03\x14\xbe\xa7uF\x943B\xd08\"\xe2\xe8\xd49R\xebCQA\xb5\x05\xf8\x04&tyX\x10\x93\xa5\xf1N`\xa8\xa5\xebb\x1e\xf14\a\xb4\x9b\xb9\xa8!\x902\x8e\xa0}i\x97\xa30\\.\xaeNF\xe8\xd1\x1b:\xad0\xf1@+\xa9\xb1\xa4\x9e\xf9\x12\r\x81((\xf14\x10\xe8\x17\xa1\x9bz\x91\x9ajv\x19У\xe8U%8\xf9ֶu\x99A>\xe6Cԉ\x9b\n44I)\x97\xean箅\x95\nY;[\xdbt\xe4\xc5y\xbb\xd4\xe2\xdb}\xf7xk\xf1\xad%\x1f\x87\x1798\xf1^i2\xa4\x92/\x03%y\xe3տ\xfd\t\xa1\x92UM\x02\xcd\xf6\xaeUS\xb0\xeb\x8e\xfa\xdbZ\xd4\xda\xdaj\xcf[\xba \x10.SM\\\xb8?\xa6\x91T\xb2ZN\v4wi\xa4>\xca\xeeN@\xe3\xc2?\x1f**ʻ\x10SF\xc1e\xa1\x92\xb16nu+\xda\xec-\x96\xa8\x86\xf3\xba\v\xe9w\xfc\xd2\xef]1\xf3b\xfd\xe8\xc5\xe4`pO0ς\x8dj^\xe1\xa4x\a\x16\x05i\xb63Wgn\xc6\x1e\xea\xde\x14\xb8E#\xa1o:y\xcd#\xb6R\x92#\xb4c{_\"7@90 \x9a\x06\xf2\a\r\x0f\xfcRh\xc4O\xa2rp+%\xaf\x88˕\xe0\x86[cp=~\x15\xa1\n\x82\x18\xb6\xa3 z\x8d{\x94[\\\xdbT\x87\xbc8\xb5O\x8d\xe3D\xbf\xf2 l\x008\x84\x90_\xbcٶb\xea\xa1\r\xb0\x0f*n+\x980\xf5\xf8\x824$\x99ۀ\xb5 \xab\x9b<\xdc\xf2\x9buX\xb4\xfcI?\\7P\xf2\x99w\x0e#\xf9\x9b۴,3r\xba\x11n\x92\xb0\x1cb\xd5*\xbfm\aX\x8eF\xc4\x1a\x0eyE\a\x84\xb1\xb6\xeb0\xfbt\x06\xae\xf7\xd0\xe6u\xf1\xc0\xb1\xb5?\xa3\x048R\x00\x1e\x1a\xf2\x1f+\xf9\xe2*]\xd5\f\xd9\x00bu\x9dzH\x05l\x8d\xa6\x9a\r3\xb5\x9du\xb2^\b\x86\xa2Rp\x1fj\b&\x88\xa0F+OH)s\x9f\x1e-ڽ\xa9\x8c72\xbd\xea\xd3\xd2=$\xc2ާҖBl\xd5\xcc#\x01X\x03\xc7m\x06\xf9\x18\x99\xa4b\x0f\xc2D\xe0\x8aJf\xe8\x06\x98g\x93\x1b\xfa\xa2O-Hc\xf2\x0e֞2,60\xd3!-e!'~Rd\x8b\bW\x17\n\x18\xd5\xc2\x03-\xd1/\xef\xdc\xde7JLdm\xacmo\x1c\x98\xa6\xf1\xfc\xf1m;\x13n)\xf8W8\xba\\\xeem̏\xe4\xa6\"\xedlpf\\\x9e\xc3i\xbd\xd8m\xae\x8f\xea%\x1e\x8f\xe0k\xd7AT\x9b6\x1a\xaf\xaa\x03\xecF\x0f\xf0\xf6\x0e\x87\xb6\x12\x81i\xc3O}\x8d\x1d\xf7\x8e\xd6\xd8U\xe7\xbdSb\x8fD\xbcX\xbb\x1be\x19\xf1U\x15\xb9\xb9\xadG\xa5\xffPx\xe4Σ\x82op\xf1\x9e\xda:\xb8\xb8\xbdT\x98X\xf7\xffٻsk\x83\xff\xec#\x058~\xe4T\x1a\xa7˶\x8bt\xc7jBH\x0e\x8f\x01\xdcZRd\xb4\xd5\x1f\xa7K\xd4\xe6\xfdK\x8a6j\x9c\x008z\x01\xdeh\xc8o\xb0\xbe\x10\xe89\x06\x8bߛ\xc8\xd2\xeb\xaa+\xe3t\x89V'\xaao~\x17[\xe5W\xa2.6\xe3tY\x99ΆVC\xa3*\xbe\x82AF\xf7-\xe8\xf63\ru̺\xa1\xd8h\xa0c\\\xf9\xefMCˤ\xd6E\xb4k\xf2o\x10a; \x11\x9di~\xa0\xbc\xbc\x8bctW\x1d\x96\xd8M\xf7\xcb\xc88WRȠw\xa6\xa9\x97\xf4ꆶ\"\xaf5\xe8+=\xadR\xad\xb5wĒ\x9c\x88\xb4>\xd7\xcf\xe5\xa9\x1d\xe2-\x88\xf4ڊl\xcd9\x80\x9c\xf8\xc8\x13\x0fN\xe1\xdd\t\xd8\xcas\x1c0\x83\xafN\xe0\x12\x9e\xc0\xf8\x04.G#\xbb\x8d\v\x0e\xe0\xb2\xff\x19sY\x01GO\x1e\u007fZ\r\x1e\xd6pJ\x16\xce%f\xdeVE\u007f\x83\x14W\x05\xac\x84\xdc\x10Ox\x89\xb3\b\a\xc8Fz\x1d6 ߑ\x1f\x1b\"\x96.\x8f\xbc\x10ׇ\xf0\x04\xaeN\xe0\x1d\xb2@)(%\x84\x97\x13\x81\xda\xdb\xfe\x89\xf5\t\b\xc2\xed\xbd`K\x1c\x11eh\x1fG\xad\x85,\xae\n\x02\xc7yK\xa8{\xafR4\v\r\\\x8b\v\x88\x16\x8dK\xaf\x05\x1c\xaa\xc3̲\xa5\xb2\xe2\xb1ʍ\xd2\bO\xc1btό\x8d\xbc\x89j+S\x00s\xccRspX\xa0\x91|\xd2\x11`\xb4+\xe07\x8a\u0082t|\x88\x96\x12\x8eI\x9c)\xe1\xa9h\x97E\x1e\x14<B{;|\xe7\x9dך\xd5TD\x85Pk\xa1\xa24\x86\x04\xddc\xfd\xd8;\xe7e\xad{\xecJ\xec\xfb:\xfc\x86\xbe\xb0\xebŘ\xb40\x8e\x14\x94O*\x94\xa3}q\xd6Ӡ\x03l\x9b\xa5\t\xab\x0f\xdb\xf6\x9d\x9e\xb3\xf4:똟3U\xb0\xb3\xf4\xfa'҈\xa2\x1a\a!\xe6\x06\b\x0f\x0ekb>bb\x9f\xff\x83f\xf6\xb9\xdd7\x1f#;>~2\xdcs\u007fIm\x1a\xca\xf6g\xdagګ\x9an\xa3Bێ4\x9a\x16\xcd\nL{Q\xa6:\xa5\xd8|T\xb7\xcaª&\xad\xa4D\xa7H\xb1\xc6\xfad\x1c\xed;\x15vOi\xef\xe0\x10\xdf\xe6\xf8$\xf5\xa7\x98\xbdR\xfd5\xea\xfe-u\xa0\xe4F\xd7\x05\xa9\xf6\xe8\x95zqT\xedҌ\xb6\xe4S0բ~\xb0\xdb\xd7\x1ci\x1eF\x89\x1f\xeb\xcb\xce|o\xddƗQ\x96E\xb7?ȓ}K\x01\x95\xf9w\x81\xdfr}\xc6-\x9a\xadm\xf3=9\xf8\x93\xb6\x04=\xef\xb8rɲª\xceIYf\xe82\xb1Q\xaf-o\xb9\xae\xa9\x18\x18\x19\xe6T~\xc8Zm\xe6D-\xff\xc2\xfaX\x13\xdbR-\xc8\xe9\xcf7~\x12~\xde\xe3\x15\xc5*\x177\x91\x9b/\x06\xb5_R\xdbV\x8b\xfbm\xd4\xf7\xd0\xd1}\xde;+\xbf\xb0\xb6\x11\xad\xad\xa2s\xdbV\xfb,jtZd\xd6\xc8g\\Z\xdcR\x86 \x17\x12/\x92\xfd\xdf\xf5\xfcG\xf6\x8b\xea\xfd\xcb\tќ\\\xcbi\xb5M]\xdf\x10\x9b\xd6\xd7\x0ft\xf3\xe9\xd6\x19\x88P\x91S\x8d6\x0fm\x1d\xf9F\xf7>֭\x00U)W\x1d\x0e\xb6\xaa\xaf\xc1\x96\u0081\x8bMZ\xab\xe0\x9e\x0f\x04\x89\xb6\x8cU\x99\xb3\x9e\xd3>\x82G\xc3Q\x92\xd5d$\x1fjz\x91S:\xe2\xb5ꡒf\x14\xf1\x92n\x03k\xc3G\xb6\xe0\x10&b\xa0~t\xbfpf\xfe\x86\xbd\x83\x95wt\x8e\x03\xe9\xa6d\xf9O\x9f\xd4U\x1d\xc0G\xb6\x86\x9cB\x1d\x86\xf5ER\xe9.C\x9ba\xa2_\xe1\xb4\xeak\xd9\xf3\x12`\x04\xba\f\x90\xe7\ve\xf5ICS\x02$\xf5C\nB\xa7\xb5\xdd,\x0f\x8dnVK4\xfa\x99\u0096r\x8al\xa1\xd9\xcd\x1b\x82B\x16\x04Fz\xce\xc5(\x93\x1d\xe2A\xbd\xf9\x1bJ\a\x1dR\xfd?#\"ʦ\xcd'I\v?\xe6\xa1;\xd2*\x1a\x18ub\xd1\x0f]\xba\xe4L9\xd2\xe3\xf8\x0fab\u007f\xa2\xa4)8MB\xb7\x97\xd4\xd5R\xf2\x1b+\xa6^{\xb4ak\xb41<\x97\xbb}\xb7۴b\x1fPN\xcdg\xe3\xf3O3\x91;\fG\x93u\xdce\x9e\x9b-\xd2\xf7\x1b?)\xa3\x98\xe9\x98\xf7\xf4a\xba\xac\xef\xe56\xce;\xdeW\a\x11\xfa-\xa8r\x95\xb3b\x95ơ\xbe\x17Z\xd9M\x97p\n\xefm\x05\xec\xec\x92o\x99\xd1Q@\x8b\xd8K8\x84\xf7\x9f\xe0 \xc4}\xd4\xe9iQ\x98\xc1p[\aC\u007f\xe5\xbf:1\x19\xdf\xf5\x81DM\xa3\x03[\xbb\xe5\x9aYg\u007fn\xcf \x11\xf8\xa1wOK<\xa0\x1a*\x17{\xdc\"\xa5\xe79\xea\x98\v\xff\"\x8eER\n\x8aޡ\xd3\xe4\xffG\x0e\xbc\x8e\xf1\xf1\x91N\x1f\x1c\xdb\af\x91ߤ\xd75\xda\xfa\xd7\u007f\xc6\xc70\"\xcdm\xe0r+\xe3ToǶm\xdb\xe6\xf5^{Pӻ@\xea\xb8ŉJ\xfalV3B\x13\xa6}\x12\xd7\x1a\x82\xa4-\xb6\xd5S\xa9\x9f<\x18θ\xe7\xa9\a\x95\n!/\xd4\xe1\xb6z\xd4\x05\xb8;\xeap{\xb6m\xfb\b\xff\xb3\x8b\xf8]\xe5\xb3%Q\x1f)\x9f\u0560\xb9e:\xe8\x17Pá\xad.\x81]\xdd\u007f\xf1\x89\xba\xf0\xe2.\xf6\xc0GUl\xec\xd9}\xabݿW\xbb\xfa\xa1s\xbe\xef\xee\xd2\xfa\x15t\xcdž\x02\xac\xf2۽9\xd4]\xb9\x05`\xbd\xe1-SN\xf4\xfc\xaa;\xaa\x94\x8f\x1fI\xbd\xee\xb0\x12\u007fs\xde\xd2\xe9\xbai\x90\xf7\x1b{Ijx?\x8b\xa3dU\xc3\xfe\xf2\xd3\xect\x93ܨ\\j\x89\xce\xd5\xf2\xc2σ\x1f\x92\x84\xe5\xaf\xfd0\xda\x14\x86\xb7գ:\xb7\aǏ\xb8\x90\xe9đֹ=8\xde\xe0z\xe5\xebd\x89\xd3p\vEQe\xf6`x\x9e\x84]\xe5\x99\xc8\xea*\x8d\xdddey\xfaN\x8f\xefR\x81Q\xbe\x1a\xc9KՓl\xb9\xd6\x1fO\a\x99&Μ\a/\a\x9a\x9f\x91R\x93E\xd7~\nہ\x92%\x05V\xdd:\n\xa6}+\x81O8#erwLz(\xc9\xfdJ$\xb1\xb2\xcfC\a\x16\"\xc09\x11\x9b\xe6\xd6\xd6v`\xb1\xd3\xd3v\x9d\xb1:\xf58;![D\t\v\xeb\xc8I\xfc\xca:Vy\x16\x9d\xb7\xe3Lp\"9\a\xce`\xb8\xd8j%\x11ށ\xe1b\xd7J\x85\xf3\xb6{\x00\xd6/\xf0I\xe5\xa1VUu\xc1I\xab~m\t\xd2\x0e\x1ce@\xdcBV\xc5\xe9Yj\x9b\rO\xeb\x14\xfe\xc4\xf5\xc0\x86\xa9\xf2\xa8\xfbM\xf3-\x15)h\u007f\xad\x1eE\x91)\u007fs@pW\xdel\xdf\xe8\x9ei:\xf8\vqn\xaa\x00\xfc\x0f\xb6\xd3}\xec\\\n\x18\"\x84\nf\xe0>\x16O0D\t\xa3\vpw\x9f\x17+\xe3fk\x98\x0f\x11\xaf\xa6\x81\xa8\xa2\xddGUTݔ\xdf\xed]Q;\x8f04x\xd9j\x02\xaaP\xedWu\xc7\xf5\x80\x8b\x8f\xb8\x1e\xf0?\xd8N\x8bX\xc4\x03\xff\\4\x82\xfeؽ\x1dOt\x8bQ\xd3\x04\xb3:E\xea\x87*#\xcdy\xfc\xa4\v:ujI\x9d\x8d\x82\xb5\x17_jٻ;OD\xd9jG\xa1Buk\x974һ\x94\xfd_ۓ\x84XUt\x95\xf8\x9b\xa1\x84\xd7[\xe2\x85\xf4R \r\xaf\x97\x15:\x87+\x8e\x17\x1d.\xba\x1a\x9egqZ\xb0Ќ\xad\xbb\xd2!\f\xfe}Ї\xfcMɲo\xd8\"\xcdY\x03\xb5<\n\x13{?\x9a\x92t\xe8Yo\x9eD[\xa7\x99_\xae\xe8\x8dӌ>\a\xce\xc0\x81\xec\xcc;\x97K\xb7\xe6̒ё*͍?\x0f\x1c\xb0\x14tѹM\xe71\x83\xef\tǸ\xe1\x1aL%\xa5\xbe\xbd\xada_/J\x96\xff\x83\xda\xf5\xbd\xa1]\x84\xe3g\x81\xe3#\xdb\xf5\xcc\xe7ۄ?fLZ\x0f\xb5\xf1`\x14\xb2js\xfcX\xb8z\x9aEe*)\xf5\xce)\xa2y\x05\xf7=\xcb\xd7Q)\xe5Cx\xadyN\x03\xfd\b<\xe1.\xd1 \xf4-.\f\x92\xb2h\x11\xbbW35\xb1߷\xa1G\xfb5t\xdc\xd1PK5]*X[\xb6\xf8\x96f\x9eI\xf4M\xfeL\xce\xe1\xdc\r\xd2$\xf0˪=g\n\xd3\xcf\xed;\xf2\xa6\xc9\x15\a\x02\xe2\xd6\xe7厬\xf47\xeb\xea&\xe2R`Q\xc3\xca\xca4\xc5\v\x11\xa7'\xbdi\xf7gЄ{\xf0\xe0v\x98!L\x8c\xab,#\u007f\x9a\xb6\xdd\xfb\x8d\x1f6Ո\xa1\x92J\x95\fp\xf4\x8f\x1bZ\xa6\xfeD\xcd\xc7\xc3c\x88\xf2\x04@\x81:\xc6\x0edQ\x1d\xab\x04\xb9\x82\x95פS\r\xc3\x19\f\xfe\xe7\x00\x86`\xa1ʂ\x11\x94\xe2\xf5\xed\t\u007f\xaff\x88J\x8cg{\"\xdb3dg\xbc\xfb\xe5G\xbd\xa7\xa6Ү\xa4\"a\x93\xe6\xa5\xf0&\xa3\x9f\xa8Q\xccJ\xb5\x91\n\xa6\x1a}\x16)\xf8\x87Óf3\x9f\xf1v\x8c9\xa9%\u007fλnߘ+\xb2\x92\xbf2\xae\xb4\x9b\xb3\xa5\x01-\xb8\xa1\xc1v2\xa1rG\xe6\xcd&W\xe5Fc\xc9i\xd8!µ(\x16\xc6\xe65\xb8\xa1fT\xad}\xf3\x9bQ\xdf|;V\x97\"\xba\xb7\xde\xd1\v\x06\x01\x1b\x12-]\xf25$\x9aڹ\xb1F\\\\SwӜ\x13\xf7у\x9djG\x8d\xc4#`Ģ\xd9Gk\xd8C\x9eIP\xf2\xb3\xc8p\x90y\x8d\xd19ч'\x8dA\x83\xf1`\xb6\b~\xd1\xc6M\xb5\x87\xce+\xa9^\x01T\xeb\xa8\xf9]\x89\x94XC\xfbp\x00V6\xe1\"@ro;U\xa2'\x12Q\x04\xceMWp$\xb6>\x8e~\xe3\x17Q\xd10\x98ZKd~̾\x9f\xa2\x94\x83\xc43[[\x91\xcej\xda\xdf\xcf\"\xfa\xbd\x13\xbf\x91\xe5ٖ\x8c0\x1e\x88\x82\xff\xdfR\xcbzd[\x01B\xee\br7v\xaa\xff\x13\x0eP\x8d9,O\xa6\xdcn,\r\xb9&\x0f\xbea\x1f\"4\x1d}\"s\x8b\x88\xed[:82ua\xb6u\x8bU\xb4Pv\x13\xb2\xad\xb0+\xa2ڶ\x05\xc8v-\xb8]\x05\xa7\xdc\xe1؟N1\xbe\x957a4ʕ)\xef\x1fM\xe3]-`B\xaa\x98\xbf}\x82z|\x17A\x95\x02\xd2\xd8Tk\tm%\x90cE\xe4\xc6\xc65\xc1\xd1\x1e\x02bfu\x8b\xb1\x9cY\xf5\nCiзiyl\x99\xd9~\x84\xc4֊w\xffB;\xb9\x139\x1aE\xff\x8a\x92\xffQRe\\dK\xd1蕊5\xcc \x81!\x1c\xab\x02RI\x87I4\x8e;8\b\u007f\x82\xe4\x93ă\xc4\xf1.\xd2!\xb4\xe1]dC\n\xbbY8\xd6{6\xed_K@6I\x18\xb3>\x1b\xa3%\x16\xfa%\xe0\xa4\xf1\x1e\x8a\xb6\nP\xe6\xbc*\x89\xc7o\xaa\x93\x92s>\xf5\xe3\xfc\x15\xee\xb4t\x9a\xfdq\xb6\x93\"\x9a9P\x9a\xf6\xf5g\xfa\xc6~f\x1a\xba\xdc\x1c\x8d\xe0\x10\x12\xc5\x10\xe51\x00\xe4V܁\xb4/u+\xea\x00\xac\xed\x18\xcd>\x8a\xc2`\xabŽfq\xcfX|W\x15\xdf\xf5E\xed\xef2Z\xfaz\x90\xa4\xda\xf0j\xa9\xcf-\xd89o\x90\xcf-\xd69\xa7\xcf?\x9b\xf0\xaf\t\xff:\xe2_G\xe7\xb7\xea\x12M\xfe\xb6\x0eTх\x94M\xa2g{\x0f;ρ;\x0eTϡ\a\xcc\xefPbr\xe7:&w\xae\xe3\xe8\xceu\x1ca\x1d\xfd[}q\x9a1\x8b\xdb\xee\xcdG\xd83O\xb3\x8c\x0f)E1\xa0\xfb\xf0\xf2\xab\xba\xdfF\x8b\x05\xcbY\x12\xb0\xa2{+\xf1\x9di\xe8\xf3Y\x81f\x81\xe6\x8a\xdf\xd3\xd7\x14!\xcc`\xcd\aXW\xbb\fjV\t=\xbc>\x8b\xb0\xb0\x15\xe2\x90\n\xcdhh\x01\xa2\xd7\x1d\xc1\x10\xbcsۦ只\x1c\x05\xbePۣ\\\xf71\xebe\x9a\xa4e\x9a\xb0\xc6b\xacg\r\x16\xf2'\x84\xe9\xb1\xf6\xb5Nr'\xe7k\x05\xf7\xae[\xd9v1\xc9\xcc\x17\xa9\xfd\x9c\x06Sԓ\x18\xfe\xa0\xf1\x9cN\xb7O\xc1c\xa3G\xaa\x12\x15\xccZ\x8b\x92\xaa\x9br\xebz\xb3\xcf\x01\xcf\xe1\x10\x94\xd0\xecs\xb5\xb8\x96C\xafبO$뷹\vx\x02_5\x0eC\xb1\x95p\x00G\xf2\"&=\xb3\\hg\xa3\x82\xe2\x02\x117\xd2e\x13\n\xbd\xb2\x1b\xd3\xe6A\xc7\xe2b\xa62\x1dɑL\xae<\xd9\xdea?\x0e\xc1\xb3Ō\xa6\xe5s\xaf\xb7\x88v\x94\xcfi\xa3\xe3\x10\xacG\xf2!x\"\xfd\x80\xfe\xd4g\xe5͕r\x01\xbf\xfeJ\xef\xd2p`\xfe\xf9\xf1\xcbb)۽g9\x9fq\u007f\xb5kl\xf5j\xaf\xd7~\x18U{Ě-\x8b)\xbd\xc6l\xeeH\xb1\xe8\xb4\xf9\xd5@\xa8\xaa\xed\x90\xcbD\xc5Iϯ\xd2ԣ\x05?\x0f~\\,\nV\x9e\xa88\xb9\x12\xcc\xe5\x13\xd7AZX\xbe\xadCx\x1aD\x11%\x15\xc4M\xbb[\xba\xfdE\x98\xdf\xe7\xf1A\xf9\x9f\xd1\xe3\xc3S6\xfb\x1dx\xa1A\xbf\x16\xbe\xf7\xadB\xe3\xda-_+>\xf8\xf7\xc1\xfen#c\xed\xcb\xdbˋd\xdcp#\x19\x93\x1fI#uG\xa9䑉\x05f3\xd8z\xf0\xd4\xe4\xca\x04-\x97\x82\x1b\x1eu\xa4\xaa\xc0\xa3\n\x10Վ\xa3\xda݆jׁj\x87\xa8\xd0\xfc\xfa\x9c^.c\xa9x\xc8\xc5|\xb1\x1d\x1b|]v\x94\xb5\x1b\xf7;\xbcH\x8c\x9e\xe28\xe3\x99=g\xbc;\xbaΌ\xef\xea;3n8π&3\xfb8Ռ?\xa7W\u0378\xe5V\xb3\xf5ڞ6\"\x82\xee\xce\xfb\x9d\\n\xda\xe3X\x87r\xe0\x05\xcc`\xf0b`\xf2\xceA\xad\xf3\xb1\xde9\x9e\xe6%M\xachzK z\xcd[\x82\xd77\xfe\xb8\n\xc7Z\x85{\xd6\xe5}j\xe3\xf6o\xd7G:\x1fUU\x91\xe8\xec\xeeP\xdf\xc7\xf1q7\xd6*ܳ.\xefS\x1b\xb7\u007f\xbb~\x17\xdf*s\xd5\xff\x0f\xfbVݦG\xa4)\xc0c\x03\xb4\"̾h@s\xcf\x00x\n\x83\x97\x03\x98\xa2\xfe٫\x03~\x17'\xaeVՍt\x93\xfd\x16\xac\xd2<|\x93n\xf2\xc0\xe8/L\x19\xbde\xdf\xfa9vE\xbblI\x19\xbde;]\x9d\xf3^/gN\xf3\xa7\xf99\x13\x8eO\xf0t\x0e#\u007f\x99&~\xfcSm[6\x91\x9cA\xe8n\x1d\b\xe5c\x04}h\xf8\"CA\xd66\xa9\xbb\x1f'\xa60'\xb86\xa8\xcat<\r\xedв\"\xa4\xad\x13\x9f~\xf5.$\xaav4\x96\x11Ns\xd5P=\xb6\xd0\xd5\xccb\xb7\x9e\xa7\xf1\x9b\xe8\x03kƑ\u007ft\xdc_\xe6\xed.k\x95\x19\x04Q\x1e\xc4l\xd0_\xf2\x19\x01YE\xf4A\vHY\x85\x91\xe7\xcb\xf9\xe8\x03\x93\xeb\xfb\x9f~\xd0]\xc9\x06/\xc7t֓\xc3\x10\x06_W\xbf\xea4\x18\x83\xe7x\xc0\xa1F\xfb\x81\xe5\xfd\xae\x84\xfe6*\xfe\xaa>\u007fZ\xdd\xfe\xa8\xd2\\\xbf,skP\xca\xe72\x06\x8e1D\x97l\x04\xc1\xd1\xda\t\xab\xdf\xf2\x18]\x03gl\x0f\xd4筺h\xf9\x9bJ\xcb\uede3\x853c'\xa8ُ\x967\x9by\x18]E!\x93\xc1<\xe8v\x8a\x12\x1a\xb6\xd8̛\xa1\x03Q\xb3\xae\xe1\xc1\x03-\u009c\xee@\xd3\x17\x9bP\x8f\x19d;U\x15\xfaBOE\xce\xd79\x16\x8fa\xc7\xddI\xe8'\xdf\u007f\x19\x0e\xd7\x0e\xbcs\xe0\xea\xf6UT\x15\x1co}\x02\xa3\xd1;\x1e\v\xb0\xfd\xf0\x9fu\x85\xeb\xa1*\xce\xde\b\xde\xc1\x01\x846<\x99\xd51\x87[o\x1e\x8aV\x88w+\xed\xfe\xc7\aoTo\x9d\xd1(\x12A\\O\x80b\xa2\x02\xb1\xb7A\xc5PRqZ\a1։\xef\"\xc1pD!AM\xb21g+\xff*J\xf3\x8b\x0fi\xba\xfe\x96ťoi\xaf\xa8\xb7@\xa2+-jz3S{'h0O\xc3ݠ~ (\x8c\xae\xea'\x80\xae\xa2\"\x9aGqT\xee\x06\x0e\fVQ\x18\xb2\xa4\xfb\x81\xa0\x15\x8b\x96\xabRK\xba\x8e\xc2r\xa5\xa5\xa4W,_\xc4\xe9\xf5\x88P\U0009733a\xaa\xaf\x10\x0e&\xe3\xf18\xdb\x0elz\xc8β\xdd\xcc\xcfYR\xbeJ\xc3֥R\xa6<MN\xef\u007f\x95\xc2\\P\x9f\x1970E<\xaa\xf4\x96\xc27x\xec\xe8\xa4\aT\xbeuL\xaf\u007f[u\xbcy\xaa\x8d\x17\x87Q\u007f%\xf2\xb5\xb1\x00\xf1\x00\x8f\xb6\xa0t\x9a@\xc4\xdc\xeb\x15c1\xf59\xdan#憬\xf4\xa3\x18\x0e\xe0\xa1\xe9\xad\x13\xd9ܦ\b\xc5\xfe.ݔ\x17s:\x96\xfc\xc9/WV\x1c%\x97\xa6\xe7u0]\x98D\x0e0\x8a\x90@I\xdc\xd2q \x0e|\xaeB4\x94/\x98_\x94\xcf\xd2\xf5:M\xbeN\x02V\x94i\xf5\x8a$KB[\xbd\x85\xa4\xbe\xafS\xa9\x06\x9ev\u007f6C\xfc\xca~\xb0 \x8a\xbf \xcb;]\xdb\xdf\x13C\x8b\a\xd1h\xdd/\xee\xf5\xba¶\xb5*\x94n\xcb\x19\xf9-\xf3ggYRߒ\xe7\faI\xa8\x11\xb3\xe7V\xa2\xc61ɦ\x82\xdeiT{\u00979r\xef\x8dj\x92/9\xaa\xf5\x8a\x96\b\x80\xfb\xf2\xb5j٘\n\x11g\x12\xd5#\x1b\"\x1e\x87l\xb0T\xd6\xc4\u007f\x18\xda؍R\x9a\xe1\x12\xe0V\x06\x98DF=o\xa5\xa0-\xb4\xe2\x9aW\xab\x04\xbf\xf6\xbf\xf1q\xf0\x17\x06Q\xac\x19\x8bF\xdc\xfcv\xb0\xb9\xedpl0\x13X\xdd,\xcd,Y\x18f\x02\x89L-V~\xceB\x91Uo6\x89\xce\x10\x98f\xa2\x94\"\xcbj1\xbfV]`\xac\\\xe6\x99H0\b]\x8d\xbc\x87\xef\x8b4\x0fط\xb9\xbf\xe4/\xbfV\xb6K\xe8.\xa2-\v\xe1W\xe1\x96{[qTԭ\xc2\x0f\x84\xb3q_\xe1\x97\xe9\xa6`8\x01\x18\xeb>ޯ\xf8\xa64V~t[鯃`\xb3ސa\xf6~\xe3\x87\x0e\xf8q\xb6\xf2\x1d\bV\xa8ٴC\x8f`\xcb\xf7\x01\x83]}<\x87e\\\x0e['\xd2\xf4K91\xf3\x17\xba\xb9\x95\b\xc1\xa3l\xfa\xe0v\x14\xfd\xac\xec(ia\x05\xb7\xdbJ\x81,\xacy_ \tA\xfdR}\x90&e\x94l\x98\xf6\xee^\a\x1b\x82\x16\x0f\xeaRjs\x873\b\xc4\xef\x1a ت\xe9p\x80?\xb7J\xf6\xae\x9d\xbd\xebs*v3\xfd\t\xc5.\xd6\n\xd2\xe4\xe3|C\x19`\xc8O\xc2tM\x0f\x91ʇ!\x1a\xb0\xbb~؛F\x18\x1b\xe2\f\xd2\xcdYs\xa6`\xa2H}U\x1748U\xc3=\x93\xc2rY=(D\xf4^\u0081ֆ*wg\xc8ݩ\x83\x9dW\x85\xc2\x19l\xe1P\xadY\x95Q\x14\xda`\xd7\xce\xef\x1b\x1c/\xa2\xe4\xf2ۨ(Q}k\xa6\x81\xd0/\x93\xf1>\x18ޔ9\x89\xb5\tC\x9fr(J?\xb84ܲ\xe3\xbc\xe9+d\xb8h\xe7\xeen+\xf4#\xaa\x10\xe1\xf3[k\x12\xbe\x83+\x06vH\x1b·c\xcaC\x96\xcb\xc7\xe3\xd4\x03\xc0Vx\xcc\xfa\x94̾\x15)\xedO\xfc;\xcbS\r\xa5|\x01a$\\E\xe8\x94i|^i\x92\xdd\xd8\xe4<\xf8\x8e{\xd8\xed\xc6g\xef\x14_\x03m7\xb9\x8f\x96\x97\xfe\xf6\a\x94v\xcb\xcfs\u007f\xd7\xf4e\xf1\xaa\xa7.pMA \xd2\x15\xed\x92+;JӬ/%`{\x82\xab\xabH\x1f\xf2\xd6e\x85):'/\x9c'p\xa5\x8e~\xac0R^ UǗa]\xf5\xee\xb6\x16\xbef\xe1&`o6k\xd3\x0e\x1d\xe5Y\x8d\x12o6k\xf9\nj\x1fbD\x999к`\x05\xc3\xceˠ\xa2\xf8**\xcat\x99\xfb\xebo\xa2\xe4M\xb9A\xf5#\x1fȻ\xf2\xe3\r3\xdc\xe84\x94\xfc\x0egFY\xae~\x12\xa0\x8a\xc4\xcdQU\xfb\xb0U \xee\t\xf9Z\xd8{ҧբ98n\x85\xac\xceq\xd1\\?₢kɗ\\`\x84\xc6\xdd!$<\xc0{Kz\xb7\xc2\aqq\xb6%\xa7\x198\x80-\f\xa5\xab\x89\xdc,܇T\xfe\n\x8e\x91}g8L\xd7Q\"s\xe9\xbd\xc1\xb5\xbf\xad\xa0\xfb\xfb\x8a\xe5~\x1e\xacv\"\xe8p:\u007fǂҁ*\xbdR0ի]-\x10\\\a\xa79\xads\x83U\x14\x879\xa3\x17y\xa9zyV\xcf\v\xb9\xa8Xuk\xb6\u0082\n\xb8Ѐ\xa5\x05bڭ2 \xf8!\x89\xa3\x84\xder\xce7\xcd\xf8\xf8V\x03%\xff\xb4\xad\xd0\x18I\x84\xe7\xeeõg\xa2\xbd\x86\xc1'Y\xb1\x0f\x9a\x9f\x91U\x06\x1c\xc4\xc2}\x10\xbcI\xf3\xd2\xe4\xed9\xe7(`\x04\xfe\xfeȨ'hm\xd4\x1e\xa9\xee\x9a\xe5K\xc63\xf5(\xc9|\xb1\xd5\xdaO\x14\xe9\x15;\xe0\xd7_\xe1\xec\xbc\x11a\x992\r~\x0e\xda\xd9=\xad\xe9\xa7bm\xe7\xa8/^\xd0\xd2~\n\x84\xa5\xde\x19k\x85d\xeeU\n\x19\xaa\xbc\x0e.\xfa\x15\x17\xe7\xb7r\x11\xf1\xfc\x90\x14\xac\x81\x89\xccr\x9c\x1a\\\x82\xb8\xa8_\x03WSp\xa1\xc4S\xe7\"5\xcb\x19\xcdMz\xaa\x80\x15Fw\xa0\xc3\xceok%\xdf\x1cP\xa9۟\x86\xdeV\x97,/XP\x16\xad\x96\x93c\xf7\xdcݒ\x1cn\x85C\xf7\xdc\xdd\xd1\xf7\u03810'\xd6\xe4\xa8\x1b\xdd\\\x8f\x8d\x9e\xc3\x01\xfe3B$\a\xf8\xcf\b\x8b\x1f\xe0?O\xc0\x1d\x8f\xbdۚ\x1b\xcd\xe3(Y\xea\xfb\x14\x15\xec\x9c\x02{&\xdaJw\xfb2\xd2\xdez\xa7}\v\xac\x97~\xe4\x0e\x01Tk\x8d\xedK\u007f\xab>\x04\"\xa0\x87\n\xb4_;\x87\xefL\xb8w\n\ue74a{g½Sp\xefj\xdc\xf5r\xe4\xbe%\xd5\x1c\x81ɑG\xe7\xb0\xf7\xad\xe6\x1a\xae\n\x11Y\xefM\x88՞\xe0\x83|\xb0ߑM\x1d\xd5)\xbb&Ȯ\x05\xc2\xdd^\x03\a\"\xdaTGÊ\xd7ĉX\xa4\xf9s?XYz\xa7\xa1\n\x12\xed\xf2\xabe\xa3t\xb2\xe3^##_J\n\xf7~\x10\xa6!\xefP\xe9$GW\x11\xf4\xa3\x84y\x85\xaf\xbe%:w\xb9\x80\xe6u\xc2N\xf5l\xe5H皛,\xa2\x9d\x98\u05f7\x93s\xd3\xf2\x15\xdb\xf5S싡\xe7@\xa0,Wy\x05\x81\xddU\xae\xd6(*\x8c\xaf\x0f\xd0\xe0\x96\xd2\x01\x8eJ\xdd\x0f\xb7\xad\x8e\xb4ǁ\x8e*C\xb7q\xb5\xb7\xb7]\xca:_;\xb5 \xb3\xbb\xe0/\xbc\x8f\x1d \xd7*ρbR_\xf4n\x9c\xaa\xa8\xfa\xee\x04\xde\xd1~\xe7\xfc\x84l\xe8wJ\x16\xe2j\xd2W\xbd\xb2o\xd6P\uf413\xcd\x12P\x91\xa7\x91C\xccʙ\u007f\xa9'\xde\xdc3\xff\xe61X\t\xcfL\x15<\xa5m\x97\n\xeb\xb1\xf3N\xe0\x92\xda\xf6\xae\x916\x83K%\x05Y\xd5n\xe6-\r\xbd47\xd4\xd8\"\xbd\x1d{\xb4\xd0\xc4\xf2\u0083S\xec\xd3_\u007f\xa5\x1e\x9e\xe1\xef\a\x0fpp\xc1)\xeax\xbb{:\xc2n\xb5\x85ω\x19\b9\xa2K1U;\x1ai\xa7c\xedg\xb6\xf6\x1bOrT\x04\x8d\xa4\xd6\xe0\xec>\x81\xbb\xd17\xe1,ҡC>\a\xf0k״\xbfa\xedx\xfaNI\xcfk\x8d\xa3\xbe\xcee\x1a\x80\xa6\xad\xb4\x00\xa7\xa8\x19ԛX\x01\xce+3\xa8\xb7\xad\x82\\\x9dK\x82܁\x80\xa6\xdc\xfa\xa4\x1dq\x1c\x10\xa6!\x15\xc7\xdf;\xfd\xcd!>\xf3 \x93\xf2\xdb\xd5\xf8_\x92X*\xf2\x9e\x19\x1a\x95\xbd6\x05S\x1d\x9aE\xa2\xa4\be\x97\xf4\xef\x15\u05f5k\x98C\x16\xb3\x925+8\xe9ȣa\xd8_\xc7[y\xaaN\xd5p\xe7[\a\xb4\x93\xa9\xca\xf2m\xcc\xc75\xfbh\xf6\xa9\xf6\xd6x\x8a\x92K[9Z\xee\xeeD틃j\v\x816S\xab\xe9\xfe\x97\x8e\x87>$D#\x94us綫\xa1\xb28\xb9\b\xcb\xf6\xd6\"\xd2\xc3,}\xf6S\xed\xc3ye\xff\x05h\xd6\xf4ۋ\xcaS\xa3s:\xd7\x0e\xb7\xe4b\xb6\xb3\x1b\xee6>\x9f\xd4+\xac8l\xa4\x159\x94Vdu\xf8\xe9##\xc3\xea\x8aH8\xa7\xef\xb9\x1aL\x1f\xc9r\x1fҵ\xa39ڡ>]ט \x9e@x=׃I\xbd\xe0A \x14\xbd \x9ccՁM\xb1\xee\xe78@\xf1\xe3\x00\x04B\x82\xb3U\xb4\xea\xf8\x9e\x91\xed3\x84\xaa\rz\x13\x02\x12\x15\x9f,\xc4-7\x91G\x1cD\x06>\xd55\xa3\x8a\xb2nh\x85\xe5\xd6>\r\xe2MQ\xb2\xfco-\x99\x93{\xa6\xe4\xb7ĵ\x8d\x80P|O\x1a\x8b>\xf9\x82=\xa6Vu\xf7\xa9\x0eQ\xfb_\xbbj\xaf\xc4\\l\x83\xa9\xaf3\x98\xeb&\xb5G\xf5K~90ƾ0\x8c\x98~\xa2^\xb0E\xd9:\x18\xedW\x04\r\xaaQ\xb0\x1b\xd5\xf2\xab6\x86\x8a\xaa\x119>'\a\xf2~\xd5(J\xbe\x8e\x96\xab\xfdi\xac\xacv\x03\x99\x96A\xa9\xd8&by\x95\x15\xb5\t\x05\xb0ރ\xe22g\xec\r\xcb\xfcܧ\xfe3\xae\xd0\xe5\xd9/\x8ex\xf1\xfb)x0\xed=\x12Dğ\xab\xab\x94N\x11-t\xa9>\xb7\\\xe5\xcc\x0fo\xa1\xea\xf3\xf7N\xa3\x1b>\x82\xc67\xccσ\x95\x98\xf8\x82t\x8dlߟ\x89\xea\\\xd5I\xa7\xae\xd0)ׁ\xc4\xd1n\xe3\xddv\xcbEP\xc6+\xd3\xf6\x1e\x95Fh\x93\x9al\x8b\x03\xbc\x03\x9e\xa0\x16P\xcdI\xe1}@\x85\xfa\xaf\r\x8a\x0e\xd9C\xc0\xa9\xc7\xd7iѱ\x03\xb5\xa5ݧ\xed\x1e\xc2܅\xa3\x9aOo\xc1\xf1-c\x19\xeb\"#d\x19\xdd>\x9d\xf3_\xb7\xa0\xfa9*\xa2\x92\a&\x14R\xe2\xc7\xf1\xdc\x0f.[\xfb0W\b)\x80\xd0\xec\x8a\xd2M!vn\f2\xd0)Tw\x16+M\xb0d\xc5τ\xa4$\x9b8V\x9c\b\xeb\x9e\xee\x168\x00)f\x8aDi\x8b`j\xa8\xa9B\xfdRR\x83\x94^Q\x83\x8a\xaf\x1d\x1c\xd4<\x8b\x14Nә\xfbm\xa3\x9c\xe2Q45\x11E\xa9\x10^\x06+\x11\r}\xec\xf4(\xa8\xa8\xdd\r\x8e\xda*\xc1\xd2\xd1(\x82'3u\xbc\x19\xf8ɕԉ\n\xe0f9\x8b\xa35Z\xc9D\x9a\x9e\xb9N\xc3V\x0eo\xc1P v\xc5'\n\x0f5\xa7\xca\xe0߷\x1b\xb8H\xd2\xcb\xf4\x8aY҅\x88k\x0f\x87WT\xed\xb4\x8aL\xb4\xb0\xc4O\xb55B\xb1Ԛ\xf8DѦ\xf2\xc9#\xa2\xf4\x10\xf8n`\xb2Y\xcfY\x8e\x03[\xa2\xe3)r\xf7L\xa6\xaaͪc\xfe\x8bN\xa2\xac\x91!\xabb\x92\xc2:\xee\xc6eb7\xe54y\xddî\xcao\xea*ZKnI\x82\x1b\x9a\xe7*Z\x8b\xa9\xa9jQ=\xe3+\x8ee\xf0\xd4\x00\n\xd3\n\xed-\x14\xad\xfd\xec'?|\xb5\x89cM\xe2\x1b\xc7\x11[1Wn\xe5)\xc4N$\xecdB(A\xc2\n&\x94@\xe1\xae\xdb\x0f\xbfE\x8a\x1c\xd0\xfa\xfbar-R\xed5\x8b\xfc\xb3\xa3s\xbe\n\xa96\x8ae\xc6X\x86W\x11T\xd5/X\x89\x90\x11\n\x82\xb0\xc2@\v\x88\x1a\x85\xf21Q|\xb7CzOT\xd9?\xc7\xfe\x0f\xb7u\x98\x05\xe0U\x8f\x9b\x1b\xd5\xe1N/H\x8b\xdcp\xa7\x15\xdc5\v\xb6\xfbB\xed\x06\xad\at\xe6\xf7\xf1=,\xae\xac\x10%:*Y\xee\xc0:Z\xb3\xb7\xbb\xac}\\\x80p\x9b<nOdt@Z\xb2mɳ%\x02e\xb9\x83\x99\xdav\xb1T٘\x813UX\\\xa1\x10\x17\x8c\x83\xda\xe6'E+R\xf8\xbbݯ\xd3k+O\xaf\xdb\x0f\x97\xa4\xd7\xfc\x88\x8d\xa0\xe8|\xd1\xe6\x97L\xabf\xf6\xa1\xe5\a\x92:\xc9ի+\xfcu\a\xb7D#\x81\x83<\x85/\x06_\xc0\x10\xf0\xcb\xcdYF\xeb\xfcÿ\x0f\x0e\x97\x0e|1\x18|aÐ \xa6\x04\xd1\xf4s\xcd\xd9O>\xbf\xf2\x95\xb0kx͖Ϸ\x995\xf8{\xfe\xf7\xe4\xd73\x8a\xe3%)\x86!P\xf2\xf9\xc0\x81\xc1r`;\x151z\xd9/Έ\x1a\xbd\\r\x8e\x05\xaa\xb4g\\\xd3V\xdf\xe4h\x84\x89_\x97\x96|\x1a\xa8\xea\x11\xf5\x10Zg\nҿb~\xc8\xf2ƉsU\xf6uz]P!E\x16\xf2\xf4\x9a\xae0\xeb\x86j\xa4\x1b\x12\x88:\x85\x19\xfcr\xe3h\x8e3\xbc\xba\xc6\xe6\x8d\xc9i&=\xe3\xa0g\xef\xce)\x8a@z}\xf6N\xb3E\xe4qw\xeb\x1e\xb5J\x06\xc7\xc1\xcb\x1b\n\xd7\x0e\xa4\x9a]\xa2\x9f\xabk\xcch2Ӂ\x85!\xee@\x99^2\xfd\xee;\u007f\xb8\x8fDō\xfd\xa2$\xc7\x1e4\x16H\xea\x1a\xf7\xf1\x9e\xff\xf8\x9d\xeec\xc8\xd2Xg/Kc\xa4ď\vfh\xd6\xf3\x1f_\xb4[U;1\xb5\xe8\xd0+#\x8a\x14\x81zg\x93G\xed\xd1q\xbb\x87\xd1.zg\xe8\xc5h8\x84S\xbdi\xed\xbd\xf6f=\x91\xb9\x9eNpr\x95\xa1\x93\a,b<\x11P\x82\xd1\xf6\x1f\n\xb4\xbbfFqO&\xcd#\xa0\x00D\x97\xb5(9i\x9c1\x04\xd4\x1a\xef\xa8\xd9\x1a\xdeu\xaa\x9bG\u007f\x1b'\x9c-\xde\xd8nS\xa97O\x89#\x10Te\xf6\xa9\xfc\xa6-BDI\xb1\x99\x17e\x1e%K\xeb\x1d6\x11G}\xad \aBA~aw\xc9\xdaZ\x915\xb6e\x01\xd7>\xba\xb0\xadMr\xbd>\x1b\x9f\xeb*\x8d\xbaYS\u007f'\xb7\xd3\xec\xc0\x9a\xbb\x8b\x1a)4u\xb9\"\xb1'\xf7n\xc1n\x8c\xe3\xf1\xfc\xc7\x17B\xe9=\xff\xf1;\xf1+\xe7j\xe3\xec\x9co`\x8f\x1d(\x1dlh\xadsۄ\x8c\x1b\xbb\x03\x16\x85\x1d\xe6Z\x853\xe3\xf9\x8f\xdf5\x17\x82~#ڃ([\n\xf8\x17tK\xcdT\x18\xc0\xe7W\nJM\x8a\x95:M\f\xc4\xee[ \xce\xfbt\xb4\xb5\xc05w2\x1cڶ\xc9\x11\x1a\x99\xc0\xeb\xf0[\x8c\xabg\xfd\xa2\xa5x\x17\xad7\x9c\x10\xccd1\x14\x8a\xc9\xf0:\xbd\x16\x06\xc3\xe0\xef\xc9\xc0\xfcl}a<\x1dY\xb2\xf4\xa2\xdce\x8c\xae\x9d\x17\x14z\xc2\xdf\xc4\xc2\x06\xe9\xb8'+\xfc\xb1\x9a4\xf1db:\xe2rW~\xf1\xe3u\xf2S\x9ef,/wҩ\xab$K\xed)\x879S\x12\xcf+\xbcS\x8d\x8a\x9e\x8b\xb0\x99_\xae.\xf8\xd5U\x8b_pn\xdek]\x8b;\xa2\x94\x89\x96\x85\xaf\u007f6r\xf5\xfb\xa7\x138\xf8\xb8\x92C\xbd\xe4\x87A\x17\xe7鰲\xb0ReV\x15\xe7\xc3u\xee[#39\x1fmh\x83\x9e\xa5\x92\x9d\x88\xb5\xbf\xe6\xef\x98_nr\xa6\x11\xd0 \xcd]\xb2t\xcd\xca|\xb77\xb6gi,.\xb3jx\xab\xd0\xe48xRw\xc1\x81ŽO\xf1\xe6\x85_\xc7(7\x1c\xa4\xea\x94\xf9g\xd1y\x8b8\xf3\xa2\xbf.\xf5g\x01\xbf\x1f\x8d\x02{\xf4\x89T\xeeK\xdc\vz\x1c\x84\xb4m\x0fQAʟ\xe3-\xefD\xd5B\\`\xe7\xbbd~\xed\xed\xd1O\xd2\xcbM\\F\x9f\x95.\x89\x84\x0e\x15\x89Y\xc2\xcb{M灲,Y\xc9'\xf0Nw\xa9\xd0[5?{wn\xb8\xf6zK\xeb~J\xe3ݲ_\x0e~\xa3\xa6Ѻ\xfe\xf7k\x1d=\xae\xaf7KC\xa8\xb5떡}7&QC?\x9fh.s\xe6\x97_\xe7\xc1G\x04\xd9P\x8b\u007fD\x9c\r\xb5\xb8\x1a<\xc5j?l\xa9\x84Xk\xac@\xbf\xa9\x0e\x9e\xa3\xc4*\xe9\xdcچ\x03\xb8t\xe0k5'\x84\x11\x94\"\x03M\xa4\xaf\xf1'\x85\xb0\xfd\x86~y|\xf3\x88\x92wU\xf2\xces\xe0\x83H.\xaa\xe4b\xe7\xb5BP\xf0ȑ\xa5\x9fL\xac\x1dEE8\x94-ę\xcbO\nG\x05\xf9\xe0(\x87\xe5[\xe1\x1a\xbf\x83\x03\xd8\xd9\xed\x92p\xde\x0e\x89ů\xc0\x04\xf8O\x81\xff\\b\xd2\xe5N\xbc\x1b\x8bd\a\xf8O\x81\xffP\xe3\xa8)\xa1SyK(!cBqy\xc8\xfc\x1c%͠\xf5%\xb5K\x98\x81WE\x9c$\xc6\n.\xfbAZ\xd4\a\xff\xb8b\xaf<=\x89\x921\xe7\x1c\f\x91l8@\x02հ\x1d\xf2eb\xfc\xaf\xb9\x9f\xa0?\xa1\xa9\x90\xceE\xb3#j\r\xf7D\x1aK\xfa\xa8\x12\x8a\xbc\xc4\xc3\x00\xebL\xb6\x1d(jXl\x98\xf2Jr\xb0Ӱ\xf0\xf8M<|p\x03K\xb5þ\xd3p\xedj\\\x97T\x8b`A\x1d[\xebrW'\x17ur\xa8\xddS\x05C\xbc\xa3N\xde\xf0q\xd7\xcb\x1bO\xe3\x8d\xd7\xcb\x1bO㍧\xf0F\xc3\xc2\xe3M\xdd\xc2\x1b\r\xd7\xceSx\xe3\x11\x13<\xe2\x8dW\xf3\xa6N.\xea\xe4;\xf2Ɯ\xbd\x9fNb-\xb7\ue21f\xa6v\xab0!\x9b\x96o\x8b\x9e\xa8|h\xa3j\xc4Y\xfa\x05쨃\xa0\xf5\x05\xae\x88\xd2MN1\x85\xace\x1e\x85\xcd\xdd\xf01W_c\xed@\t\x17\xea\xfa@Ƣ\x16Ũ6\xdc88#\x8f&8?1\x84\xf9\xdbҎ\x80\xb6!\xb0\xe5\xaeY\xaa\xbf\xe8\xae7$.\xf7\xf4\x1a5\vh8zg\xe2\xf5\xc5j\x13\xc7Ϟ\xfd\x9b\x15y\x0eD\x13\a\xa2#\xa7\xbebF\xa1\x87\x15\xa7\xeb\xd0\x01\xe6\xf0+F|5zu\x16I\xbfg\x9cd\xebХs\xfa\x92y\x02Tn\xf3\a\x1ahh\x02=\x12\x9fL\x03]h\xa0\xf2NȂ_\x9c:\x00+\x80\x11\xf8\xdc\x19\xaaJc<\xed\t\x8c;e!\xe3\xc6\xc3\x0fI\x11\x85\xcc\xcax\x83\x9b\xe1\xb0\xe7\xfc\xc8\xc2'\xb7\x98\x83\xea\xe5 \x9f\xae\xe5\x9db\xbe\xf2}P\xbd7\xe4w\x85\xca\xd6k\x16\x1e\xb6VP\x05w\xd6\xe4\x91F+\u007f\xe8eR\xc5s\xda\x1e\x91\xddF?\x8f\xd1\\\xe3\x91\xf09\xac\x87?'\"\xe2\x93\x03;\x0eK?9,\xfe\xdczG<\xe0\xe1\b\xb6G\x88\x9b\"\x90N\xf0\xd3C\xa4\x94{,rw\x04\xbcC\xe0\x1d~\x12\xf0\x0e\x81q\"\xdc\x11\xf0\xeeX\xe4n\xe8\x99 \xc4p@\x05G\x04p\x805\x92;\x9a\xf8\x9aP\xd5\x1cj\xe2\xe9c\xf7\f\t\x1b\"&\x02\xa4\x96\x89O\xac\xdbx\x0f\xed*\xcd\xd3$\x8d.JV\x14,&5s\xc5\xf22\n\xd00n\x9e\xb2 g\xdfD%\xddm\x90c*\x8e\x8ar\n\xb2\x8c~\xaf誱\xcbn\xb8VD\xbb[S\x88\xd4\vE\xdb)\\a\xcf(I;L\xf2\xceM\xb7\x8b\xdc\"\xcd˺NU\x124\u05c9\x1d\x9c\x92\x0f\xe3S\x18y0\xa5\x84'\"\x81\u007fo\t`[\x03l\t`+\x00j\xa5b;ս\x84\xb2L\xd7\xc8\x11\x1e6TU\xf5\xb4\x97\x16.ً\xa8([\xec:\xab\x1a\x17\xb3E\xf9<\tyy\x99\x98G\xcbU;5J\xa2rj\x8eK++r\x05:\x98\xd5I\x01N\r\xec{?^`\x92\xb0\xc9\a\xf1@\xd9\x11\xab`e\xc5\x1f[^TO\x0e\xca-\xa4=\xf5\xb9\xb1Z@`1\xe1\xc7\u007f6\t\u007f\xa3\xa3\t\xef\xb4\xf1֫+\xa7r\xc2V[\xa3\xb0\x93\x85K\xe6\x00\xea\xb3[$\x96Q9\x02W\xaf\xc7E!\x9bҿj*\x0e\v\x14o\xb5\x1b\xa9\xd7\xdbI\xb9\"B\xba\x84\xd7\xfd_\xb0\\\x95\x80x\xee\xc0J#wň\x93\xb1\x12L}\xc5\xdd\xc5c\xe5v\r\xd0\x17A\xae\x98\x9e\xa8%ݨB\xfa\r\xae\x1a\x95ʳ\xe6\xde\xed\x8a\xf5\xf6a\x986\x8e\xb5\xa8*\x95\xa8\x1bi3\xac\x18\xdc7\xc8\x0f<x\x00\u007ff\xe9\x9a'\xfc\xb8\xb0V̶́նr\xa4qk\xeb\xdbШ\x90\xc5Js\xda|\x14\xccP)\xc4/\xc16\xb5\x8e\x15sQ\x1c\x1a\xa6\xe0\x8d6\x9a;\xab\xaa\b\xac+\xd2\xf8\xbeG\xc1\xd8X\xf05[Fi\xb2GqN;'\x1e\x9er\x05\xef֪\r\xa6\x12\xc8\xcd\t\xe5ي\xb9(\xe8\xe7\xe6\x96~\xbez\x95\xe9*Ͳ\xb4\x88JV\xd1\xd2|q\xb0V\xc2(2\x8a\x02\x9e\xd3\xcd\x19\x85\xbc\x82\xee@5\xa59a\xd7\xcfy\x9f\xfe\xa2\x1d\xd3\xf0\xb6\xe9GR\xf1\x14\n\xcfђ\xf2)\x14\x13\xf5|J\xcdf\x99\x01CS\x1f\xb45\x82v\xeb\xe5D\xa3\x96\xfc;\x8a\ty\x18\x16^\xe5\xb2_L\xc8g\xbf\xf0ܝ\x03>\x01\x858\xa9\x8d\xe1)\xfe\x98\xc2(\xdcb\x06=\x11\xbf\x93\x19;\xca\xd8\xd55\b^\xb8h\x85\"v\xe9\xfd\x8ex\xb9\x9b\xfb\x90\xbcPt\xc7~\xb4\xe9\xd4X*\x14\x90\x89\xaa\xf7\xc3]ßST\xe07\xaf~Ɍ9\bǔpk\xca\x0e\xe0p\xa6e\x19\xce\xd1UT\xc6:|\x10^3jӛu\xec\xcc\xe7p\xfc(\x9eC\x1a\xf4\xb6\xb0U\xd5\xd9&\xf6\x1c`qK\xec\x18\x9a\x88,\xf6\\>\x1d\xb1\t}N\xe8Sg\xe5}F\xaf\xd2\xdegtՋyb\x90\xa0֚\x01\x9bT\x9fvC\x80{\xbc\x06\xea(\xa9\xccs\xd1jd\x13w\x0e#\xfc\x9a\xf3/_\xa7\xa1\xf5TI\xf3\xb8v\x8f\xea\xb6\xfcq\a\x8byn\xa0T9\xe1_\x9eK\x01\x1eB\av\x12\xae\xca\xf19i\xa2\x94/\xe0\x98\x97\xf3\x06H\x06 \x17)i\xa2&\xc5\x0e4\x18ʼ\x9clD6\xc1\xbf\xc4S\xfcA\xdc\xc4\x1f\x0f\x1eP\xcaV\x80l\x1bǾ1\xef8Ut\x18\x11\xd2+\x95\xa2ؤUl\xd2\xc5/1\xf9\x91\x86\x9cq\xee=\x99\x01\xab\xda\xe66|!TxlBL\xfa\x92\x87\xa2\x8e\a$B} \xf9\xe0N=j\xb0\x97\xb6S\xa2\xb2a\xcdcw\xf6\xd8:\x82$m\x16\xc1ɾ9\\\xf8\\\xcc\aK\x99f\x05\xe7\nSzZ\xe7WFF\xbd\x80\xbc\x85Ubj\x91\xac2\xf2\xc1\xeb:V\xbe߇\xac\x83\xa9\xe3.d\xa4\x9cڷYi\xb4\xee\xe8Y5\xd2\xf3\xb2Y;\a\xc2m&Z['o\x1dX\xf8\x85\xf0\x1a\xf6\xe7\xe9\x15S\xb7J\xba\bG\x8dy\nc\x14\x14CΓ\x19\xb4<4$jQ\x19RH\x12:\xa7\t\"\xbb\xf5\xaa\xa8,\x9fq\xd7J\x9ac\xa8.`n\xd0\xf68\x11\x04\xb6]n$\x9e\xfb\xf4\xa3ۋF\xc6\x16G\xa86\x16\xd1\no\xff\xcb\xc7\xf7\xb1H\x13\x11\x9f\xa5\v\xf2G\x10݁\xeaK\nj\xecnO\x8cL\xe0l\xb3\xb0?\x89{\x14~\x81~\xef2T\xba\x88\xf3@$ћE\x13\x01wHYC\x81\x80\xb9\xadK\xbb\xbf\x05\xebn\xfat\x1b6yG\xfa\xcd\r\xa8\xad8\xa5d(\x86\xa5W\xc9\xec.v\xa0\x9c\x98d\xb5\xa4\xed\x91X\x95k\x95\x10Ib\xe9\xc1\x01\xfe\xf3\x04\xd1\x1c\xe0?C,z\x00\xe5Q\x8f\x82j\x8cmx*\xf0M\x1bM\xae\x14\x12KB:}3\xae\x18\xf1\xdfR\x93\x1d2[YvF\xb6)\x9aMQٚ\xbf\x05\x88ɪ\xe5&\xad\x1e\x19BsZ\xc5\xc2\xedŭ\xdc\xd3U\xad[\aʦ\xd6\xe4\xd6\"\xe7wm+r\x15\xa22Y\xf0\xaa>%j\xdbx\x9dV7Ŷ\xfd\x9f\x1b\xb6a=\x9b\x1f\xad\x15\xedJ\xb0ҁ\x94\xa2\x985\xd6d|9\xddb犹\xbb\xa2\xf4s\x91A\x8eש\x16\xa5\xbd\xf1\x8c\xfa\xd8!Rp\xbdZ\x91I{\v\x0e\xd0\xf2\x99/`\xeb\xf3\xcd\xd8\x10\x80\x81/\x12\xb6\xa5\x80o\\3\xc1\x1e\xae\xc8zB\x80\xe2\xe3\xd7_\x15zgj\u0383\a \x14\x83(\xc0\x9b۴4\xc0\xe0\x9bԡI[\x1e\x857\x86\x11A\xad\x15\x11k#\x8aX\xbb2\x89V\xcfj\xb9\xc5ۢ\x93\xb3\x85\xceWlsLѰ\xef\xd3ƃ\b\xe8\xa6\x10W(\xa4ym\xb2\xd8:+w\xd3\xdeǚ\x1a\x84\xc8\x1b\x82t\x96\xd0\u0087|\xa7\x02\x9f\xa1\xb1\x8dpuRLx\xfbg3\xda\xca\x114\xc7\xf2e\xc0\xbe\xe5\x86i\xafa\x1d%\x1d\xdc s)f\xeb6\xb5\xca\xebi]\x06\x1c\x16\xacıa\xc9Q\x1eIp\x8f=Ƕe\xee\a\xe5\xcbN\xf2::\xab\xf1\x1a\xaf\xa6f\xaa\r\xa2(\x89*\x98\xd6.\xc2L$\xb5щe~'\x94\x83\xb9?$\xe5\x9b\xd2\xcf\xeb\x12\xf1<\t\x1d\xc8\xe9\xdfX|\xf0/\xbe\xb3\x90*\xb0\xf3\xb4$\xb3ԁ\x92\xad3z(\xf7\xaa\xceE\x83v}\xcba\xd9}\x85!$\xeb\x96vjV\x13\xa8w\xeb:J,\xdbt\x86&\xdb\xfb\xe0\x01XmԨ\x9c\x04\x04-\x85j\xf4|ET\xe7\x91\xe6R2\x1f<\xa82\xb7z\xc1\xadF02\xac\xb9M\xf8\r\x8f\x01ŋ+VJހ%\xf3\xd3B\fZ䞲\x05\xc3w\xa0\x9a\x90\xd8ɴ}\xc8\xfbɢ\xcei\xd7*{\xb1g\v\x9cu\xee\u007f\xf3\t͊5yP_&\x964T{\x02ݰ\xf4\xb2\x99\xae.\x94\x1e\vY\xdcl\xa0\x96\xafQ\x929\xbc\xd6\xea\xcc7\xab[nt\x8c\x15ݤK\xf4\x1d\xb8\x93\xffVܑP|\xc4\xed\xcd\x1dQS]\xfa\xce\x1c\xe8S\f\x8d\xd3\xe5=Ʃ\x14{\x05\xa8R\x88\xaakrl\x1a\x1f\xcd~\xdek\\\xe4f\xa8\xbcw\xf4\xd4\xdb\xc6Mt%\xbdk`\x1cf\r\x94Wtؐ\x84b\xba\xa83\x88\xffҌ&\xfcb\xddN?\xb9\x19\xad>\xb3\xa1\xc3\xe75|n\x86\xaf\x883\f\x8d\xc6\xc0i\x90\xac\x95l\xe4e8a\x0e\xe2\x81.y\xf3\xb4\xa4\xe3\xc32\xcd\xdc\xc6^&jz\x1c:i\xa9\x8eL\xce\xe82\xd5V\xbf\x9c\xa9X@\xbbzL5\xe6\x03\x93T\x1auX\x99fw\xd6_ٺo\x80v\x8eP\xbdO\x98\x03\xa6\xc5K\xb6>\xd7{Ƥ\xf5>E\xed\xed\xa3\xf7\xba\x15\xdf<-\xcdC\xbe_\xfd\xfc\xf6\xfa\xa7\x83\x90\x96%\xafY\xf1jp\x032Ec\xe38o\x9e\xc3\xd9'\\\a\x99\xce\xd5N \xee\xd6(\xcaEp\xb9\xf6\xac\x86n\xbfS\xe7\xfa\xe2\xfd\xc6\x0f˜\xb1W\xf4\xe8\x8a\xf9.o\xcc\xfcŔ\xae\xf38\xca\xd3\x12\x85\xbaJ\xcc\xf8\xba[?`ﭐ\xe2\x1eX\vy\xa7Y\xba#n'\x0e\xec&\xda\xdb7\v\xcb\b\xa2;\xa9\x15<\x94\x98\aC\xd8N\xf8\xb1\x86\x03\x05\x8f#\x86\x89\xbb*\xb1y\xff\x9e\xdab\n\x8d@>1\x9dd+P5i\xc5\x16+\xb5\x8dؼ\xbd\xb0y\xe7\x1c\x8blh\x17\xb6\xc9^\xd8&\x82\xb6b'\xb0N\xcc؎\xf6\xc2vt.[Xu\u009e\xd2\xc5uQ\xd6}W<S|Iv\xf8)\xfcH\x8crTFkv\xb1)\x83J^\xcbUT\xb8\x17\xe2\x82\xeb\xb7\xe4\x86\xd7x\x84\x10\x9e\x80\aO)\xcf\xfd\xcb\xdbg\x1d\xef\xca\xc1\xb4\xfe\xe8\xf2p\xa2\xca\xf9\xfd\x9f\xaf\xe7\xf3\x9c]EX_\u2bdb\x17w0I\xb9\xc95v\xe0\xa8\x1b!\xbf\xd4\x1c\xd2{\xb08\xd9\xf0\x97ayQ\xa7~>\x9d\xdc1IS)~\xde\x12\xbe\n\x19\xb1\xa6\xe7t\xb0\xa8\xe9Q\x9cF<\x0e\x94\x83w\xf0d\x06\xebje[G\x8d\tT\xf4\xea\x8d\xc1\xe1P\x17$\\l\x1c=Vum&\xdeѭZ\x97\x17g\x1a*\x81\xa6\xf1\xce\xc5\xfd\f\x171\x14\xd92\x13\xfc\xa8\xb9`\xf3\x9d\xd0\x16\x99ڕ\xc4\xfbU\xe3\xd5ۥáɕq\xe4\xdd5\xae\xbc\xd2\xfd\xafy\xb77o?\xa9\x17\xb4\xff\x97\xf5t:\x80!\xc9\x02\xf7\xbb\xa2\x18\xe1\xef7i)\xef\x9a\x0f~\x1d\x88\xb7\xe3\x1c\x18D\x83\xdbd\xeeE\x9a^n2\xbdb\xba\x05\xe9gB\xfeã\x8b\x97~\xa6?\xec\xc6\xeb7\bC\x15\x9ee\xedgn\xc1J\x8e\xf8,:w\xcb\xf4Ez\xcd\xf2g~\xc1p\x99\x1d\xe9nlk?\xeb\x97\xe5\u007fc\xec2\xf4w|\x884:2R.=Q\x89\n\xf0\xb5\xf1~\"m\x02(⤂ӍO\xd1\xdf\xf5`\x8bl\x9d\xdc\x04\x9eB\x04\xc3\x19$\xf5s\n0\x15\xdd\u007fk#\xf6 \u007fo\xc2?7\xc9/Ӥ\\\xed\xc7\xf5u\r\xba\x1f\xf9z\x81\xfd\x9bA\x84\xb8k3&.\xd0\xf4\f.\xb5T\x93;\x14\xbc\x06\x0f\xec}\x99\xb0W\xf3\xef\xd0\xf0ߢɟ\xa3\xb1/\xd2\xc0\x8f\xd9w\x9b8\xeejq\xfd\x86Dk\xaeѕK\xe1\x06n\x99\x8aKg\xb6\x8ai\x1f\x12h\xe2\xfdt\x12\xb6\x9f@\xc2\xdbh\xfd[\x90\xf0\u05cf\"\x01\xbb\xe0o\xcc\xcfo\x13<\x1e|h?ɫ`;D\x8fD\x06\x8e;%\x10\r\xe0a\"n\x86\xdd]\xb6\xfe1͙\xf46G\xe2b\xdb\xccOB\xa2\x90Zx\xf7\xe1\xa3`h]G#w)x\x02\x8f\xbe\x84\xa7\xe0}5\x1e\xc3\x14&\xec6\v\x8e\x14\xcf+j\xe4?\x13\xdbP\x0f%\xdcs\xdf\xfb8A\xf8\xf6\xf6Y\xf0\xf7lP\xf8ib\xfd}\xba\xc9'\xc7\xffL\r\xfa\xfe\xd3\x1a\xf42J6%+\xfe\x99Z\xf4\xf2\xd3Z\xf4\x86\x05i\x12\xfeS\xb5\xe8ͧ\xf6Q\x1cG\xc5?\xaaYG\x9d\xcdz\xf1i\xcd\xfaz\xfdӺ\xab9MR\xfd\xf5Ok\xc5\x04\xea\xa0u\x06\x13\xbba\x195H\xaf\x1d\x92\xe9\x16\bo\a\xad\u007fzg\xe9\x0fi\xa2\xdc[F\xe2> qH\v\x1a\x0e\x98\xcd\x1ff\xc3Y\xffC\x013\xf8 \xfcm\a\xa3\x01La0\x1c8\xf0a\x053\xf8\xdf\xff\xbb\xf6\xab\xfc`\xc3!<\x1ac\x91uuǕ\xa7\xff\t\x1e鯱}(\xf83\xf5\x9c\x9cE\x14\xc7\x13\xeb\xc3\xca6$\xaeo[\b\xfeP\xa4\xaf\xfc2\xba\xe26Ls\xfa\xa2\x8b\x9d\xe9\x0fo~\x94fL'6\xdaH\xbd\xf2c+F\v\n;\x91e\x0e\x88\x00\x89\xcd\xfb\xd59\x1d\x03\xaa\x15\no\x951̀\x10\xf0L\aB\x0ff\xc2\xc1\xc3\nǪ_\x80B\"\x8c\xb0\xe4)\x02\x8fx\xc2SL\x98B\xe8\x19\x03\xaf\xd1\xebfz\xedH.%U\x04\x885p)MA\x9c\xf4ж6R`\xacF\x92MB}i\xaeK\xade(\xda|\xb9w\r\xfc\xc5\xc0r\xec@\xe99\x106\xae\xaa#R\x98\xf1֖(X\x98Phaw\xe8\xb6u\xa9\xbf\xdaR\x1fQc\xf9S(\xbd\xb6#\xc3}\x8b\xf7,\xc1\xa0\x80\x86\xa5ms\xfc\xe2\xb9_\xb9\x836$\bm\v\x9fڏɎ\x16\x88\xaasS\xbcMNOE\x8e\x19}#z\x0f!\xe8f)\xed\n\x9aت\xbe\f^\xa9YE3m\xca@\xf7\xd6ߔ\x81\xde\xcb:Ȧ\fhñ\x1c\xb7\xdc2x\xdfnʠ\xa2\xa2ަZD\x89\x1f\xc7fR\xbe\xf5k\u007f)u7\x8a\xa4\xda]\xc4)\x9d\x17\xd1\u05c9\x92AC\x92B\xcfm\xe4U\x1e\x9e\x81\xe2#\xa4HM\xe6\xc2]\rN\r\x95\x88\x9e\x9a\xd7\x11NkVp\x10\xfe\xbb\xa9;\x88\xed\x04 ڊ\xec\x91\x14W|\xc3DI\xad\x11\x03e*\x18D\v\x8c\xb0\x98\xa7\x80V\xad2\x02\vG5\x85\f\xb5\xa5u\xcfJ\xcf\x1e\xc9\xe3^\x85I\x98\u05ec\\\xa5aWȦ\x96\x06\xf9\\b\x18\xfa\xaa\xaf\x9dܣ#ڸ$^\xda\xee\xc5G\x8ba'+\n\\s[q\x940?wD}\x85#\xe2c\xb6&\x0f\x0e\xbdmE\xb0\xe2\xe5-\xfd\x91.\x02v\xa3\xe4\x8a\xe5Zl\x82vq\x8d\x18\xd2(\x1c\xa1(lmm=2\x17\xc7\x1c\xa6k\x9f\xde\xe52a\xe6\xbeƍ#\x04[\xa7W`\xb0l\xb1\xad\xdb \xa2\x1a\xf4:\xf4\xb69EP\x01\x03}I\xa4ǫX\xb7ڭ\xb6\x03\xeb\xa7\xef\v,giY\xb6\xd3\xeb͵\xae\xaf̚\xf8TF\xc1\xa5\x16\x8er\xad\t4\xf9HmK\xfe\xa4\xbcƄ\xe7\x94\xda E;4(w\x19K\x17\xb0\xa6Xq\x03Y\xc1\xa0\xe9\x10Wd>\xf6\x13\xaf\x85_G\x17\xbf\xd1d\xad\xa2W\x10\xd8!\xac\x1d\x11rA\x1e\xbfkD\xbd)YV\xc8B\x8d\x93\xe2\b\xcd\xca6t\xb3\xf7\x85\x94\xbb;\x94YN\x88\x03\xeb\x06\xae\xfbQSZ\x88\x8f\xd6\xfa6iQ\xee\xec\xc4\xe9\xd2\x12\xad;4\xd0u\x16\x89\xa7!N\xa1\x027A\x9dál\xaf\r\xa3\x91\xf2\xae.Z\xab\xa25\x9ag\xec%\xc5FT\xfd\x1a\xd72Z\xa223\xb4\xa6浥tK=\xbb\xd7\xfd6\x04O1\x92ZR\xf6]+\x06_K\xe4\xb9b1\x94\x0f\xd2l\xd7[Ҥ\xb1\xa8\x14\x0e\x8f\xa6\xe22\x06\xf2\xab\xdev%\x14\x0eH\xad7 \x9e\f\xe4\x8f\xd78\x87\xd1QN\x1d\x14\x84^}\x8d\xfdu\xd6s\xba\xa3\x8e\x19>Z\xb4P\xf2\xa5Oj\x90\xe7\x10\x87\v\xee\x99\"R\xf8\x1fyL0\x82F4\t^\xfe\x94\x17z\ng<A 9\x87)\xa5\xa4\x99#\x00\xcf\xfb\xc9\xfcV\v\xaf\xa4\x1c{\x89\x8c\xfeҼ\x9fE\bŢo\xeal?\xcf$7h\xebv\xf2W\x85E\xba\"\xc6\xc2\xf6\xbc\xbf8\xf3\xce92[\x83\x1c\x8djXY\xf7\xd9X\x80\x9e\xec3\xef\xbda%m\x1djOf\x87\xea!\xf4\x8e<\xb5\xa5-\x1b\xba\x05+\xab=❾\xa6\r\xfb\xeb\xfa\xb3\xa8K[\xbc\xee\xe4\xe2\xb5B\x8a\xeb\xaeqS\x11\xd7t\x8aU\x999W\x89.+\x9f\x0e\xe7{\xa0\xb8F\xa3\xe8\x16|\x956\xbe\xa5{\xff\xf2\xf6\xd9휑\xe7\xf0\x15\x8bT\x1e\xfd\xe5\xed\xb3\x8fd\xd3_\xde>\xeb甊\xda\xc8,\x8dz\x13\xbfT\x80\xbb\xb3\x8c\xe6\x06j|\x92^\xdb \u007f\x99T\x97\xc08\xac\x16J\x1c\t\xfeS[\x91a\x1a\x90\x8d\"\xbcǞ\xc7\f\xbf\xacA\x18]\rl\xb7(w1C\x8eV\x01,\ai\xe6\aQ\xb9\x1b\x10\xdb\aB\x1fA\xe0\x97\xc1\n,\x96\xe7\xf5\xa3\x02\xd4gG\x17\x84\xe3\"\xcb\xd32\xc5\xf9\x1af\xf0\xec͛7\x98\xf8-\vb\xf1ܐ[\xe5;u\x19\xa5^\xce\xc4\x06*\x95\xb0\x13\xb9\xfb\xd5\x03\xa32)\xf1\xd7\xf2\xa5t\a\xb2<J\xf3\xa8\xdci\xc1 [4\xb8\x81\x1f\xc7\xc2\xe3C)\x0eC\x18\f\x14\x1c\x8d\xa1\x1f\x1eUWc\xaeX^\xd0\xc5\xf2\xc1\xc4\xf5\xc6\xee\xd1@v\x87\xe0\x14\xbdh\xcf[J?\xdf\xc4\x11\x8f\x86\xa7t\x97Ȳ\xaa~\x93?D\xcf\xf1\xc7@^ѳ\xd28\xdf&iH1\xf2\xf5n\xb2\x1b\xe8\x94J\x9f\xa5\xd9NҮ\xd2\xf5f3\x0fb\xbf\xe0\x9b\b\xee\x05\xe7\xf0\xc5\x05<\xadyJp\xc8\t\xc1\xfb\xea9\x0ez\u07ff.2\xabA8Y\xd3\xdbqT\x1722ٙQ\xa2BQ\xb93\x99y\xae\xd6Q\xa7V\xf2O\xef\xa5e\xaa<\xe8\xb1qM\xfe\x10':\x1d\x97\x8cH\x90\xe5\xa4\xf7\xc3%\xdb9\"\xf1\xec\x92\xed\xce\xcdN\x0f\x9c\x86\vb\xa8%\xdd-x\xd5+\xbfP\xd8q\xc9v&Kd\xedg\x17Y\xce\x16\xd1\x16\x86\x92\x12\x14L\xf9\xa0\x1a\xfd\xa1\xe7\xba{1a\x913\x03\xbas\rO\xd1\xc4#\x04\u007f\u007ft0\x83+%$\xb0#X\xb2N\xafX\x17\x85ب\x99\xa9\xa9\x8d)Wi<=\xef\xc0\x9f\x97$JZ\r\xb9d\xbb\xc2x1E\xf4\xa7\xbeAF.a\xf2\xc5M3\x91\x1c%ߍ\u008c\xe6c\x12*\x95z\xdf\x10;\xba\x89\xe1\xd9\xfb\x91\xd3\xea\r\x89\x9d\xd3\xc53;)\xe3\xa0\x1am,\xa1й\x9dĉ\xfc\x8f\xa6N\x94\xe7\xe4\xa9ۋ\x97l7\xc5\u007f\xb4\xd8:Xz\xca\xff\xd4\xfb\x85\xdd\xed\x11ȵ\x06\t\xba\x94\x06-\f\xd1^\x15Ij>\x88p\xc9v\x8d0\xef\xb3YC8\x9f\xa5a\xe3Z\xf1B\x9d/\x10C}J\x81\xab\x98JD\xed\xbe\xb7\xcdx\xfb\x84\x1eV\x86\xc2\f\x06\u007f\x1f\x0f\x9c6\r\xcdA\xd3~q#<r\x89\x0f\xb4\xb5\x16\x1e]\x88\x0f\x91ŗ(\xda\xeb\x11\xb4\xf4s\x80G\x19lF&\x14\xeeaͭ\x0e\xb9$2\xbb\x8aq\x94g\x1cD-}\x16\xd1#\x1a\xe1хX*\xe9\x95˿\xa2dC\xb9*\xe1_Ec\xfc\"`I\x18%K\xb5=\xa6\x17\xd7\xe0\x14\xe6UD0xB\x1f\xe2\xf7\x8c>\xc60\x85W\xfe+\x15y\xc8\xf6\xc4>\x87S\xf0%\xf69<\xa1\x0f\xf1{F\x1f\x06\xeck\xe6k{Kb^\\4\x0f\xc6\xf8\xdc*\x99\xees\xd7\xcdq\xed\xbb\xf7\xae>\xf6\xa3\xd0,M\xaf\xd6F\xc0\x81fO\x89\xa8\xe6b\xcbߗ\x15\x9eE\xe7\xb6\r\xf42\x95\xe5\xc3\b\xd6h\xab\x0e\x87\xef\x8c\x0f\xa0ގS\f\x15\xd1FY\x83\x03\x91\xddW\x8b\xe6i\tOa\rS\xd8$![D\t\vu^\x86Q?7\x8d\xac\xa1\xa3\x11i\x1eq>S\xdc;\xf9藖\xb3\x88\xe2\x92\xe5u\xbbt\xd1T{\x89\xde\xect\xdfo\xfc\xa4\x8cb\xc6I\xe1\xd1\xedT\x89\xb5\x1dp\x1fڝ-\x8an\x15\x0e\xd5y\xb3%$\xf3\x8f\x95\b\xbaR\xa8\xcbAu\x96\xfa\xeb\xaf\xe0\xc3\xfd\x19\xf8\xb6MQ/5ʻ\x04\xc1\x9ak\xb8\xee\v\\\x0f\x1e\xf0q\xc81\xcd\xf7\x90\xab\x9a\xb2\x1ei\xfa\rh\xed\xc3\xdeO\xbd&\x10\xba)\xba\xfd\xff}o\x92\xde\xfb\x97\xedM\x03\xf5\x9d\xbdYmf\u007fB\x87:\xf2u\xfcO\xe9\xd3`\xaf~\r>\xado\x9b\xa6R[\xeek\x0f\xffS\xcc\tԜ\x9b\xbbHB\xf0\xcf \r\xbfI{\xf5p\xae\xbe\x03\x01hk\xd3\xdcOB%\x9c]\x92\xe6k_\r\xcc\xf0\x9f\xff\xe1\xc0\xff\xfd?\xbaUn\xb2\xc2Գ\x92\x04Nab\xc3\xff\xfd?j\x98\x1b\x99\xe1\xd9\xf0\x9f\xff\xa1\xc6\tjn\xa66\x8fT\xf8\x8b\xf4yg\xe0ǭ\xf4c\xe1M\xb1l8\x80\x89\x1e\x91\x19\xea\x97\xdc\xfb\x80r\x8a\xe5\xacĭ7\x84\x92\xbcO\x017r\x9a\xb1[\x87\x98\xff\xf9\x1f0\xc4f\x1f\x10\x9a:\xcc\t=\x13S\x1d|\xe4h[\xd47\x14o\xb4\x05D\x9c._\xfd\xfe}@q\xc0\xa4$TR\xe1ri\xb0\xec}\xbaJ\x8d\xed¶\x99U\xf3B\xb2\xbc\xa3\xc5Q~\x1d%\xdf\xfb\xb1\xda\xe2\xf6\x01\xa2\xb1\xcejAUpK\x94[\xa0\xdaS\x19\x05\x9atZϷz\xad\x80C\xe5`Q\xb9$&\x86H\xb1Yߦb\v\xe5!\x8b\xa6\x86U\x9f\xb6\xfeX\xbb\xf8~T\xbc\xf2_\x91j\x1a*V15\xce\xdf\xdb\x12V\xb1\xf4\xda\xc1\x1a^\xedhFU\x1dҮT\x99\xc3\xd7\xf8J`5\xe4\xce\xf70\x03\x91\xa3\x1c\x86\xe0\x10\xcc\xf8\x1bj+9:\xc9\r\xc3\xfa\xdev\xe8\xd67/rƏ\x88\x1c\xba\xab\xfc=\x8c`\xa5ٻ\f\x9e\xc2\x15\f\x81\xc1\x81\xac\xe4lu\x0e#\xb8B\x93\xf6J%\xb8\xcc\xfd\xa4\xc8R\xfd\xf9ǵ_\xe6Ѷ\xe5w\xef~\x882q\xf1.<r@@\xa9\xd8>D\x99i+\\8.\x19\x86\xa7]\x1d\xb3\xca\xcd\f-\xf4\x8b|\x0e\x92\xdbܵ\x98\xd0\xca\xfbC\x94\xbd\xe0H\x1c\xf8\x10e\x85\xd8.\xfc\x9a6h\xd76E\x82\xe1Ro\xd8n\x90\x8fM&T\x16f\x84\x81b\xc4(H\x12B\xf2\x8e?\xbe\xa2\x0e\xb2\x0fQv\xf6\xee\\[:\xbf;W\x0e\xaf\f\x13\r\xe2WY\xa5\\!o\uf2f4\xee\xefj!j}\a'\x808u`\xd5z_\xb35\x92N\xe1Ȇ8գ\xd0u@\x1e۰\x8aԇg\x9a\x9e`q\n\xa7\x8dJ\xc5nm\x14\x92\x8b\x11\f\x11Ó'O\xf4Y\x84ހ\x13\xa3\xcb\x01\xffl\x1d\x85\xe7\x0e\x16\xb2\xe1\x14\xb6\x82>\xc41\x04\xef\x84\x0fZ\"d\x1d\x85=\xc1y\xe2\xf4\xd60\xc0\xff\x9a\xac\xda\xc2)\x98\xd9e\xd7|\xe1l\xd28\xb7\x17\xab\x94\xf3\x11esK\x11GE8\xeb\xbd\xc4\xd6\xfd\x8a\x93j{\xac\x82\u007f\xc1\x16\u008bD\x96\xa7\x9b\xf1\x1a\x8c\x8a\xff5vV\xa3\x00u\xa0\xdc'\x8b\xf2b/K\xde<\xcdT\xf6\xf2\xf8|\xafEڂ\xd3V\xed\x01\x987\xcfT\x17\xa3Ƅ\xe1\x80n\xa3?ѣ\xfe\xf9\xb7\x98\xa2\xda2&\xf6\xff\xa5\x9b~:\xfb\x84\xb6'\xac0\xba\x91Tg\xc6k?\x935\x87,S\x9f\x8d\xa5\xcd-L\x82'3~:\xd0\xf0\xfe\xc9\xd38\xded\xf0T\xfc\xe0\xad\xc0\nń\x8f\x13d\x91\xe6\xfc\r\xc5\x02\x9e\x82\xb2AT\xa7\xf3\x8b湿;i\xf87\x98ה\xfc4\x05\xc99#\xe2\x86\xc3sJ\xfc\x99\x9f\u007f\xf2\xf3*\xb1U_|\xb3\xfb\x1f\x04\xae^\x05\x96&\x04\u007f*\xb9k!\xa5\xab\xb7\xea\x10CAK^\xfb\xb2bN\x928zӶ\x16\x9bJ\xab>\xce\x10\xe7m'\xfd!)\xeb\xfa\n\xa5>\a\xce\xe4c\x97ݻ\xeezqӑ\x86\xc0u%:\xa2\xae9=\x93\xb983\xa3\x8cH\xb6q!\xe9<\xb4H\x8d\xfe\xc8\xe2(\xc3Zc\a\xdcU\xcc\xd6~\xa6\x8a\x86/\x1evE\t\xe2\xbd+~5\x04\xe2D5Sĉ\xc8\xda\xd7B\xa0\xf8w8\xb8)\xa6j+\xe8\xb8C6\xc5t\x98\xa34N\x90g\x83\xbf\xdf\xcb\x1f\x02\xde\xf2]:t\x9a\xbb\xbd\xe7q~\xf3\x9d31\xe2\u007f\xb9q\xea\x83\xc0\x8a[\xea\xe7ϢG\xf9\xe8\xe5h\xb0p\xf3<Y\x8c\xe5ƂI\xd1\x1bc\xddI\x8cp\xd4'k\xb7\xe0Q\x98Z\xe33\xe3\xe4C\xbf=\x8d\xaag\x97a\x93?X\xb2\x8dJaG}n\x9e\x87L\x89>^I\x95\"\x93\xb4R\x80\x19\x10\xe8\xde\x15\xfd,\xb5G_U\x15\xd0\xfe\xb8\x85\xfe\x9d\x19\x0f\x03\xeb\xcc\xdbp5\xd2\xe5\xccq\xd9`\x8f2v\f\x87\xccͳG\x826\x1d)7\x8e\x91emW-\x1e5\xeak\x9d#\x1bkT\xf5\xab\x1c\xa6z\xc5\xf5)q\xb5\xcb\xd9\x16\xd5F\xdd\xedcbc\xe5\xc6\xd3\xe0\xa6B\x11\xa7\xc0\x92\xb8{\xea\xa0n\x1f\xfcJ\x1a3\x96\xaf7%3X1\xf4\x1e\x91\x1e\xfeB\x00˱\xaeΥ\x02\xba7\xfa\x85,\xceWpb&\xe3\xe5\u03a2sݗR\xc2\xeagT\xf9\xb2Miӹ\x91V\x85\xb5\xaf\x94\x1b\xa4I\xe0\x97bI\x8cd\x8bR*\xe6\"\x8b\xa3[-9^N\xb4]\x91\x9a\xcaE\xaa˶\xe81\xedh\xafk!<\xb7\x90\x88R\x0e\xd1=\xec:9s^\tCAߌm\xba:h\xbe\b-\x8b\x80V\xff\xdaV\x878\xd7S]$\n5\xba]\xb7\xff\x84\xc1r$L\x8d\xed[\xbd/\x15_Y~I\xa9\xf7ܑV\x81ꍱ\xe6n\xa1\x99\xd3u{\x85c/\xd5z\xa2\xa4r\x0f౩-\xb4\x05N\xe5F\x1cІCQ\xf7l\x06?$\x8b(!\xff\xb8r\x95\xa7\xd7d\x19>\xcf\xf34\xb7\x06\x11\xcfb\xfc\"\xcc@\t\xba*\xb9\x80Bt)\x0e\xf5\xe9\xb2L\x94\x94l\xc9\xf27\xe4H]]v$\xb6\xd8\xca\xc1\xb5\xf0\xce&\xaa\x0ffp)|\x8d\xe5O\xc6\u007f\xd6\xf2GI\x14\xddG\xbeE\xff\xff\xb1\xf7\xb6[n\xe3H\xa2\xe0\xef\xed\xa7\x80\xd5w\xea\x90NJI\xe6w*\x9d\xe5S]]=];Uյ嚾ݓV\xfbP$$\xd1I\x912IeJn{\x9el\x9fa\x9fi\x0f\"\xf0M\x90\x92\xd2\xf6\xd4ܻ;Փ\x16\x81@ \x10\b\x04\x02\x01 \xf0V0\x81\x1c\xf1\x02\x18\x9b\xf7k\xc0\xe4#\x85\xd8\xcbo\xc91\xb9\xf7\xf9\x92vW\xf9\x17\x9d\xe5\u007fg\xdf\xe22\xc4\x02\xa3\xf6\x18\x82a\x8d\xefzT\xd1U\x1e'ԃ#\x10\x00\xff\xa6\xa2\x01\x19\xbc~\xfd?\xbe\x1a\xf8\xf6\xa2Y\x81\x90[r|\xf7\xfa\xf5\xeb\u007f\xbc\xfe\x1f\xaf\x9f\xbf>z\xfd\xf2\xf5\x87\xd7w\xaf'\xaf\xbd\xd7\xfe\xeb\xd1\xeb\u007f\xbe\xfe89\x9e\v:\xf8\r*uo%P\x83P\xbb܋\x0eZ\xb8\xaf\xb9!\xcf\t\xb8\xcf mU>z\x11[\xf7\xf9\xf0\x86lA\xc6\x06\xac\xa1}6\v\xc3\xc1\xb4\xae\xf2\x80,ᆞ\xebٹ\x8a\xbe\xe3\v\x8f\xbf\xfd\xf8ß\x9bf\xf5\v}\xb7\x96So\xf7h\x11\xb8\xc0\x15\xc1\x90/\xf1*\x12DOV\xa1\x9d \xf5\xab\xafX5\xa3\xf2\x81VU\x96\xd2\x1f\xb3%\x1c\x90\xf4\x9d\xa9PD\xf6\xeb\xbbQ\xb9\xa2\x857\xf8\xd7\xef~\x1d\x04\x04\xda\x02\xc1|\x15y\x00\x0e\x905m8\xf1\u007f\xa6qJ+o\xf0M\x92\xd0U3@\xe2t\x9cEE\xe3t[7qC\x93\x85\xad;L\xed\xc8\xe0\x01\xfaU\x03\xb7Hoo\xc9Y\xeb\xba\v\xb9E\n\x9a\xb8Y\u05ce\xb0\xf2\xcfj\xc1\x84\x8a֫\xb2\xa8)\xf9\xf0\x81\xd4l)q\x12\x86\x10\xa1\x9c\xb15\f1\x99Ur\x1a\x9e\xb1%+}G0D\x9f;V3\xb6\xbbH=\t\"\xbd\xbc\x18C}\x1f9P\xf71Y;Y\x8b\x1d\xd1\t\x19%V\x1b~\xa5\x9bƸ\x00\xb6[\xb9Zbs#\xc3k/\xcdg\xbe>\xf2òL\x9auҁB\xa3\x9do\xeb\xb2h\xb5\xd3n!\xe7\af\x0e\xd8\xe4\x9d%p\n\xfa\x98\x15\x1fh\xb7\xad\x18\x98\xa3\xf5\xc0͗\xe4\xff|\xf5\x97\x9fF\x18\x85\x06\x01\x8d\xbe\xf9h\x10\xb6h\x96\xf9\x81\x84\xb1\x9fǬ\\7Ep\t\x8b\x11\xe3\xd8\x1d\xd5\xe7\x00\xeb\x94\xf9/p\xc1U\xdf\xf4\x01MZӜ&\rđ\x94\x05\xa6\xa5\xf6\xfe\x00!\\\x8e\x10\x1e\x91}[\x16,u\x1d\xe7\u007f\xaa\xe29\x9c^o\x94(\xa8U\xa5\xc1='\x8f6\x0e\x16}^\x19\xfdۏ?\xfc\xb6\"\xca獢\xfeY\x9c#Ċ\xea\x87\xf9\x98\f\x16M\xb3\x1a\x1f\x1f?>>\x8e\x1eOGe5?>\t\xc3\xf0\xb8~\x98\x0f\xd0\x12\xdf0qp\x02F\xd7\xd7\xd7\xc7\x1b\x94\x16\x04ͳ\xe2\xbe\a\x94e\v\xd0\x0e\x9c\u007f\xfb\xf1\a\x06|u\f!\xe5VqBU\x81\xa2\xee\xa6\x17\xb2\x8f\a\xbaCO\xbde\x8a\xc7\"\xc7:\x17\x10\xe9\xbbu\x9cg3\xfd\x99\x01=$\xa3\xf2\xb0ATF0\xed\xff2\xf3\x06\xe3\x81\x1f\x10y(\x93\xe5\xe9\x96Z\xd6z:\xc7\x005\x83;f\x9a\x9c\xb3\xdc6P\xa6\xdd\x17iݞ\xd3\x1a4Z\xc4\xf5_\x1e\vyi\x03+\xf5\xc9K\xddHd\xfc4\xd8p\x87`ڋ\xacp\x8dy\fTH\xdbz\xac5RߐM\xb3z\x05\xb7\v\x1cs\x17H\x9d\xca\xe7\x8eE\x91b/.\\\xfb\xe7\xf6\x8aA\x94\xbds\x1c\x1d\x15yo\xe8\x03܅\xe3\x9f\xd6= \x9e\xaa\x1f\xc7\x17i\xda\x02\xcb\xd4\xe6x?%\xcf\xea\x86\x16\xb4\xb2=\xe1P@\nƈ\t\x06\xef\xc6\xc1@Y\b\x99\xe9\x95\xe7\x10xU\xa5\xa3\xa3\xf9\xb5\x19\vF\x93\x18k=\xd2^\x1d\x90\x97xޘ\xa1\x98\x8c\xa4h\x8f\x1d\xa9Z\xfbt\xdd8kݭ\xacW4\xc9f\x99ɇ%\xef\xe2\xf4\x94\a\x19yS\x89\xb02\x12< \xb3,\xcf\xc1C\xcaz0\x9a03c@\x06\x01\xa9\xb3y!\xd3O1}\x10\x90\xf7\x06\xfc\xf9$ \x8fYڰj\x8e0\xe9b\x12\x90\xa4\\.c\tt9\x81Q\x99duV*\x94W\x93@0\x13\x13\xae'\x01\xde\xffē\xcc\xf5z\xc6\x0fW\x0f\x02\u0097)\xf0\xc4U^SՃ\x12\xafoTq$?\x8c\x93ު\x1c4C\xdb\t\xc6F\r\u0081\x11\a\x955\xc3\xe7\r\x1c\x1a;\xf0\x1eO$\x11\xb3\xba\xcf\xcc\xcb\xee\x8f\x19\xdc\xecѯ̐$\xae)\x19\x14\x83\xb1\x98K8\x8b\x98\xcdj\xc9\xd6`.\xa9\xd0\x03Q#\x86\u007f\x91\x18$\xafB\xb9\x8aT<\xfb\x97\x81\x8dtփt\xf5T\xa4U\x0f\xd2T\"U\xbd\xa7\xb7Vﯰ\x1bMݢM\x85y\xed%C\xcd\xea\b\x06p\xcc\bx\xa6\t\x8d\xcdr\xfe\xad\x86\fK\xa8a\xa7\x04\xbb\xf3\xc3\a+\xf3\x8ft\x16\xaf\xf3\xe6\xc6y\xefպo\x01:\x873㫯\xb8?\xe5_\x98\f\U000420c1\xbeQP\xd09\xc4\x04\x12\xdb6\xf0*\xdcW_\xf1\xdd\x1c\xc6\t\x8e_\x844bcV\x17`\xe4\x98\xf5\xde\x18^\xdc\xe23\x9f\xd4&8\xedx\xf2\x12\x9e\xe0\x90刁\xbb\\0\xad\xe1\xddk\xd3#\xa3\t\x8b\x80\xda.\xa7e\xde\xe9\rB\x9c\xcfo\xf5\xc0\t\xd6\xe6\x0fW\xb6ݔ9\x863\u007f7\x86\xefj\"\x1e\xa1\x81\x8f$[[\x9b\xf5\xf06\x90\xd0\xd30\xc0}I\x83\xa7\x0e\x82\x88\xa1/\x11F>\x8f\xfe\x8bT\x1c\xe9\x17\xac,]\"\xd0)\x19\x9aW\xe5z\xd5⣀\x93\"`!u\xfa\xd5>s\x1d=\\\xfc\xd2<\xfb\xe8\xb8\x18E\x8e\xb8tݸ\x0f-\xc89\x8eܒc\xef\xe5ػ\xfb\xc7?'\xfeK\xef\xee\xc5\u05f7\xff\x98\xf8\xec\xd7\xd1\xeb!aI\xbf\xf7_z!K\b\x87ד#\xff\xa5\x17\xf8/\xbd\xd7#\xf9y\x17\x0f\xdf\u007f3\xfc\x8f\u007f\x99\xf8/\x8f\xdb5\x80J\xe0\xe7\x90\xe2\x15\xf7\x93\xcfǆ\x0fg\xd5\xda&ڌ\x9a\xf2g!\xbc\x9ex?B\\\xf3ڧ\xf4w\x9bUYТ\xc9\xe2\xdc.?ۧ\xfc\x9f\xb2\rM\xed\x92ծ\x92\xc2I\x05\xb9\x86Tɡ\x88\x05}Y\x05̓\xcbx\xc3\xcc\"\xfc\x9d\x15\xdeI\b@z\x8cy\xbf\xc5[\xd4B\xe8C&\x83\xed  \x83\xf7\xecO\xcc\xfe\xcc؟\x15\xfb\xc3\xd6\xe5\x83\xff\xe7\xfff\u007f\x97\xec\x0f\xfb\xff{\xf6\xe7G\xf6\xe7_ٟ_ٟ\x9fٟ\xef؟\xff`\u007f\xfe> \x13\x11\xb1C\xaf\xcf7\xec*\xb9\x1a3\xb5\xb8\xaez\xecc\x17ʮp(|\xa9\xba}\xa5\ue186\x0fYC\xac\xc6.\xe7:\xaf\xdb\xc5\xf5\x16YJ%\xb2\x19\x9c\x1c\xe9\x06KD\x87щH\xca˹\xa0\xf4\x18S~\xf8)\n\x8d\xe2\xb2\x0f\x87'gz/\x8a\x0fn\x06ep\xb8\x03\x83[\xc3=\xaaL\xd8D\xa7>y\xae\xe2\x14~\xb4\x82\xf6\x9a\xdd}wE\x8eH\xc6\nM\xecQM\xe3\x9a\xc2\xc3\x03(z\xf0\xb9*\xf3\xadw\xe2\a2!YO\xb3\xc4\x068\xd5\x00R\x9c\xa4{n\xe6\v\xc8,e#\f\xef\xb0\xdbt\xd8c\x1e\x83\x86\x8c\xedZp`1\x1a\xc6\x06Eri\x9b\x8e\xfbc\x9b\xc86\x1b#\x15\x1a\xb9OI\x004o\rw<\x02g\x15\xac\xb3\xc2\xd4J\x9b\xd5>ŨxaV\x10\x9aUIN\xf7\xa2\x14 \xcd:\xf3\xb8nX;%vL\xc0\xdci\x9cܫ,\xf6\xc5\xd3˵\xf9\bgg\x95\b١\x84\x00b)nnj==Ȋ\xc1\xb8%#Xw\xb9nTVE\x1fhU\xf3\x97U\x06Y1,\xd7\xcd@Ϟ\xe54\xe1\x122(\xd7\xcd\x10\x10;\xb7\xbfM\xbayIϪț\xb55jz:\xe2\xd2\xea\xf4\x9e\xb8}'C\xb6Df\x03\x04\xdd$\xe4\xa5\xd3)\xc2=\x0ex\x0e\xb7\v\x12\x17\xccd\f\\\xe3f\xb5\x1a\x9chK\vCZ\x1f7\b\xba\xd4\xc61\xeb\n\x80_\x1a\xf0\xfa\x18m\xcbT\x1e/W\xde\xd2\x13;\xb1\xf0\xca\u007fk\xb3\xb6γ\x84\x8aSk\xf2\x1cq\xe4\xf3\tJ\xee\xac?\xe0\xf5!\xe1\xdb\x13g\xa4\x99\xfa27\x15Y\xcbu\x0e\xcf۞V\x1e\x15\xe2\xa7W\x1ex\xc1F\xc2D~\x98\al\x01b\xfaD\xbc\xfd\xaa\"d>\xaai\xf3M\xd3T\xd9t\xddPo \v\rD\bW__S\xb0\xd6\xcc\x15\xe6\xd14\xae\xe9_\xe3|\x94\x94E]\xe6Y\x1a7\xd4k\x9f@\xc1\x80u\xa2\x90א\x97\xa4\x19\xe1\x91o26\xf2\xbe\xe7}#\x9d\xba\x9eF\x84p\xee\xa8\xeaU\x8f\x88\x18\xed=:\x1aۖ3\x12\a\xe4\b\xef\xb9\xcb$xu\xa3*\x1b#\x17\xbf!\xab\xbe\xa7\x8f\u007fS9\xec\x13\xd3a\x1d\xa3\xd2a\xc9\x04/x\xd8S\x80\xa4\xfa\x8ft^Q0R\xa2\xabP̠?\u007f\x1f8\x19!\x9d\x9d\xf1\x98D\\[\x8dI\xc8U\xa5\xfc\x95\xca\\*\xd3fc\x12j¨Š\xea\xba\xe5,ƶ\x01]V\xea&\xf8\xccp\xe1\r\x87|\f\xb3U\xb17s\x14\xbc\xcb&X\x81\xef\x13s\x89{\xe3\xa6\fåw\xd17%Cyo\xc2^+7-\xdd\x17\x93#2%\xcfIc[\xfcf\x95\xbf\xd8{\xa8O\xafQ\xdb:\x95\x95\xfb\xfd\xb5\xb7\xa5\xd6\xee\x90e@\xb2\x80\xbc\rH\x1d\xe2\x19\xdd:\xe2\xff\xf2\x1d\xf9w\xfc\xdf\"\x10\xa7\x0f\xd3\xd37Z\x15\xfc\x1a\xb3+|4\x1c\xe3A\x1bThNGAp\xfaM}\xeb9\\\xd8#\xc5\x19\xc0'\xfc\x84\xc5T\xd3\xe3\xb5 U\x00I\xbd\xf0\xce>v\x98\x8d\x89\x94\xb1\xdfi\xaf\xd8.\xef\xc2I\xebܟ\b\x19\xabo]\xd6a'\xf1\xb2ն[\xa7\x0e\xc9\v2\x95\xa7H\x9c-\x104+>\xa1g\xfb\x9d|(\xd8\xdb\xc1\xb6\xd8\xf7\xd9\xf0\xc8\xf0އ\xc1\xc0\x92!\xd2.z0\xa2\xcaц\xdcb|=\xfb\fn9r\xbcE\\ߕ#|xXܙ\xb4ߞF\b8\xb7wtKJ\xfb\xd9z\xf9\\s9\xca\xccH\xbe\xb2\xddoa\xb2\x86\xdb\x14oE3\xde\xfa\xe4\xdd\xdd\xdb\xc9(\x1b\x0ew>jݢ\x80-\xc5%\xdd}\xe4\x9c\x1cL\x0e\x1b\xae'7\xfb>x\u007f\x00\a\xe1ԣɼ\xee\xc6r\xe0=ۉw\xb4>\x99\xf5\x8e\v\x1b\xef\x9coq\x13R\xe8E5<\xad\x16\x818:U\xb4\x87\xaf\x9c\xe4e\xdcxK|pCK)G\xdap\xd7\x0f'\xb9\x9f\x1d\x83\x910Z\x95+\xcfp\xcb\xf5w\x8d\xab[Z\rأ;\xfa\xbaB\xb0R2LS\x1f\x1d\xd7\v\xc5\xf1\x9f\xbbp\xa2\x85\xa6\u007fw\x17NF\x1b\xd2\xff\x96\xf6\xd4qI\xb2w\xda\xd1շ\xaea\xea;O(\x17_6\xdfkZ\xe1f\xf9\xa3c\x83\x1dsԯ.CҞ\xa6\x1c\xb3\xd17(;\xca\xf0\x8b\xfd\x80\xfc\xc1N\x9c\xb2\x95CLn\xc97\xca\x1a\vH3%\xb7\xe4\x0fzJ\x850h\x93\x05\xa4B\x00\xf1\xf9\x88\xb9\xcc.\v\xc8#\xe6\xe1\xc7=\xcf\xc1H\x9d\xf7<K9\x8c\xc1\xc5\x1f\xb3\xeezvK\x9a)\xfb\xf1\xe1\x03i\xe2\xbbH\xa4D\x9a.\xe6ӄnK\x06\x04\x17\t\x83@\xfd\xf4\a\xbd\xb3]dLs\xce\xf1\x05$\x05H\x90/\xaf\xa6\x11\x03\xcd\xe9^h\"D\x13)4\xbeq\xa5\x15\x18 ۽\xb3\xb9\xccԝ\xa2\x89\xeb;\xafƊ2\x83\xd6\xf9\x88\n.\xf3WS\xeb TL\x86\xac;\xbff\xf6\xb0\xcf~\x1dݒӋP;\xedUM\x19H,Ab\x01\xd2kQ@Z\x8dz\x85\x11,\xcc{\xbd\x97Ȑ\x9c\xec\xe6b\x153\x81\xeb\xe1\x9fަ\xee\x8a\xc9\x11\xb4Ng\x9d\xe4\xcd#\xf0\xe6Qó_\xa3\xf8\xc2\xe4\xf06=\xc6l\xa0\xf4\xb4鱷MjA\xf4\xd8դ{1\xaa\xee\x85t\u074bQuo\x8a\x19\xb3\xa9\x1cU\xe0\xda\xea\t\xe3\xab Cr\xb6\x9b\x05\xf78\xc6\xee\xfb\xc6X\xb1\x1f7\xefq\x9c\xdd\xef\x18gȉg\xb7$\x02vL9;\xa2^N\xcb%潋ӆ=\xbak\xc6\xd0\uf595]w\xbf>\xf3\xf4\xf1\xcb|\xda5q\xc4\xdcW=\x9fz1\xc72UIS\xed\br\\\xc1\x05\xd9* \xf1\x1c~\xcd\x03\x12\xc3=\xc1\xd14 S\x96;\x1dUdH\xe2* \xd39|\xce\xd9\xe7< \xd3)|2\x1d\x12O\xf7]\xcb\r~?\xc0\x97X\xaa\xf9\xf4͂n<}qW\xb1\xd5]\x05\xcb;\xbf\x1bjΠ滠X\x9fNq\xa9\xb8\x83\x91\u007f\xae\xf3\x1d\x8c\\Թ\xcdH\x96\xa43r\x11\x02\xd3\x16|=\x19\x8f\xea\x80\xe4\xf8+\x0f\xc8\"\x02V-Ȑ,\xc4\xfam:\xaaɐ\xb0\xe5\\\x8e\x9f9\x19\x92\\۵XDB1/\"f\x80\x9b\xba{\x11\x91\x17d(\xb2u\xbd\xbd\xbb\x13\xd2\xd37\x8b:gl\xf3\x16!9b\b\x9e\x93\x06H?b\xc4\xc1G\xce>r\xf8\x80\xf12\xe8\xe7\xe2\x0f\xf1.q\xcc\xe3\x968\xb2$C\x1cs\xc1\xb1\x18.ȎbC\x1csɧ8\x0f\xc8\x14n\xac\x8e\xd84\x17\xc7O\x14\xc7\xf4\xf4M\x1eO\x81\x13q\xce$&\xc7\xc6\xc7\xe0h\x88\xf9\x87&K{p\xe2\xcf\xc9NyJ\xda\xf2\x94\x98\xf2\x14/\x84<\xc5\x10\xa5h\x94\x04\x1aw\xa6\v)O\xf1\" S\b\xa13J\xd8gb\xf1I\xc9\xd3t!\xe4i\xbah\xcb\xd3t!\xe5i\xbax\x82<%\xf9\x1b\xe8\xe0\x05\xe3Ղ3.a\x1f\t\xffP\xfc݇\x8b\xdf\xf0\xe0q\x9d\x96\xf1\x86[ĉ\xb0\x8cc\xed\xae\u007f@\n\x14\a\xf9\x19\xca\xed\xb3\xac\xf0\x14\x98\xf4T\x04$k;o@s\x87\xdc\xfc\xdf\xf0\xab\x80\x06\x99^\fWn\xa6p)WC\xc0\xcbƼl\xc2o=I\xbf\x84\x0e45\x81\xa6\x12\xe8\x905Jh\"\xd9\xdce\x93\xf6ܒ\xf4\xf3\xfc/\xe2\x9eq\xaf\x1b\xf3\x9f\x1f\x91\xe9p\x15Tk\xcd=\xc9\n\x12\x9bF(\xa4\x19\xb7Q\xb3\xbb\xfbI˷\xf3\x87\xedO\xf1\x92z\xf7\xbe\x17\xdf\xdd\x03;\xef'~\xe7\xea3A\x14\fԵ\x18V\xc4X\x16\xf13A\xa2\xdfF6\xed@\xb6\xab\v\x00#\xb0\x1dа\xd6\xed\xc1v\xee\xbbn{\xb7\xe0\x92\xca\xf0h\xf2\xd2{9~\x9d\x1e\xbd\x1e\xbd|\x9d>\xff\x00\xff\x1c\xf9\xde\xcb\xf1\x1d\xfdn\x02\xf9\xec\xfb\xa5\xbc\xb0bx\x87a\x9b\xdeձ\xc1\x8e \x9cYQ7q\x91\xd0r\x86;3\x10.\xb8=(\xb10\xb4\xa5\x1f\xa3x}$\x86\xc5\xfc\x00\xdd\u007f\x03X\x0ea\xc6\xd4\xc8\xf0\xdb\xf5\xf1\x97\xd5b\x1eA\x1c\xec\xb4\xc1!U\x9b5@`I\xb0\x16\xf0\xb1\xeeE\\{S\xd8\xc4:\xfe\x87\xf7\xfb\x0f\xd5|\xfa\xda\xfb\xb0\xa8\xf3מ\u007f<jhݰܱə\xf4\xf4ͷe^V\x0ej\u007fa\xb3Ȟ\xbc1B#\x01.\x9e\xc2\xd4\xd6\xd1ԁ\x9d[Ī\x02\x8c\x88&\xe89l\xd3\x06\xf7a\xe6SO\xa9by\t˴+\xab\x80\xcc\x1d\xf1`\x9dQ*\xc8KRY\xbcbV\xaa\xe0\xbaW1C\xb3b6f5\x02\xce\xf2\xde\xc0\x1b\x1a\x03X\xc8\x05<1Ь\x14\x05\xea\xfd\xe7\u007fV\x01\xf9\xcf\xff\x9c\xb3?\xd3\xf6m\xaf\xf9\xf4g-\x8c>\xd6?\xd2#\xeb\xf3]3`\x19g\x9f^h4\x85\xe8&\xe6^\x89\xbc\xd4p\xaf\xdf\xef\x1a]\x06m6\xbc$\xf7d,=]p\xc1\x83\xdcrn\a\xb0\xfd\b\xbf\xe7\x18\x8c\x03~O\xf1t\xf7\xa9f\xf9=\x83\x83\x88\xcf\xe6\xf0w\xeak\xd3,cA\x06;\x17\x99vn\x16\xc0+\xf2\x82)\"V_\xa6\xb2\x00\xc7\x1c\xb3\xe6f\xd6\x14\xe36B\xd6Te\x99\x95\xa93&\xe7\xe7\xc6!\x93\n\xee\xf3\xf9\xfa)\x14\vb\xbe\x13b\x8a\x10\xc6.\xa4\xd1\x1bi\\\xdd\u007f\xa6\xbep4\v\x89\xb8g\x16\tt\x90o\x10'\xd3\xe7\x1d\xe9\xd3\x1e\xc2\x17暢}\xac\x05\x96,5\x06\xbff\xa2!Ăc\xeeD\xbcφ\xac\xbd\xc4\xe2\xads$\xce]\x89\xcea\x85\x1a\xd3>\x81\x11\xe7YB\xa7p\xf3|\xf0\xfbY8\xbb\x9a\xcd\xf8\x05\x94\xb8h\xb2wk\xfa\xb8\xc8\x1ǎ\xe94\xbd\x14\x99\xef\xd61K\f\xc3\xd9L\x95x\xb7\x8e\x97q\x95\x15\x00\u007f9\x9b\xcd\xd23\x91\xf5~]\x89*T\x81)\xcd\xe6\x98z>;O\x13\x91\x9a\xd5\xef8=3z\x96\b\x14\xd3\x1c\x0e\xa9\xb0:\xd9\xff\xa9\xd4\"Y\xd04Ηe\x91\xf2B\xd3$\x95و\x89\x95P\xd5\xe6k\xfa\x90\x959mX\xd6U|2\xa5'\"\xab*\x1f\v\x96\x1a\x9f\x9f\xc4'\xb1H]W\xf9\xf6\xb1,\x01\u007fJ\xa7WW\x82\x0fI\x9c\xd2FTr>\xbb\xa6\xb1 ,Y\xc4US\xd1u-\x99\x11\xaa\xac2\x81\t\x01Н\\\\G\xe2\xd2ORVq\x8e\x8d\xb8\x9c\x9d\x87*\xb5\x98\xe5\xe5#\xadDM\x17g\xd7\xe74ղ\xeb,\xbf\xc7r\xb3+\xc9Ȥʖ5\xbc\xc5\xf1\xfb4\x89\xceNe\xfa6.Z\x9d\xc7\x06\xaaέ\xab\xa9\x96\xa1J\\M\x8d\x8cy\x99\xa7\xb4\xa8\x903ӫ\xab\x8b\xd0ȭ\xe2-0\xf3\x9a\xfdgdP\xca\x11^\x9cI\xbe\xf0\x1cw\x91\xfbE|\x9fA-\xe9\xf4\xf2B\xafe\x19\xcfiр8^M-\xc2\xcb<{\xa0\xb2\xb6\xf3\xf3\x8b\xe9\x89\xde\xe0\x12.\xd9!\xe3\xae\x12\x83\x90\xb2J\x16\x194\xeb\xfa\xfa\xf4$I\xb4\xac\x8a\xa6\xa22\xa3H\rBȲ\xe8\xf5\xf5\xc5e\xacg\xd1XRq5\x9b&W:\x15\xe0?\x16\xbc?\xbb:M\x8d&@\xae\xe0\xe4\xc9\xeclv6k\xe7Rwn\xb3\xaeޭˬ潚\xd04\xd2r\xd5\x18\xb8>\v\xc3\xf4TdQ\xbaZ\xe1Ŷ\xdf\xcff\xd1ٵ\x9eQ\xdfo\x95\x94L5\xf1ɖ\x82Ƌk\xf6\x9f\x9eN\x1d\xe9e:W\xf2\x1c\xd1k5<gYE\xa7U\x86\x83}z\xc2\xfeO\xe4\xe4l|(\x8d4\x9b\xc53\xd1\x01\xb3\xb2\xa2u#\x99|rr5U\xe5\xd6ɢ\xceb,\xa3)\x82y\x9c\x15\xf5\xb4\xacJ\x1c\"\xec?\x91\xb3(\xebFUt\xa5\xe9E&\xf2\x88)\xbd\x94\xddo\x8c\x834\x8e\xcfOd\x0e\xe7\xcaU\xc8\xfe\x93\x89R\xfe\xaf\x94\fA\xea\x96\xe6y\xf9\bC \x9dͤ\xb4\n&\x1ah\x16eA\xb7)}\x94\x8aUe4\xaa\a/\xae\xa7B\x87fE\x9a\xc5\x05\x97\xdf$=O\xce\x13-g\x0e|8ccHp.{(\xab-\xe7\xb5B/\xc7\xe2,\xa4\x17W\x02E\x1e?\xd0\"\xa5\x15\f\x80\vz1\x8b\xad\x8ci\xbe\xae\x17\x1cY8;\x97\xb9\x8f\x85\xe4\xc7e2S\xc30\xa7˲H\x16\xd9l\x86\xa3\x8a\xf5\xb7\xd4\xec9\xb3\xf2\x84\xfc\xc4izE/\xf4\x1c\xa5Iu\x86a\x16WfTW\u007f\x90#{Qu\xc2,\x9e\xc5\xe9\x89\x01\xc4;4=e\xff\x999\xbc\x15\xd7!\xa5ס\x95\xd5QH\xf5\xd3\xf4\"\x89\xf4\x1c\xa5Nf\xb38\x94\xea\x04\xf34}r\x12NOb3W\rҫ˄\xce\xccL]\x9f\\^^]]_;\xb2iWvCi.\x90O\xc3\xe4,\xa5z\xb6Ƹ\xd9lF\x15\x0f\x96T\xcc8\xa1\x9e(\x1bqz\x92\xa4\xa7\x8a\xcf\x05\xa6\xb2\xf1-\xfbU\xd3\xf3\xc6(^\xc6U\x89|\xba\xd2-\x83%M\xb3\xf5ҴI..\x92T\xb2\n\x01\xf49O\n\x17f\xa9)`\x1a\x9f\x9f\xcbn\xc3\xccպZ\xe5P\xf2\xfa\xf42L\xa7F\xa6\xde=\xa7\xc9\xf4\xf422\xb3u\x85\u007f9\xbd\xb8\xa2\xd4\xcc_1\x03QS\x12\xb3\xf8ڤ\xdaP\xecgWi$'(\xccG\xd5.\x06\xfaet~%\x86\xdb2K\v}\xe8D\xd7\xd1\xf5\xa5\xe4YV4IE\xe3%7\xcafRt\x96Y\xddl\xab\xb2\x96v\x19\x95M*\x93$\x86\x93И1\x155\x15\xf1C\xfc\xb6ԴuJ\xe3T\xe5m\xa5\xa5!j/\xf34\x87\v\xbb\x83\xdf\xcf\xd2ٹ\xecx\x98ą\xf2\vC=5\xad\xe2)t\xec\U0010a788\x0e\xd2g\xf5\xf8\\\x15\x80dΓ\xd9\xecL\xcf\x11\x1d\x9dƗa*\xea]\xc595\x14;\xa5\xf4J\x8a\x0f\xe4\xca\x11\u007f5\x9b^_i9F\a\xc53Je\x17\xb3\\\xa3{\xd2\xe9e(\xe7\xd8U\xbc\x8a\xb7\xf1\xe3\"[q~\xceR\xc1\xcf\x15\x8d\x93\xc5j=\x9bqn\xc6\xd3k\x99S\xadQ\xa3_\x9d\x9f\x8aa\xa1\x14K\x12&B<W\xf9\x1a\xfa6M\xe30\x15\x9d\xb1*\x1fS5\x17OC\xaaƜ\x92\xf2+\xbd\xa7$\x13\xb5\xf1V\x95\xf5V\x9a\xcf\xcc\u00916NUnc\xa93\u03a2\x8bk);u\x9c\xa69\x95\xa5\xae\xa6g\xe7ѩ̓\xea/\xbe\n/Odr\x91\xaazfg\xf1م\xa0\xc0P\x8a\xf4jz~\xa92\xea\x05\u0379e=;\x97}Qg\xb4(@\xa3\xc4\xe1\xf9\xc9I*\x93\xf3\a\x9cǒ\x90\xfd'\x92M\xb5J\x05S\x8d\xe1|\x11\x9f\xabI\xcaԵ\xe1U(g\x05S\xcd\x1a9\x85T\xa0\xb1\x1c~m\x8d0\xbb\x14\xec5T\xf2\xd9\xc5Չ\x9c\xee\x1b\x9c\xe8ғ陜\xa5\x1b\x8a\xf3b\xa8ϋl\xd5\xd8`?\xa7W\xd3Y*$\xb9)\x97qSr3\xe2\xf4L\xf0\xd3\xd4>!\rS\x81H\x99\x94\x94^\x9dH>?.hܠNI\xe9\xf4T\xa6*CN\xcdĐZ/\xcb{\xb92\x94\xa6\x829\xbbH\xc9\xc3d5\x16c\x98J\xb4s\xfb\x9a\xc3p\x8f\xf7\xbb\fx\xf9ޝ\xe1\xe8R\xb7\x87l?\x97o\x84최\b\x8b\x80\xd4\x01\xc9\xf7\xf5\xc4-,Oܟ\xeb\x1c=q\x8b:\xf7\x16\xa3E@\x16\xa3\x9a\xfd\xc9ݞ\xb8E\xa0\xf9)\x04\x95\x1c\x94\xa18Z\x04\xe4\xa8\x0e\xc8Q\xde\xf2\x17,\xea\xdcr\xc3\xfd\xb9\xcew\xb9\xe1\xf4B\x9f\xd7\rg\xecz\xa2\x8fc\xc1=-5\xff7\x97ѩ>\xba\xc8\xf9\"~(\x171ҹ\x94w\x13c9h۾%\xb1\xb7\xebn\xa9\x19\xf4&\xb1E,\xf9\x14\x11K\x84\x88%R\xc4\x12)b6\xf4\x0f1w\r\xe7\xf1\x94\x97\xc8\x19p\xcc\xfe\b\x9f\xaf\xc8\xf3x\xcc\x04&\x8fy<\x15\xdf\xe0\xc3^\xf8\xfe\xa8b\x85\xe6X\xd2w\xe0a8@b\x13\xb7\xc4&-\x89MvKl\xb2\xaf\xc4\x1a\xfb\xa4f\xbf$\x9a\u007f4\nC)\x8fG\xa2\xf5\xffF\x9e;B\xe0\b\xa12}\x90\x06E=B\xbb\x0f=xS\x94S3\xfcdjvK-\xdfA\xb6\xa9\xe1R\x8b\xbb\x15f\xfcm\x03c\x8e1\xae\xf7\x95ݼG\x1a\xbd\x9cIP\xce$(G\t\xca{$]/\xc1F\xcfh\xa1)T\x90\xd5\\\xc9j\x8e\xb2\x9a3Y\xcdu)\xf7\x8e\xf2\x80\x1c\xc5\x019j\xfb_\x91\xf1\xb7$\xba\xb2\x12\xffFn\xc9\xe8\xfa<<\xbb\fD\xd2\xdf1P\a\xff\xfa\x0f\xf65\n\xaf\xae\xaeN͢\x96\xb4\xff\x10\xef\xdc&\xd1\v\xed+\xed\xac]\x9f$ݼ@\xect\x8c\x1b\x14\xed%튞'I\xf7\xfe\xd4\xec\x94vq\xea\x04)\xe8F,\x9e\x88\x86\xc0_ƍ3GH\xc2ѻ5\xad\xb6\xaf\x00\xb6\xac\xbcZlDJ\x04\xdf\xe4\xf9a8\xbe\xc9s\a\x9a_ʲ\xd1o\xd2Y/,k\x90?\xc6M\xb2\xa0\xfc\x1dMUx\x04\xc1eh-\xaa\xe1\xf7\b5\x80G:\xbd\xcfx\xf1\x1e\xb0e\xf9~7L\xbd\x13\xa4\xb4 Z-0\xc2\xf8\x16\x01\xa9\xdb=j4\x98\a\xadg\x80\xb2+EГrF^e\xef\xdf\xe7\x18\x9cp \xd0\x0e4\v\xb2\xbf\xbbe\xad\x88\x06\xf3\xf8QP-\xdeٍ\x89\xad\xa7\xef-\x84\xa3u\x91\xbd[\xd3We\xd5xz\x15\xbe\x1b\xb1\xe2\x10/nu\xae\xf5V6\x16\xca\xcaBWAw\x13\x11\x8bW\xe4\xf6\x0f\x1f\tƺψ\xe5+2\fu欖\x8f\xdbv\xc6\xc8\xc1~\xde\x16\xe3h\xfez\n!<d\xf0s\xfe\r\xbf\x8a2\xa5\x01\xe1\xdf\xec\xe3\xc6\b|SΈ@I\x9eYR 3L\xba\xdf\xd4r\\\vb\xac\xa0\xd1o\xb5\xe7xP\xaf\xf0\xdbT\xf8@\x8e\xf1ʎ$\x9e\x9f\x8b\xe5\x9f\xd0\x14u\xff\x8b\xa7\x8eVqE\v\x88;Hn\x89' !4\xd6ۉ\xafe\xdf؏\xf8\xe8\x81\xcb\x10\x97\"*k\xbf\xda\x03O}a5\x00\r/U\xe8W\x81\x14I\x9cl\x0e-x\xc2\a\x1ep\x9f\xfd\x1d\xbdy\x93\xc6M\xfc\xe6\r\x84E\xb6_q\x11\xe5\xbf\xfa\x8a\f\x04\xe0\x80d\x05\x14\xf5E?J\x1clbԿ{\xdfX0)5\xeeչ\xde1vJ\xb7\xec\x17#`u\xb7\xc8\xdac\xfc \xa9\xfd\xbcR\n3\xc7g\x10TYȒ\xba\xe0\x8b\x89V{H\x88\x17\xfe\xbd\xfd\xa4\xcc\x103\xf7\x18*\xb4\xb1\xf2\x05\xa4!n\x9aʎ4`\xf9)v\x87\xc9֤\x00c\xf0\x19g\xb5̨N\x9c\xb1Й\xec\xb7\u05ceԈ\xd7\xeby\fI\x8c}\xd0z\xd3\x0eb\x16@LE\xf2\x12\x19;\u05ee\xd1\xff\xf4\xcaà\x06\xab8\xa1\x81\x06\f\x11\x10lh\xb3\x8a\x8f\xa68\xe5k\n\xc3\x1c\"0\x00\xd54N\x16\x9e!Ɍ\x89\xc2W\xc3\x00\xef\xe0\xf7ķO\xf7\xb1⎛e}X\xf5\xfe\xd8\u0557I\x1e\xd75M\u007f\xe3\xee\xc4a\xe6\xe9Q7\x9b*[z>F\xcf\xf7\x8e\xff\xf1\xe1u}t<g\x8bo~\xb463\xa2\xc2\xe9aw\xb8\x1a\x85\x96\xfd\x90Ս9\x0e\x9do\x00b\x9c\xab\xa4,\x9a8+j\xa0\x03\x1f\x13\x14\a4U\x04¾7o\x8c\xaa\u007f\xd2b\x91\x1a\x04\x8a\xf8\v*h\xaf(ld\xdf\xec\"\xda\xe8xޏ\xbfPI<\x1e1\xe4R\xd0\xd5\x10{\x88\xe8\xc1\xfa\x9e Ԝ\x8a\xcf/\xd7\x02\xf1!\xa2]7ۜv\bv@VUVV\x10S\xdf~\xd0\xdd\x15\xffT<\x12z\xda-\xf1\xcf:$^{xT\xf4\xb3\n\xf9ǹ+\x88\xd9\xc9`h\x93\x04\xe7\x1c\x16\x9f\x13\x833\x1d\xdc6\x9f\xf9\xe1\x84q\xa8ǬH\xcbG\xa6\xe7\xbe-\x97\xabuC\xd3WP\x9f9R\x99\xc82\x18\x11\xde\x16\x1e\x811\xf5\xa1l\x8fj\xeb>\xbd\x8c\xadswӮ\xde^qj\xbe\xb0&\xd3\x1b\x80\x1c\xb9cP\x93\x9b\xc3\a\x8b\xa0\xf8\xf3\x8f\x16\x89\xf9\x90\xe1b\x87\x887\xd8\xd6\x1d\xdb6\xe2\xb1m97\x00\r\x84\x04/\x1a\x1e\xdf\x16\t\xe4,\xe5C\xc00\xf7\xc8K\xd7\rkx?G*E\f\xec\xc3\xd0i>p\x15\xa1\x17\xc2\xc2h5߲\xa2\xf2\"\xf7` ^=\xc5\bʂ\b\x91\xef\xa8݁QJ\xb2\xfbF\xb8\x8b\x06\x15\xdf\xf0\xe3.\xf6\xdb\x01\xe2\x9f\xc8\xfe\xac(h\xf5\xe7_\u007f\xfcῘ\xf9\xaa\xde\xcf\xc3z\x1d\xdf>\x8c7\xea?\x80\xed\xf1jE\x8b\xb4+n\x96\x8c3\x8f`\xed\x10c\xe8X\x83\xcco\x17Y\x9ez\x9d\U0005fc13D \xf5\u007f\xff\xe5{\x1c\xf0f\xb0<\xab\xbe\x9f^}J\x8d\x1d֬y\x0fu\x87\xf5\xacW\x8a\xdc\xf3\f+Z\xd0I\xc6\xfc\xe7.~gEM\xab\xa6\xad\xa4\xa7tVVm\xbe#x\a\x170\xf3\x0fP\xf2p\xc6k^8\x0fkG\xffhG\x8f`e\x9d=\xb2\x1f-\x1d]\xb2\x1f)\x9f\xdaW\xa2\x05d\xcc\u007f\xee\uaaca.\xcb\a\xba\xeb\x9e\a5\xb6\xacM\x15\x82\xabRa\xeb\xb7\x1d9\x10\t\x13R}\x0e\xcb+E\xd1\x06\x1e\xec9\x90\xd9\n\xd9\x11\xc0\xf3\x9en[B5͊\xd4\xe3n\t\xf8\xe7\x8fq\x13[\u05ed\xdb\v\u007ft(H\xf8\x8e\xbb\u007fE@\x96\xccH\x8a\xf4x\x9a<q\xbdJㆲ\xf6\v\xf7\b-\x1aZ\x19\t\x9b\xacѿ\x95\x13\x80թ\xb3Mk\x98\xbe\xae\x82g3\xff*\xa3\xfc\xaa\x17D\xc5Ә\x02\xb3zH\xf3\xad\xa3Y\x96}\xca\x17[n\xd7\a!惢\xca}\xa19C\x1c\x9e\f{qd\x92\x0fק\x04b\xdf\x0e\xef#\xf9t\xf7\xf6\xe8h\xd2ryt\xc4\xf9\xb1j0\xdf&\x05\xa7\x9c;8\x8fj\xa1z\x19\xf0\xafVd\xe5\x8f}\x1c[\xee\xc31\xd9\a\xaa\xc3\xf5\x9eᏯ}\n\xd74\x01\xe4/\x9d+\u007f\x91\x86C\u007f#\xd6\np\xe4rX\xea\x92\xc9{G\x8a5V\xa3z\vk\x95\xbb\b=}e\xe30\f\\V?\xbcS#\xea\xb7\xc8l5t\x17\x05\xbf\xeb\x14\x12\xd4G\a\xf7xk\x8c\xec\xe8\xab\x1a\xfd\r]R\x0eT\x8b\xb1\xd4\x1fA\xaa\xc5M\x17u\xa1M\x9e5\\o\xac,\x870\xba\x04\xd1w\f\xb8\xdd\xe2\xe2\x14\xcb\xffm$\xca\x12\x13\xbcP\xbd\xb4\xc2\xca\xedO\x9f\xde\x05\x06\x8d\x87Q褪\x88\\d\xed\x16B\x9b\xf2v\x87:\xeb\x16\xff\xaa\xe2#,i\xa2\xb8i\x83\x19\xden\r\xd4̐\x94\x9bɶ\xc3\xdc\xc0\x8f\xfa]U%Y\x8c\xb5`\xb6V\xa3\xccg\x95\xf1¢Z\xdf~\xe5X\xdfS\xd0v&:v\xf2\x9e\xd9\xcb=\xddDQ\x13<ޚ.Z[g\xe1D8K\xfd\xfd\xde\v\xefݾ\x80\x1a\xd5\xe8tmR\xf5\xc4\xf1\xb7\x19\x01\xfc\xb5E\x1c\x12\xbd\xbb\x89\xb4\x94,\x00\xccb\xdcud\xb4\xb6\x96\\k]դnN(\xe3P02\x13~5m\x9a\x0eZR\xe4\xde\n\xfc芵\xf5\xa4ʝ\x91\xf9\xb8P\nvv\x87\xc2\x06\bc\x83]\x14\xdddM\u007f\xc9M\xe6|y\x19\xcb\xef6\xca\xd7ˮ\x1dr\xfb\xc9\xeeC=\x1e\xd2˥6WœJ\xae<\x9d\x8f=$ϲ\xdcb&\xa6\xec\xb7\xcbٷ\xd9\xc91\xdb[\x9d\xb2Bc0`\xaa\xa8۱\xc3\x19:68\xf9\xf6\xe6ۣ\xa3\xdff+>tm\x97rk(Ӊ\xe2\x0f\u07b64\xceW_qv\xf4o\x81\xf6턛V\xfcg\xdf\xfb\x84\x87\xce]\x03\xe6\vl?\xeb|$C\xc2&\x0e\xf4њ\x8b\xaa\x1b\"b:\x1f\xb8%\r\x00\f\xe3W_!f&\x9a\xc0m\xf6\xf5*\x9b\xe6\x10u\x9c}\xe8\x8a\xcepu\xf0.\xd2\x1f|ħށн7\xa2\x85\u007f\xbbo;\xa74\xfdFI\xb9\\\xc5U\xac\x1d@P)\xadc\x03e\x05[\x1a\x98\xdb\xeb\xcf<\xa8\x1fyw\x01m:Am\x87\fȍ\xb7S\xbaz\x9e\xbb\vH\x12\xaf\x9auE?ǎ\x15\x9c\\\xdac\xc7JTn\xbe\xc2&\xf9\xc4)\"YA𩬎\r\t\xd6]\b\x8a\x0f\xc0\xdd\xf1\xaf\x89\xf6\xda\xddS7\xad\xbc\xc2\xdcK\xbe\x1b\xbcyS\x16\x10\xf1r\xbb\xa2\xa8T\x8a\x914T\x04\xc9F\x83\xf6\xd9T\xe9\xe9\x90]\xddʐ\x19\xa2k=g\xea\xd0Ho\xd0o\x0f\"\xaa\\\xa50ز\x80\xbcm?Pڧ1Y\x81=\xddh\fK\x1f\xa9\xb2:}\f\xa9\x1d5\b\xa0K\xec\x91\xd5\x1e\x0f\xbb\x18\xb6\\\x99;yv|\x1b\xeb(H\x0f*\xae\x01;\x1b\xf4\xa4I\xb5_o\x1f0\rj\xc7!\xda\v+\xb5\xaa\x16\aX4u\xeaP\xa2b\x95շ\xc9W\xc5E\x9du\x9d\x80\xdc\xf7\x04ח;\x13\xf8\xd9\xcec\x99VA{\x1e|\xd9\xf2~\xe0!\x9f@KMi\x1eo\xd5S\x17\xc0\xb6?\xb24\x03h]\xc1\xdb\xd16\x1cO\xd6Ό\xb8_\xee\xb6\x14\x80\u00a0l\x92\xc0\xcc\xf8>%\x1f>\x90\xa3##\xf1'\xbai\xbeO\x03\xf2Gf\xd4\x17\xe5\xa3\xe7w\x9c\xf2\xe6\xa7Z[K(r'O\\\x93\t\x99\xf8\xb6\f\xb1Bw\xe1Ĵ\x10\x8ds\xce\xc6\x19پ\x83\x82B\x0fXg\x00۱\xb4\x8c\xcaŞ\x86\xc26n7A\"\x13M0O\xee\xee8\xc1\xf8I\x84\x19G\x12۴\xb5N\xf9\xf9:\x85v\x17\xe1b\xb8\xff\f\xf3\xa8{\x15\xed\x06\xed<\xb4l\xd5fw\xbd\x95\xad\xf6L\xfb\xb6Tw!\x91\x1b\x81}\xfb\x84\xbb\x90\x88y\xa2g\x16م\xa2\xb0$\xb95y\xecB\xf0َs\xafW]\x8b\xc8'\xe9[\x8eι\x8eS\x8b\xf7O\\\"v\xf9\xd2\xfe\x8bNh\xf36\xa2_\xaa븶M㮣\xdb\xff-\xcfe\xeb\xef\x11e\xed\xfb\r\xd6\x14\x81\xb3\x01\x9a\"\xf6\xdc\xd1N\xe4\xcf\xd9\xc2\xd4֓\xcdg4rKN\xce;`\xbe\x93/\xe6Ѹ\xa6\xde\x00\x9e\xa5\x1b\xf2'\xd1\xfc\xc01\x95\xe2\xd0s\xd3\x12\xb8\xa7Ԯ\"<\xdb*%IrS\xab\xbfN\x959M\xe1n;Y\u007f\x1d\xaceS\xc92\xbb<Z/[=\xf4\x92(ŭ\xd9\x03lRQ\x17X\xc6\xedyH\x87\xd5\xe7>\x95n\xcf\x00\x8ef\xf70\xe4\xb3i\xba\xffUo\xac|\xd6\xdb\x02N\xefW\uf753\x91\xb6\xbas\xdc\vh\xf9vڗNF\xfd7O:Ԝ\xad\xd5\xda{|cٷF\x167\xa0\x01mj\x1bκ\xe9\x8c\x10\xb6ɬ? \xf5\xb9\x95\xad\xdb\xceƣ<)\xff\xd1dK\xea\xa3&\xe3>\x81\x9az~\xfbU\xbb\xec\xb3_\x96\xe1ܬ\xff\xffk3\x82\x13\x87\x0f\x88\x9b\xbd.\xdfܙ\x9b\xa8\xb2a\xf7\xe2\xbd\x05eX\xe8\x1c\xb9'/Hi\xef\xa1\xf7\x8f\x13k\xa4\xd4w\xf7\x93\xc0\x02\xd81^\xf6\x191\xe6\x98q\x9e\x0f\xf8\xaf\x1e\x0f\xff\x9f\xdcQ\xf9l\x1e\xa2\xbd7J\xfag\x87ϵe\xf2\x05\xe5\xe4\xb3\\+\xeb<\u007fϰ\xff\xfaHi\xa1\x05\xc8i\xd87\x8f\x0e\xaf\x9d\xbeo]\xa3\xd8\xe3\x10\xbeB\x8fd[ص#\xfe\xfb1\x02p\xd9\xdc\xf8\xe9U@\x00k\xfb|\xb2\xac>\x857{\xf5\xb3\x8c3&},\x0fe\x05\x9d\xb3)\xb8\x851Lo\xebZ\x9b\xdd\xf0\x19\xb8@D\x93~\xc1c\xa1/\t\x0f\xfd\v\x9f\x16\x06~M\x84\x8c\xc9\fd\xd5\x11\xe0\x9e\x88\x93\xaaV\xed\x01\x99y\x8d\xb6\x83\xee>\x1f-\xda\xfbӫOl\xf1\u0383\xd2O\xe2B\xdfYߧp\xa6\x13_\x1f\xb7\xc0\xb9\xdcuh\xf8\xa7W\x8e]*h\x997`\xdc\x1d\r\xc8\x11\x11Bg\x9e\xf9V\xbc'c\xf5\xb5\xdb.\xfa_\xfe>\x16\xb4\x00\x87\x99\xba\x82\xe5\xd2#ڍ,\xd9.\xfb\x1cG\xef6\xd7^\x17\xa84rv*\x1d\x8d\x90\xbd\xfaɩ\u007f\xb8\xf6iwV\x87^>\xf5]\rq\b\x1cT)%N\xdb\xf1:hl\xf7]_뿷v\xd0PGbq\xc0\xff\xacߴ:dl\x03\x8eZ\x11\xa3\xa9>\xab\xab\xf4a\xbd\xb3\xef\xf6\xb9ĥ3\x9e\xc1\x0f:\xf9\xed\xb8\xd2\xd4\u007f\x93H;&\xa5:\xc6\x17\xd7~\xf6m\xc4\x01\xf7\x0e\x06\xb4H5\xff\x86\xde\x14\xeb&\x82~v\x1e\xb7\x0f\xdfh\x95\xbfy\x03\xab\xefU\xfb\xaa\x82\xef\x93\xd5~\x97\x12\\MI\xb9\x8f\xab\xb7C\xba\xf6}\xf7\xbc\xb5\xe5\xda\x15VK\by\x89K?\x95oۋ\xd6^1\xf9 ޚ\xfeH\xc6\xeaZ5\xfe\xfb\x81Y\xafF\xe5}\xd5\nF\xed\xe6\x94\xf2\xee\xfd6\xccR\xf5\xcb{\x1bQ\xf04\xde\xf9\x0e浐\x02\xe0\x0eN*\x9a\x0ecf\xff6\xaf\xe3\xa2\x10\xc3#\xe4Z\xba\r\xed)`\x1a\x10\xedŘ\x96\r\n\xf0\u007f\xd25I@\xe2\xf6\x95\xc2iK?X\xcaW\xbf>\b\xefk\x0e\x06\xf8^\x8d\xa1\x8dǘ\xf7\x00\x0fu\xeb/f\x05\xe4A=\xe4\xd32\x1c\x01\x05\u007f\xf8\xa7E\xa2|\x83\xfe\xd9-\x99:\x10O}\xd7Tl\xbc\x05\xa4\v\x9d\xc1\x10x\xe9G5L\xb4\xe6'\xf69&\x1e\xbc\x9f:\x18\x04:\x81m\xef\xbf\xd6\xfe[\xf2O#+[\xd2\xeaM\xa6\xb9\xf7!a\xba\x05\x8f\xff??ji\xef\xd6\x14\x0f\x0fë\x9c\xaa,k\xebC\xac'\xb1\xbf\xe5Zl\xecB\x9a\xeb(E\x80\x8e\vf\\\xab\xa5I\xb7I\xa0\x1bl\xeeŜ\xd0 \xfa\vrϲ\xfaOY\x915ԃ\\\x19\xd2@\xddB\x05aU\xfb߶%,\xc87xs'ϳd\xe9D\xf3/\x00\xecW_a\xa1\x91\x00\x82.\xb6\x0f\x90\x10\x0e\xc4\t`\xff\xdc\x18\x19\xa25\xf0\xaf\xf1\xb8h')\xe4\x96\xef\xef\xf3^\x9d\xe8t\x8b\xfe\xb3O\x00\x8d\xe5\xaf@\xe3\xc9\x18\xfe\x8a\x14q\xa4Aw.\x15tӌ-\xf4\xfa\x81_\x11l\xc2\x14\x13\xd5|[\\\xc8-Ir\x1aW\xbf\xe2\xa7g\xe7\xcb\xf1\xde\xc2InI\xd4ʜU̖\x95\x9fuCWZ\xdf~ԅs4\xcb\xd7\xf5\xa2\xebd\v\xcd\xe3UMS\xa6\xb6\x1f\rI\tH\x13\xb5\x18|\xa3?}\xdeho\xbdr40\x13<\x92!i\xa2\x91\xde\xe7h_D\xd8\xeb>\xcb\x15D5\x91\x14$\x8f#Qw\xa8#\x04`}\xa1\v\xae\xe2\x02C\xe2\xb55\x82b\x11\xb9\x15\x06pE߭i\xdd|SdK\x98>\xfe\x04\xd9\x1f>\x88|\x8c~\xf7\xcb\x0e\xa8e\xf9~\x17H\xb9\x13G\xdd\t\xd1y \xab\xa6\x8d\x90\x1c\xa5`\xa2KcjZ\x96\xeb\xda<Յ\xa1[hն\x16\x00\xf6\xe72+\x1a\x05\x05:\x8e>ТyU\xae\xab\x84:N\xcb@\xb17\xd3\xf5\xfc\xec,\xbc:%\xb7\xe4\xf8\u007f\xd2\xe9\xbfe\r\u007f\xba\xad\x88\x1f\xb29\x1c&]״\xfaf\x0eWX_\x92aD\xc6h;1\x99,\xd7vp?\x8d\x02\x9e\xbb۵\xa5\xd0\xd8D\x8b\x1a\xccu\x15\a\u007f\xa9\x8e\x9c\x88\xaa\xe0m%\xb5*a\xa9\x96\x95\xcc\u0604\x15\xb9نed\x98\x0f\x96=\xcaRZ4\xd9,\x03\xed\n\x00Z\x925\xb7C\taƐ1?\xd5\"\x0f\xe9$q\xaef6\x910ʳ\x82\xc6Վ\x90}\f\xf2\rBzwl\x0e\x8c\xc8$ \xea\x97\xf9\xdc]\x80\xa7?\xfdV\xed\xa3\xbc\x9c\xefUS9\xf7,\x02=~\x8f[\xe4\xaf\xda\xc7{D֟\xcaj\x19#\xa3G3\xf8\xed\rF!\x1d\xc8SW\x12\xc7h\x05\xcaJҳ\xb1\b\x92\xc1\xa1\xa30 \x1b\xf30\xa4@R\xecB24\xb0\f7\x0e\xb6X\x18\xba\xd8\xc2p8\xd8\x1290\xd6益\x17\xa5\xaa\xd9\xf3Gt\xb3*\vZ4\xde\xe8܁\xab\xacҬ\x88\xf3}(\xe4\xa0\xde\xddD\xbd\x19ތ\xc9\x00\x1e`\x18\x8891\x1e\x93;r7!\x93\xf6:\x8fW\x99\xc4\r\x9d\x97\xd56\n\xf7i\x84\xa8\xd5\x1fA=l:S\bz\xb0\x9f|*\xf6\x93~\xec\xd3OF?\xedş|2\xfe\xa45\x8a\f\xceߑ\xc1\xef\xa3\xd9\xe5\xe5\xf4l\x10\xf0\xb7\xc9B\n?O\x928<I\xe0gzqryr\x05?\xaf\xcf..\xa7)\xfc\xbcJ\xce/Φ\xf0\x93\x9e^^&'\xf0\xf3rv\t\xcf\x17\xc0C\x11\xd3\xf4\x04S\xa3\xcb)Mf\x03|\x14Ӣ\xe3\xc4AGL\x93Kze\x934\x9bM\xa7\x97W6u\xd7W\xe9\xec*\xb6\t\x9dͮ\xaf\xae/l\x9a\x93\xf3i\x98\x9e\xdb\xe4'g\xd7\xc9\xf5\x99ݒ\xd9\xe5\xf4\"m5*\xb9d\xff\xd9\xedK\xa7\xe9\xf4*՛\n\x15\xd34\xa6\xe7]\xad\x9e\xf2f\x9f^\x9fN/\xaf\xa1\xc0\xf9\xc9\xf9Y|\n?/\xa6\x17\x12MrMS\xe4\xc0\xc5\xe9\xe5\xf5\xe95\xa7?>9\xc7ڧ\xe7\xc9\xec\x827\x85\xa6\xd3\xeb\x84\x03\\\xa4\xa7\x11\x02\xa4ה\x17\xa3\x97Ә\x17\xa3\x97ɔ7\xfb\xea\xec4\xe1\x00qzv}&\xd8y\x11q\xbc\xf4\xf2\xfa\x82㽜\x9eE\x97Hd|~\x1eq\f\t\xbdH9\x93SzMӋ\xaef'\xa2\xd9\xd1\xd5\t/p1\x8dY\x01dY\x12S$:\xb9H\xa7\x149@/\xce\xcfC\x84\x9d\xa5W\xe9i\xc2\u007fƔ\x937K\xd30\xc6V\x9dF\xf1\xe99\xd2ty\x96\x9c]\"\xde8J\xaf\xaf9\x8b.\xe9u\x12\"\xc0\xf9\xc5t\x1a\xf1\x8a\xaf\xe3\xe4J\xf6k\xca%?Nc:\xe5\xacg\xff!,<w\xc69;\x95;f\xff\r\xc8\xc4\x18\xcb\xef\xd6q\xd1d9\xddG\xb7\nXP\xaew\x13\x87f@\x88\xf7\a`{O=6\x85\xab\xa9܁\xb5YT\xb4^\x94y\xba\x0fZ\t\xecݑѹn$80\xa3!\xd3{\x17A\"\x16\xb0\x9e\x1b\xdf\xc3ܰl\x1e棸r\xaaG\xb5\xc9U%\x96\x17\xb3b\xaa\x06B\x1d\xfd\x12\xa7ٺ\xee\xb8\xc9\x14\x90\x8a\xad'\xcau\xb3\x130f\x18\xeb&\xae\x9ao\x8ay\xde\x15\xea\t#\xaa\xd7\x0f\xf37q\x95\xfce6\xabi\x13\x90\x98\xd5A\x8b\xf4\xe0\x82iLn\x89\x17G\xe4\x05\xab\xfe\xab\xaf\x88\a)q\xc8ɉ#\x8e<\x8d}\xf85$qȌ\xab\x19\xa4\x91\x17h\xf5\xfc\xfc=yI\x06ဌɀ\x8d\x81D\x06bI\xcaڃ\x12\xb5L\xaa\xb3\x02\x93\x92Ȁ\x8a\x18Td@E\xb6C*\x8d\xc9\u05f7Z;~\x8c7\xe4%닗d\xf0c\x18\xc0\xc3\xc2\x119\"\x83o\xd4O-\x95\x84$\n\"\x82\x80ý!1I\xe0\x0fU\xa9P\x95\ne\xa9P\xe2\xdf\x17\x12\x93\xfe\x03\xd8\xf7\xa5[\x01\xb5p\x86\xf1\xd4笿\xb4\x82\xcfYg\xf5\xe1\x84ggg\x90\x1e\x11\x85$\xb2\x90\xc0\xf7\x0f\xbc\x81f~(\xf3;٣U\x82\x1f\x88$\xb4\x90\xe8\xac\xfb/iO\x18\x84\x03\xac\xb3\x15S@i\x03\xa2\v\xe9\xf7*=\xd05\x81\x01\xf4\x17\x95\x1ehZ\xc0\x80y%\x93\x039\xda\r\x80\xefx\"R\x16W\xc9ȤI\xb9\xf3\xad\a\xf3\xdb\xf1\r\xf8\x80\xd3\xcaK\xb7\x8a\xddL\xc0ZVރ=Z\xe3*1\xee\xab3\x82\xcc\xf6\x1fN\x90V^\xd4\xd6b\xe9A\x04\x19\xbc>\x9c\x1eU\\\x1eϷ:\xef j\xb4n=\x9c\x16jt?\xb1d\xe4 :\x12Z4U\x99\xa5\xee\x80\x04\xe2\xbdso\xf7\x04H\x8e\xf6\x98\xfc|rLN\x02\x02\x93\xd1>3\xe0\x8e\xa9\x0e\xd09\xe6;\xab\xddw\xda\xe4\xe3\x93\xe7\xa4\n\xb4\xa9\a\x12\xc8\xc4\x15o\x81\xf3\xcbp'\xe8Ր[\xbe\x8c\xff\xf9{l\x979_ݒ\x13\xf2\\ΛC\x12\xd1\xe1\x85f\x8a\xb0\xa5\xfa\x0e\x1b\xe7a\x0e\xee\x15\xb6:\x13\x96\x8e\uf888\x01}/=-e\xd5z\xd6\x1b\xdd\x02c\x1d\xfe\aH\xc2\xc5\xf7\x00\xf3\x87I^\xd64\x1d8྅\x1c\x0e]7t5Ġy&쫆\xae\xf0\x92\xba\x0e\x19\xcf\x1aZ\xb5\x01\xbfa\xc9\xfcA\xed\xb8\xcej\x03\xe0\x0f,\x85#\x81\xdca\xb9\xa2Š\r\xf3\x97\x95\xf0\xb4s8W\x1b\x00Ro\xc2t]\xa495a I<\xe3\x8d\xcbc\x03\xe0[\x9e\xc8k\x130\x0e\xc2\x04\xa4F\x9b\x84v\x91'\xe0u\n\x97eQ6ea\xd2\xf8#O\xd4߲s\xf6\xff>\xef\xda\xe16\xeb=\xddb@\xb4\x1b-\x11\x89$\xb7\xe4\x98\xd3\xfb?\xb8\v\xf6\x9en\xb5\x17\xed,\t\x04.\xff\x81\xbe\xcf(\x98\xc2`\x92\x9f\x90cr\xca,s\xf8'D\xaf\xa0\xabĉ(\xc1AOv\x968\xd5K\\\x18U]\xa8\x05\x15\x1fh\xa3*N3\xb7\xb7\x8a\xb5\x82\x8fEk̉\u07ff@Y\xaeM%6\x98\x84\xe0k\x03\xbbq\xb4\xa1\xfcS\x03\x8c\xb9Z\x86\x8f\xad\t\xb75\x94\rK2\\\x89\xad15\xaa\xe8\x03\xadj\x8bP9\x94\x1c\xe5 \xbd\xbb\x18\xa25VG4ޭ\x92\x18\x94[%ihv0\x9c\xd7dat2\\b\x03\x86×b8~j\x80\xa6т١\x05\x1ej\xf0\xa6M\x81\xf9\x91\x05\x1fi\xf0\xa2C\xe1ck\x02n58\xc38\xc0\\\x8b\x8c\xadN\x866\x83c\xa6E\xc36\xb2f&\x1a\xdb\\O\x16e\x95\xf6.n\x01\xc2q\xbe\xab\xc6\x03\xf7p\xb2\x98ϱ5lw\xf0\xc3\x02\x01i\xda\x10M\\\xcdae\xe98N\x80\x16z=Z1s\x9c-\xa9\xebQ\x15\xb0\xef\x88\xfd\x85\xd5e=\x8aC6\xc3{\xf4\xdd:\xcek\x8fa\xf4\xc9K\x92\xac\xab\aj\xc2\xe3\xcf\xd0'\xe3vn\xc3~6\x90\x8b5\x89\x04ȃ\x9a\x1a^\x13\x96\xd5\xf2\x15f\xbfm\xe7K\xa6\xc9f\xd74\x9f\x05d&\x0eX\x19\fT\xe7\xd1gx\xd4\x02\x819\xf7\x98\x05\x85\x02\xacg\xaa\xf3\xf6\x99\xc3)\xd0\x01\xb8\x87W`\xff\x92V\xaf\xa9\xf3r\u0558T\xea\x1eD\x1c\x8eI\x1cj\xdfјđ\xfa^\x85crG*a\xebHO\x80L\xe1\x8e\x00\xa2]\xbeXE\xed2Q\xabL\xe4\xf3݀\xae3\xc9\\x\xf4g\x02\xd5\x18\x191\x9eޒ\xe9\b=\x1e1\x13\a\xfc\x8e\xdc\a\x9c\xabī\x02\xb2r\x9dO\xe1kJ\xb5P\xd4V\x94G^L\xbe\x16v\x9e\xaf\xad.W\xcejP\x0e\xab0 \xab0 U\x14\x90UԮ\xef\xff\"!_\x0e\xaf\"{\xfd\x89\x83S\xa9O\x18\u05ef\xf8\x88\xc5qie\xfe\xca\ake\xadG!\xf3\xf3.F\x01\xa5\xd2ׇ/n*c\xcdW\xed\xb3܃:\r\v\x1e\xa9\x90\x8cz\xc2r\x0f\x8aʥ\x9e\xc6\U00043a50=r8\x15XT\x9eXк\xf6p^\xfcf\xcb\xdf.\x8a~\x8b%p\x9b\x16;C\x9fR\xd3,\x9e\x97\x1d\x9b\x9cr<\v \xc7ĺ\x02\xa5\x0e\xb2\xd3:\x81\x17\x90\x15\xb3`\xb1O\x1d\xb9K\xb6F^\x85\xa3-S\x01\xa7\xa3-_:Ý'\xd0\x1cJ]o\xc6d\x15\x8e6J\xbbn\xc7d)\xf4\xa6\rx\xda\r\xb8:%2\x14\x0e\xabg\x05+\xc8UU\xbe\xe5ת\x9d\xd3\xfc\xea.\x9c0\xa5\xf7-~D\xf0\x81\x9a\xeb\xeeD\xff8\x9d|>E\xa6\x88R\x00\xa2#~\x96y\xfc]C\x9e\xeeP\a\x9bϧ\x0e6-\x176\xaf\xd6|cQ\xd0\xd2V\n\x9bϧ\x14\x0e\xa4\xc5`\xe6\xe1\xf4\xac,~\x13\xb3w6\xfb\xd0\xe2\xc8s\r\xc4\x1d\xab\nm\xbcZ\x05=\u007f_\x99\xd1\xe1\xde0\xc06\x97\x0ee_w\x18\x02\xad*Ϣ\b\xd7@\x8a.\xcfd\xaa\xcf\xcca\x9b\xa4\xfd\x98)Nr\x89C]Z\x96~\xeeI?\xe9ĳ\xeb\xedrZ\xf6kC\x04\xb1t\xa1\b\x1bǛ\x880p'̓\x91\x1dt\xed\xe7\x8b\a]%\xf0\xb7Y\x95\xe4\xd4\xf7\xea\xec\xbd\x03\xbcu\xeeT\xc5ۑ\x18~\x85@r5n\x84\x1a9\xaf\xb2\xf7|d\xe3\xf7\x88\x17\u007f\xc2\xc8\xe4\xc1\x1c`\\J\x1a:G%Vgt\x1d\xa7\xa0\xb6\xf6k\xf7\xd7S\xb2-Dk\xeb!\x14\xb4rlg#\xef=\xdbŘ@\x0f\x8d]\xddƝjUY\xd7\xdak2\x8c\xbc\xb6\xa7\x19\x8d\xffwU\x03\xf9䘜\xc3\xc4\xe7\x9cy\x86\xa7\xe0\xbb\x156\xf9\x10~\xfeY\xfd\xfc\xab\t\xf4gi\xb8\xff\xd5\x02\x97 \u007f\x95 \x16\xb0\x89d\xe8\x02V\x15ɕd \x14Ų,\xd2\xfe\xa6o]m\xf7N\xc8sK\x8a\xe3\xe24\xf4\xd9Bi\xc3\x16\x95[g~\x8bYb\aq\xab6\xea6\xb8\xe16\xc0u\f\xaei\xb6r\xb2\x1e\x1a\xf9V{\xeaw븢\a\xf6dO/\xda=\xf8\x83\xb9\xd4\xc2D\xe2Z\u007fY\xc5]\xcc\x1f4U\x06>\x9baZ>\x16\x83~\xaa7\xaeN0uŻ\xaa9e\xecg\xfdUml\xf6C\xb6\xb3\xa5:\x8b\xf5\x1e\xd0:\xc6༖\xde٢\xf5\xea\xb7n\xcf\xd0ݠ\x8e\xf6X\xcdѼ\xd8\xda\xec\xc2\xf4tm\xab\xe8ztO\xb7\xb5\xe7;U\x11\x12\xa9\xb7\xf4\xd4\x0f\x1c\xc3B\x804qᝆ\xda\xd6\xcc1\x89\xaeB\x9d\x92x\x93\xd5\xfd\xc7D6Y\xed\xcd\x15\xb7\xe7\x9dO\xf2 \xbdsn\x91\xe0\xcd\x1f\xed:\x9b\xba)\x91ܳ*ٿ\xe2\xcd\x18yq\x85\x9f\xb8\x01\x10\xe3\x8bo\x8bAJ\x00e\xbf\x11\x13\xc3\x1b\b]\x04\xa0i\xb9\x8c3\x8ce\xa4\xd0#\xb8<\x14\xab>\xde8+\xe6\x80\xed\xa4]$\xe0\xb5\x1a^5\xaf\xc1lz\xbd\x9e\x8a\xd6\v\x9f\xc2&\xab_\xad\xa7i\xf6\x90\xa5TGͩ\x96y~ J\x93[2ׂ\x02\x0eFˬ(\xab\x81\x0fo\x14y\xa2\x8a\x80\x93\xa3\xca}\xc7\xe3\xf9\xf1O\f\xda\ao\xba\xc1#X\xb016\b\xc8`>\xf0!F\x807\x80g1Y\x12T+\xaa\xc1g\x14\a\xe5*N\xb2f;\b`\xb3O\xabFD\xd0\x1f\x99\xc1\x1c\xb0\xceM\xd6x~\x17\x0e\xf1\x1a\x8aB\xf6\xef*R\xbf\x03\x9d\v\x8fC\xd8l\x86\xcd\x05\xaflF\xe9\\r\xb2hΘ\xb1\x8a\x9bE\x0f\x1b:yp\x18\x03zZ\xdf\xd9t,\xf5+\x83\x9c\x95\xd5\xd2\xe4\x04\x1c~\xe5\x82\xc7\xc4\xec\x178\f\v?ق!n\x16-\xc1\xc2\xd1$\xb8uGB2᠂K\xec\xb7\xe4\x12\u007f\xc5N0Ȓ!\x81\f\x11t4\x8deY=\b\x14FLn\xf1\xf0o\xb9ڂ\x80\xb0\xafP\\\xa8}\xf3&Y\xc4U\xf3\xe6\r\xb3\xa6\xb1čy%ZAܶ\x01D\xbf\xcb&\xc0Xp\x0e\x83\x81\xdfW\x0e.<[\r`\xb8t\xa9\xc2\x12\\?\xf2\x9a\x02\x80\x92LQ\x9d߂\xe3W\xb0A\x98D\x1e\xd6\nW\xa9=\xa5{8pw\xd5X\f\xa1\xfa\xaa\xb6\x1bU?fM\xb2 ^Yep/E\x8b\xfd\x92\xc45%\x83i\xd94\xe5r0\xd6b\u0098\xc1\x8a\f15\x95\xe1\xdfn\xec\x90G\x1a\x97\xa1;\xb6'\x03\x14\xf4\x1f\x99Bb+\x1a\xdfY\x86\xb7\x02\vmX\xa1\xd0\xdf\x17\x85\xec\xb3V\x81\xf8\xad\xb3\x80\xe4\xb4(0\bԝ\\\xa3 #\x83\x1c\x01\xb2\x9f\xe34\x15\x171ͺ\xf7\xa2\xbd\x87\x14\xb3\xbcQ\xfc\xe9\x841\xc4\x1cK\xca\xd0\fF\x97\x11]\xcaa²\x87q\x91,ʊe.\xb34\xcd\xe9\xc0¡ƾ\xc0\xc4`\xf1\x94\x1bSH\xdc\xc1\x066\x14v|\xcah\x835H\xf8g\x05\x86\xae\xb7\xbfZ`Veӊ\xc6\xf7\x1d\x0fq\xa1\xa46\xe5ꋊ\xe9\xf03\xc8\xe9\xf0`A\x1d\x1e*\xa9Coo\x91x\x82\xb0\x0e?MZ\x9fN\\K`\xc3/)\xae\xc3=\xe5u\xf8)\x02\x9b\xd3Y\xf34\x89\xfd\xfbN\x89\xdd<]b\xadRz\xef\x87;$vs\xb0\xc4n\xbe\x80\xc4Z\x04\xf45\xc0)\xb1\x87У\xc9v\xb8SŞ\x9et\xcb,-\xd2\x03\x04֖\xcf\xc0\x96\xe2?\x87\u007f\xb5\x05\xf6ϟ,\xb0U6_|Y\x89}\x92\xc0~\xa2\xbc\x1e*\xae\x9f\xd5\x14\xf8DQݛ\x96\xcf&\xa7\xb0}z\x80\xa4>UP\x9f$\xa7\x1f\x8d\xd0]\x9a\xc7\xc1\fj\xa8D\x0f]\xf2\xba؊ՈQ\xa9&w\x9d%\xa2V\t\xb6r\xdc\x17\xde\x18\x10{Re\x0e\x88\x03+ڛ6G0]pem\xe4\xe2\v\xefb\xfe!\x86W\xcfa\xb7w\xa3{\xa1R;\xf4\xa9p\xd5Ca\x96}Dҍѡ7{w\xd6\xe6\x90~ڸbW\xb6va\xc4\xd5r\xc7\xe5d\\%\x91[\xb9,\xb2\x060\xb9%\x17\x96>RIb\x14\xc8\x04>6\xc9-9\xb5]P\xb0[\x1e\xc1)Rݵ\xc6\x03\xb1h>)˳\x04\x91P\xf0x\xde&\xab\xe5-\xf9'lȰ\x92rG\x86\xa3\xb1\xb7DY\x1d\xe6\xc9\xfc\r\xbc_řtx\xadXTޛ\x10\x88\xf6\xabWx\xe3\x1c\xde\xcc\x1d\xdb_\x06\xebUD\x16\xabCd\xf9\xbd\xa9\x91\xdd\xf6\x84-9Y\\\xa7G\"ܟ#\xd2U\xfa4\x1a,ק\xe1o=\x80\x8aW\xf6\xc6`@\xb6\x01y\u007f\b%r\x90\xddh[\x05\xae\xb0\x8fd\xa8B\xc3أ\xf3hc\xe4h\x83\xb4 _\xc3{\x91G[\xee\xf4m\xd5g\x8ea\x06\x1f2x\xf5\xa0U1\xe9*\xba\x93?J\x15<\xad\x9bxy\x9dT\x85\xf2\xe8\x80~\xd2\x14\xc9\xd3(\x91\x18tZt\xb4\xbb\xa9\xb1\xd2\xf53\x00Ӫ#j\x8fz\xb1\x9fA|\xe2\x1eG@\xa6\xf3\x96\x03u\x1a'\xf7\xf3\xaa\\3;\xddt\xa2\xce\xda\xc0t\xd3Т\xb1\x01\x9b\xf7-\xc0\x8a\xd6\xd9{*\x00\xf1Kwck\xcf\xe8ͅ\x9b\x18\"\xa3\xd0j\b\x01^\xc0\x99\x19\xe7\xf9\xc0\x1f\x95\x857\x80\x93\x11i\xf9X \xab\x06\x012\x04\xac6\x84\x80\xe3\x11\xf0\xed\x02Q\xd5M\xe7-gpE\x93\xa6\xedI5\x18\xc3I|\xc8\xeal\x9a\xe5\xe8\xcc\x1e,\xb24\xa5\x85\xcaM\xd6U\x8d\xd6$l\xae/\xe2\xac\xd2-\xcaپ5K.\xb7\xf0.\xcb\ac\xfd\u07fco\xa1lo\x8dtY,b\xf3\x10{\aocj\x86D\xbb\xfa\x1d\x88\xf8\xb2\b\xb8\xfe-\x94\xb9K'\x06Bg\xb37\xbb1\x1f\xdf\xd1ǉ\xb8\xf4\x92B\x94\xa1S\xfe\x98\x98\x89_.\x06v!\xfc\xc7]QO\xf6E\xf8\x98\xa5\r\x93\xa7\v\x91\xb0\xa0\xb0b\x84\x94^\xc90z\x8aC\xa6Y\xbd\xca㭐O|\xa3\xc9c4\f\x8a\xb2\xa0\x03\xfb\x954\xeceؓ\x91{/\xe6\vy\x1b\xeb\xb9\xf2\xd6\x0e\x8aiMN\xe7\x1a\xe7)\xbc\x92m\xb6\x13\x16,C̺1\x98\x97V\xf1\xe3\u07fc\xb9\u007fӱ4\xd9\xee\xa2d\xeb\xa6dkQ\"\x19\xdcO\xca\xdf;H\xc1\\-\xf3\xa3;\x14\xa2\x84Ӵ\xaaS\x8b\xf1%\xa30\xb9;\x05L\f)\x80\x84\x98\x89lT\xe1x\xbe;:\xa6J\x86'\xba[N\x02\xfc\xa3\xd6\x00p\xd5\xe8\x0f\xf6j\xc4\xdf\\\xad\xd05\xb6\xd6\xe5X[8љ\xea\xd2\xf3\xc1\xa8\xf8\x9a\r\xd5`T\u007fm\fY)(\x88)\x82\xc6\f]\x88\x9d\xb4\xfe}?Z\xb7\x06\xad\xd1.Z)\xa7\xf5ѤU\x89\x92$62\x89\x8d:\x88UӇ6\xb9\xca\\\x98\x91Z\xb3\xaeqb\x0f&\xb2Q\xb2`\x92\x9f\xfe\xaa\a*\xeb\bV&\xce\xf8y\xe26\x8f\b[\xc6\xf8;\x96'\x04y\xb6o\x87̖\xb4\xdd\xd3-\x9b+=;跤\xe9\x9en\xbf\x85X\xae\xb7\xe4\xf4\xa4\xfdlγ\xb4\x8a\xe7sx\xfa\xd8\\\xe2&\xe2\xc1=SS\xf2U\xcd<+@\x10n\r\xc1p\x82E\x16Xd\x81\t\x02ȭ:\xecb\xf9\xeed,\xb8o\xe3\"\xa1\xb9\xe7w?į3f\xbdړ-\x10qU\x92qK,>\xa9\xf6\x1eu\xb7W\xb5\xf6\xa8\xbb\xb5Z[ÛOk\x1f\x88,N\x11\x96`\x8a\xb8v\\l\x03\u00a0\xd2\xf6\xa3ƌ\x1f%\\G2\x9b\v\xe5yk1\xdfj*\x02D:\x80\xdeLs\x9e\xe8\x100\xa33\xe2\xbc\xf97\xba\xb5%\x10J\xa3\x18\xfaJ\x1c\uf227+\x1f\xa5R\xa1G\xb8\xf7\xc6Ӈ\xbc\x01\x12!\b\x99t\x89\xb4\xec\xbc#O2\xe2\x05\xaf\x9eU\xd0'筲\x91V6\x9a\xf8\xb68p\xc7T\xc7P3\xf9\b\x13TV\xcc\xffƄ\x95\xf5h\x84u\x04d\x13\x90зm\x9f\xf6\xf4M\xa4\x1c4՚\xee\xaa\xe6\xef\xadj\xb6\x01\x89\x9c\xd5\xfc\xfd\t\xd5\x00\x8c\v\x99\x85\v\xe4\xe3\x8d\xf5\xb8P\xb3]\xd11\x19p\xdb\xdf\xc8Z«Cr\x98\xbd\xe4v4\x19\v\xdbw\xd0\xf5\xe0V\xd783x\xc0ODe\xf6\x90\xeb?\xcd\x02\x81\x8c\x84ǘ\x87+\x12\xde\u202cJ\x19\xbc\x9aKR6\x91ǥ\x95\xe0f\xe6L\xc6`\x96Y\x11\x90e\xbc1\x87\xb4{\xbcU!\xd3¢.þ\x8aXN\x8d\xde\xed6\x80\xea\xb7ef\xc4\v\xafB\xe1\xbc\xcf\n\x0f.Ӂ\xc8g\x13\xdf߇\xa0%\xc4i\xf0\x18\xd2#E\x98O\x8e\x8c\x93\xd4N\xe7-C*\xf4\x82ƽn\xcaN\xc8s1\n\x81\x8dˬ0\xf7\xe1\x18F\x89\xe9\x05\xe4[\xca\b\xc9]f\x859\xf6\x91'.\xb6:\xe8\x16X\x9c\xe0\x1d\x03E\xefqx\xe5\x83\xd5\xf8\xe1\x83)\x18\x90\x1e\xdbk\x03\x80\xf8#\x9csrL\xe3\x06\xe2V\xcb\f\xf4\xb7\xa6\x90){\xc69\xc0\xdd3\x15\x05\u05fa\xa2O\x9b\xbe\x0e\xf0\x0f8-\xf6'/\xb6\x94\xcfd0-ӭc\x15n\x15xT\x0e\nF\xba\xf4>\xe0k\x1b2o\xbdr\xe4\x80}\xd7Q\nm\xbf\"udq\xfbΝcW\xa4(u\xe8M]k\xd2\"\x1d\xfcΥ\x06\xbb\f\x91\x8f\x9a\xc7R\xde{\u008b \x00\xff\xab\xba\v\xa5\xa2\xec\xf3ٝ\x9b\xb0\x1c\xf2\r\xd3j\x90^Τ\xf1\xab\ai\xb3\xbcY\xa2\xb0\x98\x98Dq\xacq\x94\xc6\xcdz\xe9i\xf9\u007f#\xb7\xe4\xd9\xf1?\xbc\xe2C\xed\x8b5\x98ȃ\xa7\xc46\n\xf6\xef\x1c\x96~xt\xc3n\x03\xddd\xd3\xeb\x05\x8f\vM=\xe9\xc1\t\xb8\x86\t\xb8\x16\xd7\r\xb0Ҹ\xf4\xcdx\xf8h4\x12\x03H\xfb\x1d\xd2%\x87\x8a[\xc4D\x87v\x89\x99U\xbc%kfy[\xde\xf8\xb7K\xe4\xe0\xcb\xd7C\x81\xbb4\xbdò\n\xf9\"Rf\xdd\xfc\xaeǖ\n\xf9*NfI\x99T\xf1\xf2U\xa7\x99\xf32e\xea\xf6\xe8\xf8\xb1չ\x01\x81\xe8+G\xc7\xff(\xec,\x8d\x18\x11k\xe8N\xeaC\x98\x83m\xf2\x03#{k\xd3K\xda\xedӹ\x81\xf8zY\x808\x1d\xednY\xd0ҔD,\xa3:\xcf\x12M\xc5\xf6(XБ\xfbjXC\xdb\xecV\xa3\xfa\xc01\xf3\xd44\xdc\xd2X\xba\xbe\xc2-\xfb\xdf\xd9\xfa\xca1\x89\xb8\x15\x98\xda\x1a\x85L-\xba\xf8\x1f\xb3z\x157\xc9\xc2\x03\\\x81Q_ mLMm\xfa\xb87\x8cۘ[\xf9\x8b\xfb\xbdՑ\x11(\xf0\v\xa6j2\x02\xd2\x04\xc1sB\x19\x8d3$\x13\"\x01p\xc2F\xb2q\"3\xb6\xa2\xf7\xdep\x92\x136+\xfe^mX\xf4\x91\xf9lC^\xbc \x11\xf9@\x9em'\xd6\x1e\a\x00\x1a\x9b\x1cH\xdd\xf6I\xd4m\x05\xf6헥N2\xddE\"\x93\x87M\x18\x90M\x14\x90m\x18\x90m\x14\x90榟~%\x9f\xef\xe5\xf8\xe46\x96\xb4\xcaz]\xb6\x1b\xb6\x18x\x8f:\x90U\r_-o\x02Ԯc\xb7\xedQ@\xa3\xebS\x8e\xab\xdb\x1d\x03\xb4\x8c\xb2\xe2\x81V\x8d\x8f\xe5ŧ\xb7\t}^^%E\xbe\xa3|D^\x90M\xe8CX\x1a`\x1d\xa0\x89x\xe1f\x1f\x8b\xd6Z\xe7o%?\xd8bh+\xf9\x11\x1dƏmh\xcd\x18\x1cW\xb7\xdf\th\x91\xfc\x80\xf2\xe2\xd3\xdb2~@y\x95\xe4\xe2ǖ\xf1c\xcb\xf9\x01\"\x04h\"^x'?\xc4X\x05[\x83\xbc\x04հA4\xa0\x1c6\x88iB&dL6\x00\x80\x02\v\t[V쎋\xae\x9ae\x04zk\x01\xe0V;\xba\xb8\x1b\x92*\xe5T\x13\xd2\x1b\xbb#\xb1\xfbC\x05\xb5\x89\f\xb9\xd3I\xe0\xd3>ʍ\x91\x11\x89\x8c\xc8\x1a9\x96\xb4\xeabj\xca\xe7a\xb2i\x98!\f\x16\x9f\xf92\\ܬ\xa4z\x19L1\xb5%\xc1[ŧ\xad\x9bO\x1b!]\xa1\x12\xcbmd\x80\xd9|\x82\xc9\u007f\xeb\xe0\x13fX|\xb2\xa5X\x17_Sn\x0f\x93Y\xc3\x00c\xb06\x9f85.>\xed\xd0\xcb\xf0f\x8e\xfb\xa4KϺ\xd5\xea8\x8b>\xab\xff,2\xc3}g\f\xb6ltS\xa6\x8fV\x93\x94[\xab\xf6\x0f\x1fpp\x9a\x14\u07baԑ\x159\xe0tT\xd1iV\xa4\xc2\x1e\x01#% \x83\x92o1\xdawµ-X\xf9JR1&\x83\xa2\x1er\xf3\r\x1dd̔\xa2\x8ffZ\xed\x80{t\xc0\x15,\xb1x\xac\xa9\x95\xccp\x16\xb4\xb6\xb1R't\xfdhAw5\xe6\x17i\x040\x8d5(`\x9b\x1c\xcem\xb2?\x8f`\xab\xe2_Lſ\x8f\x03\xd4k\b*\xbf\n,\b_\x13\x19\x9bpJ\x17\xf1C\x86\x1c\xfbh\xa5\x8d\xd8\"\xa6?\xecL\x15\xcf=\xeb\xddH\xf3\xe0\x02\x83\x18\x04D&\xb4\x8e-\xb4\x00\x9c\xbbR2۵)\x05\x16|\xdb\xf7\xaf\x02ۨW\x1d\xdd\x1bQߧ\xe6F\xd4\xca\x1f\x89W\xc3E\xd3W\xce\x1d\xf5\x95\xf1\b\xce\xed\xad@\xa7o\xa7[\xbbX\xab\xee\r,Ɗ\xd66\x06\xbe+e\xb7CZ\x8f\xac\f8\x94nZ\x8d\xe7l\t\xf0t\xe7J_\xa9\xbd\x01%\x9dn!=\xd2\xd3uU\x8c\xde\xeb\x0f\xb7\f\xc1\a\x92n\xed\x85\xd9\x1bV|\xb7ä\xcf\x05\x83\xbd\xafۄc\x11\xcbG\xee\xb7\xe8\xd9۱\x88\xee#w[\xf4\xect3&\xe9\xc6HَI\xbau8x\x9cܷ]s\xfd\x84w\xba\x8e:\xdc\xfa\xdd\f\xb26\x81\x84[\xe9\xd6p\xb4\xf8\xdc\xe9\x96\xe4Yr/\xc6\r|\x04\xe0\u007ft\x1e\n\x80\"J\xc85\xa7\bC0\x84\xc3\xd9<wL4\x8f\vG/}l6\nZ\xa4\xdd\x18\xd6+\xa3|'ˁx\x83\xe1\xdd,r\xb4݉\xbc\xcb-\xb7\xd3\xd9\xd6r\xde\xe9}\x11\xc86\xeaY|\x13\x1b\x1e\xa9t\xee{߅\x13MC\b\xefW\xa0\x8f\x1f1H\xc5VQ\xb8\x97o\xec\xe9=*\x94̧\xf4*\x17~K\xec`\x13\x15\x1af8\xbd\x84爻ax\x10\xedV\a8}M\xf8s\xb4\xb1\x14\x17O\xde\x1az\x8b\xd8^\xa1\u007f\xba0\xa2\xb1\xef2e\x9f\xf1\xf6\xfa\x9dB\xd8\xe5\x97a\xecp\xbae\xf6\U00035c32\x81Ѓ:\xaa@\xe9\x18_s\xa4*\x1b\x90\xe5\x8ed\xfa\x93\x8eg\xcf\xd5N\x87DԊ\x94U\xc5\xf3~\xf3\f\x9b\xe0\xb4\xcetc\xe2}Y.{\x8d\t\x06\xd0oL0\x88\xb61\x01\x9f\x8f\vJs\x03\x00RlG\xb2\x0e\xa0\x1c\xc1\u007f\xfcˏ?\xb2\x94WIU\xe6\x9dX\xd2i\x8e\xea\x87\xe7\x8b\xef\x96I\xc3\xf3U\x8a\xed\x8f\xd6\x01\x1c\xee\xe8vy\xa7M\x94\x97Il\xdb&2ؼ\xc7\xe7{y&\x8b\x9f\b\xe0۶\x1e\x9f\xf6Uv\xa4\xb2\xc9\xc4Y\x1f\xaa\xaa\xdcQY\xce\xeaz\xce\v\x1f\x19u\x06$g5\xb92Ր\xb5\xa3\xdd2\xd0_KO\xbb\xe8#.-ȍMH\xf8N\xac)\xb4MN=\x83\xad1k\xbf\xe3A`Aǯ\xa5\xb7\n\x88֬\\\xaae5\xbb\xe8M\x82MZ\xe4n\xae-\xf1\x8d\x86\x1dI\xc3*W\xcb\x1b;d#W\x010r\xccQ\xbb\x89|\xb2\x89DДM\xc8\x1fH\xb3\x1er\xdc8\x0e\xe1y\x9b\xaeNW\a\xea\x00\xcb&\x14KeC\x81o#\x9fle\xcdێ\x9a\xb7\xae\x9a\xb7]\xf2dռm\xd7,\xa7\xcfU\x85\xea\x91\xce\xe2u\xdeX\xaa\xb7\xadyq\xa4\xa8\xf8\a\xac6\x1eqF\xa5J\x9a\xc6\xeag\xff\xe1\xc2\xdeՆ\xd4\x1d\x86\xe1\"\xa6o=x\x85&a\xf6!6&p\x9a\xe5l\x88\u009bn\x83\x89\xcfí\x05\x0276wYO-=\xd8ޭ\xd53\x1c\x06\xada\xeb\xecc\xa6r|.3\xf5\xb3\x99\x83.\x9a\xbf\xb49(͵`\xaf\rL\xf7\xbcc1\x9d\u007f2\xe1 \xb7J\xbd۲#\xdb\xc8\x1f\u06dd\x95ɺ\xdeo\xdb\xc7\x14$\x98\xdb\xec\x8bURjC_Ip\xe8\xa6g\x91Պ\x1a\xa1\xb5团\xf8\x16\x89\x98\xff߰F\xfe\x91\xe6M\xec\xf9\xe49\x19\x85\xe1\x89/\xa6\x85\xb6\x9a5\x86\f\xde\xe2\xd0\b\x93M5\xc6\r\xf4\xa4\xfd8Kw\xeb\xad!l\xb4UYY\xb6\xd2\xe6s\xbe\xf5<\xcdJ\x8bd)\xe85\xfap\xd5b\x93\x94\xa8z\x91͚\u007f\xa3[\x11\xd7\n\xce\xe4q%F\x9e\x93\x13'sV\xba\x02y*\x1b\x94\x81a5\xc7\x15\x81S4\xcb~D\xdbhW(\xae\x91\u07b8\xb8\xfaOy\x15\x94\xe3l\xbf\x10b\x9c\xbfT\x85\xef\xf4׆':c\x9b\xd6i\xf7n\xad\x01/\xbc\xf3\x9a\xf9\xed2\xa6\xbb\"[\x9b\xf2\xf7\xbd\x19d\x93-)yA\xce\xc3\xd0o]\x9a]\x89g\x8f\xf9\xae\xa6\xd1\xe1*ü\xb0+L\x9bV\xef\xf6\xf6\xf0A\xbdl:\x00T3\xe0\xe1rS?\xba\x04\xc2\x1a\x17\xbb\xe4\x01\xc2K\x1bl\x80\x04\xd5u\xabP\xef;\xbd/V\x91V\x94\xd9\v\x96\x03/\nH\x1eY\xc8\"\x172\x82T0\xab7D\xe7\xd1*\xd2N\xc0\xaeBt\x19\xad\"יל\x17\xcdy\xd1\\/\x9a\U000e2e73h{,\xf3n\xb5\xeew\u007ft\x8d\xe0\x90\xb1\xca7F\x84\xe8&\xcd\xcf\u007f\xe0؆\xde\x12\xb5h\v^]}\x06Қ\x8e\xc4Mt\xfe\xefwb\x8b\xd8\xd6\xdb\xdf\x17\xb3\xacȚmн\x90e`\x01\xb7\xc8\xfc\xa0\xbd\x99,ڇ\xd42\xb0\x91N\xe8\x13\xee'\x8a\xd2-]\x03{\xb7\xcc\xd2\xfci\xbd\x9c\xd2ʎ7\xcb\xea6V\xb4@\xccg\xbe]ݺ\x18\xd9S\xebw\xad\x9d\xf9\xc3\xea\xfe\xce\xd8j7Qn\xb4H\x8a]\xbdJ\xc6O\xe0\xd7\x13\x8fbH\xfb\x18wR\xe5\x17l\x1d\xf2\x18r{\xd5\xff\xc4\xc3\x16\xb2\xfe\xadQ\xff\xf6\x90\xfa[\x1e\x10\x94\xfd\xbe\xfd)\xd3\n\xca\x1e\x82\xee\xce\xe0\x83V~\x8b\x9d\x9a<ޖ\xebFߧ\xc1\x94\x11\xbe#\xd6\xf3d\x92LϳB\x8f\x9e\x01J6n\x16\xb0\xc14\tHFn\xc90\n\xe0\x1e6\x80r\xeeI{s\x91\xe5\x94xGG\x19yA\n\x1fˎV\xebzᥧo\x90\x9a7H\xcd\xcfq\xb3\xc0\xea\x8cS\xd0bۄ\x15\xd4Xj5h\xf7#B\x15EP\xcf\xfd\x04N\r\\\n\b|\xbcZ/E\x03\xe1\xfb\xfb\"\x85\xb3p\xac\xfb`m[\xf8\xea\x91\x1a\x91ɠ\xef\xe1>\x01SdY@ފ&\x00q\x88P\x1e%\x13u\xaa\xa4{\\\x1a G\xbb\xf8\xa7\x9d0@\xf0\xb7\x06\xb8V\xe0\xad]\x80\x159\xba%˸\xa9\xb2\xcd]6\xb9{\xeb\xbcu\"\x19\x80\xbd\xa4߈4Z,:QrD\x03\xbcg\x15m\\\x1eӺ\xac\x9a\u007f\x85\xb6\xeb\xa4iX\x19\x80\xb2\xed\xcc\ar\xf4\xd8!\x12\x8f'\t\xbe\x8b'Z\xff\xddMu3\xaa\xbd\x89#\xa8y%\x04@\xaf\xc7li\xcb\xe4L\xadK\f\x84\xa4;\t7H\x97\x95z\xaa;\x18\xf5\xeakj\x1a\x81\x1f{\xdb\xc2D\xc73\x1fm\\\xf1\xdb\xffϙ\x10\x1c\x93{\xfd<\xdb!R\x06\xa7@\x0e\x133|р\xdcj\xdd\n\x17.ҷ\xdak\\\x98\x9c\x82\x18\x06\xe4\x81H\xc1dI)K\x8by\xd5\xf0J\x14\x88\xee\x03yN\xee\xadX6\xc8ƻ4#Gd\x00{\x10\xe9ۉ\xdc˗&:\xabmL\xd2,\xb0#J\x89\x9c\xb7V\x8e|$\xc6|CJ\u007f\xb2\xc5|MJ\xbeB8&\x0f\xeeP6\xd6\x18cD۔:\xe9\xd4i\xd9\x18\xb4(J\x8c\rLN\x06\xf8\xf56!t\xff\xef\x1c\xf4\x00OWf\xe0\b9:\xf6\x94\x0f֥\x99\x1epc\xb7h\xc8GHT\xef\xa9\xcec\x1d/}/\n\xe0\xad\x04\xc8Z\a\xdb\xf8;5\xd0h\xf2\xe1\x83\xd8\xc07ތ\x94\xd72A\x13\xa3\xea2ʽ0\x8a\x91\x97V9\xf1t\xcaX<\x1fg\xe5b\xea\x98C\x19\x99\x1fɸ\x13\x19\u007f\xad\xae\x03\x19\xfek\"\xf3\xfb\xcf\xc4\xd9\xca\xed[h/3e@=u\xdd5\xc6LI&\xe7R\xbfFӴ\x19\xd6\xe2y\xf1\xc8\xe0\xe9\x11\x89Gfg\xe0\x02ij\x83M\xdb`\x1d\x17ҙ\xfc\x88\xa9\x9e\xcd\xd6H)\xd7\xfa\xb5П\x01)\x02\xa9\x00Aݩ\xe9\"0\xf5o\xa05@\u007f\xe3\t\x11=ɾƢ\x82\xfe\x02\xaei\tl\x1b\xb8\x17\x81\x9f\x96\xa5$\x8d\x04i\x1a\xe8k\xba\x1dOR\xad>!|\x8c\xa5\x01\x14\xaa\xcdg!M\xb1\xfe\x89\x0f\x18\x89\xe2\xea\x11#\r\xe1\xe7\xa3\xf1\x95f\b>\x8dL\x89A\xa7TG\xdb&\xf6@\x1a\xbf\x15\xe5\x9eF\xa0.\xe6\x84\x18\b7\xba\x93%qj\x8d\x9d\x14&-\xea,\xe2\x14^a\x8a\xbb0\xd7\x0e\xd4ힱP\v\x13\xae\x13\xb5\xde3;^P\xe3K\x8aYi=\xba\xe5XR\xac\xd6yM\xbd\x02NWY[\x9c\xb2ػu\x9cr\xc7F\x14\x90\xcdI@\xb6'\xb6\xfb\x90\xc1\x8c\xf0\xe6\xf93\xb6\xf86\x10\x1a\x11\xf7\x002a3;\x03\x82\x17e\xb72y+\x92\xb7\x01I\x99\xe6\x89ȱ\xf6\xbcD\n\xefdl\x98\x8d\x04\x0f\xb0l[ǉ\xbc\xcd\t\xb3\x19\"\x9fe\x17lV\\\xd0&\xb6gQF̽\xact\xc1t7³?\xe61o\xa0f\xb5!C8\x11f\x99o\"{\v\xd9\xdbv\xb6\xf3\xa6\xa4\x19\xdb\xc0\xe2\xdeW_\x91\xac\xfe\x13[\x05S/-\xfc^ʡķ_\x8c\xfc\x9e\x13\xeb\xcf4\xce\xdd\xf4>\x14*\xcf\xf7i\xe7\xc2RF\x91\xb6\xbf$\xc7n\xcah\xd12\xe4\xe9;\x10\xe5QE\xeb\xf5\x92\xb6/\xf5\bAg\x13\xaa\xf2Ս\xa4\x1bq O\x97@\xcc|\x11\xabV\u07b2\xbe#Q@\"x\xf0\x1a\x8eu\xc4\xf9j\x11\adVe\xe2կ\xd15\xc4Ŀ\xffcV7q!^\xa0\xe3\xab\u007f\xa8\xfe\a-\x17a_5\x15\xf7\xb7\xbb`E.3\x01\xa0\x03o\xc9\xf04d\x86@\xfc\x80\x1e\x91Q\x14\xa0\xf4\xb2\xdfW\x01\x81\x1b[\x0fq\x1e@\xaf\x89\xe5=\xf8\x1b\xf8\xef\x94\xd7\x0fO\x8a\"\xfaZ\xe0\xe7\x9a\x03\x19\xc9ߡ\xe8\xd0E\x1e\xb4\x9f<g;\xf6\xc9\v2\n\xc3\xf3\xd6\t\xc1\x11-R\xd7!A\xc6Z\xdd \x04dc\xfc\x87\xd92\xceÃ\x8eq\xf2\xd1\n\x92\a\x8d\xe6\x93\x02>Ҩ\xbbk\x02\xf2\x0e\\\x16e@\xea\x804\x01Ʌ/C\x97!\xe2epڌ\xb0e\xc0\xf2\x86\x1c\x1d\x19\xab\xe0R \xbdӍt\xc6\xddrd\xbeCH\xe0\xf4|92_\x04\xc4\xc5)\x1eݪGڕ\xe9-$o!yk\xee0\xe7\xb06|\xce\xd62\x84\x8d@\xebz\f\xcb\xe6ݡ\xfa\xf4.\x9b\x90\xe7ă\xb2J9\xe6\xbeO\x86J\x06\xee2\xf0\xdd\x1b\x97\xbe7\xacS\x8d\x94m+\x05\xc8G\x9a<&$\xf5\xe8\x11\xe2\xea\x90c\xe25\xe2\xf7\x91L6/\xd2C#oIK\x95\xd4#X\xabI\xa4\x11\x19\x92{s\x93h\xb4e\x10VIײ\xe0^c\t\x1f-\xb6[\x89\x8d\xeb\xbbp\xa2\xbf\x9f\x84\x9d\x00\x19\x91\x95\x91Y\xae\x01\xa8\xc4\xefY3\xa2\xa4\x80@ޙ˹\x12\xdb\t\v\xd7r\xb4\xf1m>\x94\xd8J86R\x8e\xb6\xfe\xee\xd6\xe2\xe8\xb5\xce\a\xe8\xca\xe4\x9b$Y/\xd7\x10\x81\xea\x1d\xea\xbd9-\x97#\xa6\xa0\x9b\x8a\xe2\xdc^\xfbR\xa9qm\xe0w7\xbf\xaf\xdd`\xaaxz\xeb\xfd\xd1,\xdb\xd0V\x84\xdcw\xa3\x87\xac\xce\x1aOX\x18\xa5\xbf\xef\xc2o\xcfu\xbb\xbb\a\xe08\xa6\x8b\xa2\x12|\xf7\xe5h\xb5\xb1\xbb\x03R\xb7\xbd\x01'J\x1c\x12^\t3)\xff\xf7\x16:\x98\xf5\xa0\x98'l̼Ȗ\x17\xc1\xaa\xb6\x1dEl>\xf0\x93\x17Yr\xdf>\x01\x84\xb3\xd8\xef\\\x1a\xd6^t\xeaZ_L\x1b\x87\xdb\xdePR.\b9\x1a{\xb3\a*qT+f\xa8ë\x85\x92\xa2\x16\x81f\xdfj?\xe7K\x8e\x874U3\x11\x9e\xd6b\x81@o\xb8iwt\xbe*\xd9EW\xaa\xd1\xd4\"\xd4n\x80f\xb7<\xad\x01\x02\x81\xde\x00\xd3\x18:\xb8\x01\x9a)v8M\xf6`Ӑ\xed۱\xd2F;\xbcv\xd3H\x96\x88\xd8P.g\xb0UyK\x06\x02퀼$\x1b2n\xef\xa4v\x11\xa6\xcc\xc5\xc3)\xe3eՎ\x8e@\xb5o\xe5\xc2:}\xc2>6+)\xf7\xb09\x9a}\xab\x15\xa6\xe4\xe1\xd5BI\xddQ\x00\t\xf6ZvC\xbe&\xa1/-\xd6\xcd\r\xce\b҂u\x04b\xe0El\x13\x19\xcf\x199\x8cd\xbe \xe96\x937\x1d\xe1YFM\xb6\xa4\x95\xa7\xccx\xbf\xe3\xe6e\xa7Zd5\xbbm\u007f\xed\xd03\u007f\x00-͖\xb4\xa8\xe1mf\xf3\x91Kn\x963\vpZV\xe0\b\u2ffd\xcc\x17\x9b-h\xa5K i\xa9o:\\\xedK\x1f\xbb/\xab\u007f\x8a\u007f\xf26zٻ\xb7\x93;I\xcc\xc4oG9\x90\xed\x06{\xb8\x8a\x8b\x14\xce\xd5?7\xb4y\xeb\xf0\xa5$\xbau\xf9L\xd6l\x9a\x11z\x8b\xef\f\xcb\x0fV\x17oqu\x01\xfb\x06lu\xf1\xd66\x8b\xf4\x06\xb5P|\xecA\xb7t\xa2c\x9d\xa0\x16-o\xad\x1b\xee\xaa2\xb1|\x19\xc1\xf6\xcc\x04\xb7\x0f\xc4\xea\xc5\xef.Ž\xdaf)D\xe5\xef\xe9)P貉ki\x97\x05\xe4m\xb0\xd7\x02\xefQ\x19\xf6\x01Y(c>Pu\x04\xa4\xecZ\xed\x15\xad՞e\xc6f|\x17<\xd3.\xe5\x88\xe5\xcem\xfb\x82\xb3\\g\x19\xdd(\x97hF\xeaSW\x9epv\x0f\xe7\x89R\xbe\xa1\u007fK\x06\x05\x1cY\x19\xf8Z*o\x88H\xe8B\"O/\x1bH\xe4~\x95@\x82\t\x13㰶\\UrR\x85\xf9\xa0?\n^\x06$ӷ\xdb\xf5\x05\xeb\xada\a\xf4\x94::\x92\xe2\x8a\xec7\xb3\xb8L\x9aY\x1f\xf7\xee\xf5\xeeu\x03\xaa\x1d4\xeaq\xb9 \xf5 \x04\xd3}\xf4\xdd\xf0[\x80\xdf\x1a\xf0\xdbA@\x16\x1d\xf0+\xac@,!:\x80\x10\xabX4\xd8\xed\xe4\xab8Cʴ\x8e\x16V\x86aZ\xe8\\\xd8\xcd'Y\a\xf6\xdd\x11~\xe9\xdd&\xb8h\xf6\xdeǮ\xfbh\aWi\x1aN\xed\x15\x92>\xd1ٮA}\xc6ì\xde+\xfd\x9a]\xe1\x8d\"\xdf9m\x96\xab\xbdQ\x84.\f]\xf7ʵ0\xa9>\xe1@\xf6}t\xcf\xe7\x97\u07bc\xf4\xf4\r\x9e\x05m\xb6\xfc\x82\x96vy\xce\xf2\f\xfcQd)H\xfb.\xa4\xbcn\xe7,L\x8bT\x9d\xd54n\xa6\x95\x0f\xb4\xc2\xfd\x85v\xc9\x1f\x05\x80v\xde_nGt\x81\xaf\x1b\x1fe\v\xf8\xd0\u007f\xf2\f\x8au_\xbe\xe3\xbb\x1f\xab\xb8j2{\xcd\xd0\xda\x01\x91C\x96I3\xf8\vSؑ0\xcfX%\x8b,O+*f\xa9\x91\xf8֗Ġ16F\xcaV\xc0\xa7t\xd5,`\xc3\xc2ȇ\xfd\x90\xd4,\x03\x9b!\n\x0e]@\xbc\xf6\xaf\xbe\"^\x01#\x03\x13\x84yk\xdbe\xf2L[@\x92@\x8f\xf4\x0f\x15B5\xe2\x94@\xba!\xc7z\xcaX\x0fL\xdc\xe7\t\x92\x8cK4\x82@\x1b0\xfe\xb14\x8e\xf1\xb9\xe0\xe8\x8d}\x90+\xed\x1a\xdb\xf6\x16\x02㞵G\xd5\xd7+X\u007f\xf8yX\xd8͍T\xbf\x9a\x97\x06\x9cL\x8d\x15~\x97m\x1e\xa9\xf7\x17\xec\xeb\x86Bh\xad\x13Z`ms\xbf\xcb\"\xa3U\\%\x8b\xad\xae\x8cS]\v\x1bB\x8d\a\xd4\xc3@\xd9N\xca\t\xaa8\v\x87\xf6\xedո\xe6\xf6Q\x9b-\xb2z\xd4S|\xb4\xc9T\xaf\xbd\xad\x82\x18dӾ\xa8gF\xd6\xe2V!B\xf3Hr\u007fA\x95\"\x8b\x05\xaa}N\xa5\x92\xf5o\xa8\xae2\xea\xa5q\x13\xb7:\xefA\xbc{\xc4r\xcd{\x85\xf6Y<N\xec\x11\x14\xc1>^e\xd4\xeab\xb5*d\xd8ي\xf1HL\xfe\xea\xb4U۷\xa0\xf2ĝt\xeb`=\x19k0F\x1dpDO\xd4!\xcem\xb5k\x109\xdd\xf8\x05\x84O\x86z]L\x1cOG\xf5z\xe9!\xaf\x8c\xca3\xfb\xfc*\xb0\x91\x8bǍuf\x88<\xc3s\t>\x16\xc3c@\x90\xc1\xa8UB\xb0\xca諲j\xfe\xb0\xfd+W\x86\xb2Kآ\xc4\xd1%H\x18[\xba\r\xc5omQC\xc6;\x11\x00%\x8ct<N\xc8~\xbc\x9dt\xf5i\x95X\xf6\x9d\xfb(g+\x18\xb5\xa6V\x19\x8e\xbb\xd6\x19=V\xef\x98\b2\xda\xc7\xee\x98b\x93\xcd\xeb:\xc7\x17\xbb\x8f\xf1Š\xd8\xc9sׁ\xbd\x8f\xb6~a\xe4\xd9\xea\x05'\x8d[\x82\xc7\xf2\xf1t\x13\xe9\xee\xb5\xc0\x10w\bO%E\x93h\aJ\xb9\x0e\xca\xc4<\xf7\x14\xf5\x03%U\x17!\x9a\x96\x02\xcaL\xaf\v\xab\x92\xb7\xe1ig^\xf4\xd3.\xfb֧\xb3\xe4\t\xb5\xca\xe2jY\xab!܇\x02\xad\x13\x0e\xaf_\x14\x96\xf7\xa0\x15\xb2]u\x9b\xc9\xfae\x04\xf7\x98o_,\xa8\x9bؽ7\xaen\xea3\b\xa1\xe5٘\xb4\xce\xe3\xd3*;L\xd3\xe3XCM\x0f\xc8{u=\x1c\xfa`\xf8\xb1\xa2\xbdjHM\xa8\x87\xd6\xe9o\x19\xd5`c\x90\x01\x80\x01ٶ\x13\xc9\xc4y\x9c۠\xb4\xacR\n\x9e2\xf8a\xe0\xc0F\b\xfeI1\x93\xac;\x1d\xadh\xb5\\7\xd4ô\x80#\xd3L\x1c\xce\x05\rT \xb5@\x81\x14\b\x86\x02\xb4`\x18\x95\xddĈs\a\x9cͺ_\n\x93\xee\u0089LE/\x96\xe9|\xea\xf5\xde\xc1\x95\x0f\x8d\x06\x89\x12N\x93\x97\x92\xd0\x1a\xbe\x91B\xcc՟\xc7\xd1V\xd5Q\xf7\xaa\xba\xa3\xaeL\xd4ut+*\x80\x83м\x12Yk֮\xb5c)\xce$ީ\xcfyHW\xb1r\xe5]d\xa8u\xa0\xed/,\x99\xc7A\bT\x04\x1b\x1b\n\xd2\xff\x83Ve@\xf0v\x90\r\xb0n0J\xaf\x95\xfe7\x8c\xd8k\xa5\xf2\x87\x9d\xe1\xf7\xe8\xe1\xe9\x0f^>\x18\x8f]>t=t\t\xf5\x18J\vk\x16,yJP\x9b\x94V*\xa6\r\xa2\xe9\xdf_r\xf1\xbd\x1e\xcdi\xc3j\xfd\xf0\xa1\xaf_\xf6m\x8e\xe8\xbc'\xb4\xc7\b\x97.\x11\x1d\xd6\"\x1c==M\x92B\xb4g\x83>o\x18\xe6ު>oL\xe5\xfenZ7O\xaa\xac\\\xab\x0e\x02\x14\xbb+\xb43\x1c\xb3\xb3&\x8c<\xb8@\xbc\xe2\xdbg\x83\xac\xa8\xb3\x94\x0e\xcbu3\xd0\fm\xa6r\xace\xb2\x98zM\u074cOo\xc89٪\xf3\xc7x\x03\xf7y\xe0Vܲ\xee\x81\xfc\x85\xa6넾Z/\xfd\xa0\xbd*)\xfc\xfd\xee\x1c,\xe3\xcd]\f/\x91ě\xbb\xa9ZB\xf8\x01A?c\x18\x10|\x9f\x19\u007f7\xe5J\x9c\xf1\xc3\xe4\xfe\xfd\x85\xf6$\x00\xb7\\\xf8=&kk\xa0\\\x91\x17\x1c\xab\xf5\xc6x\xb9bS\x03ㇵ\xb5\xc4\xc8\xc1ݠ\xb7\xfd\xcfl\xf36\xb8\xb1\xf0\x968\x10uĒ\xe5\xf0\x15}\xa0UM=\u007f\x94\x94E\x127\xac\tb\xb6\xff\x18pQ\x03\x90N9\xd1\\\x8a\xadդ\xc2o\xa0\x1c\xa4\xa8\xfe\x06\xe3>\xe5\xf8;a\x03\xb9\xe4Z\xda \x86`\xd7Y\xbe(״i\xe8ab\xbd\xe4\t\xba\x19R\xabk\x9f(\xef\xa14Mx\x9c_Khzm\x14%Vh\x91(\xe9ʎ\x8e|4\x1c\xf8*\x12-\x04\xeb\x1c\x16\xf9\x1a_\xa5ARJ\xfd\x1a\xa6\xe8\xf6\xd2w\xee\xe0\xf4\x92\xb5\rq\xf3\xd4cx\x87B\xb2|\xfdL\x9f%9\xdb\xd0\xe8\xca\xc7l\x0ek\xd5C\xb8\xbdQ\xdcF\xd6oTG\x98\x9cf\x06V\x14\x90\xfa$ \xf5)\xba\x93\x99\x9d\x12\xb6:`\x1bb\xa0b0\xf6B\xddc)y\x10\xed\xd35u\xd4\x1e\xf9u\xd4\xd39F\xe1\x13\xfc\x17<\xc3\x1b\x06\t1\xbe6wo\xd1\x14\f'\xddv%\xe0\xe1\x97~\xebS\xd6#F\x85d\xa8\b@d\x18M\x02\xeey\xa6\x1b\xff\x86ܓ\x17$c\x88\xef\xed\x8d\xed\xfa\x14\x8eIB\xf9{\v\u07fd\x85\xcfzy_\xf7W\x9c\x80\xea9e\xf5\xb9Y\xf1\xb1%U%\xbc\x86\x15\x91\x97\xac\xf41\xfb\x057\x0e\f\xbf8J\xf7\vR\x86>\xf6\\\xb9\xaf\x14c-C\xd6\xe17\xbd2J7\xab\xb8H?]#\xdc\xf3;\x14\xc5o\xa9\b|\xd7\f\x05EM\x819f\x9c\xc4Yd\xbf\x02\xb7\xea\xf4\xec~\x9co\xc7\x01\xb7\xf8\xfe\x9eV\xe5\xb8\xdbV\x94\xfa]\xf7|\xd7M9\xaf\xe2\xfe8\x8f\x12\xca\xed\x1e\x9ef\x85P\xdcmO1\xa4T\x01\xe1\xc1g\xc4cs\xf0o\xa5o\x02`Q\\\xb37\x8b\x8a\u058b2\x87\xfbLӬ(LP(l\x96\x98f\x85\x19\x0e\x81\xfb%t\xf1RX\xb5\xa7\xeaQ\xcaf\x15}\xb7\xa6E\xb2%/ID\xc6B\xea6Σ\xbc\x86\xa91\x85\x18\x9c\x8c\x05\xe8\xab\xd4O\xc5L\xb3\x02\xb7\xc9T\xcdp\xd9\x16ԁ\xc7r\xedL}\x9d\xca\xf2\xb7\xae\xd3\x1b\x10\xdb\xcd>\xb3u\xc8A\xe8\x8d\xee#\xb5/!m\xc8\xd7\xea\xc1?xs\x8a\xbcPO\xfeٺNk~z:\x9af5\xbe\xe2.Z\x04\x1bjQ\xc0XƔލ]\x16O\x94߷\xd31\xbc\x01\x8e\x97=\x0f_\vK++Z~Yս\xf8\xb2#\x97\x9dJsբ@\x1a\vl)\xf6\xbf\xa0\xbc\xa1 \xbaA\xfe\x90\x15\xaf\x9a\xb5\xba\xaa\"3>\xd5e[\x19k\xf2ʱ&\x97U\x19\v\x16E\x80\x18r\x87\x13\x80<\x91\xd5i\x1c\xea<\x03\xbb\x8b\x18\xae+\x0e\xa7\x05\x99/\x1fO\x12]a\xae\xab\xf9\xb1 }7\x04\xa8vy\x15\x9d}\xf8\xa7lCS\x8f\xeb\x17ո\x8f\xb8F\u007fr\xabu\xf9{\xca\x11`^Z\x9d\x01V\xe8\x9e=\xdbO\x1e\\\x99\x1f\xed\xc9@m\x8e\xf6]\xbbL\xd6l\x85\x81S\x01l\xc2\xf2\xe34\x8e\r\xeeZ߭\x06\r.+\xc1-$\x8e\xc1G\x14\xd6\xd8\xe2\x90\xdf\x17yV\xc0~\u007f\xdc\xc4\xc6}~\xb5\x19\xf4;k\xbbF;\xb9p\x8bU\x187\x00P\xbf\xc0\xae|k\xa7\xbd6\xf7\xd9\xeb}\x0e*ػ\xf8b:TQb\x90\x96#\xa6\f\xd3\xfd\xb4tʦI\xcel\xa4\x046\xde\xde\nn\x1b\xa1\xe6\xf8K\x03\x9c\x0e=+\xe1\xbaԀ\u007f\x00\xabgdl\n\xd9o\x1d\xd5e\xd5\xf8$Q;\x90֑0\x1e.@;\u007fqK\x1e\x1cǋ[\xa1 \x8c\x12\xfaNq\x87l\x90\x0f\xddo\xb4\xa8ֶ\xc2*\x00b~4\x86#\xda\xef\x00\xc6\xc3\xe7;\x80a\xf5}w\xc7C\x87\b\x9a\x8d\x13)o\xfd}x\xfa\xd0\xc3ʞ\x11\x05\x83n\xccG\xcb.\x96\xef\xect1o9;D\x1d\xb2H\xddGC\xee\xb4wѸ\x8a\x81\xa3\x1f\xa6\xb4\xf7\x9d\xeehm\xb7\xca:_\x95U\x13\xe8]\xee\x80\xf9V\t\x00o\x98\x03\xe8\xafjĨ\xa3,_r\x8bT\xd6bM-\xf2\x18\x8dj\xd3S\xaew\x98G\xc14d\x87\xd2\xf1\x85w\xa6wUχ\x8eN@U\x96\x8d\xee5ñ\xc5R\x03\x12\xda\xf2Ē\xdd\xf3\xa5V\xaf\xc3\xe9k\x0f)\xed\xa1z\xfd4ߎ]\xd9\x15l\xca>\xe1\xd0T\x00\x84\xcbS\xc1*\xb69K\x86\xd5Eh\xa4\x18\xeb\tՊ\xa6\xa2\xf4\xafY\x9d5\xdf\xcc\x1aZq\x1e\xa9ջq\x81sT\x19\x17w\xf9$\xe2\x0e\xeaڇ^\xdb֎\x93\xfbW\xd94ϊ\xb9\xb9\xf1\xd9}h\xfe^?\xbevB\x9ec\xeb*rL\x1e\xf1\xc5l\xf9m\x9e\xb0\x11\xe1U\xac\xc5\x13\xf4)k\x97\x8a\xdbuo^\xb3}\x12\xaf\x90[l\xa6\xadn\xba^,\xfe\x04\x16}\x12U\xc3n\xaa\x9e\xc2\u070f\xadNg$\xffZ\xc5E=+\xab%'\xe8\x11c\x10-\xf0\x1f\xb6Ⱦ\xff<\xc7\xf6\xd0H\xb1\xf8\xc5L\x16;\x12\x91\xfbp_r\xff\x85\xcf\xf5Y\xbbIP\xa3#jЛO\x8e\x1at\xf4fW\xdd{\x1c'L\xeew\x9c$L\xf2u\xddЪW\xa7q\x98ϣ\xd6\x02\xb2\xaa\xe8CV\xae\xeb\x9f\xf0\xa83v\xe7\xfd& \xf7\xdbC\xf5\x99\x1d\xdfe\x9fC\xd2mCж\x01ͫV\xe2L\xb5\xa2\x89\xf3\xe3o\x12\x89a\x90\xcb#\u05ed\x02\u007fw\x15plW\xc9*uN\xc1\xfe\xf2\xd1-\xa9\xe9*\xaeb\xed\xac\xb8\x0e\xe4\x9b\x0eb\x8d\x18#\xd5\xc0k\xaf3>:\x0f\xd1\xe4tָ\xda\xf4\x03\x9d58?\a\xa4◔Z@\xbf\xb0\f\x01\x05\x91\x10\x19:\x8cO\xa1\x9a\xc3\xd28\x12\x1e\xe1\f\xe2\xd4B\xc2hC\x8etXH\f\x00\x8d\xb1\xe3\xf2T\xc1\x91,\xf7\xf8/\x11\xf0\xcf\xdbD\xfc\xf7s1\x81\xdd\xfc\xae\xc5]\x8f\x01y|^~)ҏ\xc5L=&\x91/\x11h/h\u007fN\x8d\x89\xcfI\xf0\x95\x12\xfb\x1dh\xfc2:\x85q\xe6\x95\xcc\xeaФ\xbc\xe7F\x06\x8e'\xa8TY\\\x1d\xae\xd2\x10\xda\xea\x95\xd7j\x06\xae\x12\x94|A\xb5\xee\xaaw\xa7v\xe5\x85v(X\xc6\xed^\xed\n\xd11L\xd5*\xf3fYU7\xff3\xceﭱ\xce\r\x88}U_@d\x00cH\a\x11\xe8և;\x17\xc6\\\xf9\aH\x1f\xac\xb6\xf4\xdb\x17\xba\x92\x87̀\xc0\xa5\xbd\x06\x9e\xbaTe\xf82.hy\xbc\xfb\xbd)|qc\xde\xf70\xfdΊo\xbc\x06\x83\x1c릩F[\xbcZ\x95\x15^'q\x94SͰ0\x18P\x82\xae\xae\xdd@k .\xb2Yc\xba\xaf\x04\u007f\x97Y\x8a\xb1\xb8n\xc9\xe8\x9c<'\x9e\xe2\x1c\xf6\xe0hU\xd1<[\x92#\xac\xd0HlE$\xeb\x11\x1d\b\x8e\xc0\xd77\x88\xf1֖4\xbb\xc2\xceYH\xa0\xbfqa_\x96\x8c;fUC\xd9N\x83a\xedY\xb1M\xa3\xb3`\xdf\xc4\xfa\x1b\xf3\xa1{\xc3C\x1d\xea\xa5IY\xa4ڈ\xdf8g)5\x8a\x15)\x9b\x9bO4\x83\xf6\x1c\xf6\x8e+I\xfc\"\x95FղL\xf7\x1f\xceZ\xa3\xcd\x1b\\\x87rO\r_ggh\xe3\u05fag\xdf+\x14p|4[qF\x06\xe4\xa1\xd4~\xbb\x84\x84\x81,\x05\xdfћ;2\x94c\r\xd8\x1e\xb2\x95\xe2V@j@\xfbP\x9a\x89\x80\xff![\x9a\x90\x90X\x9a\x89L\x918x\x8e\x14\x9aJ\a\xed\xb1\x87l\xe9\a\xbcef>\x18u\x0f\xd9\xca\xc7\x06~\xf5\x15\x83j]\xfa/\x97\x1d\x05˥5\xfc\x91cN\x1a\xcaU\x1b\x967K\xd3˶'\x9c`s\r֨1\tZEqW*\x1a\xc6vc\xc8>dK`\x80\xe3I}\xc4o9\x17\\\n\xfcG\b*h$}\xc3\tG\xfc((R\xf2D\x02T`UL\x90\xc4\xdbvg\xa2[\xb1+\xef\xa3ɚ\f\x8e\xba\x19Bc1\x0fk1\x04Ђ\xc0\xe3r\x86\x8c\xd9\x10\x88\xa3\xec\xc0aǖ\xe4\x82\xf4\xacK\n\xda\xe2%\x107\x8b\x8a\xc6)\xf6u\x97\xac\xb0\x99\x85\xf1\x06\xfb\xb9\\\xf5S\xb2rP\"E\xd7!\xe7mBV7\x1d0\x92\x90\x15\x10\xb2\xec45l\x91\xee\x89]!J9C\x12>ݱx\xc8Z\xa9\xd7\xf4Tzߺ\xe1%\b\xc7m\x89\xc0\\\x81\xe6\xd9rL\x8c(\xec\xcb2\xb5R\xf0E_+\x11\x06\x80\x95\x86\xdb\xc5c\x9bJ\xf2\xb2c\x02Gxr\x04GD¾;bʔ\x84\xf5\xabZ\xc6\xc89\v\xd95\x84\xb5\x9e\xd3\x04s\xae\x9fq\x15ƺ\xac\xe5\"\x14\xe2\xb8,k\xf7\xb2zGY\x18T\xbcpJ\xe9ꐲ\u007f\xa4tE\xeb/\xb6N\x0f\xf0\xed\x17F\x15\xdf\xd3\xfd\xf0Am\xa6\xfdF\xabwm\x87\xf9\x98\xd1\xd7Z\xa8\x13\x92Ҝ6Աz\xfa＆\aY\xfc\xaf]\xc0+\xd6|\xd4i\xf8\x82K\xf7V\x8d;\xd7\xed\xac\xc4\x1e\x8b\xf6e\xbc\xea\xbf\u007f\x97Ĺ\xda\xe0\rȽ\xe9\x1a\xd5O\xb0YF\xb5\\\xf7\xc6\x15\x8dw\x87\x98dPL\xb6\x1d\xab^_\x067\xf0\xee\xc9\v\x12\x92\x97$$c#\xa4).\r9\x0e\f\xeb\xc2>`_\x18R_\xdc\xcar:=\xce\x10\b\xf5\xbbu\\e\xb3\xed\x01Q\x10\xbav\xdf{\x9c\xae\f]E\x93\x06wq\xb0.6\x8b=\xf2\xb3\x18\x15]\xc6Y\x81^r\x89\xa6γ\x84z\xbe\xe4\xed\x94\xd6\f\x81z=\xadNʊ\x06d-\xb7D\xb2\xc2c\xb5\x8c\xd2M@\xf0\xc7\xd67\x965\xd8ò6\x01\xb5!\xcf\x05\xbc\x11\xb4B\x8f#\\>\n\x96\xb7\xe3X\xc0:Kb\x95\xedoٚ\f\t\x1c\xf8\x10\x1d/\xcb\xdc\x15p\x06ϰ\x1ee\x95G\xb7Z\x9f\xb7\xe2\x92\x03\x17\xc8-y,\xab\xba\xf1\xaa\xf21 k\xdfgB\xc0\x18\xd6~iGP\xb9*W\x9ee\xadr\x0e\x03ƝkwI\xde\xf0\x16\x1b\xc6\xf0\xb5H\xd4bF i\xc8\xf3\x00\xb7b\xad\xfa\xfb{\xf2\xa6U\xbdxv\xb2\xa3w\xb4&\t\xa1\xd9e\x9f1\x8e*\xcc\x1d\x81Ĭ\x18\xaf\xf0\xeeI\xd9Gs03i\x17\xd7\xefŀ\xf4\xfb\x87m\x93%\xf7\xbfհ\xddg\xb0\x8a\xb1\xfd\xc5\xc7^k(\xa1 \xf6\r\xbc'\x8c2\xccy\xaf\x02@\xfc\xb3G\xc6\x05\xf0K\xd9ıh\xa2\x10\x9ag-m\xf1\x04\xe9\xfe\xb8\x97<qI\xe9\x97'Cy\x98\xb7\xce5\x02\x02R\x05\xa4\x92\xd7R\xaa%\x1c0V\nY\x9f!U\vvχ\x18\xfc\xb9\xc2Rw\xd9\x04*\xf3}\x92\x94E\x93\x15k˱]\x91\x17P\xb5/\b\xa8\xec\xfc\xaf\x81H_\x90\xdaZf\xd5\xe4\xf9-\x91\x17j\xd7\xeckm\xdf2$/\xd5>\xfb\x9a\xc9(\xc3\xf5\x9c\x80\x9dD\x8eI\x1d\x90\x9a\x99\xa3\x98\x95\x15\"\xcb\xf7\xc9\xd8\xd26\x1f;\x02CY\xfa0_\u05cbn\x83C\xb1\x137XA\x9e6x\xed\x18~o\xf1lܚI]\xb9.ROJ\xcd1\xeb\xd21\\\xb1\xd0u\xc0\x9a\xdc\xde\n\x01\xb5;\x03\x88a\xf6\xc4\x03\xe3%\u05ff\x80\x9f\u007f\xecwJ\xb2\x94=zӊ\x98\xed\b\x97\xbd5\x93 `\xd5C\xcb'Y\xe2\xb1}s\x9e`\x8b\x141؆d\xc3x!\xf8P\n.<\x00\x17\x9c\xcf\xfa\x96\xa3\xf7\xfc\xf4\xb9\x1e\xa31\xdd\xe0\xc1\xbf6~=8(\xe3=\xf8M\xac\xc4\x14\xe2tۇ.w\xf2y\xa3\xf3y\xf3_\xc2\xe7\x8d\xcd\xe7-\xe7\xf3\xb6\xc5\xe7\xad\xe4Ö\f\xc9\xf6i|\x96\xa7\xb0\xb4\x9e\x16\x8c\xb6*\xb0x\xbaq2zc2\xda\xf9\x163\x9a\xff]\xc7\x1bQIҚu\x85~\x16\xf2\x93\x8epAJ\xaa\xbd\x1a`fh\xaf\x06\x18q\x868)~\xfb\x00\x9d寀\xf9\xf4\x0e)\x9c\x04\xb2\xba\xe7\x12?߭6\xa7SY\x01\x04m¹\x81\x8c\xe5:\xc07+\x91\x14m}Ť[3l\xfb'\xc6\xf4\x02\xe9\x11\x82\x06\x1f\x8e\xe7S\xd4!\x1a6\xfd\xc2wk\xf5\xbc\x8cW?\xc7\xe9O\x90\x8f4\vY\v$\xed\x01W\xe0b\x03.\"G\xday\xb9s\x11\xa8\x8c\xa3\xfb\xe2\xcb\xdce\xbcj\xad\xadY\xbd\xfd\x8f\x96iG\x13\xd3?\xf5\x1d\x9cY\xc1uRt\x1f\"f\xe1\xabV\x0e\x92\xf6[)+\xf3\x91c'\x87yuc'\x00\xf7/\xf2\xeb\x1f+\xfb\xfa\xc7\x1dY\x05\xe2\u007fdB\xc6d\xe5w\xc6Q^\xc5\xe9\xb7eQ7qѴZ\xd8r\v\xb4(\xd88\x9f6\xdd\xfb\xe4\x16<\xff\xbd]Q\xed$\x17[\xb5k\xcf\xc0\xf9{\xb0\x8a\x8c1z\xaav!\xc6oG\x9aк\x92\x8c\t\u009bl\x83\xb8\xd5w\xf0\x88.\xfe\x8f\x8f\v\xc1\x1f\xd6\x1b\xda\xe7\x01\xd2&F\xe0\x13.!A\xc9g⦖Rp\x88p#\x8c(L\x18\xdb`\xbbIS\xe3\xf8\t\xc1\xa6XQ\x15h\x8a#\xda\x18)\\\x9d\xb5ߚ\xeb\xe5\x17W!O\xb9\xb4\xd5d*,\bG\xb3\x8fb\xd8\xcb\x05\x06\xe3\xdb\xed\x05K\xea\aԗi\xfd\xe0\r\x02x\xb1\x8an\x9a\xe3\xa4~\x18\x88k\xa7\x8d\x01\xf3\u007fH\x98&\x9e\x0e\xb9\x8b\x90\xa6C\xbc\x9b(\v\xcdi)c]\xf1\xd3\xd7sZ\xbe\xa9\xe24\x8b\xe16\x99xw\xf7\x98DW\xa1*4\x8a\xdfg\xcbu\xb3\x88\xf3^\x97\x9c\x84\U00092cacҬ\x88\x1b\xfb.\x13\xb8\xaa\xb5ܻpB\x9e\xdbt\f\xe1\xe5\xe9\xad\r\x1a\xb5A\x03\x92\x00F\xa0;)ko\x13\xf9\x01\xa9UZ\x9d\x15\x98\x96l\r\xb8-\xc0m\r8HK\x12rK\x96eJ\xe1\x01\xbbAY5\x8br^ūE\x96@\xe4\xc4mH\x9eC\xc1#\x92\xc0\xef\x04<\u058c\x8c1\x9f\xee\x12<U\rH@-\xd4\r\xad\xa8\x8e%b\x8b\x11@\x910E\xa0 \xe7E\xb9,\v\x05\x94$F6}\xb7\xce0\x06w\x03J&\x11\xe4ǬMI\xc2\xe8'/I\"_\xcb\xcb\n/A\x03\xcf\xc6\x13\xe7\xcc\x00\x1c\x881\x0f3\xea\x89F\x16+\x10\xe1\xea\xe5^\xb6\xb2\x86\xd7\xffx\x92W[\xed\x1fr\x8e\xd4\xdb\xc8\xf6\xcaߡ\x01\xc4\x1f\xb8j\xaa\xb8\xa8\xf3\xb8\xe1qHy\xce\xd6ȉ&\u008d\xae\xec\x13l\x80\xd5)\x01\xc1\x98\xc8\x02\xd1-9\t\xc3@a\x02=|v\x15\x06\xe4\xe4<dZ\x18d+d\x12\x112\x11\xe0\x16\xa0\x94\xddQV<P\xf3\x16J\xb74\x83_ؔ\xe6\xa1\xd1<\x9f\xad>\x19]\xc87ϒ\xe7\xa1\xd1b\rxe\\\r0_\x06\xc3[r=\xe2%\x03\x1e\xc6M\\x\xabn\x11\xeb\x04\xb2\x04m\xd5-=\xaa.&l`\xa7\x01.\x95\xb6b#-\xd1\aZ\"ƙ\x1c\x8eI[`\xbcM(\xcc=F\xe1\t0\xa1N\x18k\x9e\x8b\xa1\x97p\x9e\xf0Qɤ\xf6\xb8\xa5!\x14%I\xc2a\x87\xc4[\x91\x97X4\xe1莡\x95\xa1\x03\x85\x14D[R\xb8<>\xe1i]m\xf3\x99#a\xdd;\x18ؑ1EU\x1d\x04\xa0\xe4?1Z\x18+\xaa\u0085qD*P\x15\x04\xb4\x80d\x97\x8eV\xd1K\x14X\xd4\r\x96\x00\x9cҾj\xc1Y\xab\x1c\xd0\xc1\xad\xfbD\xbbx F\xfd\x13l\x0eV\xd2X\xa0\x91\xdb\xf6\x93;\xbb\xea\xd7\x15\xcd\x13^\xc0\x11\xa5e\x80uCo\x1dm@E\x1em4}\xb8\x832;S\x84\x8c\xbf#a@B21,\r\x98\xdb\xf3)\x06\xda\xea\x99\xd8\x01\xa4[\x0f¢\x9bM\t\xd6\xc0yNZ\xca1/\xe6\xa1o\xeb\xb7o\xc9\x10\x14I!\x94I\x8d1\xee\xbbqE\x13\x18\xa8E\xe7<\xb3\xd2Q5~\xe7\xac\xe3I@&\x98\x8dO\x86d\x15\xfa\xbdS\x91v36/\xe3\xd43y\xb1Zd\x11Zf&\xf5\xcc$\xcbs\x9a\xf3K\x18\x8b\xec\xa4\x1f*\x9a\x04$\x8f\x9b\xd0\x05%\a\\@j}\xf4\xb0\xba\xf9\xf4 \x9b\x04i\xf2\r\xaebއPy=\n\xb9\xe8\xae\xe5\xa2\x1b+8Q\xf1\xc1\xbfe\x06\x1a\xeb\x18r$\xbbO\x0e\xf9U\xb8\xbb\x8bY\xfb\x9c\xfd\x88\x02g\xcf\xffRKݑ\xe1\xf5U@N\xafpU\xc5Y\x06\x19'ף\U000c071d\x8f\xce\xc9D\x99\x04\x11=\xed3\t\x18[\xe0\x8e\xf1\xb7\x01Y\t\x83\x00H\xf8ob\r\x84\xf0\xae\b\x9b\x10\xb7\x01\x8c6}^\x84|\xbf\xcbf`e\x9f\x03D{\x8a\x05q8\"\r\xeb\x81\xfe\xa9\x13\xfao\xc5\xc7\x15\xeb\xc3B\xc6p*\xf6\x982\x91\x97\x9f\u007f\xbaڡ!\xc5\x00u\x11\xa3\x8b\xcdS\x1e\x9b祕\xbbA\x97\xc2'S\xf5\xc5g3}`\x195\xff\x86\xf3X\x9b&\a\xa7\xdaSֿ\xd7\xf1\x1e\xb3ֿ\xd7q\xf7\x90\xcd\xe1\x14\x8d9fA\xed\xb6\x96\x9e\x16\xcdLu\x91\xaf\xc9yH^\x928\x8f\xeb\xfb\x98\x8c\x01\xdb\v2\x8c\xceX\xea\"~\x8c\xb3\x8c\xa5\xc6\ryAN\"fG\xafiՔ\xbfdI\tЏ\xb4:\xbb\xf2\r\xeal\x95ǁ䋮\xbc\xe9Bx B<V߂P\x13\xff0\xba\b\x03r\xc1&\u007f%\xf6\xde\x1d9?\x0f\xc8\xc59\x99h\xc88\xd5;\x91\x9d\xb4\x90]\x05$\xba2pi\xad\xed\xc3\xc7\xd0E;\xd0ɮ\xfc\x84\x01\xc2Y\x89\x18\xd4a\n3Y9!\x91\xad\"\x99<'\xa3\v\x99\x87\\j\x15Q\r֊E\xa3s\xdf)\xf0\xac5r\x94x\x82\f\x95\xe2\xbb\x14\x84Q\xe8\x93ؠU\xa4\xbbP\xd3\xf7\xe4\xd6f\x159\xc6\t\x94G\adC\x046D6ڸh\xa3m\xb1Re\xdd\x11ؙ;\vٲ-}\x0f\xe8\x8eHt\x89\x9fdb3\xbaU2\xba6J\x9e\x84\xad\x92Z_X\xa5\x8f\xc8\xf9\x95Q\xfa\xec\xb4U\xda\xee(\xa7\x85mʤg1\xad\xa5\xb4\xa6eQ\xf4_E\x03\x88~\xfbbO\xd7\xd9n\xcf\x19\x19ʘw(1\xdbȵ!\x91D\xcc\xe6\x88p\xfb\xf0;\xfeH\xb9Z\xc71\xd1X\x99\xef\x9e\x1b\x86\xf7w\xbe\xf9\xf0\xb6al\u007fǌ\xed$\xea\xdcs\x85gʵ\xbatT\xcf\xf5\x1bk\x1f\xbf\x84\xb3\xe9@o\xd26\nd[\xa0\x1f\xff;X\x8e\xfd\xfd\x9b\xc8\xfe\xed\xb2\x1d\xc1\xb8\xb7\xbaPI\x84\xd5\xf1\xe0\xafY\xe9\xae'\xb0M\x13_x#\xcd^lu\xb7թ\f鱳\xff[ݽq\x98\xae\xdb]6)v\x92\x98r\x9e\xa4J\xb7Q\xab\x12\xe9\xf0\x88x\xc4M\xa0\xbf\x89\v\x0f|͛\xd68\xb4U\x0e\x90\xe5 \xf4\x13l\xe7;\xd65\x0e\x0e\x85\x9d,\xe2\xee\x9f\xcdN\xcfϦ\xcf鳣E_\xda\xd4\xed\xaa\xf7\xb73t\xdb\x14\xe9\x19-G\x8d\x14N\xef\xec\xbc5\x9b\xd0w묢I\x13\x17\xf3u\x1e\xf7\a\x90\xb0`\x0f\x9aa\x8e\xc9\xe9\x05\x9fQ\x86\x96ҁ\xac\x9b/\xa9{\xcf\xfbt/\x16\xb2\xda\xf6\xdfI\xebJ\x96\x9c^0\x03c\x13\x90!\xfe\xdaZ\xaa\xc8n×\x1e\x19V}\xbd\xb4\xfcv\xa3\xa5\x8f\xcan\x10}\x8c,i\x95\xc4\xfc\xd6{\xe7\xe0\x10@O\x1e\x15\x1e\xa8\xf8\xbc\x9c{R\u05ebm\xd436\x8b\xee\xb0ĎɉÅ\xe2?y|ɓ\x90\xc3\xd1y\xa0\x8e\x84\xb1\x8f\xad\xef\u007f\xce\xc1'x\xf7\xdf{ԙ\xdba\xf0\x8bnV\x9e\x1c\x8c\xad)\xd9\xe1\xcf\x1a\x92\xeb\xd0\x1a\xb5\xb2\xf1_z\xb8\x8a\x8aܵ\xffv\x03\xd4I\x97#O\x1f\x92\xab\x18\x0eI\xf7\x05pk\x16V8\x0e\xedEc\x887\xf0K\x9cf\xeb\xba\xf5\xac1\xe4}\x9bUI\xce#\x0e2To\x12H\xf0\xb4\x82\x1dO\x12\xaa\x95c\xdc,~ݮ\xa8\x16\xe8\x13\x8f\xd6\xd7뜉\xf8t=\x9b\xd1J\x1c\xf8~)\xbeߖY\xe1\r\x06>?\x06 \xe3\xebB\xae\x15\x94\x91;\xb7j\xf9\x8e\x8e}\xea\xb8*\xdfҤq\x0f\"\xe1\x81D\x18{\xacq:\x82\x81\xdfq\x9e9\xdf\xce\xcb⛊\xf6x\xc5\xea\xf5\x92\xdc\xc2m$k\xa0\xfaxj<\xe4תTގ\xc3\xe3\f\xe1Ё1k\xad\xb8\xeb\xf5\xb2\x8f\xeeoi\xd1Te\x96v\xd3\xce\x01\xa5\xc7i9\xe2)VS \xa4\xba\xe2\xa1\xef\a\xe2\xa2\x17\x87\x87\x03\xb2pG\x82שe\x89$\x0fop\xc1\x05.\xfc\xfd\x1c\x0f\x81GL\f\x86\x91\x8f\a(\x048\x8c\xa5\xad\x9e\x10M\x02\xf2\x9e\xf3\xfa\x89\xbcU\v\xa8\xfd\x9a\x9e\xb5\x9b~c\xdfu3Y\xa0]f\xfb\x04V\xe8뺡\xc1\x13}}94\x98\xa3r\xde\v\xf9\xe9^\x04\x06l\x1d{A\x9e\x93\xf7\x1d\xdbuq\xa7\xd0s$8ILk\xaf\x87\u007f-\xe6q&\xb5<\xb7\x86\xaa\"gl\xf6\xddWC\xf9\x816\xbam\xdf\xe9\xbf\xd7 \x95-\xbd\x02urե\xb6\xf8\x98\xde\xf4D3\xffD\xe3f]\xd1o\xcb<G\xdc\xda\xc3\x12\xa5\xed\x1d\x98!0<\x967\x12\x1f\xe6\xad\x11\x91jIh[F\xb9\x8a\x84K=R\xbd\x8a\xd2L\"\x19\xb7iSm\xb5\xb7\x92\x03\x93\xe8.R%\xbaR!i\xe1\xf8\x99\xf1\xb6\v\x83N\xdc\xe0ǁ\xe4\xbdW\x8eta1\xba\xaf]ŏ\xeb\xbc\xc9z\xeb\x01\x97\x8bB\b|վM\xd6v\x8f\xff~\xee\x1a\r\xb0T\xed\x8e\x16\xfc\x90\x15\xf4USe\xc5\xfc\x8b\xb7\xc0\xa2\xd8\xdfSr\xba\x9a5\xf8AG!\x8a\xe87&\xcdn\xfa\xe2-\rH\xbd\x9e\x1a\xf0o\x03\xb2\xdc\xefz\x88Y\xd2Zz\x98wF\u07b6\xe2v\xc1\xb3\x94\x06\x82\x16\xef\xfb\xb8\xafQ\xf6\x16\x9f\xe7p\xf1߬\xe0\xeem\xbb\vܝ\xa0E\x0eR\x03\x13\x14\xec\xffn\xdd\x00W};\xfb\x82\f٤\xe8\x88m\xd3\xd71\x9f\xafkD=w\xa6!;$\xf0\x92\xce\xe0?\x06;\xa2>Y\x1a\ufffe\x03!\xd5θ\x0ft\u007f\xf4o;\xc0잲\xc2m\xd9ķPZ/\xe4a\x1ca\x93\x0e.c+,{\x98\x98\xed\x124\xad\x01\xf7\xe4\x05Yu\x8a\x9aE\xf5\xbd[\xda\x0e\x9273\\P\x8f\xf4\xfd+\x9f\xec\xf73f\xb8i\x90q\x11T\x9f\xa6\x04\xaa\xf4\xa7\x994\xaa<\x9b\x98\xecK\xbc\xfa\x9e}Ecn\xa8\xad\xc0\xe8D\xbb\xfb\x93\x8d6q\xf18\xf8\xac書q-\xa8\xd6M\xb7\xf6\x8d$\xc3J\xdfӊӊ\xeea\xcc\xf5\xaa\x1c\xb1<\xd6\x16\xba\xa6!w\xf3TE\x86\xcb\xe20\xf82V\x1c\xc3~t۵@wrZ.\x96\x9f<.d\x93>\xff\x00\xe1\xed\x91]j\x8e\x8d\u07b6\xf0\x97\xd8\xe4\xb5T\x98E\xf8\xaaP\x1f5\xdar\xb4g\xe4\xec\x10\x14\x1d\xf1\xa7ȝN\xa4Z\x1fK\x87\x85[\x06\xb5\xf5\xab\xb6 &\xc7\xea\xebd\x12\xe8+b3K\xb9\xe4\x0e\x16f\xa5)z\xa5YT&\x03\x85o\xf1\x9f\xf7\xfc\xad\xcb'\n\xbb>!\xf50\xads\x00\xf0\xdb\xe5N'\x02\xbf\x11\xedt#\x10\xf2\xde\xc8;q>\x02il,\xbfǽ\xe4\xf7\x1a\xaf\ru\x0e\x92h,\xd7]^X\x03\xa0\x1d\xd5^\xf0꣎\xd3p t\xb9v;\x9e\xc3\xf6-\xff\xc3\xe6\xc6\xden7\U000cfd1b\xe7{\xbb):|1\xad\xe6X\xe9桘u\x91\x1a\xed\xe3s\x8bh\xa5\x16\xbcM\x85\xae\x90\x8f\"\xab$\x11\xa5m\xa8\x92\xf0\r\xe5\xa1\x19߁+\n\xacWT\xa6\xc56\xdb\x04dkrxC^\xf0\x00j\x9c\x8e͍\x91\xfb\xb5\x88\xc5&H0\xf2\xb7ڳʒ\xec\xad\t\xf15#\xd5\xe7\xf4\x8a8\x14\xbe\xc1\xbb;rG0\xf0\x1bG2\t\xc8\x1d\xe1\xe1\xddXA\xae\v\xec\xab}\xd8N\xa6\xdbj\x19\x1cP\xeaE\x03\x86\xa7\x06\xbf\xeb0:\\\xc0*\x1f\x8b\xb9&\x1f\xa3\\\x1b\x00\v\xea+r\xa3\x80\xca@\xc0\xd6\xfa݀\xb6r\xb5\"\xdc3\xb3\x1b\xb7T\x9dm\xc4<\v\x81]\b!Md\xbb\xd0\xf0Tk\f$b\xa8\xf5<\x01\x81\x83\xcf'\xff\xb4|\x9a\x0fY\x9dMŰl94\xe3*\x19\xe15\xaeD\x82\xbc \xc61\x8d\x8f\xf6[\x13٪۹\xbek\xb5\xc6J\xafh\xcaㄭ\u0080\xac\xa2\x80\xacN\x02\x92\x86\x01I\xa3\xdd>\xec4\x02o\xb8N\xf6Ik\x89f=\x9f\x96F\xaaQ~;\xe0t\xe4\v\xba\xf8\xfbm\xd8#\xf3\x8a\xc6\xcd7U\xf2}\xd1\xd0jU\xc2\xf9@\xa4\xd6\xf7\xbc4$C\xa2m\xc4bB\x1a\xf9\xbe1\t\x19xW'F\x1e\xdc\u007fXEօegx.\x80Z\x9d\xd8\x1e\x84g\xab\x10B>\xf1J\\Q\xae\xc8\xdeM\xe3pw&6X\x85\xb1^\xf2=Omu\xa6\x18\xbc1\x8d\xf0\xb7o\x87[ǖ\xed\nn\x94\xc2M\x93\xd6!=(m\xee\xc5ȝ7\xb8\xf1\xcb\t\r\x8d@\x1b\xab\x88\xb1bu\x02oe\xdfޒ\xff\x97\xbd\xbfoo\xdbF\x16\a\xd0\xf3w?\x05\xc2m\x1c1\xa2hR\xb6lG2\x93\x9b&\xed6\xbby\xbb\xb5{\xb6\xf9\xb9:~(\x11\xb2\x19S\xa4\x96\xa4\x1cie}\xf7\xfb`\x06\x00\x01\xbeH\xb2\xfbz\xcem\xba\x9b\x88\xc0`0\x18\f\x06\x83\xc1\x00\x989\xfcM\xd5Y\x17^\x00\xc6$\x17\x92\x1e\xb5\x148\xb7\n\xe7\xe2顂\x8fz7\xbaM\xf3ZJ3\u007f:\x8b$/͆CA\x02j\xd3(r\x9a\a\x11\xba8\x04\x9a\x00\x8eV\xbd`\x06\xa2\xbeg>$}\xa2\x99j\xa2Df\x15\xe7#\xd8Hʒy:V\xa2|\x9b\a\x9fĠ\x97\xd4\x06\x1f\xde|W\xb6\xccT\xa3\xb1\xf4\xc2\xf8gl\xed\x14\xae\xc9\xe1\xf8\x85}H\xb8\x8bd@̢\xc1\xd8\r\x12\xf6\xe2\xf3\xb0\xd2!\nq\xd8TsP\xdfU\xc1\x86#D\x18ee\x91\x80^\xa5\x14\xda\xfc\x8c\rr\xdbq-R\x9c\x88\x17\xb9Փ\xe7~:.\xf6fĐ\x83\xabDU\xc2\xec\xdcO\xaf(\xbc\xa8\x14\x064\xce\xc3\\,-P\xa7\xdbL\x98T\xdd\x1f\xd4\xdaw\x82\ue491WaĶ\xcdm\xf9\xe0F8\xe3{\xdc\xe4\xeeN\xd1Pke\xc5\xc5a~\xb3\xcd$\xd8J\x13\xb5\x98\xf6$\x8c\xe0\x95\xa3\n\xa3\x14\xba%\x9e\xbd=\xd2J\x88G>\x8c\x98Ym\x8f\x19\xfbi+1-\x05\xbf\xe2\xe2\xb0Hr\xef\x9d%\xc5o\xb4\xe4z\xa9i\x8d(\xe9\x93\xf0\x9b\xe8S\x90\x8a\x9f\xb5\xf4m\xdcM\x12O\xfe\xf1\xf9__^\xb2\xea\x93_\u007f\x8bJt\x11\xaf\xb4\xda\xfc\x1a'\xe4ޞ6a1\x11\xea\x93\xc4f\xffj7M\x17%5\xb5Vs5\xf4/ٯ\x02\xf3f\xcbR\xbc\xbe\x11\x8d\xbd\xd9\xe8K\xae\xed\xd3_g\xffI\x8e\x1c9j\x9a\x1e\xa8\x13W\x91TV\xe4\xeb߹\xe1\xbfl\xa3\xa0h\xf0&\xaa/\x9c\xe1o\xd4c\xbf\x02\xf5[{\xa8\xa6\x89\xeb\x9d\xfb\xb7h\xfa\x1f\xd7ſ\x92K\xfe\x01\xd3B\xc5-\xb9\x8b\xfeŚUOg\xd2\xe0\xb1\xe73\xf5ox\xdbBu\xa6\u007f\xb4m\xa6\xafL\xe9@\xa4\xfet\x17\xd2\xed\xc7W\x0f\x8cO\xe4\xc6Oq\a\x92\xb0\x8aZ\x85\xd1\xd4^\x98\xdbN\x00Ԑ&\xafH\xb2S\xfe\x9a\x17\xc0\x80Ue\x11c\x96\xd2q\x98A\xcb\xcb\x1e#aim\\/\x17\xe6\x98fy3cZd\xc9\x15f\x83Ʉ\xe7\xacٲ5\xc7'f\x90 \xb2O\x82\xb2\xcf\xf4\x82-j\x86%\xfb\xba\x95\xc3{\xa5\xb0\xe2fKPe䁁\x1b*\x8b\xb3\xbc0\xd0*p\xb3\xcae:\xc58\xc2\xd9\xd4(\xe6\x13\xa3\x98S\xb7̨\xeb\xea\x93\xcfL\xb8\ncO\xf0\xe9\f\xd2q5\x8f\xb6l\x15\xe6\x1c\xd2\xf9j_rʃh\xac\xb2٬\xb4\xbb\x8a\xa8X\xb1&\xa9X\xb1T:\xac\xae\xe7\xb5!$\x9aR\x1aBJ\xcdb(\xc1b\x14\u007fo\xb3\x9c\x15\xfc\x82\r\x1b\xf0s\xab\x1fV\xb4\xf8{G\xcb\\E\"} \xfaIP\xc9\x10\xd9e\xf7\u007f\xf6\x14\x8b\x16/fsD\x97\x83Fn>\xba\x177\xcb\xcd\x124\xd77D\xca\xd5\xfd\x1b\x82Ee\xf4\xb2@t\xd9\xdcm\x8f\xee\xd5m\xf7k\x88*\xfc\x0fx\x8bVU2\xb5\nU\xc5\u007f\xb9M\xed֒Z\x93WѮ\x8a\xef_qP\x16PSy\x89\x9c\b\x94\x1c'q\x9e̵\x83\x16Wi\x18X$\xcb\xfd4W}\xf9x\x81\xab\x9f\xe6l݉\xe4O/y\xf13\x96\x0e\x05\xf9%&\x17C\xdcw\xcad\xe4,ދ\x12\xf0ͨ\x80\xefF\xcd \xe1\xbd\xff\x9e\xfd\\\x8a\x9faq\xbfk\x90\x14]\xa0\xde\xfa\xca\xfa\x83\xd5ׂCŬ\x8a\x0e\xbc\x15\x1a2\xdd\xedV\xa1J\x10\xddF<\x02\xe2\xb0\x16\x87\xc8=QsCP(G\x9a7\x14\x0eԲ\x16y\x1e\xe9\xb8\xe4\x05\xfb\xabO\x14\xd7[\xa0]b\xcb}\x8b\x05\xb6g\x15lN\xa9,\xb0\xce\xf3H\x15y\xc5Q\x19,\xa4\u0096]\xf6z\xa1\x85\xcc\x04\xcb\x1a\x90\xa5\x02\xa2^\xed\x19,\xc8#$`o\x8f\x95\x84\x8f\xa5\xe6\x98\xc3)\x10\x83{\x89\xea\xf7\xc5\x1eW\xaf\\Ǝ/.\xbb\x165\xc1Fa\x01\b{\x83\x02j-&k\xf0\xa8=\xf2ȂI%\x9c2y䑥\xbe\x053\xae\xd9_Q\xf8 n\xc0u,\xb8\a\xd7\x02G\xb9\x83\xff\xe0/\xf9?%\xe7\xbd\xff\x1e|Pe\x96\t\x0fUǭ\x96s$z\x89\x9a\xe7\x006u\\^\xcf#\xedh\xeb-M\xf3p\\8$\xe1=.\x9e&L\xe7SrP\x1c\x1dU\x82\x8b#x\xa5\xa0\x04m\x91\x19\xa6\xb3\xbfA\xf4a\x9b!\xe3\x837\xcb\xf1\xc1~\xf6;\x04\xd7\xe65\x8eX\xb8HеȢk\x91e\x17nV\xbf\xb5\x88o\x91\x8cGq\x81\uf40dUw@B\u0601\x8b\a\xa4\xdd.\x1d\v\x11\xd4\\\x84C\xd6i\xa7\x92\xbc\x8b\xeb!\x1c\x0e*d\x89U\x1c\xd6\f\x94\x12\n\xcfۂC\x85w\xcaU:C\U00082124O\xaek/\xb8\x16mr6\xb5\tG\xeeu\xf5B}8A\\\"\xb7S\xa2V\x9e\xdfu\xab\xa4vJ\xa4\xcaI\x05z\f\x87\x9a\xf2\xec\r[5\xf4\xd5\xc3\xdc\xd0_\xaeY\x98\x98a\x1c\xd0E\x9f\x84\xa5\xb7\x98\xb0\xb1\x1c+<\xac$\xe5ϷȨ\xbaK\xc5W(\x1d2\xc2_\xfa\x0e(\x1eH`\xc8\xd8\"W\x01\xb8\xd52\x80\x18̘\x17z\xae,F\xb3*\xcf?\x17hB\r\rv\x87\xcfD\xa2ȇ\xfaU\x99\xd09}\xbb\x91\xd3\xe5>\xbc\xdd؇\x84,\xba*\xf4\xe7m\xb8\xcb\xd0\x1bq\xc3\xe6\xb5K\x9e\xb2\x06\xb4\xf1٭\xa5K\x9e{\xacҧ\xec\xaf6C\xf8\x94,\xbb\xe5\x87U4V\x95\"\x13\xebv\xb7\xb0\xc0\xbc\xbe\x80ֿ\xa1ֿ\xc533\xa1\xf66/\xf1\xc8皘\xe2r\xcd\x1b\xf1\x96\xb0j8\xd5!\v\n\f\x87\x86\xb8|\xbb\x18\xc5\x16\xc8\x0e\x1f\xcc]\xd8\xcdЇ2\xaf\xfe\xb3h\xfa#\x98\xc6U~*\xf8K\xc0\xea&g\xbb]K\xdb\fm\xa9\xf1\x8d\xe6\xfa\x01\xfa\x06\xe4\xb3\"\xed[\xc8\xf28Ye\x85\xc3'\xc7Gbvb\xb3ɫW\xffjA\x9d\x17ٌtHW(y\xfc\x84\xcdD\x1d\xbb%%P\xdbs\xebt\xb2Y\xe5\xa5\x0f\x8e\xa8\xdd\x1e\x16}\xf7Y\x1b\x93\xea\xf9\x9ah\xa9\x1c\u007f)\xeb\xd6lV\x1a\xe6\f\x1e\x19-G\x04\xd6\x17\x0e\x87\x9a\xe2R\x02\bKֱ<\x10\xb4堫\xba\x86\xe7qV5\v\xd6\x1d\xb6\x02\xfd\xd2V)\xbe;\x85\xb72\xe8~O0\x8bG\xf5\xe0n\r\xb83\xdc\xe1\xe13\b\x95\xaal\xfeU\xab\x0fu\xcd2j(\xe7֔s\x86\r\x9b\xad\xad\x11\xe9\x10\xdf$O\x89\xddӝZ\n\x9f\x94\xd01\xc9\xe0\x9b\xfb\x85,\xe8am~\x13\x13-2\x1el^\xc3a\x8c6\xd9'\xad#\xbd\x99\xfa\x01\xb1\xcd\f\xf7\xc8H\xe5csl:[\x1d\xf9\xd8\x13#\xd4\xf3#\xfc\xf2\xf5\t\x84uE\v\x00\xdb\x00\xc1\x18:V\x0f\xdca\xbe\x8b\xf9\xae\x9e_s\xd1\xcaSrc\xc1\x91\xe9\x9b\xd2ih\xad[J[\x9a\xd9\x1c\xbc\xb0\xa5\x8e\x89g\xf3|\xfb\x01\x00\xdc\r\xf7\x1b:e\x04\xb7m\a;<Z\xc4j\xc3`yp\b\xf3\x17\xb0\x94\a\xafx\x86|\xc7\xc9ٽ#\x00\xf9\x05\x1c\xf8\x80\x9fR&\x18\x91\x05h\xf94\xc1\xa6c\x02\x81D\xab\x9f\x05\x80u\x13W\xc7\\\x19\xbd\x89\xb30\xa0\xad\x00\xa5\xb7\xf2~.\x88k}\x91qC\x91\x82\x1bJT\x89R8\xa7i\x06\a\xb1\xe0\x89[D\xb1\xf1Ed\r\x9bY\xf3X]s\xb3\x9ah\xfce\x14\xaa\xf4\xc1.~]\x18\xa86\x1c\xd7\xe5s\xcaP}\x9dk\xa5\x14\n\xa1\xcd\x1f\xb7I\x9a\xc4I\xb8iI\xa6\x1cb\xce\xd4\xf5\x96\xb6\x87T\xb1\xa1\xc54(\x8c\xe6\xe0\xe0\x92\xd7u\x99\xd3,\xa3\x11\xf8\x99\x052%ޱ\xf4\x1e]\xe6Z$\xeb\xe2\xfa\f\xd6fl\x9d\xa6\xb99\xa8\xeds\x87\xc1\xde\x1e\xa1\xf6\x88\x99\x8c\xda\x11\x93\x8c\x99\xb8Ԧ3[y\xea+\xeb\x8aĨѿP\x14\x8c\xea\n\xa6u>\x04I\x8dJ\x01\x18ٙK^\x90̵\x97p\xfc\x98\x1e\rt\x8b\x9d\xdac\xd2\x01\xfa\x99\xdd[2\xa2\xb3.+ۅ\xb2z\xd1n\xb9h\xb7\xf9\xfa2\x95\x8aE\x85\x8a\xa5J\x85\x0fvx\xa9\x1eAŢLŲ[.ڭ{\x9b\xfa\xd6\xc57H`\xa9M\x86\x16\xb9\xedb\x02\xac\xb9\x89\xb2\xfeCi\xbb\xa0vJ\xaf\xc2$\xb6#4\xb9\x86\xdc^rYQ\xb3\x19<m\x06/\x85\xb1\x8a\xb2\xba4\xf3T\xed\xd2\a\xd8R]T\x16\xb1\x16\x19/+\xab\xe0\x12e\xf25\xbdb\x8ci\x9b\x8frCLY\xdb\xde\xe2\xd2j\xcc\xccU\x9cO\xc7\xca\x11\x80\xb2\x13XT\xb4iq\xbbmy[\xbf\xbb\xab\xf1@\xa2x\x14\x82\xb7T\"\xe2\xf5sÊ'?'.\xed\xb8N\x99\xe8\xb5Y\xf6[\tŐ\xcc\xe0u\xbb\xe2\xd5\xf0\xa8O\x8c\x94o!\xa5}bDFY\u007f\x054\xf2籿ܦ\xc0hpE\x1f\xa4\xbd,\x92\xa7!\xb4'S\x8c\xfb\x87h3 \xa1Q\xa4\x85\xfcTd\xb8$\xb7\x80\xa5*P,\xb9\"\xae\xb7\xba`Z\\~\x15\xa9Ud\x95a\xf8\r\x05\x15\xd0\xffR\xf1\x94o\xb0\xb3\xb5\x15k\xa1\x12\xcb\a\x15\x14A\xa4\xb8ޜ\x0e\xc8\xe7v[\xadD\xf6\xa6\xf0\xe4\xdeZP\xf4\xe2\xf3P\xfc m\xe2\x0eɰa\xef]\xdeS\xc3\xf1T\xa6\xd4\u007f\xcf\xfd\x80\xbf}\xaf(\x14\xb6t,{\x19+\xbb\xc4a\x9c\xd14o\xc5\xf0\xbaU=,w\xc9\xc1\xbb\xcd3{\x01\xd1r\xe2ki\n?\xa9:?\xc6vD\xfdI9ځ\xc9F\x8cG:t\x17\xccm5bY^Cq\vO\xa9C\xb5m\xa2\xa4.!ui\x92Sb;n\xc5\xe0\x83V\xbd\xba\x0e\xa3\xa0\xbei[\x1f\v\xe6\x84VB\x97k\xb0\xdfn\xc4~_b6\xb9\x91\n\xa2f;8\u007fv\xae\xb7\xf6\x01\u00ad\xa5u\x9b\t\xae\xb4\x027ڢ\x8b+V\x8bdpu\x15\xbc\x13\xb4\x94\x89\xe2\x84\xc8\xcc^0\xb3)[(GYf\xf6\x12\xd2\xf8ˬ-\x9eq\n\xd1\x03m,*o\xe7\a\x19+?\xce\b\x8f\b\xdb\xf8\xf8a8d\x92\xdaR>\x8b}\x191b\xde'\x01m\xe9\x1b\xdc\xfc$\v\x9a.\xe2\xcc\x10\x1a#\x9aST\x9chAKk\xc9\x01\xd1v*\x0e\xb7l\x18^\x15o\x8e\xbe\x12\xe4\xcecխ\x05c\x8bٜ|\xf8I\xc7\xef\xc24\x8bM\a^\x10^\x8f,5\x17\"\x1f\xcd\x02Yy\tONIO\x1f\xf6\x15\bfi\x1eh\x96&k1\xf0G\xb3!\xe1\xaaOw\xb3e\b@\u0557\xae\x11Y\xb7zpi\xdb1\xba\x99\xea\xee,/\x19\x99\xc0\x9d\x92\x85˻vf/\xaa\x10KrJ\x96.\xefә\xbd\xac\xc3\xf1\x1c$\x1chl\xc0\xf1\x1c\xc4}\xd9-\xe3Xk\xea\x10o\x92\xee\x92\x0e\xc8\x05\xec\xdc-ٗ\xcaE\xbeE\xf8\x9c\x04K\x8e\x10FS\xa0\x89%\x8c\xba\xf2\xa6_!V\xfcA\xd0z\xc9\xe7\x13L\x92\xe4\xb6\x1fh>\xa4\x99\"\a(\xc4\fj\x83\x1c+\xa8n\xc3,\xd4B\x19\x94\xb9\xa0L\xc7\u007f3\xd8\xd6\x04\x9f\x03\u074c\x9b\v\xb6\xb0\x18\x04\xd5\xfa,\xc9R\xd5\t2\x0f\xa7\xb4\xfc\xe0\x1bO{\xed\xe7\xd4\x12ߗ\x81\xbf<[NG\t\u007f\xbe\xc08\x9bǁ\xbf4,b\xbcKį\xf39\xcd\xf8\xcf\u007f\xd1 \x96\x1f\xe7\xd7\xf3T\xfc\xfe.\r\xf9\xaf3?\x9f\xa7\xec\xb7ؐĊ\xe6\xf9؞\xa5I\x9e\xf0G\x16\x913W4g\xf4\xf4I\xb3\x89\x98_\x87\x99}i_\xd1\xfc\xc7\xf3W\xaf\x95k\xcby\xf8!\xa0X\xde\x03ò\x8a\xe0\xbby\x14}\xa2~\xba3\x16Q\xa0\x8a\xea\xfbd\x9ef;\xe3\x01\xe8*\x92wa\x14\x85\x19\x1d'q\xb0;.\xb5P\x1d\xcax\x0e\x11a;c\x03\xf8\x1aDI\x9c_\uf386AW\x91\x9cݳmgM\xcd:\x0f\xa7;J\x0f\x83\xac/\xff\x9f$\xa6\x1f&\x93\x8c\xe6\x9b09ZQx_\xf1\xc3d\x87\xaa9d\xa9\xeal\x83\xe0\x8b!#\x87\x8b\x9dI\xe1W\x82\xc9\xecK5\x9c\xac\x8a}yO\xe4˝qo\x1c.M\x15\x88B;\xd7\xd2<\x92\x9a\xaa\x80\x12;\xe3\xdf:Ț\xaaQ\vޣ\xb6\xe6\xf1\xd7\\\x11\x94ٽ\x8eơ\xd9X\x03+\xb13\xfe\xb3\a0\xeb\xec\x9e|j\x1cе\x150\xe8m\xa8KN\x10\x1d\t\x9f\x10\x8b\xa9\xa9\f9Iҩ\x0fc\xf5\x1c\xe7O\xe3\xb1O\x1e\x8f\xc8cJ\x1e\u007f\xdf\u007f\xfc\xae\xff\xf8\x8c<\xfedX5\x05\x00x\xba\xff8\xd8\u007f\xbc\xac\x00Hl\x1c\x89Q\xae8\xf0\x97\x19Z0\xa5\x89Z\x9b\xbc_\x8eF)\xbd\r}Ƭ\x12\xb84\x86\x95Z%85\v4S&\x04|\xfe\xff\x87\x1f\xcf\xfd\x14\xa7t:J\xc5\xefw~:\xbef?^\xce\xd20\xc2\x14\xc8\xf8\xc7<\xa6\xf8o\x04\xdf/\xe7W\xf3,\a;\x80\xcer\n\xaf\"[\xc4\xf80\xce\x13\xfe\xf3}r+\x93_\xd31\xfe\xe6\xa1P\x059M\xedBZ7\xb7L\xb1\x81l\xccT\xad\xb2\x9cNg\x11\x03+;\x05\x10\xb4\x15(y|\x99\a\x11\xd6\"\x98\x89/V\xb8Gdl\x91\xc9\x0e\x9bP\x13\"\xeb\xb5\xc7\xd7~\xfa*\t\xe8˼\x15\xc2\xcb\xd4\aǥ{\xaa\xa0>\xf4\x9b\xc8R\xd9|\x84\xe9\xad\xcf\x16\tM\x8b\xb4&\n[\x90\xf6\xecbL<\xa2U\xf42g\xf4\x98C\x93\xbc \x13\u07b6\xbe\xf6\xb4\x03nO\x85\xa4\xadF\x17\xae+\x1b\xf4\xdbI\xaa\xdc\xe8\x8ae\xc4\xf5\xb8\xe5\x85_\xac\x92Z\nc\x98\xfa\xb9=\xf3\xd3L\xf3\xed \xbej\\\xbf\xb2(\xeb\x13\xf7\x99\xe3\x14qJ\xd3>Q\xbe\x82>q\x8b\xafﵼw\xdaי\xf6\xf5\xb6O\x1cy䅄\nہ\xc6V`ɆX\xbc\xd5\xc5\x156\"\xb4\xeb\x91'\x18R\x8a\xfdU=-\fԘ\x19$\x8cI`\x92\xc0\xfe\x9e\xd5e\u007fO\x1e\x13\xb7˖;\xf6\x8c<%nW{\xb6\a\x15ML\xbf\b\xaaDn\x00}T\xcc֭\xc0^Z$\xb0\xa7\xec\xafb3N\x80\xa1)\x1a\xd8߳\xecw\xec\xaf3\xf6\xd7\xdbr\xb7\x06\xf2\xce\xe8\xb5\xd6_yr&\xc6\xc9\x06\x9b\x88\xf3\xa9n\xfb\f\xf1\f\xea\xd4\xf5\u007f&a\x14\u1cc9|D\xb7\f\xa7\x1b\x18\x8a\x12\x03\x90\x83\x12\xc8A\x15\xe4\xb0\x04r\xa8\x81d5\x15\xb1zj\x94\xf3\x0f\xb42\xfe~\xa0-U\xff\x9a5\x9azk)M\xf1\x95\x95\xf4\xe6Ҩ\x1b\xcbe\xde&\xc9\xcd|V)\x87\xc9[\xca\xeeBsU]\xd7c\xb9\a\x1d:\xb6\xfa\x19\xb9\xb8\xd5\xc4\xefמ\xa1.\xdeb\xafe\xedE`\xe3ʱe\x0e5\x1b\xe4\xe5\xae\xe8\x9aQ\x8cvBQm*\"\xe4K&\x1d\xe57\xbb\xa3܄f\xdc/͌\xadz;\x87\xc7z\x06;Պ\xa3\xb3Ź\xa1>`\x86X\xe8NX\xb2-X\xbe\xbf7-|q\xad\xa3y\xf3P4\xa8\x84\xef\xee\x88\xdb\xd51~\xde\x1d\xe3\x01<\xb2.z \xf0\x97\x1f&\xa8\x99KD\xbe\xbd\x0fJ\xecmm\xf1\xafc\x9b\u07bb\xc9\\v\x98M\xa0\xa3zw\u007fT\u008f\xa0#\x9amF\xa4\xf2\xfd\xb9\xc7\x18\xff\x82\x18\x1f\xdf\x19\xa4O\x8c\x97\xef\f\r\xd5ٽi:\xab\xe7ӏ\xf7B\xc4;1\x03\x87YC?~\xd9\xdeʪ?\xea_\x0f!c\x9al c\xb1è\xe7#\xfe\xa7-\xa0\x8arXޛ\xef\x85\u05cc\r&\xc7ѩ\xfc\xb4;\xbe\xc3:|\xf4P\xc7\xf7\xff\xfaE\xa1$\xe6\x97\x0e\x18\x8f\x8d\x8d\xd5\x18\x8f\x8dM\xcbFf\xef\xa5\xdaԣe\xfd\x8b\xd2\x1b9\xd1\xc8\xe9\xa4\x0eDN\x14Z\xe6\xbbbJ\x90j\xbf\n\xa0\xa8r%\xebm2\xf6#\xcaX\"u\xb7\x96\xffZTJ\x9b2\xbe/e\xb0\x01\xd8=\x94j\xb31\xefm\x99HE\x17I\rTm\xc6\xfb9[\xfaI\xbdRB\x01ZC\xea\n-\xf3\xe5\xf4\xe3T\x0e}-\xe7L\xadtQ\xcb p\u007f\x17\xa2^\xc9g\x02.\xe5[\xcbg\x92&%U\xcb\x11rX'21\xb4\x12L\xa8\xfd\xff\xf99{\xfas\xd0\xde/\xc3\xf8ӏS\xc5<\x82\xd5-\x17\xb0b\x053\x9b\xf6\x89\xfb\x95\xd8\x1f\xd7\a\xa9=\xcf\xc7\x0f_\xe3\xe6\xe9R=\xec%w\n\x14\xd7\xfd@\xdb\xc4\xc1\xdaj\x96\x1c\x84eٗ\xac\xac\xf2\xb2\f)^ \x1d\xfbQk\x9e\x8f\x95\xb7\x00'a\xecGQ=\x01\xaf\x15$\xeb\xca\xfb\xb8c?Bv\xa9\xdaJ\xb6\xfc~k\xc9{\xb3\x80/\xbb\x80\nD/pVZ\r\xa0{{\xb8к|p˛\x16[H\x82H\xd8uE\x85Yo\xb2\xa4\xc2B&K-\xe3\xf1\xa7\xce\xe3i\xe7qp.\xfcS\xf6\xe3\xb7\xffϨ\x13\xbd\x10p\xe8N4;Oޜ}\xe0$\xbe\xa8V\xfa\xde\xcf\xc3[J\xfa\xd5\x1cu˨\x04\xbe\xad\x17K\xaba\xb0!\xb5>\x11\a\xada\x1b\x19\x87\xc0\vX~3B8\xc3כ\bP\xb8^\x01\xd1z@\x1a\a\xa0\x8f\x14p8r|\xebGJ\xe0\x972\x14\x85G\xa0\x18X\x18\x122\x89\x92$\x05H|\x90\xd7$O\xe1\x1f X\t\x82\n\xc0\x03\x91\xc0V\x86\xc0)\x96\xf7\xb0\xfd\x01\x1fr/DĖ zQ\xac\x19u\x89L\x81Lۛѵ\x13\x9f\x04\x14\x19\xc3\x14;\xf5\xe3+Z\x03\xc9\x15Y\t\x9a)\x95J\x89)L\x11\xbf.o\x8f\xe8!c\xc0\x117%~\x03\xde6\xa1n୶\v\xa7\xf3\x16ۯ\xf2\x16S\x9a8U\xe6-\x87\xae\xe5\xed5\x1e\xe9ލ\xb3l\xdc\xe5|\x1b\x8dk~\xbb\xb2\xb3\x06oI\x8bG\xedj\xfa\xa2\xa63\x0e\x8eh\x8ft$jx\xbfN\xfe~\nٿU/5\xe2n\xe8&e\x1bW\xef$\xc6H\xb5\x8b\xd8w=\xbb\xcb\xdd\x03\x90\xb5\x9d\x13\xf8\xcb{\xf5\r«\xccv\x9f\x1d;\x85\x872𗺛\x907\xaa\xb0\xae\xadB\x1eqaX$h+uɕ\xe5}\xfa\x05P\xe8\xf8H[\xc0ޣ\x13x\xd1\x0e\xfa\xb1\xf5~\x90\x9b)\U000b35af\xe5N`pM}\x80k-\xed\x02\xbf\x12㗘/\xca,\x05sun)\x02\x88\x92\xdf\xc1\x82\x1d\xd2j\x1cJ\bR\x97%t\f\xd9''G\x87T{>\xb6\xbc\x91T\x8dBe\v\x93\"\xb8\x95\v\x9a\xbf\xb4\xf3\xe4m\U000859af\xfcLn\xa1\x87\xc4#Ǥ#\xceR\xe2\xc1\x1e\x14Ţ\xcd\x17\x81\xbf\x1c\xee,\xac\x04[\xac\xf3\x1f!\xcc&AQ\xb8\x04+i\xd2f\xf4?&\xc7\x1b\xdc\xd6[\xe4r\x9bd\xd6j\x8cc\xb3\x1eyi\xbb\x82\x0fݲ@\x94\x1c\x01\xf5\xb2Q\xf5\x1a\x01\xfe6\xe3\xc0\xb2h7\xd9g\u007fu0\xf5\x91\xe7\x91\xd0,\x9f\x10\x91}C\xda\xc4Ȍ!\x1c9\u009e)D\xbd\x0e\x90\x0f\x0f\t\xac\x8d\x8dJ\x01$\x13\xd0\xff\x19x\xa2\xec9\xe8\xca\xe1\v\xa57\xaa\xd1\x01\x1e\x9d2@V\x81\xa8j\x05\x80+\x9b0\b[\xabE\x18\xbcT#u\x0e%m\x16g\xaaw\xe7\xb1\xd44\x90\x06U\xd5떵7\xb7\x84wT\xdf8%\x94&\x88\a)p%V\xa8d\xea\x88\rj-\xa1\xc6\xd0\xc1\xcdᒝ\x03\xb0\xb5\xfc\x97\n\xfa\xd7\xe3(\xb6\xc1\xb1\xc8/\xe4\xea\x86\xd9\xf8A\xbc\xd5\x03\xd6t\xf626d\xa51XϬ2o\x01Rc\xad\xbaƄwm\xcfr:\xc3\xd8\x02\x97\x1eX\xa4\xc7\xfer\xe1\xef\x03zh\xb1\xb9\x8a\xfd\xeaY\xe4\x19\xfb\xcb=a\u007f3\xf3\xcb\"\xae\x03\x1f]\x17\xbe\x0e\x0f\xba\xec\x1f\x98\xd4,\xe2\x1ew!\xf3\xc89D\xa0\u07b3.=\xb2\xc8\xf1\xf1\xf1\x11\xfb\xf7\xc0\xed\x1d\x1c\xd1#\fA\xac\xd0\x04\x9e\x9ew4\xbfN\x02$\xed\xa2\xb4Ⰸ\x8b\xf7엓{\xf5\xc9nC\xfa\x81\xa3\xa7\xa3\xcd]\xc1.\x92{\r\xd0\r\xe9e\xec\xccd\xac\xe0\xc6ă\xbaģ\xda\xe2]=\x15L\x02\xb7&\xad\x04\xc7tY\xb5a\xe0\xaelH-\xd1\xc4d\t@7u\xdawr\xefO\xed4\xb1S\xfb\xf8\x93aZ5\xde]y\x94\x04o8X\xeb\x15\xcb\xd2\xdfl,\x1d\x94UT\x03\x96\x11y\x1clGĭ\x89G\xfc\xb2\xa9&d\xfe\x8eȘ峷w/\xd4o\xc8\xe3\xd9v\xd4\xeaʦ\tQ\xff\xf1\xbb\x1d8\xa7\xadd\xebQ\xf5\x1f\x9fmG\xa4\xbb\x1b\xea\x11ُ\xdf\xeeBQ9^v\xdd,|a,giH\xb0#Hi\xa9\xb1\x03%)Uf\x16\xc8\xfaN\xdfO\xa9\b\xb5\xa9Z\xe9\x15=%&\xab\xe2$\xda\"\xa7qn\x15\xe7\xc7\xcb\xfb\xa9\x05\xddv\x90L\xfdP\x14т\xa7\x00\xea\xef4gs\x83i\xday8\xbe\xc9ZS\xb3\ns\xc6a\xcaQ\xe6\xd5G\xd07\xd1\xd3ډ\u007f\xbc\xc9ͬ5k}\x9a\x00\xf5\xe3\xf9\xabB\xb37sS;\x96Xf\xe1\x05\x99^8`\xecZd*\x1f@_\x9bM\x15n\xd2J\u009d\xfap\xcd\xc41젝\xf4x\xebM\xc8vSR2\xe0~\xb32\xe18\xfd\xddq\xaa\xea\xea~\x95즵\xf4\xc8\xfa\x8d\xf8vR^\xe5x\xf8\r\x18w\xd2a\xe5\x88\xf6\r\xf8vRe\xb5\xd1\xff\xcd\xdaL\x8a\xec.*\xaa\x90廒*\x06\xdbo\xa9\x9e~<\u007f\xb5\x8b\x86\x02\xa6nRR\xe5]\xb2_\xa4\xa8\x8a\xa6[\r\xcc\x15T\xac͖9\xf8\xaf\xbf\xfe\xfc\x1f\xf8C\xd34I3\xfb:\x9fF\xbfY\x1d\x8e\xeb\xb0?\xff\xe58\xb5\xff:GǇ\xf27\xa4\xbb\xee\xa1\xe3\xfe\x17q~\x0f\x06̳\xdcO\xff\xcb\xf9\xc5u\x95\x1b\xf7\xbf\xe4\xcfj\xb5\xff\xf4+B@Q\x05Y\x9f\x8c\xfc\x8c\x824|\xf5t\u007f\xbd\xfej\xb5\"\x01\x9d\x841%F\x1e\xe6\x115\xc8z\xfd-\x88\f\x19\xcd\xc3(\b\xe3+\xb2Z\x11\xfb#\xbe\th\xbf\xf7\xa7\x94\xac\u05eb\x15\xa1q@\xd6\xeb\xafN\x83\xf0\x96\x8c#?\xcb<\xe3\x9a\xfa\x01M\t\x88\x9c\xf1\xfc+\xc2J\x8a\xddmb\xa44J\xfc\xe0rJ\xb3̿B\x1a\fb3\x1c\x84\x9c^\xbb\xcfY5\xaf\x92y\x9c\x93\xf5\x1aql\xa6\xe1t\xff\xda}\xfe\xd5\xe9~\x10\xde>g\xed\x80\x05=\xb19\xf5\x88\xb6J\xdc8\t(\x99Σ<\x04\nY\xd5\a\xac\xea/a~MlfpA\xd4\xc1z\xfd2\x87J\xc9z\xdd'\xb2\xb9,\x05*\xc0\xfa\x0f\x10\x85,\xfe\x8a!_\x8b\b\xf4\xd3YJ\x9f\x9f\xb2\n\x9fsL\xa7\xfb\xf0u\xba\xcfrDQ\xceHB\x8a\xa6\xf0\xa4_e\xfc/\u009c\x06\u007f\xec\xf8\xefu\x8f\xca\xe3\xff\xe0\xf0\xe0\xaf\xf1\xffg\x1d\xff 2\xbf\xc5\x10G\xcc8X` \xc2xZ\x849\x1f88\xa4\xe5\x98\xe4C\xed\x8e\xe4\xc9%\xc3%\a]1Rp\xdc}\x98\xe7\xb3y\xbeq\xcc+\xa3\x1d\xa1\x8b\xe1\xbb}\x9c\xfe&#\xf3\xf7\xf93M\xe20O\xd2\xdfT\x01l\x19\xff\xdd㣃\xf2\xf8\xef\xf5z\u007f\x8d\xff?\xe9\xf8?\xcb\xfd|\x9e)\xe3?\n\xe3\x1b\x92\xd2\xc83\xb2|\x19\xd1\xec\x9a\xd2܀\a=<#\xa7\x8b|\u007f\x9ce\x06\xb9N\xe9\xc43V+\xe2g\x19͉\x91\xb2eϵ\xffŞ\x86\xb1\r\x10\xeb\xb5\xf1\xfc\xabS\xc0Q.\xcdF\x19\x9c\xfe\xca\xf9\ng\xea\xa7Wa\xdc']g\xb6 \x0e\xfes\xfc\x98G\x94E\x89\x9f\xf7\xe1\x8dYq\xe1@\x16\xe2\x1b>)\x8d \xf0\tӿ\x84A~\xdd'\x87\x0e/xMë\xeb\xbcO\x0e\x1cg\xb6\x10\xd1iqޙ\xf8\xd30Z\xf6\ty\x99\x86~d\x91\xefitK\xf3p\xec[$\xf3㬓\xd14\x9c(\xf0Y\xf8\x1f\xda'n\x17\x91\xac\vگ\x0fJ\xe4w8\xfd\x0eq{Z\x95\x8d(l\u007f\x11f\x1cK\xd1.\u007f\x94%\xd1\\\xec\a\xff\xa7ï\xb3f\x03\x0f\x93\xf2d\xd6\x17\a\xbe\xf1\xc6\x13\xf9)\xb9\xa0\xd6\x15\xb1\xc5/\xbf\x026\x82\x83ѣ\x84?y\xac\xd02\xf6\xe3[\u007f\x1b5jլO\xf8\xc7\xfa\xab\xd3}\xe8\xeb\xe7u3\xc8\x17?\x8d\xc3\xf8\xcaxΧ\x86\xbf'q\x90D>\xc9hzKS\x92\x81\fj&\xdevc\xee\xf4\xba\xfb\xfc\x1d\x9d&\xe9\xf2t\xff\xba\xfb\xbc4\x19@\x93\f\x12\xf8\xb9ߙEI\xee\x19S:\xb5_FQ2V&\x87W\xf34\xa5q\x1e-\x89\xcfr|6_\x8d\x969\xcd\xc4|\xc1\xa9\xd9\x05\xf7\xf7ԟ\xe1\xa3N\x99R\xc3\xdb\xf0\x96\x92\x04\x93\x1f\x80\xf4l\xa9\"\xfb\x86\x91Fƒ\xe8ܿ\xa11\x99\xa4ɔd\xcb,\xa7\xd3\a\xd4\xf0\x0eZ\x9eY\xec\xf7w)\xa5j}<\x8f\xf8q@ o3~&Y\xc6sс\xfc\x9fl\xeaG\xd1\xf3\xf7IN\xfb\xe4\xfc:\xcc\xc8̿\xa2$\xccH\x123\xb6\xdf\xfaa\xe4\x8f\"\nG\n\xe9h~E\xa6I@\xed\xd3},\xf7\xd5i6N\xc3Y\xaej\x90\xcf\xfe\xad\x8f\xa9U\x9bğ\xcd\xec\xcf\x197CN\xf7\x11l\x1b\x16\x92\xa5cU\x97\x05\a\xf6m\x17\xd00\x1d\xf6P,\x9aF\xfc\xa5\xc8\xc4\xc4^\xc5S;\xff\u007f\xce\xfe돲\xff\x0f{\a\xdd\xf2\xfc\xdf\xed\xfe\xb5\xfe\xff]\xfe\xdc\xfa)y\xf5\xe1\xc7\xf7\xe7\xc4#l\xde\x1b\x90\xfd}\xe2\xf6\x1c\"\x02]\x93\t\x8c\xff\xaf\x18\xe0\x9b\xf7\xe7\xdf\xfe\xf0\xdf/\xdf\x12\x8f\xcf,_)\xaf\x94\xf9\xb3\xeb3\x9a\x864kшNi\xf1\x847wF\xf2T\xfb\x8a\xe6/\xf3<\rG\U000dcd9eH\xdd\xf2Ĵ\xb3Y\x14\xe6\xad'\xd6\x13s\xf0\xd5Z\xc1\x1d\xc6a\x1e\xfaQ\xf8\x1f\xfawVK\xd6ҮgD\xb4\xb07\x92\x8c碊oy\xf27\xcbWL\u05fc\xf7\xa7\xb4\xf5\x04\xf4\xd9\x13S\x96\x05\xa2\xd5\xcb\x19\xe1\xfa.?\xa2\xb9\x8c\xb9\xfeA\x8c\xc9WI\x94\xa4\xf6G\xccl\xa9\xf7\xe7C\xa0\x98\xb8\xae=$\xa7\x92$\xf94p\x18\xea7\t\"\xddē\x90\x17\xa1z\xcf\x15ˆY^i\x12\xbe\xfa\xc7[\xd5z\x12\x84\xb7O\x94\xb8|\x06mg\x1acA\xc5>\xb1\xc8\x13\x96\xa7\xc2\xd2\xc8\xf6g3\x1a\ax'\x1c\xcb6\xf5\xba\xf9\xac\xbes\xed\b\xdfT?\xe6n\xa0\x00\x01J4d\x94?jxQb\f<t\xa8\t\x9bYz\xa5\x19nw\xe4\xaf\x19|\xfeLNI\xd1\x11\x9fKW:\xe2\r\x1d\xf0\xd8\">\x19\x12\xfbS\xda'\xd9\xc5\xe7\xcfC\xf5\xc9I\xfdƯ\xab\xb2l\x80X\xb6t\xb4\xbck\xfb\x9c;\x96\x96\xc9M.\x1a\xd9\x18\xf7\xf2/\xf6\xad\x83\b[T\xc2|\x0f\t:PJ〦4\xed\x93'~J\xfd'zn\x96\xa7\xc9\r\xed\xc3^\x9cU\xd3\xe6\xbe\xde\nd\xa8\xfd]\xb8\xa0\xc1\xeby\xea\xe3\x81\x04\x8a\xefI\xf2QaU\xae\xd7&\x10\xc2\xfc\x86\a\x1a\xf5\xa5\x8e\xb0*pS\u007f\xf1\xda\xcf}\xb8\xbb.\xeb\xa3ֱj\xb1}\xe3g\xb4_\x1cy0\x95\xf0\xe6}\xd0<\xfa\x05\xddf\xed3\xa1\x9cb{\xcc\x06\xaez=\xfa\x95-\xba\\\x8et\xfeK\x97\xb4%\x1f\x83ծ\xb6_\xb2\xf1\xf6\xa9\xd4\xe3 \x95}r\xa5\xb7)\x0f\xc77\xb8{\xd2/\xb0|\x17.\xf0\xd9h<1Ʒ\xc7\xfe\xf9\xee\x9b\x12C\xa4\x101Rj[\tD\xda(\aZ#A\xb51\xbd\xc2ƋzSEF\xf37\xe5\xb00\xd2\xd2n\xbd\xa6q\xf0\x03\xfd\xf7\x9cfy\xeb\xc9\xfe\xe5\x15\x9aޗ\xdcT\xb8\xf4g\xe1\x13\vN\x99(\x9bx)\xfdwy`\xf1\xd3+\xf0v\x89\x9ff\xf4\x1f\x19\xc2\xd9)\xcdfI\x9c\xd1s\xba\xc8Kwl\xd6+TlL\xa3:\xd5\xc6&\x03\x15*\xa2\xa4W+p\xaf\x91\xbaպ\x1eFꠚ\xc9\rP\x9b\xd5r\r\x1a\x88*\x0f\xba֫!\xb5\xe2\x1b\xe2\xf1\"L\x11\rj\xe1d\x03.n\xa0\x8bi\xfe:\xc9s\x1a\xfc\x93B\x14\x9fo\x91\x9b\x1a\xf2֕\x94q\x12gID\xed(\xb9jI\x9c5%!O\x8c\x1c?\b\x18\xd8\x0e\x05\xaa\x92Y\xba\x1d\xd5\"O>gI\xfc\xa4\x886\x16\x1a\xa4d\x05ԶP1\x04n(\x04\xc4\xdf\b3\xc2~\xb2q\x92f\xe0\x8d\x12\xc5\xc56\x00\xf6\xd2%t\xf6\xb0\xe6a\x15\xb0\x8c\xd6_}U\xb5P\x06\xffK|\x803?\x0eǿ\xad\xfb\u007f\xab\xff\xcf\xe9\xf5\xca\xf6\xbf\xdb\xeb\xfee\xff\xffI\xfd\u007f\x1f\x99\xc8\xfc\x16\xee\u007f\x81\x18\x9d\xf6g\xf3\x11TI\xd6k\xc2}\x02\xd2\xfd.?\x91\x86{\xee\v\x00Y\xe1\x84\xd8o\xb2\xd7\xf4\xf6\f=J|[\xee\xf4\xfa@4&\xa0\xb7\x1dt7u\x8a\x06\x01L6\xf3\xe3\xe7\xe0\x9a\b3\x12'9\xf1\xc9h~E\u0098,\x939\xba\x9dN\xf7\x01\x88\x97\xe0n\x8c8\x1c\x93k0}i@Bx\x14\x84p\xc7֓\x8c\x04\xf4\x96FɌ\xcd.\xdc\xcde\x93\x8f\x11\xf53j\x91S\x9f\xfbO\xaf\xf3|\x96\xf5\xf7\xf7\xaf\xc2\xfcz>\xb2\xc7\xc9t?\xf5\xc3x9\xf6gt\x9f\xcf\xd4\xc6\xf3\x94Β4\x87\xfb\x1aI\x98esz\xba\xef?\xb7\xb1\x81\xdc!St g\x86\xb2\xb7\xb9\xd36\t\xdf\x00U\x8a\xfd\xf6;\x9e\x85p\xc0c\x8cM\x94\xea\xceÃ\xe7\x00-=Q\xf7\xdfΑ\xd5r\xab\xa8\xb1\xe20\x9e$\xb2V\x0e\xfc\v\xea\xdd\xe2\n\xbb\xa21M\xfd\x14dIu\x85\x91\u007f]Ә\xa4\xf3\x981\x81\xe5\xcd\xd2$\x98\xe3,\xca\x00,\xb1}\ue9d4\xa0\xa8Ѐ\xe4\tɯ)\xf1\x83i\x18\x87Y\x9e\xfay\x92\x12:\xf5\xc3H\xfa\xd6\xfe\n\x97\xf9?\xf7g\x96&\x930\xa2\xf68\xcb\xfe\xa8\xf9\xdf=>r\xab\xfb\xff\xc7\u007f\xcd\xff\xbfǟ\xbf\xf19\xa3\xc3\x05\xa13\x9a\xe7y\"\x1e\xe6\x0f\xc2l\x16\xf9\xcb>\x19E\xc9\xf8Fٞ\xfa½\x13\xa3$\nĦR\x1aд\x93\xfaA8\xcf\xfa\xa4+v\xb3F\xfe\xf8\xe6*M\xe6q\xd0'\xe9\xd5\xc8o\x15\xaf\a\xdb=S<\xb8\x16%i\x9f\xfc\x8dRZް\x9b\x84\v\x1a(\xfbHr\x97,E\x026\xed\x9a\x112\xf3\x83 \x8c\xaf\x14\xb0\x9c.\xf2\x8e\x1f\x85Wq\x9f\x8c)[\a\xd7l\x999\x0e,=\x04o.9o.\x99z\x87\xfb\x11\xca쉓\x98n(\x12NR\u007fJ/\xbf\xa4l\xee\x17\xdbj\xbb0F\xa7\xca\xddƜ\xea&[e\xb3\x8f\xb3M\xdf\xfas\x1d\xb1\x03\x9a\xdc\xd2t\x12%_\xfağ牞\xd6Y\xf6I6N\x13q\xb9cSz\xe7\v\x1d݄y'O\xfdXP\x9a\xcc\xfcq\x98/\x89cw3\xc2L\x9aN\xc8_\x1a\xe9L\xb3\x1d!\xb7Cm\xe9\x00\xce\xf8FVqn\x9c\x14\xbb\xbfS\u007f\xd1\xe1\xa9\xcf\x04\x8b\xb4\x9d\xe7\x82K%\xf9\x97\x02W7\x846s\xa8\xb73\x87z;q\xa8\xa7q\xa8A\xff\xff\x81\xf1\x1f\xce\xf1qE\xff\xf7\xba\u007f\xc5\u007f\xfdn\xeb?\x12$\xf1\x93\x9c\xcc3Z,\xd6`\x171\xb3H\x96\x90/\x94\x8c\xfd\x98\x8c\x938\x00ق+~\x92i\xc8\xd6\x17tJ`\x9dx\xdf\x18\x10\xe9T\x84\x13\xaa\xb1\x1f]\xf2mK\xc5\x1e\x91\x01!;m{n\xc5x\xdf\rU\xbeH\xfa\x9a)\x10\xd2\xf7\x8a\nT\xcdB\xbe\xfeo?\xcd\xecW\xf9\x02\xd7\x04\xb7~J./\xebA=\xf2D\xe2\xbb#\x9f\xb3$\xce\xc8z\xfdd\xa0\xee:\x8be^AG\xbf@wv\x9d|\xf9\x88\x18\xdfē\xa4e\x1a$\f<\xa3~\x067\x9e\x8b\xca\xeco#\u007f\x96Q\xb6\xa2 m\x96\xc0\x16\x83_\x95\xc6\xff\x1f\xb8\xff\xdb\xed\x1dT\xe2?\xdd\xe3ÿ\xc6\xff\xef\xf1G\xbaW7\x88\x19\x9f7\xc3\ti\x15P߇\x01\u0560\xaaﱕ\xef\xc7\x16\xf6\xcfN\x1b\x8b\x1c\xd8\x06ub\x8b\xd9L\xbee+\xb2\xe1\xc1\xe4';X]O\x94[=\xd0\x1ah&\x03\x01\x9e\xc8\a\x96\xd8W\x13!<\x17\xa7\xffJ2\xfc\xfdM}\xdeVҟh\xe01\xd2,\v\x17\xed\x01\x8b\xb4\xb95,[\xf1\x85O7U\f\xb0\n\xa8\x8f\xc2\xe1\x91'\xfb\xe0`\xd8\xe7\xd0*\xcc\x14\x0e\r1\x98\x8f\x1f\xce\xce՜\xdcO\xafh^\x10͚\xa0^\xae\x82\xef\x187\xf6\x02˗\x9d\x00\x8f\x11\xf3\xf7\r\x9e0]\xfdD\xcd\xe0́p\x02-\x03\xde*!^\x93RV\x9b\xaalICْ j\xf9\xd0\x1e\xdcw\x94䏒`\xa9A1\xb4\xe22\x85F \x8e^\x97\xb5$\x8e\x12?\xa8\xbfw\xbc$\x90\xb8O\\\xf0x\x9c\xc49\x8d\xf3\u007f\x85q\x90|\xb1\xf5z\xd1JǍd\xd2&Of\x8b'\x83z\xac\x85\x98\xbb\xea(\x06Fe\xf3\xd14\xcc[\xaaD\xcd\xfc\x94\xc6\xf9\xfb$\xa0vJ\xa7\xc9-\xad0\xa0i8\xbb\xfa&\xcf\x06\xfd\xa2\xc5|\xd4G{|\xb3|\x13\xb4vR\x06\xca\xdbi4RyK\xa3\xa6\xc6h\x81\x06\xa5S\xae\xa5m!\xfe\x94\xdd\xff\x868p\x95I\xbf\xd5\"`\xcb\xfc\xdf\xeb9e\xfb\xbf\xdb;\xf8k\xff\xe7w\xf9s\xfa\xe8\xf5\x87W\xe7\x9f>~KX\xe7?\xff\xea\x146L\"?\xbe\xf2\f\x1as_6\xf5\x03\xee\xf7\x9f\xd2\xdc'\xe3k?\xcdh\xee\x19\xf3|\xd291Ԭ\xeb<\x9fu\xe8\xbf\xe7\xe1\xadg\xfc\xd4\xf9\xf1e\xe7U2\x9d\xf9y8\x8a\xa8A\xb8j\xf2\x8c7\xdfz4\xb8\xa2ZI\xa6\xc1=\xe36\xa4_fI\x9a+\xc0\xb0\xfe\xf6\x02z\x1b\x8e).\xc6-\x11\x11ց㩞k;5\xa8\x02\x8a\x06t\x98\xc4\n\xb6\x1a@\u007f\x9e_'i\rL\xfd\x9a\x06\r\xf4\xfd\xfd\x98\xe6A\xecۣ$ɳ<\xf5g\xe3 \x86\xed\x18\x99\xb0\u007f`\xbb\xb6\xcb\x16>E\x9a\fv\xe7U\xc0\x0e\xd7s\xd4sa|E\x98\xaaK\xa7\xb0\xa1r\xba\x8f\x99\b\xb8u\xa9\xa2]\x8a\x9b'WW\x11=\xf7G\x11-\xe97\xb8\xda\x10\xc2g\xbd\x92\xaeS~\xc6t\x91\x9f\x85#F\x91\xfa[\u007f\xcf\x10\xb0\xd8c\x11]\x87o\x0e\u007f\x98\xb4\x9e\x8c\x93\b\x17\x1cO\xccʃꄔ\x8a\x11\xaf\x9cb\xa7t\x16\xf9c\xdazB\nL\x16y\xf2D\x8b!\xa0\x91\x1d\xc61M\xbf?\u007f\xf7\x96M\xfc{\u007f{v\xd4s\x06\xca|V\xf3\xeej\xb9\xee\xb6G\xd4J\xb6\xe1?\xd2\xf1k\x97\xd9\x16k8쮦C\f\xec\x8f-Vl\xb0\x14,\b\xfc\x92\xa4A\x87\xcdP}2J\xa9\u007f\xd3a\tE\x85\x8a3\xaap\xd8\x15t\xe4i#\xde\xe6B\xb3\x946\x96\xba\x0es\xda\xc9f\xfe\x98\xf6\x19\x1cPV./\v\xd3[\x1a\xe7\x9d(\xccr\x05\x85\xea\xe2D\x17i\xa94\x96\xc2m^\xb5\xa3\x1a|\xcc\\\x84\xe1]j?\x12n\xdc<\x99\xa9\xd9\xdc\xe7\xdb\xe1\x9eNW:\xf3\xf4l\xf0\x02>\xb3{EnA\x15\x17\b\x92\xcd\xfcX\xa3K:\xf3\xc28\nc\xdaQ|z\xbbb\xdf\xecp\xafx\x95ɣp\xca\x14\xa2_<\xb6,锒\xab\x14\x16a\x83Π\x8a\xafDm\xe1\xeb\xbd\x0e\x83\x80\xc6\x15\x81\x025\xa1\xbe\u007f]x\x17\xfd(\x02\xcfb\xb9\x8co\x8f\xa3D\x1bu\x8a_\xfe\xa0\xabv\x05\xfaQ\xeb\xbaI\x8e(<\x1d\x02{\xd6|\n:ev,\x1fc\xbe\xd8|\x9dͣ\b\xf1\x10\xa8ݨ:QP\xb9\xd9\x1b\xccK\xe3\xf9^\x1eNi6\x00\xef\bT\xa0\x9eTH\xe2\xdc\x0fc\xaa\x04\x01(\xb93\xff\x8avp\vX\xe6\xf3\x90\x86:\xcd.\xc2\x19\xf2$\xf7#<\xe3(=4b\xabU\x844 'x\xd8\x02\xdf\x1f\xe7'\x99\xcf\xc3i\x18_e\xc5\xf6:\x9epa\xf8\xf8\x11h%nb\x96\x86q>!\xc6\xe3\x80\xc0\xa0\xcb\fҊhL\xeco\xe1\xcbd\xd0\x1d\xa0\xe5\x1c\xa8b\x85\x853\xeao\x06I\xe2q\x14\x8eo<C\x9dY\xf2\xeb03\a\xba\xd1k<皘\xb1QiLW\xe1\v\n\x16\xe7\x1d~\xc0ߝ,O\xc3\x19\r\x14\x1e\xaa\xedEJ\xd5\xe6\"\xb6\xf4y)\xf6\xec4\x0f\x04v1\x94\x8d\xe7\x18\xb4Qa6K<\xddσ\r8T\xfdh<\xafĹ\xe9ͩ\xeaÚ\"j\xab\xde'9\xad4jC\xe3\xea\x1aYhQ\xf0\xfa\xd9\xe7<n\xa6\xaeaJ\xf9秳\x94ַr\xb5\"\x9f\x930&-\b\xa6#\xf69]\xe4İ\f\x93\x18\x161\x005\x8434Vp\xba_O\xba\x1a\xdeQ)\xc1\x18Y\xe9\x88J\x15e\xd4U\x94%Te\x006J\x92\xfc\x9a\xa6\x99\x1aX\xf4\x03\x9d\xfa!DLh\xa3\x90\vnq~i\x1fU\xd0\xe9>\x1a\xcc\xff\xcb\xf7\xff1&\xeb\x0f=\xff\xdf=:\xae\x9e\xff?\xf8k\xff\xffw\xda\xff\xc1Ц\xff\xcf7i\xe2\ac?\xcbi\xfa/:ʒ\xf1\r\xcd\u007fL#\x82qH\x9bW\x1f\x8a\xfbF8_0\x80\xedr>\v\xfc\x9cf-\x98Zs\u007f:S\x9dɏZƿ\xe8\xe8\f\xaa\x82\x17\x02\xbf\x80\xd3J\xf1$\xef\xef\x93o\xd2\xe4KFS\x12$\x14c\xed\xb2\xf9\f\x82\xdbd\xd1L\xb9z\x97\xc6l\xe0\a\x1b\xdcCR\xd1E\xe1-\xed\xa0\xf8wx\xb1\x0e\x0fM4\xb4\x97\x0ey\xa6\xe6%\xc2$\xee\xcd\xe2\x06\x16\xf1\x88\xc1L6\xa3l\x14A |\x98=\x882Q\xae\x9e4\x91k\xea\xe6c\x03qh\xb4֒\x97R?\x03?\xefÈ\xc3\xe2:m\x98\xa6\xef\n\xb0\x14\\W\xc1\x84\xe2\x11\xa3\x05\x11\x93#\xa5\x93\xe3'9\xb9\xf6oi\xd1\xc1\xa2\xcb\xcd\n\xe9\xf5\xfb\f\xf34b\xa8y\x94\x9d!\xf6Ɨ\xa5e0\x93q~\xbcCV՚\xa7\xaa\x9b\x8f\x81\xd8I\xccy\xaf]\x06v[w\xdca\x9a]\x11\x8f\xfc\xe3\xec\xc3{\xfe\x82\n\xbd\xb5\x83jx<c\xcf4\xbb\xe2\xeelOD\xc6\x1aug\x02\"\x1e_i#L+O\xe7\xb4\x1cM\x8f+\xdd\nV9\xe8j\x11\xc3\x1a^@\x90\xbd=R|<\xf2\b *\x0f\xda\a\xd0ְ\x12\xd7\xcf\xfb`\xad\xa5J\xb7\x1d^P\xce\x10T:\f\x17!\r\xees\xf6g\aE5(\xe3_\x93\xb1\x9f\x8f\xafI\x8b.\xf4\xd32p6)\x99\xe7-\xb5\xba]\xaaX[\xea\x9ba_\xad\xbfj,c\xaa\xbbĿ\xe6=#51ٿ{\xfc_\xcf)\xc7\xff\x1f8\a\u007f\x9d\xff\xfd}\xfc\xbf\xd7G\x04&\n\xcfP#\xe8\xd8j\xbc.VNܭ\x01\xab\xed\x81XË\x183\x97N\a\x04\x1c#\xc2\r\xe1\xda=:\x1d\x182\xb4\xfbk1A\xf7=e\x80\x84\xd9%\x9bV.\xb90\n\x18\x11\x92\x0fn\x185\xd2a\xd3ԍ\xd1\xec\xccP\xf8\xba\xc0#\x9a\xa8\a\xec\x19r \x15\xcb\tF#l&\xf6=\x92\xa4|%]\xbeZLB\x171\xd9_\xc2(\"H\x12\x04\x85\xb1\x95\xfe؏\xa2\xa5\x8a\x19,-D_Z\x00%\xf1\x98\x8a\xfb\xc4\b\x93\xc8<cՏ\x93\xe9,\x8c\xd0i0\t\xe30\xbb\xa6\xd9W\xf5\xeb\x1e\xf5\xc0\xc1f\x8eUL\ndك\xd8\x05\x97U\xf0f\x8b\xb3\x10\xe2\x82\x06\x9b|J\xe6d:\xcfr\x92\xd2IJ\xb3k<\x90\x00\xfc\x9a\xfa\xf1\x9c\xb1\xc7.\x16m@t\xb9f\xf0Y\x19\xdb\xdb\xc2-\x10}\xa5(X\xde(i\xa2\xf8%\xb7\u007f\xd6\xeb\x16\xef\x04\xb3nU\xa9p\x98\xff>ݿ>\xfa_\xbd\x06,\xdf\xc2\xf3\xfb\xaf\xff\xdc\xee\x91S\xbe\xff\xa1{\xe08\u007f\xe9\xff\xdf\xe3\x8f-\x04\xe0\x12O\xce\xda\x01\xcd\xfd0Z\xcd\x12\x88\xa3C?V\xd6\a\x05 \x03\x8f\xe5\xfd>y2\xeb;\x03\x11\xa5\xdc\x1d(\x0e\u007f\x1e\xd2\xcc\xfe\xb3]s\xc0\xa7\bg\x80\x9b\x11\xeel1P\xfc\xc9\"\x1e\xc0\xee\xf62\x82\xd7\xd3\x0e:\xd3\xe4?\x9dm0\xc9V\x88j\xa8m\rغ\x81\rv\x18\xfb\xe3<\xbc\xa5+^\xa8\xef4\x81\x12;\xcc)\x06\xcc(\xe0n3\xf8\xe22\xf2G4Z\xa97L\xe1\xfdRʝRzT\xf1\xc1l1\x10!\xedG\xb3\xc5@\xd4b\xf78 \xe3+ɒ(\f\xc8ߨ\xc3\xfe\x1b\x14\xb3:\x84\xc5W\xfbP鳿M&\x93\x81\xba\xef\x13'_R\u007f\xb6\xb9ɫ*JE \x1a鷻\xcc2(Q\xb7\x91\x17\xb2\v\x9a\xc4\xec\xd0\x1c\xe0I\x02hG\x85#%Pn\xbb@\x0483\\\xf87\x13\xe9\x0e\xfb\xbe/\x1f*]\xdfD批\x19Q\u007fD'IJW|?\xbao\xfc\xdc퍻FM\xd7\x01\xed\x1d0\xb1\xb0\xe1j5\xc7&\x1fk\x1bD6H\xf2\x15\x02\x1d\xce\x16\x03n\xb4\xb1\x9f*s:J\x02p\x87u\xa3ޱ\xbdz\xc9J\x16\x9d\xec\xda\x0f\x92/}\x878\xa4;[h}pdVd\x8fcE\x92\xbaE5`\x15\xf4\xa1\x1b\a\xc24\x00\x95T 茣p\xd6\x17\xfbo\xa3d\xb1\xb1բ\xb3\x04203\xca%\n\xc9\x16\xd7\xc6Upf\xb7W:\x8e\x81\xdcU\xc3M\xb5*\x15\x8b\xcb<\x1c߬\x9a\x94iIQ:\x82\x05(\xa7\xb3\x05\t\xe0\x10\xbc\xc6ɮ9\xa8\xd1\xd7MU\x13\x1b\xb6\nj(x\xc8h\xb4{5#E\x93\x1f\x14\x17h\x95;S\xbbŏ\xe3$\a\xe3\x16.\x1bg\xaax\xc5e\xd0-\xfa\x9e\xb1\xa5\xaa\xd6\x14y\x84\xc5ʦ\xae\xaa\xa9\x87\xd8Eb\r#8\x15L\xc7b7\x1c\x95\xc7\x04\xe3φ\xc1\xa0\xcaeedv{5\x1a@!\x12\x18\xb1E>:\x05m\xba\x88tk\xf5݁\xa9\x8d\x9b\xad\xd5\xef4>\xb4R\xb0\x9d\xb5j6\x00\xb4a\xbbC\xe3\xb6W\xf6@\x1ay1\xb8Ug\x9cR\x1a\xaf\x1a8\xb3El\x88\b\xb5\\\x95\xb5\x18\x9f\x84\x1c\xc7)F\xc939\xf1\x81xl\u058c'f\xdd\xc4Y\x96\xf0b\x9a\xad\xce\xf2\x85\x91@N\xf0\xff\xc0a\x97\xfd@\x01v])@\xee\x11\x1bAZ\a\x8d\xe7i\x96\xa4}\xaeU\x1e\xc0\x94\xbaIlT;\x89\xe1\x84˨Q&op=H\x0eu\xd8\xf8w+Lڝ,\xde\xe7\xd6\xce\x05\xfa\xd7L\x8f\xaf6\x0e\xe3\x13S\xb0遂\xc3+\x11\xdd\xd8s\xeeݠB\x06\x9b\x06\xc1N\xedl@3\xa8\xa5\x8c\x0f\xab奿\b3\xabf\x8ea\xe9\x97\xc1\xc1j\x12FQ\x83\xb6Y\xc2D\x94\x11x\xfb\xc2j\x98\xa7\x18\x12\x84X\xf1\x9b\xac4\x95rd\x0e0Y1\x17\xb2k\u007fF;x\xe7\f\x93\xffq\x1af\xb3\x0e\r\xaeh\xb6\xdb\f\xb9\xbc\xbcJà\x99.\x96[\x90%\xf8\xd3q\a5\x14vK\x042\x11\xe7\t\x81\x9f]\xfbi\xea/\xfb.q\x1b\xa9\x98\xf9\xf9\xf5&\"X~\xc1eA\xc2f\x8e7\xe1\x94\f\xafGz\x95\xfa\xcbf\xa4l\xb4nD\xca\x00V\x8a\xc5PY\x94\xecl\xbc\xd8WQ\xf2\x85[0V\x13A\b\x04\x95BK@\x15+ZY\xd5.R\xb78\xa8]\xba\xbd\x9e%\xfeo\xbb\xa6Ų:[\xf27d;\x9b3\xb7av\x9a2;\xdbs7\xe0n\xe4n\x18\xdf\xd24\xa3[\x19,\xe0\x14\x1e+3_Y\x83\x97y,\x15h\x85\xbbzNm\x86Ӕ܌ǩ&w6\xa5\xd7bRx\x16\xd1+\x1a\aՕ{Y\xae\xd5ըb#\x1c:\xec?9\xe1j\xb1\x84b\xe6f\xe5I\xafb[v\xb7\x98\xbaHYibq\x9d\n\x04\xb1\xb3/~>\xbe\xe6\xab?0\xa1\x85\xe9\xed\xc8Z\x1b\x16\xcf]\xb3\x06\x1f\x18\xadxU4\xdc\x14\xadn\x03\xb8\x87\xce\xe3\x81\x1e\x97\xe9\xf64\x13O\xc5\"i\xabe\x90\x166xPǠ:\xb4\xe0i\xe1'\xb7\x9d\xba\x05\x8b^\x97ґa|M\xd30\xafZ\xf4\xe0Q¸B\xde\xd1\x02T\x8d^=t\x1c\x8d\x15q\x92N\xfdHv\xb3\xae\x8bJ\x8aOP\x8f\x87\xb0x\xa7J-z\xd4\b\xb9\xd2xdwu?\x8b\xa3:\x8e\xba%KO\x05<l\xec![\xf8\xcd\vj\x0e\xab\xa0\xf3h\x15\x85Y\x8e\x8b\xf7N\xbe\x9c\xe1\xdc4\x90\xddP0\x81'\xc1\x98i\xb2<9\xd6(\\\xc9r\x84\x1bσi\x18\xf3I\xf6\x845o\xa3\xebF\xe2\xa9\xd8x\xfd\xaa\xaat\xeaL\xf1Zd|1\xb2\x11[\xb7\x0eٟ\xc8\xff\xff\x1b\x1d\x02\xde\xe8\xffw\x8f\x8e\xbb=\xa7\xec\xffw\x0f\x8e\xfe\xf2\xff\xff\x1e\u007fn\xfdT^K\xea\xc1\xf5\xb78ld(\x83L\xb2\x92\xd1gs\x857$\xa7y\xe6\xc9\f~\xe1\xa2a\x1b\xe6\x80g\xd38\xf7\x04\xd6\xc1$I\xf1\xfaEϵ\xf0\xc2E\x0f0\x88\xdb\x17\xc3S\xf1\xa3\xdd\xc6\n\xf8}\xf5\x1f\xfd4GЋp8@\xb4\x17J\xdeЫI\xbb\xbb[\xad9l]\xf6\x9aG+c\xd6ں\xa1ˬh\xacl\"K\xf6.\x86\x92\xf6\x1b\xba$aLX>\\\x1c\t\xb7\x05\xdfХ9\xe0\xf8X\xea\xda\xc2k\xf4\n|\x01\xcd\xf20\x86\x95\x96\x95%\xf3tL͕@9K\x93\x19Ms\xc0+\xf2\x14\xf8\v\x91?\xf40\xb7H\x10mP\xa0\xd7\xd68JbZj\t\x87S\xe2\x91\xe0g\x96\xb3\xc5Q8Y\x02\x94\xb9^\x0f\xc2I\x8b\xe9\xe7dB\xa6I0\x8f\xe8#\xcf3\xe61\xde\xfc\x17\x18{{\x98j\xd3\xc5,I\xf3\fY\x14\x1cx)\xfd\xf7<Li\xcb\b\x0e\fs\xa0\x03\xc9\xfe_\x17a1WQ2\xf2\xa3Wl\xad\xb9\xc8\x11\xcbe\x9e\x9c\x019\x1e\xbe\x84`\xcf\xd2$O\x181\xb6ȱ\xde\xff\xf8\xf6\xed\xe5\xf9\xa7\x8f\xdfz\xc6\xfby\x14\x19֏\xef_\u007f\xfbݛ\xf7\u07fe\xe6\xa9?JR\xado>|x\xfb\xed\xcb\xf7<\xe3\x9b$\x89\xa8\x1f\x1b\xd6\xfb\x1f\xdf}\xf3\xed\x0f\x12\xc9tDS\xc3:;\xff\xe1\xcd\xfb\xbf\xf3D\xac˰>|\xf3\x8fo_\x9d\xf3D\xa4ɰ\xbe\xfb\xf1\xfd\xab\xf37\x1f\xde_\xbez\xfb\xf2\xec\xcc3.\xf0}\x06\xf2\x1do\xd9\xd0\x18\x14\xb7\x95g\xdf)\x9d@ǹ\xec\a\xd9V{\xecG\x91\xc8\xf5<OG\xbf\x96\xa8P\x9c\xee+Eᤅ\xbf\xeck?\xfb\xf0%\xfe\xc8AZ\x02\xd64w\x93\xb3A\x8d\x9cIژ\xc0\xcb\x06\x86\x93\xd6\xf9rF\xc5\xf7#\xcfS\xd8h\xae\xf2\xeb4\xf9\x02\x81v\f\nng\\c\xd4a6\x8frm\xa0\xa9\xedQ\xb0\xe3\xcf\xe6\x06\xad8*\x1c\x982}-\x86\n\xcf.\xc8Gr\xcdU\xf6%\xcc\xc7\xd7\xec\xd7\xd8\xcf(\xdcj\xdc\xe7e\xa4\xd8\r \xeb6\t\x03\xe2\x88L]\x04\xa15\x10\x1dˇQ2\xe0\x88\xd97\xe26F\\\x18\x05\nUT\xa1\n#F\xc1,\b\x90B\x8b\xf98pe\xbe\"\xbf\xa2\x9d\n\xdb\u05caD\xca\x01R\x16IA.\xa4zڨ\x876eQ8\xa6\xde\xcb4\xf5\x97\xcaȄ\xd4B\xe2\xfd\xf4\nbF߳\x19\xa15\x89qd\xc3\xfc\xe0Mb9\x8e[\xa6=ev}k\xff\u007f.~\xce~n\r\x9f\n\f\x17\xff\xd3\x1a>\xfd\xb9պ\xf8\x1fs\xf8\xd4\xfc\xd9\xdc7/ܡ<\a\xb8\xff\xf3\xfe\xcf\xfb\xf6\xd3\x17\x17?\xa7?\xc7û\x9f\xf7\u007f~\xdazѷ\xef\xf0\xdb|\xfa\xe2\xe7\xa7?\xef\xef_Y\x86a*e\xb26OⳓeH]\r\x94\xf1\xd9\xc7\xf3ܽ\xbdG\x90r\xe1\f_\\\f\xfb\xf0\xbb`\x1e\xb3\x1e[\x93\xd8\x12\xe7\xf4Qk]\xe2\xa5\a\xde$\x16H\x8b\xd8?\x80\xf0=\f\xddk]\x8c\xc28h\x89\x02\x16\x9c\x99\x19Z\x82e\x99$J\xb9f Z\xc2\xd1\x1a\xcb7\xd7\x05\x1d\x1c\x1dx\x8dXq\xae\x85\xe1\xfb-6\x05~\xf3v\x89\x99\x96A\x8a\x89\xf6\xcbu\x18\xd1\x16~t:&\x80_(\bژ5\x84B\x17\xfcC\xd0\ap\x059S\x9a^\xe9\xd4\xc0o\x0fD\x03\xf5\x1bf:\xb2\x85\xd5\x16\x14\xe8\x80I\x93\xd8\x1a\x8b\xb9!\x9c\xb4$\x8f8\xfd\xa7ݽ=U\x94e\xfe\x8534M!\xd0\xd7a6(u\x11T\xa6S\xc6KZ]\xb3\xb1\xffD\v\xaf\xb2\x9a\xde\x12\xd8ywq\xb2\xa1\xc7 \f}:˗b\x1a\xf0\x14\xd4k\xa0\r\x9e\xa4\xf0J5\xbe9\xbb|\xfd\xe1\xfd\xf9\xb7\xef\u007f|w\xf9͏\u007f\xff\xfb'\x15@\xaaG\x12\xc6+1\xa0\xfa\xee\x1a\x185c\x03W$\x1a\xa6z\x1ek\xad\\T\xb0n\x99Ř\xcd\xe6#8\xf8\xc3h\x92\x89x\x01GKZy̆\x82{\u07b9B\r)\xd3\xd58\xfcy\xcb\x15ք\x93\x962\xf7\x15E\xa0{\x84E&S\xed\xec:\x9c\xe4*I7\x9c\x1eօvq\xa3\xb66\"dmk>7B!\v\x18j\xf3\u05cb\xcd\x01$\xda\xd9|FS<\xddė\xe9\"\x1d\x9b\x0e\x8daTc\xae\xb9\x12\x19\x85\xa6\xe3\x05\x8b\x04\x8e\xa2\x00`\x93\x9a(7\xc8\xd3劗(*\xe1\xb6\"\xfb0\xd7\x10Aܢ\xe6j\xbd.\xccbG\x9a\xc5\x05w\xealc\xac\xdb\x0f\x02\xdeP\x95\xc7\xe1\x10:\xe0Q\x89>\x85\x8ffs\x96\xa7\xc9k\xb9\x8d\xf68\x89\xb3<\x9d\x8f\xf3$\xf5 O\x1a\xbd\xec\xa3\x10\x1f\x852a\xa6\xc0P\x8a\xc74ce\xa1c\x8b^\xd9\xdb+%\x14U\xaa\x02\a\x86\x06\xc7ǚX\x19'0\x06\xb8\xc5#F\xc1\xa3fs\xd2T\xb8\f]\xa3\f\x9dA\x81\t.\x8e\xf90\xa9A\xc4s\xaaxx\x86a>\xa0oW\xaa\xf1\xe3i\x1dk\x01ުu\xc6T$g-S\x8cr\xe4\x01\xb8\xb9\xb7\xa7O˘z\xe1\f=\xcf\xf8\x1axn`\xa5\\MB\xfe\x00\xab\xc29O\xe0\x9bJkAQHbV\xe0\x04\\L\x87\r\xe3t]Xc\x16Veb-\x82\x8f\x1e\xe8~\xaeNyZ\tR\xae\x11TP\xb9<\xe0\xb0k\x90&\xd9M\x8a]\vH\xd6\xca\x04\xc1\u007f\xafP\xdf\xf5\xf1\x1f\x8b\xcbn\u007fU\xc8q\xbf\xf8\xb9^3\xfd\x19N\xf4EL\xb1$\xaaM\xb6Q\xd3\xc3\xdfk\x1ae\xb4\x04\xa6f\xaf͖X2\x99\x03\xf9R\x88\\e\xb7\f\xe5)\xa2\xe9\xcc\xe7\x85߆Yn(\xf0\xe5<u\x12)Vy\xe2\x94Oy\x9d\xf7\xa8e\x8c%\xd60n\xba\x9a\xc9\xf0\r\xd34W\x85\x88܆\xf4\x8b\xb92\xe6\x19%\xccF\x1d\xe7\x06\xccs\x12\x17\xb3\xd8=\x05\xb5\x05݄\xa9\xb2\xc7\f\x8bFt\xfa*O?\xb2\x14\x0f\x90\xdaߟ\xbf{˫\xbd\xbb\x83\x14\xfee^H\x1cC+\x19}~\x95\xa7|\xa8ZY\x9e\x9e\xa7\xe1\xd4C\xf9P\xe0\xec<\r\xa7wwU1\x06ё\xb6\xe3\xff\xfc\x9c\xb5\xef~\xce\xda_\xa3\x05\xb9\xb6\xfc4}\x83\xb7[\xa0)\xac\xa2\xe4\xd7^(XÜNqh\xf1Ꮚ\x8f\x0fv\xa6\x1ap\xe8\xe3\xb8g\xb3&[\xec0\x18Ԉ\x17\xe1\xd0\xf3<\xc4\xc2\xe9\v\xc5B\xa6㮭\xd7\x1f\xde}\xbb(\xfa\x15\xf4%\x0f\xe5\xe6\x93'\xdck\x02\xd3\x15|\x8e\x93\x80zPjL\xe1>\x94\v\x967\xc4L^\xd2\xe3\xff\xae\xad\xf15\x1dߜ'74~\x19\a\u007f\xa79\xb4\xbc\xa8Nv\xa2\x953\x18\x94*\xf6\x8b\x19!\x86\xbaփ\x1a[\xc6٧\xf7\xe7/\u007f\xba\xfc\xf6\x87\x1f\f\xcbx\x19\x930\xbe\xf5\xa30 IJ\xc2(\xa2W~Dp]C\xbe\xf8\x19\xc9ft\x1cNB\x1a\x18\xe6:\x9c0;~\xdf\xcei\x96c\x1df\r\xfe7\xef\xff\xfb\xe5\xdb7\xaf/_}\xff\U000875efο\xfd\x81W\x85\xbdO\xf8\xc9\xfd\x8c\xf8E\xd5\xe3k?\xf5\xc79S\x81\xeb¼\xe5]\x8c&b\xb9\x99k\xabfH1yŞf\x825\xa5\xc1+nWp\tDT\f\xaa\xb8tĴ\x84\xf1\xa1\x97y\xa1\u007f\xf2e\v\xacc\xcc\xfe\xc5\xd0\x12\xb2$\xac\x8a\x06qB%Ȧ#\x0e\xc8,\x03T\x8d\xfc0\x91|\x0fMU\r:\x8d(\xb0źm\xbd\xb6ԡ\x9c'\x9ed\x862\x14\xbc\x8ba\x01\xf6w\x9a\xe74\xf5\xaaC\x8d\xf5\x9c,\x8dW\b\xac\aЕ**\xf0\x11(\t\x03\xbd~\x88\x93,\x90\x87\xda@\xbe\b\x87ww\xccl]\x97K\tYP\x06\x0f\xca0\xfc\xd3\xf6\fCX6\xb5\x83\x00\xe76,\xf2\xc8\xf3:n\xa5\x02?\b6\xe0\x0e'\xad\xadx=\x86W\xedFL\x1e\xd4\xf6 \xeb\x992\txe\xd9\x06*\xf0\xea=6\xa6\xb7\x12\x03&=K|\xa4P\xc5\x04sL1\xddr\xefA\x19^\x1d\xf1+\xf2\xc7\x0f\x02\x8e\x04\xe7V\xae\xc5Y\xfbEz\r\x11ܔh\x98\x04>'a\xdc2\x88a\x82S\x14\xe7\x15\x1b'H\xe1v\xe2>jun{M\xb3\xb1\xb7\xba\xa2y\xbf4\x00,\x1aϧ4\xf5G\x11\xed\xc33l\xe3$\x9e\x84W\xf3\"e\r\xab\x86ڊZ\xea|\xa8\x8d\xc0\x99U\xa9^\xae-\x16\xa0\x92\xe9\xc2Fo\x12cX\xd7=<:\xe9\x1et{]sU)h\x17$zx\x87ǯA\xccz\xcdϤr\x1e\xaaS\xe6\xe5%\xa2F\x16]^\xa2\xfa\x11\xb8+٭\x86\xca0\xdfd\x86\x13?8\xaey\xb2\u007fx\xf3\xea\x9fg\u07ff\xfc\xd7\xe5\xfb\x0f\x97\xaf>\xbc\xfb\xf8\xf2\xbcb\xedTA\xee\xee\x1aK\xeb\xde1sUz\x1dR7\xbb\xd6\x1b\r8x$J\xb5\xda \xa1\x10It\xa8\xb0\xe5\x1c\xb8o\xc4{\x9a0\xf5\x95\xb0\x90\x98\xd2 #>I鄦4\x1eS\x92'l\xae\xe3\x85\f\x1c\x9f\xfc\xcbS\xf1a\x0e\xbe\xe8\x85\x19\xf8\x1b\xd3\x03:\xf1\xc19\xbb\x82\x8d\xd8Y\x82\xc7\xd0\xfa\xc6\xd8O\x830\xf6#\xc3\xc2w\t\xfb\xc6\u007fh\x9a\x18\xd64\x8c\xfb\x92?\xd6\xd4_(_\xb3\x94\u0081\xd6>:%\x8a\x86\xc3\xe2N\xabϴ'I\xfa\xad?\xbe.\xac\xcb\x1b\x1c\xef\x177\xdc3u3\xbc\xbb\xd3\xca\\\xdc\f\xd7\xe8YC\xdaQ\x1c\xbc\xd5\x1a?\xb9n\xf2\xa3h\xe4\x8fo`ՏϻE\x13\x98\xe9\x06%\x8fC\xb9# \x1b,\a?\xa7\xfc\x198\x85_\xa6\xcaH\x1e\x98W\xa3^Xu\x02h\x12F9M\x8b\x16f\x02\xeaQ&\xf7\xd6\xd7\xe6Z \xce\xcf\xc2\xff\xd0\x16\x8fՀ\x9a\xf1\x1e<\x1eS\x00)\xf8{mj\xfdm\xcb\xc1\x02\xca\xd2\xd0\xc3y\f\x0e|\x1bf^p`g4\xa2c\x9c\x92\xa5\xc8\xf1\xabJ[Fv{\xd5\xcfn\xaf\f\xd3\xf6\xf3<m\xe1\xad|\x86\xc5\xd9\x1d\xe4\xd7\"\x03\xe9\xe09\xf8aJ\xef>\x1c}\f\xe3\xf2s\x8a?\xf0g,Q\xe6\x19\xd0\xddݣ\x06\x98\xf2\x1e\x00\x836M6\xb3\x871,`S\x92z\re/\x180,\x9c\x1f\xa5ww\x8f\xd2b\xb5\xa8\u007f\xf1W\xeb\n\xac\xd0w)\xbd\n\xb3\x9c\xa6\x02[\x8bi\x80\xb4\xb5\xc2w\x1f\x19\xc8\xda\xe4\xa6VFs\t\x05\xbd#\x1e꼻3\xb2\xdc\x1f\xdf\x18\xe8\x00\xe5C-\xcc\xc6\xc9-M\u007f\xf0\xe3+6s\x0ej\x04\xae\x10(.t\xc0*ṫ\x19\xfc+r\xd82\x8e?]\x18\xc6YΖ\xe8L\xa1\xe9\xcf|\x9a8\x8b!\xdcYx\x15\xfb\xf9<\xa5\xcd^\x13\xbe\xba/$\x9e\xa9\"Q\v?\xf8ɝ\xc4}b\xb4Kx\xc1)\n!\x1d٫d\x1e\xe7\x031\x0e\xcaC\x9d\xb7\xab\xa5\x91\x8eD\t\xdb_\xd4\xcaET\xa9\x1dw1\xa0z\xb6v`c\xc9\xcf\xfdR\xb1k\x9f\xc1\xc3\xcb}\f\xb2\xb4!\x9a\xc1\xaa\xa3\xccW\xc4SB\x04o\x04\xd64\xbd\x8c\x11\v\x03\a\x16\x1e~]8C{\x01\xa2\xbaTR\x96\xca\xe4\xb5x\xe4\x89\xdd 9%-\x8b\xb4\xbd\xbd\xe5#\x0f\\\xb3\x82\xa8\x05<\xb9\xbe$\x85\xe7\x88$\x13\xcer\x92]'\xf3( #J\xb0<r\x97\xfa\x01\x831ڢζ\x01Hd\u0092\xf1\x02\xe9\xe3ˍ\xe7\xde\x01zې\xe8\xee\xd0^\x9c\xf2\xdf\xee\xd0^\xdc\xdd)\x1f\xa7J[e\x86\x86\xadS\x06\xab\xe30\xceoy¨\xcf\xf0\xa5\xaa$&\v\x02N\x9d\f\x1ew\xe4\xe0\xf8v\xaf\xd1\xc6\xf5\xefzͧ\x04>\x9c\x18\xb2\xd7\xc9\xd4\x0f\xe3\xb2\xe3\x9d\xe5x\xaa\n\x9f\xaaޯ\xacP\xe0\x80c\x8dA\x0e\xd30f:s\x1a\xc6\xf0\x16\xa5^&\x90e\x02h\xd7\xda\xe4\x85\xfc\x05\x14\xf2\x17[\n\x05*\x87Xi̹\x98\x861\x9bY\x87\xeb\x1a\xc5Qi\x166\x96\x1b\xc5\\ybb\x8b+\x1e\xa0\x06\xeeP\xb5\xf1\x1cd\xcb\x14\x10\xf8\x8f\xbd0m84Ѻp\x14]?\xe4\xe5\x97[\xcb/eye>\xb0\x1c\x89\xc0\x9e\xfaWq\x98\xcf\x03ڌ\xeaB\xe0\xbap\x86\x1d\xe5\xb7%\u007f\xbbZ\xfa\xb0L3\xd6:\x14\x92\x80\xcc(\xb3\v\xd42\x85gM\xb90\xb0\x04x\xe5\xb4^M\x0ft\xceʇN\xebL\x8e\xaa\xa2\x1b\xf3,s%~\xb5L9\xe3ci\xb5\xeb\x06:I[E\x18\xad\x10\xd8q\xad\x171.\xcb\xcd\xf9e\x13%\xd0\xd7I\x97\xb0\t\xd4\nL1ʸ\xfd\x85nl\xb4\xf6<\xee\x91\xc5/\x98vŇXK6M\x03\xc5\x1c\xc7A@4\xccU\x81\x9a-\x9d\xd6\xe6\x1aZ.R_\xc8I\x0e\xa2a`\x90\x99}\xf6\xf7\xa0\x969\r\xf5Z\xb0\xb6\xad\xa9\xbd\x981\xa1\x13@o\x01,\xd8\x14E\x8e\xb9*~W+\t\xccU`/=\x15u+\xb0\x97\xac\xfb\xd7\xc2~\x93=m_'\xc9\rך\x15L4\xceӥ\xb9\x02\x1e\xc0o{\"\xe7\xe9hb]\xb0\x8c\xa1\xc95\x96\"\xe0\xd0\x15\xba\xf8\xcec\xc8\xe7\xfdr)\x8d\x0f\x96\b\x97=\"\x92\xc8_&\xf3\x9c\x8dU\xfc\x85\x84\xb6\xcc\x01\xff\xc4%\x01T\xcf\u007f\x9b\x03ud!\x18v\xccZ\xcdP~\xdf\xdd)=V\xe2\x83?\xc9\xf1\xb9\xecZF\xa8\b\xb7\xf2#\xf4\x9c\xc1=\x85P^\xb7\xc5\xef\x9d\x12]ȪU[p\x11\xb6\xdbC\xad+\xab\xad\x14\x9e&%\x89\x8f\xfe*\xf3+\xba\nMB\x85\xfa\xc1=\f,\x05\xccS~\x8b9ZnOMZJ\xeeޞ\x96\xfbH-)\xa7ml\x8a\x98\x8f\xc7p\xcc\b\xef\xf2\n\xc2\xc9\x04\x8e\xe4H\vDZ'l\xcaV\x90\xb5\rr\x9b\xc1,\xaeT\xd76\x06$\xa3\xb4\xf2V\xfd$\x8c\xa2\x96i\x94\xa6\xfa\x92\xd0x0@\xfa\x17C\v\xa4\xa7\u007f!\xa6O\xd4a\x9e:0\x99\x05V\xac \xedŻ0\xe6kM\x99\xe2/\xb8s?{\x13\xe3\xc4\v\xaf\x00Ԕ\xdc\xdb\v\xec\xc5i9\xd5,\n\xa2\xbb\xa5R\xd2_@\xc9\xe7\x95z\xcb%\xc5\xee\x80H\xd6v\xfb\xb1\x8dI\xfc#N&53O\xedL\x85\xbec\x01#\xa7L}\xe9\xe3)ﾋ\xa5\x1b\xb2T|s\xe9,\xbe!.\xb3\x94v!\xd5\x0f,\xcc<\xf1\xb9\x16\xfb\x16\xe8+\xa35\x9e\x91b\x15|w\xa7\xac\u007f异Kf\x8c2y\xb0\xb7ᆭU\x19\x8e\x17\xdc\xefп\x11[5/8L\u007f\xa3#b\xc3:PW\xbf\xac\xfd<&\xa6RRa\xb6%\xdb\xcfM\xf4\xd4\xf3\f\x91m\x98+\r\xa9W\xb3>\xad\xefΖV\x8e{S\xe5\x1c\xa1\xf6\xd7P\x8c\xf51[Q\xc4Or2\t\xe3\x80\b\bb\xb4ӵND\x15ź \x1f\x02s<\x03\x17ne\xfa\x8b\xfe\xe7\xfd\xa8\xfbC\xcaB\x01\xb8\xd8_ iE\x1d8\x80\x1ey\x9etG\t\x83o\x19Q\x8f\x0f\xaf+\x9a\xbfJ\xa6\xb3yN\x833\x96\xaeyA,Xh\r\xf0\xe5\x11H\xf9\x17\xbcI\x00!\xb5o⼅7-^\xd1\\x%\xfe\x9b-N\x84\x93Ĵ\\G+\x8d\x0f\xb0l/\xce])P~]Xߞ*\xfb*Aww\x87\x0e\x9fӰ\xa8\xa7\x8c\f\t\xfa=\xff\xec\xf6\x1c\xe9\xfe\xe1\xa1\x18\xb7a\xf6\x10\xf7κ\xec@\xe3\xfd\xb5y\x17\xfb\xbbp\x91\xcfS\x9aٯ\x92(IUo\xa8\x9e\xa3N~\xd8\xff\xe3k:\xa5\x99t\xef\xf1o;\x9b\xd1q\x9eΧ\xee\xa1wa\xfc\x8d\x8eG\xc7ώ\f\xcb\xf8[0>\x99\x1c;\xecר\xeb\x1f\xe2\xafgݓ\xe3\x9e\xcf~\x1d\xbbG\xe3\xc3g\x00ץ\xc1I\x17\xe0F\xf4\xf0\xe8\x84\xfd\xf2\xdd\xc0\xe9\x05\xec\x17=\x1e\x8f(\xe2;\xf1\xfd\x00~\xf9'''c(\xf1,\x18w\x83\x03\xf6\xeb\xe8\xf0\x19\x1d\x01\xbe\x83\x93c\xdf?0\x86vJᰜX\x1b\x94\xe9\xed:\x8e\xc3(\xee\x1d\x1f8G\x13V\xb2\xe7\x1e\x8e\x8f\x8f\x10\xdbQ\xef\x04\xf0\x1e\x1f\x9c\x1c<;\x84\xb4ѳ\xf11\xd0tr8::\xea\x01%\xc7c\xbf\x87m\x9c\xd0\xe3C(K\xbb\x93^\x17Z1\x99L\x8e\xbb\x986\x0e\x02\a\xe0\x82Ñ\xeb\x02\x96\x80\x9e\x9c\xf04z\xc8\u007f\x8d\x9f\xb9=\x170?\xf3\x1d\aӎG\xcea\x17Z\xd6;qN\x0e\xb0\x8d\xaes\xd2\x1d\x19\xc3Ɩ\xb9\xace\xddI\xf7\xf0\x00Zv0\xee\x8e{\x80\xf7\xd0?8F\x1e\xf7\x8ez]앣\xd1\xd1\xe8x\fuu\x9f\xf5\x8e\xa1\xc4ɑ\x1f\x1cQ\xec\x8bѸ\a\xbfF'\xc1\xb3\x1e\xf0\"8\xa0\xce!\xa4\x8d\xc7~\xd0\xf5\xf1\xd7ɡ\v\xbd2v{]l\xa3\x1f\x1c\x9ct]\xc0绎\x8b\xb5\x9d\xb8\xc7\xee1Pp\xe0R\x17\xb0\x1c\x04\xee\x89\vT\x1dt\x1d߭\xb4\f\xfc\xa9\xe1\xf8\x19k\xd5a\xf7 8\x9c`[\x8eN\x8e\x1c쑓\tr\xc6\uf38e\x0f\xa0-A0\x1e\xf5\xa0\x0f\xc7=\xff\xa0\v%\x8e\x83\xde\xc9\x01\xf4ȳ\xa3\x83Q\x17\xf9;\xee\x1ea/\x1d>s\x83\x03\xa0\xac;\xe9\xf6\x0e\xfdf\x19\xba\xce\xf3\xd9Y\xee\xe7\xf3\xcc[\xf5\x9c\x83\xbe\xf17\xea\xf7\x1c\xd6K=\xa7\xdbg2}0q\x0fٗ\xd3g\xb2qp\xe4\x1e\x18֡˾\xe8\xc4\x1fS߰\x0e\x9dg\xec\xab\xfb\xcc\r\xc6\xec\x8ba\x99\x1c\xf6\x8e\xe9\t\xfb:ayn\xd7\r\xba\xec\xcbeX\x9eu\x03\x9f\xb2\xaf\x1e@\x1e\x8f\xe9\x88}\x1d\xf6و\xe8\xfa\xcf&\xec\v\xea\xeb\x1e\x1d\x8c\x8f\f\xeb\x00\xca\x1dM|\xa7{Ⱦ\x18e'\xc7\xe3\x83\xee\x88}\x1d\xb3rNpr8f_\fK\xf7d\xd4\xeb\x8d\r\xab\vX\\\xffpr|Ⱦ\x8eX\xde\xf1\xc9\x01\xab\xa1\v8{]?\x18?c_\f\xe7\xf1\xf8\xd91\xe6\xb16\xf8\xbd\xd1\xc9(`_\f\xe7\xd8\x1d\a\x81k\x94t\x06\x1c\xdc\xfbrMi\xc4zt\xd4\x1b\x1d\xf9\xd0{'\xbd\x93\xe3c\x90\xa1\xe3\x93\xde\xe4\xf0\x00{\xaa\xd7;\x06)9<:\xe9\x8ep,\xf6F\xcf|\x94\u0603\xb1s\x80\xf27\xea\xb9\a\x1b\xfal\x9c$P[\x8f>\v\xb84Ȳ\x05\xe6\xe3\xd1\xf8`\x84:\xe8\xd9\xc9\t\x97qw\xd4=B\xcdsx|\xf8\fe\xcay6\x19\xf5\xcar:\x9d\xc7a\xcc*q\x9c\xf1\x18\x87\xaf\xe3\x1c\x1d\x8d\x0eP\x1d\x9c\xf0!=\x99\x88܃\x03\xc7y\x06M\u007f\xf6L\xfc\x1a\x8f'\x13\x01'\x94\xc0\x89\xc3\xfeC|'\x13\x81\xf9\xf0\x04\x15\xd2\xe8\xa0\xe7c\xda\xe8@\xe4\x1e\x8d\x1c\xe7h\x04e'\xa3\x03\x91+\xf0\x8d(\xfb\x0f13\xba\xf0\xd7\xf8\xd9d\xc2\xe9\xe3\xb5M&\xf4\b\u007f\xf9>\x83\x04eFY\xebD\x8b0\xf7\xe8\xe8\xe8H\xd0<\x9a \x9c\xe3\xb0vb\x8b\x8e\x8eD+Y;\x8dᮓ\xd4\x0f~\x1c$S\xb6\xa8\xa8\x9d\xa9\x8ale\u007f;\x9c\xb2靦\xb7~\xc4#!\x03\\\xfa\xa99\x9e\xfaqw\xe7\xf2ev\x96#J0\x05\xbc\xae\xe3@:\x83\xfd\xc6Ϩ\xf7\xceϯ\xedI\x94$i\v\xb6!^\xfb95\x99\tq\x1eNi\xcb\xdcw\xe9\x01\x97:^\xa7\xb2\xca\x01\a\x05\xec\x93(5\x00BLh\x99O]\xc7i\xbb\xbdv\x89\f%t@\xb8l\xf9Z\x116\xc4\xd9ꍦ\x9e;\xa8wV\x88\xe5\xb4Zs\x1a\xfa\xf1\xb8\\y\x17\xdbz\xeb)\xf4\xedw{m^A\xbbM\xda-(1N2\fCxʳ\x9e\xba\xee\xfe\xb3#\xc7lwͧn\xaf\f\xb5\u007f\f\x19Ǖt\x173\\\xb1h\x86%\xd0j\xd1G\xdcj\xff\xb4E\aX\xcb\xfem[o\xc5\xda\\\x9b\x83r\xc7)Mxj\x9f\xf4\xe4rj\x9a\xdcҺn\xd9\xcc:\xe1o\xc0\xc8if\xcdsrښ\x14\xadw\x15j&,\xb5\xe2\xcc2\xcaN\x87\xfc?\x1f\xc0\xa1\xe3U\x05\xee?IL1\xb3e>=r\xeavW\xa7I\x9c_gޅ\xf1\x0f?6,\xe3;6e\x18\xef\xfc\u0530\x8c\x97\xb3\x14~/\r\xcb\xf8\xc7<\x86\xbf#\x96>\xbf2,\xe3\x8c\xce\f\xcb\xf80\xce\r\xcbx\x9f\xdc\x1a\x96\U0005a385\xba\x9b\xc7a\x9ey\x17pn\xb1o\x04t\xec\a\u05302:N\xe2 \xeb\x9f\x1c\x1d:\xceӃ\xa3\x9e\xdd\xed=u\x1d\v\x1f`\xc9iگ\xf1jJk<`\xad\xfa\xf1\xfc\xd5w\xf3(\xfa\x04\x8e\xe8}\xd7a&\xf8S\xd7Y\xaf-^ג2\xda\xebj\xda\\M\x15{\x81\x13xTA\xea\xd8[P\x82\xb3\r\xf9{!пc\x9f-sX \xffB\xe9M\x19\xf7\xf1\x0e\x88\x11\x82uw+Ph\rXwi\xd8vk7 R\xf0\x1c\x91\xebd\xaep\xf2\xe0\xc8q\x9e\x1e\xedL\x17\xa8;\x95\xae*\xb6\x87\xe3r{d\x1a\xc6\xf3\\\x11\xa9#\xe7\xa9\xdb{8\xc6*\xbaݸ\xf6\x0e\xcae-\x9d8\xc4R`sw\x94\xbd3\x84o\x99m#3\n\x8c\x15t\x0f¦\x8c\xccB\x83\xb0/\b~\xd4\xf6\x10`\xf4V6\x1aX\xaa\x84\x13\x05\xd9\xea=\xc4g^צT\x80XU!\xa1^\x1d\x99\ap\xa5<\x87j\x19\x8fG\xe415L\xd6-jq]㩭\xcc\x13\xd6\xc8\xd2\t\xb6\xd6\xcfA\xbb\xffs\xd06\xfbpVM8\xach\x18\xe9\U000ff16d\x81\xd8\a\xea\xa7\xdf͙~\xc5 \x85d\x1e\a4\x18\x84\x93\x96l\x9a\xe7q\x1d`\xae\nhO\xa8\xdb\x16`l\x034\uf8cek>\x85\xa9\x9ec+`\x1d\x99fg\xba\xc2)0WTQ\xb9\fj\x91J\x01\xae\\\xca\xd0P\xaf[N\xfd>\x99\xa7Y\x95\x1c!ѕ\f!N5%\xa2(̔\\~\xb2\x93\x03i\xb6Ϻ\xc4U\xd0\xd6\u007f*\xa6:\u007ff\xee\xf1L4\x93h\x18\x01\x8f\xf6U\x1e\x99Oկ{\x19\x1c\xf6\xdbd\xecG\x8df\af\xff\xff\xb5\xf1\xf1\x1bZ\x1e\xbf\xa5\xd9\xf1\xe7\xb49\xfe28\xeemp\xfc\x8a\xd6Ưhj\xfc߷3~7#\x83\r\xa4_{6\xacL0EV\xfd\xa4T?\x87\xd5MxP\x9b>\xc3\xe2\xa8\xd6\xf1\xd5\x18+u\x96JÜ\xadM\xd8\xf70-~\x1b\x83\xed\xb7\xe6\xa6\xfbg`\xddob\x95\xedB\xe3\x1f\xcb\xe7?\xcc\xfa\xe2\xf7\xde\xd4Y^\x98\xc5u\xd4?\xdf}s^h\x18~\xde\xc5\x1fe\x97K\xf4\xd4\xf9\xa3\xac\xb5\x84\xc3A\x90\xf8\xdcs\xa9ە\x9al\xb9\xcf>\xdbƹ!\x8f\x80H\xb0g\x1aԳ\xb6\xf1M\rБ\x06t\xd46\xde\xd5\x00\x1dh@\am\xe3\x9f%\xa0Swoo\xf9\xdc)\xc0\xec<\xf9.\\Р\xd55uH\xcf\xf3$\x98\x81Xd!\x95\xb7\xf5\xcc\xfa\xc6Ϩ\xebt\x0f\xff\xf9\xee\xef\xe7\x1f\xef\xc55gq\xa8\xdcz\xa6P\xba_\xcai\x1b\x1f\xab\x1cp\x9e=\xeb\xb9\xeeQ\xf7\xf8\xf8Xc\x98\x9a^\xdb\t\xce\xf1\xc1\xf1\xa1{\xd2=\xd4J\x89Ķ\xf1\xf7\x9a\"\x87'\xbdR-\x90R\xdb5N\t3\xc3\xf9\xdbwN\xfd9\xe9(I\xed\x8f~D\xf3\x9cꇤ\x95\x8cr\x1c\x01\xfa\xb2\xa3\x04\xe3'\x1a6\xa5\az\xb4\xc1\x86}i\x0fp\x89\xcc\v<7\x02\x1fC\x1e\xb9\x82_ww\x1a\xa0\xb23\xc5}\xc6\xf38\x0e\xe3+<\x8d\xcbw\xee\xafhLS?OR\x91*\x02c\x8a#;48˓\x19\x0fӂ\xa5\x11\xe0\xc7\xe8/\x85L\x19p\x8cN~\xeb\xb3\xc5\xc9\xe7w3\x85\f\xfb\xa9R\x18\x8f\x9b\xf2\xc2xR\xb1\xc0\x06\xd7Q0D\x92B/8P\xa9\xfa>\x8bJ\xf0\x96\xf6\xd9v\x87xj\xe4\xb3\xe7\x0e>\x9f67j\xf0\xb9L\x86\xac\xb2\xe5\xee7\x97{\n\x17\x9f5\x91_ǘ\xa1\xb6\x8d\xe7\xe1?<\xd8%\xc9\xfd\xbc\x91\xa7\"\x98I\x8be\xb8\xa1K\xddH\xe55\xdfХ8ݤҢ\xf6~\xbb- \x94\x96\x81T\xb6̻;\xb9E'\xa32t\x98҅\x00\xa5\xa3\x0eJ\xc5\xe2J\x9d\x81\x1c\x102R\xad$w^\x99\tO\xbb\x1d\xd7\\a\x8b7\xf7{\r:]\x12\x9c\xa1ٲ{f\x83\xbcW*\xf6\xba\xa8\"~\x95\xbak\x00\x98\\6\xd1\xd3n\xaf\xd5\xce\xc7@:F\x87\x9c\xef\xe1k\x9b\xd6\xc2#K/?\xfb\x8b\xca\tAH-\x0e7\xe1\xad7\xfcҞU\x11~ӯ;\xbf\x16\xf8\xb9\xff\xe3\x0fo1$\x88\u007f\x88\x10A؉\x82\f\xfc\xad\\f\xa0\xacPdD\xe1\xabd:\x8bhNE\x11\xf1\xadݬ \xa1\xe1(\xb7\x00\x85\x8f:8\xa9O\xc5\x0eٿ\xe74ck/\x8b\xff\xec+e\xbe\xb6\xfd\xcf\xfe\xa2\xb5\x9a\xa7Q_m\x9c\xc5\xfe=_\xceh\xdf\xf8\x9c%\xb1ae\xf3\xf1\x98fY\x9f_4\x03\x1f6\\\"\x02Az\x16e\xe4`.\xfcT\xf2\xd6\xe6\x9ag+\x15\x8f\x938K\"jG\xc9Uˀ\\\x12%~\x10\xc6W\x84\x93\xd0'F[%\xc9\xd4\xd8\xc0QK\xba\xb4\xdd?+\x83@\x0e\x1eϭt\r\xee\r\x16|\xb2\xd5\xf8\xdfK~\x1e\x1b\x83\\\x11\x0fd\xf7\xcb\xf0\"\xfa\x0f\xe2\x01\xbd\xe2\xe7ݝ6с\xa4\xb5da\xb5P\xe9\x90C\xd1\xf3\xa2]\x9c\x98~͔\x1aH\x19\x83\xe8n%\x8cY=z*ϼ\xf2-O!{\xacȆ\x90\xe6\x02\xdb?\xe9\xd2\xcb\xec\x1b\xba\xbc\xbb\xc3s@\x80R\xe6\x99\xda\x01#qv\xf6\x86.I\x92\x12\x1f\x8e\x10\x19\r\xfb\xd7A\xd1\x0eVI\x80\x95\x04E%<\x87W\xa1\x1c^\xaaT #\xca\x19\"O\x94S\xaf\x00\x82\x9b\xb6\f\xd0\x17\x86e@)\vP\x1aÁr\x93P\x85\xc8\x19(\xf5\xe0b64\xb3\x8b\xd9\xd0c\xbf\xd6\xe6\x1a\xf6\xa6\xa5\xbb\b\x84am\ue906\xf0\xa5\bZ\xa3\x8axN\x9d\xf9T\x960\xecb\xf8\xa9\x1dOͼ\x95x\x94\xa2\xaf\x9eL^\xd79qA\x80\x84\xba\x10\blQ\xbe\xf1\xa4k\xcd\x03\x18F\x11\x1aQr\xa4\xf0g0,\x1a\a\x00m\xae\xc0\xb9\x06\xd1\x0e\xec{蕾\xef\xeeV\xa3dA3\b*/\xe5ِã\tē\x1fJ\r}QK\xf9\u070f\xa2p\xf4\xd0eYAM\xd82\x92\x8b\xf7m\x89\x16\x97\xa9\xc5\xd8\x12:\xc91\x03\xc7\xf4\x02\x8b2\xa1dY\xa7\xce\xdd\x1d\xfb\xf7\xb9\n\xc2\x0fT\x99\x17\xee\x10\xa3\xaf\x8b\x87H\xc4IᕒV\xd7!\xf2\xb9\x1d\xc3\x1cT\x8b돕{\xf8\x8e\xfaZ\x81C^VZ=J\xf0\x9c\xce(\xe14\x8aہ\xca\t\x1bȑC\x03\x8e~\xd65M\x89\xdd\xf5\xaa\xf9^\xd3-IAxk\x98\x83\x86\xa3\xd8\x05\x1e\xc3l\x92i<|\xfd\xea:\x8c\x82\x96 F\xe2\xf3\x83\xe0\xdb[\x1a\xe7\f%\xb3CZ\xc68\n\xc77\x86U\x9c\x8a\xc1\x8b\x15\xf4\xca\xf1\x16\x8e\x96\x81\x87\xa0\xf4\xce(\xf5\xdb&\xd0_\xb1?\xcaհ\x99\x17\xce7\x98\xebFA\x01!f\u007f\xb5\x8d\xd9\xc2\xd8A\xa0\xf0\x1d\xd9][\xa0v8\xa3\\ܘ\xc0\x04DJE\r\xc0\xc3D\x81\xeb\x04\x05\x00\x9e\xc8\xff\xfe\xfc\xdd[@\xce\xf3\xebZY+!\xa5\x1e\xddBԖq[z\x18\xcc0\a\x8ab\xa8#\xa3\x84\x0f\x14\v\xb0(\x0e\xccUY\f\xb6жm\x14\x97\xdf\xf6\xdaN]E0\xd7k\x95>]\u007f¡\x9a\xb3\xdcOQ\xd6\x065\xb9\xdf\xc6\x01\xbaw\xa6a\xdcҴ\xaa\xc0i5)ҁ\xa6H\x8b\xba\x9eW\xabP\xb5\xab\xac\x16H\xaa%\x16\t\xf2\x17\xf7!\xc8\x19\xe2\x19\xf5\x12B<\xe9P\xad\xbdSWq\xb5\xbf\x94\xd1ZW\x00GoC\xa9/uU\x03=\r\xc5\n\xe1\xe0\x97\x00iZ\xbeY\xcek\xa0+\xa3\xa2N\xed\xacM\xfd\xdc\x19\xb2T\x9c\xb8j)\xd38p\x9c\xdf\u007f\v\xc7|w1\xbd\x16a5B\xaf\x94Ug|\x95̦-\x06\xd8\x05\xdfG\x83g}\xce\xd9\x18,\xee\x89\xd1\xd3\xee\xee\x8cY\xe4\x871\xbfQf\x12.h\xc0h\xf81\x0e%<~\xc9P\xd5\"\x99{\xcfJK\rm\xe3\x9f\xdbd3fަ\xa1\x9fS\x89\xbc\x14\v0\x17U\xe0\x06<\xec\xba\xc1\xcfA\xf9ػ\x90qy\xea\x9dǞ^Q\xee\xdc\xf70\xa78@~\xe1\f\a|\xbb\xb0<1\xccabS\x02oUD\xfbs\xe9\x9b\u007f\xeeu\xcd\x15lK\xb2\xbf\xee\xee\xe6\xeb\xc2\xf6Ɣ\x82\xe2\x8b\xe2g\xe1gZ\x17=\x82q\r٦\xa3\xfd\xb5m\x84\xea\xab\xdd\xc4\xddF5<\xe6\xe5 \x8e\xd6+v\x1eZ5\xfc1\xf5\xad\b\xe4)\xba\xa7\xce\xc3\xf1\x8dW0\x92\xe5$\xbc\x05\xca\x15\xef\xe8J\x1c\x17ND\x14\x97\xf1\r\x86\xcc\x02K\xa0v\x05+nq\x0e\xd4zd\tm\x9bh\xbf;\xe0Ur\xc3\x1b\xee\x89\xe8K`@\xd4g\u007f\xad\xe5\xdd\u007f\xbc@\xe3\xf5\x00\xbauV=|l\xae\xa8\x8d\xd7\xfe\xbeO\x02\xca\xd5\t\x9f\x93\xcdҍ<\xf2\xf6!\xc1\x98r_\xb7LـJM\t?\x8c\xac(\xf5\x04\xafL5\x1b\xcduŉw?#E\xd1x\xb55\xa2\x12n0i\xf09\xb1F\x93\a\x10\xea\xea\xc5\xe4Z#\x8f\xb6\x19,\x00S\xae\x10\x12efa?%0\xbal\x19^ \xe3\x87D;`SQ\xb1\xaa\x15[\x01pm\xb5(\xa4\xd9\x05pRL@\xfaD\xdez\xc7)B\xf8W\xee3E\xfc\xd40?\xfc\xb4yr`_#\x9a\xe6\xd7?\xb0%\xa7\xed\xeet\tV\xfdd\x92\xa4!\x8d\xf9\x8a\x13\xfd|E\xc2ݝ\x91'3>g\xcc\xc2\x05\x8d\xb2\x8f4\x85\x01\f\xa0Z\xd2\xdd\xddqO\xeea\x80x\x98\xe2@w\x1e\x8e\x19D\xa6\xccL\x1a$\fn\x0e^|{\xa5\xfcB\xb3\xc2\tR\x99˾\xee\xee\x0e\xef?\x15\n\x12\xe4\xa2`\xcbmk\x97\xe2\x12\x11y<Y\x1e2U\x9cm\xfa\x85\\Z\x05\x8d\x17r\xd5\x1d\xcc\xdcv\x98\x13Ɛa\x95\xae\x05#\xf2\t\xcf\xd2\xca\xd4\x13\xc4]8C\xa6ߵ\x9b\xc6P]\x88\xb7\xe9<C<Ng<\xe4\x123\xe5>G\xc6\ve\xe8܆\xd9\xfa\xbe#\xe9^\xa7\x86\x1fi\xf7\x9fq\xedY\xd3wZ\xe3\v\xed_\xea\xc6\at\xceӖۖ\x03\x93_\x1c\x04\xdf\xea\xa1\x1f\x05\\\xc2\xeewͺ.\x01\r\xdeq\x11\x0e\xed֦\x89N\xee\xef\x00o\x01\xfd#O;\xc1\r淩\xf7\xa8?\xcf\x13\xbc\xca\x12\x89e\xc2\x03\xe2{{e\xb3\xdf-\x93_r\xa2\xda+&W\x12\xad\xb2\x061\a\xac\f\f\xb8\xef0\x94J\x0e@\xfcVv\x0f\x16rsb\xb16\xe5\ue522\x0e$*\xfc\xae\xe4+\x03\xde+k\x9a\xbb\xbb2\xbf\x15\xb6\xecW\xf5\xd9\xfdz\xea\ue39f\xe8J\xfd8c\xb3\x93$_զ\x1e(OT\xddK\x1e\x01\xab\xc8\x10\xb7\xe7\x90.L\x1aH\x84\x9e\x01?#6:\x8c6\xef}\xcbhs<m\xc3\xe4\xdb\xe9[J\x10\xc74ւ8]\xcf݂\x180%\xf52\x8aZ\xc6Sx\x80\x05\xd6R\xfc\xbc\xfa-Z\x9bRa\x15ꊫ\x9e\v\xa3x%\x96\x0f\x02]\xd5\x0e\x8b\x1bYEQI\xafaɟ&\u007f\xd8C\xf4\xb7\xd2ՙ\x89*~>\n\xc2\xdb0\xa0-ǔ:\xbf\xa5\xcd\a|\xb0]\xa5a\x00\xaa\xa2\xae?\xf0\x85r\xe3\x85\xdb\xef\xb8\xe6\xd3\x1a\xf6k\xaajs\xe3\r\xf9\x94\xaf\xf1P\xfa\x05\xad\xa6\xa9ݭ\xc1o\x18\xa8\x90\xb7\xae\xd1d\xfau\xd6r&Z\xd5ܠ\xa0\xdd\u007f\xba\xfd\x0e\x85\xc6\xeb\x13\x1er\x01B\xb15\xc4\xf4\x8d梻\xffm\fk\xf5:\x85V\xf3}\n\xe5ao\xea\xcay\xe7\xbb\x16\x0e\x9d\x87ݑ\xa0\x98s\x9f\x1a̼O\x0f\xdc\x0f~\x90)\a/\x8a\xfe\x9f5\xe6d\x01\x9ch\xbc执\x88\xddX\xf2\x8e\xd5L\xe9\xfbZ\x85\x0f\xb1\xf7\x1al7|\x96\xfdOj\xb85\xb9\xa7v2\xe3,NI\xffA\x16\xdc/\xd7fM\x06߯q9L\xb3n{\xd0]1\xbb]\x16SVnOuA\xdeY\xb95L\x82\x0f\xbbL\x86)X\x9d\x0e\xcd\x04V!K\xe4\xd6\xdbO\xcc\xfa5\xf4\x01\xc8%>Of%\xb3xm\xa1\xb8\xf5\x1bMb\xac\xb9d\x13#\x1f6\x18\xc5\x0f02\xb1\xa2F+\x13Ll>\x95\xa7\xfe\x176\x11\xa8\xa5\x97\xc2\x1a`\x99\u007fO\xc3\x00\f\x8b\x1dM\x04K\xa2,\xf8\xa0\xdc\u007f\xd8h\xdd#\xcc\xeef}I\xdb>\xcc~\xdf\xd8\xe9;\x89\x8df\x84\xeb\x16p!\xb0\xcc\f\x96\x16\xf1oj\x0e\xf3\a\xf6\xff\f\xb6\xb0x\x19e\x11f\\(\x98()\xea\x97\xc9\xd4j\xabŌ6C\xd5`\x06\xc6\xde\xcf^^\x82\xbd\xfc+\x18˻F\xbf\x80\x81e\x9f1\xc9\x0e\x9a\xec/\x9e\xdd`\x86\xd5\x16\xb1j\x8d3|\x95\xabb}\xf3\x18a?\xa2\xe5\x17\b\x8a\xa7p0\xb8\xcc@J\b\u007f\x967#P\xca\x10w\x92\x03\x8a\x02۠T\x05\xe3N\xb5\x86\x90g\x80*+&wH\x93\xc5\xd6H:7i\xeb\x14\bo\x9b\xaaG\x82\xcf\xf3\x8cM\xae@VA\xa1=Nf˖\xb8\xc2\x17\x93\xb8;[\x8a\xa4\xa8NEa\xd6ʨ\xe0)\x88j\xa1\xcb\xc3\xc0\\\t$,k\xbd\xa3@|C\xaf\xfd\xdb0I\xc5\xfd\x93߇W\xd7\x11N\xbc\x83]A\xef\xe1X\xc5\xd7\xe5\xc5\x1b\x9e\xec\xf7\xa0\xeaą \xb13\u007fB=\xfe\xba$\xc6Q\xbc\rc\n7\x84\xe3\xae\x18\xbf\xb9\x14C\x801\fOMRl[\x8c\x0f\x83T\xf5p\x9b\x12H\xfb\xc3\xd5H\x85\xb2\x82\x03;\xbd\x1a\xb5\x8c\xbf\x05'\xec?\xc34[\xf6\x89\xa9\xbe\x1f$\xe3\xad$\x17 tE\xd9؊\xccUd7\x87\xb6L\x93yFيU\x0foa\x86\xb6l\xad0\xf5\xe0\xfc\x80\u0084\b=\xf3\xc8@\xd8ͭ\xd9Za\xc9ʽ\xbf\x91\xe7y\x10\xbd\xb0\x12O\xe0<w\xf6\xf6\x94\x9d\x80\xf2Ź{{-\xdc'\xc6\xde\x16\xd9/\xea\x12E\x19\xb0\x0f\xb4\x8b\xf81\xaeY\xa9\x86\x97\xe4{\x83@H\xc7\x1d|\x05X\x934\xbc\nc?\x12\x85$\x025\xb8\xb2\x8a\x8bǋ*\xf0\x96k\xb2UA\x15\x14\xf60x$&\xdf,[Kq\xbbP\x9b\x86\xf7wnȻ\xbbS\x930\x9c\xbc\x92\xe2i2٪\xe4\x9bkm7Fn\xa8\xf3p\xa1\xc1v\t\x9a\xe7\x15\x01z\xb4]\x82`\x18\xed(D\x9a\xf8\xec\xedA\x1bJOV\x18Z\xcf\x19\x9a\x04\xd4t\xd8,\x99\xb5\xccAcGVe\xc1r,\xf1NC@#\x9aSR\x85a&̆\xee2W\xd5\xce\xd9\x00\xbe\xde\xdc1ki\xa7!\xff\xb8\xd2\xdb\xc2L\xbe\x04\xacj\x8dV\x04we?Da\u007fH\x03\xfd\x04\xdc\x06\xb0_SQ\x97\x97\x9f\xf6\xd7usy\xe9V\xd5\xcf\xff\xdf9M\x97\xc4\xcfe!c]Ed\xcf\xc3\xddq\xfd\xf8FEg\xcfCc\xfd\xb5\xba\xe8\xfe\xba\xa5\x8by\x96\x9bv\x96\xa49\\\x01\xbe\xe2隣\x11\xd67p\tlXy\x12\xd1ԏǴo\xc05\xceLG\xa3\x00\x14\xd30e\xfdf\xcdCM\xd2/\x86\x83\xba\xea\x18\xb9-#\n\rӦ\x9a@\xe0\x8bc\xf8.\"\x1b\xb7\xec\x97\x1e'\xae]\xe0\xa5f\xafM%\xfa\xa0Q\xbd\xba\x83\xf0\xb9\xe7\f\xc2N\x87\x8b\x9e\nt\x11\x0e\xbd\xd2\xfd[u\x12\xbf6k\x1b\xc5\x15\xdb\x19\xac\x0e\x80\xd5Z4~\xad\x03\x84Q{\xdd\xe8\x8eP\xeb\x90\xce\"\xbe\xfe\xae\xc9\xe3\x8b^\xbe\x9c\xbf\xdeq\x83\xb9<.\xce!Ts\xfb\xf89/=\xf5\xf6\xcb\a\x900 ^\xc6\xe3k\xf5\x10\x13\xaa\\\xfe\xcc0\xcb\xd9\xf0d\xe9\x00A\x94\xe8\x00c\xefo\xae\xe38\x87\x03C\xe4\x95\x03\f\xf9\xcd\xca8W\x15\xe1\x99\x19M\xf3o\xe8$Ii\v\vZ\x1a\xc0$L\xb3\x1c\xc2\x03d\xa5I\f\xe1\xb9^i\xf6QU\xa9\xbc^^S\xbe4\xe6\xf7\xefk5T\xa3\xd7Di\xa3\xb8\xbfY\x11\xb0{=<%\x1e\\\xf7\\1\xb0j\xc8l\xa6\b8\xa7\x90\xb3V\x8e\xd5\xf0\xeb\rG4\xf2\xb4\xd2W4\xe7]\x95}\xb3<\xf7\xaf\xe0\x11A#\x9b\xf9\xb1\x01\xc6\t\x14\xa9e\xa2b\u07be\x8c\xa2\x0f\xf95M٤\x9dyu\xacŃ\x1a5\xd0\xc5\x13\xeb\xfc\xa4ce\xb6/?\xd6\x1cy\x15\x98\x8b\x10\x1e\x82P*fv\x80PB+y\xb25\xaa\xf68\xf6Ym;\xe0z\xf9QJ\xfd\x9b5\x04\xab֓\xff\xcbDf'\xeb\xa6,\xb0\xd5ƭ\xa2\x1a!\xd9EB\xb8\xcc\xeeFETm\xe7nキ\xf3*\xa6H1\xa3~\xfdH\u007f\x1f\xf0\xeb\x96n\xa6\xa8\x93!\x9b*\x9bs[\xab,\xf7Ӽn\x02\x9c\x87\xf0x)\x0e\t#N\x84L\xabv\xe9-Ɨ\xdf\xe2\xd3\xf3\xf0\xefk\xbca\x9e{\xc2\xf3dV\x87;\xc3{\x04\x92y\xae\xce#\xa2\xc6y\\\xa9\xd3\\[ݞ\x03\xfc\xbf\x9fE\x86j\x18\r1\xf4*\xfaA f\x81J윮\x82j\xceoIy)E#4M\xd5L3q3'\x89\xa3%Ib*^\x9d\x00\xdfߺ\x18Z8|\xea\xa6\xea\x81\x10 \xb5R\xa5 \xbe\xb1P?ɋ\x8dT\xa5\xd5\xfa\xd3?<u^e\x86^f73\xf6{\xb6\xf4}Ms?\x8c\xaa\x93\xaf\x92y\xff\xbd\xc0\x9d\x8ek-\xbe\x13\xe1s\x98U|\xd7\x06c\xc8\x10\xbb\x05\x04\xd7\xe97\xe8q\xce-K(\x97u(\x95m6\x0f\x9f;{\xb1\xeckw\x05hq\x8dږ\xd7=Nb\xc0\xc5?F\x80앛\x17!\x93\f\x10\x9e\x1db\xffp\xf4\xf8\x19\xaePp\xc1({[,B\xb3\xe2\xe0\xe4\xd9u\xf2E\x9c\x89e\xbfE\xfa\xf7a \x8fղ\xdf\"\x1d_j\x109?(oBMt>N\n6\xea\xd9\xeb\xba+\x96\xf8{G\vk)s\x83\x9f\x8a\x9f\x9f,\xf5\x96)\xb9\xdck\x1b\xfd\xbdx\x94\xcd\x06F\xbb\x00]W\x8d~sE=\xca鐼\xc134Z`U\xc17<HI\xed\xdcO\xafhn\xc7I@Y\xe7\x88\v\x91\xfe\xa75\xf3\xf3\xeb\xbb\xec\xf6\xea.\xa5\xe3\xfcn\x1c\xa6㈚_\xefkg\xd5\xcb\xe2\x8c2\xc2\xf0\xff\xe4Q\xfe*\xd1OwwԎ\xfc%M\u007f*\xb2?\xc9\xecO2\xfb\x13d\u007f\xf6\x1c\xe5\x85\x1d\x11\xc2\x1bS?\xa5Y\xfe\x91%\x0e\xaa\x8an\xdb3S姻xa\xe5\x19\xa1\xcf\xed\xf6P\x1e-\xe5\xaaOm)\x86Z\xff䉰\xdf0\xbe\xa5i\x8e\x13\xc2O\xa6\x02\x02K~\xf4\xb4nxfM<\xc3\aǨm|\xe0\xab\x03\xbe!{\xa1>\xaa\xa6?\xe1\xc7w\xa4f\xb34Y\x84S?\xa7\xe8\x8f\xc2;\x9d\x93y\x1c\xb4\xca4\xf0\x84\x9fL<\x02S.\xe9i\xf8\xcdr~\xa73\x10|Sj\x9a\x86q\x05\xd3\xdd]\x89Vu\x15X\x06\x1e\x84\xa7\x1a\xec\x00\x17\x99\xf8\xc6\xd7\xf0\xee\x8e\xffj\xbbC\x13l1F:ϴ\x17\xa7<\xea\xfd\xa7\xbd=\tf/\x9e\x8bv\xaeJ\xc4\xfa\xa3L\xf0\xa0#q\x98\xa7\ry\x80\xcb|\x11\xf6ö\xcb\xed@Y7\xe4\xc9\xda\xcdU\xd8ns\xeb\xbf\xd3Y\v0\xa8\xf9\xd41\v*\xf8\r\xdb\x10o\x0f\x88d\xd6P\xb8\x8b\xf3\xe2f\xee\xec\xdfi\x8e\a\x1ffɗ\x96\xa4R\x04\x84\x03\x1a{av\xb8\xdcY]\xb3\xdd\x04\xbd\xe4\xd0\xcb6\xff\xd7\xe1\xc5>\xb1b(J\xcal\xc3G\x92:ߔ&$|E\xb3RbY.\xb1\xd4K\xc0@\xf6V\x85҃\r\xc5~\x81W\xb6Jц\bS`\xd2^\x87{\xa1~\x88\x91\xc8\x1bi\xf6\xc5\x0fq6\x9f+^<\x9c\x00\u007f[\x82\xe7}\xf1\xc3JҀ\xa6\xfd\xcf\x16ܙ\xa7\xe8_\x8crP\xb5\xcfݝ(t\xaa&\xdb\"\x15/\xd9\x12\xc9\xf8v\xd7\x1a\x95\x19:K\xe0\xb7|į\x8c^\xe8\x1c\r7\u007f\xe8\x18fHU\x19i@\x9c\x8b\x00Pb\xb6\x0eX\xca\xd4CT\x94\x05\xbb\xd1\x18\xe2*\xc4Q\x8c\x05<\x9f\xa0\xce\xe6\xfc\x95\x1b\x1eS\xc2\xdfA\xeb\xe3?\x16N\xff\xe2\v\xbc\xc5?\xf5Q\xa2\xf1\xeb\x13~}\xb2\xcaBS\xfa\xe6\xcfR\xfe\xd4\xe7\xff2\x83\xfd:\fh\xbfl\b\n\x1b\x03\xed\xccM\xcf6\x87\xb1<\x82[\xacVT\x8b\xa1\xfa\b\x14f\xc0\x15\x8e\xd9u\xf2\xa5\xb1r辆\xba\xc5\njS\xf5`\xc8\xd4U\xcf2\xa0\xfarLE\xb3ݩN\xb1\x18O\x06\xbf\x95\x01\xcbE\xb6\xecŘ\x15\x17\xa9r\xb1,.o\x94\xef\xd8qY\x14\x96\xa4:\x8f\x96%sV+\x92\x1a\xe4\xa7Z\xc8O\xbfTxUJ\x17\\\x84A'\xbe\x05\x97\xc9f\xa3\x16\x81T\x9bvq\t~\x13Cd\x15\xb4l\x1co\xaa\x89\x8b%\xf9\x93\x899\x9dn\xa1\x01V\x9a\n\x05\xec\xdbP7\xa4\xb0\x85ʫ\x85\xfe8\x9f\xfb\xd1'o\xab2\xd5z\xd1\xeck\x9fXo\xd1<\xdd\xf6\x15v\xae\xc6]\x8bW\\\x1e\xcfe\x85\x8f\xa5xˊ\xd0!5\xdcF'\xcdi\xeb\x94*\x8a\xa8\x8e\xc1\xe0\xd0\xe6\x1at۲%H\xf4%K\x92\x1b\x90V\x90\xa5S\xa9d\x8e`6\xc1\xfd_m\x17\xae\x91\xb0 ɕу\xe3\xca\\\xd5u0\xdf+3j\xe8\x13Y<\x06\x01\x94BU\x8b\xf0\xe5M\x9d\x1e\xc1,\xf1\x02\x9c\xa5\xad\xa7\xfa\xf5\x8e\x86-\x9b\x80L\xa9黀\r\x1a\x91\xaf\xf7\xa9\xa9:0\xc5^\xe3\x16罊@+\xaf\x95|\xc0v%\xb5!@\x93\x06\xe7\xb0F\xda\xdb{TN\xb2\xc7\xc9t\xe6\xa7\xf45\x17\xa5\x8f<\xb4\xb3U\xad\xd8܃\x13\x8b\xaf?\xbc\xfa\xf1ݷ\xef\xcf/?~8{s\xfe\xe6\xc3\xfb\xcbW\x1fޟ\xbf|\xf3\xfe\xcc\xe4-\xb9\xe6\x93I\x95\x11;FN\xfc\xe3\xec\xc3\xfb\x8fU\xdf\x05$\xef\x18:\xf3\xd9_X\xab\x87\xdf\x065\xfb\xa5\xd7A\xed\xd6з\xe0B\xab\xb6\x14\xd3\xeb\xce\xe4պ/\xb4 \xe1mN\x1a}\xab\xa4\xc1\x88\x90\x81\u0091\xa0\x10\xbc\xd8a\xc6k\x86_M\xeag\x1e\x19\xf5g$\xc1\xef\xa9\xeawm\t\xdc\xf4\x92\xfaZ\ty\x8d\xfd|\x9e\xfa\x11l\xb9\x8a'n<\x191\xc1_\xd0\xe2\xeeI\xf0\x87\x8b#\xeb\xa0\tb\xc5{\xa7.\xab7^|\x11\x85bGGQVp\xbbŠ\xe6\xb1ߕ\x0e\xd9\xf6\f\x19Nc`H\xf3\x17?\x1f_oQ\xdd\b\xa4V\x88)\x86\xc8\xe1j\xda\x1f\xdf\\\xc1\xb2\xb9FUW.\x85\xc1\xa2\xea\xa6J\x13\x11\xb8\x93·Q\xd4V\xa3}\x10\x95\xcc\x03e\xb5Q\xad5B\x8b \x02\xd1\xd2d!\xa6\xa6\xbaU\xe4\x15\xb7z\t\x84\xc9[\xeevWnU(\xdd\xfa\xc3\x1a\x03w\x8dx⊕>D\xe7hK\xa7\xf5@\xb8\x87\xb3k\x1a݆\xf1\x15wN\x8bO\xc5K}\x89\x84\xe9\xd9%7m\xcb\\\v\x84\xd7\"\xda\x00\xe5QO\xa9\vG@\xfc\xb8\x1d\x8c\xaesXS\xf1\xe4M\xf7\x96\tw:\x93\xe3\x16lS7\xcf(;n\xd9\xe2\xe5\x1aQX\x1b\xe7\xa0d>\xccU\xfc\xcbu\x15\x86\xdfΙ\xbcljm\xf3\xfc\xb9\xb6\xa0t\xbf\xb4S^GY\xb3;\x90ߠ\x80\xf5\xb3y\u2d78>\xa1\x14\x0e!\xb7\xd63\xe0Zk\x05^0\x88ܲ\xa6aܗ\xb7\x1dXS\u007fї\xd7%\xe0\xca>\xeb_\x14\xf92oh\x01\xae\xba\r\x9cpҚ\x87h8f\x17\xee\xf0\xd4+\xbe\x1cy\x94_\x84\xcf\xca\x17\xa55\xa8r\xb6\xbf\xf0T\x94\x03}+c\v3\xc2IK6\xc0ӉYՐ!\xf6\xce\xd6E9W+\xe7V\xcb1\xfad9\xbc\xa8\x8e\xf3\xfc\xc2\x19j7\xc1(a\xc3\x18\xaf_\xf18?D\x12\x902\xaf\xda\xd3F2\x03K\xd42\x10\xc40\xb7JN3\x8ai\x18\x1bVq\x85\xc6fX\u007fa\x14\xd2\x02\x9dP\xe55\xaeaW\xb2?\x8ak7\xd6Ux\u007fQ\x82w\x87\xc5\xcd'\xeb\x1d\x9a\xce\xc5yW\x1bH\xbc+]\xa3\x80ĳп桵\x9cƙ<\xb0\xc6?\xb8ۏ\u007f\xa967\x0f\xbe\x14OS\x97\x12\xeb.ô\u007f\x14\xb9\xe21\xed\xa2\xa4\b\xe3)\xbfU\xcdg\xab\x8f~~\xfd\x9d?Γt\xa9\x8a\xa9\xc8>\xcb\xd3\xe4\x86\xd6\x02\x88'\xc5\xd5TT\x00+ި\xbe}be\x80\x00\x0e\xf4\xf4\xbb\x96\x1aWj\xcd\xfc \b\xe3\xab\xfe*Of}\xdbq-\b\xc4\xef;\x16\x1ea\x85\xa4\x88N\xf2\xbe\xb3\xe6h\xfa`\xd1[\x930\x8a\xf0\xe7z\xcd\xe5\xb0_\xf3z\xa4\xb2Q\x01>d\xed \x90\x92YM\x87;Mq4A\xa0\f:%Ս\x0f<\xa6\x16NZJ\xbe\xf6\x807\xe7Ģ\u007f\xc1\xe4\x1a\xce`\r\xad\xa5\xfa\xb5\x06;\x02\xc6J\x81\x84{\"aDTR\x97%\xd8e[\xfdp8\x8c^\xb2\f\xa34b\xeb3\x8eu7\x8c2\xc5i/\xab\x1e\xaa\xa5\x17\xd8\xcbv\xc0\xaa\b'\xad\xe5)\xa3\xd5\x04\x82\x97\x90\xf0\x9c\x11f\x02uK4\xaeyԚ3\xb4\x17\xa7\x8c\v&\xb0BIU\x80JapC{\xf1\x9c\xb1\xc8\x04>5¬\xcd\x01\xc3\xd9\xf1Z\f\xae\x03\x95\xe0\x01\x0f.z\xe0\xda\x1a\xb0\xccv3\fH\xe5\x00\x9a\xa2\xc8ɔ\xa98\xcf\xf0\xe7yb\xbc`\x99}=\xf3\xee\xce\x19@k\xd5d\xa6\xe6\n!y\xc1\xf2\xfbz\xfe@\x0f\x8aRk\xb9\xbbc՜:\xe6j\x89\x8db\xc5;\xcb*\xc18|\xd6%Lz\xdd\f\t\xb4\xba\tI\x9e\xccօ\f3\xb6X\x8cA \xc3\f\xdcb\x05\x87\x8d\xdeS\xfd\xccc\xe3\xdcV\xbd`\xf7\xeeN]\x9f\xe1\x04\x18r\x88\xdbPf߆\xd9`\xc3i&\xb9\x01\xe7\xdd/\xac\xaci9\x88j\x81_\x1f\x11'\x01ͼR\xed3?\xbf6L\x98oQ\x05\xd94f5\xea\xe7c9\x14\x1e\x1a\n\xf8\x19\xaa\x8a\"n\xf1}\xa6\xd0s\x9ams>Tk\x96\x83\xa5PS?\xbf\xf6\x80d\xa6\xb4\xc2v\x9b/N/\xc1r\xc1pH\x19Bo\xc9k\x92\x8b\xcc~\xed\xf2\x95\xe9`q\x81W\x14\xbdPW\x81}\\&a\a\x83\xde\x16\xa7\n\xd9\xefZP\x85V;\xa3\xf9\xcb<O\xc3\xd1<\xa7-\x83a7@\xe3\x9b\x1b\xa0\x10\xb5\xc1\xa7\x89\xed\x90\x1d\xf5ħ2Em*\xc9\xcfx\t\xf2\xc5\n\xd5\\[rj\xdd<\n\xf4\xabr\xd1\x11\xc4\xe7ЖY\xb3\t\x0f7\xf0K/D)D\x9fe\xa2\xcd\x017\xf2{\xf2\x97:\x9b\xa9\tEU\x90\xaa\xdc(\xabz:KP\x9eg$\xa3\xcft\x9c\x1b\xe5\x9b~\xeb\xa0\xebڠ\xd0xq3\x04f\x88\x8fG\xaa.T3\xfaj\x11\rJ\xcd\xe8בpq3\x14B,ςaKd\x05\xf5\xd5\x16u\xd6W\xd8/q\xb5\x02\xa5\xe4\xd5R\xb6\x96\x83+\xa3\xf9\x99b\x15\x15\xc3K\x91C\x18\xd7\xc5\xf7#Mu\x97\xa5\xd6S~\xaf\x01\xff97\xc0\x8a˗1\x01\x83\t\xf1w\rNa\xa7\xf2\u007f\xd7\xf7\xb4\xa6\xed\xb7x\xff\xea\xa6\xec\xdd\\\xa9\xa2\x88x\x8e\r\\_5\x16'\x1e\x91\x93zZb\xa1\x8b\x9c\xe9\\~\x82δV\x9a\xe1Y؏®\xc4s\xd0[l\xe2\xc6Yl\x82\xb0\xe2\xd01#\xb6eڋV\xcd\x1b\x05r\x0f\xd8^\x98k\xd3^6\xc3,\x99\x9d\xc5`\x94sm\xea\x84^$\xb3~\x15]\xd7R\xfb\xd1\x1cp\xcal\xde\xcd{{\xa5\x84V\xedCo\xcbGh\xdf\x15\xd7B\xf2b\xf7\x95\x873\xc6\xf3\r\x02\x01\xf9\x0f\x93\b\xe8\xce_$\x12 \x05 \x0f\xda\xe2BH\n\xae/~-\x99\xf0S\xea\xef*\x13\xce\x16\xa1p\x00\xca\xdd\x02\xd5\x16\x90\xff\x8b\xe5\xe7\x1b\u007f\xc3\n\x9d\xe5>LvF~\xbaIr\xc0v\xe4\xb9^\xb3\xfc\\\xf93\xb8\xed\xc3vze\xa1\x11\xa7\xda\x04\x16\xb9\xca\x17,\xe2\xe9kk\xeb1\xeb\x9ag\x9cx\xc5\xdcˀ\x1fb\xd6ǯ\x81~ܹ\xbcD\xd6\x1a\x8a\xde\"\xd1,\x94]\xd8Ês\xf1\\?\xbfȡ\x9cܪ_N\x17qy\"\xc2\xc1\x1c`-\xf6\xe2\xc2\x1d\xb6=|\x9d\xacUFgO\xfd\xab8\xcc\xe7\x01\x95b\x83\xc5\xd6\xd6\xc8O\xcbs\xa5j\x8a\xeeH\xaeزg\xa4\xf2+;8^\xed\xa2Z\r\x0e\x16\xa2\xedfR\x9f\xb6\u070e\xca\xf9\xe2\b\xb8\xc0\xfdg^!\xe9\xed\x17_\xadRx(\xf8\x039\xf8O\xfc\x8e7G9\xc8}\xc6OZ\xcf\xe3\xdc{\xc8)\x1e\xa5\x9d\xdfh\x04\xf1Q\xf5B\x10\xb6_\xa9\xaf/\xb2J\xb7d\x94\xdfD\x99\xfay\x1a.\xbc\v\xd7r,\xc7\n\xec\xe5\xa9\xf3\xa2\xe3\xf6]\xf9\xc1\x15gѱE\\ \x9b\x86ͧݾ#lf\x03\xb1\xb5\x8c6\xfe\xe0\x97^X\x86\tWo\xfc\x82%\xdb\xd6>\xd9q\x01\xaaJp\xa5/\xea5u\xfdz5\x05ӟ\xafW\x17\xcaV\u007f\xfd\xec\xd5.$d-J-7\x94\xc2\xe9\xac]bu\xab\xe8\x1fs]\xba\x85R\x17\x93\xf25=\xcd\x155v\xecz\xe3E%\x03||l\x96&y\u0096I\xa2S\xf1~\x0f\xb1\xaa.\xea\x8d\xcdU\\\xbb\x80-\x1f\x12\x17\xce\x19.\xe2f\xc1\xb8\xb6\xa7\xb7\xb1X\x93\x97\xb5P\x9d\xcf3\xe4y0:\xe0\xa5?\xfdԘ\x1eA,\x8f\x8a\x89b\x9e\x12\xb4[\x04\xfe\x0et\xac\x17\xe2s\xe85e\xdc\xdd9\x8d\x85\xda\xedu\xad\xd6^\xc1\x85\xdb}ǒ]\xd5wˋf\x1dg\xcdz\x13\xb7\xa8*\xfa\x1aP\x9f\x96)\x1a\x9a\xab&**\xa0\nU\xe1Z\xb90\xbd\x8c\xe0\xbe&\xce˔\xfa\x1bl\x1c\x96\xfd0#\x87Y\x9c\xbfʒ\xa9\xecs\xd7\xcc\xe5\xffk\x06\xf2\x1fh\x1bo\xdbq\xf9\x03\x96\x9f\u007f:\x16U\xaf?\xab\xe5Jq]\xd3&\xebgJ\xf3\xeb$\xd0M\r\x03g?\xa3o\xe0\x11jC\x99pk\xb1\xaa\xd3n\xbd=,g\xd6\v\xacp(.\x8f\xb2\x8c+\xc3\x1c\x00\xee\x879\x89K\x97O\xf9\xa8J\xc4\xd4\xc2}\xa1\xab{U\xa0I_\xb5\n|`g-\xe6\x93-\xc7&\xff@\x17u\xf1\xa6\x1e`\x17\x15\x16wX\xaa\x99\"Ͱ\x91\x85\xbc\xd153w\x85\xb9\xdb\x10\"\xc34\x84\xe8\xf2\x16i\xd2s-L6\xf6yw\xd7t\x9f\x11\xd2a\x19\xa3\b<+\xff?\xf6\x9e\xbf\xb9q\xdb\xd8\xfc\xedO\xb1q&GҦ)ʗ\xb4\xf3tQ\xd2\\\xf2ҹ7\xb9\xa4\x93K\xd3v<\x9e\x0e-B\x12\xef(BCP\xb6t\xb6\xf2\xd9\xdf\xec.\x00\x02$\xfd\xeb\xee\xd2\xf6\xbd\x89\xff\xb0H\x10\xbb\x00\x16\xc0\xfe\x02\xb0\b\x93\xf1\xe9瑏\xebV\xdf\xf6\xbe\xed\x88\xd6{}\xfd\x0e\x0e\xefG\xbb\x81f\xb4\xc9\xf9/\xa5l\xeer\x06\xb5\xb9\xde\xd1%\xc4\b\xd6Ẋ\xf5\x15\xf6\xbdD\xb7\xac]\xe3Sw\xf5:I\xc7h\x82sh\xd0\xcfP`\xdek\xf4\xfb\xe6\xfb\xbf\xd1~\xe4=\xdf\xe4m`\x9f6\xbf\xdciX\xbe\x9f\xf9\xf3\xaf\xb3m\xf8lf\x1b\xc2\xef~\xf3\xc6\xda\n\xb3{\x8d\x9a6o=\x94\xf5\xb0>,*ȿʓz\xa2i\xba\xff͌\r\xc32\x1f9k_nʦ\xb8c\xbe\xd2\xf7w\x9b\xa9+B\xfd\xd8y\xf0ۻ\xffos\x94ݱ?\x84A\xec\x89\xdbE-7k\x13\xf9\x94_̙\xea\u007f\x12hȉ\xd13\xfe\xedO\x13JwN\xde\xd2{\U000815f1\xef=\xb2k\x1c\x82|\xb1\xafn$\xef\x1a\xe5\x97H/\x86Ӧ\x8d\xfc)\x1do5ټ\xe2\xdd)\xb9=K\xcf\xf7\xe6\x10\xe5\xcblK\x80\xd9\xf6!\x80c\v\xb8{L\x89;\xa7\xc4\xddcJ\xdc\xe9\x12\x1f\xb4\aB\xf7\xf4\x83\xb4u\xe6\xe6\u007f\xe6qr\xbd\u007fo\x1d\n\xbb\xd2\xc5y։\xe5w\xceu\xa9\xd4\xf4p\xd94\xeb\xc9htuu\x95\\=Md\xbd\x18\x9d\xa6i:R\x97\x8bC+\x06\x86\xb7g\xff\xf0*\xac\x14\xeb\xabV:\xf0\x1e(o\u007f\xf5\xa5\x89\x9fc\n\xd7:\xf3?\xcd{\xbfv\xcf\xee\xac\xfb\xf4\xda<Ñ\xd9e}v\x1e_\x16j\xd2*`\x97\xc4\xe3\xeeD7\x1860n\xc3\xfe\xe8\xd9{v\xfe\xecGZ\xf5f\xa7\x83\x8b\xf2\xb6ez\v=\xf5*@+\xedz\xa6\xf3\xdd\xff4\xbb[ˆ\xbf\x916K\xb6\xb6\xc7\x05Tt\xfd\x00.a\x8f5\xdc\xc5'\xf402\xcc\xc2\x1fJ\xfb\xfe~\x19\x9f\x83x\xd0\xe0J`\xcdK\xb8\xf0n\xf8H}\xd0!\xdb\xc9M\x83\x13\x8f\x9f\x18P\xb3Ow\x8f\x9ee߳RV\"\xe4\xdć\xee\xd3*L`L\x9d\x8b\xa9\xd9E\xe8\xeeߣ\xfc\xe7thF\xf7\u007f\xa7C\xfa\x96\xe6C\x02\xc5\xf4\xad\x0fC\x93\x81`\xb8\b\v\x9d\x8b\xbeMv\x98K\xcd?  i\x19\xd8O\x14\x8d\xa8\x1b\x96\xf8.;wX&y\xa9\x8f\x16M\x1fd\xd0uF\x8d9\xf6\xdc9\x81^\xa8\tg\xbc,\xd4>\xba\xf3\xb0\x02\x0f\xad\xa9i\xd0͍N\xc1\a\x1cG\xfb\x87\x9f\x92\xf26-\xdcc\xb9\x98,ﾷ\xe17\xb0Yz\n\xce;\xdb,O\xfdM\xbc\xef`\xc1\xfc\xbe\xad\xe2\x1e\xbf\x96\x16\x90\xdf\xcaF=й\xf5!\xac\xacA\xf6\xb1\xfd\u007fkhu\xe2\"\xbf\x8f\xddE\xf1'lЉ\x8e\x11\x86\xd4;!\xe3\xab\xeb=\x1a6\xd7\x0e\xaf\x96E#\x0e\xfb\x9f;\xbe\xa1[\x90\xdc\xe7\xf5\xf1\x8f&\x19\xef\x19\x93\x81B\xf3=h2~\x18\x17\xe8{n\x86\xfdw\xfa\x1b?\x98\xebٽ\xe3\x82\xe8\xdfIC&\x10>TL\xbdZI\xd9,\x87\x0e\xb2\x98/\x1f\xf4 \xcb\xed7\x04e\x8bE-\x16\xc459\x8c\xd3\xd8?H7dB'K)߰d\xd6w\xe1jG\x9ei\x14\a\x981\xc7\xc3'\x9f\xa7\xf1\x9c\xf7V\xda\x15Z\xd19\x02=|\xf4\xce\x0fs\xec\u074b1\x14\\\xb8w\xa4nUT\x931\x9d\x9f\x1b\xa7\xe9\xed\x87\xe3\xf8\xfc\xa6h8\x86\x949T\x16\xdd\x16\xa1/⽚\x94\xbbw\x93\t\x0eb|\xfa\xc2\xc6\x11\xa4W\x90\x9b\x06\xe4\x9c\uf21e\xc0\xe11\xa5\ue1fb\x80oQp\x0f\x11ٰ\xd7\x0e\x01\xbb\xcbȆD=t6\xd8*Y\n\xbc\x01D\xe7\xd1\x1a\xfc\xd9\xf9\xb3\xfc\x8e\x83%\xdf\xdae\xea\x16\xee\x95\xfd\x82\xd0Wˢ\x14Nn\xe3\x9f`\xa0\xcb\xc5ߧi\x9c].\xfe\xa1\xf7\x9f\xd0N\xa3\xa9\x93]G\x1cO\xe3\xc1\x16D\xcf\b`\xf0\\\v\"?\x9e\xe6\xc9v\xc4y\xf4\xd6\x14,\f\x93w^\xf2>z6\xd4\x02=\x88\xb7\x13D\x16\xef\xf0\xe7\x1f\xfbh\xef\x13\x893\r\x81w\xa20\x98\xdbM<\xe8\a\xb2\x05{\x1e\xac\xcf\x17짇\x05?\xbe\xf5F\xac\xee,\xce捨\xbdil\x8f\xac\x1d\xc6\xf3\x81A\xf6\xf1\x1d\x97\x15x\x03\xed\xfe!匀\xa1\xbe͓]:M\xf7\x8eٝ3)\xef9\xaf\xcc]㒐Sna\xa9\xa4F\fߜ\x82\xe5\xc5\xeb\xac\x14M#b>\xbb\xa8\xa2k\xfd0տ\xed\x0e?\x9ds\xea\x9d\xf4\xa3\xe3\xf6\xc9_\xf8S\xa8\xb3ػ\x9bV\xe2y\xa6\xc4T\xef\xe5\xd7\x18\xdbt/\x00\xfbWΕN\xf6\xba\xe9(Ypp\xd60\x1a\x8d\xc5\xd3h\xd2\xc5a/\xcdo\xf7\xd9\xf5\v\xb3\u07fc\xf2\xc6\xe2\xe9d(\x97\xbd\xc0\xedg'1tsD&\x8aݓ'\xba4\xf2R\xd8c\tO\x9e\xb0\xf2V(\xfa\xd5ނ\xeb\xe1!Ñ\xd9Mh\x86\x17\x8dXq\x92u\x90\xc7:y\xd2\x01i\x0fIPp\x19\x1c+\x83W\xdd\x1c\x1a\xb4\x915\xe81\xef\xe1\x9ec\xd2P\xbc\x84\xf6Q\xef\xd9\xd4=\xc9i\xa1-@\xc7\xf3\xa1\xe6ڧ\x9b\x9b3:uh\x134C\x9aN\xa7\xa9\x0e\x1e\xc6\t\xfae!\x1a\n\x9a\x17F_\xa6\xfa$Fz\x9e\fS\amP\x1d='w\x99\x19\xa6'\xdbx7\xa1\x19dCD\x0fUa\b\xdc\x1b\x9f'\xa1}5=|s\x93F\x1a9\x93ÄƏ\uef13\xc1\xc4$\xe0K+\x1b\xb1z\xbe\xa3\x88\xdc-\xfdt\u007f\"W\xe8Lĭ\xd9\xf4\x94\vs>\xcfҩ\xb3ሏr\xf5hE\x05\\۫\xfc\x9c\xf2\xb9h\u007f\x901?\xa4\xc0x\xad\xbf\xc9\x04\x80\x1a\x1a\xa2}*non\xf8z\x81\xa3\x01\x02\x8e\xa3c\x8f\xca\xf1n\xc2{\xb7\f-\xceon\xd2v\x1d(6\xad\xed\x9f\xdc\x05=Dx\xf8\x98\xb1\xe2\xbf\xe9\xfe\xfej m\x92\xee\xe3\x96\x16\x93\x0e\xb9\xfc\x9dh\xce`\xe5}h\xba\xb7ϊs=\xc1\bʭWq\xaeO\xb78\xadw\xa6\xea\xa59\xc5\xe2\xf2\xa1\xe2\x12\xb9\x99\x05C\xf28\xa7b\x1c\bf\x9f\xfb8߬\xd6]\xfd\x91&\xe1\xb5\xc9\xe5\x0f\xe9\xd8-nҫ\x00\xd1CM\xce\xce\xf7w\xf68Gk\xbd\xc2\xe12\xbd\xe6\xa3q-\xa3\xe0\xf8\x8a\xb67)l\x10al\xc7\xc9-\xb3Y\xa3\xbceB\xd3Î\xe4\"e\xa0\xaar6\r\xd8\x15\x99q)\xb3|@\x96\x13\xb8Ƿ\a\xba\xa2\x97i\xefB\"-\xbb\xdd\xe1}\xb4\xb9\xa9\x9a\x9a\xc7s\x95\xef\xe2\xf4\x1f\x94\x9fh9\xb1\xef\xa9\x04\xc9[Q\xcb\uf2b2\xec\a\xfb\xe9\xe6D\xa3߸\xadSW\x05q\xbew\xb1\xf0\x01H\x1a%[k\r\xdf\xed\xb3wV\xfd\xd8\xf5\xa9\x95낣\xb8\xae\xb2-\x1a\xe3\xe5.\xa4\x13\xe9D\xcc[\x17Ì\xd6\x1bE\xd7m4\xdb\x1e\xf8\x1d>\"\x9c\xbaѭ\xf8i\x93\xe9>\x8an\xd1\xf4r\x1d薢\xdcr揧[T봺_\xc4i\x8c<2\xdeM\x90N\xec\xe3-\x8e\x8f\xf7\x0f\xd2\xef\x12\x8a\xd5\xfd\xed\xa6\xce\xf4M\x1aw~\xbf\xcf\xc7\xcb0\xef\xab\x05\xb6\nǰru\xcf5{^\x8d\xcd\x12\x83\xbdt\xcfEu\x18\xed\xfbe\xad\xb2-JͿp\xa0\xcb\xf7+\xcc\xc3en\xfa\xfb\xcf\xd4o\a\x95\xd1!\xfa[N\xf20I\xa9\x19\xcdlSעj7\xd3tr=s3\xbd\xb0\xaaI'\x97sá\x8b/\x1d\x80N\x99\xd6~g\x0ev\xf1\xbfP\xc7~֭\xfa\xb1q\x14\xb9u?\x9e\x8e\xf7\xbeި/\xee\xf4\xaa}\xd2\xc5\x15\xf5\x95\xa3n$G\x0f\x81\u007fŲV\x99\x1d|_\xf4A\\-\xe6\x015\xa2\x1b\x9b\xc6|a\xd3C[\xfe@\xcdpS\xf1\xe9\xaa\xeb\xed$\f\v{u齚a\x1a\x17\x93\xc2\xd9\x144\xa0$\xeb\x95\x1c\xa3+;[A\xe2\xed\xc3{Њ\xdb.͝S\xc7,\x92\xba\x18\xbf\x1c\xa2;\xef?\xab\xe5\x9a7\xdf`\xc5\xcd[o\x95\xf6^\xe2Y?\xd1\xd8^s\xe5\xf6\xdat|\xaf\x92\xec\xb5\x16\xe5\xcdG\xbf\xff\xfd&\u007fj]T\xc9k\xf5\x9b\x96\x91\x8eS\xfc\xfb(M\x87~O\xff\xf0\xf9\xe9\x1fM\x1a\xa7\x8f\xd3\xcf\xc7O?\x82\xf4_A\x80\x8dj\xb2\xfa\xa3\xf4\xbd\xcb\xea6\xee\xff\xc8\xdf\xe8\xe8\xe8\x00\x8e\xe0\x1b\xb9\xde\xd1z5\x84\xb3\bN\xd3\xf1\xf8\xe44\x1d\u007f\x06߉\xb2\xd8\u009f\xabL)\xcc\xf6}1\x13\x95\x129 \x93\xa9\xa1Y\nx\xf9\xe2g(9\x19s\xe8MPzX\xd1.\xa8\x03\xa0\"\xfe{\x9b\xad֥\x98\x1c\x00\x00 \x8b\x97\xebF\xc1\x14\xae)\x01(b\xa7\x9a\xc0\xf8\x14ܿ\xd1\b~^\n\xa8\xe8P+\xc89g\x83FB^gW\x04\x1a\x836\x8f\xe1\x8fC\xa0\xfc\x11AE6[\x12\xbc\x06\xa35\xbd\t|\x0e\x83`E%\x90\x15\xcd\xdeT\x02\x1bO\x10u\x96\x17\x1b\xacd:\x00\xc1\x1f\x81d\xa1\x00\x8a\xa5\t\xbc8\xab\xa1i\xc1`\x02\xe3\xa4\vM\xees\x05\xf2R\xd4YY\x82*\xde\n\x83\x06\tY\x89Zc\x98ɚ\xe2\x1eø\x83\xe1'\xb9\xa9r\xac(\x84i\x92\x8c#\x9b\x1fM]\b>I\xd34p\xf3\u007fR/.@\xd6\xf0I]/\x16\x17\x17:\xbb\\g\xb3\xa2\xd9M`<\xfa\xccC\xff#\u007f0\x95\xa2>0$\x91M\u0588\t\xa4Ы\x12_\x01\x0e|\xa5\x8aΞ\x175߀\xe8\xb5a4\x82\xf1\x04f\xa5\x9c\xbd\xb9*\x94\x88\xe1\x04_\xe5\x06%\xadM5T\\\v\x91w(\xd0\xd2@\xc1ZԠ\xc4LV\xb9\x06h\xea\xac(\xb1Ϻd\xffzވzQ\xca+\x84\x99\x89\xaa\xc9\x16\xa6\x90\xf9ZM\xe0\xd4o\x13\xc1|W\xa3\xa5\xe3\x14\x02WKQ\xc1F\x15\xd5\x02\x9c\xfb\xb7L\x0f\xbce)\a\xa7\xe2\xbf|D\u007fU\x022X\x16\x8b%\xbc\xe5\x8b}\xe1bg\x8e\xae\x9b\xee3\x87\x02&\x10\xe8\x81\x10\x10\xf07\xaf^\xf1G\x9c\b\x99RŢ\xc2'\xec\x1b\xbd\xbaf\xda.\xd7\x13\b>O?\r:\xed\x98ђ1\\\x8a\xba)fYY\xee\xecT\x9a7}\x88\x16`)\xeb⭬\x1a\aD-\xb3\\^M\x80\xb6ĸ \u007f[\x8af\x89lB\xea\xddU\x90\xe9\xcc\x1ary\x95\xcdf\xa2\xec\x80\xfa\x90\x1b%`\x99\xd5\xf9UV\v\xa0\xecB\xdbB\xe1\x8a\x18օ\x80\x8b\xcdb\xb13\x14_\x9b\xf5L\b\xb2\v%\xcbM#\x98hz\xb3\xa5\xcdPT\v\x02\xd9[\x8e\xc4\xd7\x0e\xc1\x14\xec6\xcd\xf6Z\xc2\xe7\xbb\x17y\x18̥\f\"\x9b_\xf7\tL\xc9`{\xc5ohӨ(\xc1o!#\x8c\x0e\xe0ht\xf0\xcc\xeaK\x10\xd6R6\xb1ٟ\x12\xc1\xf5\xc1\x01\xc0\b\xb9\xf0j%\xab\xffy\x85\xd9\x01\x8a9\x18\xe5z%\xf3M)`:\x85\x80\xad\x86\x00\x9e<ѩ\x89خeݨ\xa8\xf3\x0eSS@\x18i\xfc_\xbf\xfc֠\xa2\x12\xb4\xb3\xd9\x16Ê#\x15c\xeaJ\x05qz\x92\xad\xf2H?\x87\xa6\xee\x1a\xf3\xf3Z^)Qâ\x94\x17Y\xe9`ǖ&\xaf,\x9d\xda\x1a\xedI/\x8d\xa1%JD\xa2\xe0\x10;\\5u1k\x0e\x119]\xfeP\x8by\xb1\x15ؤ\xb3\xe0J\\\xbc)\x9a \x86\xe0\xa5|\x8b?+\x85\xff\u007f\fα\"\xbf\x88*\x97\x0e\x04\xd5\x04\x87EV\x15+\x1a7$s\xf6D\x0e\x93\x04\xf5\x06\xf9\xef\x1b\xb1\x139\xce\xc2f)\x8a\x9a\xd6\x16Z\xf8\x8d\x12\xdf(\xf5u\x8bet\xd4\x1d\xa68+\x9dbd\xed0\x84\x16\x91Z\n\xd1P\xf1@\x1b\"\xf8\xbd\x91\xb0\x94eNS\xf8Oo\xc4n\x8e|\x06Q\xfc\xf2\xf2{\xae^\x82(\x88\xdaG\x88\xe9\b\xfe\xda\x14%2eK\xc1F\x02\xfbK\f\x13P\t\xbc\x98C%\xa1\xc9\x16ܜB\xc1\xa2\xb8\x14U\xcc(2\xf8\xf6\xc5/\x98\xc8py\x02?\x92\x05\x8b\xb3\x1b\xd6\x1c\f\xab\x10\nfY\x85\xf3l\x9d)%\xf2\x84`\xb19\xb6d\xb3\x9b\x19\xc2&[\xc4\x04\x19i\xc1\xce\x11Y\xdd9\xe5G\xa6ƪ\xdd\xdc@\x90\x17\x97zf!\x91\xaa\x03z\x9c\xcb\x1a\xc2\n\x8aJ\xa3\x14\xe5Yu\x0eSz;\xab\xce)\x8f\xb6\x1aDy\x80S\xd9%\xd0״\x1bF\xc1lY\x94y-*Ȫ\\gWDh\xbe\xc2x\xa0=E\xa5 \xe4\xaf\xd8S\x84`\x1c\xf3\xefi\fI\x92\x1c\x8dL\x03\xa9\x8ed\xb2\xc2\x14\xc61T0\x85\xac^l\xb8\x03\xb4\xf7\x01\n\xf8\x02\xaagP\x1c\x1fGV\xe3\xd1Ż[\xbc-\xe0Yq\x1ei\xee䶒Az-\xfd\x86H\xaa \xab\x8c\f\a;\x862o\x94{4(\x1aEÂ)\x00\xaf\x8aj&`%U\x03+yQ\x94\x02\xfeFsM\xc12\xbb\x14\xd0\x14+\x14r\x85R\x1b\xa1\xe0\xaah\x96-\xee\x93\\\x94\xd9N\x8f\xaa+aơ\x12\xeb\xac\xc6\a\x9e`H*\xab\x84\x8d\x94X\xac\x86\xc9O\xa1\xbbM\xadì\\/3-\xc6c(bV?\xdc\xf1EC\x1by\x83n|`s\xff\xfa+\x83\xc3\x11\xca\xff\xa8\x05?\xe7\xb0\x12\xc1\x893\xe8\xe8\xceN\x98B\x9a\xa4c8\x86bĺ&\x81\xdaLoa\n\xc6\xc5\x1b\x8e\xe1\x04\xc2\xf1\t\x15\x11\xc1\x88K\x85#\b\xc7izB\xe8\xa2\x18\xf8\xabE\xc0\xac\t\xa6=\x8e\x92\xa8ͅ\xe2[\x12\xd3>\xbfIHG\xf8q\x1e\x066-\x88\xa2\xa4\x91\xdf\xcb+Q\u007f\x93)\x11ze\xd0,\xa1\x92\x9e<\x81\xe0$\x80c\xf3~L\xaf8\xe7\x02\x1e[(\x01>n\x19\xd7\x19\xdf\x00o\x87)1(}\xe7\xf1O\x9bR\x84\aFR\a\u007f\xd2h\x11\xa7\x19p\n0\x91\xba\xe4\x18\x82\xeb\x00\x8e\xdb\xfc\xe9\xa7\xd7F\xc7\xc4Lo1\xc7\xde\xcd\xc1]p\f\x81\x9f\x91\xfb\xb0\x9b9\xa4\xdc\xc7\xd8]\x91\x0f3\x1e\xcaG\x9d\x13\xc1\xa7\xa4\t>\xb0\x88`\x9c\xde[\xe7`\x1fh\xae\x9e̔\xfa\x89x\xb5vM\x1e\xe8L]\xe2\"\xa7\x18\x98\xdd\xf8\xad7\xb7\u007f&\x8f\xefeV\x17r\xa3\xe0\xb2#ߺ,\x8d\xc2тڬQ\a\x10\xb9a⻁Y\xa6Q\x85\xa2\xecsl\x14\x92\xa2\xe4\xf0\xdb\xed\xa8Z\xdbǂ\xeb\x8d`\x9a\x1d'\xb3eV\u007f݄)\xdd\xf7\xb9^\x9b1I\x03D\xaeu\xd8\"m\x99\xe0\x88Sg\x98~\x0e\x1fO\xa7\xd0\xfa\xac,\xa3\xab\xe5\xbae\xaf\xc8ZSf\xa2\xa6\xe5-g\xf5X\xeaڎ{\xba\x8e\xf9\xd8ⱅ\xde^\xe4ڪ\x83~\x0f\xbc\x12\x8d\x02:&\xb0.\x05\vmW8f\r\xc8j&\x86\xc4\"\xdac]\xf2Zy\xe1\xc85S}C\xf23\xee\x1b\x82\xad\"\x9c\xab=\xb1珜\x01\xe9\xf7]Q\x96\n\x8b\xd06\x85\x8et>P͕\xa8\x17\x02By\xf1zX\xa61\xe1\ad\x9aKy\x1d\xd6\xcb\x15~gŹ\xfe\xe8\xb79\x17\xf3\x16\x8e\xfbE^\xbc&\xc9\xeew\x8cIE\b\xd3n\xa3\xaf\xef\xdd\xe6ˋ\u05fd\xf6\xff\xe4\xcc\n2\xe6\xc9\x18\x86y-W\x94Fz\x100\xc3EE+#/|\x9f:t\xf3>B\x86\xbcZ\fE\xbe5\xd57\x1eKV\x9f\xb9\x00Ԟ\x19k\x00_\xe9\xb4\t\xff\x9e\x15\xf9\x16>\xe5gM\xc5s[\xef\x11<\xdf\x14es\xd2\xf6\x982\xfa\xafy\xb7ޒw\xf4\x95\xbc\x93\xa7\xe4\xb1~\x92\xf7\U00052f2f\x8f\xe4q\x1e\x92G\xf9G\x1e\xef\x1dy\x94o\xe4}=#\x8f\xf4\x8b<\xde+\xf2\x81|\"\xef\xe1\x11y_\u007f\xc8#\xbd!\x8f\xf6\x85\xbc\xab'\xe47\xf0\x83<\xda\vbX'Mљ\xacTSo\xd0R\xef\xf0Bcȇ\xd2p@\xbe\xa5\x8d=\xb9$GB\x89\xe2\xeaz\x1f\x9b\xcc6Rol\xf9X\xe40\xbd?\xb3\xc7\xc0\xb2\xb8f\x89\xf2\xf4R\xd4u\x91\v\xea\xc1\v\xcd\x16\xd5\xe4\x00zHɘGT\\\xb8\xf9lO\x9bĺ\x9eZ,\x90Y\x98\xe7\xca\xe5\x1bf\xa4\xb0@\xd0> s\xd7\x15\x1a\xd0\xd8F4\t\xe9*U\xb4\x96\xb3\xb2\x16Y\xbe3\b\tOQ-b(\x1a\xfa\xbci$\xaa{4\x92\x80O&\xe4,xИZ\xd7\xe2\x92t9]\xd4\x05`F\xe3\x8dB|\x8d\\\x87\x11G\r#S<\xd1_\u0605\x80\xc5M\x1c\xbf\x89v2Y\x89\xaaϨ \x8e\x03G8+Q\xa2tƯV\xf4\xc6 aJ_\xa8\x0f\x9dt2\xd9\xf5v}\x98Z\x03\x9f\xf7\xa3\xc0\xb53\x13e\x1b\xbbdo\v\x9c)E\xcaK+\xe5\xdb\x01)\x13\xf3l\xd5I-a\x1c+K3\t\x99\xf0\x93\xfd\xc0sR\xd2\xd5\x026\x91\xe6\xb5L\x1a\xab\xea\xb5\x15!\xefV\x87@\xa0)\xafm\x9a\xe7b.kA\xd5\xd5\xe9\xa4D\x93AN\xaaצ,#\xab{8\x9a\x9a{N(\xa8e)\x82\x18\x82u-\x17\xb5P\xea\"\xab\xadi\xd9\xde\fE\xa5Xz{\xb5\xfc\xb8k\xf1\xb9\x15\x1e\x8d\xe0\a\xe9;\x98\x8c\x92O\xa6\xa2\xc7fi\xac\x8a,\xb7\xd0F\x93K\x0fZ6֚\xbb\xa1\xe4\xba\xc1\t\x8c#2^\xe1\x04db\xe5\x11\x1a\xb6\xa7\x1e$\xd9J^\xca|\x8d3Q&\xf3\xb5\xf2\xd3aJ\xdfF \x13\x12P\xdeW\xa9\x1a\x81\n\xbb.Q\vX,/\x9c\xc3\x11\xf6(\xd9\xd3#\xb2\xdd\xfd\x1ah\xc89af\x89k38\x9eV\xa4\x96\xf1,Z}\xf3\xf8\xd8y\xb3\xaa\xe9k\xb61^\xc3\x17\x06\xe33x\xedj\xb8ڔ#;\xb1\xeb\x03(\xe0إ\xe3k\xa4#\xd5\x11\xedMj\v\xbe\xc4N#\x0f<\xaczH\xd0'\x1a#\xaf\xa9\xfd\xb6\x0f\xe0\x98\xbbK\xd3>\x06\xe9\xd2c\u007f\xd0\xc1\xd4h\xa7c;\x85\x9f<q\x87\b\x92\x85\x9c#\xe34Ma\x84]\x14\xb5\b\xf7Q\x18\x1d\xf8\x98\xed\xd9\xf4r\xeeZ\x1f\x0eW}\xd5ȵ1G\x91\xe11\x8b5\xfc\xd8a`1\xf1\xb7I\xcf\xf1\xeb9\v\xf5)\"gz\x88\xd2\xed\x89Y)\xb2ڴ\xc6\xee\xc1\x90\x9b&\xf2\xcc\nQ&\xec1\xfbA\xe6\"\x02\xefU\x1f\x19c\xbf\x9b([@]68\xa6\xc805,+\xedS\xe3\x85\xe6\xdd:\n\x1aK5\xd4\u0095V{\xf3\xe2\xb2\xc87Y\xc9\xdab\x02\u007f+\xca\x12E8J\xbe\xab\xbah\x1aQ\x19\\EEN\xe0yV\x96\x17\xd9\xec\r\xacd.\xe0B\x94\xf2\xca'\xab6\nZ\xba\xe28\x92>q}.\xf0\xae<\x00Y\xd8\xc2\f`[\xde\x1c\x9b`\x8c$\xd6n\xdc.\xd3DC\xc9`\xa5I\x14{\xb3kHiq\n\xd5RB\xf2\xb5\xc0XK\xa9\xad(8\x06\xc97\x83\x91#h\xbdu\xc18|\xa9\v\xa7\xf3\xf6\xb3\xb6\xb7/j\x8b\xcd\xfd&\xb7\xaf\xb4~\xe7,b\xd9%F>\xef\xf5c],P:\a(\x9f\x82\xa1\x1c\x13\b\xd8,\b\x038\xc6)\xf8\xf4\x0f\xe9HS\xff\xa8\xa0\x86\xf0wjI.\x16\x11Ö\x06D7\xe3H&ڔ\xe2V\xe0O\x9cF^k\xe8|\xdfO\xda\x1a\ve\xa2\x8d#\xa2@\x97\x16_~\t\xe3\x1e\xf1\xf6=\xb9G\xec\x92\x1d\x02\x96K\x16>\x97Tb\x81\x1aû\xf73\xb1\x871R'\xec\xd7s\x04\xa7\x03}\xec\x90W&Z\x9d\x86\xaf \xb0\xa4{\x9a\x87i\x9c\"\x81`\x02\x81\vj\xcd:˛\x9d\x8fV\xdaN\xfa\v@O\x9ex\x8e\xea\xd0\xc2\xc7Fr\xc5֟Y\xf8\xec<6ģ\xa6\x90\xc3t\xdc\xcaHLS4\x9d\xe9\x18ż\xa8\n\x97@{Gv\x90\xef$1\x93\xad\xa8T\xa8\xc4\"&\xe2\xd3Fh\xb6lc\bRH\xe1\xb3\xf5\x16\xe8\x1d\xbb\x83m\xa1\xd3\xf56\xd8;\xac\x1f1 ۰\x98\b\x8bq\x81\xd0\x10b\x1fH\xa4q\x8e\xd7[\xa8\x17\x17\x19S7N\xc6Q\x10E\xb7H\x0f\xcd\xcf\x1f\xc8-\xb3\xfc\xf5F5\xcc/ekag\x80fe\xc9f\xb6a\x80C\xec\xf3\xc1|\xd3\x0e\x00\x9fs\x161\\f\x8e\xd0AR\xe3\xb0\x17eB\xeb@?P\xe0G\xed\xe8\xf5S\xcf\nss\xa1\xa9\xf8\x14q\xb5\xad\xc7.\xf4כ\x8a\x06\xeb\x1a\xea\xb5`ZS\xbdc}/\xa3\x965\xd9\xc2\xd8\x04\xadSwe\x16\u1ca6\xa9\xdb\xda\x1b\xeekfd\xf0\x05\x0e:Ā\x03p\xbb*+5=\xdc\xd4\xd5D͖b\x95\xa9\x93U1\xab\xa5\x92s\xbaNzr\xb9*\x0f\xd9\u009e\x1e\xa2\xfdq\x82\t_\x06\xba\x14\xaf[\xad\xa6j礂\x8bMC5\xb6*k\x96\xe7\x90Q.Z\x94\x9a\xeb\x85N\xb3nɛ\x84ا\x9e\xe59\xad:\x04\x89)\x18\xc7\xf3\x05_\xdcZO6u\x19~\xa2\xad\xc1O~y\xf9}\x14\xe8\xe9\xd13\x05\xb5\x84\x9b\xde-!k\xd2c\xef\x920\xae\x18\xf42\x9f\xc2\x11\xd4=\xb1\xb8\xa8\u05fe\x06\xea\bB\x87'^\xae\xca0\xa0H,A\xdcQ9gRֹ*ފ\t(\xcb/|=\x9b\xb2H-|Nj\x9b\xeb\xa4v\xd5D\xb7\xea\xd7F\x9c\xaaVB*G\x93\xecq~\x0e\xf4^/\x8a\n\xa6p\x12\xb62T\xdaIp\xe4\x11\xa3Ǥ\x8dXX\xd4k\x92\bCb@s\u007f.Ș{\xfc\xe6U\xbf\xe8\xd1\x19\xc5NXĐo\x89k5\xa2\xf6\x9c̕G텋\xca\xff\x04^\x1dk\xed\xa9\x9b\xc0\xd3?\xa4\xad\xb1\x81j\x99\x96ρ\xa9寿\xe6۽o\xa6\xf4PC\xb7\u06dd\xce'\xbd\x03\x05\x04\x0e\x80\xac\x9eq\x8f[\xa9\xed\xa1\xee\xf4a\xdd\xc3x\x87\xda3\x90\xd7X\xd5mN\xd6-\x06\xb2R\xf7\x9c\f\xeb\x0f\x03ٹ'&\xfa\xb7\x97a\xdfI\xf1\xdb\x183aP\x06!M\xb4\xb7vX\x1a\xf5\xc5\xf8~\x10\x19\a\xef@t\x16\"\xddGȶ\x88_\xe9E\u007f΅\x1cw^l\xb5\x13\xff\xa2\x14\"/\xaa\x05lֲ]g\x9f-\xb3j!\x0enkDt\xfb\x84\xf2d\xf7\x81g\x8f\xb6\xcb.\xd3[\xd5,R\xb4p\xc0\x9f\x9cj\xb7C\x91O\xbe\xfd\xfb\x8bU\xb6\x10?\x1b֛\xbc\xb4l\xfcy\xb9\xa9\xc3u\xb1\x15%\xf7\xed\xf44^eo\x04\x97?\x1d\xc7\xfc\xa0\x9b5M\x9eF\x81c\x11z\xea\xdf\xed\xb5\xa3\x1aE\xbe\xc81*\xc5\xc2\x13\x13}\xee܊\xca!9\xdc\xe3\xd33^\xa1l]5\xfa\x9bd\x96L\x8dA\x15\xcdL؛\x1bk\xf8 \xe1g\xf8\x8d\xd4m\xf8\x02fC\x12\xdd16\x91oy\xe2\x1d\xe1Ο\xf1\aD4s\xeaqK\xb2\xa7\xb2\xcd\"\x98\r\xe8\x06\xee\xda\x16\xd1\xc9ݛ\xa5\xb7\xcf\xd0\xe2e`-\xd3\xc0T\x94\xb7\x11M!\xbcӮn\xe5?\xe9'8\r\x10?\xeač\xd86\xa3\x99R\x81\x9d7\xd8s\x03;\xe1\xd4\xf3\xdd\xcfق\x8e\xef\x05K\x91\xe5At\x96\x9e\xc7К\xd0V\xddK\xb8R77va\xf3\x15&p\x1b\xc3H\vj\xde\xe1%/D\xd7j\xd0\xe2\x10ٰ\x15\xf7\x10\xf4\x05\xbeQ\x88ɋ\xa6WN\ta\xac\r\x00\x9c\tA\x84=B\xc9I\x96\xbf\x8e\x8cΥ]\x1d\xb4Y\xad\xa7\xe2O\xa1\x83.k\xf7^\x98>ҭ\xd5\x03\xfa\xe0`\x1fE\xcf\x0e~?X\xf0\xfb\xdf\xef\u007f\xbf\xff\xfd\xc7\xff\xfd/\x00\x00\x00\xff\xff\x01\x00\x00\xff\xff7b\xf6k\x00Z\x05\x00")
+var AssetsFS = vfsutil.MustOpenBaked("\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xec\xfd\xed\x9a\xdb8\x92(\b\x9f\xbf\xaf\xaf\"\xac>SMZ\x94RRf\xfaCi9\x9f*W\xd5T\xed\xa9\xaf\xb5k\xfatOV\x8e\x1f\x8a\x84RtR\xa4LR\x99\x92\xab<\x7f\xf6\x9a\xf6\xdf\xfbk/\xe0\xdd[z\x9f\b|\x10\x00AJ\x99\xb6\xbb{vO\xf5\x8cS\x04\x02\x81@ \x10\x00\x02\x81@\xb8^\x0fߖ\xff\xed\xb3\xfe7\x1a\x8f\xf0\xbf\xff6\x1a9\xffN\x1e?~,\x7f\xf3\xf4\xf1\xe8x2\xfao0\xfao\x7f\x87\xff6e\x15\x16\xffm\xf4\xd1uٍ\xfb/\xf2\xdfMX\xc0_\x7f\xfcỪZ\x7f\x1bFU^$\xac\x84\x19\\<\x00\x00Xl\xb2\xa8J\xf2\f<\x1f~/X\xb5)2\xc8ح,\xf0\x8a\xbd۰\xb2\xf2\xfc\x0fA'\xf8\x97Q\x95ܰ\xbf\xfe<\x7fˢ\xca\xeb\xfdXnW\xe9d\x88H~\xfd\xf5\x97\xde}J\x1f\u07fbt\x12\x15y\x99/*\r\xc1\x83˳\a\x0fT\xf9\xa8`a\xc5D\xae(\xe6\xc3\xefT\t2k\xbbJ\x97U\xb5\x86\x19d\x9b4=\xe3\x95\xe7\x05x\x98\x99$0\x83\xd1\x19\xfe}\xde`\xeb0e\xd9U\xb5\xc4\xdc~_\xa2\xc4\xff\xaab\xa7}\xe1\x7fu%6\x92\x8b$\xb9\xf4\xfc3\x05\xfdA\xfd\x8a\xc2*Z\x82\xc7|\vW\x94gU\x92m\x98\xab̼`\xe1\xf5ك:QpN\xd4\x7f\xf6\xe0\x83ƙ\x92e\xb1\xec\xf2M\x91\x06\x10\x87U\x18@\x14\xa6\xe9<\x8c\xaeu&\x15\xec\x1d\xccܬ\xe4\xb5%\v\xf0\x1e\x16\xec\x9dN,\xaf[\xa7\x06Q\xadX\xb5\xccc\x98Qmp\x0e\xbd_~~\xfdk\x0f\xa6\xd0\xfb\xd7o~\xed\x9d\t\xaa\xdf\r\xf35\xcb<\x0e\x1c\x00\x91W\x15\x1b\xa6U\x87\xe5\xcd\xea\xde\rKV\x89\x16}\xc7\u0098\x15ޟ_\xe6YŲjP\xed\xd6\xec\xcf\xc1\x9f\xc3\xf5:M\xa2\x10\xdb\x7f\xb4\x1d\xdc\xde\xde\x0e\x16y\xb1\x1al\x8a\x94eQ\x1e\xb3\xf8Ͼ\xc1\xbdw\xc3<+X\x18\xef\xca*\xacX\xb4\f\xb3+\x063S:\x15\x05H\x15\x16\xa1\x02\xaf\xb1\x00\xccfp\xd2\xe8@\xc1`\x84m\xf4\xfc\x87\a-\x98\x1eZ\x98xs\xb3\x98\xf3ArY\xef\xdfuX\x94\xec\x7f+\xf3̫ض\x92e\x11\xf5\xff\xf6\xfa\xe7\x9f\xe0\x8b/\x00\xff\x0e\t\xac\xd9oZ&/\xef\x10+v\x13\xa6ޟ\xbd?C\x1f\x10\x04\xfa\xf0g\xffϾ)e\xe1z\x8d\rؔ\x9e-X\xba\xfc\xfd\xeb\xcf?}\xfd\xf3\x0f_\xbe\xf9\xfa\x9b\xbf\xbcy\xfdͫ\xbf|\xf3\xea\xcd\xeb_\xbf\xfc\xf5\xdf^\a4,\x03\x8d㖔q\xf1L\xf3\x10ej\x11\xa6\xa560$\x1bK\"\x80:c\xd4\xde\x1dX\x91\xde\x1f\xc0ҒY\xc0\xbc\xb6\x12Gr\xcd^\xdeS\xe5:\xcfJ\xf6k\xcd*G\x7f\x97\xebf\x87#\xbf>\x9d\xfe\x9f\x87%\x1b.\xabU\xfa\x8f\x9b\xffO\x9f\x9cN\xac\xf9\x7f<\x1a\x8d\xff\xd7\xfc\xff\xf7\xf8\xef\xf7ߏ\x1e=\x00H\xb2(\xdd\xc4l\n|=\x18\x88!\xf2f\xc5\xca2\xbc\xe2\x12\xf2\xe0\xd1ч\x0f\x0f\x9e?\xfc\xfa痿\xfe\xed\x97o\x00\xd3^<x.\xff\xb00~\xf1\xe0y\x95T){\xf1\xfb\xefP\xb1\xd5:\r+\x06=J\xea\xc1\xf0Ç\xe7G<\xfb\xc1\xf3\xb2ڥ\fP\xc9\xcez\xa8\n\x8e\xa2\xb2\xec\xbdxp\xf4\bpޙ\x1e\x1d\xad؎\x15\xb7l>\x8c\xf2\xd5\x11+\x92\xe8\xa8\xca\xf3\xb4D\xb8\xa3\x82\x95\xac:z\x00\x007\x93\xe1\b\xfe\x80\tJ\xccx\xf2\x18\x93~H\"\x96\x95l\nY\x9e1\xf0֛y\x9aD\x10\xe7\xab0\xc9\xfc\a\x8f\x8e\x1e<@\x82\x03\x98\xe7\xf1.\x808\xb9\t\xa0\\\x87Y\x80-OY\x15@N\x13U\x00ɢ\bW,x\xb0\x1c\a\xb0\x9c\x04\xb0<\x0e`y\x12\xc0\xf24\x80\xe5\xe3\x00\xd6\x01\xcc\xd3<\xba~\xb7\xc9+\x16\xc0\xba`\xc1\x830\x80p>/\x02\b\xa3\"\xcfv\xab\x00\xc28.XY\x060O\xae\x02\x88\x12\x04ŉ#x\x103\x9cB\x17Y\x00l\x15@\xb2\xba\n \xc9\xca\x00\xae\xe7q\x00\xef\x02(\x03(\xc3\xd5:xP\xaeB\xd4heU$\u05cc\xfe\xe6\xd9U\x00\xe5f\x8e\xff\xac\x03\xa8\xaa\x00\x15M\xf0`\x1e\xc0&\x80$\x80\x88e\x15+\x82\a1VQ\x05\x10\xc7\x01\xe4i\x00\x9b4\x804\t\x1e,\x12\x96\xc6%\xb6\x16\xa7\xb3\x00\xd2p\x8eԤ\xec\x8aeq\xf0\xa0\n\xe7)\xd2\x19\xaeQ\x85\x06PqfU\x8b<\xaf\x02\xa8\xb0\xabqzş\x01Tq\xf0 ,\xaa$\xc2\"a\x99\xc4T2\xbb\t\xcb\x00bV\x85IZb\x13\xe7,\xc6z\xaf6\x05\v`\x91\\)䈔\x15\x01,i\x06\x0e`yU\xe4\x9bu\xf0`ŲM\x00Yx\x13@\xbe\xa9֛*\x80b3\xdf\x05P\xb2\x88\x97,7\xabUX\xec\x82\aU\xb2b\x01\xac\xc2\xe2:\x80p\x13'y\x007I\xccrRƫ\xb0\xb8J\xb2)\x8c\xce\x1e\x00\xac\xc38N\xb2+\xf15ϋ\x98\x15\xe2c\x91gՠL\u07b3)\x8cG\xa3\x7f\x91ISH\xb2%+\x92\n\x13n\x1864L\aa\x9a\\eS@\xed\x99&\x19\xc3)\xec\xe8\x11|\xf7\xeb\x8f?\x9cB\x9c\x94\xeb4\xdc\r\x8a<e@\xb2\x8a\\\x86<\x8dY\x01\xf3\"\xbf-YQ£\xa3\x06\xd7\x14\xb7\f\xf6p\x96=ha\x13hl\x12\x8c\xa1V\v\"\xa6\\F\x91>\xecC\xcaB\x82\aK\x96\\-\xab)\x8c1\x8b\x8b\x86\xc8,\xab\x01\x8dN>\x82\xa8\xa4&\xe6\xef\b\x8a>J\x17\xc4t\xce\x169v\xb1\x96\x14.H\x18ߩ\xbcw<\x89PE|\xc15\x85?\xff\xf9L\xff\x94\xa8I\x16\xe1w\xd5[\x83(O\xd3p\x8d#\\\xfe\xaa\xbbrP\xae\xc3H\xf6/u\xc97Y\f\xf9Btã\xa3\x9a\v\xd4\u074bp\x95\xa4\xbb)\xfc\x85\x15q\x98\x85\xb6\x18<^o\tw\x18]#\xb7\xb3x\n\x7f\xfa\xfa%\xfe\x0f\xb1/\xc75\xa2[\xc1\xcey\x9e\xc6\x16\x96\xe3\x11\xc7\xc2\xe5p0ϫ*_\xa1\x8ca\xf2\x87\a\xcbc\xf8\xdd,0\x119CV\x149g\x92A\xc1\xb7߾\x1c\xbd\xfc\x8a n\xc3\"K\xb2+\x17̷_~K<\x18&\xd9\"w\x00\xbc\xc4\xff\x11\x00\xaa#\a\xc0\xe8ۧ\xdf~\xab\x00\x86\xabMZ%ӬZ\x0e\xa2e\x92\xc6^\x1e\xc7~g\xbd\\P\xe1w}\xd4M\x04/Do)^\xac\xb7P\xe6i\x12\xeb\xec\x1d\x96\x9b(be٬\xe4\xebo\xbf\x1d}\xfd\x14aօ!\x1aE\x18'\x9br\n\xa7\xbc\x16{\f\x00JL^L\xe1O/\xbf\xfd\xfa\xabo^\xd6C<ˋU\x98\xc2x\xb2\xde\x1eM&\xeb-\xfc\x98ga\x94\a?\xe6Y\x8e\"\xc5\xe0a\xb2Z\xe7E\x15f\x95)\x11\x03\x89r\xb2\xc0\xffY\x99\xc9*\xbcbS\x18ܲ\xf9uR\r\n\xb6fa\x95dW\x03\x1c\x83a1\xb8B\x8aYVyU\xbe\x0e\xe0O'''0Zo\xc5/$$\x90\x88\xad\xaf\x93\x93\xf5\xd6o\xa9l\x95\xbf\xff{\xd5T\xfe\x9d*\xca\xff>\xf5|\x86J\xea\t\aƧ\xba\"\x98\u0098\xad\xf8̓߰b\x91\xe6\xb7S\b7U\x8erMS\xbe\xad\x17\xc6\xe36\xb1\xc6\x15\x94\x9c\x92\xf8\xd4o\xd4#5\xcd\x18j\xbc\nI\x92\xf1\t\xac\x1e\x1c\x8f\x1f?>3\xe7M>ІѦ(XV5\a\xe4ӯpɫ\xe3\x90\xea\xa5Q\xd1@\x11}\x9b\xc4ղ\x9ek億|\xdcø\x11\fx\x12\xa7\vW\\\xf0\xbb[\xab\xc4yU\xb1X\xa7'\xda\x14%\x92\xb8\xce\x13Ψ\x0f\x0f\x861\xbb\x19\x94\xac\xb8aŠֹn\xa6\xca\x06\xd6MVT\r\x8fe\x87\x9a3\xec\xf0\x94\xad\xdc\xd5\xe0\xc2\xd39[\x8bګ\"\xccJ\\\xa1Ma\xb3^\xb3\"\nK\x9a\x13\x9f\x1f\xd1\f\xfd\xe2\xc1\xf3#\xb1\xec\xc6i\xedŃ\xdf\x7f\a\xb6\xadX\x16\x03.ԏx\xe2\xf3#\xbeBo\xd9\xffm\x92\x14\xf9\xfc9\xf7\x80\xfb\xec\xbf\xc7\xe3\x13{\xff\xf7\xf8\xf4\xf8\x7f\xed\xff\xfe\x8e\xfb?.6%_\xd1\xf2ݞ\xb6),\xd7I6|[\xf2\r\xe0\xef\xbfC\xcc\x16IV\xef\xec>|\xf8JH\x11\xfc\xfe;\f\x7f\nW\f>|\x18\x0e\x87(\x8fB\x18\xe3\xe4\x06\xa24,\xcbYO,\f\xc4\xf4\xde{\xf1\x00\xc0\xd8/:\xb6\x9e=\x18\"\x16\x80\xe7\xcb\xf1\x8b\x96ʞ\x1f-ǈ\x8b\xaa\xa2\xf11\xeb\xc918\x19\xa1\x0e\x84u^&\xb8BFM\x9f\x86h\x18>\xa3\xeaE\xa1$\x9e\xf5\xb0\xa9\x19+z/\x9e\x1f\xc5\xc9\r\xe1\xe3?\xe4\x9f2*\x92u\xa5o]߆7!O\xed\xbdx`\xb4D\xf0\r9\xf4\x00-A\xf9\xba*a\xa6\x16\xe1\xe5\x14\xc6\xc7\x01\x1c\x1d\xc1\xafK\x06\xd9f5g\x05\xe4\v\x9e\aU\x0eq\x11\xde\",ٍ\xb1\x15\n\x98'!0\v\xa3%\x95\xd0Uj\r\x87\xfdT-\x93\xe8:ce\xf9\x00@.\x91\x8ek\x18\x9e\x84\xb8\xaa%\x03j>DI\x11\xa5\x8c\xf4]\x91\xb1\x02)%\xf8\x97\xf4\t\xa4\xf8\x11#x\xa3\xe1p\xec#\xe2\xbc\n+6\x05\r/\xa6$y\x06\xf9bQ\xb2\x8a\xd4\\\xc1\xb7(\x12\xddx\n\x11j\xbcۤd\x01\f\xf03ߠ\xaaU\xa9\xb5\xca\xfd\xf3\x9fF\xa3џ\xa9؟\x8a\xab9\xe4\x05\xfc\xa9(\xae\xae\xe6\xf43,\x8ap\x87m hli\xb9f,\x96\x15\xbdB\x82KX\xa3ܱ(\xcf\xe2\a\x00U\x11&\xe9\x14\x1es\x8a\xbf\xc4\x1d\xc9U\x9a\xdf\"\x10\xea\xfb\xf0\n+/\x97a\x8c33\x1a\x93\t\xee\x7f.Y\xb5d\x05T9\x14,CA\x0e\x05\xd0\x03\x80\xe5m\x18E,u\x83oJ\x06˰\x88oÂ\x01\xc1\xb1\x82\x18\xf4\x00\xf8\xd0@Y\x9e\u009f\x85\b\xfeY1\xf2\xe5\xeb\xd7\x1c\x00\xb1\x84e\x99\\e\xf8\v{K\xc0>\x00x\xff}\x16\xb3\xed\x14&\xec\x99*\xf8~\x90`\"x1[\x84\x9b\xb4\"\x04\x13\xa5h\xb1۪|=\x85?\x9f\x8e\xfeET\x97\xaf\xd5(Q\x83\x04K\xadC\x9c\xfaI\x18\x17\x95(\x81\x05~`\x8b\xaa\xb3ć3\x12\xfd*,\xaeX\x053\x88\xf3h\xb3bY5\xbcb\xd57)ß_\xed\xbe\x8f=\xd5l\x9f\x17\x10\x9f0\xa3c\x9c\xd7\xfc\xcb\xc3\x11\xe4\x0f1\xcf\xe3\x18\xfd3\x9c\ri\xf4\xbd\xf8o\xff\xeb\xbf;\xff\x17\x1f\x0fo&\x9f\xf9\x04\xb8s\xfe\x7f29\x19=\x994\xce\x7f\x9f<\xfe_\xf3\xff\xdf\xe3?O\x1e\x94\x88\x93)\xf9\t\xf1\xf1\x1b\xd29\x1e\x1e<\xa2y3_\xb3\xa2JX\xc9\xe1\xccSKu\x00z\xcdv\x90d\x0eh\xfc\x8f\x1f\x01\x0e\xf9\xea\xe1\x17\x0e\xb2#\xfc\xc3u\x91W9Ω\x01\xa2\b\xb4B\x007a\xbaaS\r\xe7\xc55\xdb]\x06\x1a\x04\xcb6+V\xa0\xa1hʏtT\xde\au~\"NO\x1cǤ&\x010\xd3j\xaaϰ>X\xbc\xa1\xf9\xe6e\xbe\xdey\xeb\x92m✾\xf5#\xd0\x04f0\x18\a\x90!\xc2\x1aD\x1c\x02\ab\u009a\xc1\xc5%\xaf\xe4v\x99\xa4\f\xbc~?\x81\xe7\x90\xf9<\x7f\xb8ޔK\xbd\x86\x8b\xe4\xd2?ӏ\xd4(\xf5\xac\x8d\xc0\xd7i\x121\x17\x85\xa2\xf4\x97\xbc\x12\xd9\xf6a\x89\xf0C<\x802\n\xb9\xf0\xff\x18\xaeQd\xec\xe4$fY\x95T;/\xb6\xaa\x8a]H\xaaeRz\x16 \xa69a\x8b\rk\xc0\x16\x1b悥\xdfy\xe1\xdd\xd8\xf0\xbb5\xcb\x17p\x03\xb3\xd9\fz\xb2D\x0f\xce\xe1\x06\xa6`\r\x05\xad܍\x10\x04W]\x05\x9b'Y,\xa6\xa3\x00\xca|SD,\x10\xe7\xd6V\xfd\x8e\x1aPVH\xc2a&\xca\f\xf1$b\xe7IDaqE\x13f\xa9DYu\xbd\xc8\x102\x05\xe7r\x96\x9dr\x8c\x1dD\xf3Ŧ\xb7\xb5\xe8\xdb\xe2\xb11\x9ek\xe2i\xefä\xfc)\xfc\xc9\xdb:\xbb\xff}\xb2\xfe\x81jmv\xb4 \xc7U\xaa\\\xa7IU\xb1\xa2Y\bf\xb5C\x85]J\xday\xbd\xd2*U\x0e\xab\"Yy\xfe\xb0`\xeb4\x8c\x98w\xf4[\xd9?\xba\n\xa0\a='\xd5\x05\x9eǿ\xc1\x8d\xff\x15+^Ga\xcaj\x16\x90\xfa\x82\x19Zĵ\xf1\xb8\x85Gp\r\xff\x02c\x1f\xae\xe1\xd1\f\xc6#c\xf8]\xbbj\xc1\xdd<j\x19\xd7\xf8\x90yo\xd8\rڕ\xe4\xa7$B\x81\xf2lSL\xdeÌ\xec\xf3\fW䁡a\xde+\xbd\x92J)\xb1\x15\n\x1en\xa7\bz\x91\\\x0e\xf3̇T\b\x1a\x0e\xb8\x0e1\x93$ڎ\x19\x8a\x12Rb\x81\xfa\xfejG{2\xben㊂\x17\xa5&\r\xf3L\xf3\x87\xf02<bSE\xcd\xe6\xa60\xb3\x90⚑\x8a\xf8\x01$\x92LlWc(<\x87\x89/\x1b@\u009c\x0e\xf3L/\x91\xd6u\x01e\x1a\x0e=\x94\xa8\xb5O\xfd\xe6\x1a\xd2\x1bq\xee\xd7ɴ\xce\xfey\u197e?\x8c\xf2,\n+\xcf.\x93@\x1fƾ߬@\xb4\xad`\xab\xfc\x86\xf1晓\x96\xa0X1\xa9\xc6LӃ\x85\xa7\x14<\xd2'Q\xdcqI0\x89\xda\xdf\xd3\xd1g\xa6\xe7\x06\xe3\x96\uf1a6E\xcby\xf5f]\xb0()\xb1G\xb7\x01\xac\xada\xba\x86\x01\x0e\xa4s\x18C\x1f~\f\xab\xe5p\x91\xe6y\xe1\xd1\xcf4\xbf\xf2\xb62}\x9d\xdfz\xb8{m\x05\xf4\xe1\x88g\xfc\xf0\xd3x\xe4\xc3\x00־\x954\x85\xb1\xdfA(\xce\x00_\xf3ݐC\xfb\xf5\xa1\xd7\xeb(LGn\x1e\xe9V{\xaa\xa7\xc4a\x1a\x96\xd5\xf7B\x14zÞ\x1f\xc0\x02f\x90\xc0\x8b\x19\x8c\xe0\\\x00\x95\x9b9\x9e\xe5fW^\x82\xe4zZq1\x86{X\xb2j.\x0e\x12x\x01#\x1f*\xde\xef\rl0\x98\xc1q\x00(hǾ\xb9L\xa8\x86\x05\xbba\xe8\x9b\xe3\x0f\xdf\xe6I\xe6\xf5\x82\x9e\x0f}X\xb4\xb7\xf6\x97\x82-\x92\xad\x17\a\x90\xd8*\xd2\xe8+\xfa\b\xe7\xa5\xf7\x14\x06\b\xfa\b\x8eͺyY\x80\x12\xf5\xed\x14\xb9\x01O\xe1\xbc\xd6\x01\xb1Ù(\x86#\xb8V\xa3\x00\xa6\xfb\xa0\x1fi\xd0reX\xeeV\xf3<\x9dB\xdc>\r\xb2\xb0d\xb8\xcc]\xad\xbdE\xdbT]5V\x03\x15<\xe7\xfd9\x82)Tعc\x12\xee),<\xe5\xf7\xd4Z\x9d\xec\x88;T8\x86\x01,<\xfc\xf7\x10\xf4\x8b\x14\x1d\xed\xee\x80~x\n\x8f\xc0\xab\xe09\xfe:\x87\x857\x81GP\xa1lN\xa8b\xfc\x97'\xed\xad]-\xff*\xab\xfa\xaa\xb5\xc8:Ow\x1e\xb3\xc0;\xa8U\xc2W\x05\xc0\xf6\x12T&Y\x83\x16d$a\x89\xf2ҫ\xe0\x11\xff\xf8\xe5{8\x82\x89ߊ\x89m\xd7\rL\x8a\x96I\x00\xe3\x11\xe7\xe2@jy\xb7\xb0\x91a\xad\x9d\xa4\xf2]Q\xf1\x9e&\x86\xb7S\x83\xaa&\x89\xbcPS\xb7d69{\xd0=)\xaea\x06Ó\xd3N\xb0q\xcd\xf4\xb0^\x11\x01\xe0T\xb8\x86#89{\xa0y\xbc\xc9T\x12\x1b\xc1J_\xfe\f\xb1\x03\xc6p\x04\xa1\xa9\x14:\xa5\xbd\x0f!<j2wP)\xb4\x88\x95x]b\xd2\xc4\xe8\xc3\xf5^\xa1 \xef\xbaR\xf7p|X\xfaԎ\xf1\xf0\xc9h|\xfa\xf4\xec`M\xf0\x88\xfe\xdf\xf3J\x9a\xdd\xe9\x13\x89\xdaKA\xbeɢ\xa6\x18\xe0\x18DfM\x86Op$>\x19\x9e>\x9e\x9c\x8a:*R6\xcfa\xe2\xc8GV \xf1\xa7\"\x8f\x13\xd2\a\x04\x13\xa5\x86\xa7\xad\xe5&\xc3I\xa3\xe0\xb3c*ڀ|\xec\x00}zr\xfc\xe4\xd4\xd9T\\5\xbc\f\xb3\x88\xa5j)\x1b\x1f\x0f)yXV\xf9\x1a\x8d\x00\xe1UȷDgf\xfe\xba\xa0\xbfr\xb2\xf6[+xM\x1b%\xc3I\x9b\xc1L!\n\xa04&Q\xecf6仫o\x10\xc0'\xf0\xd2\\\xef\xb4\xd6\xf6\xb5\\\xdc\v\xfb\xa3V\xab\\@\xd5k_\x99\u0097\x8b#\xbeV\xb7\a\x9c{\xff/\x8b^(\xf0\x8b\xe4\xf2\x12f:Z{\x13!8(>\x87\xf9B_jW\xcb\xe4}\xbd\xcc\x7fߐe\x05\xc8\xc6]\xce\xe9\xc4\xde\x11\xf2p\xac3Qc\xb8\xee\xcd\xca\xc6Ce\xf9\xe5?\xf4\\Y\x82\xb0\x19\x19\xb2\xf1X~\xb7f\x97\xf5V\xc5hC]\xe6\x03,\x92,LS\x93X\xbd\x82Q\xd3\x01\xfe\x83k\xa9\xab/\x83\x9b6\bq \xeb\xad\xf4\x8e/\x90\x1f\x17\xb0\x1a\x86\x01\xac\x86s\xb8\f\xa0\x18\x8b\xa4\b\x93bL\xba\xde\xc2\xcc@\xf2\x13y\x9d$\xef\x99W\x8c\xfc\x00\xae\xdf[\xf9_\xe7\x95W\x8c\x10\x17\xe6\xeeZK\xeb\xa9/\xf3\xd5<ɘW\x8c\x03\xc0\xb2\x83\xeb\xf7\xbe\x0f\x7f\xfc\x01\xa3Z\xe3\x17\xa3\x8b\xd1%<\x82b|1\xbe\x84\xe7\xf8\x97\x7f\x8f.Ɨ\x9a\\p0\xdcd\x9e\xd5Ic+\xe9zk}\xbf\u05ff9\xa7q\x879\xe4\aD0\x03\xef\x1a\xf7\x02|v\xa8\xc2l\xe2\x11րׇ\xab\x0e-k@\xb4\x05\x9cT\x1fU\x8e\xc1 vU0i\xa5\xa3J(+\xe5\xf5 \xf7\x19r\x7f\x01\x97\x1a\b-C)\xfbzKl52\xaf\xd9-\xcc0ՠ\xf0\xfa=B\xb6T\x0f\u0081\xb0UX\xb0\x1f\xc3\x00斲\x0f9\xd7\xe7\xf8\xa7\x0f!q\x16\xe6\x17\xe3\xcbNdu\xb7\x87\xcee9- \x1c\xb5\x87\xbev\x17\xe2Z\x9b\xe0\xb1\xfa\xa3Y\xbd~&B\xea\x84\x0f{\xcd\x1d\r\xd9ö\x06\xa0\xea\xa0\x1a\xfa3\xb8\x16\x8d={\xa0\xaaQ\xa9\xe3KӪ骇\xfc5\xd69\xf6.\xdf\xea\xf2\xbd\xb2\xc9Ԍl\x0f3\xe8)\xaaк\x17\x1f\x0f\xb5ҿ\xca,\x98Z9\xaej7\x99\x06\xf0\x13\xb7\x9b\xe9\xbd9\x87\x19\xccq\x87\x1b\xc2\f\xfa\xa1O[\x81#\xf00-\xf4\xa5p4\xd5춡\x81\xbd-/\xf2\b\xe6\x1d\xeb\a\x83\x9e\x97\xb4w\xf9<\xe4\x908\xad\u00ad'7y+\\\xca\x05:\x99\x9d\x1b\x82\x97x\x12벁\x15Ws\xaf\b\xe0\xaa9$Ĵ\xf9J\x03pa~\xd5D\xc0U\f̠\xd0F\xf3\x15\xcc\xe0J\xfb&\u07b8\x10\x16W\xf37K\xb6m؋op=\xf6\x18o\x1d\x8dz\xd078r\xe3\x0f\xab\xfc5\xdfy\x8f\x1f+\xb5\x85<\x9a\x9c\x9e6\xf2\xdbj\xe5\x17f\xf8\x8e;\x80\xe2j\x1e\xc0\xb2L\x8dɅ\xaf\x1c\xae\xf8\x9f9\xff\xb3\x1a\a\xb0\x9a\x04$\uef01+\x9cp\x8e\xbc\x8bp\xf0\xfe\xb2\xef\xff\xe6y\xc3G\xfeo\xfeQ2d[\x16\x89\n4\r\xb0\xd2&\xf9\xd5\x04f\xb0\x1a_L.\x87d\xa0%\xe3\x80Z\xf3\xdf&t`\xb2\x1a\x1b\x93\x03\xa0/\x12\xf4\x96eڛʤ:\xb3\xe6\xe0\xb2L=j\xe3\xb7i\x1eV\xdej\x82:>\x003\t\x11\x1f\xa1'\x98\x9d1\x91\x19\xae\x1bo\x9c\x82\xe2j\xdeM\x01J\x9b\xcel1\x84%%\xee\xacq{\xd6\xe4\xd2wPc\xdf\xc6\xe2zH\xa2\xc0\xaf\x92\xac\x97\xa2#|\x9d\xba\x8c\xcc\x7f\xbc3\x87W\xe2\xef\\\xeb,^H\xb7\xcd\xf3\x94a\xb4\f\x8b/+o\xe4\xf3\x03\x8d?\xf5\xea\x0e\xaa\xcb\xc9}\xdd̾\\\x86\x92e\"\x1ak-+\xa0\xafF\x13\xfewՀ\x9e\xf8zn_\x8d5\xa9\x86L\xe8c_\xcf\xedϤ\x92S\xfbH7\xc5OZ(\xae\xed^\xe3\x00\x8e}'\x9d5\xccq\x00\xa7\xbe\x93\xba\x1a\xe64\x80'\r\xcbk!on}\x9fU\xa8s\xc4P\x96\x15\xa9\xac+#k\xaeg͵,c6-\xf6\xa89\xd2Jej\xab:\xba\x15\x99d0\xab5N\x81\x136\xa9\x9d+\xf5k.~\xf9\x01\xac\u00ad\x82\x0e\xb7\n_\x001\x0e\xfc\x10\xf5\xf9*\xc9\x02Xҭ\x93\x14f\xe0aj\x1fSq\x00N\xb4[\x94uw\x90a\\\x1a\x8db82\nMy\n\x9a\x8eT\x05\xben\x80/p\x96^\x85[\x1fp\xbf\xe4]\xc1\x00[x\x041\xf4\xf1\xeb9\xcc\xe1\x1c\x1e\xe3|\xe5\x9f\xd5\xf2qe\x96\xc2I\xad\x90\xa5&\x02\x8er\n\x18\xc0\x95\xcc9\x91\x15/qm\xfaxd\x98/\xf4\xe6,\xe9\xfan\xb3\xa7\xe2c\xea\b\xc1\x9f\xd6\xceJ\xc3\xe6\xc4V+\x81\xed\xee\xbdW\b\x1e\\\x99\xc9W\"yn&K\x1d@\xf7\x8ey\xd6v\xf7\x9e\xaa\xf1\x86'\xe3\xc9\xc9\xe9\xe3\x13x\x84#\x15\x86ǧON\x9f<\x1e\xc3#\x1c\x8a0\x1c?\x1dᮜ&i\xe4\xc21\x96z\xf3W?\x80\x9d\x8dh2\x9e<~2y&\x11=\x19\x9f\x8eO'\x13\x89h\xf4d2n\xe0\xf9\x9b\x1f\xc0{\x1b\xcfh\xfc\xec\xf8\xf8X\xe1\x19\x8f\x9f\x8d\x9f)4\xcfNGǣ\x93\xb1\x85\xe7\xdfM\xa3\x10O\xf5\xc6\xe3\xc7\xf0\bvhI{\x1c\xc0\xe9\x88\fk(C;?\x80\t\xff\xc4\xdc\xf7~kOp^\x9b\x93\xb9\x1a%>\xdao\x87\xa3\x93\xd1\tJn\x813\xccd\xf8l\x02\xd3\xda\xe4\xe4Q\x13F\b{\x04c\xfc\x11\xc0dx\xd2=\x8d\x8b9\"\xd2\a\xea\x02f\xfa\x9c\x16\x99\r\x8e\xa4r\x8c\xa4\xcaC\xeb!W\xe6\xffғ{\x10\xf2\xb6\xf3\x16h\xe8\x1a\"ES\xb7\xd9^\x97P\xe7\xb2\xea\xbb=\"\xfc]\x13\x01-\x9bpT,\xf5MRm.\xa1o:\xa8k\xa1\x88\x16{\x16R\x05r\xe3-\xcd\tk\t/\xe0\xf8\xf1\bG7\x9ee<\x1eiC\x1f\r\x93\x94\xd3\xe79F\xb1瀥DsWhA\xf4V\xa4{\xc8&\xb7\x84#h\x96\x18?ՊL\xec\xdc\xc9I\aBor2\x82\x01,}\x03\xb1\x82\xd65H\xddX\xa3\xb5\xfa\x1a\x9b\xf7/e?\"\xf9l܁\xa7\xd5\x1eO\xa5\u0380\x7f\xa9Y\xd0\xca\x1b\xea&x\xaeN*Jx\xa1\x0e*D\xf7\xa5B\x85K\x90T\x03I\xcf\x1e\xa8\xc5aJ#\x06GK\x8a\xadGv\x94>\xc1\xf7\xa1\x84\x01\xa5\x96\xda:\x14\x87}\n\x03Et=\xbeQ\x1c\x90\x13h.\x9d\xa0Ճ\xd8\xc2\xff\xa0\xf0OF\xeeA\xbd\x8cH6\xa3v\xe1\xd6\x00\x9c\xc2\xddD\xe0\x14\xee\bf\x10\x1d$\xdcQJ\xda\xcaM\x95PeiP\x9f4\xd0\xe4S\x9b\xa9I\xfc\x1eaYe\xd1&\x01\x88\x9c\xe4\vda\xeb\x9e\xe9\a\r\xc0\x85\xe0\x87&\x02\xb3}\xe2;\x84\x19\x84\xdaw\xeb\x9e\t\xf5wq\xd5@\x8a\xf2\x8aS\x8c\x87\xb2\x81\x1b\xa3#\x18\xa3\x1a\xc7\xf9kG\x96\xfd#T\xe9|\xfe@->\xc7\x05\xc6HȬ\x98\xe5Ґ\xab\xf0\xad0\xb9\xd0\xdc\xc5!v&\xc4N\x83\xf8\x1b\x87xoB\xbc\xd7 \xfe\xdd%\x8eb\x12ß\xc7\xc3\t\xce\n'(\xbf8ጇ\xa7\xc7O\xc6\xc7OO\xc5|4<y\xf6\xf4\xf4x\x8c\x93\xee{\xbeA\x90%\a\xc3g\x8f\x9fM\x1e?\xa6\x82}\x18\x0f\x9f>y<\x1a\x8f\x9eRA\x9cJNƧ\xa7\x8f\x9b\xe5pjy|r|\"*\x1cNF'\xa3\xc9\xe93Q\fg\x9e'\x93\xc9\xe9\x84\n\xb6\t\x06\r\x0e\xb7p\x88\x91\xa3\x99\xb2\xe6h\n\x92G\xe6\xbf|\x0f\x8fP\x10\x03\xcdv\x14\xc2#\b\xa1\x0fs\x9a\xabۆ\x93\xd6C\xd6\x19\xfa\vl\xc4\xe3\xa7ώG\xc7'p\x0e[x$\xfe\x7f\xca'\xf1\x13\xec\xefgH\u0093ᓧOF\xc7O\\\xf8\xe5\xb2\u0089\x7f4z\xfa\xf4\xf41\x9c\xd7\xf3\xf56 +Ǳ\x0fS\x85Vt\x05\xaf\xaf\xad\x0eZ\x83\xbbN\xf5\xb8R\x9e\x9c\xd2\xe1G\xc1W\f\xb8\x84\x81s\xb1Zx\x04\x05L\xf9\xda@?\xac*\x02q\x82s\xe2À/!\x9c\f,Y\xca}\xe1=\xf2*(\xeb\xf3\x11ặ\x99s\xffK\x9e\x1d\x18e~\x91\x1e{\xe6r\x82\x83v\xd6&~\xe5\x85'\x7f\xecwQ\xabe\x89\x97QE\x03R\x11]6\xa0f\xbd_\xa6\xe9GT\xad\x97\xbeK\xedaU\x15\xc2+\xc6p\xdfP\xd0\b\xf0\xd3&M\xb5\xba\xb9I\x89\xbcr\xbe\xac\xaa\"\x99o*\xc3=\xe7\x83\x1b\xc3O\xaf\xf7\xe0\xf8\xe95\xdf\xf1\xd3\xc5R\xb1\xdbO\xf3(L\xdb\xf1\xbe̳\xb2\n\xb3\xca\xc6\\\xb2\xca$M6o/\xa6\x9f^w\xe1j%q/\xfeo\xdd\x1e\x8e[\xe5\xe3\xd2\xeds\x86\x8b\x99\xad\xf4\x05\xf4\xbb\xba\x80/\f۸\xb0\xddO\xe1O\xaf?\x1f\x8d\x1d]\xdcBw\a\xc7Ͷ(\xc3\xd20+\x87\xef6a\x9a,v\xbaTJ\xcb%w)\xe5D¹\x86\x10\xce5Q\x85\xa9\xfa\x80\xa9r\x8f\x15e-\x17\xd9\x06\x8a\x9a\x9305\x12`\xda\x04\xae\x05\x0f\xa6FB\xf7\xc0%\x1d\xc8\xe2Wnc?\xbb\x85W\xec\xea\x9b\xed\xda\xeby\xe7\xd3\xff\xf8\xe3\xb7\xdfʾ\x8f\x96\xda\xf8xX0\x8a> \xca\xf5\x01!0\xff\x8f\xff\xee\xf7\x02\xe8]\xf5\xfc\x83j\xee\xd4\x1a\x02\xc61<k\x17\xf06\x7fL\xc4{\x91\\\n1\xeb\x18V\xa2\x92\x96\x91%\xbd@\x0f\x95\xden2\x9c\xb2\x86\x7f\xa4\xa7-7\x10\x93\x9f\xad?\\\x85k\xcfų\x9fjqD\x123\x89C?\xef\xb6<\xb2\xdd\"g5\x1d\xa66\xc7\x0f\xea\xc2\xc6Y\x11\x8f\xca\x043'\xf4+\xe6\x18N\xb5\xc3*\xc6n1\xa5\x81k\x84\b\x1b\x89\x81\x12\b\xcd\x0fIY\xf9\xe6X<\x87h\x18Ʊ d\n\x91\xd3\xe3\x13I3Q\xfdD\xf2\x17\xe1:<\x1a\xe2\xf5̿\x84\xa94\x05\a\x10\xdd`\xfa\x1cε̩\xdc\xc0p\xca,b\x01\n\xcdQ\xb16xiaن\x15++/\xba\xf1\xf5R\xc0\xeb\xd2\xfd\xb1\xa3\x1b\x1cW\x80\x03\xceh\x84\xe2\xc9\xdc\xd7ȚAt#ԟ\xd9:\x9e\xd34\xa9\xd7{\xff\xe8F\xa7\xc4A\x87\xf2\x00/\x18\xf7\xfe\xf6\xcf\x1e|,%\x1f\x0eZ\xd6\xe0ES]G\x04\xb0.\x92\xbcH\xaa]C[\x10\xack\x91A\x19B\x1cԽ\x98\xf6\x95\x06\x81\xb7.\t\bW\xc9*\x03Q\x93\xb8v̟a\n\xefh\xa0>\x1f\xb6\x90\xbem!\xbbe\xaaSl\xde;\xa3\x19ͅ\xa9\xc9\xd8\xee~_;\xb8\xdb\xe8p\td\xf5y\xccRV\xf16_`\xf1Kg_\xc8\xc2-\x1d\xcdK\xca>\xe9\xc4\xf0i;\xb4I}݅\x8a\xa8\xed\x01\xfd\xa4sgoW\xd9m\x81i\x83A\xdd\x1d\x16\x87U\xf8S\x1e3#\x0e\xa4\xe5\xa1\xfc\x86\xa0\u07bc\x99R\xb0\xc9C\x86\xff\"I+\xf61{\xa9\x1f\xd1Ո\x95\x82\xf9\n\xcfa[\xaa\xbc\xa8^\xe6\xabuX\x84\xb8\xa1\x8b\xd4Oó\xd1v7\xf3\xa1\x06\xe4\vɰ\x8cX\x867\xea\xddS\x9enVP\xb95\x0e/\xc4\x03\xc9p(\x99\x17\xc0\x1c\x13\xe6*\xe1\xb0Ơ\x87\x1a\xdd\xff\x93\xf7\x14x\xfc\xb1M\xd1\x1cVy\xf6\x8aϜ\xa6<\xdf\x16!\x06ɀYcdqN\x88\xfc\xba\x90\xb1=#/\xb6\x1fD݂\x14QB\xfd\x18\xfewmZi\x19\xc5\xe6Q\xb0F\xf3\x97q\xac\x11\xac2\x04j3n+6'w\xba\xd4\xe9\x1emujX\\\x95\xe8e#\xd6\r\x92\xf3g\xad^|\x92\xc9b\xe8\xf3U\rb\xb9\x8bK]\xdev\f.\x96}y\xccDgpܺ\xef\xa3,*\xfb\x92_:\xd46\xf4\xd2\x1c\x19Ǯ\x9e1\x94M\xb3\x9b`\xa6\x84G\xad{E\xde\x1b\xedގmg\x17\xeb\xddޛ7\xa8t\xfa\xc0\xebJ\xb0\x11x;2\xd1nvԶwy)\x83\xdf\x1d%\xc0\xfaLy\x14@b\xae$e\xddp\xcee\x02\xa6\x8a\a\xdd\x03\x04\x03=(c\x90\x1d\x1bU]\xc0}+\xfcC`&\xacAr\xd1\ro\xe19\xac\xce\xe0\xad\x1e{X\x15#XU\xe6\xe2\xed\xa5\xe1\xb1J\xa9\xeav\n\xf6\xeb\x19\xd0\xf6\xe1\f\xacP\xc6\xc8\x10\xd1\xefT\b\xef\xabj\x11SI̒\x00\xde\xfa\xae\xc1r\a+\x16\xcbj\xb5\x9b\xe4Y\xab\xf1LA\x04\x8e\xf2-V4\x05\xd5\xea\xec\x96hv\xbb\x00\x928\x80*Y\xb1V\"4\v^]ܮ\x1c{\xa1\xbae,+\x8d\xabs\x01\xb0ڽV],\xec\xe1\xa5\xf5\n\xb7\xb1,\x8b\xf1\xb2\x10\vK\x063\xb3\x86oB\x19\xdbVHB\x12\xc3\f\x92\xd8HC\xc2aF\xf4\x9b\xe9HJ\xf3\xa6\x1e%\x9b\x1b\x9fλw\xbcE\xf5\xbd=]\x1f\x8b*\xd4B\x91\x83\x1a\x9b\"\xb1\xb2\xe09\xf5\xc56N\x85u\xecV\x8b\x8d\xf2\xe8\x15m\x11\xccQMq\xec\xde\x1c\xb3\xa4@\xcb\x14\x17Ar\xb9{\xa5\u0093ɳ\x10ᅆ\x8d\x8f;\xaeXv\x91N\xee\xe4\x8ats\x8a\xbcCG\x18\x92A\xba\x84k\\)\x9b\x9a$\xd675\xed\xea\xf6\x93\x1d\x1f\x93D\x15uX\x01F[\xb4\xb8}\x05\xd4\xd0m\xd6^\x9bԅ*mlV¢j\xe2W\xf7%\xf3\xe8z\x18R\xf8wx\x01I\xacX\x81\x17\x0f<c\xaf\xaa\x83\xd6#\x84\xff'\x84o\x91\x17\xdf \x91\x8a8\nS\xd0\xd8Z\x1b{n\xb5\xbe&N\xf3\xd6\xd0u:\xbb\x84\xa8\x85\xc5\xda\xc5>\x83\xc0zb5\x83\x1aԝE\xcchTdo\xc8\x1fVIt\xadX\xe6\xd7݅\xe9\x01\x8c\x84\";kzэ\x9bs\xbd\xd6\x15\x06\xdaΞx8\x9bu\xf7\x05\xe9A\x98\x81D\a\x03\\ia\xe4\x82#\x887<`N\x00\xc8[\x1c]^\xe5\xf3)J2P71\x19\x06\xaf\x8cO\xd3&\xe3E\xa9\x8b\xc1 \xbbԙg\xb2\xe0\x83\xd5\x1e~\xe5\xcf\xc6\xd5l\n\x98\xa3\xee\xfb\xb8!\\\xb2\xf7X\x16w\xf6\x9d\v\xd3\xc8\x04h\xf6\x93N\xf8\a\xd7\xf0\xc9\xd7^\xb3\xb3\x1e\x0e\x06\xd4_\x14\x88I\xed\xf6ĲRS!o\x0e\x15\x125\xb1\xb1X\xdc\x14\xa7\xfe\x94kU\xfa\bTתd\xd5\xd5H\rʃXVP.\xfe\xe3[\xf4\xe0%\a\xcfA\xa7\b\xb8%\xff\xe3r\x88q\xaa\xb4Dj\xec\x14F5\xf9\xc29\xaee9\xdd\xef\xd7<2v\x04\xe1\x0eO\n\xa5\xe8\x9e\xdb:jZ\x8f8\xca\x11\xbc\xb0\x86\x9d\x1c\xdf\x1f\xec\x11\xb9w\x81d\xa9x\xcf\xf56\x03\xad\x02,qj_@Խed}\xdd\xe853[\xa4\xcbI\xc1\x96]Z\xda'\xb1#\xfb\x1b1\xc5\"\x00\rpu-̞.\xf8\xbe\xa2s\xb2h\x92lHݙ\x13\xacE\x10%\xb0di=^u\xa1\fL\x891\x16\xbb\x1f\xfc6vtq\xa2^\x7f8[\xa35\xa4\xad\x19f\v\xf6EY\xc1\x91J\xf6\xaa\x98\x88\xb7M%!\x9a\x9e{=\xdc\xe1K\xe8\xf6\xcd\ve\x8b\xbb\x1as~\xa8\xd5\xf0O\xe0+M\xccn\xbf\xe2\xe1<\xa9\xa1a\xf4\xa6\xac\xd8ڼ\xfa\xc7\xc7\x1av\x04\xde\xe4\xf9:\xac\xb0gn=?\x80j\f\xb3\xba\xe4\xbb\r\x93v31ET\x9aN\x17h\xa8ooa\x00\xd5xX-\x99\x11BB\x82\xbc\x98a\xae\x98\xa4\xaa\xf1p\x91nJ\\\xb4U\xe3\xa1\xda\xf9H\r \xcb\x13)\xd5x\x98\xb1me\xefB\xf5\x01G\x94\x12Bχ\x01Ң\xb9\xe2\x12\xdc\v\x98\x9c\x98\xeb\xc0\xa4\xfc6ɒ\x8aq\x00c\xb1\x11\xa5,,~MV,\xdfT\x9e\xc2_\xf1\x04ݶae\xc1\fJV5\n\"\xf3\x85\x82h8N+ \xeaӛ0\xad\xa7,\xcb\xf1\xd6\x059>\xb33)\x1a\xbfY\xb5\xdf\x1e\x9e\xc9d\\-\x1d\xd5\b\xe4ɉC\x1a(\xb6=\x0e\x99\xef\xb3\x05\xb2p\xd7*\x1e\xb4\f\x10]]\xa7*\xa3\x90B;\xdf}\x1f_h\x820L\xe2˚\xcd\\\nFp\x0eՈD\xa1\x16\n\x98\xd6H\x886[^\x1al\x04I\xbc\xf2\n\xc7\xef@J.\xf4k)\xb5(\xf0\x88+\xd5\xd87\xd17w\xe6r\x04\xd8\xdc^囒\xfd\x92'Y\xe5EyV\x85\t\x99\xef\x8c\xe3\xb6\xf2\xe6\nf\xa0r\x87\xf9mƊ\xd7\x7f\xf9W\x11\x02\x10'o\x95Y\x8bxys5\xe4\xaf\b\xbd\xfe˿R\x05\xa6ɏ\x82\xf8\xa2p6\xe0<c\xab)i|3\xdf\\\x9d\x9c\x8c\x9e\x1e\x93\xcf\xe3\x17_\x80w\x9bdq~;,\xa3\"Oӿ\"\x19F\xcaߌ\xe1\xc3\x1b\x11\x1f\x0f\x85\x1f\x8c\x8ae\x88\x91v}\xdc\xe9\xe1\x03;\xbd\xf2\xe6\xaa\xe7\xf3\xd3\f\xaf'c#\xe2f=\x9c\x97y\xba\xa9X\x9d[\xe5\xeb^\x00#\xf5\x9d\xb2EE\tg\xc6\xfa)\xaaV\xbc\x95\x17\xa3ˋ\xd1%\xee\xa7_G\x05c\xd9\xcb_\x7f\xf4\xccqk\xb5s\x06\x0f\xbd\xa8\xc2;\x90\xc8\xe1j5ԗ\xb6\xc86\xb1\xdfv\x86\x9ci\xa0әA\xbc\x1fn\xe96\xf5:\xbcb\x7f=\xb3\xb2v*\xebo\xad\"\xab#\x89҄eU\v\x1a\x9e\xf97\x9bJ)\x00\x1ct\x15VE\xb2U\xd7\xfbja\xb4\x186L2\x11\f\xc5\xde\xd2^H\x8a\x02U\xfb\xa5\xad\x9f\v\x16U\x86,_\xb1\xea+\xf4\x16K\xb2\xab\x97D\xe6+\xed\xa5+\x85X5\x10\x06\x84b\x88}\r\x03\r\x0f\xcfǈ\x9aA\xddb\t]\xe5k\a0\xc6\xebt\xde\x17\xcd\xf2\xdc\x19\x9f\x8dn\xbf~\xb3\xad\xe8\xde8\x7f\x14E\x1f\xa5\xb8(E\x11\xa7\x1c\xbay\x8bۄ:\x85\xffќ\xd5\xcd{\x9b\xbc\xfcs^\xe8\x1c.@\xacr\xe9\xfb\x12\xa6\x94\x92\xaf\x03\x01褜(|\x85\x81\xba<\xfai\xc7\xfc´!\x05\xf2\xe2̀\xf3f\x9aǗ\xdaFk5 \xaf\xc5%\x0f!\xded\x18\xac\x89\xb73\x00\xfc0\x16\xcf#n\x15MƊ'\x1a3\x02؎j^%Ⱦ\xedXK\xc0[\xce\xf1\xb6Vo\xdb1<\x87\xad\xb6+\x8dq$$\x88\x1f\xf1$cY\xd1\xf6L\a؎DE۱\xac\xc08oC\xd4H8̈~\xacf\x80\xd5h\xf5(\n\x05\x8c\b\xb4\xb4\xad\x15OM\xb3\x04\x89X\x92z۱\xeb\b\x96\x03w\xf3T\x05rh:[\xb6\x17\xe4q\xf8UwP\xef\x05\xa0-\x13\x03\xa0\xf0=\x8d3\xa2\x82\x11\x02눈\xa3\xd3\xe7H\xa3\x0fE\x05\xe2\xcbǵ\x15\x9c\xd7\xc4`\xa4\x1a\x81\xa1\x96\xae7\xf3\x84\xa7\x05`\xdc̅\x19\xa7\x8c#h^څi#\x9d\xdf\x1fQ'#\xf4\xe8\r\x9dV\xb8x`\x944XR\xcf|\x99\x81@\x14\x94x,\x04\xe6Eh[/RS\xdd.\x03f\x14=U\x82\x93\xefm\x1b\x97\x19xF\xc0\xa9\xe3\x99|h\x92R\xaetk箁\x95\ny;\xdf0:\xf2\xe2\xbc]z\xf1\xed\xa16\xdeZ|k\xc9\xc7\xe1E\x0eN\xbcWl\x86(\xf9rPRX\xaf\xfe\x1dN\b\x95T5\t4ۻVM\xc1\xae[\xeaojQo\xeb\xeb=\uf642@\xb8\\5q\xe1\xbeO#\xa9\xa4\xdaN\v4wi\xa49\xca\xeeN\x80u\xe1\x9f\x0f\x15\x1d\xe5]\x88A\xd3g\xa9\x93\xb1r\x9a\xba5m\xf6+\x96P\xc3yՆ\xf4[~\xe9\xf7\xae\x98y\xb1n\xf4brp\xb8'\xb8gA\xab\x9a\x9fpR\xbc\x03\x8b\xa2|\xbdsW\xe7n\xc6\x01\xea\xde\x15\xb8\xc5 \xa1k:y\xc5#\xb6RR \xb4c\xd3.Q8\xa0\x02\xe8\x11M=\xf9\x83\x86\a~i4\xe2'Q\xd9\xdbK\xc9O\xc4e%\xb8\xf1\xd6\x19\\\x8f_EPA\x10\xe3f\x14ıu\x8fr\x8b{\x1buȋS\xfb\xd49N\xcc+\x0fb\r\x00G\x10\xf3\x8b7\xdbFL=\\\x03\x1c\x82\x8a\xaf\x15\\\x98:|A,I\xe6k\xc0Z\x90u#\x0f_\xf9\xcdZV\xb4\xfcI?\x98\t8\x8c42\x90\xbf\xf9\x9a\x96\xad\x9d\x9c\xb6\xc2M\x12\x96#X\x99\xfc\xf6\x03`E\x013X\xc1\x11\xaf\xe8\x11a\xac\xd7u\xac\xe0\x17FƧ>\xe5\x88\xc0\xb1\xb5?\xa3\x048\xd6\x00N\x1d\xf9O\xb4|q\x95N5\x03f\x1a\xab\xeb\xd4#*\xe0\x1b4\xd5l\x98\xe9\xed\xac\x93\xcdB\xd0\x17\x95\xc2\xf0\xd4@0A\x045Z\xd1\xe1<\xf3\x90\x1e-\x9b\xbd\xa9\x8d7Zzէ\xa5\aH\x84\x7fH\xa5\r\x85ب\x99G\x02\xf0z\xc1\xd0\x0e\xf21pI\xc5\x01\x84\x89\xc0\x15Jf\xe8\x06\xd8\xd8'7\xf4E\x97Z\x90\x8b\xc9;\xac\xf6\xb4a\xb1\x81\x99\t\xe9i\x1b9\xf1\x93\"[$0\xd3q\xf0\xa5!A\xf1_\xe3K\xff\xd0(1\x89\xb7\xf1\xb6\x9dq`\xec\xc5\xf3\xfd\xdbv!\xdcR\xf0\xafpt\xb9>x1?\x90FE\xb2lpf\\c\xf8+\xc5#{\x7fTo\xf1x\x04_\xbf\x0e\xa2j\xaf\xd1xU-`\x1f\xcc\x00ooqhk\x11\x986\xfc\xd4\xd7\xd9qoi\x8f\xad:\xef\xad\x16{$\xe1Ś\xdd(ˈ\xafK\xdf \xa3\xa3G\xa5\xffP|<\x9c'%7p\xf1\x9e\xc2\xebu\x14\xd1I1\xb1\xee\xff\x8b\xb7\x97\xde\x06\xff9D\np\xfcȩ4ͯ\x9a.\xd2-\xbb\t!9<\x06pcK\xb1&S\x7f\x9a_\xa16\xef\xdeR4Q\xe3\x04\xc0\xd1\vp\xab!\x9f`\x7f!\xd0s\f\x1e\xbf7\xb1\xceoUW\xa6\xf9\x15\xae:Q}\xf3\xbb\xd8:\xbf2}\xb3\x99\xe6Wj\xe9\xech5XU\xf1\x1d\f2\xbakCw\xd8\xd2\xd0\xc4l.\x14\xad\x06\x06Ν\xff\xc144\x96Ԧ\x88\xb6M\xfe\x16\x11~\x00\x12х\xe1\a\xcaˣ\x17c\xb1S\x87%\xbe\xed~\x998\xe7J\n\x19\xf4\xd65\xf5\x92^ݐ)\xf2ր\xbe1Ӕj\xad\xbd#\xaeȉ\xc8\xe8s\x9d\x1a\xe0\xed\x10oA\xe4\xb7^\xe2k(\x84\x13\x1fy\xe2\xc1sx{\x06\xbe\xf6\x1c\a\xcc\xe0\xd9\x19\\\xa3\xef\xc3\x19\\\x0f\x06~\x13\x17\xc6N\xee~\xc6\\V\xc0ѓǟQ\xc3\x18kxN+\x9c\xeb~\x7f\x7f\x15\xdd\r\xd2\\\x15\xb0\x12rC<\xe3%.\x12\x1c \x1b\xe9uhA\xbe\x85\x99@,]\x1ey!\xae\x0f\xe1\x05ܜ\xc1\xdb\xc1\xc0((%\x84\x97\x13\x81ڛ\xfe\x89\xf5\t\b\xc2\x1d\xbca\xcb\x02\x11e\xe8\x10G\xad\x85,\xae\v\x02ǹ'\xd4\xfdX)\x9a\x85\x01n\xc4\x05\xc4\x15͐^\v8҇\x99\xe7Ke\xc5c\x95;\xa5\x11\xce\xc1ctό\r\xc6\x13}\xadL\x01\xcc1K\xcf\xc1a\x81\x8b䳖\x00\xa3m\x01\xbfQ\x14\x16\xa4\xe3c\\)\xe1\x98ę\x12\xceE\xbb<\xf2\xa0\xe0\x11ڡ\x11\xbe\xf3\xce{M5\x15Q!\xd4Z\xa8(\x9d!A\x0f\xd8?v\xcey\xeb\xc6=v-\xf6}\x1d~\xc3\xdc\xd8ub\xcc\x1a\x18\a\x1a\xca\x17\n\xe5\xe0P\x9c\xf54\x18\x00ۮ\xf3\x8cՇm\x87N\xcf\xeb\xfcv\xdd2?\xafu\xc1^緿\x90F\x14\xd5\xe0a\xcb\xed\xdc\x011\x86\xa3\x9a\x98{L\xec\xf3\x7f\xd0\xcc>\xf7\xbb\xe6cd\xc7\xfd'\xc3\x03\xedKz\xd3<\xbf\xc5\x10\xf4\xf1v\xa6\x83\xaai_T\x18\xe6H\xe7\xd2®\xc0e\x8br\xd5)\xc5\xe6^\xdd*\v\xeb\x9aTI\x89I\x91\xb6\x1a\xeb\x92q\\\xdf\xe9\xb0\aJ{\v\x87\xe8\xcfǙڴe\xafT\x7fVݟR\aJn\xb4]\x90j\x8e^\xa9\x17\a\xcaJ3ؒO\xc1Ԉ\xfa\xc1\xf6\xef9\xf2\"\xc6{,涳8X\xb7\xf1m\x94\xe7\xd1\xed\x0f\xf2d\xdfR@e\xfe\x8dN\xe9[\xb9?\xe3+\x9a\xad\xefs\x9b\x1c\xfc\x8b\xb1\x05\xbdl\xb9r\xc9֥\xa7\xceI\xd9\xda\xd1e\xc2Polo\xb9\xaeQ\fL\x1cs*!\xad\x8d9Iÿ\xb0>\xd6Ķ\xa8\r9\xfd\xf9*\xcc\xe2\xcf{\xbc\xa2\xad\xca\xc5Md\xfbŠ\xe6Kj[\xb5\xb9\xdf&]\x0f\x1d=佳\fKo\x9b\xd0\xde*\xc1\xf0\xd1Z\x9f%V\xa7%n\x8d|\xc1\xa5eX\xc9\x10\xe4B\xe2Er\xf8w=\xff\x91\xfd\xa2{\xffrB\f'\xd7j\xaa\xcc\xd4*-\x9c\xd6\xd7\x0f\xcc\xe5\xd3\xde\x19\x88P\x91S\x8d1\x0fm\x03\xf9F\xf7!\xab[\x01\xaaS\xae;\x1clu_\x83-\x85\x03\x17FZ\xaf\xe4\x9e\x0f\x04\x89k\x19O-g\xc7A\xf3\b\x1e\x17\x8e\x92,\x9b\x91|\xa8\x99E\x9e\xd3\x11\xafW\x0f\x95|\x8d\x95L\xe86\xb01|d\v\x8e`\"\x06\xea\xbd\xfb\x853\xf3\x13\xf6\x0eV\xde\xd29\x01\xe4\x1b\xbc\xeb\xf4Q]\xd5\x02|\xec\x1b\xc8)\xd4a\\_$\x95\xee2d\f\x13\xfd\n\xcfU_˞\x97\x00\x030e\x80<_(\xabK\x1al\t\x90\xd4\xf7)\b\x9d\xd1v\xb7<Xݬ\x97\xb0\xfa\x19\xb9 (\xf2\x85fw\x1b\x04\x85,\b\x8c\u07b8&\xcb?D<\xa87?\xa1t\xd0!\xd5\xffkDD3\xda|\x94\xb4\xf0c\x1e\xba#\xad\xa3\x81A+\x16\xf3ХMδ#=\x8e\x1f_\x1d\xfaHI\xd3p\xba\x84\xee \xa9\xab\xa5\xe4\x13+\xa6\xce\xf5\xa8\xb5\xd6hb\xf8FZ\xfb\xf6\xafi9\xa8'\xa7f4\xbf}\xd4\x12\xb9e\xe1\xe8Z\x1d\xb7-\xcf\xdd+\xd2w\x9b0\xab\x92\x94\x99\x98\x0f\xf4a\xba\xae\xef\xe5Z\xe7\x1d\xef\xd4A\x84y\v\xaaZ\x16\xac\\\xe6il\xdaBպ\t\x8dw\xef|\r\xec⚛\xcc\xe8(\xa0A\xec5\x1c\xc1\xbb\x8fp\x10\xe2>\xea\xf4\xb4(̠\xbf\xad\x83\xa1\xff\x14\xfet\xe6Z|\xd7\a\x125\x8d\x18\xab\xa8\xe1\x9aYg\x7fn\xcf \x11\xf8\xa1Ӧ%\x1eP\x8d\xb5\x8b=\xc32\xa7\xe79\xea\x98\v\xffE\x1c\x8b\xa4\x14\x94\x9dC\xc7\xe6\xff=\a^\xcb\xf8\xb8\xa7\xd3\a\xc7\xf6\x9ey\xe479n\x1bm\xdd\xfb?\xe7c\x18\x89\xe16\x80/\xe1\xf0\x10\x97\xe8k\xe9\xfb\xee\xfd^sPӻ@\xfa\xb8ŉJ\xfal\xaa\x19\xc1\x86i\x9e\xc45\x86 i\x8b\xadz*\xf5\xa3\a\xc3\x05\xf7<\x1d\x83R!\xe4\x85\xdaߪG]\x00\xc8\x1d\xb5\xbf\xbd\xd86}\x84\xff\xd9E\xfc\xae\xf2ِ\xa8{ʧ\x1a4{\xa6\x83n\x01u\x1cښ\x12\xd8\xd6\xfdo>R\x17\xbe\xb9\xcbz\xe0^\x15;{\xf6\xd0j\x0f\xefն~h\x9d\xefۻ\xb4~\x05\xddp\xb1W\x80*\xbfٛ}ӕ[\x00\xd6\x06o\x99rf\xe6\xab\xeeP)\xf7\x1fI\x9d\xee\xb0\x12\xbf=o\x99t}\xb0\xc8\xfb\xc4^\x92\x06\xde\xcf\xe2(\xa9j8\\~\xecNwɍΥ\x86\xe8\xdc\\\xbd\t\x8b\xe8\xfb,cū0N6\xa5\xe3m\xf5\xa4\xce\xed\xc0\xf1\xf3\xa6\x92P\x0e\x1c\xf9\xa6:\x00\xc7kܯ|\x99]\xa5́\xa2T\x99\x1d\x18\xbe\xc9\xe2\xb6\xf2,\x8b;Kc7y\xeb\"\x7fk\xc6wQ`\x94\xafG\xf2\xd2\xf2Jv\xb52\x1fO\a\x99&Μ{?\xf6\f?#\xad&\x8f\xae\xfd\x94~\x00\x15\xcb\xf0Ak\xbfq\x14Lv+\x81O8#\xad\xf3\xa4\xfe\xd0\xed\x95H\xa2Z\x9f\xc7\x01,D\x80s\"\x16\x9d-\xf1\xd8ug\xa6\xedZcu\x9aqvb\xb6H0䁊\x9cį\xacc\x95\x17\xc9e3\xce\x04'\x92s\xe0\x02\xfa\x8b\xadQ\x12\xe1\x03\xe8/v\x8dT\xb8l\xba\a`\xfd\x02\x9fT\x1ezU\xaa\v\xce\x1a\xf5\x1b[\x90f\xe0(\a\xe2\x062\xa9\x83e\x9fr@8\xafS\xf8\x13\xd7=\x1f\xa6ڣ\xee\x1f\xec\xb7T\xa4\xa0\xfdU=\x8a\"S\xfe\x16\x80\xe0.O\xaf\x8a\x8d\xe9\x99f\x82\xff \xceM5\x80\xff\xc1v\xa6\x8fݐ\x02\x86\b\xa1\x02\xf4\xed\xe4Tay\xba\x00w\xf7yQ-n\xb6\x8e\xf9\x10\xf1\x1a\x1a\x88*\xdaݫ\"uS~wpE5\xfb\xee\xb1\xd0\xe0e\xd5\x04\xa4P\x1dVu\xcb\xf5\x807\xf7\xb8\x1e\xf0?\xd8ΈX\xc4\x03\xff\xbc\xb1\x82\xfe\xf8\x9d\x1dOt\x8bQc\x83y\xad\"\xf5\xbd\xca\xc8\v\x1e?\xe9\r\x9d:5\xa4\xce\x1f^\xd7Dv\U000a55bd\xbb\xf3D\x94\x95\xf5Ԩ\xf6v\x89\x95ަ\xec\xffڜ$Į\xa2\xad\xc4\xdf\x1c%Ɲ%~\x90^\n\xa4\xe1Ͳ<Q(\x8e\x1fz\xfe~</\xd3\x1c\xc3C;\xb1\xb5Wڇ\u07bf\xf7\xba\x90\xbf\xae\xd8\xfa+\xb6\xc8\vf\xa1\x96Ga\xc2\xf6c(\xc9\x00\xd6*\x89L\xa7\xeb\xb0Z\xa2\xa6\x855}\xf6\x82^\x00k\xb4\xad^\xba_\x1b^ӑ*͍\x7f\xe9\x05\xe0i\xe8\x92K\x9f\xcecz\xdf\x11\x8e\x91\xe5\x1aL%\xa5\xbe\xddװ/\x17h4\xf9Ǵ\xeb;G\xbb\b\xc7_\x04\x8e{\xb6\xebe\xc8̈́?\xaf\x99\\=ԋ\a\xa7\x90)\xe3\xf8\t\x9c\xeb\x98,Q\x99JJ\xf1\xa1T\x1d\xee;V\xac\x92Jʇ\xf0Z\x1b\a\x16z<\f\v\\\x84\xfe\x8a\x1b\x83\xac*\x1b\xc4\x1e\xd4LC\xec\x0fm\xe8\xf1a\r\x1d\xb54\xd4ӗ.\n֗-\xde\xd3\xcc\v\x89\xde\xe6\xcf\xe4\x12.\x87Q\x9eEa\xa5\xdas\xa11\xfdҿ#ol\xae\xe0m\xa5\\\v\x89\xf5y\xb8#+\xfdd]m#\xae\x04\x16=\xac\xacL\xab[1\xc6\xe9\xc9l\xda\xc3\x19\xd8p_|\xb1\x1f\xa6\x0f\x13\xe7.\xcb\xc9\x1f{m\xf7n\x13\xc60\xdb[\x89R%=\x1c\xfd#K\xcbԟ\xa8\xf9xx\fQ\x9e\x00*L\x18\x05\xb0N\xeaX%\xc8\x15\xac\xbc&\x9dj\xe8Ϡ\xf7\xbf\xe3\xbd\x12\x0fU\x16\f\xa0\x12\xafoO\xf8{5}Tb<{,\xb2ǎ\xec5\xef~\xf9Q\xdb\xd4tڵ\xd4\x04ԍ\xa1\x0f\xad\x9d\xf6B\x8fbV\xe9\x8d\xd40\xd5\xe8\u05c9\x86\xbf\xdf?\xb3\x9b\xf9\x92\xb7c\xc4I\xa5v\xea\xed\x1bqEF\r4\xda\xcd\xd9bA\vn\x18\xb0\xadLP\xeeȼ\xd9\xe4\xaal5\x96\x9c\x86\x03\"܈b\xe1l\x9e\xc5\r=C\xb5\xf6\xf5'\xa3\xde~;֔\"lV\xda\xd2\v\x0e\x01\xeb\x13-m\xf2\xd5'\x9a\x9a\xb9\xa9A\\ZS\xf7\xc1\x9e\x13\x0fу\xadjG\x9b\xf2%sŦ9\x84\x19?\xb3T\xa0\xe4g\xb1\xc6A6\xb6F\xe7\xc4\x1c\x9e4\x06\x1d\x8b\a\xf7\x8a\xe0wc\xdc(\x1b:\xafd\xa2>'\xfaBA\xa6*\x91\x12{\xe8\x10\x1e\x81\xb7\x9ep\x11 \xb9\xf7\x03\x958\x16\x89(\x02\x97\xae+8\x12[\x17G\xbf\nˤ\xb4\x16L\x8d-2?f?LQ\xcaA2v\xaf\xb6\x12\x93\xd5d\xdf_'\xf4{'~#\xcb\u05f8\xe3\x14\xc7\x01\xe2\xff=\xbd\xec\x98\xd6V\x80\x90;\x82܍\x02\xf5\xff\x84\x03\xf4\xc5\xdcv$\x96r\xbb\x91\\\xc8\xd9<\xf8\x8a\xbdOp\xe9\x18\x12\x99軰\xf3\xf7tp\xe2\xea\xc2\xf5vX.\x93\x85fMXoź\"\xa9\u05f6\x00\xeb]\x03n\xa7\xe0\xb4;\x1c\x87\xd3)Ʒ\xf6&\x8cA\xb96\xe5\xfd\xa3i\xbc\xeb\n\x98\x90j\xcb\xdf.A=\xb9\x8b\xa0J\x01\xb1\x8cj\r\xa1U\x029\xd2Dn\xe4\xdc\x13\x1c\x1f  nV7\x18˙U\xef0\xb4\x06}\x9dW'\x9e\x9b\xed\xc7Hl\xadx\x0f/\xb4\x93\x96\xc8\xc1 \xf9\xaf(\xf9\xf7\x92*\xe7&[\x8aF\xa7T\xac`\x06\x19>\xff\xa7\v\x88\x92\x0e\x97h\x9c\xb4p\x10\xfe\x05\xb2\x8f\x12\x0f\xac\x10\xee\"\x1dB\x1b\xdeE6\xe0\xb2K8V\a6\xed\xbf\x96\x80l\xb28e]k\x8c\x86X\x98\x97\x803\xeb=\x14c\x17\xa0\xcdy*\x89\xc7o\xaa\x93\xb2K>\xf5\xe3\xfc\x15\xef\x8ct\x9a\xfdq\xb6\x93\"\xba\x0e\xa0r\xd9\xf5g\xa6a\x7f\xed\x1a\xba|9\x9a\xc0\x11duҚ\xc7\x00\x10͆Gr}i\xae\xa2\xd0#a\x04}\xa8(\n\x83\xaf\x17\x1f\xdb\xc5\xc7\xce\xe2;U|\xd7\x15\xb5\xbfm\xd1\xd2Ճ$ՎWKC\xbe\x82\x9d\xf3\x06\x85|\xc5:\xe7\xf4\x85\x17\x13\xfe5\xe1_\xc7\xfc\xeb\xf8r\xaf.1\xe4o\x1b\x80\x8a.\xa4\x19\x89^\x1e<\xec0\xf4\xd6\xdd\x06\xea8\x80\xdd\xddJL\xee\\\xc7\xe4\xceu\x1c߹\x8ec\xac\xa3\xdbԗ\xe6k\xe6\xf1\xb5\xbbյ\xdezl\xac\x8c\x8f(E[@w\xe1\xe5Wu\xbfN\x16\vV\xb0,be\xbb)\xf1\xadk\xe8\xf3Y\x81f\x01{\xc7?6\xf7\x141\xcc`\xc5\aX[\xbb\x1cjV\v=\xbc»\xaa3\xf0b\x1cR\xb1\x1b\rm@̺\x13\xe8\xc3\xf8\x12/\x90\x1c\x99\xdbw\x81/6l\x94\xab.f\xfd\x98gy\x95g\xccڌu\xec\xc1b\xfe\x840=־\x82\xd9A\x9c\xaf\x15\xdc\xdbve\xdb\xc6$7_\xa4\xf6\v,\xa6\xe8'1\xfcA\xe39\x9dn?\xc7˧\x8fk\xac\x8aY+QRwSn\\o\x0e9 \xc6@\xd1B\xb3\xcf\xf5\xe2F\x0e2\xcbx\"ټ\xcd]\xc2\vxf\x1d\x86b+\xe1\x11\x1cÑ\xf6\xccri\x9c\x8d\n\x8aKDl\xa5\xcb&\x94fe\x1f\\ƃ\x96\xcd\xc5Lg:\x92#\x99\xac<\xd9\xdeb?\xf6a\xec\x8b\x19\xcd\xc8\xe7^o\t\fD>\x8d\xda\xc7\xf2!x\"\xfd\x11\xfd\xa9\xcf\xca\xed\x9dr\x89\xc6\xc1Q \x81\xf9\xe7\xfd\xb7\xc5R\xb6;\xcfr>\xa3}\xb5mluj/\xf4\xb8\bS\x8bd\x15Ŷs1[\x04\x106\xbb\xd5X\xf3\xeb\x81P\xf5\xb5C!\x135'\xbdP\xa5\xe9G\v\xe87\xb2X\x94\xac:\xd3qr%X\xc0\xa3\xfa!\xfb\xd07!\xc6\x06\x04>d\x1f:\x17vDZ\xbb\xbf\b\v\xbb<>(\xff3z|\x8c5c\x7f\x00?\x18Я\x84\xef}\xa3Шv\xcb7\x8a\xf7\xfe\xbdw\xb8\xdb\xc8\xc8\xf8\x1a\x1f\xe4E2\xb2\xdcHF\xe4Gb\xa5\xee(\x95<2\xb1\xc0\f\x03\x86¹˕\t\x1a.\x05\x1fx\xd4\x11U\xc1\x98*@T;\x8ej\xb7\x0fծ\x05\xd5n\xec\xf3\xe5\xd7\xe7\xf4r\x19I\xc5C.\xe6\x8b\xed\xc8\xe1벣\xacݨ\xdb\xe1Eb\x1ck\x8e3c\xb7\xe7\xcc\xf8\x8e\xae3\xa3\xbb\xfaΌ,\xe7\x190d\xe6\x10\xa7\x9a\xd1\xe7\xf4\xaa\x195\xdcj\xb6\xe3F\xd2ND\xd0ݍ\xffN.7\xcdqlB\x05\xf0\x03\x1e\x03\xfd\xd0sy\xe7\xa0ֹ\xafw\xce\xd8\xf0\x92ގ\x1d\xde\x12\x88\xde\xf0\x96\xe0\xf5\x8d\xeeW\xe1Ȩ\xf0\xc0\xba\xc6\x1f۸\xc3\xdbuO\xe7#U\x15\x89\xce\xee\x0e\xf5ݏ\x8f\xbb\x91Q\xe1\x81u\x8d?\xb6q\x87\xb7\xeb\xef\xe2[\xe5\xae\xfa\xffžU\xfb\xf4\x88\\\n\xf0\xd8\x00\x8d\b\xb3?X\xd0\xdc3\x00Ρ\xf7c\x0f\xa6\xa8\x7f\x0eꀿ\x8b\x13W\xa3j+ݵ~\x8b\x96y\x11\xbf\xce7E\xe4\xf4\x17\xa6\x8cβ\xbf\x86\x05vE\xb3lE\x19\x9de[]\x9d\x8bN/gN\xf3\xc7\xf99\x13\x8e\x8f\xf0t\x8e\x93\xf0*\xcf\xc2\xf4\x97zmi#\xb9\x80\x18\x1f$\x88\xe5c\x04]h\xf8&CC\xd6\\R\xb7?NLaN`\x06u\x99\x96\xa7\xa1\x03\xdaV\xc4d:\t\xe9W\xe7FB\xb5\xc3\xdaF\x04\xf6\xaeA=\xb6\xd0\xd6\xccr\xb7\x9a\xe7\xe9k\xbc{c5\xe7\xf1Iw\x99_w\xebF\x99^\x94\x14Q\xcaz\xdd%_\x12\x90W&\uf340\x94*\x8c<\xdf\xce'\xef\x99\xdc\xdf\xff\xf2\xbd\xe9J\xd6\xfbqDg=\x05\x9e\xfa|\xa9~\xd5i0\x82q0\x06\x0e58\f\xac\xe8v%\f\xb7I\xf9W\xfd\xf9Su\xfbC\xa5\rê*\xbc^%\x9f\xcb\xe8\x05\xce\x10]\xb2\x11\x04G{'\xac~\xcbct\xf5\x82\x91\xdfӟ\xb7j\xa3\xe5o:-\xbbOG\vg\xc6NPs\x18-\xaf7\xf38\xb9Ib&\x83y\xd0\xed\x14-4l\xb9\x99ۡ\x03Q\xb3\xae\xd0{I\x8f0g:\xd0t\xc5&4c\x06\xf9\x81\xaa\xc2\xdc\xe8\xe9\xc8\xf9>\x87^f,\x85;\t\xfd\xe4\xf6\x97~\x7f\x15\xc0\xdb\x00n\xf6\xef\xa2Tp\xbc\xd5\x19\f\x06oy,\xc0\xe6\xc3\x7f\xde\r\xee\x87T\x9c\xbd\x01\xbc\x85G\x10\xfb\xf8\xbcU\x1d\xce\xd0~\xf3P\xb4B\xbc[\xe9w?>\xf8A\xf7\xd6\x19\f\x12\x11\xc4\xf5\f(&*\x10{-*\xfa\x92\x8a\xe7u\x10c\x93\xf86\x12\x1cG\x14\x12\xd4%\x1bs\xb6\fo\x92\xbcx\xf3>\xcfW_\xb3\xb4\n=\xe3\x15\xf5\x06HrcDM\xb73\x8dw\x82z\xf8>P\xaf~ (Nn\xea'\x80n\x922\xc1\xb0\xbf\xd5\x0e\x03\x87/\x938fY\xfb\x03AK\x96\\-+#\xe96\x89\xab\xa5\x91\x92߰b\x91\xe6\xb7\x03B\xc9_2j\xab^!\xecMF\xa3\xd1z\xdb\xf3\xe9!;\xcf\x1f\xaeÂe\x15\xbe\x99o\xef\xf4\x98\xf649\xbd\xffU\x89\xe5\x82\xfe̸\x83)\xe2Q\xa5_)|Ø\x1d\x9fu\x80ʷ\x8e\xe9\xf5o\xaf\x8e7O\xb5\xf1\xe20\xe8\xaeD\x90\r\x11\xe2\x01\x1emA\xeb4\x81\x88\ro\x97\x8c\xa5\xd4\xe7\xb8v\x1b\xe0\xe5\x85*LRx\x04\xa7\xae\xb7Ndsm\x11J\xc3]\xbe\xa9\xde\xcc\xe9X\xf2\x97\xb0Zb|\xadk\xd7\xf3:\x98.\x96D\x01\xb0,\x96I|\xa5\x13@\x1a\x850k\xa0\xfc\x81\x85%\xbe\xf7\xbfʳ/\xd1\x04\x8f\xc6\x14\xc2H8|\xfd\x16\x92\xfe\xbe\x8eR\r<\r\x1f`M#͊&\x89\xa2\xbf\xa2\xd3\r\xfb\x9e\x18Z\x94\xdfp\xb7\xbc\xee\xf4\xba¶5*\x14\xe0\xe5\x9a\xfc\x96\xf9\xb3\xb3,\xabo\xc9s\x86\xe0\x93\xa8:1\a\x9a\x12\r\x8eI6\x95\xf4N\xa3\xde\x13\xa1̑\xb67\xaaI\xbe\xe4\xa8\xd7+Z\"\x00\x1e\xcaתec\x14\"\xce$\xaaG6$\xe3\x8fCZ,\x955\xf1\x1f\x8e6\xb6\xa3\x94\xcbp\t\xb0\x97\x01.\x91\xd1\xcf[)h\v\xed\xb8\xe6j\x97\x10\xd6\xfe7!\x0e\xfe\xd2!\x8a5cq\x117\xdf\x0f6\xf7\x03\x8e\rf\x02\xebp\x8d\x0fT\x89\xc20\x83\xb9\x91Z.Â\xc5\"\xab66\x89\xce\x10\x98f\xa2\x94&\xcbz\xb1\xb0V]\xe0\xac\\\xe6\xb9Hp\b]\x8d\xbc\x83\uf2fc\x88\xd8\xd7Ex\xc5_~Uk\x97x\xb8H\xb6,\x86?\x84[\xee\xbe⨨\x1b\x85\xbf\x10\xce\xc6]\x85\x7f\xc4\a\xdbp\x02p\xd6}rX\xf1M\xe5\xac\xfcx_\xe9/\xa3h\xb3\xda\xd0\xc2\f\x9dX\x03\b\xd3\xf52\f Z\xa2f3\x0e=\xa2-\xb7\x03F\xbb\xfax\x0e\xcb\f9l\x9dH\xd3/\xe5\xa4,\\\x98˭L\b\x1ee\xd3\a_G\xd1O\xb5\x8e\x92+\xach\xffZ)\x92\x85\r\xef\v$!\xaa_\xaa\x8f\xf2\xacJ\xb2\r3\xde\xddkaC\xd4\xe0A]Jon\x7f\x06\x91\xf8]\x03D[=\x1d\x1e\xe1ϭ\x96\xbdkfﺜ\x8a\x87k\xf3\t\xc56\xd6\x02\xd4\xd0C\xa2\x81\a\x18\n\xb38_\xd1C\xa4\xf2a\b\vv\xd7\r\xfb\xc1\ncC\x9cA\xba9k.4L\x14\xa9Ou\x81ũ\x1a\xee\xa5\x14\x96\xeb\xb3\a:Ϯ\xe1\x91\xd1\x06\x95\xbbs\xe4\xee\xf4\xc1ΫBጶp$>\xb5~\xe1\t(\xb4Ѯ\x99\xdf58~H\xb2믓\xb2B\xf5m,\r\x84~\x99\x8c\x0e\xc1\xf0\xba*H\xac]\x18\xba\x94CY\x85ѵ\xe3\x96\xddp\xbb\xaf\x90\xe3\xa2\xddp\xb7\xaf\xd0ϨB\x84\xcfo\xadI\xb8\x05W\f\xec\x98\f\xc2\xfb1\x151+\xe4\xe3q\xfa\x01`#<f}J\xe6\xefEJ\xf6\x89\x7fgEn\xa0\x94/ \f\x84\xab\b\x9d2\x8d.\x95&ٍ\\\u0383o\xb9\x87\xddnt\xf1V\xf350\xac\xc9]\xb4\xfc\x18n\xbfGi\xf7¢\bw\xb6/\xcbX=u\x81{\n\x02\x91\xaeh\xd7\\\xd9Q\x9a\xb1\xfa\xd2\x02\xb6g\xb8\xbbJ\xcc!\xef]+L\xc9%y\xe1\xbc\x00m7\x03Ta\xa2\xbd@\xaa\x8f/Ǿ\xea\xed\xbe\x16\xbeb\xf1&b\xaf7+\x97\x85\x8e\xf2<\xab\xc4\xeb\xcdJ\xbe\x82څ\x18Q\xae\x03\xb0\xb1\xae\xd1\x16\xd5r\x19T\x14_&e\x95_\x15\xe1\xea\xab${]mP\xfd\xc8\a\xf2n\xc2t\xc3\x1c7:\x1d%\xbfřQ\x96\xab\x9f\x04P\x91\xb89*)\x94u \xee\t\xf9Z\xf8\a\xd2g\xd4b88n\x85\xac\xceq\xd3\\?₢\xebɗ\\`\x00s\xac:\xe3\x01\xde\x1bһ\x15>\x88\x8b\x8b-9\xcd\xc0#آo\x8b\xf9\x00\xcc!\xa4\xf2Wp\x9c\xecC\xffWr4\x11\xb9\xf4\xde :\x96H\xe8\xee\xbebEXD˝\b:\x9c\xcf\xd1:\x19\x80JW\nF\xbd\xda\xd5\x00\xc1}p^\xd0>7Z&i\\0z\x91\x97\xaa\x97g\xf5\xbc\xd0\x10\x15\xab\xb9\x9aUXP\x01\x97\x06\xb0\\\x81\xb8\xacU\x0e\x04\xdfgx\xba\x003\xc0\xe3M\xcb<\xeaY(\xf9\xa7\xef\xc5\xceH\"<\xf7\x10\xae\xbd\x14\xedu\f>ɊC\xd0\xfc\x05Y\xe5\xc0A,<\x04\xc1뼨\\ޞs\x8e\x02\x06\x10\x1e\x8e\x8cz\x82\xf6F͑:\\\xb1\xe2\x8a\xf1L3J2\xdfl5\xec\x89\"]\xb1\x03\xcd\x00\x17\x97V\x84e\xcat\xf89\x18g\xf7\xb4\xa7\x9f\x8a\xbd]\xa0\xe5\xf0\xad\xfd\x14\b\x8bJ\xff\xd0\b\xc9ܩ\x14֨\xf2Z\xb8\x18*.\xce\xf7r\x11\xf1|\x9f\x95\xcc\xc2D\xcbr\x98A8$\x887\xf5k\xe0z\nn\x94x\xea\\\xa4\xae\vFs\x93\x99*`Ţ;2a\xe7\xfbZɍ\x03:u\x87\xd3\xd0\xd9\xea\n\xcf袪l\xb4\x9c\x1c\xbb\xe7\xc3-\xc9\xe1V8tχ;\xfa\xde\x05\x10\x17Ě\x02u\xe3\xb00c\xa3\x17\xf0\b\xff\x19 \x12\xf4\xb3\xc6_;\xfc\x85\xef\xf3\x0fG\xa3\xf1\xbe\xe6&\xf34ɮL;\x85\x82\x9dS`O=\v`\xfbcb\xbc\xf5\x8e\xb9D;\xfd(\x02\x02P{\x8d\xed\x8f\xe1V\x7f\bD@\xf75\xe8\xb0v\x0e߹p\xef4\xdc;\x1d\xf7΅{\xa7\xe1\xdeո\xeb\xed\xc8CO\xaa9\x02\x93#\x8f\xcea\x1fz\xf6\x1eN\x85\x88\xacm\x13b\xb7'\xf8 \x1f\xec\x0fdS\au\xca\xce\x06\xd95@\xb8\xdbk\x14@BFu\\X\xf1\x9a8\x11\x8b\xbc\xf8&\x8c\x96\x9e\xd9i\xa8\x82D\xbbB\xb5m\x94Nv\xdckd\x10JI\xe1\xde\x0fbi\xc8;T:\xc9!;2\xf3(a\xae\xf0շD\xe7C.\xa0E\x9d\xa0\xa1\x94H熛,\xa2\x9d\xb8\xf7\xb7\x93K\xd7\xf6\x15\xdb\xf5K\x1a\x8a\xa1\x17@\xe4\xd7P\xbc\x82\xc8o+Wk\x14\x1d&4\ah\xb4\xa7t\x84\xa3\xd2\xf4\xc3m\xaa#\xd0\x1f\a:V\v]\xebjog\xbb\xb4}\xbeV\x9dXv\x97\xfc\x85\xf7Q\x00\xe4Z5\x0e\xa0\x9c\xd4\x17\xbd\xadS\x15]ߝ\xc1[\xb2w\xce\xcfh\r\xfdV\xcbB\\6}\xea\x95}\xb7\x86z\x8b\x9c\xb4K\x80\"o|fe\xcc\v\x16^\x9b\x89\x1f\x1e\xb8\x7f\xf3\x18\xac\x84g\xa6\v\x9eֶk\x98\x19\x9dw\x06\xd7Զ\xb7V\xda\f\xae\xb5\x14dU\xb3\x99{\x1az\xedn\xa8\xb3Ef;\x0eh\xa1\x8b\xe5\xe5\x18\x9ec\x9f\xfe\xf1\a\xf5\xf0\f\x7f\x7f\xf1\x05\x0e.x\x8e:\xdeo\x9f\x8e\xb0[}\xe1s\xe2\x06B\x8e\x98R\x8c\xff%\x83\x81\x9e\xe0xf\xeb\xb0\xf1$GEd%5\x06g\xfb\t\xdc\a\xd3\b\xe7\x91\x0e\xed\xf39\x80_\xbb&\xfb\x86\xb7\xe3\xe9;-\xbd\xa85\x8e\xfe:\x97k\x00\xbaLi\x11NQhY\xa9\x13v\x94\xa0\xccVQ\xa1\xcf%Q\x11@DSn}Ҏ8\x1e\x11\xa6>\x15\xc7\xdf;\xf3\xcd!>\xf3 \x93\x8a\xfdj\xfc߲T*\xf2\x8e\x19\x1a\x95\xbd1\x05S\x1dƊDK\x11\xca.\xeb\xb6\x15\u05f5\x1b\x98c\x96\xb2\x8a\xd9\x15\x9c\xb5\xe4\xd10\xec\xae\xe3Wy\xaaN\xd5p\xe7\xdb\x00\x8c\x93)\xb5\xf2\xb5\xe6\xe3\x9a}4\xfb(\xdb\x1aO\xd1rɔc\xe4\xee\xce\xf4\xbex\xa4L\bdLU\xd3\xfd\xef-\x0f}H\b+\x94\xb5m\xb9mk\xa8,N.²\xbd\xb5\x88t0˜\xfd\xf4\xf5\xe1\\\xad\xff\"\\\xd6t\xaf\x17\xeb\xb6\xc6s:\u05ce\xb7\xe4b\xb6\xf3\xcd6\xc7!\x95\xaa\xb1Ⱁ\xabȾ\\E\xaa\xc3\xcf\x10\x19\x19\xab+\"\xf1\x9c\xbe\xe7z0}$kxJ\u05ce\xe6\xb8\x0e\r\xe9\xba\xc6\x04\xf1D\xc2\xeb\xb9\x1eL\xfa\x05\x0f\x02\xa1\xe8\x05\xf1\x1c\xab\x8e|\x8au?\xc7\x01\x8a\x1f\x8f@ $8_G\xab\x8fod\x12\x92\xae\xda`6!\"Q\t\x87;\t\x82\f\xdbioD[\x9aQG\x19\xcf\x1bX\xf6\xf6i\x94nʊ\x15\x7fkȜ\xb4\x99B_\xda\"$\x84\xe6{bm\xfaD\x19JUu\xfbg{k\xffk[\xedJ̅\x19L\x7f\x9d\xc1]7\xa9=\xaa_\xf2\v\rep\xe4\x1a1\xddD\xfd\xc0\x16U\xe3`\xb4[\x11XT\xa3`[\xd5\xc2yKEjD\xa2\x17\xc9t\x9fj\x14%_\xa1o\xc2\xc14\x06\xd0N\xa6\xe7P*>\x9c\xb7U\xa9\xa8\xcd(\x80\xf5\x01\x14W\x05c\xaf\xd9:,B\xea?\xe7\x0e]\x9e\xfd\xe2\x88\x17\xbf\xcfa\fS\x98\xecA\xfc\xb9\xbaJ\xeb\x14\xd1\xc2!\xd57\xac\x96\x05\v\xe3=T}\xfeޱ\xba\xe1\x1e4\xbefh.\x12\x13_\x94\xaf\x90\xed\x873Q\x9f\xabZ\xe94\x15:\xe5\x06\x90\x05\xc6m\xbc}\xb7\\\x04e\xbc2\x98\xb9\x1baLj\xb2-\x01\xf0\x0ex\x01#\x1d\xa9\xf2>\xa0B\xdd\xd7\x06E\x87\x1c \xe0\xd4㫼l\xb1@m\xc9\xfa\xb4=@\x98\xdbp\xa8\xf9t\x0f\x8e\xaf\x19[\xb362b\xb6\xa6ۧs\xfek\x0f\xaa\xbf$eR\xf1\xc0\x84BJ\xc24\x9d\x87\xd1u\xc3\x0e\x83\x9eZ\x95\x00\xc2eW\x92oJa\xb9q\xc8@\xabP\xddY\xac\f\xc1\x92\x15\xbf\x14\x92\x82GҚ\x13a\xdd\xd3\xed\x02\a \xc5L\x93(c\x13L\ruUh^J\xb2H\xe9\x145P|m\xe1\xa0\xe1Y\xa4q\x9a\xce\xdc\xf7\x8dr\x8aGak\"\x8aR!\xbc\f\x96\"\x1a\xfa(\xe8PPI\xb3\x1b\x02\xbdU\x82\xa5\x83A\x82^\x91\xdaxs\xf0\x93+\xa93\x1d`\xb8.X\x9a\xacp\x95L\xa4\x99\x99\xab<n\xe4\xd0\a\xa6r\x10\xf1\t\x9eh\x8e\xca\xe0\xdf\xfb\x17\xb8Hҏ\xf9\r\xf3\xa4\v\x11\xd7\x1e\x01\xafH\xb6Gf\xc2L\xfd\xd4[#\x14K\xad\x89\xcf4m*\xd8\xcc)=\x02n\r\xcc6\xab9+`P\xa3\xe3)\x82`\x95\xaa7\xab\x8e\xf9/:\x89\xb2\x06\x8e,\xc5$\x8du\x94\xe1d7\xe5ؼ\xee`\x97\xf2\x9b\xbaIV\x92[\x92`K\xf3\xdc$+15\xa9\x16\xd53\xbe\xe6X\x06\xe7\x0eP\x98*\xb4{(Z\x85\xeb_\xc2\xf8\xa7M\x9a\x1a\x12o\x1dGl\xc5\\\xb9\x95\xa7\x10;\x91\xb0\x93\t\xb1\x04\x89\x15L,\x81\xe2]\xbb\x1f~\x83\x149\xa0\xcd\xf7\xc3\xe4^DٚE\xfe\xc5\xf1%߅(C\xb1\xcc\x18\xc9\xf0*\x82\xaa\xfa\x05+\x112BC\x10+\f\xf1N\xcb\x1a\xe9p\x13\xcdw;\xa6\xf7D5\xfb9\xf6\x7f\xbc\xad\xc3,\x00\xafzd\x1b\xaa\xe3\x9dY\x906\xb9\xf1\xce(\xb8\xb3\v6\xfbB\xef\x06\xa3\aL\xe6w\xf1=.o\xbc\x18%:\xa9X\x11\xc0*Y1\xbc\xd4И\xa6\x10nS\xa4͉\x8c\x0eH+\xb6\xadx\xb6D\xa0mw0\xd30\x17K\x95\x8d\x198S\xc5\xe5\r\nq\xc98\xa8\xef~RT\x91\xc2\xdf\xed~\x95\xdfzE~\xdb|\xb8$\xbf\xe5Gl\x04E\xe7\x8b>\xbfd\xaa\x9aم\x96\nX$Ǩ\xbf\xee0\xacp\x91\xc0A\xce\xe1Ͻ?C\x1f\xf0kX\xb05\xed\xf3\x8f~\xeb\x1d]\x05\xf0\xe7^\xef\xcf>\xf4\tbJ\x10\xb6\x9fk\xc1~\t\xf9\x95\xaf\x8c\xdd\xc2+v\xf5\xcdv\xed\xf5~+~\xcb\xfe\xb8\xa08^\x92b\xe8\x03%_\xe2\xf1\xf2U\xcf\x0f\x141f\xd9?_\xf4\xfe\xdc(\x97]b\x01\x95\xf6\x92kZ\xf5M\x8eF\x98\xf8e\xe5ɧ\x81T\x8f\xe8\x87\xd0&S\x90\xfe%\vcVX'Ϊ\xec\xab\xfc\xb6\xa4B\x9a,\x14\xf9-]a6\x17\xaaF\x02G\x9d\xc3\f~\xff\x10\x18\x8e3\xbc:\xcbx\xe3r\x9a\xc9/8\xe8\xc5\xdbK\x8a\"\x90\xdf^\xbc5\xd6\"\x82Լq\x8fZ'\x83\xe3\xe0\xe5\x1d\x85k\aRc]b\x9e\xab\x1b̰\x99\x19\xc0\xc2\x11w\xa0ʯY\xe6\xd9,\x12\xa22Lò\"\xc7\x1e\\,\x90\xd4Y\xf7\xf1\xbe\xf9\xf9[\xd3ǐ\xe5\xa9\xc9^\x96\xa7HI\x98\x96\xccѬo~\xfe\xa1٪ډ\xa9A\x87Y\x19Q\xa4\t\xd4[\x9f<j\x8fO\x9a=\x9c\xa0\xd1\xdbыI\xbf\x0f\xcfͦ5m\xedv=\x89\xbb\x9eVpr\x95\x81\x87\xa2\x88\xf3D@\vF\xdb}(\xd0\xec\x9a\x19`\x05\x13\xfb\b(\x82\x19\xb8)9\xb3\xce\x18\"j\xcd\xf8\xd8n\r\xef:\xddͣ\xbb\x8d\x13Ζ\xf1\xc8oRi6O\x8b#\x10\xa92\x87T\xfe\xa1)BDI\xb9\x99\x97U\x91dW\xde[l\"\x8e\xfaZA\xf6\x84\x82\xfc\xb3\xdf&k+M\xd6ؖE\\\xfb\x98¶r\xc95\x06X2U\x1au\xb3\xa1\xfe\xce\xf6\xd3\x1c\xc0\x8a\xbb\x8b:)tu\xb9&\xb1g\x0f\xf6`w\xc6\xf1\xf8\xe6\xe7\x1f\x84\xd2\xfb\xe6\xe7oů\"\xbf\x95\xb7\t2\xbeѨ\x02l\xe8كvBF\x96u\xc0\xab`&\xb5\ng\xc67?\x7fko\x04C+ڃ([\t\xf8\x1f薚\xab0@ȯ\x14T\x86\x14ku\xba\x18\x88ݷ@\x9c\x0f\xe9hk\x81{\xee\xac\xdf\xf7}\x97#42\x81\xd7\x116\x18W\xcf\xfaeC\xf1.\x1ao8!\x98k\xc5PjK\x86W\xf9\xadX0\xf4~\xcbz\xeeg\xebK\xe7\xe9\xc8\x15\xcb\xdf\xe0\x8ds\xbav^R\xe8\t\xf4/\xe5k\x90\x96{\xb2\xc2\x1f˦\x89'\x13\xd3\x11\x17\xbe9\xfe\xf3m\xf6K\x91\xafYQ\xedD\xa9aE+\xb5s\x0es\xa1%^*\xbcS\x83\x8a\x8e\x8b\xb0\x18\xaf\xee\r\xbf\xba\xea\xf1\v\xce\xf6\xbd֕\xb8#J\x99\xb8\xb2\b\xcdO+\u05fc\x7f\x8a6\xfd{\x95\xec\x9b%\xdf\xf7\xda8O\x87\x95\xa5\x97k\xb3\xaa8\x1f\xaes\x7fu2\x93\xf3ч&\xe8E.ىX\xbbk\xfe\x96\x85զ`\x06\x01\x16i\xc3+\x96\xafXU\xec\x0e\xc6\xf62O\xc5eV\x03\xaf\nM\x1e\xc2\f\xf2\xe1\x82\x03\x97\x81\xfe\xe6EX\xc7(w\x1c\xa4\x9a\x94aԛ\x06q\xeeM\x7f]\xea_\x05\xfca4\n\xec\xc9GRy(q?\xd0\xe3 \xa4m;\x88\x8ar\xfe\x1cou'\xaa\x16\xe2\x02;\xb7\x92\x85\xb5\xb7G7I?n\xd2*\xf9\xactI$t\xa8H\xcc\x12^\xde+:\x0f\x94ei\x95|\x06o\xf5\xb2v\xab\xe6\x17o/\x1d\xd7^\xf7\xb4\xee\x97<\xdd]\xe5\xd9ߡi\x17\xa3\xbfk\xeb\xe8q}\xb3Y\x06B\xa3]{\x86\xf6ݘD\r\xfd|\xa2yU\xb0\xb0\xfa\xb2\x88\xee\x11dC/~\x8f8\x1bzq=x\x8a\xd7|\xd8R\xe52\xcfځ~\xa5\x0e\x9e\x93̫\xe8\xdc\x1aϔ\xaf\x03\xf8Rωa\x00\x95\xc8\xd8\xc2\f\xbeğ\x14\xc2\xf6+\xfa5\xe6\xc6#Jީ\xe4\xdd8\x80\xf7\"\xb9T\xc9\xe5n\xdc\bAA\x15\x85U\x98M\xbc\x1dEE8\x92-ę+\xcc\xca@\ay\x1fh\x87\xe5[\xe1\x1a\x8f\xa7\xd5;\xbfY\x12.\x9b!\xb1\xf8\x15\x98\b\xff)\xf1\x9fkL\xbaމwc\x91\xec\b\xff)\xf1\x1fj\x1c5%\x0e\x94\xb7D\xcd\xd0a,.\x0f\xb9\x9f\xa3\xa4\x19\xb4\xbe\xa4v\r3\x18Ñ\xceX\xc1\xe5\x10\x83o\xa8\x83\x7fܱ+OO\xa2d\xc49\a}$\x1b\x1e!\x81z\xd8\x0e\xf921\xfeg\xdb\x13\xcc'45ҹh\xb6D\xad\xe1\x9eH#\x98i\x95\xe0\xd7\x1b\x1e\x06\xd8d2^\x0e\xada\xb1a\xda+\xc9\xd1\xce\xc0\xb2\xe3X\xc6\x0e,\xb2D\xb93p\xedj\\\xd7T\x8b`A\x1d[\xebzW'\x97url\xdcS\x05G\xbc\xa3V\xde\xf0q\xd7ɛ\xb1\xc1\x9bq'o\xc6\x06o\xc6\x1ao\f,\xbb\xf1\x01\xbc1p\xed\xc6\x1ao\xc60\x13\x82\x11ձ\xc0\xaewur\xb9\x1dߓ7\xee\xec\xc3t\x12k\xb8u'0k\aG\x15&d\xd3\v}\xd1\x13ʇ6Q#\xce3/`'-\x04\xad\xde\xe0\x8e(\xdf\x14\x14SȻ*\x92ض\x86\x8f\xb8\xfa\x1a\x19\aJ\xb8Q7\a2\x16\xf5(F\xb5\xe3\xc6\xc1\x05y4\xc1\xe5\x99#\xccߖ,\x02\x86A`\xcb]\xb3t\x7f\xd1]gH\\\xee\xe95\xb0\v\x188:g\xe2՛\xe5&M_\xbe\xfc\x9f^\x82քI\x00\xc9q\x007:/*\xcd\xe9:\x0e\x80\x05\xfc\x8a\x11ߍ\xde\\$\xd2\xef9\x84\x19ԡK\xe7\xf45\xbe4@\xa5\x99?2@c\x17\xe8\xb1\xf8d\x06\xe8\xc2\x00\x15L\xf6\x16\xfc\xe2\xd4#\xf0\"\x18@ȝ\xa1T\x1a\xe3i/`\xd4*\vk\xbex\xf8>+\x93\x98yk\xde`;\x1c\xf6\x9c\x1fY\x84\xe4\x16\xf3H\xbd\x1c\x14ҵ\xbc瘯}?R\xef\r\x85m\xa1\xb2͚\x85\x87\xad\x17\xa9\xe0Ά<\xd2h\xe5\x0f\xbdLT<\xa7\xed1\xad\xdb\xe8\xe7\t\xcc(\n\xbb\b\xd7\x18ѵ\xc3\xddDD|\n`\xc7a\xe9'\x87ş\xdb\xf11\x0fx8\x80-\x86\x17\x9f`\xd9\xed\x04?1j\xfa\t垈\xdc\x1d\x01\xef\x10x\x87\x9f\x04\xbcC`\x9c\bw\x04\xbc;\x11\xb9\x9b\x90\xfcUO\x8eq\x06\x1e\x1fc*\xfdޢ\xfd\xed\b<\xf15\xa1\xaa9\xd4dl\x8e\xdd\v$\xac\x8f\x98\b\x90Z&>\xb1n\xe7=\xb4\x9b\xbcȳ<yS\xb1\xb2d)\xa9\x99\x1bVTI\x84\vc\xfb\x94\x059\xfb:\xa9\xe8n\x03O\x01H\x93\xb2\x9a\x82,c\xde+\xba\xb1\xac\xec\x8ekEdݚB\xa2_(\xdaN\xe1\x06{FK\xdaa\xd2\xf8\xd2u\xbbh\x88\x97\xdf\xea:uI0\\'\xf0\xb0\v}\x18\xcfa0\x86)%\xbc\x10\t\xfc{K\x00\xdb\x1a`\v/D\x02~\xd7J\xc5\x0fԽ\x84\xaa\xcaW\xc8\x11\x1e6TW\xf5dK\x8b\xaf\xd8\x0fIY5\xd8u\xa1\x1a\x97\xb2E\xf5M\x16\xf3\xf22\xb1@_\x94Fj\x92%\xd5\xd4\x1d\x97VV4\x14\xe8`V'E85\xb0\xef\xc2t\x81IbM\xdeK{\x9aEL\xc1ʊ\xef[^TO\x0e\xca\r\xa4\x1d\xf5\rS\x985\xb0\xb8\xf0\xe3?\x9b\x8c\xbf\xd1a\xc3\aM\xbc\xf5\xee*PN\xd8zk4v\xb2\xf8\x8a\x05\x80\xfal\x8f\xc42*G\xe0Z*\x16\x9cҿz*\x0e\v\x14o\xbd\x1b\U0007fd19Th\"dJx\xdd\xff%+t\tH\xe7\x01,\rr\x97\x8c8\x99j\xc1ԗ\xdc]<\xd5n\xd7\x00}\x11䒙\x89F\xd2\a]H\xbf\xc2]\xa3V\xf9ڶ\xdd.Yg\x1fƹu\xacEU\xe9D}\x90k\x86%\x83\x87\x0e\xf9A;$Z{x\xc2\xcf\vo\x89'\xf3\xbe\x7f\xf6\xc0F\x9a6LߎF\xc5,՚\xd3\xe4\xe3\xb0hP\xb8d\x8amz\x1dK6Dq\xb0\x96\x82\x1f\x8c\xd1\xdcZ\x95\"pX4J\"\x13\x0f(\x98:\v\xbebWI\x9e\x1dP\x9c\xd3Ή\x87s\xae\xe0\x87\xb5j\x83\xa9\x04\x1a\x16\x84\xf2bɆ(\xe8\x97\xee\x96~\xbez\xb5\xe9*_\xaf\xf32\xa9\x98\xa2\xc5~q\xb0V\xc2(2\x9a\x02\x9e\xd3\xcd\x19\x8d\xbc\x92\xee@\xd9Ҝ\xb1\xdbox\x9f\xfen\x1c\xd3\xf0\xb6\xe9i4\x9a\xcbq`$\x15S('Z\xca\a=\x9b\xad\x1d\x18l}\xd0\xd4\bƭ\x973\x83Z\xf2\xef('\xe4aX\x8e\x95\xcb~9!\x9f\xfdr\x8c\x97<C\x02\x8aqR\x1b\xc19\xfe\x98\xc2 \xdeb\x06\xc2\xc6;\x99\xb1\xa3\x8c]]\x83\xe0\xc50B\x94\xe3\xa1\xf2~G\xbc@n\xee}\xf2B1\x1d\xfbqM\xa7\xc7R\xa1\x80LT}\x18\xef,\x7fNQAh_\xfd\x92\x19s\x10\x8e)\xf1֕\x1d\xc1\xd1\xcc\xc8r\x9c\xa3\xeb\xa8\xc6g-\x95\x93\xd7L\xbcse\xf3:v\xees8~\x14\xcf!\x1dz[\xacU\xf5\xd9&\x1d\a\xc0҆ر1̀\xa5\xe3!\x9f\x8e\u0604>'C\xa6!\x16\xb7L\x19\xbdJ\xfb\x90\xd1U/6\x16\x83\x04\xb5\xd6\f\xd8D}\xfa\x96\x00wx\r\xd4QR\xd9x\x88\xabF6\x19\xcea\x80_s\xfe\x15\x9a44\x9e*\x19\x8f\xee\\ݖ?\xee\xe0\xb1\xf10Ҫ\x9c\xf0\xaf\xf1\x90\x02<\xc4\x01\xec$\x9c\xca\t9i\xa2T(\xe0ظ\xe0\r\x90\f@.\x16`\xf0\x04Y\x1f\x80\xc5P6.h\x8d\xc8&\xf8\x97x\x8a?\x88\x9b\xf8\xe3\x8b/(e+@\xb6fKY\xca;\xeeLO#B:\xa5R\x14\x9b4\x8aM\xda\xf8%&?Ґ3\xce=\fc\xa9\xda6\xb4|!txlBJ\xfa\x92\x87\xa2N{$B] E\xefN=\xeaX/\xa1\x87XbF\f@G\xb1$\xab:\xd6:\x82$c\x16\xc1\xc9\xde\x1e.|.惥\xca\xd7%\xe7\n\xd3z\xda\xe4ך\x16\xf5\x02r\x0f\xab\x96L\xe3C\xea\xe6ø\xedX\xf9a\x17\xb2\x16\xa6\x8eڐ\x91rj\xdef\xa5Ѻ\xa3g\xd5H\xcf\xcbf\xed\xd0\xd7p-Z['o\x03X\x84\xa5\xf0\x1a\x0e\xe7\xf9\r\xd3M%m\x84\xa3\xc6|\x0e#\x14\x14G\u038b\x99\xed\x9b\x0f\n\xb5\xa8\f)$\t\x9d\xd3\x04\xb1\xde{UT\x96_s\xd7J\x9ac\xa8.`è\xe9q\"\b\xb4\xa9\xa8\xf1<\xa4\x1f\xed^4\xa2\xdd\x04\xd5\xc4\"Z1>\xfc\xf2\xf1C,b#\xe2\xb3t\t\xb3\xba;`P\vj:ܞ9\x99\xc0\xd9\xe6a\x7f\x12\xf7(\xfc\x02\xfdޭQ\xe9\"\xceG\"\x89\xde,\x9a\b\xb8#\xca\xea\v\x04l8\xf7?\x03\xeb>t\xe96l\xf2\x8e\xf4\xdb0\xa2\xb6┲F1\xac\xc6Jfwi\x00\xd5\xc4%\xab\x15\x99GR]\xaeuB$\x89\x15Zd\xab1\x8ejlz5\x81>\x16}\x04\xd5q\x87\x82\xb2\xc66\x9c\v|S\xab\xc9J!\xb1,\xa6\xd37\xe7\x8e\x11\xff\xad\f\xd9\xc1\xbc![_`.\xbdp\x95T\x8d\xf9[\x80\xb8V\xb5|IkF\x86\x00\xddi\x15\v77\xb7Ҧ\xab\xafn\x03\xa8l\xad\xc9W\x8b\x9c\xdf\xf5Z\x91\xab\x10\x9dɼv픨\xb9\xc6k]uSl\xdb\xff}\xc36\xac\xc3\xf8\xd1\xd8\xd1.\x05+\x03\xc8)\x8a\x99\xb5'\xe3\xdb\xe9\x06;\x97l\xb8+\xab\xb0\x10\x19\xe4x\x9d\x1bQڭg\xd4G\x01\x91\x023\x8dL\xb2-\x04@\xdbg\xbe\x81\xad\xcf7SG\x00\x06\xbeI\xd8V\x02\u07baf\x82=\xac\xc8zA\x80\xe2\xe3\x8f?4zgz\xce\x17_\x80P\f\xa2\x00o\xae\xbd\xd2\x00\x87oR\x8b&mx\x14~p\x8c\bj\xad\x88X\x9bP\xc4\xda%\xf3\xef\xb4[n\xf0\xb6l\xe5li\xf2\x15ۜR4\xec\x87dx\x10\x01\xdd4\xe2J\x8d\xb4q\x93,\xb6ZW\xbbi\xe7cM\x16!\x82\x00~\x96\xd0\xc0\x87|\xa7\x02\x9f\xa1\xb1V\xb8:)&\xbc\xfd3l\xbf\x1c\xf0\xc4\x13z\x1c\xafk\xbb\xe1\xb25\xac\x92\xac\x85\x1bH2K٪I\xad\xf6zZ\xdb\x02\x0e\v*q\xb4Vr\x94G\x12ܱ\x9ec۪\b\xa3\xea\xc7V\xf2Z:\xcbz\x8d\xd7P3\xca@\x84\x86Q\tӰ\"\xccDR\x13\x9d\xd8\xe6\xb7B\x05\x98\xfb}V\xe1\x89W]\"\x9dgq\x00\x05\xfd\x9b\x8a\x0f\xfe\xc5-\v\xb9\x06;\xcf+Z\x96\x06P\xb1՚\x1eʽ\xa9sqA\xbb\xdasX\xf6Pc\bɺg\x9c\x9a\xd5\x04\x9a݊\a\u07be\xeb\fM\xb6\x17o\xe65Q\xa3r\x12\x10\xb4\x15\xaa\xd1\x0fwf\xdelff~\xf1\x85\xcaܚ\x05\xb7\x06\xc1\xc80\xdbL\xf8\x15\x8f\x01ŋ\xfb\x9a(Z\xb0\xb4\xfc\xf4\x10\x83\x11\xb9\xa7j\xc0p\v\x94\r\xc9`\xc6͇\xbc\x9f<\xea\x9cf\xad\xb2\x17;L\xe0\xac\xd5\xfe\xcd'4/5\xe4A\x03\\K\x1a\x94M\xa0\x1d\x96^63Յ\xd6c1K\xed\x06\x1a\xf9\x06%\xeb\x80ת\xce|\xd7u˝\x8e\xb1\xa2\x9bL\x89\xbe\x03w\x8aO\xc5\x1d\t\xc5G\xdc\xc1\xdc\x115ե\xef́.\xc5`\x9d.\x1f0N\xa5\xd8k@J!zZ\xfd\xa9k|\xd8\xfd|и(\xdcPE\xe7\xe8\xa9\xcd\xc66\xba*_\xeb\x80\xfa0\xb3P\xde\xd0aC\x16\x8b\xe9\xa2\xce \xfe\xcbe4\xe1\x17\xfbv\xfaɗ\xd17~\x1b|Q\xc3\x17nxE\x9cchX\x03\xc7\"\xd9(i\xe5\xadW@;\x04S\xf2\xe6yEǇU\xbe\x1eZ\xb6L\xd4\xf48trm\xf1)\x19]\xe5\xc6\xee\x973\x15\v詼Ƣ\xe7\x92J\xa7\x0e\xab\xf2\xf5\x9d\xf5\xd7z\xd55@[G\xa8\xd9',\x00\xd7\xe6e\xbd\xba4{ƥ\xf5>F\xed\x1d\xa2\xf7\xda\x15\xdf<\xaf\xdcC\xbe[\xfd|z\xfd\xd3BHc%o\xac\xe2\xf5\xe0\x06\xb4\x14M\x9d\xe3\xdc>\x87\xf3ϸ\x0er\x9d\xab\x9dAڮQ\xea&\xaa\xbd\xa7\x1a\xba\xddN\x9d\xab7\x18@\xbc*\x18\xfb\x89\x1e]q\xdf\xe5\xc5\xe8\xecS\xba\xce\x13hOK\x94\xfa.q\xcd\xf7\xdd\xe6\x01{g\x85\x14\xf7\xc0[\xc8;\xcd\xd2\x1dq\x8bO\xc8O\x8c\xb7o\x16\x9e\x13\xc4tR+y(\xb11\xf4a;\xe1\xc7\x1a\xe8L\x88\x89\xe4a\xb1S\x89\xf6\xfd{j\x8b+4\x02\xf9Ĵ\x92\xadAդ\x95[\xac\xd4wb\x1b\x1f\x84m|ɱȆ\xb6a\x9b\x1c\x84m\"h+w\x02\xebč\xed\xf8 lǗ\xb2\x85\xaa\x13\x0e\x94.\xae\x8b\xd6\xedw\xc5ך/\xc9\x0e?Ǘ\xedrT%+\xf6fSEJ^\xf1}\xb8\xe1\x1bq\xc1\xf5kró\x1e!\xc4\xf8\x96pNy\xc3\x7f\xfb\xf5e˻r0\xad?\xda<\x9c\xa8r~\xff\xe7\xcb\xf9\x1c\x83H`}Y\xb8\xb2/\xee`\x92v\x93k\x14\xc0q;B~\xa99\xa6\xf7`q\xb2\xe1/\xc3\xf2\xa2A\xfd|:\xb9c\x92\xa6\xd2\xfc\xbc%\xbc\n\x19\xb1\x82\x99(\xeaz\x14Ǌǁr\xf0\x16\x8d\xbf+\xb5\xb3\xad\xa3\xc6D:z\xfd\xc6`\xbfo\n\x12\xe0\xfd\xc8'5V\xae\xa9\x8d\xd6\x15允J\xa0\xb1\u07b9x\xb8\xc6M\fE\xb6\\\v~\xd4\\\xf0\xb9%\xb4A\xa6q%\xf1\xa1j\xbc~\xbb\x14o\x8c5\xb7\xb2\x83\xf1]\xe3\xcak\xdd\xff\x8aw\xbb}\xfbI\xbf\xa0\xfd\x1f\xde\xf9\xb4\a}\x92\x05\xeewE1\xc2\xdfm\xf2J\xde5\xef\xfd\xd1\x13o\xc7\x05\xd0Kz\xfbd\xee\x87<\xbfެ͊\xe9\x16d\xb8\x16\xf2\x1f\x1f\xbf\xf91\\\x9b\x0f\xbb\xf1\xfa\x1d\u00a0³\xac\xc2\xf5\xb0d\x15G\x8cw|\xaa\xfc\x87\xfc\x96\x15/C|]<\x80\xc4tc[\x85\xebnY\xfe\x9f\x8c]\xc7\xe1\x8e\x0f\x11\xab#\x13\xed\xd2\x13\x95P\x80\xaf\x9c\xf7\x13\xb1}\x19̜\xe0t\xe3S\xf4w=\xd8\x12\xdf$7\x83sH\xa0?\x83\xac~N\x01\xa6\xa2\xfb\xf76\xe2\x00\xf2\x0f&\xfcs\x93\xfcc\x9eU\xcbø\xbe\xaaA\x0f#\xdf,px3\x88\x90\xe1ʍ\x89\v4=\x83K-5\xe4\xce\x0f\x1a<\xf0\x0fe\xc2AͿC\xc3?E\x93?Gc\x7f\xc8\xf1q\xc7o1\x1eKK\x8b\xeb7$\x1as\x8d\xa9\\\xcaa4\xacrq\xe9\xcc\xd71\x1dB\x02M\xbc\x1fO\xc2\xf6#H\xf85Y}\n\x12\xfez/\x12\xb0\v\xfe\xc6\xc2b\x9f\xe0\xf1\xe0C\x87I\x9e\x82m\x11=\x12\x198i\x95\xc0\x1d̠\x9f\x89\x9baw\x97\xad\x7fLs&\x9d͑\xb8\xd8v\x1df1QH-\xbc\xfb\xf0\xd104\xae\xa3\x91\xbb\x14\xbc\x80\xc7Oѵ\xf8\xd9h\x84a\x12ٱ\x7f\x80\xe2\xf9\x89\x1a\xf9\xcfĶ\x15p\xb6\xc0\x00\xc6w\xe6\x125\xec\xeb\xfd\xb3\xe0߳A\xf1ǉ\xf5w\xf9\xa6\x98\x9c\xfc35軏kЏI\xb6\xa9X\xf9\xcfԢ\x1f?\xaeE\xafY\x94\xe3M\xee\x7f\xa2\x16\xbd\xfe\xd8>JӤ\xfcG5븵Y?|\\\xb3\xbe\\\xfd\xb2jk\x8eMj\xb8\xfae\xa5-\x81Zh\x9d\xc1ķVF\x16\xe9\xb5C2\xdd\x02\xe1\xed\xa0\xfdO\xe7,\xfd>ϴ{\xcbH\x1c\xdeٍ\x91\x16\\8`6\x7f\x98\rg\xfd\xf7%\xcc\xe0\xbd\xf0\xb7\xed\rz0\x85^\xbf\x17\xc0\xfb%\xcc\xe0?\xff\xb3\xf6\xab|\x8fN\x8d\x8fGXd\xa5\xee\xb8\xf2\xf4\x7f\x81\xc7\xe6kl\xefK\xe8\xd7\xe4,\x924\x9dx\uf5fe#q\xb5o#\xf8}\x99\xff\x14V\xc9\r_\xc3\xd8\xd3\x172\xa4ʿ\x7f\xfd\xb3\\ƴb#C\xeaM\x98z)\xae\xa0\xb0\x13\x19\x9e\xbb\xf0\x00\x89\xf6\xfdꂎ\x01\xf5\n9\x1f\xe3\x11̀\x10\xf0\xcc\x00\xe21̄\x83\x87\x17\x8ft\xbf\x00\x8dD\x18`\xc9\xe7\b<\xe0\t\xe7\x980\x85x\xec\f\xbcF\xaf\x9b\x99\xb5#\xb9\x94\xa4\b\x10{\xe0J.\x05q\xd2\xf3\xfd\x16\n\x9c\xd5H\xb2I\xa8\xaf\xddu\xe9\xb5\xf4E\x9b\xaf\x0f\xae\x81\xbf\x18X\x8d\x02\xa8\xf0\xba\xb5uU\x1d\x91\u008c\xb7\xb6B\xc1\u0084\xd2\b\xbbC\xb7\xad+\xf3Ֆ\xfa\x88\x1a\xcb?\x87j\xdctdx\xe8\xf1\x9e%\x18\x14и\xf2}\x8e_<\xf7+-h}\x820L\xf8\xd4~L֙\xd9a\x14o\x92\xd3QQ\xe0FoE\xef!\x04\xed,%\xab\xa0\x8b\xad\xfa\xcb\xe0J\xcdj\x9aiSE\xa6\xb7\xfe\xa6\x8a\xcc^6A6UD\x06\xc7j\xd4p\xcb\xe0}\xbb\xa9\"E\x85j\v,\x92,LS7)_+a1\xadQ$\xd5\xc3E\x9a\xd3y\x11}\x9di\x194$aƇ\xa6\x9e\x81\xe2#\xa4HO\xe6\u00ad\x06\xa7\x81JDO-\xea\b\xa75+8\b\xffm\xeb\x0eb;\x01\x88\xb6\"{$Ŋo\x98(\xa9ub\xa0L\r\x83h\x81\x13\x16\xf34P\xd5*'0\xcf\xd5\xc0\x8d\x96\xd6=+={$\x8f;\x15&a^\xb1j\x99\xc7m!\x9b\x1a\x1a\xe4s\x89a\xac\xc9Nm\xa3#ڸ$^\xfb\xc37\xf7\x16\xc3VV\x94\xb8\xe7Ɨ`YX\x04\xa2\xbe2\x10\xf11\x1b\x93\a\x87\xde6\"X\xf1\xf2\x9e\xf9H\x17\x01\x0f\x93\fO\xa8\xf5\xd8\x04\xcd\xe2\x061\xa4Q8BQ\xd8\xdb\xfafd.\x8e9\xceWa\x92\xb5`\xe6\xbe\xc6\xd6\x11\x82o\xd2+0x\xbe0\xebZD\xa8AoBo\xed)\x82\n8\xe8\xcb\x123^Ū\xd1n\xbd\x1dX?}\xbf\xc1r\x9e\x91\xe5\a\x9d\xde\\\xab\xfaʬ\x8bOU\x12]\x1b\xe1(W\x86@\xa3\x8c\xb2mş\x947\x98\xf0\r\xa5Z\xa4\x18\x87\x06\x18/+_\xc0\x8ab\xc5\xf5d\x05=\xdb!\xae\\\x87\xd8O\xbc\x16~\x1d]\xfc\xc6%\xab\x8a^A`G\xb0\nD\xc8\x05y\xfcn\x10\xf5\xbab\xebR\x16\xb2N\x8a\x13\x98͠\tm\xf7\xbe\x90\xf2\xe1\x0ee\x96\x13\x12\xc0\xca\xc2\xf50\xb1\xa5\x85\xf8\xe8\xad\xf6I\x8bvg\a\x1fp\x15\xad;r\xd0u\x91\x88\xa7!\x9e\x83\x02wA]\u0091l\xaf\x0f\x83\x81\xf6\xae\xee\nf\xb25\x86g\xec5\xa6_\x8c/-H\xdc\x16h3Ccj^yZ\xb7Գ{\xddo\x18\xbeR{\aǖ\xb2o\x1b1\xf8\x1a\"\xcf\x15\x8b\xa3|\x94\xafw\x9d%]\x1a\x8bJ\xe1\xf0\xb0\x15\x973\x90\x9fzەP\x04 \xb5^\x8fxғ?^\xe1\x1c\x86_Z\xfc\x10\xfc\x8c\xd2p\xb5\xee8\xdd\xd1\xc7\f\x1f-F(\xf9*$5\xc8s\x88\xc3%\xf7L\x11)\xfc\x8f\x90U\x12\f\x83z^\xfe9/t\x0e\x17<A \xb9\x84)\xa5\xa0\x0f$\a\xbc\xec&\xf3k#\xbc\x92v\xec%2\xbaK\xf3~\x16!\x14ˮ\xa9\xb3\xf9<\x93(\xa4\xb5\x93\xbf*,\xd251\x16kχ\x8b\x8b\xf1%G\xe6\x1b\x90\x83A\r+\xeb\xbe\x18\tгC\xe6\xbd\u05ec\"ӡ\xf1dv\xac\x1fB\xef\xc8S[\xaeec<_S6❹\xa7\x8d\xbb\xeb\xfaWQ\x97\xb1y\xdd\xc9ͫB\xea\a|'\xd6B\xa7ؕ\xb9s\xb5貂&~e\x94\xf6h\x14݂\xef\xd2F{\xba\xf7\xdf~}\xb9\x9f3\xf2\x1c^\xb1H\xe7ѿ\xfd\xfa\xf2\x9el\xfa\xb7__vsJG\xedd\x96A\xbd\x8b_:\xc0\xddYFs\x035>À\xe4\xf2\x97Ku\t\x8c}\xb5Q\xe2H\xce\x1e\xe8\xab\xc88\x8fh\x8d\"\xbcǾI\x19~y\xbd8\xb9\xe9\xf9òڥ\f9\xaa\x02X\xf6\xf2u\x18%ծGl\xef\t}\x04QXEK\xf0XQ\xe4\x85\xce7\\U \x8e7\xeb\"\xafr\x9c\xafa\x06/_\xbf~\x8d\x89_\xb3(\x15\xcf\r\rU~P\x97\xd1\xea\x85Y\x9d\xac@u\xc2Τ\xf5\xab\x03Fg\x12\x9eR\x8b\x97\xd2\x03X\x17I^$\xd5\xce\b\x06٠a\x88\xbeQ\xc2\xe3C+\x8eG\xef=\r\x875\xf4\xe3cu5\xe6\x86\x15%],\xefM\x86\xe3\xd1\xf0\xb8'\xbbCp\x8a^\xb4\x87\x99\xfa\xf9\x1a\xafDX\xdd%\xb2<\xd5o\xf2\x87\xe89\xfe\x18\xc8O\xf4\xac4ηY\x1eS\x8c|\xb3\x9b|\v\x9dV\xe9\xcb|\xbd\x93\xb4\xebt\xbd\xdẹ4,\xb9\x11a\xf8\x86s\xf8\xcd\x1b8\xafyJp\x01(\xde\xcbZ(]+2\xabA8Y\xd3\xfd8ԅ\x8c\xb5\xec\xcc$ӡ\xa8܅̼\xd4\xeb\xa8S\x95\xfc\xd3{ik]\x1e\xccظ.\x7f\x883\x93\x8ekF$\xc8r\xd2\xfb\xe1\x9a\xed\x02\x91xq\xcdv\x97n\xa7\aN\xc3\x1bb\xa8'\xdd-x\xd5˰\xd4\xd8q\xcdv\xae\x95\xc8*\\㻉\x8bd\v}I\t\n\xa6|P\x8d\xfe\xd0sݝ\x98\xb0ȅ\x03ݥ\x81\xa7\xb4\xf1\b\xc1?\x1c\x1d\xcc\xe0F\v\t\x1c\b\x96\xac\xf2\x1b\xd6F\xe15\x13Bi#\xb3\xa6\\\xad\xf1\xf4\xbc\x03\x7f^\x92(i4\xe4\x9a\xedJ\xe7\xc5\x14џ\xa6\x81\fq\xa8\x177\xddDr\x94\xdc\x1a\x85\x19\xf6c\x12:\x95f\xdf\x10;ډ\xe1ه\x91\xd3\xe8\r\x89\x9d\xd3\xc53[)\xe3\xa0\x06m,\xa3й\xadĉ\xfc{S'\xcas\xf2\xeadb\xe6\x14\xff1b\xeb`\xe9)\xff\xa3\x92?\xb4\xb7G 7\x1a$\xe8\xd2\x1a\xb4pD{\xd5$\xc9~\x10\xe1\x9a\xed\xac0ﳙ%\x9c/s3\xa4\x10\xc0B\x9f/\x10C}J\x81\xbb\x18%\xa2~\xd7\xdbf\xbc}B\x0f\u05f5\xc1\fz\xbf\x8dzA\x93\x06{\xd04_܈\x8f\x87\xc4\a2\xad\xc5\xc7oć\xc8\xe2[\x14]3\xf2\xad_\x00<ʠ\x1d\x99P\xb8\x87٦\x0e\xb9%r\xbb\x8aq\x94\x17\x1cD/}\x91\xd0#\x1a\xf1\xf1\x1b\xb1U2+\x97\x7fEIK\xb9j\xe1_Ec\xc22b\x19\xbe\xa4\xa3\xb7\xc7\xf5\xe2\x1a<\x87\xb9\x8a\b\x86\xf1\xc0d\xb80tk\xc4\x0f<R\xf8)\xfcIG\x1e\xb3\x03\xb1\xe3\xed\xebPb\xc7\v\xee\xa1\xc0N\x17\xebC7\xf6\x15\v\rے\x98\x17\x17\xf6\xc1\x18\xa5+\xa6\x87\xdcusT\xfb\uef6d\x8f\xfdP\x92\xed~\xb2\x03\x0e\xd8=%\xa2\x9a\v\x93\x7f(+\xc4X\xbf>\xd0\xcbT^\b\x03X\xe1Z\xb5\xdf\x7f\xeb|\x00u?N1TD\x1be\rx\x12\xd7U\x8b\xe1i\t簂)l\xb2\x98-\x92\x8c\xc5&/㤛\x9bN\xd6\xd0ш\\\x1eq>S\xdc;!uf\xce\"I+V\xd4\xed2ES\xef%z\xb3s\xf8n\x13fU\x922N\n\x8fn\xa7K\xac\x1f\xc0\xf0\xd4omQ\xb2W8t\xe7͆\x90\xcc\xef+\x11t\xa5Д\x03u\x96\xfa\xc7\x1f\x10\xc2\xc3\x19\x84\xbe\x0f\baP\xde&\b\xde\xdc\xc0\xf5P\xe0\xfa\xe2\v>\x0e9\xa6\xf9\x01rUS\xd6!M\x9f\x80\xd6.\xec\xdd\xd4\x1b\x02a.E\xb7\xff\x8f\xefM\xd2{\xffe{\xd3A}ko*c\xf6Gth _\xc7\xff\x98>\x8d\x0e\xea\xd7\xe8\xe3\xfa\xd6^*5\xe5^\xe6D8\xc9\xfa\x10\xe99\x1f\xee\"\t\xd1?\x834|\x92\xf6\x9a\xe1\\\xc3\x00\"0\xf6\xa6E\x98\xc5Z8\xbb\f-\x8ez`\x86\xff\xeb\xff\f\xe0\xff\xfe?\xccU\xb9k\x15\xa6\x9f\x95d\xf0\x1c&>\xfc\xdf\xff\x87\x1e\xe6Ff\x8c}\xf8\xbf\xfe\xcfz\xa5\xd04\xa6\xdaG*\xfcE\xfa\xa25\xf0\xe3V\xfa\xb1\xf0\xa6x><\x82\x89\x19\x91\x19`w\bP\x0130\xe2\xd6;BI>\xa4\x80\x1b\x05\xcd؍C\xcc\xff\xeb\xff\x84>6\xfb\x11\xa1\xa9Ü\f&\xf2\x13\x0f>\n\\[\xd47\x14?\x18\x1b\x884\xbf\xfa\xe9\xef\xdf\a\x88QI\x82\x92\x8a!\x97\x06\xcf?\xa4\xab\xf4\xd8.l\xbb\xf6j^H\x96\xb7\xb48)n\x93\xec\xbb0M\xa7]\a\x88\xce:Ն\xaa\xe4+Q\xbe\x025\x9e\xca(\xa1o\xf5|\xa3\xd7J<\x89\xb3iӆH\xb9Y\xedS\xb1\xa5\xf6\x90\x85\xada\xf5\xa7\xad\xef\xbb.~\x98\x94?\x85?\x91j\xeak\xabbj\\x\xf0JX\xc7ҹ\x0e6\xf0\x1aG3\xba\xea\x90\xebJ\x9d9|\x8f\xaf\x05VC\ue83f\xa8\xc8\xd1\x0eCp\b\xae\xf9\x1bjK9:\xc9\r\xc3\xfb\xce\x0f\xe0F\x1ar\xca\v\x82\xbe\f\x00+\xfa\x0e\x06\xb04ֻ\f\xce\xe1\x06\xfa\xc0\xe0\x91\xac\xe4b\x89\xe7\xae7\xb8\xa4\xbd\xd1\t\xae\x8a0+\u05f9\xf9\xfc\xe3*\xac\x8ad\xdb\xf0\xbb\x1f\xbeO\xd6\xe2\xe2]|\x1c\x80\x80ұ\xbdO\xd6.S\xb8p\\r\fO_\x1d\xb3Jc\x86\x11\xfaE>\a\xc9\xd7ܵ\x98\xd0\xce\xfb}\xb2\xfe\x81#\t\xe0}\xb2.\x85\xb9\xf0K2Ю|\x8a\x04å\xdean\x90\x8fMfT\x16=\x05\x13~ت#\xc9\b\xc9[\xfe\xf8J\x8d\x04\x10\xf8\xe2\xed\xa5\xb1u~{\xa9\x1d^9&\x1aį\xb3J\xbbB\u07b4\x8b4\xee\xef\x1a!j\xc3\x00'\x804\x0f`\xd9x_\xb31\x92\x9eñ\x0fi\xaek\xb6V\xc8\x13\x1f\x96\x89\xfe\xf0\x8c\xed\t\x96\xe6\xf0ܪTXk\x93\x98\\\x8c\xa0\x8f\x18^\xbcxa\xce\"X\x9f\x1c]\xf8V\xcd*\x89/\x03,\x84\xa7\xdf[A\x1f\xe2\xc0\x17\x02\xf8\xa0%BVI\xdc\x11\x9c'\xcd\xf7\x86\x01\xfe\xafɪ-<\a7\xbb\xfc\x9a/\x9cM\x06\xe7\x0eb\x95v>\xa2\x19\xb74qԄ\xb3\xb6%6\xeeW\x9c)\xf3\x98\x82Ǉ\xf9a\xa6c\xa3\x9b\xf1\x06\x8c\x8e\xff\x15v\x96U\x80:P\x94X$Ey\xd0J\xde=ͨ\xf52\x9e\xb6\x1f\xb0I[pڔ\r\xc0m<\xd3]\x8c\xac\t#\x00s\x8d\xfe\u008c\xfa\x17\xeeY\x8a\x1aۘ4\xfc/\xdd\xf4糏h{\xc6J\xa7\x1b\x89L\xc0s\x1cYs\xcc\xd6\xd5\xd2$\x8d\x92жG\xa7\x03\x96\xf7O\x91\xa7\xe9\x06](\xf8\x0f\xde\n\xacPL\xf88A\xa25\x88\xdeP,\xe1\x1c4\x03Q\x9d\xce/\x9a\x17\xe1\xee\xcc\xf2op\xef)\xf9i\n\x92sA\xc4\xf5\xfb\x97\x94\xf8\x17~\xfe\xc9ϫ\x84\xa9\xbe\xfcj\xf7?\xd8NLC\xf2lJ.!\xf8S\xc9m\x1b)S\xbd\xa9C\f\r-y\xedˊ9I\xe2\xe8\xcd0-\xdaJ\xab>\xce\xe0\xc0\xfeYwHʺ\xbeR\xab/\x80\v\xd1Th\xb7\xba\x9b\xc5]G\x1a\x02\u05cd舺\xe6\xfcB\xe6\xe2̌2\"\xd9ƅ\xa4\xf5\xd0\"w\xfa#\x8b\xa3\fo\x15\xae%\x86\xc3\xc5l\x15\xaeu\xd1\b\xc5î(A\xbcw\xc5/K \xce\xf4e\x8a8\x11Y\x85F\b\x94\xf0\x0e\a7\xe5To\x05\x1dwȦ\xb8\x0es\xb4\xc6\t\xf2|\b\x0f{\xf9C\xc0{\xe1\x10\xe9\x80\xf9\xb0\xf3<.\xb4\xdf9\x13#\x1e\x9f\xc1U\a\x81\x8a[\xfa\xe7_D\x8f\xf2\xd1\xcb\xd1`a\xfb<Y\x8cekä鍑\xe9$F8ꓵ=x4\xa6\xd6\xf8\xdc8\xf9\xd0oN\xa3\xfa\xd9el\xf3\aK6Qi\xecP\xf8\xf2\"fZ\xf4q%U\x9aL\xd2N\x01f@\xa0\aW\xf4\x17\xa9=\xba\xaaR@\x87\xe3\xe6\x1d\xe7\\\xf4\x02ԙ\xfbpY\xe9r渶أ\x8d\x1d\xc7!\xb3}\xf6HЮ#e\xeb\x18Y\xd6v\xd3\xe0\x91U_\xe3\x1c\xd9Y\xa3\xae_\xe505+\xaeO\x89e\xd5\x0eQ\xb5\xean\x1e\x13;+w\x9e\x06\xdb\nE\x9c\x02K\xe2\x1e胺y\xf0+i\\\xb3b\xb5\xa9\x98c\x15C\xef\x11\x99\xe1/\x04\xb0\x1c\xeb\xfa\\*\xa0;\xa3_\xc8\xe2|\a'f2^\x0e\x0f5\rR%\xacyFU\\5)\xb5\x9d\x1biWX\xfbJ\r\xa3<\x8b\xc2Jl\x89\x91lQJǌ\x91U\xf7\xae\xe4x9\xd1vMj\xc4Gк\xb6\xe8Xڑ\xadk!<\xb7\x90\x88\x8a\x15n\xe69\xd6ur\xe6\xbc\x11\v\x05\xd3\x18k\xbb:hB\xe6X\x11\xd0\xee\xdf0u\x88s=\xddE\xa2ԣ۵\xfbO8V\x8e\x84\xc92ߚ}\xa9\xf9\xca\x06tI\xa9\xf3ܑv\x81\xfa\x8d1\xdbZ\xe8\xe6t\xdd^\xe1\xd8K\xb5\x9ei\xa9\xdc\x03x\xe4j\v\xa2\xf5\xa8܀\x03\xa29\x94\xd7=\x9b\xc1\xf7\xd9\"\xc9\xc8?\xaeZ\x16\xf9-\xad\f\xbfAW?\xaf\x97\xf0,\xc6/\xc2\xf4\xb4\xa0\xab\x92\v(D\xd7\xe2P\x1f\x93\xe8\xfa\xcb\x15+^\x93#\xb5\xba\xecHl\xf1\xb5\x83k\x8e\x89S\xfdh\x06ל\x7f\xea'\xe3?k\xf9\xa3$\x8a\xee#ߢ\x7f+\x99\x00}Q\x80\xc7\xe6}A\x98|N!\xef\xe5\xb7p\x84~\xe5\\r\xf6\x95\x7f\xdeZ\xfe\x81}\x8b\xcb\x10\v\x1e\xb5\xc7\x10\fk|\x97Â\xad\xd3\x10\x1f\xc7=~#\xe0\xdf\x14,\x80\xdeo\xbf\xfd\xf7/z\xbe\xbdi\xaeA`\x06G\x17\xbf\xfd\xf6\xdb\x7f\xfc\xf6\xdf\x7f{\xf4[\xff\xb7\xf3\xdf\xfe\xf8\xed\xe2\xb7\xcb\u07fc\xdf\xfc߆\xbf\xfd\xfeۇˣ+I\x87\xb8AU\xdf[\t \xb3\b\xc9\xe0\\\x18h\x11\x98\x1e\x8e%\xf3\x19\xa5\xad\xf3[o\x8c\xfb>\x9fސ\xcd`j\xc0\x1a\xdag\xbb4\fL\x9b\"E;\u008a\xb9\x9f\x9d+\xd8;\xb1\xf1\xf8\xeb\x8f?|WU\xebW\xec\xddFM\xbd\xed\xa3Eₙ@\xbe\xe2W\x91(zr\x1dډR\xbf\xf8\x02\xab\x19\xe67\xac(\x92\x98\xfd\x98\xac\xc8A\xd2w\xa6R\x11կ\xef\x86\xf9\x9ae^\xef_\xbf\xf9\xb5\x17\x00\xb5\x85\x82\xf9\xd6\xe4\x118A\x96\xac\x12\xc4\x7f\xc7\u0098\x15^\xef\xcb(b\xeb\xaaǉ\xd3qf\x05\vc\x8c\xad\\\xb1hi\xeb\x0eS;\"<A\xbf\xae\xe8\x16\xe9l\x06'5Dm\xf7&\n\xaa\xb0ڔ\x8e\xb0\xf2\x0fKɄ\x82\x95\xeb<+\x19\x1e\x9f\x94\xb8\x95\x98\x8cF\x98W\"[G#\x9e\x8c\x95\x1c\x8fNp\xcb\xca\xde\x01\x0f\xd1\xe7\x8e\xd5\xccۍa~%\x88\xb2\xf2\xf2\x18\xea\x87ȁ\x84\x01j'\xb6\xd8\x11\x9d\x10)\xb1\xda\xf0+\xdbV\xc6\x05\xb0\xfd\xca\xd5\x12\x9b3\x15^{e>\xf3\xf5A8ˢ4\xeb\xa4\x13\x85F;ߖy\xd6h\xa7\xddB\xc1\x0f\x9e\xd9\xc3\xc9;\x89\xc8\v\xfa\b\x8b\xf7\xb4\xdbV\b\xe6h=&\xc39\xfco\xaf\x7f\xfeiȣ\xd0p@\xa3o>\x18\x84-\xabUzG\xc2\xf0\xe7\x11\x96k\xa7\bY\x8c)\xae\xd3Q}\x0e\xb0\xbc\xcc_a\xb2q\xe8\x83\tÒ\xa5,\xaa(\x8e\xa4*0ϵ\xf7\a\x00\x84\x1cqx\x8e\xece\x9ea\xea&L\xbf-\xc2+,ĉ\xb4w\x95\x06\xf7\x9c<\xda:X\xf4ie\xf4\xaf?\xfe\xf0\x8f\x15Q1od\xe5/ҏ\x90WT\xde\\M\xa1\xb7\xac\xaa\xf5\xf4\xe8\xe8\xf6\xf6vx{<̋\xab\xa3\xc9h4:*o\xaez|%\xbeEqp\x02\x8e\x9f={v\xb4\xe5\xd2\xc2A\xd3$\xbb\xee\x00\xc5l\tڂ\xf3\xaf?\xfe\x80\xc0O\x8f(\xa4\xdc:\x8cX] +\xdb\xe9\xa5죞nЫ\xdf2\xe5n\x91S\x9d\v\x1c\xe9\xbbM\x98&\v\xfd\x99\x01=$cma\xc3\xd4!-\xed\x7f^x\xbdi\xcf\x0f@9eb\x9e\xbeRK\x1aO\xe7\x18\xa0fp\xc7D\x93s\xccm\x02%\xda}\x91\xc6\xed9\xadA\xc3eX\xfe|\x9b\xa9K\x1b\xbcR\x1f\xce\xf5E\"\xf2\xd3`\xc3\x05\a\xd3^d\xa5k\xccS\xa2B\xd6\tS\xad\x91\xfa\x81l\x9c\x94k\xba]\xe0\x98\xbbH\xea\xea|aX\x94)\xf6\xe6\xc2u~n\xef\x18d\xd9\v\x87\xeb\xa8\xcc{\xc3n\xe8.\x9c\xf8\xb4\xee\x01\x89T\xdd\x1d_\xa6i\x1b,S\x9bWt?%Mʊe\xac\xb0-\xe1T@\t\xc6\x10\x05Ctc\xafW\xaf\x10\x12\xd3*/ \xa8l[G\x8bk3\x16\x8c&1\xd6~\xa4\xb9;\x80s\xeeo\x8c(.\x87J\xb4\xa7\x8eT\xad}\xban\\4\xeeV\x96k\x16%\x8b\xc4\xe4\xc3Jt1\xfa\x15S\x897\x85\f+\xa3\xc0\x03\xc0\b%d!\xc5\x1e\x1c_\xe22\xa3\a\xbd\x00\xca\xe4*S\xe9\xc7<\x1d\x03\xa6\x18\xf0\xa7\x97\x01\xdc&1\x9e\x1d@\x9f'=\xbe\f \xcaW\xabP\x01=\xb9\xa4Q\x19%x\xe1F\xa5>\xbd\f$3y\xc23\xb4\xea\xe1>\x84{2\x97\x9b\x85p\xae\xee\x05 \xb6)\xd8\xe40-Y݃\n\xafoT\xd1W\x1f\x86\xa7w]\x8e\x9a\xa1\x9d\x04\xf3F\xf5F=#\x0e*6\xc3\x17\r\x1c\x18'\xf0\x9eH\x841\xae\xbaO\x8c\xbe/o\x13\xba٣_\x99\x81(,\x19\xf4\xb2\xdeT\xce%\x82E\xb8f\xb5d\xabw\xa5\xa8\xd0\x03Qs\f\xff\xa20(^\x8d\xd4.\xb2\xe6ٿ\xf4l\xa4\x8b\x0e\xa4\xeb\xfb\"-:\x90\xc6\ni\xdd{zk\xf5\xfe\x1a\xb5\xa3)\x1b\xb4\r\xc6\a\x91Q\xcf\xea\x1c\x8c\xe0p\x11\xf0P\x13\x1a\x9b\xe5\xe2\xbb\x1e2\x98P\xd2I\t\xef\xce?\xfe\xb02\xbff\x8bp\x93Vg\xce{\xaf\xd6}\v\xd29\x82\x19_|!\xec)\xff\x822$\n\xf6z\xfaAAƮ(&\x90<\xb6\xc1\x1d5\x96\xe3h\x91\x13\x02\xbf\fi\x84cV\x17`\xce1\xeb\xbd1~qK\xcc|J\x9b\xf0i\xc7S\x97\xf0$\x87,C\f\xdd\xe5\xa2i\x8dp[\x16\x19MX$\xd4n5\xcf\xd3Vk\x10\xc7\xf9h\xa6\aN\xb0\x0e\x7f\x84\xb2m\xa7\xcc1\x9cy#\xe5\xa9&\xc7#5p_\xb1\xb5qX\x8f\x98\x94\x9e\xa6\x01\xee+\x1a\xbc\xda\x11D\x0e}\x85p\xec\x8b远\x8a\xbe~\xc1\xca\xd2%\x12]-CWE\xbeY7\xf8(\xe1$\xad6R\xa7]\xed\x13\xd7\xd1\xc1\xc5\xcfͳ\x0f\x8e\x8bQ\xd0\x17\xd2u\xe6vZPs\x1c\x9a_\xbc\xf3\xa9w\xf1\x1f\xbf_\xfa\xe7\xde\xc5\xf3\x17\xb3\xff\xb8\xf4\xf1W\xff\xb7\x01`ҟ\xfcso\x84\t\xa3\xc1\xb3˾\x7f\xee\x05\xfe\xb9\xf7\xdbP}^\x84\x83\xf7_\x0e\xfe\xfd_.\xfd\xf3\xa3f\r\xa4\x12\x84\x1fR\xb8\x16v\xf2\xab\xa9a\xc3Y7\x8e\x890\x10\xea/Rx=\xf9~\x84\xbc\xe6uH\xe9o\xb6\xeb<cY\x95\x84\xa9]~qH\xf9o\x93-\x8b\xed\x92ž\x92\xd2HE\xb9\x86T\xa9\xa1\xc8\v\xfa\xaa\n\x9a'W\xe1\x16\x97E\xfcw\x92y\x93\x11\x01\xe91\xe6\xfd\x06o\xb9\x16\xe26d\xe8\xe1\xc5\xe6\xde{\xfc'\xc4\x7f\x16\xf8\xcf\x1a\xff\xc1}y\xef\xff\xf7\xff\xc5\x7fW\xf8\x0f\xfe\xff5\xfe\xf3#\xfe\xf3\xaf\xf8\x0f\x1a\x85z\xbf\xe0?\xdf\xe0?\xff\x8e\xff\xfc\xad\a\x972b\x87^\x9fo\xac\xab\xd4n\xcc\xd4\xe2\xba\xea\xb1\xdd.\xeau\x85C\xe1+\xd5\xed\xd7\xean`ؐ5\xc4\xf5\xd8\x15\\\x17u\xbb\xb8\xde \xabV\x898\x83C__\xb0\xe0S\xc1\x13\xe8\xd7N\xb6\x82\xd2#\x9e\xf2\xc3O\xe3\x91Q\\\xf5\xe1`r\xa2\xf7\xe2I\xa0c\xc5\xf0\xf33\x8a\xa9G\xabd\x98B\"\xd7D\xc7\xe8\xbex\xec\xbbVō\xee\xbex\n}H\xb0Х=\xaaYX2zx\x00f\xeas\x9d\xa7;o\xe2\a*!\xda̓\xc8\x068\xd6\x00b>Iw\xdc̗\x90I\x8c#\xacڹ\xe8\xb0\xc7<\x0f\x1a2\xb5k\xe1\x03\vi\x98\x1a\x14\xa9\xadm<\xed\x8em\xa2\xdal\x8cTj\xe4!%\t\xd0(Z&\xd9!\x05\xcb$3\x8a\xb1\xed\xfa\x90bl\xbb6\x8aEI\x11\xa5\xec J\tҬ\x13}\x9d\xb0\x9d\n;O\xe0\xb9h}\xa9\xb3\xf0K\xa4\xe7\x9b,:\xa8J\x0e٢\x84\bb\x95Ǎ\x9e\xee%Yoڐ\x11^w\xbe\xa9ꬂa`\x01\xf1\xb2J/\xc9\x06\xf9\xa6\xea\xe9\xd9\v4\xab\x89\xec|S\r\b\xb1\xf3\xf8ۤ[\x94\xf4\xac\x8a\xbcES\xa3\xe2\x89ph\xfa\xf0\xea\xd6\x13\xb7\xedd\x80[\xe4\nf\xc0\xcd$p\xee4\x8a\b\x8b\x03\xf7\xc3m\x83\xe4\x1bf\x98\x12\xd7Ĳ\xba\x1e\x9c|--\x17\xd2\xfa\xb8\xe1\xa0+\x98\x19]A\xf0+\x03^\x1f\xa3M\x99\xc2\xd0<\xdeʓ'\xb1\xf4\xca\x7f㰶L\x93\x88I\xaf5\xe5G<\xf6\xc5\x04\xa5N\xd6o\xf8\xf5!iۓ>Ҩ\xbe\xccCEl\xb9\xce᫦\xa5UD\x85\xf8\xe9\xb5GV\xb0\xa1\\\"\xdf\\\x05\xb8\x011m\"\xdeaU\x01\\\xe1\xe9ƗUU$\xf3Mż\x9e*ԓ!\\}}O\x81\xad\xb9\xaa1\x0f\xe7a\xc9\xfe\x82\xf1\x05\xf3\xac\xcc\xd3$\x0e+\xe65=Px\xc0:Yȫ\xe0\x1c\xaa!w\xf9\x86\xa9\x91\xf7\xbd\xe8\x1be\xd4\xf54\"\xa4q\xa7\xae\xbe\xee\x11\x19\xa3\xbdCG\xf3\xb6\xa5Hb\x0f\xfa\xfc\x9e\xbbJ\xa2W7\x8a\xbc2r\xf97e\x95\xd7\xec\xf6\xafu\x0e~\xf2t\xda\xc7\xd4\xe9\xf8I\x19={\nPT\x7fͮ\nF\x8b\x94\xf1ӑ\x9cA\x7f\xf9>p2B\x19;\xc3)\x8c\x85\xb6\x9a\u0088\xff\x8aԯX\xe52\x95\xb6\x98\xc2H\x13F-\x06U\xdb-g9\xb6\r輨o\x82/\f\x13\xde` \xc60\ue2bd\x85\xa3\xe0Er\xc9+\xf0}0\xb7\xb8gn\xcax\xb8\xf46\xfa\xe6hF\t\xdd{媡\xfbB\xe8\xc3\x1c\x1eAe\xaf\xf8\xcd*_\xd9g\xa8\xf7\xafQ;:U\x95\xfbݵ7\xa5\xd6\xee\x90U\x00I\x00o\x03(G\xdcG\xb7\x1c\x8b\xbf\xe2D\xfe\x9d\xf8\x9b\x05\xd2\xfb0>~\xa3U!\xae1\xbb\xc2G\x93\x1b\x0f_\x83J\xcd\xe9(HF\xbf\xb9o=\x87Kg\xa4|\x06\xf0AxX\xcc5=^JR%\x90\xd2\v\xefl\xb7\xc3d\nJ\xc6T\xe2vJa\xe6\x1a~\x7f\xa2*\xe3\xe8\xb2\x1c\xc1l_\xabm\xb3N9\x82\xe70W^$\xce\x16\xf8\xbe\xcd'n\xd9~'\x8a\x9d\x81\xb7\x87m\xa1\xef\xe3\xf0H\xf8\xbd\x0f\x83\x819\"\xd2.z Q\xf9p\v3\x1e_\xcf\xf6\xc1͇\x8e\xb7\x88ˋ|\xc8\x1f\x1e\x96w&M\x18\x00\x0eA~{\xfd\x19\xe4\xf6\xb3\xf5\xea\xb9\xe6|\x98\x98\x91|U\xbb\xdf\xd2dM\xb7)\xde\xcaf\xbc\xf5\xe1\xdd\xc5\xdb\xcba2\x18\xec}ԺA\x01\xf4\xa1\xa6\xbb\x8b\x9cɝ\xc9\xc1\xe1:9;\xf4\xc1\xfb;p\xf0\x12\x1a\xccko\xec%ܥ\x9d@w\xb4>\x9a\xf5\x8e\v\x1b\xef\x9coq\x03dzQ\rO\xa3E$\x8eN\x15\xed\xf1WN\xd2<\xac\xbc\x15\x7fpCKɇ\xdapם\x93\xdcώ\xd1H\x18\xae\xf3\xb5g\x98庻\xc6\xd5-\x8d\x06\x1c\xd0\x1d]]\xf1\xe1\x81\xc50M}\xb4\\/\x94\xee?\x17#E5\x9c\xc3;\f\u0d45\uedf4\xe7\x8eK\x92\x9dӎ\xae\xbeu\rS^xR\xb9\xf8\xaa\xf9^\xd5\b7+\x1e\x1d\xeb홣~u-$\xedi\xca1\x1b}\xc9e\xa7^\xf8\x85~\x00_ىs\xdc9\x840\x83/\xeb\xd5X\x00\xd5\x1cf\xf0\x95\x9eRp\x18\xbe&\v\xa0\xe0\x00\xf2\xf3\x96\xe7\xe2\xba,\x80[\x9e\xc7?\xaeE\x0e\x8f\xd4y-\xb2j\x831\x99\xf8C쮇3\xa8\xe6\xf8\xe3\x8f?\xa0\n/\xc62e\xac\xe9b1M\xe8k\xc9\x00\xf8&\xa1\x17\xd4?\xfd^\xe7l76\xa69\xe7\xf8\"\x92\x02N\x90\xaf\xae\xa6\x81\x81\xe6\xf8 4c\x8ef\\\xa3\xf1\x8d+\xad\xc4\x00\xd5\xee\xbdͅ>vN_k\xa35\xead\x99^\xc3?\xa2\xa0\xcb\xfc\xc5\xdcr\x84\na\x80\xdd\xf9\x02\xd7\xc3>\xfe\xea\xcf\xe0\xf8\xf1H\xf3\xf6*\xe6\b\x12*\x90P\x82t\xae((\xad\xe4z\x05\t\x96\xcb{\xbd\x97`\x00\x93\xfd\\,B\x14\xb8\x0e\xfe\xe9mj\xaf\x18\xfa\xd4:\x9du\x8a7\xb7ě[\r\xcfa\x8d\x12\x1b\x93\xbb\xb7\xe96ā\xd2Ѧ\xdb\xce6\xd5\x1b\xa2۶&]\xcbQu-\xa5\xebZ\x8e\xaakS\xcc2\x98\xb9\xaa\xe0{\xab{\x8c\xaf\f\x06p\xb2\x9f\x05\xd7|\x8c]w\x8d\xb1\xec0n^\xf3qv\xbdg\x9cQUȀ1\xb1c.\xd81\xee\xe4\xb4\xdab^\xbb8m\xacG\xf7\xcd\x18\xfaݲ\xbc\xed\xee\xd7'\x9e>^]\xcd\xdb&\x8ePت\xaf\xe6^(\xb0\xcc뤹\xe6\x82\x1c\x16tA\xb6\b \xbc\xa2_W\x01\x84s\xfa5\x0f`\x8e\xb9\xf3a\x01\x03\b\x8b\x00\xe6W\xf4y\x85\x9fW\x01\xcc\xe7\xf4\x89:$\x9c\x1f\xba\x97\xeb\xfd\xa9\xc7_b)\xae\xe6o\x96l\xeb電\x02\xfaX'n\xef\xfcv\xa8+\x84\xba\xda\a\x85}:\xe7[\xc5=\x8c\xfc\xaeL\xf70rY\xa66#1Ig\xe4rDL[\x8a\xfdd8,\x03H\xf9\xaf4\x80\xe5\x98X\x85gmK\xb9\x7f\x9b\x0fK\x18\x00n\xe7R\xfe\x99\xc2\x00R\xed\xd4b9\x96\x8ay9\x86\x01W̵\xc8/\xc7\xf0\x1c\x062[\xd7\xdb\xfb;!>~\xb3,Sd\x9b\xb7\x1cA\x1f\x11<\x82\x8aH\xefC)>R\xfcH\xe9\x83\xc6K\xaf\x9b\x8b?\x84\xfb\xc41\r\x1b\xe2\x88I\x868\xa6\x92caH\xbfBC\x1cSŧ0\r`\x1e\xd2'NsaxOq\x8c\x8fߤ\xe1\x9c8\x11\xa6(1)o|H\x86\x86P|h\xb2t\x00'\xbe\x8b\xf6\xcaSԔ\xa7Ȕ\xa7p)\xe5)\xa4(E\xc3(и3_*y\n\x97\x01\xcc#\xfa\x8c\xf03\xb2\xf8T\xcb\xd3|)\xe5i\xbel\xca\xd3|\xa9\xe4i\xbe\xbc\x87<E\xe9\x1b\xea\xe0%\xf2j)\x18\x17\xe1G$>j\xfe\x1e\xc2\xc5/E\xf0\xb8֕\xf1V\xac\x88#\xf17\v\xb5\xbb\xfe\x01d\\\x1c\xd4\xe7H\x1d\x9fa\x94\n\x95.!\xfc\x00\x92\xa6\xf1\x864\xf7H,\xff\xb7\xe2*\xa0A\xa6\x17ҕ\x9b9]\xca\xd5\x10\x88\xb2\xa1(\x1b\x89[O\xca.\xa1\x03\xcdM\xa0\xb9\x02\xba\xcb\x1eed\"٢\x8d\xb01\xb7D\xdd<\xffY\xde3\xee4c\xfe\xfe\x813\x1d\xff^k\xad\xb9\x86$\x83\xd0\\\x84R\xda\xdc\xd7\xe7\xfe\x8b\xebˆm\xe7\xab\xddO\xe1\x8ay\u05fe\x17^\\\x13;\xaf\xeb\xfbǍ\xddg\xc4Q \xa8k3\\\x13c\xad\x88\x1fJ\x12\xfd&\xb2y\v\xb2}]@\x18\x13_\xa2\xc1\xd6\x1d\xc0va\xbbnZ\xb7\xe8\x92ʠ\x7fy\xee\x9dO\x7f\x8b\xfb\xbf\r\xcf\x7f\x8b\x1f\xfdA\x7f\xfa\xbew>\xbd`\xdf\\R>~\x9f\xab\v+\x86u\x18ǃ\xb3c\x83=A8\x93\xac\xac\xc2,b\xf9\x82\x9f\xccP\xb8\xe0\xe6\xa0䅩-\xdd\x18\xe5\xeb#!m\xe6{\xdc\xfcף\xed\x10Ϙ\x1b\x19~\xb3>\xf1\xb2Z(\"\x88\xd3:\xadw\x97\xaa\xcd\x1a(\xb0$\xad\x16\xf8c\xdd˰\xf4\xe6t\x88u\xf4\x1fޟ\xfe(\xae\xe6\xbfy\x7f,\xcb\xf47\xcf?\x1aV\xac\xac0wjr&>~\xf32O\xf3\xc2A\xed\xab\xab9\xa7\xe5\x00\x02\x8d\xd0H\x84K\xa4\xcc1e\xee\xc0.V\xc4u\x05<\"\x9a\xa4\xe7n\x876\xfc\x1c\xe6j\xeeժX]\xc22וE\x00WM\xf2\xddQ*\xe0\x1c\n\x8bW\xb8J\x95\\\xf7\n\\h\x16\xb8\xc6,\x86\xc4Y\xd1\x1b\xfc\x86F\x8f6r\x81H\f\xb4UJ\r\xea\xfd\xe7\x7f\x16\x01\xfc\xe7\x7f^\xe1?\xf3\xe6m\xaf\xab\xf9/Z\x18}^\xffP\x8f\xac/N͈e\x82}z\xa1ᜢ\x9b\x98g%\xeaRõ~\xbfk\xf8$h\xb2\xe1\x1c\xaea\xaa,]H\x15b\xe2\xdc\x0e\xe0J\xfe\xbe\xe2\xc18\xe8\xf7\x9c{w\x1fk+\xbf\x87\xe4\x88\xf8\xf0\x8a\xfe\x9d\xfb\xda4\x8b,H\xe8\xe4\"\xd1\xfcf\t\xbc\x80\xe7\x90\xf8T_Rg\x11\x8e+\x9euef́\xe26Rּ\xce2+\xab}LNO\r'\x93\x82\xee\xf3\xf9\x01\xb4B\\텘s\b\xe3\x14\xd2\xe8\x8d8,\xae?Q_8\x9aŉ\xb8\x86G\xbc#\n?\x00W\xfaUK\xfa\xbc\x83𥹧h\xba\xb5Ж\xa5L=)\x1aR,\x04\xe6Vć\x1c\xc8\xda[,\xd1:G\xe2\x95+\xd19\xac\xb8ƴ=0B\xb4\xf0\xce\xe9\xe6y\xefO\x8b\xd1\xe2\xe9b!.\xa0\x84Y\x95\xbc۰\xdbeR\xf1̐\xcd\xe3'2\xf3\xdd&\xc4\xc4\xd1h\xb1\xa8K\xbcۄ\xab\xb0H2\x82\x7f\xb2X,\xe2\x13\x99\xf5~S\xc8*\xea\x02s\x96\\\xf1\xd4\xd3\xc5i\x1c\xc9Ԥ|'\xe8Y\xb0\x93H\xa2\x98\xa7䤂u\xe2\x7fuj\x16-Y\x1c\xa6\xab<\x8bE\xa1y\x14\xabl\x8e\tK\xd4զ\x1bv\x93\xe4)\xab0\xebi8\x99\xb3\x89\xcc*\xf2\xdb\fS\xc3\xd3I8\te\xea\xa6Hw\xb7yN\xf8c6\x7f\xfaT\xf2!\ncV\xc9JN\x17\xcfX(\tØ\xe4U\xc16\xa5bƨ\xce\xca#\x9a\x10\b\xdd\xe4\U00073c7c\xf4\x13\xe5E\x98\xf2F<Y\x9c\x8e\xea\xd4l\x91\xe2C\xaf\xb2\xa6\xc7'\xcfNY\xace\x97Iz\xcd\xcb-\x9e*FFE\xb2*\xe9-\x8e?\xc5\xd1\xf8\xe4X\xa5\xef¬\xd1y8Pun=\x9dk\x19u\x89\xa7s#\xe3*Oc\x96\x15\x9c3\xf3\xa7O\x1f\x8f\x8c\xdc\"\xdc\x113\x9f\xe1\xff\x8c\f\xc6\x04\xc2\xc7'\xa3\x91\x99\xe3.r\xbd\f\xaf\x13\xaa%\x9e?y\xacײ\n\xafXV\x918>\x9d[\x84\xe7ir\xc3Tm\xa7\xa7\x8f\xe7\x13\xbd\xc19]\xb2\xe3\x8c{\x1a\x19\x84\xe4E\xb4L\xa8YϞ\x1dO\xa2H\xcb*X,+3\x8a\x94$\x84\x98Ş={\xfc$ԳX\xa8\xa8x\xba\x98GOu*\xc8~,y\x7f\xf2\xf48~:\xb7s%''\x8b\x93\xc5ɢ\x99\xcbܹզx\xb7ɓR\xf4j\xc4ⱖ[\x8f\x81g'\xa3Q|,\xb3\x18[\xaf\xf9Ŷ?-\x16\xe3\x93gzFy\xbd\xab\xa5d\xae\x89O\xb2\x924>~\x86\xff\xd3ә#=\x8f\xafjy\x1e\xb3g\xf5\xf0\\$\x05\x9b\x17\t\x1f\xec\xf3\t\xfe'sR\x1c\x1f\xb5FZ,\u0085\xec\x80E^\xb0\xb2RL\x9eL\x9e\xce\xebr\x9bhY&!/\xa3)\x82\xab0\xc9\xcay^\xe4|\x88\xe0\xffd\xce2/\xab\xba\xa2\xa7\x9a^D\x91\xe7\x98\xe2'\xa3\x91\x96\xa8\xc6A\x1c\x86\xa7\x13\x95#\xb8\xf2t\x84\xffS\x89J\xfe\x9f\x8eFFꎥi~\x8bya\xbcX(i\x95L4\xd0,\xf3\x8c\xedbv\xab\x14k\x9dQ\xd5=\xf8\xf8\xd9\\\xea\xd0$\xc3(\xf1B~\xa3\xf84:\x8d\xb4\x9c+\xe2\xc3\t\x8e!ɹ\xe4&/v\x82\xd75z5\x16\x17#\xf6\xf8\xa9D\x91\x867,\x8bY\x81\x19\xec1{\xbc\b\xad\x8cy\xba)\x97\x02\xd9hq\xaaro3ŏ'Ѣ\x1e\x86)[\xe5Y\xb4L\x16\x8b<\x93\xfd\xad4{\x8a\xab<)?a\x1c?e\x8f\xf5\x9cZ\x93\xea\f\xe3YB\x991]\xfdQ\x8e\xeaź\x13\x16\xe1\"\x8c'\x06\x90\xe8\xd0\xf8\x18\xffg\xe6\x88V<\x1b1\xf6lde\xb5\x14\xaa\xfbi\xfe8\x1a\xeb9\xb5:Y,\xc2ѓ\xd0\xc8\xd3\xf4\xc9d4\x9f\x84fn=H\x9f>\x89\xd8\xc2\xcc\xd4\xf5ɓ'O\x9f>{\xe6\xc8fm\xd9\x15c\xa9D>\x1fE'1ӳ5\xc6-\x16\vV\xf3`\xc5\xe4\x8c3\xd2\x13U#\x8e'Q|\\\xf39\xe3\xa98\xbeU\xbfjz\xde\x18ū\xb0\xc89\x9f\x9e\xea+\x03|\x11a\xb32\xd7$\x8f\x1fGq\x18\x1a\x00\xfa\x9c\x17\xc5FV=\x05\xcc\xc3\xd3\xd3\xf8\xd8\xc8\\o\x8auJ%\x9f\x1d?\x19\xc5s#S\xef\x9e\xe3h~\xfcdlf\xeb\n\xff\xc9\xfc\xf1S\xc6\xcc\xfc5.\x105%\xb1\b\x9f\x99T\x1b\x8a\xfd\xe4i<\x8e\"#\x9f\xabv9П\x8cO\x9f\xca\xe1\xb6J\xe2L\x1f:\xe3g\xe3gO\x14ϒ\xacB\xa7ʕX\x94-\x94謒\xb2\xda\x15y\xa9\xd6eL5)\x8f\xa2\x90<\xa1y\xc6\\֔\x857\xe1\xdb\\\xd3\xd61\v\xe3:o\xa7V\x1a\xb2\xf6<\x8dS\xba\xb0\xdb\xfb\xd3\"^\x9c\xaa\x8e\xa7I\\*\xbf\xd1HO\x8d\x8bpN\x1d;\x7f\xca&\xb2\x83\xf4Y=<\x1d\x8d\x8cd\xc1\x93\xc5\xe2Dϑ\x1d\x1d\x87OF\xb1\xacw\x1d\xa6\xccP쌱\xa7a\xa8\xe7\xaa\x11\xfft1\x7f\xf6T\xcb1:(\\0Ƙ\x96ktO<\x7f2Rs\xec:\\\x87\xbb\xf0v\x99\xac\x05?\x17\xb1\xe4皅\xd1r\xbdY,\x047\xc3\xf93\x95Sl\xb8F\x7fzz,\x87E\xadX\xa2Q$\xc5s\x9dn\xa8o\xe38\x1cŲ3\xd6\xf9m\\\xcf\xc5\xf3\x11\xab\xc7\\-\xe5O\xf5\x9eRL\xd4\xc6[\x91\x97;\xb5|\xc6\x15\x8eZ\xe3\x14\xf9.T:\xe3d\xfc\xf8\x99\x92\x9d2\x8c㔩RO\xe7'\xa7\xe3c\x95\xa7\xd4_\xf8t\xf4d\xa2\x92\xb3\xb8\xaegq\x12\x9e<\x96\x14\x18J\x91=\x9d\x9f>\xa93\xca%K\xc5\xcazq\xaa\xfa\xa2LX\x96\x91F\tG\xa7\x93I\xac\x92\xd3\x1b>\x8fE#\xfc\x9fL6\xd5*\x93L5\x86\xf3\xe3\U000347a4L];z:z62s\x98#'S\n4Tï\xa9\x11\x16O${\r\x95|\xf2\xf8\xe9DM\xf7\x15\x9f\xe8\xe2\xc9\xfcD\xcd\xd2\x15\xe3\xf3\xe2H\x9f\x17q\xd7X\xf1~\x8e\x9f\xce\x17\xb1\x94\xe4*_\x85U.\x96\x11\xc7'\x92\x9f\xa6\xf6\x19\xb1Q,\x11\xd5KJƞN\x14\x9fo\x97,\xac\xb8N\x89\xd9\xfcX\xa5\xd6\v\xb9z&\xa6\xd4r\x95_\xab\x9d\xa1Z*\x98\xb3\xcbhd$\xd7c1\xa4\xa9D\xf3\xdb\xd7\f\x86\a\xbc\xdfe\xc0\xab\xf7\xee\fCW}{ȶs\xf9F\xc8^ˈ\x80g\x8b\x01\xa4\x87Z▖%\x0e\x8f9\xcfEe\xde\x12O\x96\x96xH\xb9\x1c\xa6nK\xdc2\xd0\xec\x14\x92J\x01\x8a(\xfa\xcb\x00\xfae\x00\xfd\xb4a/X\x96\xa9e\x86\xfb\xaeL\xf7\x99\xe1\xf4B\x9f\xd6\fg\x9czr\x1b\xc7RXZJ\xf17Uѩ>\xb8\xc8\xf9,v(\x171ʸ\x94\xb6\x13c\x19h\x9b\xb6%y\xb6\xebn\xa9\x19\xf4&\xb2E,\xfa\x18\x11\x8b\xa4\x88EJ\xc4\"%b64\x9e\x18\x9f\xcb\x13X^\x02\x8f\xca\xf1\xccw\xa9\xcc\xc32\xcf\x131\x13P\x1e\xf1\x9cQ|\x93\r{\xe9\xfbhB[\x0e\xafxI߁g\x19\t\x89\x8d\xdc\x12\x1b5$6\xda/\xb1ѡ\x12k\x9c\x93\x9a\xfd\x12i\xf6\xd1\xf1h\xa4\xe4\xb1/[\xff?\xe0\x11x\xadBe\xda \x97\xd1aB{\b=\xfc\xa6\xa8\xa0f\xf0\xd1\xd4\xec\x97Zq\x82lS#\xa4\x96\x9fV\x98\xf1\xb7\r\x8c)\x8fq}\xa8\xec\xa6\x1d\xd2\xe8\xa5(A)JP\xca%(\xed\x90t\xbd\x04\x8e\x9e\xe1RS\xa8$\xabi-\xab)\x97\xd5tx\xa5p\x8b:\xfbi\x00\xfd0\xc0\xc3\x1f[:9\xe3\xf1b\x8b\x95\xf8W\x98\xc1\xf0\xd9\xe9\xe8\xe4I \x93\xfe\x06\x14\xa8C|\xfd;~\rGO\x9f>=6\x8bZ\xd2\xfeC\xb8\xf7\x98D/t\xa8\xb4c\xbb>J\xbaE\x81\xd0i\x187(:H\xdakz\xee%݇S\xb3Wڥ\xd7\t\xa7\xa0\x1d\xb1\xe81\x1e\xf8KGY:B\x12\x0e\xdfmX\xb1{M\xb0y\xe1\x95\xf2 R!\xf82M\xef\x86\xe3\xcb4u\xa0y\x95\xe7\x95~\x93\xcezaY\x83\xfc\x11\x83\xc80\xf1\x8ef]xH\xc1eX)\xab\x11\xf7\b5\x80[6\xbfN\xaa\x1f\xf7\x81\xad\xf2\xf7\xfbaʽ \xb9\x05\xd1h\x81\x11\xc67\v\xa0l\xf6\xa8\xd1`\x11\xb4>\x00\xc1\xbc\xb3\auГ|\x01\xaf\x93\xf7\xefS\x1e\x9c\xb0'\xd1\xf6\xb4\x15dww\xabZ9\x1a\x9e'\\A\xb5xgg&\xb6\x8e\xbe\xb7\x10\x0e7\x19\x9eݼƨ\xe2z\x15\xbe\x1bq\xcd!Q\xdc\xea\\\xeb\xadl^(\xc93]\x05]\\\xcaX\xbc2\xb7{\xf8(0\xec>#\x96\xaf\xcc0ԙ\xb3Z1n\x9b\x19C\a\xfbE[\f\xd7\xfc͜Bx\xa8\xe0\xe7\xe2\x9b~ey\xcc\x02\x10\xdf\xf8qf\x04\xbe\xc9\x17 Q\xc2CK\nT\x86I\xf7\x9bR\x8dkI\x8c\x154\xfa\xad\xf6\x1c\x0f\xd7+\xe26\x15\x7f \xc7xeG\x11/\xfcb\xc5'5\xa5\xbe\xff%R1\x84\"ޑ\xe5w\xb0=\t\x89u\\\xbc\xbd\xf4\xb5\xec3\xfb\x11\x1f=p\x19\xc7U\x13\x954_\xedA\xfed\xbc\x1a\x82\xa6\x97*\xeal\x9d$A\xb6\x80\x96<\x11\x03\x8f\xb8\x8f\xff\x0e\u07fc\x89\xc3*|\xf3\x86\xc2\"ۯ\xb8\xc8\xf2_|\x01=\t\u0603$\xa3\xa2\xbe\xecG\x85\x03f&\xce\xce7\x16LJ\x8d{u\xaew\x8c\x9dҭ\xfa\xc5\bX\xdd.\xb2\xf6\x18\xbf\x93\xd4~Z)\xa5\x99\xe3\x13\b\xaa*dI]\xf0\xd9D\xab9$\xe4\v\xff\xdeaRf\x88\x99{\fe\xdaX\xf9\f\xd2\x10VUaG\x1a\xb0\xec\x14\xfb\xc3dkR\x90Q\f>\xc3Wˌ\xea$\x18K\x9d\x89\xbf\xbdf\xa4F~\xbd^Đ$\x8a\x9ao\xdaa\xea\x90b*\xc29g\xec\x95v\x8d\xfe\xa7\xd7TlH\x81\x19\x03\r\x98\" \xd8\xd0f\x15\x1fLqJ7\x8c\x869\x82p\xaa\xd1<\xea\x19\x92\x8cL\x94\xb6\x1a\x04\xbc\xa0ߗ\xbe\xed݇\xc5\x1d7˺\xb0\xea\xfd\xb1\xaf/\xa34,K\x16\xff\x83\xbb\x93\x0f3O\x8f\xbaY\x15\xc9\xca\xf3y\xf4|\xef\xe8?\xfe\xf8\xad\xec\x1f]\xf9\xbe\xa8\xdc|A\xd0\f\xbb#\xd4(\xb5쇤\xac\xccq\xe8|\x03\x90\nb<\x84\nO]\x89\x0e\xfe\x98\xa0tЬ#\x10BǛ7F\xd5?i\xb1H\r\x02e\xfc\x85:h\xaf,ld\x9f\xed#\xda\xe8xя\xaf\x98\"\x9e\xbb\x18\n)hk\x88=D\xf4`}\xf7\x10jAŧ\x97k\x89\xf8.\xa2]V\xbb\x94\xb5\bv\x00\xeb\"\xc9\v\x8a\xa9o?\xe8\xee\x8a\x7f*\x1f\t=n\x97\xf8\x87-\x12\xaf=<*\xfb\xb9\x0e\xf9'\xb8+\x89\xd9\xcb`j\x93\x02\x17\x1c\x96\x9f\x97\x06gZ\xb8m>\xf3#\b\x13P\xb7I\x16編\xe7^\xe6\xab\xf5\xa6b\xf1k\xaa\xcf\x1c\xa9(\xb2\b#\xc3\xdb\xd2#0\xa6>T\xed\xa9\xdbzH/\xf3ֹ\xbbi_o\xaf\x055\x9fY\x93\xe9\r\xe0\x1c\xb9@\xa8˳\xbb\x0f\x16I\xf1\xa7\x1f-\n\xf3]\x86\x8b\x1d\"\xde`[{l\xdb1\x9c\xeb\xdc 4\x14\x12<\xab`\xaa\x11(X*\x86\x80\xb1܃s\xd7\rkz?G)E\x1e\xd8\a\xd1i6p\xc5%\xaaF\xafy\x86E\xd5E\xee^O\xbez\xca#(K\"d\xbe\xa3v\aF%\xc90=\xb0\x84\x16\xdf\xf0\xc3>\xf6\xdb\x01\xe2\xef\xc9\xfe$\xcbX\xf1ݯ?\xfe\xf0wf~]\xef\xa7a\xbd\x8e\xef\x10\xc6\x1b\xf5߁\xed\xe1zͲ\xb8-n\x96L\x04\x0e\xd6\f1Fu\xf3̗\xcb$\x8d\xbd\xd6\xf8O\xbc\x93d \xf5\x7f{\xf5=\x1f\xf0f\xb0<\xab\xbe\x9f^\x7fL\x8d-\xabY\xa3\xc2}\xabg\xbdR\xce=\xcfXEK:a*~\xee\xe3w\x92\x95\xac\xa8\x9aJz\xce\xd0I\xae\xc1w\x0e\xde\xc2\x05\x9e\xf9\x15\x95\xbc;\xe35+\x9c\xc7k\xe7\xf6і\x1eᕵ\xf6\xc8a\xb4\xb4t\xc9a\xa4|l_\xc9\x16\xc0T\xfc\xdc\xd7W\x05[\xe57l\xdf=\x0ff\x1cY\x9b*\x84\xefJ\xe5Z\xbfiȡH\x98\x94\xea\vXQ)\x17m\xe2\xc1\x81\x03\x19wȎ\x00\x9e\xf8\x86\x9b-T\xf3$\x8b=a\x96\xa0?_\x87Uh\x12\x9e47\xfeܠ\xa0\xe0[\xee\xfee\x01\xacp\x914\xd6\xe3i\x8a\xc4\xcd:ƛ6y\xac\x1e\xf7bY\xc5\n#a\x9bT\xfawm\x04\xc0:u\xb6i\r\xd3\xf7U\xf4l\xe6_T\x94\xdf\xfa\x05Q\xf94\xa6\xc4,\xbf\xf9\x03\xf1v\xb3\xac\xf5\xa9\xd8l\xb9M\x1f\x00惢\xb5\xf9B3\x868,\x19\xf6\xe6\xc8$\x9f\xaeOI\xc4փ\xa4P\xf3\xe9\xe2m\xbf\x7f\xd90y\xb4\xc4\xf9\xb1j0\xdf&\xc5L\xdf\x1d\x9c\xa7na\xfd2\xe0_\xac\xc8\xca\x1f\xba8\xb6:\x84c\xaa\x0f\xea\x0e\xd7{F<\xbe\xf61\\\xd3\x04P\xbct^ۋ4\x1c\xfa\x1b\xb1\xfeY\x83\x83\xb6\xc1R\x97L\xfe_-ּ\x9a\xba\xb7x\xad\xea\x14\xa1\xa3\xafl\x1c\xc6\x02\x17\xeb\xc7<O\xd6\xef\x9fu7t\x1f\x05\x0fZ\x85\x84\xeb\xa3;\xf7xc\x8c\xec髒\xdb\x1bڤ<\xb9\x94\xa2\xa0\xc5\x1esG\x90jp\xd3E\xdd\xc8&\xcf\x1a\xaegV\x96C\x18]\x82\xe8\xc3\xef\xf7\x10\x17\xa7X\xfe?F\xa2,1\xe1\x17\xaaWVX\xb9\xc3\xe9ӻ\xc0\xa0\xf1n\x14:\xa9\xca\xc6.\xb2\xf6\v\xa1My\xb3C\x9du\x7fx`\x17\x1f\xf2\x92&\x8a\xb3&\x98a\xed\xd6@\xcd\fE\xb9\x99l\x1b\xcc\r\xfc\\\xbf\xd7U)\x16\xf3Zx\xb6V\xa3\xca\xc7\xcaDaY\xado\xbfr\xac\x9f)h'\x13-'y\x0f\xedힾD\xa9'x~k:k\x1c\x9d\x8d.\xa5\xb1\xd4?\xec\xbd\xf0\xce\xe3\v\xaa\xb1\x1e\x9d\xaeC\xaa\x8e8\xfe6#\x88\xbf\xb6\x88S\xa2wq\xa9VJ\x16\x00\xcfB\xee:2\x1aGK\xae\xbdnݤvNԋC\xc9\xc8D\xdaմi:hH\x91\xfb(\xf0\x83+\xd6ֽ*wF\xe6\x13B)\xd9\xd9\x1e\n\x9b \x8c\x03vYt\x9bT\xdd%\xb7\x89\xf3\xe5e^~\xff\xa2|\xb3j;!\xb7\x9f쾫\xc5CY\xb9\xea\xc3U\xf9\xa4\x92+O\xe7c\aɋ$\xb5\x98\xc9S\x0e;\xe5\xec:\xec\x14\x98\xed\xa3NU\xa11\x18x\xaa\xac\xdbq\xc29r\x1cp\x8a\xe3ͷ\xfd\xfe?\xe6(~\xe4:.\x15\xab\xa1D'\x8a\xf3\xa5\xa9q\xbe\xf8B\xb0\xa3\xfb\b\xb4\xeb$\xdc\\\xc5\x7f\xf2\xb3Oz\xe8\xdc5`>\xc3\xf1\xb3\xceG\x18\x00N\x1c\xdcFkn\xaa\xce@\xc6t\xbe\xe3\x914\x01 \xc6/\xbe\xe0\x98Q4\x89\xdb\xf8\xf5:\x99\xa7\x14u\x1c?tEg\x98:D\x17\xe9\x0f>\xe2\x7f\x82Ѓ\x0f\xa2\xa5}\xbb\xeb8'7\xedFQ\xbeZ\x87E\xa89 \xd4)\r\xb7\x81\xbc\xa8^\xaa\xdcN{\xe6\x9d\xfaQt\x17Ѧ\x13\xd44Ȑ\xdcx{\xa5\xab㹻\x00\xa2p]m\n\xf6)N\xac\xf0\xcf!'V\xb2r\xf3\x156\xc5'A\x11$\x19al=\xea\xc83\t\xca\x1f\x80\xbb\x10_\x97u\xf3\xee}h\xe5e\xe6Y\xf2E\xef͛<\xa3\x88\x97\xf8\xae\x1e)\x95l\xa8\x16*\x92d\xa3A\x87\x1c\xaattȾnEd\x86\xe8Zϙ:4\xd2\x1b\"\x80\x8b\xa8*\xc7\a\x1b\x06\x16o>Pڥ1\xb1\xc0\x81f4\xc4\xd2E\xaa\xaaN\x1fC\xf5\x89\x1a\x05\xd0\x05{d5\xc7\xc3>\x86\xad\xd6\xe6I\x9e\x1d\xdf\xc6r\x05\xe9@%4`k\x83\xee5\xa9v\xeb\xed;L\x83\x9a;DscU\xef\xaaE\xb3uu\xeaP\xa2r\x97\xd5\xc1\r\n\t\x9b\xb4y@\x1e\xea\xc1\xf5\xf9|\x02?\x99?\x96\xb9*h\u0383\xe7\r\xeb\aw\xf2\t\xb4Ԙ\xa5\xe1\xae~\xea\x82\xd8\xf65\xa6\x19@\x9b\x82ގ\xb6\xe1Dr=\xf9\xb5\xbc\xdcm)\x80\x1aC\xbd&\ť\xefct\xc5\xed\xf7\x8dğض\xfa>\x0e\xe0k\\\xd4g\xf9\xad\xe7\xb7xy\v\xaf\xd6\xc6\x16\n.\x94\xc75\\¥o\xcb\x10\x16\xc2x\xd8\xc6\n\xd1\xf0s6|d\xbb\x1c\x05\xa5\x1e\xb0|\x00\x9b\xb1\xb4\x8c\xca\x05b\r۴\xd9\x04\x99\xa9\x9a`z\xee\xee\xf1`\xfc(\xc2\f\x97\xc4&m\r/?\x1f.ۻ\x88o\x86\xbb}\x98\x87\xed\xbbh7h\xabӲU\x9b\xdd\xf5Vv}f\xdau\xa4\xba\x0f\x89:\b\xec:'܇D\xce\x13\x1d\xb3\xc8>\x14\x99%ɍ\xc9c\x1f\x82O\xe6νY\xb7m\"\xef\xa5o\x05:\xe7>\xae\u07bc\x7f\xe4\x16\xb1͖\xf6w\xf2\xd0\x16m\xe4v\xa96wm\x9b\xc6}\xae\xdb\xff\x94~\xd9\xfa{DI\xf3~C\x00\x8eـ/E칣\x99(\x9e\xb3\xa5\xa9\xad#[\xcch0\x83\xc9i\v\xcc7\xea\xc5<\x16\xe2M_z\x96n \x9eD\xf3\x03\xc7T\n3;\xb1\xa6%pO\xa9mED\xb6UJ\x91\xe4\xa6V\x7f\x9d*q.\x85\xdb\xd7\xc9\xfa\xeb`\x8d5\x95*\xb3Ϣu\xde\xe8\xa1s\xa8\x15w\x9d\xe1ᤢ2`ڜ\x87tX}\xee\xab\xd3\xed\x19\xc0\xd1\xec\x0e\x86|2M\xf7_\xf5\xc6\xca'\xbd-\xe0\xb4~u\xde9\x19j\xbb;ǽ\x80\x86m\xa7y\xe9d\xd8}\xf3\xa4E\xcd\xd9Z\xady\xc67U}kd\x89\x054\xa1\x8d텳\xbet\xe6\x10\xf6\x92Y\x7f@\xeaS+[\xf7:\x9b\xbb\xf2\xc4\xe2G\x95\xac\x98\xcf5\x99\xb0\t\x94\xcc\xf3\x9b\xaf\xda%\x9f\xfc\xb2\x8c\xe0f\xf9\xbf\xae\xcdHN\xdc}@\x9c\x1dt\xf9\xe6\xc2<DU\r\xbb\x16\xed\xc8녅Αkx\x0e\xb9ٔ}\xe3\xc4\x1a)%\x86\xa2\xb6\x00\xf6\x8c\x97CF\x8c9f\x9c\xfe\x01\x7f\xef\xf1\xf0\xff\xca\x13\x95Of!:\xf8\xa0\xa4{v\xf8TG&\x9fQN>ɵ\xb2V\xff{\xc4\xfe\xeb-c\x99\x16 \xa7\xc2o\x11\x1d^\xf3\xbeo\\\xa38\xc0\t\xbfF\xcfɶ\xb0k.\xfe\x871\x82p\xd9\xdc\xf8\xe9u\x00\x84\xb5韬\xaa\x8f\xb1\xbb\r_\xc6\x05\xccx).+\xdc8\x1b\x93Y\x98Ho^k\xb3\x1b\xbe \x13\x88l\xd2+\xee\x16z\x0e\xbcw\xb9\x83\x94\x85A\\\x13A\x87m\x92UG\x80{\x90\x9e\xaaV\xed\x01,\xbcJ;Aw\xfbG\xcb\xf6\xfe\xf4\xfa#[\xbc\xd7Q\xfa^\\\xe8\xf2\xf5\xbd\x0fgZ\xf1uq\x8b\x8c\xcbmN\xc3?\xbdv\x9cRQ˼\x1erw\u0603>H\xa13}\xbek\xdeô\xfeڿ.\xfa/\x7f\x1f\x8bZ\xc0\x87\x99\x84p\xeb\x11\xedF\x96j\x97\xed\xc7\xd1y\xccu\xd0\x05*\x8d\x9c\xbdJG#\xe4\xa0~r\xea\x1f\xa1}\x9a\x9dբ\x97\x8f}WC\x1c\x02GU*\x89\xd3N\xbc\xee4\xb6\xbb\xae\xafu\xdf[\xbb\xd3P\xe7\xc4\xf2\x01\xff\x8b~\xd3\xea.c\x9bp\x94\xac2\x10\xf0\xc1lu\x95>\xac\xf7\xf6\xdd!\x97\xb8t\xc6#|\xaf\x95ߎ+M\xdd7\x8947\xa9\xbac|y\xed\xe7\xd0F\xdc\xe1\xdeA\x8fe\xb1f\xdf\xe8\x05\xcd2\xe2&\x82\xee;Ϗ\x0f\xdfh\x95\xbfyC\xbb\xefu\xf3\xaa\x82\xef\xc3\xfa\xb0K\t\xae\xa6\xc4\xc2\xc6\xd5\xd9!m\xe7\xbe\a\xde\xdar\x9d\n\xd7[\b\x98齢9|\x0f\xdbϊ\xe1\x0f\xf9\xd64\x9eZy\xc6\xf5d̳\x8e\xa4\xbb\xaa\x95\x8c\xdaϩں\xf7\x8faV]\xbf\xba\xb71\x0e\xee\xc7;\xdf\xc1\xbc\x06R\x02\xdc\xc3ɚ\xa6\xbb1\xb3\xfb\x98\xd7qQ\b\xf1H\xb9VfC{\n\x98\a\xa0\xbd\x18\xd3X\x83\x12\xfc\xb7\xba&\t l^)\x9c7\xf4\x83\xa5|\xf5\xeb\x83\xf4\xbef\xaf\xc7߫1\xb4\xf1\x94\xe7\xdd`\x96\xf1bV\x007\xf5C>\x8d\x85#\xa1\x10\x0f\xff4H\x14\x04\x10\xe2\xb9\x03\xf1\xdcwM\xc5\xc6[@\xba\xd0\x19\f\xa1\x97~\xea\x86\xc9\xd6\xfc\x84\x9fS\xf0\xe8\xfd\xd4^/\xd0\tlZ\xff\xb5\xf6\xe3\vYzV\xb2bśD3\xefS\xc2|\xf7},\x1e\xd3Ri\xef6\x8c;\x0fӫ\x9cuYl\xebM\xa8'\xe1\xbf\xf9F\x1e\xecR\x9a˕\"\xe0\x86\v\\\\\xd7[\x93\xf6%\x81\xbe`so\xe6\xa4\x06\xd1_\x90{\x98\x94\xdf&YR1\x8frUH\x83\xfa\x16*\tk}\xfem\xaf\x84%\xf9\x06o.d\x1b\x86I|\xa9\xd9\x17\b\xf6\x8b/x\xa1\xa1\x04\xa2.\xb6\x1dH@\x00\t\x02\xf0ϙ\x91![C\x7f\x8d\xc7E[I\x81\x998\xdf\x17\xbdz\t\xb3f\xff\xd9\x1e@S\xf5+\xd0x2\xa5\x7fe\x8atiЍK\xe8\xab7\xb5\xd0\xeb\x0e\xbf2\u0604)&u\xf3mq\x81\x19D)\v\x8b_\xf9\xa7g\xe7\xab\xf1\xde\xc0\t3\x1872\x17\x05\xaee\xd5gY\xb1\xb5ַ\x1ft\xe1\x1c.0\x8a\x7f\x9bg\vK\xc3u\xc9bT۷\x86\xa4\x04P\x8d\x1b\f>ӟ>\xaf\xb4\xb7^\x05\x1a\x9a\tna\x00\xd5x\xa8\xf79__\x8cy\xaf\xfb\x98+\x89\xaa\xc6J\x90<\x81D\xb1\x82\b\xa8\xc6\xe4x\xa9\vn\xcd\x05D\xe255B\xcd\"\x98\xc9\x05p\xc1\xdemXY}\x99%+\x9a>\xbe\xa5\xec?\xfe\x90\xf9<\xfaݫ=P\xab\xfc\xfd>\x90|/\x8e\xb2\x15\xa2\xd5!\xabd\x95\x94\x9cZ\xc1\x8c\x9f\x18S\xd3*ߔ\xa6W\x17\x0f\xdd\u008a\xe6j\x81`\x7fɓ\xac\xaa\xa1HǱ\x1b\x96U\xaf\xf3M\x111\x87\xb7\f\x15{3\xdf\\\x9d\x9c\x8c\x9e\x1e\xc3\f\x8e\xfe'\x9b\xff\x8f\xa4\x12O\xb7e\xe1MrEΤ\x9b\x92\x15_^\xd1\x15\xd6s\x18\x8ca\n#)\x93\xf9\xc6\x0e\xee\xa7Q r\xf7\x9b\xb6j46Ѳ\x06s_%\xc0\xcfk\x97\x13Y\x15\xbd\xad\xa4h\xa1Tk\x95\x8cl\x82\x99j\x7f\x83m\xbc\x8c\n\xf3\x81\xd9\xc3$fY\x95,\x12Ү\x04\xa0%Ys;\x95\x90\xcb\x18\x98\n\xaf\x16٭\xf4h\xb2\x9a\xd9d\xc20M2\x16\x16{B\xf6!\xe4\x1b\x0e\xe9]\xe0\x1c8\x86\xcb\x00\xea_\xe6sw\x01\xf7\xfe\xf4\x1b\xb5\x0f\xd3\xfcꠚ\xf2+\xcf\"\xd0\x13\xf7\xb8e\xfe\xba\xe9\xde#\xb3\xbe͋U\xc8\x19\x8dQ\xbfWa\xe5\xf5\x86#\xd6S^W\n\a\x06~\xd6\xe9\xd9Z\x04\xa9\xe0\xd0\xe3Q\x00[\xd3\x19R\"\xc9\xf6!\x19\x18X\x06[\a[,\fmlA\x1c\x0e\xb6\x8c\x1d\x18\xcbwEՉ\xb2\xae\xd9\xf3\x87l\xbb\xce3\x96U\xde\xf0ԁ+/\xe2$\v\xd3C(\x14\xa0\x1e\x9e2\xa8\t|\n=z\x80\xa1'\xe7\xc4p\n\x17pq\t\x97\xcd}\x9e\xa82\n+v\x95\x17\xbb\xf1\xe8\x90F\xc8Z\xfd!Ճ\xd3Y\x8d\xa0\x03\xfb\xe4c\xb1O\xba\xb1\xcf?\x1a\xfd\xbc\x13\x7f\xf4\xd1\xf8\xa3\xc6(28\x7f\x81τ,\x9e<\xc1w\x05\xc4\xdbd#F?'Q8\x9aD\xf43~<y2yJ?\x9f\x9d<~2\x8f\xe9\xe7\xd3\xe8\xf4\xf1ɜ~\xb2\xe3'O\xa2\t\xfd|\xb2xB\xcf\x17\xd0C\x11\xf3x\xc2S\xc7O\xe6,Z\xf4\xe0\xd2A\xc7\xc4AGȢ'\xec\xa9M\xd2b1\x9f?yjS\xf7\xeci\xbcx\x1aڄ.\x16Ϟ>{l\xd3\x1c\x9d\xceG\xf1\xa9M~t\xf2,zvb\xb7d\xf1d\xfe8n4*z\x82\xff\xb3\xdb\x17\xcf\xe3\xf9\xd3Xo*U\xcc␝\xb6\xb5z.\x9a}\xfc\xecx\xfe\xe4\x19\x158\x9d\x9c\x9e\x84\xc7\xf4\xf3\xf1\xfc\xb1B\x13=c1\xe7\xc0\xe3\xe3'ώ\x9f\t\xfa\xc3\xc9)\xaf}~\x1a-\x1e\x8b\xa6\xb0x\xfe,\x12\x00\x8f\xe3\xe31\a\x88\x9f1Q\x8c=\x99\x87\xa2\x18{\x12\xcdE\xb3\x9f\x9e\x1cG\x02 \x8cO\x9e\x9dHv>\x1e\v\xbc\xecɳ\xc7\x02\xef\x93\xf9\xc9\xf8\t'2<=\x1d\v\f\x11{\x1c\v&\xc7\xec\x19\x8b\x1f\xb75;\x92\xcd\x1e?\x9d\x88\x02\x8f\xe7!\x16\xe0,\x8bBƉ\x8e\x1e\xc7s\xc69\xc0\x1e\x9f\x9e\x8e8\xec\"~\x1a\x1fG\xe2g\xc8\x04y\x8b8\x1e\x85\xbcU\xc7\xe3\xf0\xf8\x94\xd3\xf4\xe4$:y\xc2\xf1\x86\xe3\xf8\xd93\xc1\xa2'\xecY4\xe2\x00\xa7\x8f\xe7\xf3\xb1\xa8\xf8Y\x18=U\xfd\x1a\v\xc9\x0f\xe3\x90\xcd\x05\xeb\xf1\x7f\x1c\x96\x9e;\x13\x9c\x9d\xabf?\xc3\xff\xf5\xe0\xd2\x18\xcb\xef6aV%);D\xb7JXR\xae\x17\x97\x0e\xcd\xc0!\xde\xdf\x01\xdb{\x86\xa1\xb4\xc7\xf5T\xee\xc0Z-\vV.\xf34>\x04\xad\x02\xf6.`x\n\x97\x9d\x98\xf9B\xa6\xda\x1d\x82X\xc2zn|7W\xc6\xca\xe6\xe6j\x18\x16N\xf5(\x13 ,\"ˊY\xa0\xaa\xa1PG\xaf\xc28ٔ-7\x99\x02(\xc60\x83|S\xed\x05\f\x11cY\x85E\xf5ev\x95\xb6\x85z\xe2\x11\xd5˛\xab7a\x11\xfd\xbcX\x94\xac\n \xc4:X\x16߹`\x1c\xc2\f\xbcp\fϱz\xb4\xbbRJ8\x12\xe4\x84c\x81<\x0e}\xfa5\x80\x10Me\xf1\x82\xd2\xe09_\xf5\xfc\xf2=\x9cCoԃ)\xf4p\fD*\x10K\x94\x97\x1e\x95(UR\x99d<)\x1a\x1bPc\x84\x1a\x1bPc\xdb \x15\x87x\xbb\xaenǏ\xe1\x16α/Ρ\xf7\xe3(\xa0\x87\x85\xc7Їޗ\xf5O-\x15F0\x0e\xc6\xc0\x01\a\aC\xf2$\x89\x7fT\x97\x1aեF\xaa\xd4H\xe1?\x14\x92'\xfd;\xb1\xefs\xb7\x82j\x11\f\x13\xa9\x8f\xb0\xbf\xb4\x82\x8f\xb0\xb3\xbap\xe2G\xbc\xa0\xf41\xd4H\xc6\x16\x12\xfa\xfeA4\xd0\xcc\x1f\xa9\xfcV\xf6h\x95\x8c@\x15\x8aF\x16\x12\x9du\x7f\x97\xf6\x8c\x82Q\x8f\xd7و)Pk\x03Ѕ\xf4\xfb:=\xd05\x81\x01\xf4s\x9d\x1ehZ\xc0\x80y\xad\x92\x035\xda\r\x80oD\"\xa7,,\xa2\xa1ISmη\x1e\xcco\xc67\x10\x03N+\xaf\xcc*v3\tk^x7\xf6h\r\x8bȸ\xaf\x8e\x04\x99\xed\xbf;AZyY[\x83\xa5w\"\xc8\xe0\xf5\xdd驋˺\xecλ\x135Z\xb7ޝ\x16ft?X2r':\"\x96UE\x9e8\xa7\xf1\xfa\xbdso\xff\x04\b\xfd\x03&?\x1f\x8e`\x12\x00MF\x87̀{\xa6:B\xe7\x98\xef\xacv_h\x93\x8f\x0f\x8f\xa0\b\xb4\xa9\x87\x12\xe0\xd2\x15oA\xf0\xcb0'\xe8\xd5\xc0Ll\xe3\x7f\xf9\x9e\xb7˜\xaff0\x81Gj\xde\x1c\xc0\x98\r\x1ekK\x11ܪ\xefY\xe3\xdc\\\x91y\x05wgr\xa5\xe3\xbb(B\xa0\uf565%/\x1a\xcfzs\xb3\xc0T\x87\xff\x81\x92\xf8\xe6\xbb\xc7\xf3\aQ\x9a\x97,\xee9\xe0^R\x8e\x80F{\xf0\x80\a\xcd3a_Wl\xcd/\xa9\xeb\x90\xe1\xa2bE\x13\xf0KL\xe6p\xf3\xb0LJ\x03\xe0+L\x11H(w\x90\xafY\xd6k\xc2\xfc\xbcf\x99\x01\xe7j\x03A\xeaM\x98o\xb28e&\f%\x05\xe2\xc6-\xdf\x1e\x1b\x00/E\xa2\xa8M\xc28\b\x93\x90\x1am\n\xdaE\x9e\x84\xd7)\\\xe5Y^\xe5\x99I\xe3\x8f\"Q\x7f\xcb\xce\xd9\xff\x87\xbckG\x1f\xc3k\xb6\xe3\x01\xd1δDN$\x1a`\x05\xbd\xff]\x98`1\x02^\xfd\xa2\x9d%\x81\xc4\xe5\xaf\xd8\xfb\x84\xd1R\x98\x96\xe4\x138\x82\xe3\x00\xc6\xfcψ[\x05]%&\xb2\x84\x00\x9d\xec-q\xac\x97xlT\xf5\x18.\xad\x816,\xc28q[\xab\xb0\x15b,ZcN\xfe~Ee\x856U\xd8h\x12\xa2\xaf-\x9dƱ\x8a\x89O\r0\x14j\x99>v&\xdc\xceP6\x98d\x98\x12\x1bcjX\xb0\x1bV\x94\x16\xa1j(9\xcaQz{1\x8e\xd6\xd8\x1d\xb1p\xbfJB(\xb7J\xd2\xd0\xeca\xb8\xa8\xc9\xc2\xe8d\xb8\xc2F\f\xa7\xaf\x9a\xe1\xfcS\x034\x17-<{d\x81\x8f4xsM\xc1\xf3\xc7\x16\xfcX\x83\x97\x1dJ\x1f;\x13p\xa7\xc1\x19\x8b\x03\x9ek\x91\xb1\xd3\xc9\xd0fp\x9eiѰ\x1b[3\x13\vm\xaeG˼\x88;7\xb7\x04\xe1\xf0\xef*\xb9\xc3=y\x16\x8b9\xb6\xa4\xe3\x0e\xe1,\x10@Մ\xa8\xc2\xe2\x8aU\x02\u009ap\xf9\n\xbd\x1c\xaeq9\x8e[\xearX\x04\xf8=\xc6\x7fiwY\x0e\xc3\x11\xce\xf0\x1eC\x0f\xc9\xd2C\x8cx\x9a\x13m\x8a\x1bf\xc2\xf3\x9f#<\xbbh\xe4V\xf8\xb3\xa2\\^\x93L\xa0<\xaa\xa9\x125\xf1\xb2Z~\x8d\xd9o\xae\xf3\x15\xd3T\xb3K\x96.\x02X\x04\xe0``폾\xe0\xae\x16\x1cXp\xaf\x80\x19p\x01\xd63e!\x0ec\x1b\x05Z\x00\x0f\xb0\n\x1c^\xd2\xea5\xd9\"\x80b\nE}\x0f\"\x1cM\xd1PP\x7f\x8f\xa7h/P\xdf\xeb\x11Z\xee\vx\xa4-\xb3F~P\xa7\bC\x00h\x97/\xd6\xe3f\x99q\xa3\xcc\xd8\x17\xa7\x01m>\xc9Bx\xf4g\x02\xeb12D\x9e\xa2\xf3\v\xb7x\x84(\x0e\xfc{\xecD\x86\xe2S\x04\xb0v\xf9\xa7\x88=e\xbdQ\xd4v\x94}/\x84\x17r\x9d\xe7k\xbb˵\xb3\x1a.\x87\xc5(\x80\xf5\b\xadF\x01\xac\xc7\xcd\xfa\xfew\x18\x89\xed\xf0zl\xef?\xf9\xe0\xac\xd5'\x8d\xeb\xd7b\xc4\xf2qie\xfe*\x06ka\xedG)\xf3\xd3nF\te\xad\xafﾹ)\x8c=_q\xc8v\x8f\xea4V\xf0\x9c\nŨ{l\xf7\xa8\xa8\xacG\xe7\xf8\x9d\xa9P=rw*xQY\x8f\u07b5w\xe7\xc5?l\xfb\xdbF\xd1?b\vܤ\xc5\xceЧ\xd48\t\xaf\xf2\x96CN\x99\x00\x12\xc81\xb1\xaeI\xa9\x93\xec4<\xf0\x02X\x1f\xc3L\xf4\xa9#w\x053\xf0֣\xe1\x0eU\xc0\xf1p'\xb6\xcet\xe7\x894\x87\xac\b`;\x85\xf5h\xb8\xad\xb5\xebn\n+\xf1\xf1\xc1\x06<n\a\\\x1f\x83\n\x85\x83\xf5\xaci\a\xb9.\xf2\xb7\xe2Z\xb5s\x9a_c\x00\xa2>\xf4^\xf2\x8f1}p\xcdu1\xd1?\x8e/?\x9d\"\xab\x89\xaa\x01dG\xfc\xa2\xf2x}2ݡ\x0e\xb6\x9fN\x1dl\x1b&lQ\xad!m\x8a\x96\xa6R\xd8~:\xa5pGZ\ffޝ\x9e\xb5\xc5o0{g{\b-\x8e<\xd7@ܳ\xab\xd0ƫU\xd0\xf3\x0f\x95\x19\x1d\xee\r\x026\xb9tW\xf6\xb5\x87!Ъ\xf2,\x8a\xf8\x1e\xa8\xa6\xcb3\x99\xea\xe3r\xd8&\xe90fJO.\xe9ԥe\xe9~O\xba\xa7\x93\xc8.w\xabyޭ\r9\x88\xa5\v\x05A\xb2\x89\x1c\x86\xee\x84y*\xb2\x83\xae\xfd|\xf9\xa0\xab\x02~\x99\x14Q\xca|\xafL\xde;\xc0\x1b~\xa7u\xbc\x1d\x85\xe1W\n$W&\xef\x1b9\xaf\x93\xf7bd\xf3\xef\xa1(~\x8f\x91)\x829\xe0\x7f5\r\xad\xa3\x92Wgt\x9d\xa0\xa0L\xdeߏ\x82R\xb5\x05\xb4\xb6ޅ\x82F\x8eml\x14\xbdg\x9b\x18#ꡩ\xabۄQ\xad\xc8\xcbR{M\x06\xc9kZ\x9a\xf9\xe2\xff]QQ>\x1c\xc1)M|ΙgpL\xb6[\xb9&\x1f\xd0\xcf\xef\xea\x9f\x7f1\x81\xbeS\v\xf7\xbfX\xe0\n\xe4/\n\xc4\x026\x91\f\\\xc0uEj'\x19HE\xb1ʳ\xb8\xbb\xe9;W\xdb=4 \x9bR\x1cf\xc7#\x1f7J[\x98a)W~\x83Y\xf2\x04qW\x1f\xd4m\xf9\x81\x1b\xfe\xee\xc9sÝ\x9a\xac\aF\xbe՞\xf2\xdd&,\xd8\x1d{\xb2\xa3\x17\xed\x1e\xfc\xc1\xdcj\r\nE\x96\xbd\xff\xb2\x8a\xbb\x98߫\x8a\x84l6\x838\xbf\xcdz\xddTo]\x9d`\xea\x8awEu\xec\a\xbc\xbf\x8a-<rd;[\xaa\xb3X\xef\x01\xadc\f\xcek\xe9\xad-ڬ\xff\xd1\xed\x19\xb8\x1b\xd4\xd2\x1e\xab9\x9a\x15[\x9b]PO\x97\xb6\x8a.\xd1N]z\xbeS\x15q\"\xf5\x96\x1e\xfb\x81cXH\x90*̼\xe3\x91v4s\x04\xe3\xa7#\x9d\x92p\x9b\x94\xddn\"ۤ\xf4\xaed2\xc0U\xeb\x93<\x9c\xde+\xb1\"\xe17\x7f\xb4\xebl\xf5M\x89\xe8\x1a\xabĿ\xf2\xcd\x18uqEx\xdc\x10\x88\xf1%\x8e\xc5(%\xa0\xb2_ʉ\xe1\r\x85.\"\xd08_\x85\t\x8feT\xa3\xe7\xe0\xca)\xb6\xfex\xe3\xacX\x006\x93\xf6\x91\xc0\xafՈ\xaaE\rf\xd3\xcb\xcd\\\xb6^\xda\x14\xb6I\xf9z3\x8f\x93\x9b$f:jA\xb5\xca\xf3\x03Y\x1afp\xa5\x05\x05\xec\xe1\xc3@y\xd1\xf3\xe9\x8d\"OV\x11\br\xear߈x~\xe2\x93\a\xed\xf3|\x11\vϣ\x831\xf4Ժ\xea\xf9\x14#\xc0\xebѳ\x98\x98D\xd5\xcaj\xf83\x8a\xbd|\x1dFI\xb5\xeb\x05tاU##\xe8\x0f\xcd`\x0e\xbc\xcemRy~\x1b\x0e\xf9\x1aJ\x8d\xec\xdf\xeaH\xfd\x0et.<\x0ea\xb3\x19v%ye3J璓EWȌuX-;\xd8\xd0ʃ\xbb1\xa0\xa3\xf5\xadM\xe7\xa5~EHt\xfb69AίB\xf0P\xcc^\xe17\x978\xdc0\x84ղ!X|4In]\xe0\t\x95\x00\x95\\\xc2ߊK\xe2\x15;\xc9 K\x86$2\x8e\xa0\xa5i\x98e\xf5 Q8\x86\x19\b\xe7\xdf|\xbd#\x01\xc1\xaf\x91\xbcP\xfb\xe6M\xb4\f\x8b\xea\xcd\x1b\\M\xf3\x12g\xe6\x95\xe8\x1ab\xd6\x04\x90\xfd\xae\x9a@c\xc19\fz~W9\xba\xf0l5\x00q\xe9R\xc5K\b\xfd(j\n\bJ1\xa5\xee\xfc\x06\x9c\xb8\x82M\xc2$\xf3x\xadt\x95ګu\x8f\x00n\xaf\x9a\x17\xe3P]Uۍ*o\x93*Z\x82\x97\x17\t\xddK\xa9\xf5?Daɠ7ϫ*_\xf5\xa6u\xba\x0e\x03`\x8a\xa9\xa9\f\xffzf\x87<ҸLݱCGVL\xfc\x11\x15\x12\xeeh|g\x19\xd1\n^h\x8b\x85F\xfe\xa1(T\x9f5\n\x84o\x9d\x05\x14\xa7e\x81^P\xdf\xc95\n\"\x19\xd0'd\xbf\x84q,/b\x9au\x1fD{\a)fy\xa3\xf8\xfd\tC\xc4\x02K\x8chz\xc3'c\xb6R\xc3\x04\xb3\aa\x86\xb6+\xcc\\%q\x9c\xb2\x9e\x85\xa3\x1e\xfb\x12S/\x10\x8bcRP\xc2\xc0\x16\xf4\x04%\xdfd1\xd2F{\x90\xd1w5\xd8\xf8RmK40\xab\xb2y\xc1\xc2떇\xb8\xb8\xa4V\xf9\xfa\xb3\x8a\xe9\xe0\x13\xc8\xe9\xe0\u0382:\xb8\xab\xa4\x0e\xbc\x83E\xe2\x1e\xc2:\xf88i\xbd?q\r\x81\x1d}Nq\x1d\x1c(\xaf\x83\x8f\x11ؔ-\xaa\xfbI\xec\xdf\xf6J\xec\xf6\xfe\x12k\x95\xd2{\x7f\xb4Gb\xb7w\x96\xd8\xedg\x90X\x8b\x80\xae\x068%\xf6.\xf4h\xb2=ګb\x8f'\xed2˲\xf8\x0e\x02k\xcbg`K\xf1w\xa3\xbf\xd8\x02\xfb\xddG\vl\x91\\-?\xaf\xc4\xdeK`?R^\xef*\xae\x9ft)\xf0\x91\xa2z0-\x9fLN\xe9\xf8\xf4\x0e\x92z_A\xbd\x97\x9c~0Bwi\x16\aca\xab-\x9f\xb9I^\x17[\xb9\x1b1*\xd5䮵ĸQ\x02w\x8e\x87\xc2\x1b\x03\xe2@\xaa\xcc\x01qǊ\x0e\xa6\xcd\x11L\x97LY[\xb5\xf9\xe2w1\xbf\n\xe9\xd5s:\xed\xdd\xeaV\xa8\xd8d~m\xaa\xa7\u0098݇xkt\xe8\xd9\xc1\x9d\xb5\xbdK?m]\xb1+\x1b\xa70\xf2j\xb9\xe3r2\xdf%\xc1Lm\x8b\xac\x01\f3x,\x92\x92\xccN\x92\xa3@%\x88\xb1\t38\xb6MPtZ>&/Rݴ&\x02\xb1h6)˲D\x91P\x1e\x00\x00\x99\xfa\xd4-\xf9{\x1c\xc8`Iu\"#\xd0\xd8G\xa2X\x87陿\xa5\xf7\xab\x04\x93\xee^+/*kQ\x88\x0e\xabWZ\xe3\x1c\xd6\xcc\xeeZM\xd6\xd7\x11Y\xac\x0eQ\xe5\x0f\xa6Fu\xdb\xdd9Q\x17\xd7\xe9Q\b\x0f\xe7\x882\x95ޏ\x06\xcb\xf4\xa9%݉\x8a\xd7\xf6\xc1`\x00\xbb\x00\xde߅\x125\xc8δ\xa3\x02W\xd8G\xbc\xb2\xa0ӫ\x8f\xce\xfe\xd6\xc8\xd1\x06i\x06/\xe8\xbd\xc8\xfe\x0e\xa6f1\xbdD=\x86\x11\x1eo\xa9\xf5U\xf5\x17\xd9e[ѽ\xfc\xa9U\xc1\xfd\xbaI\x94\xd7I\xadQ\xf6\xef\xd0O\x9a\"\xb9\x1f%\n\x83N\x8b\x8ev?5V\xba\xee\x030/Z\xa2\xf6\xc8\x04 \x88\x8f<\xe3\b`~\xd50\xa0bt\x19\xf4J\xcdbۈ\xbah\x02\xb3mŲ\xca\x06\xac\xde7\x00\v\x86\xc7[\x12\x90\x7f\xe9flV\xcfXW\xd2LL\x91QX1\xa0\x00/d\xcc\fӴ\xe7\x0f\xf3\xcc\xeb\x91g\x04\x1e\x19rV\xf5\x02\xce\x10Z\xb5q\br\x8f\xa0o\x17H]\xdd\xfc\xaaa\f.XT5-\xa9\x06c\x04\x897I\x99̓\x94\x1b\xb3{\xcb$\x8eYV\xe7F\x9b\xa2\xe4\xabI:\\_\x86I\xa1\xaf(\x17\x87֬\xb8\xdc\xc0\x8b\x96w\x1de\xf5\xbe\x81\xb2y4Ҷb\x91\x87\x87\xbcw\xf8mLm!Ѭ~\x0f\"\xb1-\"\xae\xbf\xa42\x17\xf1\xa5\x81\xd0\xd9\xec\xed~\xccG\x17\xec\xf6R^z\x89)\xcaбxL\xccį6\x03\xfb\x10\xfe\xc7EV^\x1e\x8a\xf06\x89+\x94\xa7\xc72a\xc9h\xc7H)\x9d\x92a\xf4\x94\x80\x8c\x93r\x9d\x86;)\x9f\xfc\x8d&\x0fi\xe8ey\xc6z\xf6+i\xbc\x97\xe9LF\x9d\xbd\x98/\xe4m\xcd66OP\xcc\xd5\xe4\xfcJ\xe3<\xa3W\xb2\xcdv҆e\xc0\xb3\xce\f\xe6\xc5Ex\xfbW\xefʱ\xde\xe4\x94\xec\xf6Q\xb2sS\xb2\xb3(Q\f\xee&\xe5o-\xa4\xf0\\-\xf3\x83;\x14\xa2\x82Ӵ\xaaS\x8bq\xaa*\xb9\xe4n\x1509\xa4\b\x92b&\xe2\xa8\xe2\xe3\xf9\xa2\x7f\xc4j\x19\xbe\xd4\xcdr\n\xe0?J\r\x80\xef\x1a\xfd\xdeA\x8d\xf8\xab\xab\x15\xba\xc6ֺ\x9c\xd76\xbaԙ\xea\xd2\xf3\xc10{\x81C5\x18\x96/\x8c!\xab\x04\x85c\x1aSc\x06.\xc4NZ\xffv\x18\xad;\x83\xd6\xf1>Z\x99\xa0\xf5֤\xb5\x16%E\xec\xd8$v\xdcBl=}h\x93\xabʥ\x19\xa91\xeb\x1a\x1e{4\x91\r\xa3%J~\xfc\xab\x1e\xa8\f\xdc\xc1ʤ\x8f\x9f'o\xf3\x88o\x1f\xf9;U\x1e\x82\"۷Cf+ڮ\xd9\x0e\xe7J\xcf\x0e\xfa\xadh\xbaf\xbb\x97\x14\xcbu\x06Ǔ\xe6\xb39\x0f\xe3\"\xbc\xba\u0099\xda\xc8\x02\x88\xe4\x83{\xa6\xa6\x14\xbb\x9a\xab$#A\x98\x19\x82\xe1\x04\x1b[`c\vL\x12\x00\xb3\xda\xd9\xc5\x1c\xe3\xa0b\xc1\xbd\f\xb3\x88\xa5\x9e\xdf\xfe\x10\xbfΘ\xcd\xfa@\xb6P\xc4UE\xc6\f,>\xd5\xed\xed\xb7\xb7\xb7nm\xbf\xbd\xb5u%0:\xfb\xb8\xf6\x91\xc8\xf2)\xc2\x12L\x19\xd7N\x88m\x00\b\x157\x1f5F~\xe4t\x1d\xc9l.\x95\x17\xad\xe5\xf9VS9\xc0X\aЛ\xf9\xe1\xc1\x01\x02ftF\x98V\xff\x83Y\x13\x8a(\xcd\xc5Я\xc5\xf1\x02<]\xf9@\xdf\xe8\x11a\xbd\xf1\xf4!o\x80\x8c9\b\\\xb6\x89\xb4꼾\xa7\x18\xf1\\T\x8f\x15t\xc9y\xa3\xecX+;\xbe\xf4mq\x10\x86\xa9\x96\xa1f\xf2\x91&\xa8$\xbb\xfa+\n+\xf6\xe8\x98\xd7\x11\xc0\x16\r\xa2\xf6ڧ9}\x83\x92\x83\xaaذ}\xd5\xfc\xadQ\xcd\x0e}:\\\xd5\xfc\xed\x1e\xd5\x10\x8c\v\x99\x85\x8b\xe4\xe3\x8d\xf5\xb8\x10:\xf8N\xa1'\xd6\xfeF֊^\x1dR\xc3\xec\\\xac\xa3a*\u05fe\xbd\xb6\a\xb7\xdaƙ\xc1\x03\xe1\x11\x95\xd8C\xaeۛ\x85\x02\x19I\x8b\xb1\bW$\xad\xc5\x01\xacs\x15\xbcZH\x12\xbeu/\\\x88k\xc1M̙\faVI\x16\xc0*ܚC\xda=ފ\x11jaY\x97\xb1\xbe\x1acNɭ\xdbM\x80\xba\xdfV\x89\x11/\x1c\xef\xd1\xf1\x8f$\xf3\xe82\x1d\x89|r\xe9\xfb\x87\x10\xb4\xa28\r\x1e\"\xedׄ\xf9\xd07<\xa9\x9d\xc6[D*\xf5\x82ƽv\xcaЙW\x8cBb\xe3*\xc9|\xff\xcc¨0=\xa7|0e\x8e\x93\xbbJ\f\xd6I\x9e\xb8\xd8\xea\xa0[bq\x82\xb7\f\x14\xbd\xc7\xe9\x95\x0f\xac\xf1\x8f?L\xc1\xa0\xf4\xd0\xde\x1b\x10\xc4\xd7\xe4\xe7\xe4\x98\xc6\rč\x96\x19\xe8g\xa6\x90\xd5\xeb\x19\xe7\x00w\xcfT\x8cL\xeb5}\xda\xf4u\a\xfb\x80s\xc5~\xef\xcdVm3\xe9\xcd\xf3x\xe7\u0605[\x05nk\x03\x05\x92\xae\xac\x0f\x04V\xe7m֎\x1cZߵ\x94\xa2<\x96Ŏ,\xb1\xbes\xe7\xd8\x15Ք:\xf4\xa6\xae5\xf1\xa8\xf6\x81K\r\xb6-D>h\x16Ku\xef\x89_\x04!\xf8_\xeb\xbbPu\x94}1\xbbsx_@\xbe\x81\x19\xff1\xcc\x17j\xf1\xab\ai\xb3\xacY\xb2\xb0\x9c\x98`\xa6\u05c8F\xa7\xcd\xca\xd3\xf2\xff\n3xx\xf4\x1f^\xf6G\xe9\xcb=\x98̣\xa7Ķ5\xec\xdf\x04,\xfb\xe3\xd6\r\x8ba\x06\xea%\x9b^/Y\\X\xec)\vN 4L \xb4\xb8\xbe\x00ˍK\xdf\xc8\xc3[\xa3\x91<\x80\xb4\xdf\"]j\xa8\xb8ELvh\x9b\x98Y\xc5\x1b\xb2f\x96\xb7\xe5M|\xbbD\x8e\xbe|=\x14\xb8K\xd3;VV#\xb1\x89TYg\x0f:\xd6R#\xb1\x8bSYg\x0f\f\r\xab\xafZ\xecy\x99ma\x06\xfd\xa3\xdbF\xe7\x06@\xd1W\xfaG\xff\x91\xd9Y\x1a12\xd6Ѕ҇4\a\xdb\xe4\aF\xf6Φ\x17.Ϻ\xb8\xc1\xf1u\xb2\x80\xe3t\xb4\xbb\xb1\x82VKI\x8eeX\xa6I\xa4\xa9\xd8\x0e\x05K:\xf2P\rkh\x9b\xfdjT\x1f8f^=\r74\x96\xae\xaf\xf8\x91\xfd\x03[_9&\x11\xb7\x02\xab\x8fF)S\x8b.\xfeuR\xae\xc3*Zz\x84+0\xea\v\xd4\x1aSS\x9b>?\x1b\xe6ǘ;\xf5Kؽk\x97\x11*\xf0\x8a\xa7j2B\xd2D\xc1sF*\x1a\xe7\b.\xe120&lN6\x9fȌ\xa3\xe8\x83\x0f\x9cԄ\x8d\xc5\xdfˏn2\x1fn\xe1\xf9s\x18\xc3\x1f\xf0pwi\x9dq\x10\xa0q\xc8\xc1\xa9\xdb\u074b\xba\x9dľ\xfb\xbc\xd4)\xa6\xbbHDy\xc0\x105\x18v\x06c\xc4`\xe8\x97\uab1b~Y\x16\xe0=̌.\xabWe\x9d&\xdb\xed\b[\xccu VM_\rk\x02ծc7\x91\b4\xba>\x15\xb8\xda\xcd1D\xcb0\xc9nXQ\xf9\xbc\xbc\xfc\xf4\xb6#_\x94\xaf\x93ƾ\xa3\xfc\x18\x9e\xc3v\xe4\x03\x9di\x8f\x02\x81f,\nW\x87\xach\xad}\xfeN\xf1\x037C;ŏ\xf1\xdd\xf8\xb1\x1bY3\x86\xc0\xd5nw\"Z\x14?\xa8\xbc\xfc\xf4v#_\x94\xaf\x93\\\xfc\xd8!?v\x82\x1f$B\x84f,\n\xef\xe5\x87\x1c\xab\xb4րsR\r[\x8e\x86\x94Öc\xba\x04\xb4\tn\xe1\\doǔ\xb0\xc3b\x17Bt\xebYF\xa2\xb76\x00n\xb5s\xf6\xa0ER\x95\x9cjBzfw$\xef\xfeQ\r\xb5\x1d\x1br\xa7\x93 \xa6}.7F\xc6Xf\x8c\xad\x91cI\xab.\xa6\xa6|\xdeM6\xf5aC\xb0\xfc\x99/}\xe8P\xc9\xfae\xb0\x9a\xa9\r\t\xde\xd5|ڹ\xf9\xb4\x95\xd25\xaa\xc5r76\xc0l>\xd1\xe4\xbfs\xf0\x89gX|\xb2\xa5X\x17_Sn\xef&\xb3\xfap\"X\x9bO\x82\x1a\x17\x9f\xf6\xe8ez3\xc7\xed\xe9ұo\xb5:\u03a2\xcf\xea?\x8b\xccѡ3\x06n\x1bݔ\xe9\xa3\xd5$ef\xd5\xfe\xc7\x1f|p\x9a\x14\xce\\\xeaȊ\x1cp<,\xd8<\xc9b\xb9\x1e\xa1EJ\x00\xbd\\\x1c1\xdawµ#X\xf5JR6\x85^V\x0e\xc4\xf2\x8d\x1b\xc8p)\xc5nʹ\xd2\x01w\xeb\x80\xcb01\xbb-\x99\x95\x8c83V\xdaX\x99\x13\xba\xbc\xb5\xa0\xdb\x1a\xf3J-\x02Pc\xf52:&\xc7\x7fh\x99z\x8b\xffd\xfc_\x9e\xca\xff\xbd\xedq\xbd\xc6A\xd5W\xc6\v\xd2ץ\x8aM8g\xcb\xf0&\xe1\x1c\xfb`\xa5\rq\x13\xd3\x1dv\xa6\b\xaf<\xeb\xddH\xd3q\x01!z\x01\xa8\x84\x86\xdbB\x03\xc0y*\xa5\xb2]\x87R\xb4\x82o\xda\xfe\xeb\xc06\xf5\xab\x8e\ue0e8\xefc\xf3 j\xed\x0f\xe5\xab\xe1\xb2\xe9k\xe7\x89\xfa\xdax\x04g6\x93\xe8\xf4\xe3t\xeb\x14k\xdd~\x80\x85\xach\x1cc\xd0t\xdfh\x87Z=b\x192(\x9d5\x1a/\xd8\x12p\xefε\xbeS{CJ:\xdeQ\xfaXO\xd7U1\xb7^\xff1C\x04\x7f@\xbc\xb37fo\xb0\xf8~\x83I\x97\t\x86\xf7\xbe\x96\xbe\x9drJ\xfb\xf5y\x8b\x9e\xbd\x9b\xca\xe8>\xea\xb4Eώ\xb7S\x88\xb7F\n\xbez\xb6s\x18x\x9cܷMs݄\xb7\x9a\x8eZ\xcc\xfa\xed\f\xb2\x0e\x81\xa4Yif\x18Z|at\x8bR\xbcb(\xc6\r}\x04d\x7ft:\x05P\x91Z\xc85\xa3\b\"\x18\x90s\xb6ȝ\x82fq\x11蕍\xcdF\x81f\x93V\f\x9b\xb5Q\xbe\x95\xe5D\xbc\xc1\xf0v\x169\xda\xeeD\xdef\x96\xdbklk\x18\xef\xf4\xbe\bT\x1b\xf5,\xae.\xf8#\x95\xces\xef\x8bѥ\xa6!\xa4\xf5+\xd0Ǐ\x1c\xa4\xf2\xa8ht\x90m\xec\xfe=*\x95\xcc\xc7\xf4\xaa\x10~K\xec\xe8\x10\x95\x1af\x18\xbd\xa4\xe5\x88g\xc9 ڍ\x0epښ\xf8\xcf\xe1\xd6R\\\"yg\xe8-\xb0\xadB\xbf\xbb0\xf2žk)\xfbP\xb4\xd7o\x15\xc26\xbb\f\xb2\xc3i\x969\xc0ւe\x03\xa9\auTA\xadc|͐Z\xaf\x011w\xa8\xd2\xef\xe5\x9e}U\x9ft(D\x8dHYExս<\xe3Mp\xae\xce\xf4\xc5\xc4\xfb<_u.&\x10\xa0{1\x81\x10\xcd\xc5\x04}\xde.\x19K\r\x00J\xb1\r\xc9:@m\b\xfe\xfa\xe7\x1f\x7fĔ\xd7Q\x91\xa7\xadX\xe2y\xcaՏȗߍ%\x8dȯSl{\xb4\x0e\xe00G7\xcb;\xd7D\xf8.\xbf\xbd6Q\xc1\xe6=1\xdf+\x9f,\xe1\x11 \x8em=1\xed\xd7\xd9\xe3:\x1b.\x9d\xf5qU\x95:*K\xb1\xaeG\xa2pߨ3\x80\xf4b\xec\xce\x1c_\xb6TD\xa0\xbf\xe6\x9evч\x97\xd6\x0e6)\xe1\x1b\xb9\xa7\xd0\x0e9\xf5\f\xdcc\x96\xbe\x9b{\x8a\x8e_so\x1d\x80֬T\xa9\xe5zvћD\x87\xb4\x9c\xbb\xa9\xb6\xc57\x1a\xd6W\v\xab\xb4\xde\xde\xd8!\x1b\x85\n\xa0\x91c\x8e\xda\xed؇\xedX\x06Mَ\xc4\x03i\xd6C\x8e[\x87\x13\x9e\xb7m\xeb\xf4ڡ\x8e\xb0lGr\xabl(\xf0\xdd؇\x9d\xaay\xd7R\xf3\xceU\xf3\xaeM\x9e\xac\x9aw͚\xd5\xf4\xb9.\xb8zd\x8bp\x93V\x96\xeamj^>RT*\xd5&\"\xceԩ\x8a\xa6i\xfd\xb3۹\xb0s\xb7\xa1t\x87\x96Y{z\xe8\xc1+4\t\xb3\x9d\xd8P\xe0jHS\x14\u07b4/\x98\xc4<\xdc\xd8 \x88\xc5\xe6\xbe\xd5SC\x0f6Ok\xf5\fǂ\xd6X\xeb\x1c\xb2L\x15\xf8\\\xcb\xd4O\xb6\x1ct\xd1\xfc\xb9\x97\x83j\xb9\x16\x1ct\x80\xe9\x9ew,\xa6\x8bO\x14\x0e\x98\xd5\xeaݖ\x1d\xd5F^\xd5p\x91G\x9b\xf2\xb0c\x1fS\x90hn\xb3/V)\xa9E\xb3\x98\xfa\xed\xa6\a\xafW\xf8ƽ\xb2_sO\xbd)\xca\xdf\"\x91\xf3\xff\x1bl\xe4\xd7,\xadBχG0\x1c\x8d&\xbe\x9c\x16\x9aj\xf6\xd7ܪ&Ј\xa9\x9bj\x8c\x1b\xeaI\xfbq\x96\xf6\xd6[C\xd8hk\xbdʲ\x95\xb6\x98\xf3\xad\xe7i\xd6Z$KI\xafч\xeb\x06\x9b\x94D\x95\xcbd\x81'\x962\xae\x15\xf9\xe4\t%\x06\x8f`\xe2d\xceZW \xf7eC\xbd\xc0\xb0\x9a\xe3\x8a\xc0)\x9be?\xa2m\xb4k$\xaf\x91\x9e\xb9\xb8\xfa\xbb\xba\n*p6_\b1\xfc/\xeb\xc2\x17\xfak×:c\xab\x86\xb7{\xbb\xd6@\xf9\x965\xf3\xc50鮱\xadM\xc5\xfb\xde\bY%+\x06\xcf\xe1t4\xf2\x1b\x97f\xd7\xf2\xd9cq\xaaitx\x9da^ؕK\x9bF\xefv\xf6\xf0\x9dz\xd94\x00\xd4͠\x87\xcbM\xfd\xe8\x12\bk\\\xec\x93\a\n/m\xb0\x81\x12\xea\xae[\x8f\xf4\xbe\xd3\xfbb=֊\x8e/\xeb:\x05\x7f\xc7\x01\xa4c\v\xd9\u0605\f8\x15\xb8\xea\x1dq\xe3\xd1z\xacy\xc0\xaeG\xdcd\xb4\x1e\xbb|^SQ4\x15ES\xbdh*\x8a\xa6\u03a2ͱ,\xbaպ\xdf\xfd\xc15\x82G\xc8*\xff쁣\x9b4;\xff\x1d\xc76\xf5\x96\xacE\xdb\xf0\xea\xea3P\xab\xe9\xb1\xf8)\x93\xbe\x91GĶ\xde\xfe>[$YR\xed\x82\xf6\x8d,\x82\x05bE\xe6\a\xfc\xd4I?L\x96\xed\xe3\xd4\"\xd8P'\xf4\x1e\xf7\x13e醮\xa1\xb3[\\i\xfe\xb4Y\xcdYaǛź\x8d\x1d-\x11\xf3\x89oW7.Fv\xd4\xfaM\xe3d\xfenu\x7fc\x1c\xb5\x9b(\xb7Z$Ŷ^\x85\xe9=\xf8uOW\f\xb5>\xe6'\xa9ꋎ\x0eE\f\xb9\x83꿧\xb3\x85\xaa\x7fgԿ\xbbK\xfd\r\v\b\x97\xfd\xae\xf3)s\x15\x94\xdc\x04\xed\x9d!\x06\xad\xfa\x96'5i\xb8\xcb7\x95~N\xc3S\x86\xfc\x1d\xb1\x8e'\x93Tz\x9adz\xf4\fR\xb2a\xb5\xa4\x03\xa6\xcb\x00\x12\x98\xc1`\x1c@\xc6ߊ\xba\x96\xdcS\xeb\xcde\x922\xf0\xfa\xfd\x04\x9eC\xe6\xf3\xb2\xc35^э\x8f\xdfpj\xdepj~\t\xab%\xaf\xce\xf0\x82\x96\xc7&XPc\xa9ՠ\xfd\x8f\b\x15\x8c\x83z\xee'pJ\xe2R\x00\xf4\xf1z\xb3\x92\r\xa4\xefﳘ|\xe1\xe2c\xb1\xb7\xcd\xfc\xfa\x91\x1a\x99\x89\xd0\xd7t\x9f\x00\x15Y\x12\xc0[\xd9\x04\"\x8e#\x94I\xaa\xce:\xe9\x9ao\r8G\xdb\xf8\xa7y\x18p\xf0\xb7\x06\xb8V\xe0\xad]\x00\x8b\xf4\xd1\x19\xb9*\x92\xedEry\xf1\xd6y\xebD1\x80\xf7\x92~#\xd2h\xb1\xecD\xc5\x11\r\xf0\x1a+ں,\xa6e^T\xffJm\xd7IӰ\"@\xbd\xb63\x1f\xc8\xd1ġ\xc6\xe3)\x82/\xc2K\xad\xff.\xe6\xfa2\xea\x83\xdfF\xcdk)\x00z=fK\x1bK\xceغ\xc4\x00\x10\xef%\xdc ]U\xea\xd5݁\xd4\xd7_\x06\xf5\xd6e\x8bF[Pt<\xf3\xd1Ƶ\xb8\xfd\xff\b2\\\x83\\\xeb\xfelw\x912\xf2\x02\xb9\x9b\x98\xf1\x17\r`\xa6u+]\xb8\x88\xdfj\xafq\xf1\xe4\x98\xc40\x80\x1bP\x82\x89I1\xa6\x85\xa2jz%\x8aD\xf7\x06\x1e\xd5\rѻ\xa9\xbc\x88\x13\xe8C\x8f\xce \u2dd7\xea,_\xfe\x97`mS\x88\x93\xc0\x8e(%s\xdeZ9\xea\x91\x18\xf3\r)Оl1_\x93\x02\xf9\n\xe1\x14nܡl\xac1\x86D۔:\xe9\xd4i\xd9\x1a\xb4Ԕ\x18\a\x98\x82\f\xb2\xeb\xa1K\xcf\x11\\?p\xd0C<]\x9b\x81#\xd4\xe88P>\xb0K\x13=\xe0\xc6~\xd1P\x8f\x90ԽWw\x1ev\xbc\xb2\xbd\xd4\x00o\x15@\xd2pl\xe3\xf8\x86\xd4ht\x1d\xe1Ňƛ\x91\x00\xba&\xe6\xaa\xcb(\xf7\xdc(\x06\xe7V9\xf9t\xcaT\x80\x05V.O\x9d\n(#\xf3\x03L[\x91\xf1\xbfm\xc8\xf8_\x13\x99\xdf\xed\x13g+\xb7\x97\xd4^\x1f\n\x86_^\xdb]c\x9e\xa9\xc8\x14\\\xea\xd6h\x9a6\xe3\xb5x^84xڇphv\x06\xdf \xcdm\xb0y\x13\xac\xe5B:ʏ\x9c\xeaq\xb6\xe6\x94\n\xad_J\xfd\x19@\x16(\x05Hꮞ.\x02S\xff\x06Z\x03\xf47\x9e8\xa2{\xad\xafyQI\x7fF״$\xb6-\u074b\xe0\x9f\xd6JI-\x12\xd4\xd2@\xdf\xd3\xedy\x92j\xfd\x11\xe1c,\rP\xa3\xda~\x12\xd2j\xd6\xdf\xf3\x01#Y\xbc~\xc4HC\xf8\xe9h|\xad-\x04\xefG\xa6\u00a0S\xaa\xa3m\x12{G\x1a_\xcar\xf7#P\x17s\x00\x03\xe1V7\xb2DN\xad\xb1\x97¨A\x9dE\\\x8dW.\xc5]\x98K\a\xeaf\xcfX\xa8\xe5\x12\xae\x15\xb5\xde3{^P\x13[\x8aEn=\xba\xe5\xd8R\xac7iɼ\x8c\xbc\xab\xac#NU\xec\xdd&\x8c\x85a\x03\xbdi'\x01\xec&\xb6\xf9\x10a\x86\xfc\xe69\xdeF4\x11\x82\x1eq\x8f #\x9c\xd9\x11\x88^\x94ݩ\xe4\x9dLƛW\x19\xcc\xe8Q\xe1\xfay\x89\x98\xde\xc9\xd8\xe2\x1a\x89\x1e`\xd95܉\xbc\xed\x04\xd7\fc4\xb3\xc7\x19ΊKV\x85\xf6,\x8a\xc4\\\xabJ\x97\xa8\xbb9<\xfesf\x80\x125\xeb-\f\xc8#\xccZ\xbe\xc9\xec\x1de\xef\x9a\xd9Λ\x92\xfa\xbc\xd7\xe0\xde\x17_@R~\x8b\xbb`\xe6ř\xdfI9\x95x\xf9\xd9\xc8\xef\xf0X\x7f\xa8q\xee\xac\xf3\xa1P\xe5ߧ\xf9\x85\xc5õ،r\x03\x9f\x1a\xbb1Ңe(\xef;\x12e\xbc\x90\xb4Y\xb1\xe6\xa5\x1e)\xe88\xa1ֶ\xba\xa12#\xf6\x94w\t\xc5̗\xb1j\xd5-\xeb\v\x18\a0\xa6\a\xafɭ#L\xd7\xcb0\x80E\x91\xc8W\xbf\x86\xcf(&\xfe\xf5\xd7IY\xa1\x81\x9d\x1b\x03\xc5\ue7ea\xffA\xcb尯\xabB\xd8\xdb]\xb027\x00\xceE\\\xae\x1e\x8fp!\x10\xdep\x8b\xc8p\x1cp\xe9\xc5\xdfO\x03\xa0\x1b[7a\x1aP\xaf\xc9\xed=\xd6$\x7fǢ~zR\x94\xa3/%~\xa198#\xc5;\x14-\xbaȣ\xf6\xc3#l\xf63\x1f\x9e\xe3\xb9\xd5i\xc3Cp\x88~\x83\x0e'Ad\xad\xbe $dS\xfe\af0r:\x0f:\xc6\xc9\a+H\x1e5ZL\n\xfc\x91F\xdd\\\x13\xc0;2Y\xe4\x01\x94\x01T\x01\xa4Җ\xa1\xcb\x10x\t\x92p\x06\xb8\rX\x9dA\xbfo\xec\x82s\x89\xf4B_\xa4#w\xf3\xa1\xf9\x0e!@E\xa9拀|s\xca]\xb7\xcaZ\xaa\xf9-'\xee\xbaU\xd62͙\x9d\xd2\xde\xf0\x11\xeee\x00G\xa0u=\x06\xb3Ew\xd4}\x8a\x97\xb2\x1f\x81Gek\xe5\x98\xfa>\fj\x19@\x1b\x14\x1c\x81q\xe9{\x8b\x9dj\xa4\xec\x1a)D>\xa7\xc9C!)\x87\xb7\x14W\a\x8e\xc0\xab\xe4\xef\xbeJ6/\xd2S#g\xd0P%\xe5\x90\xf6j\n\xe9\x18\x06p\xed\x9b\x10;\xe87J\xba\xb6\x05\xd7\x1aK\xc4h\xb1\xcdJ8\xae\xf1`\xe3\b&f'P\xc6\xd8\xcaH,\xd3\x00U\xe2w\xec\x19\xb9\xa4\x90@^\x98۹\x9c\xb7\x936\xae\xf9p\xeb\xdb|\xc8y+\xc9m$\x1f\xee\xac|Wk\xf9\xe8\xb5\xfc\x03te\xf2e\x14mV\x1b\x8a@\xf5\x8e\xeb\xbd+\x96\xaf\x86\xa8\xa0\xab\x82\xf1\xb9\x1dϬ\x84R\x13\xda\xc0oo~W\xbbi\xa9\xe2\xe9\xadG\xaf\xf1-kD\xc8}7\xc40i\x95'W\x18\xb9\x7f\xe8\xc6\xef\xc0}\xbb\xbb\a\x90\xbe\xdcEQN\xb6\xfb|\xb8\xde\xda\xddA\xa9\xbb\u0380\x139\x1f\x12^N3\xa9\xf8;\xa3\x0e\xc6\x1e\x94\xf3\x84\x8dY\x14ى\"\xbc\xaa]K\x11\x9b\x0f\xc2\xf3\x02\x8f\xdd\x1b\x1e@|\x16{\xe0Ұ\xf6\xa6S\xd7\xfarڸ\xfbڛJJZ%\x1a\xfb\xb0\x87*qT+g\xa8\xbbWK%e-\x12͡\xd5~ʗ\x1c\xef\xd2Tm\x89p\xbf\x16K\x04z\xc3\xcduG뫒mt\xc5\x1aM\rB\xed\x06h\xeb\x96\xfb5@\"\xd0\x1b`.\x86\xee\xdc\x00m)vw\x9a\xec\xc1\xa6!;\xb4c\xd5\x1a\xed\ued5b\x8bd\x85\b\x87r\xbe\xa0\xa3\xca\x19\xf4$\xda\x1e\x9c\xc3\x16\xa6͓\xd46\xc2\xea\xe5\xe2\xdd)\x13e\xeb\x13\x1d\x89\xea\xd0\xca\xe5\xea\xf4\xeeUS\xc93\xe5\xf4\xcc\xd1\x1cZ\xad\\J\u07bdZ*\xa9\x1b\n(\xc1\xde\xcbn10\xb2\xafV\xac\xdb3>#\xc8\xef\x91#\x10\x83(b/\x91\xb9\x9f\x91c\x91,6$\xed\xcb\xe4mKx\x96!\xba\x13\x14^\xbd\x8c\xf7[n^\xb6\xaaE\xacٽ\xf6ל\x9e\xc5\x03hq\xb2bYIo3\x9b\x8f\\\x8ae9\xae\x00\xe7yA\x86 \xf1\xdbK|y\xd8\xc2W\xe9\nH\xadԷ-\xa6\xf6\x95ϻ/)\x7f\n\x7f\xf2\xb6zً\xb7\x97\x17\x8a\x98K\xbf\x19\xe5@\xb5\x9b\xd6\xc3E\x98\xc5\xe4W\xff\xc8\xd0\xe6\r\xe7KEt\xe3\xf2\x99\xaaY\xcf\x01\xa3\xc5\x17\xc6ʏv\x17oI<\x00\x1b\x93\xe1\xee\xe2\xadY\x1a\x8c\x065P|\xe8@\xb7r\xa2\xc3N\xa87-o/\xcfZ*\x93ۗ!\x1d\xcf\\\xf2\xe3\x03\xb9{\xf1\xdbKq\x00\xab\x14G\xe5\x1fh)\xa8\xd1%\x97\xae\xad\x1d\x1e3\a\am\xf0n\xeb\x85}\x00\xcbz1\x1f\xd4u\x04\x90\xb7\xed\xf6\xb2\xc6n\xcfZ\xc6&\xe2\x14<\xa9\x1b\x96\xcb\xedάy\xc1Y\xed\xb3\x8cnT[4#\xf5\xbe;O\x94D1O\xe4\xea\r\xfd\x19\xf42rY\xe9\xf9Z\xaah\x88LhC\xa2\xbc\x97\r$2U!\xe1\t\x97\x86\xb3\xb6\xdaU\nR\xe5\xf2A\x7f\x14<\xc7c应\xb1a\x9d\x19뀎R\xfd\xbe\x12W\xce~3KȤ\x99\xf5\xe1\xe0^o\xdf7p\xb5\xc3\x17\xf5|\xbb\xa0\xf4 \x05ӽ\xf5\xdd\xf0;\x82\xdf\x19\xf0\x18$g\xd9\x02\xbf\xe6\x15\xc8-D\v\x10\xc7*7\rv;\xc5.ΐ2\xad\xa3\xe5*\xc3XZ\xe8\\\xd8\xcf'U\a\xef\xbb>\xffһMr\xd1\xec\xbd\x0fm\xf7\xd1\xee\\\xa5\xb9pj\xee\x90\xf4\x89\xce6\r\xea3\x1e\xcf\xea\xbcү\xad+\xbc\xe1\xd8wN\x9b\xf9\xfa`\x14#\x17\x86\xb6{\xe5Z\x98T\x1f\x04\x90}\x1f\xdd\xf3ť7\xf49⾠\xd5N\\\xd0\xd2.\xcfY\x96\x81\xafeV\ri߅T\xd7휅Y\x16\xfbgΛi\xf9\r+\xf8\xf9B\xb3\xe4\x8f\x12@\xf3\xf7W\xc7\x11m\xe0\x9b\xca\xe7\xb2E|\xe8\xf6<\xa3b\xed\x97\xef8r\xbc7^%\xf6\x9e\xa1q\x02\xa2\x86,J3\xd9\vc\xfc\xff\x9d\xe9c\x15-\x934.\x98\x9c\xa5\x86\xf2[\xdf\x12\x93\xc6\xd8\x1a);\t\x1f\xb3u\xb5\xa4\x03\v#\x9f\xceCb\xb3\f\x1d\x86\xd4p\xdc\x04$j\xff\xe2\v\xf02\x1a\x19<A.o\xedu\x99\xf2i\v \n\xf4H\xffT!U#\xbd\x04\xe2-\x1c\xe9)S\x189Vg\x0eK\x90b\\\xa4\x11D\xda\x00\xf9\x87i\x02\xe3#\xc9\xd13ۑ+n\x1b\xdb\xf6\x11\x02r\xcf:\xa3\xea\xea\x15^\xff\xe8Ӱ\xb0\x9d\x1b\xb1~5/\x0e\x04\x99\x1a+\xfc\xb6\xb5\xf9\xb8~\x7f\xc1\xben(\x85\xd6\xf2\xd0B\x92\xa4\xdde\x99\xb0\",\xa2\xe5NWƱ\xae\x85\r\xa1\xe6\x0e꣠^;\xd5FК\xb3\b\xd5pY\xd4\xcc>\xf5a\x8b\xaa\x1ef\xdahS\xa9^\xf3X\x85cPM\xfb\xac\x96\x19U\x8b[\x85Hͣ\xc8}\xc5U\x8a*\x16\xd4\xeds*\x95\xa4\xfb@u\x9d0/\x0e\xab\xb0\xd1y7\xf2\xdd#\xcc5\xef\x15\x9a=\xad\x88\xedS\x11\xde\xc7\xeb\x84Y]\\\xef\n\x11{\x883\xb4\x9c\xfcko\xab\xa6m\xa1Γw\xd2-\xc7z\x98j0F\x1d\xe4\xa2'\xeb\x90~[\xcd\x1adN;~\t\xe1\xc3@\xaf\v\xc5\xf1xXnV\x1e\xe7\x95Qyb\xfb\xaf\x12\x1b\x85x\x9cY>C\xf0\x90\xfb%\xf8\xbc\x18w\x03\xc2\x7f\x88\xdaZ\b\xd6\t{\x9d\x17\xd5W\xbb\xbf\be\xa8\xba\x047%\x8e.\xe1\x84\xe1\xd6m \x7fk\x9b\x1a\x98\xeeE@\x94 \xe9ܝ\x10\x7f\xbc\xbdl\xeb\xd3\"\xb2\xd6wnW\xceF0jM\xad\"\x8e\x8b\x86\x8f\x1e\xd6;\x05IF\xd3\xed.\x86Yݼ6?\xbe\xd0\xed\xc6\x17\x92b\xc7c\x8d\xa6\xc3\xde\a[\xbf y\xb6z\xa1\x8aa\x06\xdc-\x9f{7A{\xaf\x05\x86\xb8\xa3\x9a\xabE\x134\x87R\xa1\x83\x129\xcf\xddG\xfdPɺ\x8bҍS\x01%\xa6\xd5\x05\xab\x14m\xb8\x9fϋ\xee\xedrh}:K\xeeQ\xab*\xae\xea\xd6\x11\x1eB\x81\xd6\tw\xaf_\x16\x96\xf5h\xc8\xf6\xd5m&\xeb\x97\x11\xdcc\xbey\xb1\xa0\xacB\xf7ٸL\x00\x82\x90Z\x1eǤ\xe5\x8fϊ\xe4n\x9a\x9e\x8f5\xae\xe9\ty\xa7\xae'\xa7\x0f\xc4\xcf+:\xa8\x86\u0604\xba\xb1\xa0\x14\xdc\x05l\r2\b0\x80]3\x11.\x9d\xee\xdc\x06\xa5y\x113\xb2\x94\xd1\x0f\x03\ao\x84\xe4\x9f\x123ź\xe3\xe1\x9a\x15\xabM\xc5<\x9e\x16\bd\xda\x12GpA\x03\x95H-P\"\x85\x82\xa1\x10-\xf4\xeb\x00b\xa4߁`\xb3n\x97\xe2I\x18\xe7F\xa6r+\x96i|\xea\xb4\xde\xe5\x1b\x93\x06\x85\x92\xbc\xc9sEhIߜB\x9e\xab?\x8f\xa3\xed\xaa\xc7\xed\xbbꖺ\x12YW\x7f&+ GhQ\x89\xaa5i\xd6ڲ\x15G\x89w\xeas\x11\xd2U\xee\\E\x17\x19j\x9dh\xfb\x19\x93E\x1c\x84\xa0\x8e`cCQ\xfa\xbf\xb3\"\x0f ߸\x006\x15\x8f\xd2k\xa5\xff\x95G\xec\xb5R\xc5\xc3\xce\xf4{xs\xff\a/o\x8c\xc7.o\xda\x1e\xba\xa4z\f\xa5\xc5k\x96,\xb9OP\x9b\x98\x15uL\x1b\x8e\xa6\xfb|\xc9\xc5\xf7rx\xc5*\xac\xf5\x8f?\xba\xfa\xe5\xd0\xe6\xc8λG{\x8cp\xe9\n\xd1\xddZ\xc4GOG\x93\x94\x10\x1dؠO\x1b\x86\xb9\xb3\xaaO\x1bS\xb9\xbb\x9b6ս*\xcb7u\am\xaa\x83*\xb43\x1c\xb3\xb3&\x8c\"\xb8@\xb8\x16\xc7g\xbd$+\x93\x98\r\xf2M\xd5\xd3\x16ڨr\xacm\xb2\x9czM\xdd̟\xdePs\xb2U\xe7\x8f\xe1\x96\xee\xf3Э\xb8U\xd9\x01\xf9\x8aś\x88\xbdެ\xfc\xa0\xb9+\xc9\xfc\xc3\xee\x1c\xac\xc2\xedEH/\x91\x84ۋy\xbd\x85\xf0\x03\xe0v\xc6Q\x00\xfc}f\xfe\xbb\xca\xd7\xd2Ǐ'w\x9f/4'\x01\xba\xe5\"\xee1YG\x03\xf9\x1a\x9e\v\xacz\t J\xfa3\xe2\x87u\xb4\x84\xe4\xf0Ӡ\xb7\xdd\xcfl\x8b6\xb8\xb1\x88\x968\x10\xb5Ē\x15\xf0\x05\xbba\x05>\xac0\x8c\xf2,\n+l\x82\x9c\xed?\x04B\xd4\b\xa4UN4\x93bc7Y\xe37P\xf6b\xae\xfez\xd3.\xe5\xf8@\xae\x81\\r\xad\xd6 \x86`\x97I\xba\xcc7\xac\xaa\xd8\xdd\xc4z%\x12\xf4eHY_\xfb\\\x85\xf2\xa2\x1c_\x9a\x888\xbf\x96\xd0t\xaeQj\xb1\xe2+\x92Z\xba\x92~\xdf\xe7\v\a\xb1\x8b\xe4+\x04\xcb\x0f\v^\xf0Wi8)y\x9d[\xaa˙\xb9\xef<\xc1\xe9$k7⇧\x1e\xe2\x1dH\xc9\xf2u\x9f>Krv#\xa3+o\x93\xab\xab\xf4\x8e\xdc\xde\xd6\xdc\xe6\xac\xdf\xd6\x1dar\x1a\x17X\xe3\x00\xcaI\x00\xe517'\xe3:e\xd4\xe8\x80݈\a*\xa6\xc5\xdeH\xb7X*\x1e\x8c\x0f\xe9\x9ar\xdc\x1c\xf9帣s\x8c\xc2\x13\xfe\x97,\xc3[\x84\xa4\x18_ۋ\xb7|)8\xbal_W\x12\x1eq\xe9\xb7<\xc6\x1e1*\x84AM\x00GƣI\xd0=\xcfx\xeb\x9f\xc15<\x87\x04\x11_\x9b\x88\x01\xd1\xf5%\xbek\vߵ\x85\xcfzy_\xfb]NH\xf5\x1c\xc3#^\xb2Ɋ\x0f\r\xa9\xca\xe95,|G\xbb\x9c\xc0\x11\xfeBbM\xbb8\x97\xee琏|\xdes\xf9\xa1R\xcck\x19`\x87\x9fu\xca(ۮ\xc3,\xfex\x8dp-\xeePd\xffHE\xe0\xbbf(*j\x94\x82#\xe4$\x9fE\x0e+0\x83\xeb\xbbq~\x06{\xf8\xfe\x9e\x15\xf9\xb4}\xad\xa8\xf4\xbbn\xf9.\xab\xfc\xaa\b\xbb\xe3<*(\xb7yx\x9edRq7-ŔR\x04 \x82\xcf\xc8\xc7\xe6\xe8o\xa1\x1f\x02\xf0\xa2|\xcf^-\vV.\xf3\x94\xee3͓,3A\xa9\xb0Yb\x9edf8\x04\x9ei\x88W\x8dU{\xaa\x9eK٢`\xef6,\x8b0\xa6\xd3\x18\xa6R\xea\xb6NW^c\xa91O2N\xa3\xb0U^h\xf23O2~LV\xd7L\x97mI\x1dx\x98kg\xea\xfbT\xcc߹\xbc7(\xb6\x9b\xed\xb3u\x17G\xe8\xadn#\xb5/!m\xe1E\xfd\xe0\x1f\xbd9\x05\xcf\xeb'\xffLDF\xf3\xf1\xdc7)\xf9+\xee\xb2Et\xa0\x86\xbeT>)\xbd3\xbb,\xf7(\xbfn\xa6\xf3\xf0\x06|\xbc\x1c\xe8|-WZIְ\xcb\xd6\xdd\xcb_v\x14\xb2Sh\xa6Z.\x90\xc6\x06[\x89\xfd+.o\\\x10\xdd _%\xd9\xebjS_UQ\x19\x1fk\xb2-\x8c=y\xe1ؓ\xab\xaa\x8c\rKM\x80\x1crw'\x80\xf3DU\xa7q\xa8\xd5\av\x1f1BWܝ\x16\xce|Y\x9b\xea\ns_-܂\xf4\xd3\x10\xa2\xdaeUt\xf6\xe1\xb7\xe8|\xef\t\xfd\xb2\xf5\xf5㑏i\xb5.\x7f\xf7q\x01\x16\xa5k\x1f\xe0\x1a\xddÇ\x87Ƀ+\xf3\x83=\x19ԇ\xa3]\xd7.\xf1\xf9+yRH\x87\xb0\u009d\xc6q\xc0]\xea\xa7դ\xc1U%\x01h\x18|\x8e\xc2\x1a[\x02\xf2\xfb,M2:\xef\x0f\xabи\xcf_\x1f\x06=\xb0\x8ek4υ\x19\xafB\xcf\x11\vx\xfc\xe97N\xdaK\xf3\x9c\xbd<\xc4Q\xc1>ŗ\xd3a\x1d%\x86\xd3\xd2Ge\x18\x1f\xa6\xa5c\x98)fsJ\xe8\xe0\xed\xad\xe4\xb6\x11jN\xbc4 \xe8г\"\xa1K\r\xf8\x1bZ\xf5\f\x8dC!\xfb\xad#4\x06\xf8\x10\xd5'\x90\x96K\x18\x15\xf6u\xff\x8b\x19\xdc8܋\x1b\xa1 \x8c\x12\xfaIq\x8bl\xa0\x01\xac퍖\xba\xb5\x8d\xb0\n\x84X\xb8\xc6\bD\x879`\xdc|:\a\f\xab\xef\xdb;\x9e:D\xd2lx\xa4\xbc\xf5\x0f\xe1\xe9M\a+;F\x14\xb6\x1c\xa6b\xb4\xecc\xf9\xdeN\x97\xf3\x96\xb3CT\xe5\xfaeZ\xdd5\xe4B{\x17M\xa8\x18r\xfd0\xa5\xbd˻\xa3qܪ\xea\xc4c\xb3@\xefr\a\xcc\xcbZ\x00D\xc3\x1c@\x7f\xa9G\x8cJ\xfb\xacG\xa4\xaa\x16kj\x91ukm\xba\xcf\xf5\x0e\xd3\x15LCvW:>\xf3\xc9\xf4\xbe\xea\v\xd6 \xa0\xc8\xf3J\xb7\x9a\U00071169\xf8清\x1f\x93\xdd\xf3\xa5V\xaf\xc3\xe8k\x0f)\xed\xa1zݛoϩ\xec\x9a\x0ee\xef\xe14\x15\x10\xe1\xca+\xb8\x8em\x8eɴ\xbb\x18\x19)\xc6~\xa2nEU0\xf6\x97\xa4L\xaa/\x17\x15+\x04\x8f\xeaݻq\x81sX\x18\x17w\xc5$\xe2\x0e\xeaڅ\xbe\xce\xc6ֿN\xe6i\x92]\x99\a\x9f\xedN\xf3\u05fa\xfb\x1a\x9ad\xa8u\x05\x1c\xc1m\x00Ʒ\xe9a#ëX\x9b'\xeaSlW\x1d\xb7\xebڼf{/^qn\xe1L[\x9c\xb5\xbdX\xfc\x11,\xfa(\xaa\x06\xedT݇\xb9\x1f\x1a\x9d\x8e$\xffZ\x84Y\xb9ȋ\x95 \xe8\x96\xc7 Z\xf2?\xb8ɾ\xfe4n{|\x91b\xf1\v\x97,v$\"\xb7s_t\xfd\x99\xfd\xfa\xac\xd3$\xaa\xd1\x115\xe8\xcdGG\r\xea\xbf\xd9W\xf7\x01\xee\x84x\xce\xde\xe9I\x18\xa5\x9b\xb2bE\xa7N\x130\x9fF\xad\x05\x80\xef\f$\xf9\xa6\xfc\x89\xbb:\xf3\xee\xbc\xde\x06p\xbd\xbb\xab>\xb3\xe3\xbb\x1c\xe2$\xdd\\\b\xdak@\xf3\xaa\x95\xf4\xa9\xaei\x12\xfc\xf8\xabB\xe2\x9f\xd9\x05v\xae\x02\x7fs\x15p\x1cW\xa9*uN\xd1\xf92\x9a\x91\xd9:,B\xcdW\\\a\xf2M\x03\xb1F\x8c\x91j\xe0\xb5\xf7\x19\x1f\x9cN4)[T\xae6\xfd\xc0\x16\x15\x9f\x9f\x03(\xc4%\xa5\x06\xd0+̐P\x14\t\x11\xd1\xf1\xf8\x14us0M \x11\x11\xce(N-%`<\x03\x1d\x96\x12\x03Bc\x9c\xb8\xdcWp\x14\xcb=\xf1K\x06\xfc\xc3\xf7<\xf9\xefGr\x02;{\xd0இ@\x9e\x98\x97\xcfe\xfa\x91\x9c\xa9\xa70\xf6\x15\x02\xed\x05\xedO\xa91\xf9s\x12b\xa7\x84\xbf\x03\x8d_0\xb38\xf3Ze\xb5hR\xd1sC\x03\xc7=T\xaa*^;Wi\bm\xf5*j5\x03WIJ>\xa3ZwջW\xbb\x8aB{\x14,r\xbbS\xbb\"\x80\xa5ZU\xde\")\xca\xea\x7f\x86\xe9\xb55\xd6\xc5\x02\xe2P\xd5\x17\x80\n`L\xe9$\x02\xed\xfap\xef\xc6X(\xff\x80\xd3G\xbb-\xad\x84\xa1\xe4)3\x00\xba\xb4W\xe54Ϩ2b\x1b\x174,\xde\xdd\xd6\x14\xb1\xb91\xef{\x9c\x19\x005\xdfD\r\x069\xd6MS\x8d\xb6p\xbd\xce\v\xea&W\xb9\xba\x19\x16\x06\x03J\xd2\xd5v\x1ah\rD|\x81\xc24_I\xfe\xae\x92\x98\xc7\xe2\x9a\xc1\xf0\x14\x1e\x81Ws\x8e\xf7\xe0p]\xb04YA\x1f\xa2Fb#\"Y\x87\xe8\xe0\x7fr\x7f\xc31\xcelI\xb3+l\x9d\x85$\xfa3\x17\xf6U\x8e\xdc1\xab\x1a\xa8v\x1a\fkΊM\x1a\x9d\x05\xbb&\xd6\x7f0\x1f\xda\x0f<j\xa7^\x16\xe5Y\xac\x8d\xf8\xads\x96\xaaGqM\xca\xf6\xec#\x97A\a\x0e{Ǖ$q\x91J\xa3j\x95Ǉ\x0fg\xad\xd1\xe6\r\xae\xbbr\xaf\x1e\xbe\xce\xce\xd0ƯuϾS(\xc8}4Y\vF\x06p\x93k\xbf]B\x82 +\xc9wn\xcd\x1d\x1aʱ$l7ɺ\xe6V\x00%\xa1\xbd\xc9\xcdD\xc2\x7f\x93\xacLHJ\xcc\xcdDT$\x0e\x9es\nM\xa5\xc3\xd7c7\t:\x8f\xf1\x96\x99\xf9\xb4\xa8\xbbI\xd6>o\xe0\x17_ T\xe3\xd2\x7f\xbej)\x98\xaf\xac\xe1\xcf9\xe6\xa4!_7aE\xb34\xbdl[\u00817\xd7`M=&I\xab\xd4\xdcU\x8a\x06\xd9n\fٛdE\fp<\xa9\xcf\xf1[\xc6\x05\x97\x02\xff\x91\x82\n\x1aI_\n\xc29~.(J\xf2d\x02U`U\f\x9c\xc4Y\xb33)/o\xcb\xfb\xf0\xc0\xc4A\xaen\x86М=p\xd4b\b\xa0\x05\xc1\xdd\xe5\f\x19\xb3!8\x8e\xbc\x05\x87\x1d[R\b\xd2\xc36)h\x8a\x97D\\-\v\x16Ƽ\xaf\xdbd\x05g\x16\xe4\r\xef\xe7|\xddM\xc9\xdaA\x89\x12]\x87\x9c7\tY\x9f\xb5\xc0(B\xd6DȪu\xa9a\x8btG\xec\nY\xca\x19\x92\xf0\xfe\x86Ż\xec\x95:\x97\x9e\xb5\u07b7nxI\xc2\xf9\xb1D`\xee@\xd3d5\x05#\n\xfb*\x8f\xad\x14\xfe\xa2\xaf\x95H\x03\xc0J\xe3\xc7\xc5S\x9bJ8o\x99\xc09<\x1e\xe4\xe0\x8e\xb9\xeb\x8eX\xbd\x94\xa4\xfd\xebكƜ\xc5\xd95\xc0?\xee%\x98s\xff\xccwa\xd8e\r\x13\xa1\x14\xc7U^\xba\xb7\xd5{\xcaҠ\x12\x85c\xc6\xd6w)\xfb5ckV~\xb6}z\xc0\xdf~A\xaaę\xee\x1f\x7fԇi\xff\xa0ݻv\xc2|\x84\xf456\xea\x001KY\xc5\x1c\xbb\xa7\x7f\xe6=<\xc9\xe2\xdfw\x03_\xb3\xe6\x83N\xc3gܺ7jܻo\xc7\x12\al\xdaW\xe1\xba\xfb\xfe]\x14\xa6\xf5\x01o\x00צiT\xf7`\xb3\x16\xd5j\xdf\x1b\x16,\xdc\x1fb\x12\xa1P\xb6\x1d\xbb^_\x057\xf0Љu\x04\xe70\x82\xa9\x11Ҕ\xa0\x87\x02\a\x0f\xeb\x82\x1ft.L\xa9\xcfg\xaa\x9cN\x8f3\x04B\xf9n\x13\x16\xc9bw\x87(\bm\xa7\xef\x1dFWDW\xb0\xa8\xe2\xa78\xbc.\x9c\xc5n\x85/F\xc1\xf0\x81\\n%Wh\xca4\x89\x18\xde\xfa\x17\xbc\x9d\xb3\x12\x11ԯ\xa7\x95Q^\xb0\x006\xeaH$\xc9<\xace\x88\xae\xd1\xfc\xc7\xce7\xb65\xbc\x87Um\x12j\v\x8f$\xbc\x11\xb4Bcz\x91\xdfJ\x967\xe3X\xd0>KaU\xedo\xac5\x11\t9|ȎWe.2\xf2\xc1\xf3\xcf,h\xaa\xb2?\xd3\xfa\xbc\x11\x97\x9c\xb8\x003\xb8͋\x12M\xb2\xb7\x01l|\x1f\x85\x00\x19\xd6|iGR\xb9\xceמo9\xf7q\x0e\x13ƽ{wE\xde`\xc6\x1b\x86\xf8\x1a$j1#8i\x9c\xe7\x01?\x8a\xb5\xea\xef\xeeɳF\xf5\xf2\xd9ɖ\xdeњ$\x85f\xdf\xfa\f9Zcn\tLb6\xc4x\x85\xf7@\xca>\x98\x83\x19\xa5]^\xbf\x97\x03\xd2\xef\x1e\xb6\x18\xa1\xef\x1f5l\x0f\x19\xacrl\x7f\xf6\xb1\xd7\x18J\\\x10\xbb\x06\xde=F\x19\xcfy_\a\x80\xf8\xbdC\xc6%\xf0\xb9j\xe2T6Q\n\xcdÆ\xb6\xb8\x87t\x7f8H\x9e\x84\xa4t˓\xa1<\xcc[\xe7\x1a\x01\x01\x14\x01\x14\xeaZJ\xb1J2ml\x99>\xdeu\v\xf6χ<\xf8s\xc1K]$\x97T\x99\xefC\x94gU\x92m,\xc3v\x01ϩj_\x12P\xd8\xf9/\x88H_\x92\xda\xd8f\x95\xf0h\x06\xeaB\xed\x06\xbf6\xf6-C8\xaf\xcf\xd97\xf0\x88\xe3z\x04\xb4N\x82#(\x03(q9ʳ\x92Lf\xf9x`gj\x9b\x0f-\x81\xa1,}\x98n\xcae\xfb\x82\xa3f'?`%y\xda\xf2k\xc7\xf4{\xc7}\xe36(u\xf9&\x8b=%5Gإ\xb8\xbb\xaao\x93 \x9b60\x9bI\x01\xb5;\x83\x88\xc1\xf5\xc4\r\xbc\x100;\x9f\xf0\x8b\x8fü$sգg\x8d\x88َp\xd9;3\x89\x02V\xdd4l\x929w\xdb7\xe7\tܤ\x88\xb6\xe0\xf6\x00y!\xf9\x90K.\xdc\x10\x17\x9c\xcf\xfa\xe6\xc3\xf7\xc2\xfb\xfcLK\x8b\xb7\xdc\xf1\xaf\x89\xffL\xdb\xd0#\xef\xa1oP*\x98\x04\x03-\xb51\x87\xb6\xf0y\xab\xf3y\xfbw\xe1\xf3\xd6\xe6\xf3N\xf0y\xd7\xe0\xf3N\xf1a\a\x03\xd8ݏ\xcf\xca\vK\xebi\xc9h\xab\x02\x8b\xa7['\xa3\xb7&\xa3]\x83N,\xff\xdb\xdc\x1b\xb9\x92d%v\x85\xee\v\xf9Q.\\\x94\x12k\xaf\x06\x98\x19ګ\x01\xfa\xb0\x94\xa4\xf8M\a:\xcb^A\xf3\xe9\x05\xa7\xf02P\xd5=R\xf8\xc5i\xb59\x9d\xaa\n(h\x13\x9f\x1b`\xaa\xf6\x01\xbeY\x89\xa2h\xe7\xd7L\x9a\x99a\xdb?2\xa6\x17I\x8f\x144\xfap<\x9fR;\xd1\xe0\xf4Kߍ\xdd\xf3*\\\xff\x12\xc6?Q>\xa7Y\xcaZ\xa0h\x0f\x84\x02\x97\apc\xe8k\xfer\xa72P\x99@\xf7ٷ\xb9\xabp\xdd\xd8[c\xbdݏ\x96i\xae\x89\xf1\xb7]\x8e3k\xacV\x98\x0f9fi\xab\xae\r$ͷR\xd6\xe6#\xc7N\x0e\x8b\xea\xa6N\x00\x8f\xd7!\xae\x7f\xac\xed\xeb\x1f\x17\xb0\x0e\xe4\xff\xc1%La\xed\xb7\xc6Q^\x87\xf1\xcb<+\xab0\xab\x1a-l\x98\x05\x1a\x14l\x9dO\x9b\x1e칅,\xc4Fh\x9e\\0\xab\xfd\x14\xe9ͺ\xfd\xac\x82)\x8f\x9e\xaa]\x88\xf1\x9b\x91&\xb4\xae\x84)px\x93m\x14\xb7\xfa\x82\x1e\xd1\xe5\xff'ƅ\xe4\x0f\xf6\x86\xf6y\ai\x93#\xf0\xeeb\xceK>\x947\xb5j\x05\xc7\x11n\xe5\"\x8a'Lm\xb0\xfd\xa4\xd5\xe3\xf8\xeeC\x90\x8a\xaaA(\x11m\x8d\x94\x84\xb5\xbc5\xd7\xc9/\xa1B\xee\xc1/,\xa9j\x12h\x0eQ\f\a\x99\xc0h|\xbb\xad`Qy\xc3\xf5e\\\xdex\xbd\x80^\xacb\xdb\xea(*oz\xf2\xdaie\xc0\xfc\x7f\x14L\x15\xce\a\xc2D\xc8\xe2\x01\xbf\x9b\xa8\n]\xb1\\ź\x12\xde\xd7W,\x7fS\x84q\x12\xd2m2\xf9\xee\xee\x11\x8c\x9f\x8e\xeaB\xc3\xf0}\xb2\xdaT\xcb0\xed4\xc9)(/\xca\xf3\"N\xb2\xb0\xb2\xef2\x91\xa9Z\xcbś\x91\x8fl:\x06\xf4\xf2\xf4\xce\x06\x1d7A\x03\x88\xb6cIw\x94\x97\xdev\x8cF\xdb:\xadL2\x9e\x16\xed\f\xb8\x1d\xc1\xed\f8J\x8b\"\x98\xe1I\b\xa3\a\xeczyQ-\xf1\x96\xd7z\x99D8\xae\xcb\xdd\b\x1eQ\xc1>D\xf4;\"\x8b5\x921\x15\xd3]Ľ\xaa\t\t\xa9\x85\xb2b\x05ӱ\xa0\xbf.\xcdfQ\x84\x8a\xa0\x86\xbc\xca\xf2U\x9e\xd5@Qdd\xb3w\x9b\x84\xc7\xe0\xaeH\xc9D\x92\xfc\x10\xdb\x14EH?\x9cC\xa4^\xcbK2/\xe2\v<\x1bO\x98\xe2\x02\xb0\a\xe7ڌ:\xd1\xc8\xc2\x02c\xbe{\xb9V\xad,\xe9\xf5?\x91\xe4\x95V\xfb\a\x82#\xe5nl[\xe5/\xf8\x02H<pU\x15aV\xa6a%␊\x9c\x9d\x913\xbe\x94f\xf4z}\xc2\x1b`uJ\x00<&\xb2D4\x83\xc9h\x14ԘH\x0f\x9f<\x1d\x0509\x1d\xa1\x16&\xd9\x1a\xa1D\xa0\x1b\xb3\xbc\xf8\xaddw\x98d7̼\x85\xd2.\xcdd\x176\xa5y`4\x0fOA\x88.\xce7ϒ\xe7\x81\xd1b\rxm\\\r0_\x06\xe3\xb7\xe4:\xc4K\x05<\f\xab0\xf3\xd6\xed\"\xd6\nd\tں]z\xea\xbaP\xd8h\x9dF\xb8\xea4tc(#}\xa0Er\x9c\xa9\xe1\x185\x05\xc6ێ\xa0_S8!&\x94\x11\xb2\xe6\x91\x1cz\x91\xe0\x89\x18\x95\x91\xcfÇ\x9a\x1a\xa2\xa6$\x8a\x04\xec\x00\xbc5\x9c\xf3\xa2\x91@wD\xad\x1c9P(A\xb4%E\xc8\xe3=\x9e\xd6\xd5\x0e\x9f\x05\x12\xec\xde^\xcf₪\xaa\x85\x00.\xf9\xf7\x8c\x16\x86E\xebpa\x02Q\x1d\xa8\x8a\x02ZP\xb2KG\xd7\xd1Kj\xb0q;XDp\xb5\xf6\xad7\x9ce\x9dC:\xb8q\x9fh\x1f\x0f䨿ǚ\x03K\x1a\x1b4\x985\x9f\xdc\xd9W\xbf\xaeh\xeeN\x83*-k5\xf5V\x7fK*\xb2\xbf\xd5\xf4\xe1\x1e\xca\xecL\x192\xfe\x02F\x01\x8c\xe0\xd2Xi\xd0ܞ\xcey\xa0\xad\x8e\x89\x9d@\xda\xf5 m\xbaqJ\xb0\x06\xce#h(\xc74\xbb\x1a\xf9\xb6~{\t\x03R$\x19<\xaaE\xa1\x13\xd7\xf8\x92\x06j\xd6:ϬuT\x95\xdf:\xebx\n\x10\x05\xb3\xf2a\x00\xeb\x91\xdf9\x15i7c\xd3<\x8c=\x93\x17\xebe2\xe6+3\x93z\\\x92\xa5)K\xc5%\x8ce2\xe9\x86\xc2\xcbYiX\x8d\\Pj\xc0\x05P\xea\xa3\a\xeb\x16Ӄj\x12\xa5I6!\xf7;\x10\xd6V\x8fLm\xbaK\xe8\x1b\x15L\xea\xf8\xe0/a\x06\xa4>\xa1\xaf\xbaO\r\xf9\xf5h\x7f\x17c\xfb\x9c\xfd\xc8\x05Ξ\xff\x95\x96\xba\x80\xc1\xb3\xa7\x01\x1c?\xe5\xbb*\xc12ʘ<\x1b\x9e\x06pr:<\x85\xcbzI0f\xc7]K\x02d\v\xdd1~\x19\xc0Z.\b\x88\x84\x7f\x92\xd5\xc0\b\xc1\xd78!\xee\x02\x1am\xfa\xbcH\xf9~ۚ\x01\xcb>\"\x88\xe6\x14\x8b\xedFQ\xc7\x1e\xe8\x9e:\xa9\xff\xd6b\\a\x1ff*\x86Sv\xc0\x94\xc9y\xf9駫=\x1aR\x0eP\x171\xba\xd8\xdc\xe7\xb1yQ\xba67\xe8Rxo\xaa>\xfbl\xa6\x0f,\xa3\xe6\x7f\xe0<֤\xc9\xc1\xa9\xe6\x94\xf5oex\xc0\xac\xf5oe\xd8>dS\xf2\xa21\xc7,\xa9\xdd\xc6\xd6Ӣ\x19U\x17\xbc\x80St\xdd\bӰ\xbc\x0eaJ؞\xc3`|\x82\xa9\xcb\xf06L\x12L\r+x\x0e\x13\x8c%\xb6ް\xa2\xca_%QNз\xac8y\xea\x1b\xd4\xd9*O\x00\xc1\xccl\xba\x14\x1e\x04\x11\xd57 \xea\x89\x7f0~<\n\xe01N\xfe\xb5\xd8{\x17pz\x1a\xc0\xe3S\xb8Ԑ\t\xaa\xf7\"\x9b4\x90=\r`\xfc\xd4\xc0\xa5\xb5\xb6\v\x1f\xa2\x1b\xefA\xa7\xba\xf2#\x06\x88`%\xc7\xe0\xd5S\xa2\x91\\\x1b!9[e2<\x82\xe1c\x95ǹ\xd4(R7X+6\x1e\x9e\xfaN\x81\xc7֨Q\xe2I2\xea\x14ߥ \x8cB\x1f\xc5\x06\xad\"݄\x1a㙏\xc5*8\xe2\x13\xa8\x88\x0e\x88C\x84\x0eD\xb6ڸh\xa2m\xb0\xb2κ\x00:\x99;\x19\xe1\xb6-~O\xe8\xfa0~\xc2?\xe1\xd2ft\xa3\xe4\xf8\x99Qr2j\x94\xd4\xfa\xc2*݇ӧF\xe9\x93\xe3Fi\xbb\xa3\x9c+lS&=\x8bi\r\xa55ϳ\xac\xfb*\x1aAt\xaf/\x0e4\x9d\xed\xb7\x9c\xe1y\x9d\x11\x9ae7v\x1dHDh\x0eڍ\xf9\xf1\xe17\xe2\x91\xf2z\x1f\x87\xa2\xb16\xdf=7\x16\xde\xdf\xf8\xe6\xc3\xdb\xc6b\xfb\x1b\\lG\xe3\xd63Wz\xa6\\\xabKG\xf5H\xbf\xb1\xf6\xe1s\x18\x9b\xeehMڍ\x03\xd5\x16\xea\xc7\x7f\x86\x95cw\xffF\xaa\x7f\xdb֎\xb4\xb8\xb7\xba\xb0\x96\b\xab\xe3\xc9^\xb3\x86G\xd6\xda4\xf2\xe1\xc8Ջ\x8d\xee\xb6:\x15\x91\x1e9\xfb\xbf\xd1\xdd[\xc7\xd2u\xb7oM\xca;IN9\xf7R\xa5\xbbq\xa3\x12Ic4\x16\x117\x89~\xb4\xb0\x91\xady\xdb\x18\x87\xb6\xca!\xb2\x1c\x84~\xc4\xda\xf9\x02\xbb\xc6\xc1\xa1Q+\x8b\x84\xf9g\xbb\xd7\xf2\xb3\xed2\xfa\xeci\xd1\xe7^\xea\xb6\xd5\xfb\x8f[\xe86)\xd23\x1a\x86\x1a%\x9c\xde\xc9ic6A\vm\xc1\xa2*̮6i\xd8\x1d@\u0082\xbd\xd3\fs\x04ǏŌ2\xb0\x94\x0ee\x9d}N\xdd{ڥ{y!\xabm\xffLZW\xb1\xe4\xf81.0\xb6\x01\f\xf8\xaf\x9d\xa5\x8a\xec6|\xee\x91a\xd5\xd7I\xcb?n\xb4tQ\xd9\x0e\xa2\x8f\x91\x15+\xa2P\xdczo\x1d\x1c\x12\xe8ޣ\xc2#\x15\x9f\xe6W\x9e\xd2\xf5\xf51\xea\t\U0010dcae\x95\xd8\x11L\x1c&\x14\xff\xde\xe3KyB\x0e\xd0\x14\xa6\\\xc2\xf0\x03\x9f\x93\xfd\x84\x83O\xf2\xee\x9f{ԙ\xc7a\xf4\x8bmמ\x1a\x8c\x8d)\xb9\xd9\x190\x80g#kԪ\xc6\x7f\xee\xe1*+r\xd7\xfe\x8f\x1b\xa0N\xba\x1cy\xfa\x90\\\x87\xd5R'\xd4\x11\xc0\xadZZ\xe18\xb4\x17\x8d)\xde\xc0\xab0N6e\xe3Yc\xca{\x99\x14Q*\"\x0e\"\xaa7\x11%xZ\xc1\x96'\t\xeb\x9dcX-\x7fݭ\x99\x16蓻֗\x9b\xb4\x82\x19\xcc7\x8b\x05+\x04#\xe1\\~\xbf͓\xcc\xeb\xf5|\xe1\x06 \x8b\xf2\\+(\xa30n\x95\xea\x1d\x1d\xdb\xeb\xb8\xc8߲\xa8r\x0f\"Q\\\xc0\xd8cM\xd0\x11\xf4|7\xe6<\xdd]\xe5ٗ\x05밊\x95\x9b\x15\xcc \xb4`pdr\xaf\xf1\x91\xb8VU\xe7\xedq\x1eG\x84\x03\aƤ\xb1\xe3.7\xab.\xba_\xb2\xac*\xf2$n\xa7]\x00*\x8b\xd3j(R\xac\xa6PH\xf5\x9a\x87\xbe\x1fȋ^\x02\x9e\x1cd鎄\xa8S˒I\x1e\xbf\xc1E\x17\xb8\xf8\xefG\xdc\t|\x8cb0\xc0\xb3\x18\x9a<\x048\x8d\xa5\x9d\x9e\x80'8\xef\x05\xaf\xef\xc9\xdb\xdf\x1f\xd4\xf7\x1a\x0eiz\xd2l\xfa\x99}\xd7\xcddA\x9d\xfd1\xac\xd0\xf7u\x03\x83'\xfa\xfer`0\xa7\xcey/\xe5\xa7}\x13\x18\xc0.\x80\xc7\xf0\b\u07b7\x1cׅ\xadB/\x90\xf0Ib^z\x1d\xfck0O0\xa9a\xb95T\x15\x9c\xe0\xec{\xa8\x86³\x1cU\x83m;E{\xb6\x1f4\xf5\n\xd5)TW}ćzӓ\xcd\xfc\x96\x85զ`/\xf34帵\x87%r\xdb:\xb0\xe0\xc0%\xbd</?\xcc[#2ՒЦ\x8c\n\x15I\x97z\x94z\x95\xa5Q\"\x91۬*v\xda[ɁIt\x1b\xa9\n]^#i\xe0\xf8\x05yۆA'\xae\xf7cO\xf1\xdeˇ\xba\xb0\x18\xdd\u05ec\xe2\xc7MZ%\x9d\xf5\x90ɥFH|վMֶ\x8f\xffn\xee\x1a\r\xb0T\xed\x9e\x16\xfc\x90d\xecuU$\xd9\xd5go\x81E\xb1\x7f\xa0\xe4\xb45\xab\xf7\x83\x8eB\x16\xd1oL\x9a\xdd\xf4\xd9[\x1a@\xb9\x99\x1b\xf0\xf8\xf4\xd8a\xd7C̒\xd6\xd6ü3\xf2\xb6\x11\xb7\x8b\x9e\xa5\xdc̛\x14\xe9@\xed\xdc\xd7({˟\xe7p\xf1߬\xe0\xe2m\xb3\vܝ\xa0E\x0e\xaa\a&)\xd8\xff\xa7u\x03]\xf5m\xed\v<\xbf\xf0]\xb1m\xba:\xe6\xd3u\x8d\xac\xe7\xc2\\\xc8\xe2\x8df\x98A\xef\xdf{{\xa2>Y\x1a\xef\xef߁\x94jg\xe0\x03\xae\xff,\x03\xcc\xee)\xb3\x97\x1b\xc47PZ/\xe4\xf18\xc2&\x1dB\xc6ּ\xec\xdd\xc4l\x9f\xa0i\r\xc0\xa8\x06\xebVQ\xb3\xa8\xbevK\u06dd\xe4M\x97\xb8N\xe9\xfbW1\xd9\x1f\xb6\x98\x11K\x83D\x88`\xfdiJ`\x9d~\xbf%M]\x1e'&\xfb\x12\xaf~f_\xb0P,\xd4ִ\xe8\xe4\xeb\xee\x8f^\xb4\t<\xa3\xe0\x93.\xdf\xe4\x8dkI\xb5\xbetk\xdeH2V\xe9\a\xaeⴢ\a,\xe6:U\x8e\xdc\x1ek\x1b]s!wv_EƷ\xc5\xe89\xfe9Vq\x88\xbd?k۠;9\xad6\xcb\xf7\x1e\x17\xaaI\x9f~\x80\x88\xf6\xa8.5\xc7Fg[\xc4Kl\xeaZ*\xcd\"bW\xa8\x8f\x1am;\xda1r\xf6\b\x8a\x8e\xf8c\xe4N'\x12f\r\x83\x85[\x06\xb5\xfd\xab\xb6!\x86\xa3\xfakr\x19\xe8;b3\v.\x1bT\x1e*̵\xa6\xe8\x94fY\x99\n\x14\xbe\xe3\x7f\xde\xf3?\xf7\x15\xf6\x9a\x16\xe8bZ\xeb\x00\x10\xb7˝F\x04q#\xdaiF\x00xo\xe4M\x9c\x8f@\x1a\a\xcb\xef\xf9Y\xf2{\x8d׆:'I4\xb6\xeb.+\xac\x01Ќj/y\xf5A\xc7i\x18\x10\xdaL\xbb-\xcfa\xfb\x96\xfda{f\x1f\xb7\x9b\xf9\xb5\xd1\x19\x0e7S\xb4\xd8b\x1aͱ\xd2M\xa7\x98M\x16\x1b\xed\x13s\x8bl\xa5\x16\xbc\xad\x0e]\xa1\x1eE\xae\x93d\x94\xb6A\x9d\xc4\xdfP\x1e\x98\xf1\x1d\x84\xa2\xe0\xf5\xcaʴ\xd8fhF29\xbc\x85\xe7\"\x80\x9a\xa0c{f\xe4\xbe\xe0u\xfb\x8a\x04#\x7f\xa7=\xab\xac\xc8ޙ\x10/\x90T_л;3\xc4K\xc9\xe2\x05U\xaf\xda~\x19\xc0\x05\xaf\x917T\xe8\x02\xfbj\x1fo'\xea\xb6R\x05\aTzр\x11\xa9\xc1\x83\x96E\x87\v\xb8\xce\x0f\x1e\xb4M>F\xb9&\x00/\xa8\xefȍ\x02uF\xf0@\xa9\xb7Vh+7x`[f\xf6\xe3V\xaa\xb3\x89Xdq`\x17BJ\x93\xd9.4\"\xd5\x1a\x03\x91\x1cj\x1dO@\xf0\xc1\xe7\xc3\xef\x96M\xf3&)\x93\xb9\x1c\x96\r\x83fXDC~\x8d+R \xcf\xc1pӰ\xf0Ei\xb2n7\xae\xefۭa\xe95\x8bE\x9c\xb0\xf5(\x80\xf58\x80\xf5$\x80\x18\x1f\x17\x1e\xef\xb7a\xc7c\x98YdO\x1a[4\xeb\xf9\xb4x\\7\xcao\x06\x9c\x1e\xfb\x92.\xf1~\x1b\uf46b\x82\x85\u0557E\xf4}V\xb1b\x9d\x93\x7f \xa7\xd6\xf7\xbcx\x04\x03\xd0\x0ebyB<\xf6uc9\x98x\xd7\x13#\x8f\xee?\xac\xc7օegx.\x82ZOl\v\xc2\xc3\xf5\x88B>\x89J\\Q\xae\xe0\xe0\xa6\t\xb8\v\x13\x1b\xed°\x97|ϫ\x8f:c\x1e\xbc1\x1e\xf3߾\x1dn\x9d\xb7l_p\xa3\x18\xc1↓\xdezd\xf5g=w\x13\x1f$\xa1##\xd0\xc6z\x8c\xacXO\xe8\xad\xec\x19ޅ\x10o\xaa\xae'\x17c\x954\xa6\xa4\x87\x9e\x067n\u008d\xf9\xed\xa1\x9a\x8ff7\x8e\xdb浂\x95\xe1j\x9d*^\xfa-\x97\x82$T\xd7(\x1a\xb5\x0f\"n\xe2\x90hp0e\x14\x87\xc1\xe4\x19\xc5b0\x96j\xb2D\x19\xd4\xf7#p$\x95\xf9\xa6\x884/\xdf\xf6\xc1\xa70\x98%\x8d\xc1\xc7#\xdf\xd9+3}\xd1h\xbd0\xfe\x96\xb7\x16\xa7=\x85_\xae\x0fA\x98H\xce\xc0\xaf\x1b̻A\xc1\xa2\x11\xcb\xee\x10\x8d8\xdeT\xff\xcc\xddUq\xc7\x15\"\xeee\x15@̮\nFm~\x86\x83|8\x1a\aP߈\x97\xb9͛\xe7a\x11\xd5g3r\xc8y\xbeEذ\n\x8b+F/*%1˪\xa4\x92[\v\xaeӇ(L\xba\ue3dd\xeb;I\xb7\xb5\xc8k0b\xdf\xe1\xb6zp#Y\x8b3n\x8c\xa2Sk\xa8\x0fڎK\xc0|\xb6\xc3$:J\x93\xb5\xf8\xc3E\x92\xd2+G\rFit+<\x18/?\x87\x19\xfc<\xc7e\xf50B\xf63/\xf7\x03\r\xbff\xe2\b \xbf\xf3ɒf7\xda\t\xbdԶGT\xf4)\xf8.\xfa4\xa4\U000a74fe\xce\xd3$Q\xa1\x9c\xff\xcd\xed%V\x9f\x7f\xfa#*\xd9E\xa2\xd2f\xf3\x9b\xea\f)\xa9\xf1\x03\xc5J\x99B>ĿF\xa4麤\xa1\xd6\x1c\xa1\xa1?漊\x967{\xb6\xe2\xeeF\xb4\xf6f\xab-\xd9٧\x9f\xe6\xfcI\x8d\x1c5j\xda\x1e\xa8\x13m\x8a\x1b;\xf2\x0f\x7f\xe7\x86\x7f\xdcAA\xdd\xe0.\xaaѭ\xe3\xf3\xf4\xd8'\xa0~o\x0f9\x9a\xf8\xe1\xe0\xfe\xad\x9b\xfe\x8f\xeb\xe2Od\x92\xbfǴ\xd00K\x1e\xa2\x7fy\xcd\xf5\x87\xd1&c\x0f.f\xea\xcf\x18m\xa19\xd3?\xdc7\xd37\xa6t\"\xd2|\xba\x8b\xd3\x1dfW\xf7\xf4O\x14\x8b\x1fU\x93Z\x15y\xf5\xa2\xa9\xbf\xf5\xf7\xdd\x00p\x90&+8\x1e\x16\xe25/\x82\xa1UU\x00\xbdu\xc1\xa2\xa4\xa4\x96\xdb\x16#\xb9\xd2\xea\xdc/\xd7\xcb1c\xe5\x1d\xc3Le\xa9\x1df˒\x89߳\xc6mkş\x98\xe1\x04\xc1\xd1\xff\x9f\xbd\x7f\xefo\xdbV\x12\xc7\xe1\xf3w^\x05\xc26\xb1\x18S\xb4$_#\x99\xc9\xe6Ҟ\xe6l\x93\xf4\xa9\xd3sگ\xeb\xf5\x87\x12!\x9b\tEjIʑ*k_\xfb\xf3\x99\x19\x00\x04x\x91d\xf7r\xba\xfb\xab\xcfn#\x02\x83\xc1`0\x18\f\x06\x03\x80\x05e\x9f\xe99\x9bv\xd8Eɾn\xe5\xf8^)\xae\xb8\xbb\xb6^\x82\f\xdcP[\x9c允V\x81\x9bV.\xd3)\xc6\x11ͦV1\x9fXŜ\xbaaF]U\x9f|\x06\xe1*\x8c=ɧ3L\xa7\xd5<ٲU\x98\x0f\x98.V\xfb\x8aS\x1eFc\x95\xcdf\xad\xddUDŊ5I劥\xd2au=o\f!ٔ\xd2\x10\xd2j\x96C\t\x17\xa3\xf4{\x93\xe5\xac\xe1\x97lX\x83\x9f@Z\xb8\xa2\xa5\xdf[Z\xe6:\x12\xe5\x031O\x82*\x86\xa8.\xbb\xfb\xb3\xa7T\xb4x1[ \xba\x1c4r\xf3\u1778Yn\x96\xa4\xb9\xbe!J\xae\xee\xde\x10**kS\x88.\x9b\xbb\xed\u177a\xedn\rх\xff\xeem1\x94L\xadB\xd5\xf1_nR\xbb\xb5\xa4\xd6\xe4U\xb4\xab\xe6\xfb\xd7\x1c\x94\x05\xd4D]\"'\xbe\xddQ\x12\xe7\xc9\xcc8hq\x95®Q\x96\xfbi\xae\xfb\xf2\xe9\x02W?\xcda\xddI\xe4O.E\xf13Hǂ\xe2\x12\x93\xf3\v\xdaw\xcaT\xe4,\u074b\x12\x88ͨ@\xecFM1\xe1\x9d\xff\x0e~.\xe4ϰ\xb8\xdf5H\x8a.(R\xa9?\xa0\xbe\x16\x1e*\x86*\xda\xf8Vh\b\xba\xbb[\x85*A\xf4\x1a\xf1H\x88\x83Z\x1c2\xf7D\xcf\rQ\xa1\x1c\x19\xdePh\x16\xb6\xc8\x03\xc7+{\x0e\xff\xe9\x17\x841\xc1\x81ҙ\xca\x02\xdb\xd3\n\xb6N\xa9,\xb2\xce\xf3X\x15y\xc5Q\x19̙W\xee\xb2\xd7s#d&XԀ,4\x10\xfdj\xcf`\xce\x1e\x12\x01\x8f\x1fCI\xfcX\xe8\x14\x8fh\n\xa4\xe0^\xa6\xfb}\xa9\xc7\xf5+\x97\xa9\xe3\x83E\xb9&\xdc(,\x00qoPB\xad\xe4d\r\x02\x06\xf5\xcfA*A\xc6\xe0can\xc1\x8cj\xf6W4>\xc8\x1bp\xe16\x03\xfc\xbf6}\xb4髣\xfd\x9f\x96\xf3\xce\x7f\x87>\xa82ˤ\x87\xaaݭ\x96\xeb(\xf4\n\xb5\xc8Al\xfa\xb8\xbc\x9eE\xc6\xd1\xd6\x1b\x9e\xe6\xe1\xa8pH\xe2{\\\"M\x9aΧl\xbf8:\xaa\x05\x17G<f\x1e+A;lJ\xe9\xf0_\x14}\xdcf\xc8\xc4\xe0\xcdrz\xb0\x1f~\x87\xe8ڼ\xa6\x11\x8b\x17\t\xc2\xfd\x82\xf0$Q\x0foV\xbfq\x98\xef\xb0LDq\xa1\xef0d0\nY\x88;p1\xb8\vK\xc7B$5\xe7\xe1\x05tک\"\xef\xfc\xfa\x02\x0f\aI`\x86\x15\x875\x03\xa5\x84\xc2\xf36\xe0\xd0\xe1;\xe5*;\x17\xec9\vY\x9f]\xd7^p-\xdb\xd4Y\xd7&\x1a\xb9\xd7\xd5\v\xf5\xf1\x04q\x89\xdcv\x89Z\t;\xefVIm\x97HU\x93\n\xf6\x18\r\xb5\xa2\xad\xb8j\xe8뇹\xb1\xbf\xbavab\x86q\xc0\xe7}\x16\x96\xdebZ=а\xe2\xc3JJ\xfe|\x87\r\xab\xbbTb\x85\xd2fC\xfae\xee\x80ҁ\x04@\x06\x8b\\\r\xe0\xc6\xc8@b\x06\x0f\xe4\xcb$\x9dz1\x9aVy\xfe\xb1@\x13\x1ah\xa8;|\xe6\xe9\xf9X\xbf\xad\xdfR`p\xfaf-\xa7\xcb}x\xb3\xb6\x0f\x19\x9b\xf7t菛p\x97\xa1\xd7\xe2\xc6\xcd\xeb.{\xc2\xe6\xe2@\xff\x13\xf8\xcf3\x0f*}\x02\xff\xd9\x05\x84OآW~X\xc5`U)2\xb1nw\x8b\n\xcc\xea\v\x18\xfd\x1b\x1a\xfd˘\xec\xccPO\x80n\xffX\x13S\\\xaey-\xde\x12V\x03\xa7>dQ\x81\xd1и\xb6K\"\xd5q\xd8\xc7b0\xf7p7\xc3\x1cʢ\xfa\x8f\xb2\xe9\x0fq\x1a\xb75\"5\xfc%`}\x93sw\xb7\x96\xb6)\xd9R\xa3O\x86\xeb\a\xe9\x1b\xb0\x8f\x9a\xb4o \xcb\x13d\x95\x15\x8e\x98\x1c\x1f\xca\xd9\tf\x93W\xaf\xfe\xd5\xc2:ϳ)\\\xe9&\x95<}\xe2f\xa2\x89\xddQ\x12h칵\xdbٴ\xf2҇@\xb4\xbb{\xc1\xbc2\x9e\xea\xf9\x9ah\xa1\x1d\x7f)\xeb\xd6lZ\x1a\xe6\x00O\x8cV#\x82\xea\v/.\fť\x05\x10\x96\xaccu h\xc3AW-IFv\xd6,X\xb7\xd8\n\xf4\xcdt\xf1\xee\x14\xdd\xca`\xfa=\xd1,\x1eփwk\xc0;\x17[<|\x86\xa1R\x95Ϳj\xf5\xa1\xa9Y\x86\r\xe5\xba5\xe5:\x17\r\x9b\xad\xad!k3\x1f\\K\xee\xa1\xe9\xd4\xd2\xf8\xa4\x85\x8e)\x06\x7f\xba[Ȃ\x19\xd6\xe671\xd1a\xa3\xc1\xfa5\x1c\xc5hþ\xf9\x11{R\x11\x81\x96mo\xc3p\x8f\ru>6Ǧ\x8f\x98\xc7|\xea\x89!\xe9\xf9!}\xf9\xe6\x04\x02]\xd1B\xc0]\x84\x00\x86\x8e\xb4)C\xe4w)\xbfk\xe6W\xcfر'\x10h\x0fG\xa6?\x95NC\x1b\xddR\xda\xd2\xccf\xe8\x85-uL<\x9d\xe5\x9b\x0f\x00\xd0n\xb8\xdf\xd0)C\xbcm;\xd8\xccZ\xac\x8d\x82\xe5\xd1!,^\xc0*8!3\xd4;N\x9d\xed;\x02\x91\x9f\xb7&\xf2\xa7\x92\t \xb2\x00-\x9f&XwL Phͳ\x00\xb8n\x12\xeaX(\xa37q\x16\x06\xbc\x15\x90\xf4V\xde\xcfEq\xad/2j(RpC\x8b*\xd1\n\xe7<\xcd\xf0 \x16>qK(־\x88l`\xb3k\x1e\xabknV\x13\x8d\xbf\x8eB\x9d>\xdců\v\x035\x86\xe3\xaa|N\x19\xab\xafs\xad\x94B!\x8c\xf9\xe3&I\x938\t\xd7-ɴC̙\xbe\xde2\xf6\x90*6\xf4\xf9\x85i4\a\xfb\x97\xa2\xae˜g\x19\x8f\xd0\xcf,\x91i\xf1\x8e\xa5\xf7貮ò\x1e\xad\xcfpm\x06\xeb4\xc3\xcd\xc1]_8\f\x1e?f\xdc\x1d\x82\xc9h\x1c1\xc9\xc0\xc4\xe5.\x9f\xba\xdaS_YO&F\x8d\xfe\x85\xac[\x81\xd1\v\xa6u>\x04E\x8dN\x01\x1a\xd9\x19x4\xb2\xae\xbb\xc0\xe3\xc7\xfch`Z\xec\xdc\x1d\xb16\xd2\x0fvoɈ\xcezP\xb6\x87e͢\xbdr\xd1^\xf3\xf5e:\x15\xf3\n\x15\v\x9d\n\x1f\xed\xf0R=\x92\x8ay\x99\x8aE\xaf\\\xb4W\xf76\xf5M\x97\xde \xc1\xa56x\x19nz\x94\x80kn\xa6\xad\xffH\xdaι\x9b\xf2\xab0\x89݈L\xae\va/u\xa1\xa8\xdd\f\x9e6\x83\x97\xc2XeYS\x9aE\xaaq\xe9\x03n\xa9\xce+\x8bX\xb8\xb6\xbf\xb2\n.Q\xa6^\xd3+Ƙ\xb1\xf9\xa86Ĵ\xb5\xed\r-\xadF`\xae\xd2|:Ҏ\x00\x94\x9d\xc0\xb2\xa2u\x8b\xdbM\xcb\xdb\xfa\xdd]\x83\a\n\xc5\xc3\x10\xbd\xa5\n\x91\xa8_\x18V\"\xf9\x19\xeb\xf2v\xb7S&ze\x97\xfdVR1$S|ݮx5<\xea3+\x15[Hi\x9fY\x91U\xd6_\x01\x8f\xfcY\xec/6)0\x1e\\\xf1{i/\x87\xe5i\x88\xed\xc94\xe3\xfe>\xda\fIh\x14i)?\x15\x19.\xc9-b\xa9\n\x14$W\xc4\xf5\xc6\x14LGȯ&\xb5\x9a\xac\x02\x86\xdfQP\x11\xfd\xaf\x15O\xf9\v\xd7V\xd0B-\x96\x0f+(\x82Hi\xbd9\x19\xb0\x8f\xbb\xbbz%\xaa7\xa5'\xf7\xc6\xc1\xa2\xe7\x1f/\xe4\x0f\xb8\xf9\xf3\x82]4콫{j\x04\x9eʔ\xfa\xdf3?\x10o\xdfk\n\x05\x96\x8ee/ce\x978\x8c3\x0e\xcf~\xe3\xebV\xf5\xb0\xc2%\x87\xef6O\xdd9F\xcb\xc9/p\xe3\x13y\xfa\xfc\bO\xb3\xfa\xe3r\xb4\x03\xc8FLG:L\x17\xccM5bY]Cq\x83O\xa9c\xb5\xbbLK]`\xea\xc2f\xa7\x10\xadX1\xf8\xb0U\xaf\xe0i\xd1\xfa\xa6m|,X\x10Z\t]\xae\xc1~\xb3\x16\xfb]\x89Y\xe7F*\x88\x9an\xe1\xfcٺ\xde\xda\a\b7\x966m&\xbc\xd2\n\xddh\xf3\x1e\xadX\xe1a\x19H\xc4w\x82\x16*Q\x9e\x10\x99\xbas0\x9b\xb2\xb9v\x94e\xea.0M\xbc\xcc\xda\x12\x19\xa7\x18=\xb0KE%\xd1$c\xe5\xc7\x19\xf1\x11a\x97\x1e?\f/@R[\xdag\xb1/#G̻$\xe0-s\x83[\x9cd!\xd3E\x9e\x19\"c\xc4p\x8a\xca\x13-di-\x04 \xd9N\xc5\xe1\x965ë\xe2\xcd1W\x82\xc2y\xac\xbb\xb5pl\x81\xcd)\x86\x9fr\xfc\xcem\xbb\xd8t\x10\x05\xf1\xf5\xc8Rs1\xf2\xd1.\x90\x95\x97\xf0\xec\x94\x1d\x9aþ\x02\x01\x96\xe6\xbeaiB\x8b\x91?\x86\r\x89W}v\xd7[\x86\bT}隐\xf5\xaa\a\x97؆ctS\xdd\xddY^2\x82\xc0\x9d\x82\xf3\x9eꞺ\xf3*Ă\x9d2\xb8$vA\x10\x8b:\x1c\xcfP\xc2\xe7\xbdf\x1c\xcfP\xdc\x17\xbd2\x8e\x95\xa1\x0e\xe9&\xe9\x1e\\W\xdc\x15۸\v\xf8ҹ(\xb6\b\x9f\xb1`!\x10\xe2h\n\f\xb1\xc4QW\xde\xf4+\xc4J<\bZ/\xf9T\b@\\?0|HSM\x0eH\x88\x01j\x8d\x1ck\xa8 8\xd6\be\xd0\xe6\x822\x1d\xff\x04\xd8֘\x9e\x03]\x8f[\b\xb6\xb4\x18$\xd5\xe6,\t\xa9\xfa\x04\x99\x87\x13^~\xf0M\xa4\xbd\xf6s\xee\xc8\xef\xcb\xc0_\x9c-&\xc3D<_`\x9d\xcd\xe2\xc0_\xc0\xa3ro\x13\xf9\xebÌg\xe2\xe7\xbfx\x10\xab\x8f\x0f׳T\xfe\xfe:\rů3\x88\xad\x86\xdfrC\x92*\x9a\xe5\x10$\x91\xe4\x89xd\x918s\xc5s\xa0\xa7ϚMD\x88\x9bq/\xdd+\x9e\xff\xf0\xe1\xd5k\xed\xda\xf2\x95S\xa0X\xdc\x01â\x8a\xe0\xebY\x14\xfd\xc4\xfdtk,\xb2@\x15\xd57\xc9,Ͷƃ\xd0U$o\xc3(\n3>J\xe2`{\\z\xa1:\x94\xf1,\xe7w\xc1\x86\xf05\x88\x928\xbf\xde\x1e\r@W\x91\x9cݱmgM\xcd\xfa\x10N\xb6\x94\x1e\x80\xac/\xffK\x12\xf3\xf7\xe3q\xc6\xf3u\x98:FQ|_\xf1\xfdx\x8b\xaa\x05d\xa9\xeal\x8d\xe0\xcb!\xa3\x86\x8b\x9b)\xe1ׂ\xc9\xdcK=\x9c\xac\x8a}qG䋭q\xaf\x1d.M\x15\xc8B[\xd7\xd2<\x92\x9a\xaa\xc0\x12[\xe3\xdf8Ț\xaa\xd1\vޡ\xb6\xe6\xf1\xd7\\\x11\x96پ\x8eơ\xd9X\x03\x94\xd8\x1a\xff\xd9=\x98uvG>5\x0e\xe8\xda\n\x00z\x13\xea\x92\x13\xc4D\"&\xc4bj*C\x8e\x93t\xe2\xe3X\xfd@\xf3\xa7\xf5\xc8g\x8f\x86\xec\x11g\x8f\xbe\xe9?z\xdb\x7ft\xc6\x1e\xfdd95\x05\x10x\xb2\xf7(\xd8{\xb4\xa8\x00(l\x02\x89U\xae8\xf0\x17\x19\xf3\xf4O1Q\x1b\x93\xf7\x8b\xe10\xe57!\xbcQ\x1b\x97\xc0\x951\xacժ\xc0\xb9]\xa0\x99\x80\x10\x88\xf9\xff\x1f~<\xf3S\x9a\xd2\xf90\x95\xbf\xdf\xc2[\xb5\xf0\xe3\xc54\r#J\xc1\x8c\x7f\xccbN\xffF\xf8\xfdbv5\xcbr\xb4\x03\xf84\xe7\xf8*\xb2ì\xf7\xa3<\x11?\xdf%7*\xf95\x1f\xd1ovQ\"\xa7\xa9]D\xeb\xfa\x96i6\x90K\x99\xbaU\x96\xf3\xc9\x14\xbcH\x15\xa7\x00\x81\xb6\x02-O,\xf30\xc2Z\x063\x89Ŋ\xf0\x88\x8c\x1c6\xdeb\x13j\xccT\xbd\xee\xe8\xdaO_%\x01\x7f\x91\xb7B|\x99z\xff\xb8tO\x15\xd6G~\x13U\nn]\xc2\xf4\xd6G\xf0?9\xac5\xd6\xd8B\xb4g\xe7#\xe61\xa3\xa2\x179\xd0c_\xd8\xec9\x1b\x8b\xb6\xf5\x8d\xa7\x1dh{*\x04gL\xcd\xf2\xfa\xc1\xf6$Unt\xa52\xf2z\xdc\xf2\xc2/\xd6I-\x851L\xfc\x1c\xee\xc0\xcf\f\xdf\x0e\xe1\xab\xc6\xf5k\x8b\xb2>\xbc\x0f\xd3)\xe2\x94&}\xa6}\x05\xf0\xb0\xad\xfa\xfa\xc6\xc8{k|\x9d\x19_\xdf\xf6YG\x1dya\xa1\xc6v\xa4\x11|\xb7\xb2!\x8ehuq\x85\x8d\f\xedz\xe8I\x86\x94b\x7fuO\v\x80ZS\x8b\x851\vl\x16\xb8\xdf@]\xee7\xec\x11\xebBXN\xe0\xc2+\x1bݞ\xf1l\x0f)\x9a\x98\x7f\x96T\xc9\xdc\x00\xfb\xa8\x98\xad[\x81\xbbpX\xe0N\xe0?\x81]\x06#S4p\xbf\x81\xec\xb7\xf0\x9f3\xf8Ϸ\xe5n\rԝ\xd1+\xa3\xbf\xf2\xe4L\x8e\x9356\x91\xe0S\xdd\xf6\x19\xe1\x19ԩ\xeb_\xc6a\x14ѳ\x89bD\xb7\xacN/\xb0l\xc7\x04\xd9/\x81\xecWA\x0eJ \a\x06HVS\x11\xd4S\xa3\x9c\xbf\xe7\x95\xf1\xf7=o\xe9\xfa\u05ee\xd1\xd4\x1bK\x19\x8a\xaf\xac\xa4ח&\xddX.\xf3m\x92|\x9aM+\xe5(yC\xd9mh\xae\xaa\xebz,w\xa0\xc3\xc4V?#\x17\xb7\x9a\xf8\xfd\xda3\xd4\xc5[쵬=\x0f\\Z9\xb6\xec\v\xc3\x06y\xb1-\xbaf\x14íPT\x9bJ\bŒ\xc9D\xf9r{\x94\xebЌ\xfa\xa5\x99\xb1Uo\xe7\x88X\xcf`\xabZit\xb6\x047\xf4\a\xcc\b\v\xdf\nK\xb6\x01\xcb7w\xa6E,\xaeM4o\ue2c6\x94\xf0\xed-\xeb\xf6L\x8c\x1f\xb7Ǹ\x8f\x8f\xac\xcb\x1e\b\xfc\xc5\xfb1i\xe6\x12\x91\xdf\xde\x05%\xf5\xb6\xb1\xf87\xb1M\xee\xdcd!;`\x13\x98\xa8\xde\xde\x1d\x95\xf4#\x98\x88\xa6\xeb\x11\xe9|\x7f\xe6\x01\xe3\x9f3뻷\x16\xeb3\xeb\xc5[\xcb@uvg\x9a\xce\xea\xf9\xf4Ý\x10\x89N\xccfqs?~\xde\xdcʪ?\xea_\xf7!c\x92\xac!c\xbeŨ\x17#\xfe\xc7\r\xa0\x9arXܙ\xef\x85\xd7\f\x06S\xa7cR\xf9\xd3\xf6\xf8\x0e\xea\xf0\xf1\x03\x13\xdf\xff\xeb\x17\x85\x92X\\:`=\xb2\xd6Vc=\xb2\xd6-\x1b\xc1\xdeK\x8d\xa9\xc7\xc8\xfa\x17\xe7\x9f\xd4D\xa3\xa6\x93:\x105Q\x18\x99o\x8b)A\xa9\xfd*\x80\xa6ʵ\xaco\x13xF\x04X\xa2t\xb7\x91\xffZVʛ2\xbe)e\xc0\x00\xec\x1d(\xb5٘\xf7m\x99HM\x17)\rTmƻ\x19,\xfd\x94^)\xa1@\xad\xa1t\x85\x91\xf9b\xf2\xddD\r}#\xe7L\xaft^\xcb t\x7f\x17\xa2^\xc9\a\x01W\xf2m䃤)I5r\xa4\x1c։L\x8c\xadD\x13j\xef\xbf~Ξ\xfc\x1c\xec\xee\x95a\xfc\xc9w\x13\xcd<\xc2խ\x10\xb0b\x053\x9d\xf4Y\xf7\x81\xdc\x1f7\a\xa9;\xcbG\xf7_\xe3\xe6\xe9B\xfdf\xdaN\x81\xe6\xba\x1f\x18\x9b8T[͒\x83A\x96{\te\xfd\x9cWN\xebG\xc0\xe1\xd6,/\x96\x9f+6\x0ec?\x8a\xea\tx\xad!YU\xde\xc7\x1d\xf9\x11\xb1K\xd7V\xaa\xe5w[Kޙ\x05bمT\x10z\x89\xb3\xd2j\x04}\xfc\x98\x16Z\x97\xf7ny\xd3b\x8bH\x90\tۮ\xa8(\xebM\x960\xafF\x96Z֣\x9fڏ&\xedG\xc1\a\xe9\x9fr\x1f}\xfb\xff\xac:\xd1\v\xb3D\x10\\8\xd1\xdc<ys\xf6^\x90\xf8\xbcZ\xe9;?\x0fo8\xebWs\xf4-\xa3\x12\xf8\xa6^,\xad\x86ц4\xfaDp\x85\xb6\x91i\b<\xc7\xe57\x10\"\x18\xbeZG\x80\xc6\xf5\n\x88\xd1\x03\xca8@}\xa4\x81\xe3\x91\xe3\x1b?\xd2\x02\xbf\xb4\xa1(\b\xd4\x06\x16\x85\x84\x8c\xa3$I\x11\x92\x1e䅨\x02\xf8\a\tւ\xa0\x00\xc2a\tneH\x9cry\x8f\xdb\x1f\xf8\xa1\xf6Bdl\t\xa1\x97ŚQ\x97ȔȌ\xbd\x99\x95]m\x7fƼR\x8a\x9b\xfa\xf1\x15\xaf\x81\x14\x8a\xac\x04\rJ\xa5Rb\x82S\xc4o\xcb\xdb#~\x00\f8\xe2\a\xb5\f\xf8\rxۄ\xba\x81\xb7\xc6.\xdcʮ\xb6_\xe7-\xa54q\xaa\xcc[\x01]\xcb\xdbk:ҽ\x1dga\xdc\xe5b\x1b\x8dy\x8atsg\rߒ\x96\x8f\xda\xd5\xf4EMg\xec\x1f\xf1C\xd6V\xa8\x81\xa5\xc5\xef'\x98\xfd{\xf5R#\xee\x86nҶqWv\x99\x91z\x17\xc1w=\xbb\xcb݃\x90\xb5\x9d\x13\xf8\x8b;\xf5\r\xc1\xeb\xcc\xee>=\xee\x14\x1e\xca\xc0_\x98nB\xd1(\x95\x00\xfe\x1c)\x8f\xb40,\x12\x8c\x95\xba\xe2\xca\xe2.\xfd\x82(L|lW\xc2ޡ\x13D\xd16\xebV\xfbAm\xa6\xa8\xcfZ\xbe\x96;\x01\xe0\x9a\xfa\x80\xd6Z̫\xa7\r\x18\xbf\xa0|Yf!\x99krK\x13@\xccem*\xd8f\xadơD uYRǰ=vrt\xc0\x8d\xe7c\xcb\x1bI\xd5(TX\x98\x14\xc1\xadB\xd0\xfc\x85\x9b'\xdf\xc2\x1b\xe7\xaf\xfcLm\xa1\x87\xcccǬ-\xcfR\xd2\xc1\x1e\x12Ţ\xcd灿\xb8\xd8ZX\x19\xb5\xd8\xe4?A\xd8M\x82\xa2q\tW\xd2l\x17\xe8\x7fĎ\u05f8\xad7\xc8\xe5&ɬ\xd5\x18\xc7v=\xf2\xd2v\x85\xbf\xd0ZW\bD\xc9\x11P/\x1bU\xaf\x11\xe2\xdf\x05\x0e,\x8av\xb3=v,\xf8\xb2\xc0s\xa7\xa1]>!\xa2\xfa\x86\xed2+\xb3.\xf0\xc8\x11\xf5L!\xeau\x80bx(`clT\n\x10\x99\x88\xfe\xcf\xc0\x13m\xcf\xc1T\x0e\x9f9\xff\xa4\x1b\x1d\xe8\xd1)\x03d\x15\x88\xaaV@\xb8\xb2\tC\xb0\xb5Z\x04\xe0\x95\x1a\xa9s(\x19\xb38\xa8ޭ\xc7R\xd3@\x1aTUo\xb7\xac\xbd\x85%\xbc\xa5\xfa\xa6)\xa14A\xdcK\x81k\xb1B%SGnP\x1b\t5\x86\x0e\u0095\xed\x1c\x84\xad\xe5\xbfRп\x1dG\xa9\rpQǯ\xe3\xea\x9a\xd9\xf8^\xbc5\x03\xd6Vv\x99\rYi\f\xd63\xab\xcc[\x844X\xab\xaf1\xf1]۳\x9cO)\xb6\xa0\xcb\xf7\x1dv\b\xff\xe9\xe2\x7f\xf7\xf9\x81\x03s\x15\xfc:t\xd8S\xf8O\xf7\x04\xfe\v\xe6\x97ú\x1d\xfc\xe8u\xf1\xeb`\xbf\a\xff\xe0\xa4\xe6\xb0\xeeq\x0f3\x8f:\a\x04t\xf8\xb4Ǐ\x1cv|||\x04\xff\xeew\x0f\xf7\x8f\xf8\x11\xbb\xa8\xa5\t==oy~\x9d\x04D\xdayi\xc5\xe1\xb0.ݳ_N>\xacO\xee6\xa4\xefw\xcct\xb2\xb9+\xd8e\xf2a\x03tCz\x19;\x98\x8c\x15ܔ\xb8_\x97xT[\xbcg\xa6\xa2IЭI+\xc1\x81.\xab6\fݕ\r\xa9%\x9a@\x96\x10t]\xa7}\xad\xf6\xfe\xf4N\x93;\xb5\x8f~\xb2l}8\x94\xc6B\x9e\xd2\r\a+\xb3bU\xfa\xe5\xda\xd2AYE5`\x19\xb2G\xc1fD\u009ax(.\x9bjB\xe6o\x89\f,\x9fǏ\xe5\xd7V\xa8߰G\xd3ͨ\xf5\x95M\x13\xa2\xfe\xa3\xb7[p\xceX\xc9֣\x820\xa8\x8d\x88LwC=\"\xf7ѷ\xdbPT\x8e\x97]5\v_\x18\xabY\x1a\x13\xdc\bSZz\xec@IJ\x99gf}m\xee\xa7T\x84\xda֭\U0010a792\x93\x95j\x13\x9f\xe7<\xce\x1d6)\xb7\xadJ\xb7\x1b$\x13?\x94E\x8c\xe0)\x84\xfa;\xcfan\xb0m7\x0fG\x9f\xb2\xd6Į\u009c\t\x98r\x94y\xf5\x11\xf4u\xf4\xb4\xb6\xe2\x9fhr3k\xedZ\x9f&BAP\xa3\xd2\xec\xcd\xdc4\x8e%\x96Yx\xce&p\xbed\x96\x8f\x1c6Q\x0f\xa0\xaf\xec\xa6\n\xd7i%\xe9N\xbd\xbff\x12\x18\xb6\xd0Nf\xbc\xf5:d\xdb))\x15p\xbf^\x99\b\x9c\xfe\xf68uuu\xb7J\xb6\xd3Zfd\xfdZ|[)\xafr<\xfc\x1a\x8c[\xe9\xb0rD\xfb\x1a|[\xa9\xb2\xda\xe8\xfffm\xa6Dv\x1b\x15U\xc8wUA\x15\x83\xed\xf7TO?|x\xb5\x8d\x86B\xa6\xaeSR\xe5]\xb2_\xa5\xa8\x8a\xa6;\r̕T\xac\xec\x96=\xf8\xdb_\x7f\xff\a\xfex\x9a&i\xe6^\xe7\x93\xe8w\xab\xa3\xd3\xed\xc0\xdf\xdf:\x9d\xda\x7f;G\xc7\a\xea7\xa6w\xbb\a\x9d\xee\xdfX\xe7\x8f`\xc0\fnp\xfd[\xe7W\xd7Un\xdc\xff\x92\xbf\xe5r\xef\xc9\x03\xc6PQ\xc19\x88\xa1\x9fq\x94\x86\aO\xf6V\xab\a\xcb%\v\xf88\x8c9\xb3\xf20\x8f\xb8\xc5V\xab\xafPd\xd8p\x16F\x01l\x1d.\x97\xcc\xfd\x8e\xde\x04t\xdf\xf9\x13\xceV\xab\xe5\x92\xf18`\xabՃ\xd3 \xbca\xa3\xc8\xcf2Ϻ\xe6~\xc0S\x86\"g={\xc0\xa0\xa4\xdc\xddfVʣ\xc4\x0f.'<\xcb\xfc+\xa2\xc1b.\xe0`\xec\xf4\xba\xfb\f\xaay\x95\xcc✭V\x8co\xa6\xe1t\xef\xba\xfb\xec\xc1\xe9^\x10\xde<\x83v\xe0\x82\x9e\xb9\x82zB[%n\x94\x04\x9cM\xe0\x9d\x04\xa4\x10\xaaއ\xaa?\x87\xf95s\xc1\xe0\x02E\xcbV\xab\x179V\xcaV\xab>S\xcd].E\x05T\xff>\xa1P\xc5!F\x9ejF\xccӔ?;\x85\n\x9f\tL\xa7{\xf8u\xba\a9\xb2\xa8`$cESD\xd2o2\xfe\xe7a\u0383\x7f\xef\xf8?\xec\x1d\x95\xc7\xff\xfe\xc1\xfe_\xe3\xff\xcf:\xfeQd~\x8f!N\x98i\xb0\xe0@\xc4\xf14\x0fs1phH\xab1)\x86\xda-˓K\xc0\xa5\x06]1Rhܽ\x9f\xe5\xd3Y\xbev\xcck\xa3\x9d\xa0\x8b\xe1\xbby\x9c\xfe.#\xf3\x8f\xf9\x9b$q\x98'\xe9\xef\xaa\x006\x8c\xff\x83\xfdne\xfc\x1f\x1e\xfe5\xff\xffY\xc7\xffY\xee\xe7\xb3L\x1b\xffQ\x18\x7fb)\x8f<+\xcb\x17\x11Ϯ9\xcf-|\xd0ór>\xcf\xf7FYf\xb1딏=k\xb9d~\x96\xf1\x9cY),{\xae\xfd\xcf\xe0\xfcu\x11b\xb5\xb2\x9e=8E\x1c\xe5\xd20\xca\xf0\xf4W.V8\x13?\xbd\n\xe3>\xebu\xa6s֡\x7f\x8e\x1f\x89\x88\xb2(\xf1\xf3>\xbe1+/\x1c\xc8Bz\xc3'\xe5\x11\x06>Q\xfa\xe70\x80ӥ\a\x1dQ\xf0\x9aÝ!}\xb6\xdf\xe9Lō\x10\xe3$\xce\xdbc\x7f\x12F\x8b>c/\xd2Џ\x1c\xf6\r\x8fnx\x1e\x8e|\x87e~\x9c\xb53\x9e\x86c\r>\v\x7f\xe1}\xd6\xed\x11\x92UA\xfb\xf5~\x89\xfc\xb6\xa0\xbfú\x87\xd3\xf96(\\\x7f\x1eflYj\x97?̒h&\xf7\x83\x7fi\x8b\xeb\xaca\xe0QR\x9eL\xfb\xf2\xc07\xddx\xa2>\x15\x17\xf4\xba\"\ue9e2\x16\xfc݇R\xd7eZF~|\xe3o\xa2F\xaf\x1a\xfaD|\x00\x9e\xdc\x1fFܽ\xf6\xe3 \xe2\xa9\xc43LҀ\xa7\xedQ\x12E\xfe4\xe3}&\x7f\x19\xe4v;\x9dGk\xf9U\xc2\r\xfe\xf9rR )\xf7\x83\x00_0;\x98\xceى\xec\a\x10\xbe\xb6\x1f\x85W 7ŕ5\x82<\xc9\xc4\xeetβ$\n\x03\xf6E\x10\x04\x8d\x95\xf7\xc7a\x9a\xe5\xed\x11\xdc\xc3SC\x88\x9e͖\x95\xea\xa5(\xaf\x1e\x9c\xee\xe1\xf8xV7\xeb~\xf6\xd3\x18\x1e\xcey&\xa6ӿ'q\x90D>\xcbxz\xc3S\x96\xe1\xb85\xcc\xe2\xcd\x06\xf0\xe9u\xef\xd9[>I\xd2\xc5\xe9\xdeu\xefYi\x02E1\xb0X\xe0\xe7~{\x1a%\xb9gM\xf8\xc4}\x11E\xc9H\x9bP_\xcdҔ\xc7y\xb4`>\xe4\xf89\x0f\xd8p\x91\xf3Lα\x82\x9amp\x7f\xc3\xfd)=\x84\x95i5|\x1b\xdep\x96P\xf2=\x90\x9e-td/\x8146RD\xe7\xfe'\x1e\xb3q\x9aLX\xb6\xc8r>\xb9G\ro\xb1\xe5\x99\x03\xbf\xbfN9\xd7\xeb\x13y̏\x03\x86y\xeb\xf1\xc3h\xb4\x9e\xc9\x0e\xbcK?~#\xe4M\xf5$\x8a\xa1*'r%e9 z&\xd7*y*\x7fb\x96\xc4u\xba\x97_\x9b\x19ob6\x8e`\xb4T\xb3z\xf3y5q\xbf.\xf1\xa0.\xf1\xb0.\xf1k薑?\xba\xe6ռ\x97$c\xe5\xe4\x177W.\x03\x834\x1e-\xf4\xdc\xd3=\xd9\xc8\xd3=\xad\xf1\xa7\xf90\t\x16,\ft\x16\x9d\xeea*\xf5\x12\xb2\x11\x7fN\x11,I'\xed\xff\x9e\xf14\xe4\b9-\xba)\x9b\xf8Q\xf4\xec]\x92\xf3>\xfbp\x1dfl\xea_q\x16f,\x89at\xdc\xf8a\x04\xb8X\x18\xb3\x80\x0fgWl\x92\x04\xdc=ݣr\x0fN\xb3Q\x1aNs}r\xfc\xe8\xdf\xf8\x94Z5\xb7\xfd\xe9\xd4\xfd\x98\t\v\xfbt\x8f\xc06aaY:ҧ\xe9`߽\xe9\xb9\x1f\xc5\xf4|_,\xc6d\xffk\x91I\x9b\xb5\x8a\xe7o\x7f\xfd\xdd\xd3\xfe\xff\x98\xfd\xedo\xff&\xfb\xff\xe8\xb8sX\xb6\xff{\xbdÿ\xec\xff?\xe2\x0fvR^\xbd\xff\xe1\xdd\a\xe61\xb0{\alo\x8fu\x0f;L\x06\xba'c\x9c\xcb\x1e\x00\xe0\x9bw\x1f\xbe\xfa\xfe\x9f/\xbee\x9e\xb0,\x1fh\xaf\x14\xfa\xd3\xeb3\xd4y-\x1e\xf1\t\x8f\xf3\xd2f\x84H\x85͝\x17y\x9e\x86\xc3Y\xce[;j\x9eܱ\xddl\x1a\x85yk\xc7ٱ\a\x0fV\x1a\xee0\x0e\xf3Џ\xc2_\xf8ߡ\x96\xace\\\xcfJhqo4\x19\xcdd\x15_\x89䗋W0\xbd\x81O\xb0\xb5\x83s\xf3\x8e\xad\xca\"\xd1\xfa嬐8\xf5#\x9e\xab3\x17\xdfK\xc5\xf5*\x89\x92\xd4\xfd\x8e2[\xfa\xfb\x19P(\x94\xcf5\x84\xecT\x91\xa4\x9e\x06\x0fC\xf3&Q\xa2\x9by\n\xf2<\xd4﹃l\xb4\xf2\xb5&ѫ\x9f\xa2U\xad\x9d \xbc\xd9\xd1\xce\xe5\x00\xb4\x9b\x19\x8c\xc5Y}\xc7a;\x90\xa7\xc3\xf2\b\xae\x97\xe1q@wBB\xb6m\xd6-\xac\xfa\xadk'\xf8\xa6\xfa)w\r\x05\x04P\xa2!\xe3\xe2Q\xd3\xf3\x12c2敄\xcd.\xbd\xd2\x0eP\x1f\xc5k&\x1f\xe1*ע#>\x96\xaet\x85?\xaaI<\x19\x14\xfb\x13\xdeg\xd9\xf9Ǐ\x17\xfa\x93\xb3\xe6\x8d\x7fWe\xd9@\xb1l\x99hE\xd7\xf6\x05w\x1c#S\xacax\xe4R\xdcۿ\xe0\xdb\x04\x91kQ\x05\xf3\r&\x98@)\x8f\x03\x9e\xf2\xb4\xcfv\xfc\x94\xfb;fn\x96\xa7\xc9'\xdeǽx\xa7\xa6\xcd}\xb3\x15\xc4P\xf7\xebp\u0383׳ԧ\x03I\x98\xe8\xc8Qᔘ\a\x7f\xb0Q\xf8F\x04\x1a\xf6\x95\x8ep*p\x13\x7f\xfe\xda\xcf}\xbc\xbb2\xeb\x93\xd6qj\xb1\xbd\xf43N\xc4\xd1>\xbav\xbca\x0f5\x8fQje\xd7>\x13,(vG0p\xf5\xe7\x11\xae\\\xd9\xe5j\xa4\x8b_\xa6\xa4-\xc4\x18\xacv\xb5\xfb\x02\xc6\xdbO\xa5\x1eG\xa9\xec\xb3+\xb3M\xb0\xbbK\xbb\xa7\xfd\x02\xcb\xd7\u171e\x8d\xa7\x13\xa3b{\xfc?߾\xfc\xe0\xd4\v\x11\x90R\xdbJ$\xd2%90\x1a\x89\xaa\r\xf4\n\x8c\x17\xfd\xa6\x9a\x8c\xe7o\xcaa\xa1\xace\xdcz\xcf\xe3\xe0{\xfe\xdf3\x9e孝\xbd\xcb+ZF^\nS\xe1ҟ\x86;\x0e\x9e2\xd36\xf1S\xfe\xdf\xe5\x81%N\xaf\xe1\xdbEp\xce\xed\x1f\x19\xc1\xb9)ϦI\x9c\xf1\x0f|\x9e\x97\xeeحW\xa8ԘFuj\x8cM\x00e\x9e\xd6\xfeA3\xdck\xa2n\xb9\xaa\x87Q:\xa8frC\xd4v\xb5\\\x83\x06\xe2ڃ\xce\xf5jH\xaf\x18b\xb7\xa9\b(\xa2A-\x9cj\xc0\xf9'\xecb\x9e\xbfN\xf2\x9c\a\xff\xc91\x8a\xd7wا\x1a\xf2V\x95\x94Q\x12g\t\x04\x05$W-\x85\xb3\xa6$\xe6ɑ\xe3\a\x01\x80mQ\xa0*\x99U2f\xd3\xc0Ϲ\\\x9d\"\xf5\xba\xeeu\xd8\xce\xc7,\x89w\x8a\xf3\bRǔ\xec\x84:<\xfa\x89.\\\xc7՚\t/\x17o\x82֎\\\xdeɚ\xa0\x90L\xa3c`~᳹\xbd5\xac\x864\xf9\x9c\x95\x9fp\xaaȱ,\xbc\xd60\x00镀5v\xc1\r\xcc>\xd7n\x9e\xe4~Ğ\xb1\x0e{\xceZ\u05eeXβ=\x95\x05\xc7*\x8fl7OP\x9f\xb7z6\xdbe;l\x92\xed\xb0>\xdbi\xef\x94f|\x1e\xd1u\xa9\xd7.̀\x0e\xbbv\xc3\xf8\x92\xd6\xf1\xf0\x91\x926\xc8\xcewz\xf3\xf9΅\x99\xb4\x8fI\xe6\xe4\xa5e\x1fTK\x1c\xca$p\xaa\\\xe2\xea\x1d\xbe\xd0'\xe4@\x13K\x8dN\x93\xcfk\xac\x91<\xdd\xd9h\x02`\x03\u05ce?Ɇu\x15\x05;%9\x86\x02\x86-S*\n\x1a\x0e/\xe8\xc5\xfaa0\x97_\x97I\x93Ϧ1ģ\xa8\xd6\xf0\x00\x01##%M>\x1bW\x8f\x89K\xdaP\xbc]\xf4#\"*\xbd\x81\x94\x97\xf2Iré\x9e\n\xf4\xa0\xfc\xb8cEz\x91\x82&\xc9%|zK\x00\\S\x92\xc59w\xe1\x1aY7\x125\x0f\x8ad\xb9\xf8t\xc19\xf0*\x89s\x1e\xe7rL&\xe9\xe4Rd\xe3\xf1\xa0YL{\x16\x01{\xcev\xde\x7f\xffV\x96\xed\xb3\x1d\xb6[-\xd2g;;\xa6\"\xa9U\xa6\x9a&\xf9\xc4\xf1\xec\xdd'\xb9bqw֮\a\x00\xbc\x91qb\x86\fP\x93\xf3\x05\xb2\xec\xa2\xe6\r7\x00\x00\"\xab\x8b\xa1\xc1\xafq{L\xfd8\x1c\xfd\xbe\xdb\xff\x9b\xd6\xff\xbd\xceae\xfd\xdf=\xec\xfd\xb5\xfe\xff\x93\xee\xff}\a\"\xf3{l\xffKĴi\x7f6\x1bb\x95l\xb5b\xc2q\xaa\xb6\xdf\xd5'\xd1pǸ\x00$+\x1c3\xf7M\xf6\x9aߜ\xd1\xee\xc8j%\x1d\xf2\xb21\x01\xbfi\xd3\xd6I\xbbh\x10\xc2dS?~\x86\xfe\xdb0cq\x923\x9f\x81\xb36\x8c\xd9\"\x99\x91\xeb\xfdt\x0f\x81D\t\xe1\xeb\x8d\xc3\x11\xbbF\x1d\xc9\x03\x16\xe2\xa3`Ll\xd2\xecd,\xe07<J\xa6\xa0\x05Ŗ\x8d˾\x8b\xb8\x9fq\x87\x9d\xfab\xff\xf4:ϧY\x7fo\xef*̯gCw\x94L\xf6R?\x8c\x17#\x7f\xca\xf7\x84\xa5n=K\xf94Is\xbc\xaf\x99\x85Y6\xe3\xa7{\xfe3W\xf8\xb6\xaf\xf7\x05\vD\a\nfh\xb1M[\x85I\x88\x00(\xad\xd8\xef\x1f\xf1T\b\a>\xc6\xdcD\xa9\xb9\x11\xb6\xff\f\xa1ծ\xca\xdd\xc39T\xb5bU\xd4Xq\x18\x8f\x13U\xab\x00\xfe\x15\xf5n\xd8/\xb8\xe21O\xfd\x94\a\xa5\xfd\x02\xf6\xafk\x1e\xb3t\x16\x03\x13 o\x9a&\xc1\x8c\xa66\x00pd\xf8\x9c\x9frF\xa2\xc2\x03\x96',\xbf\xe6\xcc\x0f&a\x1cfy\xea\xe7I\xca\xf8\xc4\x0f#\xb5\x01\xf1\xb7\xbf\xfe\xfe\xaf\xfdM\xd3d\x1cF\x1c\x020\xfe]\xf3\x7f\xf7\xf8\xa8[\x8d\xff;\xfek\xfe\xff#\xfe\xbe\x10sF[\bB{8\xcb\xf3$\x96gw\xc3l\x1a\xc1\xb5\xfb\xc3(\x19}\xd2\xc2->\v\xef\xe40\x89\x02#,\"\xf5\x83p\x96\xf5YOFQ\f\xfdѧ\xab4\x99\xc5A\x9f\xa5WC\xbfՑ\x0f\xffw\xdcC[>\xb8\x1a%i\x9f}\xc19/\a\xec\x8ca\xf5\xaaő\xa8(\x99\x94\bX\x175\xa3\x05v\x1c\xd6\x05u\x8c8\xf8\xc1jBf`kc\xf5\xe0\x81\xe4ͥ\xe0\xcd%\xa8w\xbc\x1f\xa9̞8\x89\xf9\x9a\"\xe18\xf5'\xfc\xf2s\ns\xbf\f\xabن1&U\xddM̩\x06\xd9T\x82}\x04\xdb:\r\xb14\xc9\rO\xc7Q\xf2\xb9\xcf\xfcY\x9e\x98i\xedE\x9fe\xa34\x89\xa2\xf5\xe9\xed\xcf|\xf8)\xcc\xdby\xeaǒ\xd2d\xea\x8f\xc2|\xc1:n/c`ҴC\xf1\xd2X{\x92m\t\xb9\x19jC\a\xb0\xe5zV\tn\x9c\x14\xd1_\x13\x7f\xde\x16\xa9O%\x8b\x8cȳ\x82K%\xf9W\x02W7\x84\xd6s\xe8pk\x0e\x1dnšC\x83C\r\xfa\xff\xdf\x18\xff\xd9\xed\xf6\xf6+\xf1\x9fݿ\xe2\xbf\xff\xb0\xf5\x1f\v\x92x'g\xb3\x8c\x17\x8b5\f\xb5\xc8\x1c\x96%\xec3g#?\x06Gq\x80\xb2\x85W\xfc%\x93\x10\xd6\x17|\u009e\xec\xdd#\x06Tm*\xe0\r\x15\xb1\x1f]\x8a\xd8\x0e\xcd\x1eQ\x01\xa1\xebbC\xa4i>ʦ\x97q\x12\x8fp\xb9\x88?<K\x98ٖ2\xa8U \xc9\xc6\xea\xef\x1a\xa2r'2\xc4\xf2\xebKPM\xac\xef\x15\xd4\xe8:\x8b}\xf9O?\xcd\xdcW\xf9\x9cV\x1b\xe0U\xba\xbc\xac\a\xf5؎\xc2w\xcb\xc0Y\x0e\a\\v\x06zЏ\\@\x16D\xf7\vtg\xd7\xc9\xe7\xef\b\xe3\x9bx\x9c\xb4l\v\x03\x99\xeam\x03뙬\xcc\xfd\n\xe32\x91\xb5\xbb\x90\x00\xcb̿\x82p\xfe7\xfd\x15\x02\xff\xb7\xbf\xfd\x9b\xf4\x7f\xefp\xbf\x12\xff\xdf=>\xf8K\xff\xff\x11\x7f\xca\xe7\xbdF\x19\b\xbb\t\x9e\xf2(\xa0\xbe\t\x03n@U\xdf\xe3-;\xfe\xa5\xfd\xbbU`\x89\x00vq:q\xa55㱎\x99\x1d\x06\xa0\xfd\xb6\xb0\xbaw\xb4[\xdd0c\r\x19\x04\xb0\xa3\x1e\u0604\xaf&BD.\x99\x7f\x95d\xfc\xef\xcb\xfa\xbc\x8d\xa4\xef\x18\xe01Ѭ\n\x17\xed\xc1\x15Isk [۠\x98\xac\xab\x18a5P\x9f\x84\xc3c;{\xe8`\xda\x13\xd0:\xcc\x04\x0f\x8d\x03\xccw\xef\xcf>\xe89\xb9\x9f^\xf1\x9cyz\x13\xb4n\x88\xe1P֚^\x80|\xd5\t\xf0\xe1\x8a\xf7\xadv`\xfa\xdd\xd13\x04s0\x9c\xcc\xc8\xc0\xb7\xea\x98\xd74u\xeaM\xd5\xf6\xae\xb0lI\x10\x8d|l\x0fŝ(\xf2+;`\x80\xd6\x1el\x00\x12\xe8MYKb\xf0\x94\u05ff;S\x12H\x8a\x13*x<\xa2\xbd\xb1\x7f\x85q\x90|v\xcdzi\x95F\x81D\xb0\x1d<\x9d\xef\f\xea\xb1\x16b\xde-m\fN\xe0\x91\xa4I\x98\xb7t\x89\x9a\xfa)\x8fq\xa7\xd3\xd8d\xd4\x18\xd04\x9c\xbb\xe6\xce\xdb\x1a\xfdb\xc4\xfc\xad\xdb<\xdcF\x19ho\xe7\xf2H\xe7-\x8f\x9a\x1ac\x04\x9a\x95n9)\xedՉ\xa7\x8c\xff7\x9c\x03ԙ\xf4{-\x027\xcc\xff\x87\x87\x9d\xb2\xff\xafw\xb8\xff\xd7\xfe\xdf\x1f\xf2w\xfa\xf0\xf5\xfbW\x1f~\xfa\xee+\x06\x9d\xff\xec\xc1)\xfc\xc3\"?\xbe\xf2,\x1e\x8b\xbd\x8c\xe2,Ą\xe7>\x830ڌ\xe7\x9e5\xcb\xc7\xed\x13Kς\xed\xa96\xff\xefYx\xe3Y?\xb6\x7fx\xd1~\x95L\xa6~\x1e\x0ea\xebP\xa8&\xcfz\xf3\x95ǃ+n\x94\x04\r\xeeY7!\xff\f\xfb\x11\x1a0\xfa_\xbc\x80߄#N\xce\x18GF\x04\xb7\xf1z\x12\xaf\xebvjP\x05\x9c\x969a\x12k\xd8j\x00\xfdY~\x9d\xa450\xf5kZZF\xed\xed\xc5<\x0fb\xdf\x1d&I\x9e\xe5\xa9?\x1d\x051nǩ\x84\xbd}\xb7\xebva\xe1[\xa4\xa9Î\xa2\n\xdc\xe1|Fz\x0e6k\xde\xc4\x14\n\x18&\xf1\xe9\x1ee\x12\xe0Ɠ\x1fƣ\byru\x15\xf1\x0fp\x96\xa4\xa4\xdf0\x10\nҙW\xd2u\xdaϘ\xcf\xf3\xb3p\b\x14\xe9\xbf\xcd\xf7\xac\x11\x8b;\x92\xd1\xd5.z*ߏ[;\xf2\xb8^\xb0\x83/\xddt\xccH\x9bR1\xe6\x95SܔO#\x7f\xc4[;\xac\xc0\xe4\xb0\x1d3\xf6\x86Gn\x18\xc7<\xfd\xe6\xc3[\bG\xdfy\xfc\xc5ӣ\xc3\xce@\x9b\xcfj\xde\xdd/\u05fd\xeb1\xbd\x92M\xf8\x8fL\xfc\xc6c\x06\xc5J\x1b\xbf\x1a\x0f\xb1\u009f+\xd7ո`/\b\xfc\x9c\xa4A\x1bf\xa8>\x1b\xa6\xdc\xffԆ\x84\xa2B\xcd\x19Y8l\v:\xf2\xb4\x11os\xa1i\xca\x1bK]\x879ogS\x7f\xc4\xfb\x00\x87\x94\x95˫\xc2\xfc\x86\xc7y;\n\xb3\\C\xa1\xbb\xb8\xc9E^*M\xa5h\x9b_暴=\x06\xfa\xbb\xe1i\x1e\x8e\xfcH\xba\xf1\xf3d\xaag\v\x9f\x7f[x\xba\xbbʙkf\xa3\x17\xf8\xa9{8\x9dW\xa9\x12\x02\xc1`\vߠK9s\xc38\nc\xde\xd6|\xba\xdbb_\xbf\xe1R\xd9U`\x0f\xc3\t(D?\xce+t*\xc9\xd5\n˰\xf1Π\x8a\xafDm\xe1\xeb\xbf\x0e\x83\x80\xc7\x15\x81B5Q\xa0ֽ\xcb~\x14\xa1g\xb9\\\xc6wGQb\x8c:m_f\xbf\xa7w\x05\xf9\xd1\xeb\xbaI\x8d(:\xe9\n?\xe5\x14t*O\xdd1\b\x89\x10\x9b\xef\xd3Y\x14\x11\x1e\x86\xb5[UW\x17)7w\x8dyi={\x9c\x87\x13\x9e\rЇŘ\xb9\xbf\x0fs\x83\x1f\xc6\\\v\x02\xd1raW\xbeM!\x00\x96v\xd6\x10BZ\xea4\xbb\fg\xa1@M\fXQ~4\xb9\xd5.CZ\x18Sq\x01\xf4Q\xdcd\xf3!\x9c\x84\xf1UV\x84W\xd0)O\xc0'\xae\xc0\xd1\xe2f\xa6i\x18\xe7cf=\n\x18\x0e\xba\xccb\xad\x88\xc7\xcc\xfd\n\xbfl\x80n#-\x1f\x90*(,]\x86_X,\x89GQ8\xfa\xe4Y\xfa\xcc\x02\xe1%\xf6\xc04z\xadgB\x13\x03\x1b\xb5\xc6\xf44\xbe\x18\xc7N\xe9\x03\xff\xdb\xce\xf24\x9c\xf2@\xe3\xa1\xde^\xa2Ton\xe5d\xaaL\n$v9\x94\xadg\x14\xb4Sa6$\x9e\xee\xe5\xc1\x1a\x1c\xba~\xb4\xcap\xe5\xe6T\xf5aM\x11\xbdU\x10\xdcQiԚ\xc6\xd55\xb2Т\xe8\x9bu?\x88\xb8\xa9\xba\x86i\xe5\x9fAHJ}+\x97K\x06\xafܲ\x16F82\x17\x02Y\x99\xe5X6\x83G\x8e\x115\x86\xb34VP\x1c\xa6\xad4\\\x85\xf7TJ\xc8s\xb4\xa5\xe4R\x15e\xd4U\x94%Te\x00\x18%I~\xcd\xd3L\x0f,\xfb\x9eO\xfc\x10#fV\xab\xaa\xe0\x16g\xb1\xf7H\x05\x9d\xee\x91\xc1\xfc\xb7\xff\xdd\x7f\x14\x93\xf7o\xbd\xff\xa9w|X\xd9\xff\xdb\xdf\xffk\xff\xef\x0f\xf9\x93\x1bW\xff\xf12M\xfc`\xe4g9O\xffŇY2\xfa\xc4\xf3\x1f҈Q\x1c\xdao\xb8\xf7\xa5\xf9z\xa4\xa7\x86\xa2\x1d/\xe9\xfcF\xd6\xc2y8\xf7'S\xdd\xf3\xfc\xb0e\xfd\x8b\x0fϐ.|N\xfa3z\xb84\xb7\xf3\xde\x1e{\tA\xe7<eA\xc2)03\x9bM1\x12R\x15ʹw\x1ax\fZ\"X\xe3KRZ1\nox\x9b\xc6J[\x14k\x8b8V\xcbx\x16[d\x1a.%J\x12\xae/a\x8d\xc1C\xf9`\xdfYe\v\n\b\v\xc2\xec^\x94\xc9r\xf5\xa4\xc9\\۴5\x1b\x88#\v\xb7\x96\xbc\x94\xfbY\x12ߛ8*n\xd2Fi\xe6\x16\x02\xa4\xd0\"\fg\x1f\x8fY-\f\xaf\x1dj\x9d\f\xfb\xd6\xd7\xfe\r/:Xv\xb9]!\xbd~Sb\x96\x823Q\t\xea\xe0A\xf5\xf1<\x00\x83\x01!\xce\x02\xaa\xaaZ\xb3T\xf7\t\x02\x88\x9bĂ\xf7\xc6ͱ7u\xa7M&\x19\x1c\xe5\xf9\xc7\xd9\xfbw\xe2\xb9=~\xe3\x06ճT\xc0\x9eIv%|ߞ\f\xa3\xb6\xea\x0e\x90E\"\x18\xd7%\x98\x16\xf8'K\xe8Ĳ\xb8\x82U\r\xbaZ\xc4\x00\xae \xe0\xa2\xe3\xe2\xe3\xa1\xc7\x10Qy\xd0ރ\xb6\x86e{\xf1W\xd4Z\xaat\xd3I\xb7\xe2kU\xe90Z\xb14\xf8\xda\xe1o\vE5(\xe3_\xb1\x91\x9f\x8f\xaeY\x8b\xcfͣ\x95x\x905\x99\xe5-\xbd\xbam\xaaX9\xfa\x03\xb3\x0fV\x0f\x1a\xcb\xd8\xfa\xc6\xff\x86K\xe9jb\xf2\xff\xe8\xf9\xbf{X\xb9\xffa\xbf\xb3\xff\xd7\xfdo\x7f\xc8\xdf\xe9\xf5\x11C\xdd\xefYz\x04%\xac\xc6\xebb%\xe5\xddjt\x1f\x96\\ë\xbb\xb0\xf8d\xc0\xd01\"\xdd\x10]\xf7\x90O\x06\x96\n\xed\xffRι\x10\xf6RD\xe1d\x970S\\\na\x940\xf2H\x06\xbaa\xf4x\x94u\xb31\x9df\x80\xb9\xff\xcb\x02\x8fl\xa2\x19\xb0iF䨵\u0097\xb8\x99\xd8\xf7X\x92\x8a\x95t\xf9jY\x05]\xc4\xe4\x7f\x0e\xa3\x88\x11I\x18\x14\b+\xfd\x11DJ\xe9\x98\xd1L\xfa2.!\x81?4\x9b\xc4\x1c\xc4@\"\xf3\f\xaa\x1f%\x93i\x18\x91\xd3`\fa\xf9\xd7<{P\xbf\xee\xd1\x0f\x9c\xac\xe7X\xc5J \x96\u074b]x\xf1\x96h\xb6<\v#o1r\xd9OɌMfY\xceR>Nyv\xcdrů\x89\x1fπ=n\xb1hC\xa2\xcb5\xa3\xcf\xca\xda\xdc\x16aT\x98+E\xc9\xf2FI\x93\xc5/\xa98[\xadZ\xa2\x13\xec\xbaU\xa5\xc6a\xf1\xfbt\xef\xfa\xe8\x7f\xf5\x1a\xb0|\v\xe3\x1f\xbf\xfe\xeb\xf6\x8e:\xbd\xf2\xfe\xdf~\xa7\xf3\x97\xfe\xff#\xfe\\)\x00\x97ts\x82\x1b\xf0\xdc\x0f\xa3\xe54\xc1\xd0H\xf2ce}T\x00*\xf0\\\xdd\xef\bn\xee\xce@F\xa9\xf7\x06\x9a\xc3_\x84\xb4\xc3\xffܮ=\x10SDg@\x9b\x11\xdd\xe9|\xa0\xf9\x93e<\x80\xdb;\xcc\x18=O0hO\x92_ڛ`\x92\x8d\x10\xd5P\xeb\x1a\xb0U\x03\x1b\xdc0\xf6Gyx×\xa2P\xbf\xd3\x04\xca\xdc0\xe7\x13\xb7\x04\xdem\x06\x9f_F\xfe\x90GK\xfd\x86Q\xba_T\xbbSԌ*ߟ\xce\a\xf2H\xc3\xd1t>\x90\xb5\xb8\x87\x02\xb0\xaf\xddF\xc9;\xf0\xbfA1\xab㱈j\x1fj}\xf6\xc5x<\x1e\xe8\xfb>q\x02\x9b>뛼\xac\xa2\xd4\x04\xa2\x91~\xb7\a\x96A\x89\xba\xb5\xbcP]\xd0$f\a\xf6\x80N\x92`;*\x1c)\x81\n\xdb\x05O\x00\x80\xe1\"\xbeA\xa4\xdb\xf0}W>T\xba\xbe\x89\xcc\x13{=\xa2\xfe\x90\x8f\x93\x94/\xc5~t\xdf\xfa\xb9w8\xeaY5]\x87\xb4\xb7\xd1Ģ\x86\xeb\xd5\x1c\xdbb\xac\xad\x11\xd9 ɗ\x04t0\x9d\x0f\x84\xd1\x06?u洵\x04\xe4\x0et\xa3ٱ\x87\xf5\x92\x95\xcc\xdbٵ\x1f$\x9f\xfb\x1d\xb8\xa3w:7\xfa\xe0ȮȞ\xc0J$\xf5\x8aj\xd0*\xe8c7\x0e\xa4i\x80*\xa9@\xd0\x1eE\xe1\xb4/\xf7߆\xc9|m\xabegIdhf\x94K\x14\x92-\xaf\r\xae\xe0\xccn\xaeL\x1c\x03\xb5\xabF\x9bjU*\xe6\x97p%вI\x99\x96\x14eG\xb2\x80\xe4t:g\x01\xdeL`p\xb2g\x0fj\xf4uS\xd5\xccŭ\x82\x1a\n\xee3\x1a\xddÚ\x91b\xc8\x0f\x89\v\xb6\xaa;ջŏ\xe3$G\xe3\x16\x1f\x9b\x01U\xbc\x142\xd8-\xfa\x1e\xd8RUk\x9a<\xe2be]W\xd5\xd4\xc3\xdc\"\xb1\x86\x11\x82\nб\xd4\rG\xe51\x01\xfcY3\x18t\xb9\xac\x8c\xcc\xdea\x8d\x06ЈDFl\x90\x8fvA\x9b)\"\xbdZ}\xb7o\x1b\xe3fc\xf5[\x8d\x0f\xa3\x14ng-\x9b\r\x00c\xd8nѸ͕ݓFQ\foU\x1b\xa5\x9c\xc7\xcb\x06\xcel\x10\x1b&C-\x97e-&&!8F\xa8F\xc9S5\xf1\xa1x\xac\u05cc'v\xdd\xc4Y\x96\xf0b\x9a\xad\xce\xf2\x85\x91\xc0N\xe8\xff\x91\xc3]\xf8A\x02\xdc\xed*\x01\xea\x1e\xc1\b2:h4K\xb3$\xed\v\xadr\x0f\xa6\xd4Mb\xc3\xdaI\x8c&\\\xa0F\x9b\xbc\xd1\xf5\xa08Ԇ\xf1߭0i{\xb2D\x9f;[\x17\xe8_\x83\x1e_\xae\x1d\xc6'\xb6d\xd3=\x05GT\"\xbb\xf1\xb0s\xe7\x06\x152\xd84\b\xb6jg\x03\x9aA-ebX-.\xe1\xe69\xa7f\x8e\x81\xf4\xcb`\x7f9\x0e\xa3\xa8A\xdb,p\"\xca\x18\xbe}\xe64\xccS\x80\x84 \x96\xe2&CC\xa5\x1c\xd9\x03J\xd6̅\xecڟ\xf26\xdd9\x06\xf2?J\xc3l\xda\xe6\xc1\x15϶\x9b!\x17\x97Wi\x184\xd3\x05\xb9\x05Y\x92?\xed\ue806\xc2^\x89@\x10q\x91\x10\xf8ٵ\x9f\xa6\xfe\xa2\xdfe\xddF*\xa6~~\xbd\x8e\b\xc8/\xb8,IX\xcf\xf1&\x9c\x8a\xe1\xf5H\xafR\x7fь\x14F\xebZ\xa4\x00\xb0\xd4,\x86ʢdk\xe3Ž\x8a\x92\xcf\u0082q\x9a\b\" \xac\x14[\x82\xaaX\xd3ʺvQ\xba\xa5Cڥwx\xe8\xc8\xffw\xbb\xb6\x03Y\xed\r\xf9k\xb2;\xeb37a\xee4e\xb67\xe7\xae\xc1\xdd\xc8\xdd0\xbe\xe1i\xc672X\xc2i<\xd6f\xbe\xb2\x06/\xf3X)\xd0\nw͜ڌNSr3\x9eN5\xb9\xbd.\xbd\x16\x93Ƴ\x88_\xf18\xa8\xae\xdc\xcbr\xad\xafF5\x1b\xe1\xa0\x03\xffS\x13\xae\x11K(gn(\xcf\x0e+\xb6eo\x83\xa9K\x94\x95&\x96n\xa7\x02\xc1\xdc\xec3\xecO\x89\xd5\x1f\x9a\xd0\xd2\xf4\xee\xa8Z\x1b\x16\xcf=\xbb\x06\x1f\x1a\xad\xf4T\b\xbe\x14\xa2o\x03t\xe1\x85\x153.\x13\x9e;i\xc0\xa2h\xabe\x90\x116\xb8_Ǡ:\xb4\xe8i\xa1\xa2\xfdN݂ŬK\xeb\xc80\xbe\xe6i\x98W-z\xf4(Q\\\xa1\xe8h\t\xaaG\xaf\x1et:\x06+b\b\x02\x8cT7\x9b\xba\xa8\xa4\xf8$\xf5t\bKt\xaaҢG\x8d\x90K\x83Gn\xcf\xf4\xb3tt\xc7Q\xafd\xe9\xe9\x80\a\x8d=\xe4J\xbfyA\xcdA\x15t\x16-!\xfe\x8d\x16\xefm\xd8h\xa6)EuC\xc1\x04\x91\x84c\xa6\xc9\xf2\x14X\xa3p\xa9\xca1a<\x0f&a,&\xd9\x13h\xdeZ\u05cd\xc2S\xb1\xf1\xfaUU٩3\xc5k\x91\x89\xc5\xc8Zl\xbd:d\x7f\"\xff\xff\xeft\bx\xad\xff\xbf{t\xdc;\xec\x94\xfd\xff\xdd\xfd\xa3\xbf\xfc\xff\x7f\xc4\x1f\x04\xa2\xc8k\xa9=\xbc\xfe\x9c\x86\x8d\x8aNPIN2\xfch/\xe9\x86\xfc4\xcf<\x95!n\xc1\xb4\\\xcb\x1e\x88l8F#\xb1\x0e\xc6IJwbz]\x87n\xc1\xf4\x10\x83\xbc\x123<\x95?vw\xa9\x02\xf1\xf6\xcew~\x9a\x13\xe8yx1 \xb4\xe7ZޅW\x93v{\xbb\\\tغ안V\xa6\xac\x95\x03Wn\x16\x8dUM\x84d\xef\xfcB\xd1\xfe\x89/X\x18\xc3\x13C6d\xd1E\xac\x9f\xf8\xc2\x1e\b|\x90\xbar\xe8\x1a\xc5\x02_\xc0\xb3<\x8cq\xa5\xe5d\xc9,\x1dq{)QN\xd3d\xca\xd3\x1c\xf1\xca<\r\xfe\\\xe6_x\x94[$\xc86h\xd0+g\x14%1/\xb5D\xc0i!F\xf83\xcbaq\x14\x8e\x17\be\xafV\x83p\xdc\x02\xfd\x9c\x8c\xd9$\tf\x11\x7f\xe8y\x96\xbaE\xd5z\xfc\x98R]>\x87Ȫ\x8cX\x14\xec{p\xa3o\x98\xf2\x96\x15\xec[\xf6\xc0\x04R\xfd\xbf*\"]\xae\xa2d\xe8Gx{\xeb<',\x97yr\x86\xe4x\xf4\xaa\x93;M\x93<\x01b\\\x99\xe3\xbc\xfb\xe1\xdbo/ጚg\xbd\x9bE\x91\xe5\xfc\xf0\xee\xf5W_\xbfy\xf7\xd5k\x91\xfa\x83\"\xd5y\xf9\xfe\xfd\xb7_\xbdx'2^&I\xc4\xfd\xd8r\xde\xfd\xf0\xf6\xe5W\xdf+$p\xe9\xba\xe5\x9c}\xf8\xfeͻ\xbf\x8bD\xaa\xcbr\u07bf\xfc\xc7W\xaf>\x88D\xa2\xc9r\xbe\xfe\xe1ݫ\x0fo\u07bf\xbb|\xf5틳3\xcf:\xa7\xb7\xa6\xd8עe\x17֠x\xad\"\xfbZ\xeb\x04>\xcaU?\xa8\xb6\xba\x10\x19 s=\xcf3ѯ\x14*\x12\xa7\xbbJQ8n\xd1/\xf7\xda\xcf\xde\x7f\x8e\xbf\x13 -\tk\xdb\xdb\xc9٠F\xce\x14m \xf0\xaa\x81\xe1\xb8\xf5a1\xe5\xf2\xfb\xa1\xe7il\xb4\x97\xf95\xdc\xe0\f\xb1s\x00\x85\xb7s\xae(\x900\x9bE\xb91\xd0\xf4\xf6h\xd8\xe9gs\x83\x96\x02\x15\rL\x95\xbe\x92CEd\x17\xe4\x13\xb9\xf62\xfb\x1c\xe6\xa3k\xf85\xf23\x8e\xb7\xda\xf7E\x19%v\x03̺I\u0080ud\xa6)\x82\xd8\x1a\x8c\x8e\x15\xc3(\x19\b\xc4\xf0M\xb8\xad\xa1\x10F\x89B\x17U\xac\u008aI0\v\x02\x94\xd0R>\r\\\x95\xafɯl\xa7\xc6\xf6\x95&\x91j\x80\x94ER\x92\x8b\xa9\x9e1\xea\xb1MY\x14\x8e\xb8\xf7\x02\\\x15\xda\xc8\xc4\xd4B\xe2\xfd\xf4\n\xc3@!:&k\x8dc\x1a\xd98?x\xe3X\x8d\xe3\x16\xbcp\x0f<\xd9\xfb\xaf\U000dfcdf[\x17O$\x86\xf3\xffj]<\xf9\xb9\xd5:\xff/\xfb\xe2\x89\xfd\xb3\xbdg\x9fw/\xd49\xc0\xbd\x9f\xf7~\xdes\x9f<?\xff9\xfd9\xbe\xb8\xfdy\xef\xe7'\xad\xe7}\xf7\x96\xbe\xed'\xcf\x7f~\xf2\xf3\xdeޕcY\xb6V&\xdb\x15Ibvr,\xa5\xab\x9121\xfbx^\xf7\xf1㇘r\u07b9x~~\xd1\xc7\xdf\x05\xf3\xc0zl\x8dcG\x9e\xd3'\xaduI\x97\x1ex\xe3X\"-\xc2\xf9\x10\xc2\xf7(\x1a\xafu>\f\xe3\xa0%\v8xf\xe6\u0091,\xcb\x14Q\xda5\x03\xd1\x02\x8f\xd68\xbe\xbdZ\x95.\xb7o\xa1\xd7\b\x8a\v-\x8c\xdf\xdfRS\xf0\xb7h\x97\x9ci\x01R$\r\xf0\xa2\xf0\x16}\xb4\xdb6\x82\x9fk\bv)\xeb\x02\v\x9d\x8b\x0fI\x1f\xc2\x15\xe4LxzeR\x83\xbf=\x14\r\xd2o\x94\xd9Q-\xac\xb6\xa0@\x87L\x1a\xc7\xceH\xce\rḥx$\xe8?\xed=~\xac\x8b\xb2\xca?\xef\\ض\x14\xe8\xeb0\x1b\x94\xba\b+3)\x13%\x9d\x9e\xdd\xd8\x7f\xb2\x85WYMoI좻\x04\xd9\xd8cP\x9aO\xa6\xf9BN\x03\x9e\x86z\x85\xb4\xe1\x93D^\xa9\xc67g\x97\xaf߿\xfb\xf0ջ\x1f\xde^\xbe\xfc\xe1\xef\x7f\xffI\aPꑅ\xf1R\x0e\xa8~w\x85\x8c\x9a\xc2\xc0\x95\x89\x96\xad\x9f\xc7Zi\x17\x15\xacZv1f\xb3\xd9\x10\x0f\xfe\x00M*\x91.\xe0h)+\x0fl(|\xe7C(Ԑ\x83\xae\xa6\xe1/Z\xae\xb1&\x1c\xb7\xb4\xb9\xaf(\x82\xdd#-2\x95\xeaf\xd7\xe18\xd7I\xfa$\xe8\x81.t\x8bk\u038d\x11\xa1j[\x89\xb9\x11\v9\xc8P\xf7-vIf\x0f0\xd1\xcdfS\x9e\xd2\xe9&\xb1L\x97\xe9\xd4tl\fPM\xb9\xf6Rf\x14\x9aN\x14,\x12\x04\x8a\x02\x00&5Yn\x90\xa7\x8b\xa5(QT\"lE\xf8\xb0W\x18\x14\xdc\xe2\xf6r\xb5*\xcc\xe2\x8e2\x8b\v\xee\xd4\xd9\xc6T\xb7\x1f\x04\xa2\xa1:\x8f\xe1\x9e\xfdp\xdczX\xa2O\xe3\xa3ݜ\xe5\x19\xf2Zn#l\x0edy:\x1b\xe5I\xeaa\x9e2z\xe1\xa3\x10\x1f\x8d2i\xa6\xe0P\x8aG<\x83\xb2رE\xaf<~\\J(\xaa\xd4\x05\x0e\r\r\x81\x0f\x9aX\x19'8\x06\b@\xcd5\x0f\x9b\xcdI[\xe32v\x8d6t\x06\x05&\xbc8\xe6\xfd\xb8\x06\x91ȩ\xe2\x11\x19\x96}\x8f\xbe]\xeaƏgt\xac\x83x\xab\xd6\x19\xa8H\xc1ZP\x8cj\xe4!\xb8\xfd\xf8\xb19-S\xeay\xe7\xc2\xf3\xac/\x91\xe7\x16U*\xd4$\xe6\x0f\xa8*\x9a\xf3$\xbe\x89\xb2\x164\x85$R$\x01瓋\x86q\xba*\xac1\x87\xaa\xb2\xa9\x16\xc9G\x0fu?eɴ\x12\xa4Z#\xe8\xa02Q®P\x9aT7iv-\"Yi\x13\x84\xf8\xbd$}ק\x7f\x1c!\xbb\xfde!\xc7\xfd\xe2\xe7j\x05\xfa3\x1c\x9b\x8b\x98bIT\x9b쒦\xc7\xff\xaex\x94\xf1\x12\x98\x9e\xbd\xb2[r\xc9d\x0f\xe4/W\xad\xb2[\x96\xf6\x14\xddd\xea\x8b\xc2\xdf\xc2\tS{И\xa7O\"\xc5*O\x1e\xdc)\xaf\xf3\x1e\xb6\xac\x91\xc2\x1a\xc6MW3Y\xbee\xdb\xf6\xb2\x10\x11\xb8\xb6\xc3^Z\xb3\x8c3\xb0QG\xb9\x85\xf3\x9c\xc2\x05\x16\xbb\xa7\xa1v\xb0\x9b(U\xf5\x98\xe5\xf0\x88O^\xe5\xe9w\x90\xe2!R\x17\xee_\x10\xd5\xde\xdeb\x8a\xf8\xb2\xcf\x15\x8e\vpM\xbc\xcaS1T\x9d,O?\xa4\xe1\xc4#\xf9\xd0\xe0\xdc<\r'\xb7\xb7U1F\xd1Q\xb6\xe3\x7f\xfd\x9c\xed\xde\xfe\x9c\xed~I\x16\xe4\xca\xf1\xd3\xf4\r\xddnA\xa6\xb0\x8eR\\{\xa1a\rs>\xb1\x97\xda\xf0'\xc5'\x06;\xa8\x06\x1a\xfa4\xeeaք\xc5\x0e\xc0\x90F<\x0f/<\xcf#,\x82\xbeP.d\xdaݕ\xf3\xfa\xfdۯ\xe6E\xbf\xa2\xbe\x14\xa1\xdcb\xf2\xc4{M }\x80\x9f\xa3$\xe0\x1e\x96\x1aq\xbc\x0f\xe5\x1c\xf2.(S\x94\xf4Ŀ+gt\xcdG\x9f>$\x9fx\xfc\"\x0e\xfe\x0e\x8f\x85\x05\\\xabNu\xa2\x93\x03\fI\x15\xfc\x02#\xc4\xd2\xd7zXc\xcb:\xfb\xe9݇\x17?^~\xf5\xfd\xf7\x96c\xbd\x88Y\x18\xdf\xf8\xb0\xa3\x90\xa4,\x8c\"~\xe5G\x8c\xd65쳟\xb1l\xcaG\xe18\xe4\x81e\xaf\xc21\xd8\xf1{nγ\x9c\xea\xb0k\xf0\xbfy\xf7\xcf\x17߾y}\xf9\xea\x9b\x17߿x\xf5\xe1\xab\xefEU\xd4\xfbL\x9c\xdcϘ_T\r\x17\xd8\xf8\xa3\x1cT\xe0\xaa0oE\x17\x93\x89Xn\xe6ʩ\x19R<\x92=\r\x825\xe1\xc1+aW\b\t$T\x00U\\:b;\xd2\xf80\xcb<7?Ų\x05\xd71v\xff\xfc\u0091\xb2$J7\x89\x13)A\x98\x8e\x04 X\x06\xa4\x1a\xc5\xf9 \xf5\x1e\xa6\xae\x1aL\x1aI`\x8bu\xdbj\xe5\xe8C9O<\xc5\fm(x\xe7\x17\x05\xd8\xdfy\x9e\xf3ԫ\x0e5\xe89U\x9a\xae\x10X\r\xb0+uT\xe8#\xd0\x12\x06f\xfd\x18'Y \x0f\x8d\x81|\x1e^\xdcނٺ*\x97\x92\xb2P\x94\x142\x8c\xff\xecz\x96%-\x9b\xdaA@s\x1b\x15y\xe8y\xedn\xa5\x02?\b\xd6\xe0\x0eǭ\x8dx=\xc0\xabw#%\x0fj{\x10z\xa6L\x02]Y\xb6\x86\n\xbaz\x0f\xc6\xf4FbФ\x87ć\x1aU \x98#N\xe9N\xf7\x0e\x94\xd1\xd5\x11\xbf!\x7f\xfc \x10Hhn\x15Z\x1c\xda/\xd3k\x88\x10\xa6D\xc3$\x00\xb7\x1e\xb4,f\xd9\xe8\x14\xa5yť\tR\xba\x9d\x84\x8fZ\x9f\xdb^\xf3l\xe4-\xafx\xde/\r\x00\x87ǳ\tOa\xb3\xac\x8f\xcfp\x8e\x92x\x1c^͊\x94\x15\xae\x1aj+j\xe9\xf3\xa11\x02\xa7N\xa5z\xb5\xb6\x98\xa3J\xe6s\x97\xbcI\xc0\xb0^\xf7\xe0褷\xdf;\xec\xd9\xcbJA\xb7 ѣ;<~\vbV+q\xccT\xf0P\x9f2//\t5\xb1\xe8\xf2\xd2^\xea\xb8+٭\x86\xca(\xdf\x06\xc3I\x9c\x057<\xd9߿y\xf5\x9fg\u07fc\xf8\xd7\xe5\xbb\xf7\x97\xaf\u07bf\xfd\xeeŇ\x8a\xb5S\x05\xb9\xbdm,mz\xc7\xece\xe9u`\xd3\xecZ\xad5\xe0\xf0\xe5.\xddjÄB$ɡ\x02\xcb9\xf8\xe5\xca\xf7\x94q\xea+aa1\xe7A\xc6|\x96\xf21Oy<\xe2,O`\xae\x13\x85,\x1a\x9f\xe2\xcb\xd3\xf1Q\x0e\xbd\xe8H\x19\xf4\x9b\xd2\x03>\xf6\xd19\xbbč\xd8iB\xc7\xd0\xfa\xd6\xc8O\x830\xf6#ˡwi\xfb\xd6/<M,g\x12\xc6}\xc5\x1fg\xe2ϵ\xafi\xca\xf1\x8cj\x9f\x9c\x12E\xc3qqg\xd4g\xc3c\xa8_\xf9\xa3\xebº\xfcD\xe3\xfd\xfc\x93\xf0L}\xba\xb8\xbd5ʜ\x7f\xbaX\x91g\x8dh'q\xf0\x96+\xfa\x14\xbaɏ\"؝\xc5U?=\xef\x19\x8dq\xa6\x1b\x94<\x0e\xe5\x8e\xc0l\xb4\x1c\xfc\x9c\x8bg@5~\xd9:#E`^\x8dz\x81\xea$\xd08\x8cr\x9e\x16-\xcc$\xd4\xc3L\xed\xad\xaf\xec\x95D\x9c\x9f\x85\xbf\xf0\x96\x88\xd5\xc0\x9a\xf1\xa7#b\n0\x85~\xafl\xa3\xbf]5XPYZf8\x8f%\x80o\xc2\xcc\v\xf6\u074cG|\x94\xb7\xf4\U000b6e2a\xb4ee7W\xfd\xec\xe6ʲ]?\xcf\xd3\x16\xdd\xcag9\x82\xddA~-3\x88\x0e\x91C\x1f\xb6\xf2\xee\xc3`\x00cהa\xf7{\xf1\x8c1\xc9<\x00\xdd\xde>l\x80)\xef\x01\x00\xb4m\xc3\xcc\x1eƸ\x80MY\xea5\x94=\a`\\8?Loo\x1f\xa6\xc5j\xd1\xfc\x12\xaf\x96\x16X\xb1\xefR~\x15f9O%\xb6\x16h\x80\xb4\xb5\xa4w\x7f\x01de\vS+㹂\xc2ޑ\x0f5\xdf\xdeZ\x19<Fe\x91\x03T\f\xb50\x1bA(\xc2\xf7~|\x053\xe7\xa0F\xe0\n\x81\x12B\x87\xac\"\xc7|\x98\xe1\xbf2\a\x96q\xf4\x93\x85q\x96\xc3\x12\x1d\x14\x9a\xf9̳M\xb3\x18\xc1\x9d\x85W\xb1\x9f\xcfR\xde\xec5\x11\xab\xfbB\xe2A\x15\xc9Z\xc4\xc1O\xe1$\xee3k\xb7\x84\x17\x9d\xa2\x18ґ\xbdJfq>\x90\xe3\xa0<\xd4E\xbbZ\x06\xe9D\x94\xb4\xfde\xadBD\xb5\xdai\x17\x03\xab\x87\xb5\xc3Ì\xaeS0\x8b]\xfb\x00\x8f\xcf)\x02diC4\xb3W5|%<%D\x90V\xd7\xf42F*\x8c\x1c\x98{\xf4u\u07b9p\xe7(\xaa\v-e\xa1M^\xf3\x87\x9e\xdc\rRSҢH{\xfcx\xf1\xd0C\u05ec$j\xce\xfc8`\vVx\x8eX2\x16,g\xd9u2\x8b\x026\xe4\x8c\xca\x13w\xb9\x1f\x00\x8c\xb5+\xebܵ\x10\x89JX\x00/\x88>\xb1\xdcx\xe6\xed\xdbK\x95x\u07bbp\xe7\xa7\xe2w\xf7\u009d\xdf\xdej\x1f\xa7Z[U\x86\x81\xad]\x06\xab\xe30\xcdoy\x02\xd4g\xf4RY\x12\xb39C\xa7N\x86/n\npz\xbb\xddڥ\xf5\xefj%\xa6\x041\x9c\x00\xd9\xebd\xe2\x87q\xd9\xf1\x0e9\x9e\xae\xc2'\xfe\xb4F53±\xa2 \x87I\x18\x83Μ\x841>\x10j\x96\tT\x99\x00۵\xb2E!\x7f\x8e\x85\xfc\xf9\x86B\x81\xce!(M9\xe7\x930\x86\x99\xf5bU\xa38*͢\xc6\n\xa3X(OJl\tŃ\xd4\xe0\x1d\xaa.\x9d\x83l\xd9\x12\x82\xfeq綋\x87&Z\xe7\x1dM\xd7_\x88\xf2\x8b\x8d\xe5\x17\xaa\xbc6\x1f8\x1d\x85\xc0\x9d\xf8Wq\x98\xcf\x02ތ\xea\\\xe2:\xef\\\xb4\xb5ߎ\xfa\xdd5\xd2/\xca4S\xad\x17R\x12\x88\x19ev\xa1Z\xe6\xf8\xac\xb5\x10\x06H\x80\xcfV\xbd\x9a\x1e\x98\x9cU\x0f]י\x1cUE7\x12Y\xf6R\xfej\xd9jƧ\xd2z\xd7\rL\x926\x8a0Y!-\xbbQĄ,7\xe7\x97M\x94\xc0\\']\xe2&P+\xb0\xe5(\xa3\x7f(\x00HX{\x9e\xf0\xc8\xd2\x17N\xbb\xf2\xc3^\xea\xd4V\xa7\x81b\x8e\x13 (\x1a\xf6\xb2@\rK\xa7\x95\xbd\u0096\xcb\xd4\xe7j\x92\xc3h\x18z\x01\xbc\x0f\xff\x1d\xd42\xa7\xa1^\a\u05f65\xb5\x173&v\x02\xea-\x84E\x9b\xa2ȱ\x97\xc5\xefj%\x81\xbd\f܅\xa7\xa3n\x05\xee\x02\xba\x7f\xb5\xb2K=\xed^'\xc9'\xa15+\x98x\x9c\xa7\v{\x89<\xc0\xdf\xeeX\xcd\xd3\xd1\xd89\x87\x8c\v[h,M\xc0\xb1+L\xf1\x9dŘ/\xfa\xe5R\x19\x1f\x90\x88\x97=\x12\x92\xc8_$\xb3\x1c\xc6*\xfd\"B[\xf6@|Ғ\x00\xab\x17\xbf\xed\x81V\xb1G`\xd41+=C\xfb}{\xab\xf5X\x89\x0f\xfe8\xe7i##t\x84\x1b\xf9\x11z\x9d\xc1\x1d\x85Pݠ%\xae\x92\x92]\b\xd5\xea-8\x0fww/\x8c\xae\xac\xb6Rz\x9a\xb4\xa4ՠ\x81\xf9\x15]E&\xa1F\xfd\xe0\x0e\x06\x96\x06\xe6i\xbf\xe5\x1c\xad\xb6\xa7\xc6--\xf7\xf1c#\xf7\xa1^RM\xdb\xd4\x149\x1f\x8f\xf0\x98\x11]\xcf\x15\x84\xe31\x1e\xc9Q\x16\x88\xb2N`\xca\u0590\xedZ\xec&\xc3Y\\\xabn\xd7\x1a\xb0\x8c\xf3\xb2\x11\vJ*j\xd9Vi\xaa/\t\x8d\x87\x03\x04|\xa8(=\xfds9}\x92\x0e\xf3\xf4\x81\t\x16X\xb1\x82t\xe7o\xc3\xf8\xf6\xd6L\xf1\xe7¹\x9f\xbd\x89i\xe2\xc5W\x00jJ>~\x1c\xb8\xf3\xd3r\xaa]\x14$wK\xa5\xa4?ǒ\xcf*\xf5\x96K\xca\xdd\x01\x99l\xec\xf6S\x1b\x93\xf8\a\x9aLjf\x9eڙ\x8a|\xc7\x12FM\x99\xe6ҧ\xc0\x96\xaa\xa5\x1b\xb1T~g^\xe9\x1b\xe32Ki\xe7\xf2\x17Zl\x17\x9e\xfc\\\xc9}\v\xf2\x95\xf1\x1a\xcfH\xb1\n\xbe\xbd\xd5ֿ\xf6\xd2X2#\xf8\xea\xdeކO\xb0V\x05\x1cυߡ\xffIn\xd5<\x170\xfd\xb5\x8e\x885\xeb@S\xfdB\xfbELL\xa5\xa4\xc6lG\xb5_\x98\xe8\xa9\xe7Y2۲\x97\x06R\xaff}Zߝ-\xa3\x9c\xf0\xa6\xaa9B\xef\xaf\v9\xd6G\xb0\xa2\x80\xdb\xf7\xc6a\x1c0\t\xc1\xac\xddte\x12QE\xb1*\xc8\xc7\xc0\x1cϢ\x85[\x99\xfe\xa2\xffE?\x9a\xfe\x90\xb2P .\xf8\x0fJZQ\a\r\xa0\x87\x9e\xa7\xdcQ\xd2\xe0\x83\x9b\x86(\u05fd\xe29\xb8\xf0f9\x0f\xce \xdd\xf0\x828\xb8\xd0\x1a\xd0\xcb#\x98\xf2/|\x93\x00Cj\xdf\xc4y\vQ\x01\x0e\xe9\x95\xf8',N\xa4\x93\xc4v\xba\x1d\xa34=\xc0\xb2\xb9\xb8p\xa5`\xf9Ua}{\xba\xec\xeb\x04\xdd\xde\xc2i\x13\xcd\xe4\xf5\xb4\x91q{k\xd4~{\xdb;\xec(\xf7\x8f\bŸ\t3\xe1Ź\x93{gUv\xa0\x89\xfeZ\xbf\x8b\xfdu8\a\xd7@澂\xc33\xba7\xd4\xcc\xd1'?\xea\xff\xd15\x87\xd0B\xa9Oķ\v[\x87y:\x9bt\x0f\xbcs\xeb\v>\x1a\x1e?=\xb2\x1c\xeb\x8b`t2>\xee\xc0\xafa\xcf?\xa0_O{'Ǉ>\xfc:\xee\x1e\x8d\x0e\x9e\"\\\x8f\a'=\x84\x1b\xf2\x83\xa3\x13\xf8\xe5w\x83\xcea\x00\xbf\xf8\xf1h\xc8\t߉\xef\a\xf8\xcb?99\x19a\x89\xa7\xc1\xa8\x17\xecï\xa3\x83\xa7|\x88\xf8\xf6O\x8e}\x7f߂@F<,ײ\xeb\xe9\xedu:\x1d\xa0\xf8\xf0x\xbfs4\x86\x92\x87݃\xd1\xf1\x11a;:<A\xbc\xc7\xfb'\xfbO\x0f0m\xf8tt\x8c4\x9d\x1c\f\x8f\x8e\x0e\xe1\x97\x7f<\xf2\x0f\xa9\x8dc~|\x80eyo|\xd8\xc3V\x8c\xc7\xe3\xe3\x1e\xa5\x8d\x82\xa0\x83p\xc1\xc1\xb0\xdbE,\x01?9\x11i\xfc@\xfc\x1a=\xed\x1ev\x11\xf3S\x1f\x8e3 \x05\xc3\xceA\x0f[vx\xd29٧6v;'\xbd\xa1u\xd1ز.\xb4\xac7\xee\x1d\xecc\xcb\xf6G\xbd\xd1!\xe2=\xf0\xf7\x8f\x89ǇG\x87=ꕣ\xe1\xd1\xf0x\x84u\xf5\x9e\x1e\x1ec\x89\x93#?8\xe2\xd4\x17\xc3\xd1!\xfe\x1a\x9e\x04O\x0f\x91\x17\xc1>\xef\x1c`\xdah\xe4\a=\x9f~\x9d\x1ct\xb1WF\xdd\xc3\x1e\xb5\xd1\x0f\xf6Oz]\xc4\xe7w;]\xaa\xed\xa4{\xdc=F\n\xf6\xbb\xbc\x8bX\xf6\x83\xeeI\x17\xa9\xda\xefu\xfcn\xa5e\xe8O\rGO\xa1U\a\xbd\xfd\xe0`Lm9:9\xeaP\x8f\x9c\x8c\x893~ox\xbc\x8fm\t\x82\xd1\xf0\x10\xfbpt\xe8\xef\xf7\xb0\xc4qpx\xb2\x8f=\xf2\xf4h\x7f\xd8#\xfe\x8ezG\xd4K\aO\xbb\xc1>R\xd6\x1b\xf7\x0e\x0f\xfcf\x19\xba\xce\xf3\xe9Y\xee\xe7\xb3\xcc[\x1ev\xf6\xfb\xd6\x17\xdc?\xec@/\x1dvz}\x90\xe9\xfdq\xf7\x00\xbe:}\x90\x8d\xfd\xa3\xee\xbe\xe5\x1ctዏ\xfd\x11\xf7-\xe7\xa0\xf3\x14\xbezO\xbb\xc1\b\xbe\x00\xcb\xf8\xe0\xf0\x98\x9f\xc0\xd7\t\xe4u{ݠ\a_]\xc0\xf2\xb4\x17\xf8\x1c\xbe\x0e\x11\xf2xć\xf0uЇ\x11\xd1\xf3\x9f\x8e\xe1\v\xeb\xeb\x1d폎,g\x1f\xcb\x1d\x8d\xfdN\xef\x00\xbe\x80\xb2\x93\xe3\xd1~o\b_\xc7P\xae\x13\x9c\x1c\x8c\xe0\v\xb0\xf4N\x86\x87\x87#\xcb\xe9!\x96\xae\x7f0>>\x80\xaf#\xc8;>ه\x1az\x88\xf3\xb0\xe7\a\xa3\xa7\xf0\x058\x8fGO\x8f)\x0f\xda\xe0\x1f\x0eO\x86\x01|\x01\xceQw\x14\x04]\xab\xa43\xf0\xe0\xde\xe7k\xce#\xe8\xd1\xe1\xe1\xf0\xc8\xc7\xde;9<9>F\x19:>9\x1c\x1f\xecSO\x1d\x1e\x1e\xa3\x94\xc0nڐ\xc6\xe2\xe1\xf0\xa9O\x12\xbb?\xea\xec\x93\xfc\r\x0f\xbb\xfbk\xfal\x94$X\xdb!\x7f\x1a\xf4\xc6f\xd9\x02\xf3\xf1p\xb4?$\x1d\xf4\xf4\xe4D\xc8xw\xd8;\"\xcdsp|\xf0\x94d\xaa\xf3t<<,\xcb\xe9d\x16\x871T\xd2\xe9\x8cF4|;\x9d\xa3\xa3\xe1>\xa9\x83\x131\xa4\xc7c\x99\xbb\xbf\xdf\xe9<Ŧ?}*\x7f\x8dF㱄\x93J\xe0\xa4\x03\xff#|'c\x89\xf9\xe0\x84\x14\xd2p\xffЧ\xb4\xe1\xbe\xcc=\x1av:GC,;\x1e\xee\xcb\\\x89o\xc8\xe1\x7f\x84\x19\xe8\xa2_\xa3\xa7㱠O\xd46\x1e\xf3#\xfa\xe5\xfb\x00\t\xbf8\x87\xd6\xc9\x16Q\xee\xd1\xd1ё\xa4y8&\xb8N\a\xdaI-::\x92\xad\x84vZ\x17\xdbNR\xdf\xfbq\x90L`QQ;S\x15\xd9\xda\xfev8\x81靧7~$\"!\x03Z\xfa\xe99\x9e\xfeq{\xdb\x15\xcb\xec,'\x94h\nx\xbdN\a\xd3\x01\xf6\xa5\x9fqﭟ_\xbb\xe3(I\xd2\x16nC\xbc\xf6sn\xbbWt\xbbl\xcb\xde\xeb\xf2}!u\xa2\u0382,\xf2\x89\x03\xb6T\xab\x01\x11RB\xcb~\xd2\xedtv\xbb\x87\xbb%2\xb4\xd0\x01\xe9\xb2\x15kE\xc8\x18\xc1ꍧ^wP\ufb10\xcbi\xbd\xe64\x04\x17\x7f\xa9\xf2\x1e\xb5\xf5\xc6\xd3\xe8\xdb\xeb\x1d\xee\x8a\nvw\xd9n\vK\x8c\x92\x8c\xc2\x10\x9e\x88\xac'\xdd\xee\xdeӣ\x8e\xbd۳\x9ft\x0f\xcbP{ǘq\\I\xefRFW.\x9aq\t\xb4\x9c\xf7\t\xb7\xde?\xbb\xb2\x03\x9cE\xfff\xd7l\xc5\n\x1c\v\xe5\x8eӚ\xf0\xc4=9\\\r\xb4\x18\x85\xbanY\xcf:A\x9f\x88\x9c\x06k^\x90\xb3kH\xd1j[\xa1\x06a\xa9\x15g\xc8(;\x1d\xf2_ޣCǫ\n\xdc/I\xcc)\xb3e?9\xea\xd4\xed\xaeN\x928\xbfμs\xeb\x1fp\x1c\xcb\xfa\x1a\xa6\f뭟BL\xd84\xc5\xdf\v˱\xfe1\x8b\xf1\xbf\x11\xa4Ϯ \x8c\x8bO-\xc7z\x0f\xa7\xb0\xacwɍ\xe5X\xaf\xf9H\xaa\xbbY\x1c\xc2\xf1!<\xb7ط\x02>\xf2\x03n9\x19\x87\xb7\xaa\xb3\xfe\xc9\xd1A\xa7\xf3d\xff\xe8\xd0\xed\x1d>\xe9v\x1cz\x80\x05\\\x005^Me\x8d\aЪ\x1f>\xbc\xfaz\x16E?\xa1#z\xaf\xdb\x01\x13\xfcI\xb7\xb3Z9\xa2\xae\x05\xf7\xd3\xfa\x9a\xd6WS\xc5^\xe0D\x1eU\x90v\xdc\r(\xd1\xd9F\xfc=\x97\xe8\xdf\xc2g˾(\x90\x7f\xe6\xfcS\x19\xf7\xf1\x16\x88\t\x02\xba\x1b\x9c\xbd\n]\xe0/Jضk7\"\xd2\xf0\x1c\xb1\xebd\xa6qr\xff\xa8\xd3yr\xb45]\xa8\xeet\xba\xaa\xd8\ue3eb{\b\xdb<\xb3\\\x13\xa9\xa3Γ\xee\xe1\xfd1V\xd1mǵ\xb7X.k\x99\xc4\x11\x96\x02[wK\xd9;#\xf8\x96\xbdkeV\x81\xb1\x82\xee^ش\x91Yh\x10\xf8\xc2\xe0Gc\x0f\x01R\xab\xb1\x10\x90\xaa\xe0dAX\xbd\x87\xf4\xcc\xeb\xcaV\n\x90\xaa*$ԫ#s\x1fo\x89\x17P-\xebѐ=\xe2\x96\xdd\n\xcc\xe2\xa6\xc6\xd3[\x99'\xd0\xc8\xd2\t\xb6\xd6\xcf\xc1n\xff\xe7`\xd7\xee\xe3Y5\x81j\xc4\xc3Ȝ\xff\x1dj\r\xc6>p?\xfdz\x06\xfa\x95\x82\x14\xe0\x8e\x00\x1e\x80\xa3B5\xcd\xf3\x84\x0e\xb0\x97\x05\xb4'\xd5m\v1\xee\"\xb4\xe8\xa3v\xd7~\x82S\xbd\xc0V\xc0vT\x9a\x9b\x99\n\xa7\xc0\\QE\xe52\xa4E*\x05(\xb9\x02\x8d\xf5v˩\xdf$\xb34\xab\x92#%\xba\x92!ũ\xa6D\x14\x85\x99\x96K\x1d$\x81\f\xdbgU\xe2*j\xeb?\x15S;\x7ff\xee\x89L2\x93x\x18!\x8f\xf6t\x1e\xd9O\xf4\xaf;\x19\x1c\xee\xb7\xc9ȏ\x1a\xcd\x0e\xca\xfe\xff\xb4\xf1\xf1;Z\x1e\xbf\xa7\xd9\xf1\xe7\xb49\xfe28\xeelp\xfc\x86\xd6\xc6ohj\xfc߷3\xfe0#\x03\x06\xd2o=\x1bV&\x98\"\xab~R\xaa\x9f\xc3\xea&<\xac͜a1ɜZ댕:K\xa5a\xce6&\xec;\x98\x16\xbf\x8f\xc1\xf6{s\xb3\xfbg`\xdd\xefb\x95mC㿗\xcf\xff6\xebK\xdc{c\x0f\x9a\xb2\x84\x8e\xfaϷ/?\x14\x1aF\x9cw\xf1\x87\xd9\xe5\x82<u\xfe0k-\xf0p\x10&>\xf3\xba\xbc\xdbS\x9al\xb1\a\x9f\xbb\xd6\aK\x1d\x01Q`O\r\xa8\xa7\xbb\xd6\xcb\x1a\xa0#\x03\xe8h\xd7z[\x03\xb4o\x00\xed\xefZ\xffY\x02:\xed>~\xbcx\xd6)\xc0\xdc<\xf9:\x9c\xf3\xa0ճMH\xcf\xf3\x14\x98EXT\xa1\xd5j\x13\xb3\xc0\xfd\xd6\xed\xf4\x0e\xfe\xf3\xed\xdf?|w'\xaeu\xe6\aڭg\x1a\xa5{\xa5\x9c]\xeb\xbb*\a:O\x9f\x1ev\xbbG\xbd\xe3\xe3c\x83azzm't\x8e\xf7\x8f\x0f\xba'\xbd\x03\xa3\x94Lܵ\xfe^S\xe4\xe0\xe4\xb0T\v\xa6\xd4vM\xa7\x84\xb9w\xf0GtN\xfd9\xe9(I\xdd\xef\xfc\x88\xe797\x0fIk\x19\xe58\x02\xf2e\xc3Ƴq\xc2\xc7ܔ\x1e\x98\xd1\x06k\xf6\xa5=\xc4%3\xcf}<7\x82\x1f\x17\xb7\xb7\xda\xd7\xed\xad\x01\xa8\xedL\x11\xb6t\x16\xc7a|E\xa7q\xc5\xce\xfd\x15\x8fy\xea\xe7I*Se`Lqd\a\xe2\x19\x92\xa9\bӂ\xa6\x11~L\xf042U\xc019\xf9\x9d\x8f\x8e _\xdc\xcd\x04\xd1r\xe1\xa9V\x98\x8e\x9b\x8a\xc2tR\xb1\xc0\x86\xd7Q\x00\"E!\x84\fjT}\x93E%x\xc7\xf8\xdc\xed^Щ\x91\x8f^w\xf0\xf1\xb4\xb9Q\x83\x8fe2T\x95\xad\xee^s\xb9'x\xf1Y\x13\xf9u\x8c\xb90\xb6\xf1<\xfaG\x04\xbb$9\xc4P5\xf0T\x063\x19\xb1\fpw\x9ca\xa4\x8a\x9a?\xf1\x85<ݤӢ\xf7\xfe\ueb84\xd0Z\x86Rٲoo\xd5\x16\xddjP\vS\xba\x10\xa0t\xd4A\xabX^\xa93P\x03BE\xaa\x95\xe4\xce+3\xe1I\x0f\xcehR\x8b\xd7\xf7{\r:S\x12:\x17v\xcb=\xb4\x1b\xe4\xbdR\xb1\xd7#\x15\xf1\x9b\xd4]\x03\xb0\xdbm\xa6gww\xa5w>\x05\xd2\x01\x1dj\xbeǯMZ\x8b\x8e,\xbd\xf8\xe8\xcf+'\x041\xb58\xdcD\xb7ވK{\x96E\xf8M\xbf\xee\xfcZ\xe0\xe7\xfe\x0f\xdf\x7fK!A\xe2C\x86\b\xe2N\x14f\xd0\xef\xdbۺ\x15\x8a\x8a(\x84H\xa9\x88\xe7\\\x16\x91\xdf\xc6\xcd\n\n\x1a\x8frKP\xfc\xa8\x83S\xfa\x94>\xe1fA\x9e\xc1\xda\xcb\x11?\xfbZ\x99/]\xff\xa3?o-gi\xd4\xd7\x1b\xe7\xc0\xbfp\xb3[\xdf\xfa\x98%\xb1\xe5d\xb3шg\x19\x01\x89\x0f\x17/\x11\x81\x14\xdb\xe1@\x0e\xe5\xe2O-oe\xafD\xb6V\xf1(\x89\xb3$\xe2n\x94\\\xb5,\xcceQ\xe2\a\x10\xd7*H\x80hV\x9d$\xdb`\x83@\xad\xe82v\xff\x9c\f\x039D<\xb7\xd65-z\x15V\xf1\xc9\xd5\xe3\x7f/\xc5yl,\xd5'<\x98\xdd/\xc3\xcb\xe8?\x8c\a\U0010a7f7\xb7\xc6D\x87\x92\xd6R\x85\xf5B\xa5C\x0eE\xcf\xcbv\tb\xfa5Sj\xa0d\f~\r\xb40f\x8dā:\xf3J\xdf\xf2.+,\xb2&\xa4\xb9\xc0\xf6\x9f\x1c\x8eT}\xe2\v\bg\x86\xe1\x85(U\x9em\x1c0\x92gg\xe1v\xcf$e>\x1e!\xb2\x1a\xf6\xaf\x83\xa2\x1dPI@\x95\x04E%\"GT\xa1\x1d^\xaaT\xa0\"\xca\x01\x91'\xcb\xe9W\x00\xe1M[\x16\xea\v˱\xb0\x94\x83(\xad\x8bA\x01R%r\x8aJ=8\x9f^\xd8\xd9\xf9\xf4\u0083_p\xae\x006\x8b\x95\xbb\b\x85aeo\xa5\x86\xe8\xa5\b^\xa3\x8aDN\x9d\xf9T\x960\xeab\xfci\x1cOͼ\xa5|\x94\xa2\xaf\x9fL^\xd59qQ\x80\xa4\xba\x90\b\\Y\xbe\xf1\xa4k\xcd\x03\x18V\x11\x1aQr\xa4\x88g0\x1c\x1e\a\b\r[\xeeј\x0e\xa8\xc1\xf7\x85W\xfa\xbe\xbd]\x0e\x939\xcf0\xa8\xbc\x94\xe7b\x8e\x88&\x10\x98\xfbZ\r}YK\xf9\u070f\xa6p\xcc\xd0eUAM\xd82\x91K\xf7m\xc9\x16\x97\xa9E\xb6F|\x9cS\x06\x8d\xe99\x15\x05\xa1\x84\xac\xd3\xce\xed-\xfc\xfbL\a\x11\a\xaa\xc0\v\x85\x02VT!{\xc2^jiu\x1d\xa2\x9e۱\xecA\xb5\xb8\xf9\xfe\xb8GO\xa3\xaf48\xe2e\xa5\xd5Ä\xce\xe9\f\x13A\xa3\xbc\x1d\xa8\x9c\xb0\x86\x1c54\xf0\xe8g]Ӵ\xd8]\xaf\x9a\xef5ݒ\x14\x847\x96=h8\x8a]\xe0\xb1\xec&\x99\xa6\xc3ׯ\xae\xc3(hIb\x14>?\b\xbe\xba\xe1q\x0e(y\xccS\xb8\xba)\x84\x93Ŋ9\xdc^J\xe0\xa2r\xba\x85\xa3e\xd1!(\xb33J\xfd\xb6\x0e\xf47\xec\x8fr50\xf3\xe2\xf9\x06{\xd5(((\xc4\xf0\x9f]k:\xb7\xb6\x10(zGv\xdb\x16\xe8\x1d\x0e\x94\x8bߨ\xe6\x95T\xd4\x00\xdcO\x14\x84N\xd0\x00\xc28\xe6)\\}\x85\xc8E~]+k%\xa4ԣ\x1b\x88\xda0nK\x0f\x83Y\xf6@S\fud\x94\xf0\xa1bA\x16Ł\xbd,\x8b\xc1\x06\xda6\x8d\xe2\xf2\xdb^\x9b\xa9\xab\b\xe6j\xa5\xd3g\xeaO<Ts\x96\xfb)\xc9ڠ&\xf7\xab8 \xf7\xce$\x8c[\x86V\x958\x9d&E:0\x14iQ׳j\x15\xf6\xb2\x9aF$\xd5\x12K\x04\xf9\xf3\xbb\x10Թ\xa03\xea%\x84tҡZ{\xbb\xae\xe2j\x7fi\xa3\xb5\xae\x00\x8dކR\x9f\xeb\xaaFz\x1a\x8a\x15\xc2!.\x012\xb4|\xb3\x9c\xd7@WFE\x9d\xdaY\xd9\xe6\xb93b\xa9<q\xd5Ҧq丸\xff\x16\x8f\xf9ncz\xcd\xc3j\x84^)\xab\xce\xf8*\x99M\x1b\f\xb0s\xb1\x8f\x86\xcf\xfa|\x801X\xdc\x13c\xa6\xdd\xdeZ\xd3\xc8\x0fcq\xa3\xcc\x18\xdcu@\xc3\x0fq\xa8\xe0\xe9K\x85\xaa\x16\xc9\xc2{VZj\x18\x1b\xff\x84֟\x82y\x9b\x86~\xce\x15\xf2R,\xc0LVA\x1b\xf0\x80\x9e\xb6\xf8\x06\xe5c\xefR\xc6թw\x11{zŅsߣ\x9c\xe2\x00\xf9y\xe7b \xb6\v\xcb\x13\xc3\f'6-\xf0VG\xb47S\xbe\xf9g^\xcf^\x02\x0e\xdcJ\xbc\xbd\x9d\xad\nۛR\n\x8aϋ\x9f\x85\x9fiU\xf4\b\xc55d\xeb\x8e\xf6\u05f6\x11\xab\xafv\xd3\xedm\x13\x8fE9\x8c\xa3\xf5\x8a\x9d\x87V\r\x7fls+\x82xJ\xee\xa9\x0f\xe1\xe8\x93W0\x12r\x12\xd1\x02\xed\x8awr%\x8e\n'\"\x89\xcb\xe8\x13\x85\xcc\"K\xb0v\r+mq\x0e\xf4zT\tc\x9bh\xaf7\x10U\n\xc3\x1b\xef\x89\xe8+`Dԇ\xff\xac\xd4\xdd\x7f\xa2@\xe3\xf5\x00\xa6uV\x11\f0\xb3\\\xba\xf6\xf7]\x12p\xa1NĜl\x97n\xe4Q\xb7\x0fIƔ\xfb\xbae\xab\x06TjJ\xc4adM\xa9'te\xaa\xddh\xaekN\xbc\xbb\x19)\x9aƫ\xad\x91\x94p\x83ICω5\x9a<\x88\xd0T/\xb6\xd0\x1ay\xb4\xc9`A\x98r\x85\x98\xa82\v\xfb)\xc1\xd1\xe5\xaa\xf0\x02\x15?$ہ\x9b\x8a\xf6\xa0\xceV@\\\x1b-\nev!\x9c\x12\x13\x94>\x99\xb7\xdar\x8a\x90\xfe\x95\xbbL\x11?6\xcc\x0f?\xae\x9f\x1c\xe0k\xc8\xd3\xfc\xfa{Xr\xbaݭ.\xc1\xaa\x9fL\x924\xe4\xb1Xqb\x9e\x96p{k\xe5\xc9T\xcc\x19\xd3pΣ\xec;\x9e\xe2\x00FP#\xe9\xf6\xf6\xf8P\xeda\xa0x\xd8\xf2@w\x1e\x8e\x00\"\xd3f&\x03\x12\a\xb7\x00/\xbe\xbdR~\xa1Y\xf1\x04\xa9ʅ\xaf\xdbۃ\xbbO\x85\x92\x04\xb5(\xd8p\xdbڥ\xbcDD\x1dO\x96\xf9\xfa\x85P\xe6\x85\\F\x05\x8d\x17r\xd5\x1d\xcc\xdct\x98\x13ǐ售\x05c\xea\t\xcf\xd2\xcaԓĝw.@\xbf\xebyB]ȷ\xe9<K>Ng\xdd\xe7\x123\xed>G\xe0\x856tn\xc2luבt\xa7S\xc3\x0f\xf5VI\xedY\xd3wF\xe3\v\xed_\xea\xc6{tΓVwW\rLqq\x10~\xeb\x87~4p\x05\xbb׳\xeb\xba\x045x\xbbKpd\xb76Mtj\x7f\ay\x8b\xe8\x1fz\xc6\tn4\xbfm\xb3G\xfdY\x9e\xd0U\x96D\xac?\x17\xe2{s\xe5\xc2\xef\x96-.9\xd1\xed\x15[(\x89VY\x83\xd8\x03(\x83\x03\xeek\n\xa5R\x03\x90\xbe\xb5݃\xb9ڜ\x98\xafl\xb5;\xa5\xa9\x03\x7fn|W\xf2\xb5\x01\xef\x955\xcd\xedm\x99\xdf\x1a[\xf6\xaa\xfa\xecn=u{+Nt\xa5~\x9c\xc1\xec\xa4\xc8\u05f5\xa9\x87ʓT\xf7BD\xc0j2t{\xab\xd1EI\x03\x85г\xf0g\x04\xa3\xc3\xda\x15\xbd\xefX\xbb\x02Ϯe\x8b\xed\xf4\r%XǶV\x928S\xcf݄\x99PR/\xa2\xa8e=\xb1la\xfc\xb4\xec\x95\x02\xd0\x15֕UR=\xe7V\xf1J\xac\x18\x04\xa6\xaa\xbd(nd\x95E\x15\xbd\x96\xa3~\xda\xe2a\x0f\xd9\xdfZWg6\xa9\xf8\xd90\bo\xc2\x00⅔\xceo\x19\xf3\x81\x18lWi\x18\xc0\xa7W\xd7\x1f\xf4B\xb9\xf5\xbcۇ\x80\xa4\x1a\xf6\x1b\xaaj}\xe3-\xf5\x94\xafu_\xfa%\xad\xb6mܭ!n\x18\xa8\x90\xb7\xaa\xd1d\xe6u\xd6j&Z\xd6ܠ`\xdc\x7f\xba\xf9\x0e\x85\xc6\xeb\x13\xees\x01B\xb15\x04\xfa\xc6p\xd1\xdd\xfd6\x86\x95~\x9dB\xab\xf9>\x85\xf2\xb0\xb7M\xe5\xbc\xf5]\v\a\x9d\xfbݑ\xa0\x99s?5\x98y?\xdds?\xf8^\xa6\x1c\xbe(\xfa\x7f֘S\x05h\xa2\xf1\x9a'\x9e\"vc!:\xd60\xa5\xefj\x15\xde\xc7\xdek\xb0\xdd\xe8Y\xf6?\xa9\xe1\xd6\xe4\x9e\xdaʌs\x04%\xfd{Yp\xbf^\x9b5\x19|\xbf\xc5\xe50ͺ\xed^w\xc5lwYLY\xb9=1\x05yk\xe5\xd60\t\xde\xef2\x19P\xb0&\x1d\x86\t\xacC\x96ȭ\xb7\x9f\xc0\xfa\xb5\xece\x8d\xc4\xe7ɴd\x16\xaf\x1c\x12\xb7~\xa3IL5\x97lb\xe2\xc3\x1a\xa3\xf8\x1eF&U\xd4he\xa2\x89-\xa6\xf2\xd4\xff\f\x13\x81^za\x0f\x8a̿\xa7a\x80\x86Ŗ&\x82\xa3P\x16|\xd0\xee?l\xb4\xee\tf{\xb3\xbe\xa4m\xefg\xbf\xaf\xed\xf4\xad\xc4\xc60\xc2M\v\xb8\x10X0\x83\x95E\xfc\xbb\x9a\xc3\xe2\x81\xfd?\x83-,f8\xc0\xb3r\x94(i\xea\x17dj\xb9\xd1b&\x9b\xa1j0#c\xeff//\xd0^\xfe\r\x8c\xe5m\xa3_\xd0\xc0r\xcf@\xb2\x83&\xfbKd7\x98a\xb5E\x9cZ\xe3\x8c^\xe5\xaaX\xdf\"F؏x\xf9\x05\x82\xe2)\x1c\n.\xb3\x88\x12&\x9e\xe5\xcd\x18\x96\xb2l\x19\x96\b(\nl\x83R\x15\xc0\x9dj\r\xa1\xc8@UVL\ue626\x8a\xad\x88ta\xd2\xd6)\x10\xd16]\x8f\x04\x1fg\x19L\xaeHVA\xa1;J\xa6\x8b\x96\xbc\u0097\x92\xe8w!\x92\xb2:\x1d\x85]+\xa3\x92\xa7(\xaa\x85.\x0f\x03{)\x91@\xd6jK\x81xɯ\xfd\x9b0I\xe5\xfd\x93߄W\xd7\x11M\xbc\x83mA\xef\xe0X\xa5\xd7\xe5)\x99~\x0f\xaaN\\\f\x12;\xf3\xc7\xdc\x13\xafKR\x1cŷa\xcc\xf1\x86pL\x937\x97R\b0\"4\x924ۖ\xe2\xc30U?ܦ\x05\xd2~\x7f5ԡ\x9c`\xdfM\xaf\x86-\xb8\x19\r\xfeg\xd9v\xcb=\xb1\xf5\xf7\x83T\xbc\x95\xe2\x02\x86\xaeh\x1b[\x91\xbd\x8c\xdc\xe6ЖI2\xcb8\xacX\xcd\xf0\x160\xb4Uk\xa5\xa9\x87\xe7\a4&D\xe4\x99'\x06\xe2nn\xcd\xd6\n$k\xf7\xfeF\x9e\xe7A\x12~`\xf2\xb3\xce\xe3\xc7\xdaN@\xf9\xe2\xdcǏ[\xb4OL\xbd-\xb3\x9f\xd7%\xca2h\x1f\x18\x17\xf1S\\\xb3V\x8d()\xf6\x06\x91\x90vw\xf0\x00\xb1&ix\x15\xc6~$\v)\x04zpe\x15\x97\x88\x17\xd5\xe0\x9d.l\xff\x0f\xaa\xa0\xb8\x87A\xbf\xe5f\xd9J\x89۹\xde4\xba\xbfsM\xde\xed\xad\x9e\x84\x80\x83J\x8ag\xc8d\xab\x92\x0f\x9bi\x1a\x95jC]\x84\v\r6K\xd0,\xaf\b\xd0\xc3\xcd\x12\x84\xc3hK!2\xc4\xe7\xf1c\xf8o\xf9\xc9\n\xcb\xe89ː\x80\x9a\x0e\x9b&Ӗ=h\xecȪ,8\x1d\x11\x02l\x0f\x02\x1e\xf1\x9c\xb3*\f\x980k\xba\xcb^V;g\r\xf8j}Ǭ\x94\x9dF\xfc\xb3\x97\xdaG#3\xc5\x12\xb0\xaa5Z\x11ޕ}\x1f\x85\xfd>\r\xcc\x13pk\xc0~KE]^~\xba_\xd6\xcd\xe5\xa5[U?\xfe\xfff<]0?W\x85\xacU\x15\x91;\v\xb7\xc7\xf5\xc3\x1b\x1d\x9d;\v\xad\u0557\xfa\xa2\xfb˖)\xe6Yn\xbbY\x92\xe60*)\x88\xd5\x0fc\x18a}\x8b\x96\xc0\x96\x93'\x11O\xfdx\xc4\xfb\x16^\xe3\f:\x9a\x04\xa0\x98\x869\xf4\x9b3\v\rI?\xbf\x18\xd4U\a䶬(\xb4l\x97\x1b\x02A/\x8eѻ\x880n\xe1\x97\x19'n\\\xe0\xa5g\xafl-\xfa\xa0Q\xbdv\a\xe13\x88Mh\xb7\xede\x05\b^e,ݿU'\xf1+\xbb\xb6QB\xb1\x9d\xe1\xea\x00Y\xbd\xb279@\x80\xda\xebFw\x84^\x87r\x16\x89\xf5wM\x9eX\xf4\x12\x80w\xbd\xe5\x06sy\\|\xc0P\xcd\xcd\xe3\xe7C驷_?\x80\xa4\x01\xf1\"\x1e]뇘H\xe5\x8ag\x86!g͓\xa5\x03\x02Ѣ\x03\xac\xc7_t;\x9d\xce\xc1\xc0\x92y\xe5\x00Cq\xb32\xcdUExf\xc6\xd3\xfc%\x1f')oQA\xc7\x00\x18\x87i\x96cx\x80\xaa4\x891<\xd7+\xcd>\xba*U\xd7\xcb\x1bʗ\xc7\xe2\xfe}\xa3\x86j\xf4\x9a,m\x15\xf77k\x02v\xa7\x87\xa7\xe4\x83\xeb^W\x0e\xac\x1a2\x9b)B\xcei䬴c5\xe2z\xc3!\x8f<\xa3\xf4\x15\xcfEWe/\x17\x1f\xfc+|D\xd0ʦ~l\xa1q\x82Ej\x99\xa8\x99\xb7/\xa2\xe8}~\xcdS\x98\xb43\xaf\x8e\xb5tP\xa3\x06\xda^\x9a\xe1I\xd5پ\xfcXs\xe4U`\xceC|\bB\xab\x18\xec\x00W^ߧN\xb6F\xd5\x1e\xa7>\xabm\a^/?L\xb9\xffi\x85\xc1\xaa\xf5\xe4\xff:\x91\xd9ʺ\xb1\x97\x9b\x1a\xb7\x8cj\x84d\x1b\t\x112\xbb\x1d\x15Q\xb5\x9dۍ\vX\xe7UL\x91bF\xfd\xf2\xa1\xf9>\xe0\x97-\xd3L\xd1'C{\xb9.\xb7\xb5\xccr?\xcd\xeb&\xc0Y\x88\x8f\x97Ґ\xb0\xe2Dʴ\xe5\x98\xc0\xf6\x12\xffq\xa7)\xfe\xfb\x9an\x98\x17\x9e\xf0<\x99\xd6\xe1\xce\xe8\x1e\x81d\x96\xeb\xf3\x88\xacq\x16W\xea\xb4WNﰃ\xfc\xbf\x9bEFj\x98\f1\xf2*\xfaA g\x81J윩\x82j\xceo)y)E#4Mՠ\x99\x84\x99\x93\xc4т%1\x97\xafN\xa0\xefoU\f-\x1a>uS\xf5@\n\x90^\xa9V\x90\xdeX\xa8\x9f\xe4W\x83J\xabͧ\x7fD\xea\xac\xca\f\xb3\xccvf\xec7\xb0\xf4}\xcds?\x8c\xaa\x93\xaf\x96y\xf7\xbd\xc0\xad\x8ekͿ\x96\xe1s\x94U|\xd7\x06c\xa8\x10\xbb9\x06י7\xe8\t\xce-J(\x17u(\xb5m6\x8f\x9e;{\xbe\xe8\x1bw\x05\x18q\x8dƖ\xd7\x1dNb\xe0\xc5?V@\xecU\x9b\x17!H\x06\n\xcf\x16\xb1\x7f4z\xfc\x8cV(\xb4`T\xbd-\x17\xa1Yqp\xf2\xec:\xf9,\xcf\xc4\xc2o\x99\xfeM\x18\xa8c\xb5\xf0[\xa6\xd3K\r2\xe7{\xedM\xa8\xb1\xc9\xc7q\xc1F3{UwŒx\xefh\xee,Tn\xf0c\xf1\xf3'G\xbfeJ-\xf7v\xad\xfe\xe3x\x98M\a\xd6n\x01\xba\xaa\x1a\xfd\xf6\x92{\xfc\xf6\xd6\xe4\r\x9d\xa11\x02\xab\n\xbe\xd1AJ\xee\xe6~z\xc5s7N\x02\x0e\x9d#/D\xfa\xaf\xd6\xd4ϯo\xb3\x9b\xab۔\x8f\xf2\xdbQ\x98\x8e\"n\x7f\xb9g\x9cU/\x8b3&\xa2\x92\xfc\xd1\xe3\xe2U\xa2\x1foo\xb9\x1b\xf9\v\x9e\xfeXd\xff\xa4\xb2\x7fR\xd9?a\xf6G\xaf\xa3\xbd\xb0#Cxc\xee\xa7<˿\x83\xc4AU\xd1mzf\xaa\xfct\x97(\xac=#\xf4qw\xf7B\x1d-\x15\xaaOo)\x85Z\xff\xe8ɰ\xdf0\xbe\xe1iN\x13\u008f\xb6\x06\x82K~\xf2\xb4\xaeyfM>ÇǨ]z્\xbe!w\xae?\xaaf>\xe1G\xf5`L\xf9<\x9c\xf89\xc7\xcaĝ\xce\xc9,\x0eZe\x1aD\u008f6\x1d\x81)\x97\xf4\f\xfcv9\xbf\xdd\x1eH\xbei5M¸\x82\xe9\xf6\xb6D\xab\xbe\n,\x03\x0f\xc2S\x03v@\x8bLd\n<(.~\xc1\x05\x00h\x8b\x01\xe9\"ӝ\x9f\x8a\xa8\xf7\x1f\x1f?V`\xee\xfc\x99l\xe7\xb2D,\\\x05#\xf2\xda\n\x87}ڐ\x87\xb8\xec\xe7a?\xdc\xed\n;Pս\xdb\xd5k\xb7\x97\xe1\uebb0\xfe\xdb\xed\x95\x04ÚO;vA\x85\xb8a\x1b\xe3\xed\x11\x91ʺ\x90\xee⼸\x99;\xfb\xef4\xa7\x83\x0f\xd3\xe4sKQ)\x03\xc2\x11\x8d;\xb7\xdbB\ue71e\xbd\xdb\x04\xbd\x10Ћ]\xf1oG\x14\xfb\t\x8a\x91(i\xb3\x8d\x18I\xfa|S\x9a\x90\xb0ĢRbQ.\xb10K\xe0@\xf6\x96\x85\xd2\xc3\r\xc5~\x81W\xb5Jӆ\x04S`2^\x87{\xae\x7fȑ(\x1ai\xf7\xe5\x0fy6\x9f\xfeq0\x992\x1d\xc9\xf3\xbe\xfc\xe1$)lF\x7ft\xf0\xce<M\xffR\x94\x83\xae}noe\xa1S=ٕ\xa9\xf6RO\xa6\xb7\xbbV\xf8_\xe1,\xc1\xdf\xea\x11\xbf2z\xa9s\f\xdc\xe2\xa1c\x9c!ued\x00\t.\"@\x89\xd9&`)\xd3\fQ\xd1\x16\xecVc\x88\xab\x14G9\x16\xe8|\x82>\x9b\x8bWnDL\x89x\a\xadO\xff84\xfd\xcb/\xf4\x16\xff\xd8'\x89\xa6\xaf\x9f\xe8\xeb'\xa7,4\xa5o\xf1,\xe5\x8f}\xf1/\x18\xec\xd7a\xc0\xfbeCP\xda\x18dg\xae{\xb69\x8c\xd5\x11\xdcb\xb5\xa2[\f\xd5G\xa0(\x03\xafp̮\x93ύ\x95c\xf75\xd4-WP\xeb\xaaGC\xa6\xaez\xc8\xc0\xea\xcb1\x15\xcdv\xa7>\xc5R<\x19\xfe.\x92Ip\xab^\x8ciq\x91\xaa\x10\xcb\xe2\xf2F\xf5\x8e\x9d\x90EiI\xea\xf3hY2\xa7\xb5\"i@\xfeT\v\xf9ӯ\x15^\x9dҹ\x10aԉߢ\xcbd\xbdQK@\xbaM;\xbfD\xbf\x89%\xb3\nZ֎7\xddĥ\x92\xa4\x99a\x19\xb9\x81\x06\x00\xd1)\x80oKߐ\xa2\x16j\xaf\x16\xfa\xa3|\xe6G?y\x1b\x95\xa9ыv\xdf\xf8\xa4z\x8b晶\xaf\xb4s\r\xee:\xa2\xe2\xf2x.+|*%ZV\x84\x0e\xe9\xe16&i\x9d]\x93RM\x11\xd51\x18\xf0J[mӲ%H\xcc%K\x92[\x98V\x90eR\xa9e\x0eq6\xa1\xfd_c\x17\xae\x91\xb0 ɵ\xd1C\xe3\xca^\xd6u\xb0\xd8+\xb3j\xe8\x93Y\"\x06\x01\x95BU\x8b\x88\xe5M\x9d\x1e\xa1,\xf9\x02\x9cc\xac\xa7\xfa\xf5\x8e\x86\r\x9b\x80\xa0\xd4\xcc]\xc0\x06\x8d(\xd6\xfb\xdc\xd6\x1d\x98r\xafq\x83\xf3^G`\x94\xb7\a\xdbSZ\xb7]\xc9]\f\xd0\xe4\xc1\a\\#\xc1c\xef\xa5$w\x94L\xa6~\xca_\vQ\xfaN\x84v\xb6\xaa\x15ۏ\xf1\xc4\xe2\xeb\xf7\xaf~x\xfbջ\x0f\x97߽?{\xf3\xe1\xcd\xfbw\x97\xaf\u07bf\xfb\xf0\xe2ͻ3[\xb4\xe4ZL&UFl\x199\x01\xaf\xa2\x7fW\xf5]`\xf2\x96\xa13\x1f\xfd\xb9\xb3\xbc\xffmP\xd3_{\x1d\xd4v\r\xfd\x16]hՖRzݙ\xbcZ\xf7\x85\x11$\xbc\xc9Icn\x954\x18\x11*P8\x92\x14B\xb1(\xccD\xcd\xf8\xabI\xfd\xcc\"\xab\xfe\x8c$\x94\xb2u\xfdn,\x81\x9b^R_i!\xaf\xb1\x9f\xcfR?\xc2-W\xf9č\xa7\"&\xc4\vZ\xc2=\x89\xfepyd\x1d5A\xacy\xef\xf4e\xf5ڋ/`sqP\\\x02@\xca\n\xbe\xb5\xab\x99ʻ1\nr׳T8\x8dE!͟\xfd|t\xbdAu\x13\x90^!\xa5X2G\xa8i\x7f\xf4\xe9\n\x97\xcd5\xaa\xbar)\f\x15\xd57U\x9a\x88\xa0\x9d\x14\xb1\x8d\xa2\xb7\x9a샨d\x1eh\xab\x8dj\xad\x11Y\x04\x11\x8a\x96\x9e\x8e\xd7}\xe8\x1b\x04ŭ^\x12a\xf2\xadp\xbbk\xb7*\x94n\xfd\x81\xc6\xe0]#\x9e\xbcb\xa5\x0f_\xe6\xd2i5\x90\xee\xe1\xec\x9aG7a|e/\x8dO\xcdK}I\x84\x99\xd9%7m\xcb^I\x84\xd72ڀ\xe4\xd1L\xa9\vG \xfc\xb4\x1d\x1c\xa1\xeb\x1c\xd7T\"yݽeҝ\x0er\xdc\xc2m\xea\xe6\x19e\xcb-[\xba\\#\nk\xe3\x1c\xb4\xcc\xfb\xb9\x8a\x7f\xbd\xae\xc2\xd4\xe1\f\xe4e]k\x9b\xe7ϕ\x83\xa5\xfb\xa5\x9d\xf2:ʚ݁\xe2\x06\x05\xaa\x1f\xe6\x89\xd7\xf2\xfa\x84R8\x84\xdaZϐk\xad%z\xc10r˙\x84q_\xddv\xe0L\xfcy_]\x97@+\xfb\xac\x7f^䫼\v\aq\xd5m\xe0\xc0\x05\xd9!\x19\x8e\xd9y\xf7\xe2\xd4+\xbe:\xea(\xbf\f\x9fU/J\x1bP\xe5l\x7f\xee\xe9(\a\xe6V\xc6\x06f\x80\x03I6\xc03\x89Y\u0590!\xf7\xceVE\xb9\xaeQ\xae[-\xe7ϵrtQ\x9d\xe09\xf8\x1d\xf5\x9b`\xb4\xb0a\x8aׯx\x9c\xef#\tD\x99W\xedi+\x99\xa2%\xeaX\x04b\xd9\x1b%\xa7\x19\xc5$\x8c-G\xb1r\x03\xac?\xb7\ni\xc1N\xa8\xf2\x9aְK\xd5\x1fŵ\x1b\xab*\xbc?/\xc1w/\x8a\x9bOV[4]\x88\xf3\xb66\x90|W\xbaF\x01\x89\x9c\xdf\xf4\xd0Z\xce\xe3L\x1dX\x13\x1f\xc2\xed'\xbet\x9b[\x04_ʧ\xa9K\x89u\x97a\xba?\xc8\\\xf9\x98vQR\x86\xf1\x94ߪ\x16\xb3\xd5w~~\xfd\xb5?ʓt\xa1\x8b\xa9\xcc>\xcb\xd3\xe4\x13\xaf\x05\x90O\x8a멤\x00\x96\xa2Q}\xf7\xc4\xc9\x10\x01\x1e\xe8\xe9\xf7\x1c=\xaeԙ\xfaA\x10\xc6W\xfd%l\x16\xbb\x9d\xae\x83\x81\xf8\xfd\x8eCGX1\t|\x0f\xfd\xceJ\xa0\xe9\xa3E\xef\x8c\xc3(\xa2\x9f\xab\x95\x90\xc3~\xcd\xeb\x91\xdaF\x05\xfa\x90\x8d\x83@Zf5\x1dR\x85\xea\xc1@\x19rJ\xea\x1b\x1ftL-\x1c\xb7\xb4|\xe3\x01o\xc1\x89y\xff\x1c\xe4\x1a\xcf`]8\v\xfdk\x85v\x04\x8e\x95\x02\x89\xf0D∨\xa4.J\xb0\x8b]\xfd\xa3#`\xfc\xf9:\x18\xad\x11\x1b\x9fq\xac\xbba\x14\x14'\xb8\xa8\xca\x1e*\xb8ot\xb1\x1b@\x15ḵ8\x05Zm$x\x81\tπ0\x1b\xa9[\x90q-\xa2\xd6`\xef\xe6\x14\xb8`\xc3\x7f<-U\x03*\x85\xc1\xc1\xd6\x05\xb0Ȇ\xffx\x8d0+{\x008\xdb^\v\xe0\xdaX\t\x1d\xf0\x10\xa2\x87\xae\xad\x01d\xee6àT\x0e\xb0)\x9a\x9cL@\xc5y\x96?\xcb\x13\xeb9d\xf6\xcdL8>\x8f\xadՓ\xfd\xb9.$\xcf!\xbfo\xe6\x0f̠(\xbd\x96\xdb[\xa8\xe6\xb4c/\x17\xd4((\xde^T\t\xa6\xe1\xb3*a2\xeb\x06$\xd8\xea&$y2]\x152\flq\x80A(\xc3\x00\xee@\xc1\x8bF\xef\xa9y\xe6\xb1qn\xab^\xb0{{\xab\xaf\xcfh\x02\f\x05\xc4M\xa8\xb2o\xe0lA\xf3i&\xb5\x01\xe7\xdd-\xac\xaci9HjA\\\x1f\x11'\x01ϼR\xed\xb0\xefk\xd98ߒ\nry\f5\x9a\xe7c\x05\x14\x1d\x1a\n\xc4\x19\xaa\x8a\"n\x89}&\b\xf4j\xb4\xcd\xc5P\xadY\x0e\x96BM\xfd\xfc\xdaC\x92Ai\x85\xb0a+\xaee\x06˅\xc2!%6y[\x9c\xa3g\xf6k\x97\xaf\xa0\x83\xe5\x05^Q\xf4\\_\x05\xf6i\x99D\x1d\x8cz\x9b\x00\xe9w-\xa8F\xab\x9b\xf1\xfcE\x9e\xa7\xe1p\x96\xf3\x96\x05\xd8-\xd4\xf8\xf6\x1a(Bm\x89ib3d[?\xf1\xa9MQ\xebJ\x8a3^\x92|\xb9B\xb5W\x8e\x9aZ\u05cf\x02\xf3\xaa\\\xacZΡ-\xbbf\x13\x1eo\xe0W^\x88R\x88>d\x92́7\xf2{\xea\x97>\x9b\xe9\tEU\x98\xaa\xdd(\xab{:KP\x9eg%Ï|\x04\x87\x15ג\x8f\xd0um\xd0h<\xfft\x81̐\x1f\x0fu]\xa8g\xf4\xf5\"\x06\x94\x9eѯ#\xe1\xfcӅ\x14bu\x16\x8cZ\xa2*\xa8\xaf\xb6\xa8\xb3\xbe\xc2~\x89\xab\x15(-\xaf\x96\xb2\x95\x1a\\p\x04W\xb3\x8a\x8a\xe1U$Ҹ.\xbe\x1f\x1a\xaa\xbb,\xb5\x9e\xf6{\x85\xf8?\b\x03L\xe1\x16\x16\x19\xe2\x15\xbfkp\x8a\x1cO\xfc\xbb\xba\xa35\xed~K\xf7\xaf\xae\xcb\xdeΕ*\x8b\xc8\xe7\xd8\xd0\xf5Ucq\xd2\x119\xa5\xa7\x15\x16>\xcfA\xe7Rv\xcbv\x96\x86\xe1Y؏Ү\xa4s\xd0\x1bl\xe2\xc6YlL\xb0\xf2\xd01\x10۲\xddy\xab\xe6\x8d\x02\xb5\a\xec\xce\xed\x95\xed.\x9aa\x16`g\x01\x8cv\xaeM\x9fЋd\xe8W\xd9u-\xbd\x1f큠\xcc\x15\xdd\xfc\xf8q)\xa1U\xfb\xd0\xdb\xe2!\xd9wŵ\x90\xa2\xd8]\xe5\xe1\fx\xbeF 0\xff~\x12\x81\xdd\xf9\xabD\x02\xa5\x00\xe5\xc1X\\HI\xc1\xaf\xdfL&\xfc\x94\xfb\xdb\xcaDg\x83Pt\x10\xaa\xbb\x01jWB\xfe/\x96\x9f\x97\xfe\x9a\x15:\xe4\xdeOv\x86~\xbaNr\xd0v\x14\xb9^\xb3\xfc\\\xf9S\xbc\xed\xc3\xed\x1c\x96\x85F\x9ej\x93X\xd4*_\xb2H\xa4\xaf\x9c\x8dǬk\x9eq\x12\x15\v/\x03}\xc8Y\x9f\xbe\x06\xe6q\xe7\xf2\x12\xd9h(\xe6y\xb2Y$\xbb\xb8\x87\x15\xe7\xf2\xb9~q\x91C9\xb9U\xbf\x9c.\xe2\xf2d\x84\x83=\xa0Z\xdc\xf9y\xf7bף\xd7\xc9Zet\xeeĿ\x8a\xc3|\x16p%6Tl\xe5\f\xfd\xb4<W\xea\xa6\xe8\x96\xe4\xca-{ U\\\xd9!\xf0\x1a\x17\xd5\x1ap\xb8\x10\xddm&\xf5I\xab\xdb\xd69_\x1c\x01\x97\xb8\xff\xcc+$\xb3\xfd\xf2\xabU\n\x0fE\x7f\xa0\x00\xffQ\xdc\xf1\xd6\xd1\x0er\x9f\x89\x93ֳ8\xf7\xees\x8aGk\xe7K\x83 1\xaa\x9eK\xc2\xf6*\xf5\xf5eV閌\xf2\x9b(\x13?Oùw\xdeu:N\xc7\t\xdc\xc5i\xe7y\xbb\xdb\xef\xaa\x0f\xa18\x8b\x8e-\xe2\x02a\x1a\xb6\x9f\xf4\xfa\x1di3[\x84\xade\xed\xd2\x0fq\xe9\x85c\xd9x\xf5ƯX\xb2m\xec\x93-\x17\xa0\xba\x04W\xfa\xa2^SׯWS4\xfd\xc5zun95H\xf4\xd9k\xb7\x90\x90\x95,\xb5\xb0\x9c\xf5\x13Ug\xb7\xc4\xeaV\xd1?\xf6\xaat\v\xa5)&\xe5kz\x9a+j\xec\xd8\xd5ڋJ\x06\xf4\xf8\xd84M\xf2\x04\x96I\xb2S\xe9~\x0f\xb9\xaa.\xea\x8d\xede\\\xbb\x80-\x1f\x12\x97\xce\x19!\xe2v\xc1\xb8]\xcflc\xb1&/k\xa1:\x9fg(\xf2pt\xe0K\x7f\xe6\xa913\x82X\x1d\x15\x93\xc5<-h\xb7\b\xfc\x1d\x98X\xcf\xe5\xe7\x85ה\x01ޯ\xa6\xbc\xdd\xddU\xad\xd6^\xe2\x85\xdb\xfd\x8e\xa3\xba\xaa\xdf-/\x9aM\x9c5\xebMڢ*\xe3v\x11\xf5i\x99\xa2\v{\xd9DE\x05T\xa3*\\i\x17\xa6\x97\x11\xdc\xd5\xc4y\x91r\x7f\x8d\x8d\x03\xd9\xf73r|@\xfc[,\x99\xca>w\xc3\\\xfe\xbff \xff\x1bm\xe3M;.\xff\x86\xe5矎E\xd5\xeb\xcfj\xb9R\\״\xce\xfa\x99\xf0\xfc:\tLSâ\xd9\xcf\xea[t\x84\xda\xd2&\xdcZ\xac\xfa\xb4[o\x0f\xab\x99\xf5\x9c*\xbc\x90\x97G9֕e\x0f\x10\xf7\xfd\x9cĥ˧|R%rj\x11\xbe\xd0\xe5\x9d*0\xa4\xafZ\x05=\xb0\xb3\x92\xf3Ɇc\x93\xffF\x17u\xf1\xa6\x1eb\x97\x15\x16wX\xea\x992\xcdr\x89\x85\xa2\xd153w\x85\xb9\x9b\x10\x12\xc3\f\x84\xe4\xf2\x96i\xcas-M6\xf8\xbc\xbdm\xbaψ\xe8p\xaca\x84\x9e\x95\x96\xdb\xed\x1d\xda&\xaeF\xdf\xf6\xaa\xe8\x88\xc2{\xbd\xbc\x87\xc3\xfb\xcen\xa0\x11\x069\x7f\x17%\xf9:gP\x01uO\x97\x10!\x98B5\xbf\xad\xaf\xb0\xea%jػ\x86_\xe5\xddk\xb7Ӆ%8]\rz\xb0\xb2\xb7X\xf4\x9b\xcb\xf7\x7f\xe3\xfa\x91b\xbe\xd1ۀx\xc5\xc7څ\xe5\xaf[\xfe\xfcqk\x1b:\x9bY\\\xe1\xb7yy\xa3\xd6\n\xa3\x8d\x8b\x9a\x026\xad\x03\xb5R+\x8cY\xf0<pӾ\xe0\xe9\xeaw[lH\x95y\xc7Q\xfbv\x16\xe5\xe1\x9a\xf1\x8a\xf9\xf7\x1b\xa9\x13D}\xd7q\xf0\xfb\xbb\xff\x9b\x1cek\xe2C\xa8\x88:q\v\x91\xb1Sy\xf3)}\xc83\u0557X\xb4E\x89\xf6\x80\xfe\xad\x0e\x13L\xd7N\xde\xe2\xb7\xfb\x1boco<\xb2+\x1d\x82\xf4\xb0\xafh$E\x8d҇-6\xc31h#\xd8\xc7\xe3\xad\x12̨^\x1f\x92s\x88\xfe\x92\x87(!8\x02\n\xfa\xf3m\nvU\xc1\xc5]j\\h5.\xeeR\xe3BԸU\f\x84\xe8魬u\xd2\xe6\x7f'9Y\xae~\xb5\r\x05]\xa9\xe3</\xdd\xe5wA\xb4ęg]\xe7\xf9\xb4\xbf\xb7\xf7\xf9\xf3g\xf7\U000fe6e4W{\xbdN\xa7\xb3\a\x97v\xabi\xa0><\xfb\xddY+\xce\xc8^U\xb3\x03\xc5@\x19\xf1\xd57\xf2\xfe\x1cY\xb9\xb0\x99/\xe5w\x95\xba\xc1Zڽ\xa5\xfcٗ?d\x94\xf5\xf9\x85s\x13f\xfd\xc2\x00\xbbA\x1d\xb7\x16]\xed\xb5\x81Nq\xed\x8f\x18\xbd\xe7\x17\x83\xf7\xb8\xebMN\a\x1de\xd36\xbd*\xed\x19\x04\xe0N\xbb\x18\xe9X)\xfe\xd6\xde:\xa6<\xb4fq\xadmh\x81\xcc^ҿk\xb5\x84:ְNO\b1\x92\xca\xc2\x14\xa5U5^\xc6\xd4 Fi\xa6\xcf\xc0B\x97P\xe5\xe5\xeb#\x89\xbe\xc8_$\xb3\x1c\x06\x1e\xfd\xa2\x82B}\xea1zJ}\x8f\xa2$\xe6-\x82&\r\xb4ɪ\x90\x17c\n(\xe2f\x19\xa1\x1e\xbf\x87\xf0\x17xhF\xf4\x7f\xa9C\xaa+\xcdm.\x8a\xa9\xae>$Oj.Å\xb2\xac\xf4з\x04g\xe3D\xe8\x0f\xb6\x83\xb3\xe5\x8e\xca\xc2ۈ\xca\xd7\x12\xaf[\xe7\xd6\xcfIFꝧ\xa6\xdfD\xe8JR#\x8f=\x97N\xa0\x87Y\x9f\x00\xe1\x82~{\xeda\x05\x12-O6\b^XW?@\x8eV۟\x922\x82\x166\xac\\$\xc8\xfdc\x1b~\x875K\xc5\xc0\xb9\xf7\x9ae\xdf\f\xe2\xbd\xc7\n毰\x8a\r~-1A\xbeN\xf2lK\xe7\xd6o\xb1ʪU\x1f\xf3\xff\xb3\v\xadҽȿf݅\xf7OH\x86\x96\x17a\xc0\xbd6.\xbe\xcaޣ\xfa\xe5\x9a\xf5\xf9:̹U\xcd.\xf9\x86\x1a\x90l\xf2\xfa\x98G\x93\xa4\xf7\x8c\u0600W\xf3m5\x18\x7f\x1b\x17\xe8\xaf\f\x86\xfdw\xfa\x1b\x7f3׳\xfe\xc6\x05\xf2\xbf\x94\x06J\xa0\xb5\xed4u6I\x92\xfc\xba\xee \x8b\xcc\xf9M\x0f\xb24\xbf\x10\xe4_]\xa5\xfc\n\xb5&]\xe3\xd45\x0f\xd2\xd5-\xa1\xdd\xeb$\xf9D3\xb3x\vW8\xf2d\xa3\xe8\x82\x19y<\xbc\x7f\xd8q\xc6\x14[\xa9vhy\xe9\bt\xfd\xd1;\xf3\x9ac\xe3]\x8c\xba˅+G\xea\xe0\x1c]\x17\xcf\xcfu;\x9d\xe6\xc3qX\x18\xa2;\x81\x01ꜜ\xddtC\x9fM\xb1\x9a\b]y\xc9$\x1cӯSu\x8f ~\xb2d\x96\xb3dLoD\xf7\x99\xb5\x8b\xa9\xab\xfa.\xc0<\xe3d\x91\xac\xdd\xd1\x18X\xdeF\x96,\xaa\xa0S\x97\xad\xe2J\x01\a\xa5\x84\x11\x16\xfc\xf9\xc5 Xs\xb0\xe4\xb5ڦ.ʝ\xa9\x1c(\xfd\xf9:\x8c\xb8\x06-\xfd\x13T\xe8\xe6\xeaG\xaf\xe3\xf87W?\x89\xf8\x13\x8c4\xf24pq\xe3xǩm\x81=\xc0\x02\xb5\xe7Z\x00\xf9\xae\x17\xb8\xf3=\x82\xa1\x8a\aP\x19$/\x8c\xe4\x95=\xa8k\x81\x10\xe2y\x1f\x909\v\xf8秕\xbd2\x99D@u\xc5K\xb70\b^\x9b\xa5\xb7T\v\xea<XU/\xa8\xac\xed.?n|\x11\xab<\x8a\xfdq\xceSc\x18\xab#k\x963\xae\x11\xb2\x87k\x1e+0\x04m\xb3H\x15\xbfk\xfb\x16v1\xbd\xceJ[v\a\xc4\xca\r畩kt\x16RJ\x83JE3\xa2\xfe\xe5\x14\xa8ϙ\xfa\x11\xcfs\xee\xd0\xd9\xc5\xcc^\x8a\x1f\x9e\xf8\xb7\x88\xf0\x13\x90\x9eq\xd2\x0f\x8fۻ\xdfQVK\x80\xa8\xb7\x9b&\xfc\xa5\x9fqO\xc4\xf2\v\x8cE\xbaq\x01\xfbs\xedI'\xf5ܴ\xed^\xd1\xe5\xac-{\x0f.\xc5\xec\x97q\xa8G\xf3\x8b8\xbbje*Ϩ\xaf\xcb\xf7\xfbuP\xea\x01\xb7\x0fZbK\x87\xb0\xe5-v\x8f\x1f\x8b\xda\xe0\xa38\x96\xf0\xf81\x19oa\x86\xff\no\xc1\xb2^d\xe8fvy5Û\x9cO(I9\xc8\x1d\x91\xdc/\x15)\x0eI\xc07\xcaJ\xedS7\x96Dk\xab\x05=\xc0Z+,F\x8f\x14\x14?E̦\xe8IJk\xa9\n\xc4}>\xd8\\\xf5\xeb\xf6\xf6\x1cO\x1d\xaa\x04\xa1\x90<\xcf\xeb\x88\xcb\xc3(A|\\\xf1\x1c/\xcdk\xd9\xcf:\xe2$F\xe7\u00ad\xe7\x0e\xacA\xc5\xed9\x81\xae\xcc ݝ;\x8b>\x8e uEt\x1d\tu\xc5\r\xf9l\xb7ԧ\xec\xe1\xdbێ-\x90\x13;\xe4\xd5\xf8\xf6\xda7\x19\xe4\x9d\x04\x98\x06\xe0/\x17x#w\xc1?џ\xa0\x15J\x03q.\x83\x9e\xe0\xa6\xc1\x12\x9fJ\x01Gt\x94\xab\xc2+\xac`\xa9\x9e\xf2\xd3ꧪM!#}\b\xff\xd1\xfcM\xf2\x02\xa8:\x11\xadrq~{K\xcf\v<\xa9a`\xd7\xde5\xb8\xec,\xfa\x14\xbb%y\x01\xf1X\xc5>\x90#[[=\xb9˄\x88<~\xacˊ\xf9%\xfa\xfbyM\x1a\x9c\xd4-x\xd1/\xb1ˌDӄ\x95\xe2\xd0DoC\xc4\x19\r0,\xa5\xd3\x15^\x88\xd3-Z\xeb\x8bJ\xc2\x1b{\xa9ؠ\xf4Px\x03\xdaL\x15\x03\xf6\x14Er\xad\x04\xa9ϕ\x13\xcc&Ӳ\xfd\x88\x83p)\xa1L\x91v\xf4\xea\xfa\x15\x02\x90\x1f\xe0\xad^\xad\xedq\xba\xad\xf53\x88\x8b\xb7\xa4\xa3q\x85\xa2\xa0\xfb\x15Uo\xe2\xb5A\x88\xb1\x90\x93\x86\xd1,P6\fh\xfc\xb1\xc0y\x11\x01\x90T\x02\x13\x05\xcbS\xa6\x13%~P3\x97cqCo\xd7tE\x05h\xa5\x97\x04^\x96\xbb\xc3\xc8T\xd0H\xa6\xbd,~\xaf\xd5\xf4\xbf\xa9>\x11\xf3Īb\x12\xb8\xbf\xf04\xf9\x1a\xceDV\x96\xa3eHX\xf4\x8bL\xa7\xa3\x9b Z~\x19\v\x1d\x80D)\x99\xab\xd5\xf0z\x9f\xbd\xb6\xebG\xaeOa\\\x87t\x8b\xebğ\xc3b<Z\xb4\xf0D:2\xb3q3LZ\xbd\xb6\xbd,n\xb3\xad\x14_\xe3#\x82\xa1k7\xe2\xc7 S\xd8l\v\x9a,8ܯ\xc4[n\t\xf8\xa17\a\xb3N\x98\xfb\xa1\xd3q@G:\x8b>\xf0\x89|\xbcp\xd1\xecV\xf6\x9d\x8bwu\xbf\x9e\xa5\xbexIcm\xfe&\x1f/\x95\xf9\xb5V`ap\xd4\x1bW\x1b\x9e\xd93(\x96[\f\xea\xd1=\x1d\x95e\xaf\xaauM\xfc9̚\xdf\xd1E\x97\xbf\xae2\x03\x97|\xe9\xef\xcfi\xdf\xd6\x1a\xa3u\xfcW\x9ad\xbb\x99R(\x9a\xd1,My\\\x04Ӕ\xa0\x06:\xd0\x1be\x9a\x94\xa0\xb4\x17\x0eu|\x9d\x9a\xd2\x1d\xe2\xb5ٙ\xb5]\xfc\a\xda\u06032\xe9\xbb^\xd7HC\xdaw\xbd\xeeʴ\x1b\xc5Ý\x06\xd9\xed2.\xbbj\x1c\x95or4\x10\x98O,?~\\\xc6wZ-\xa2[1[P\x84/6u\xe9\xc1\xa6m[\xbe\xa5e8\x8b\xe9t\xd5r\xdeo\xb5\xc2v\xb7\xa6\xf1\xb5\x96a\xc7\t\xfb\xa1\x16\x14Tc$\x8b\x9d\x1ci+k\xa1 \xce|\xfb\x1eT\xd3m\x99\xe7کc\x9a\x92\xca\x18\x9f\xd5\xf1\x1dӂ4\x99R\xf0\r\x10.\xbf*\xbb\xb4\x1b\x99\xa7\xfcD]\xf5̕\xdek^w\xa3\x91l\xb4\x16替\xfd\xf5\xf7\xbb\xfce\xd30v?f\xbfk\x1d\x1dxc\xab\xd3\xf9[\xa7S\xf7o\xef\xe8\xb0w,\xd3(\xbd\xdb9\xec\xee\xff\x8du\xfe\b\x06̲\xdcO\xff\xd6\xf9\xd5u\x95\x1b\xf7\xbf\xe4o\xefɓ\a\xec\t{\x95L\x17\xb8_\xcdZ#\x9b\xf5:\xddn\xbb\xd7\xe9\x1e\xb0\xafy\x14\xce\xd9\xdfc?\xcb\x00\xec\xdbp\xc4\xe3\x8c\a\f\x94L\xca\xf2k\xce\u07be\xf9\xc0\"J\x06\b\x11\x04%\xc4\n\xa3\xa0\x1e0\xac⫹?\x99F\xbc\xff\x801\xc6@\xc5'\xd3<c\x1e[b\x02\xc3\x1b;\xb3>\xeb\xf6\x98\xfe\xb7\xb7\xc7>\\s\x16\xe3\xa1V\x96\x8c\t\x8c\xe5\t\x83\xc7~\xb1\xa8\xc3\xc4\xf2\x98\x1d\xd7\x15\xa5L(\xca\xfd\xd15\x96\x17\xc5pO\xaf\xcf\x0eYm\xb10栊F\x9fb\x9ee\xa2D\xea\a\xe1\f\x88\xecԔ\xa0L\x86s!gx\x97&\xa3\xcdYQ\x1a7\f\xfa\xac\xeb\x96K\xa3\xfb<c\xc9\rO\xfd(bY\xf8\v\x97h\x80\x911O\x05\x86Q\x92\xe2\xbdǬ[\xc2\xf0=\xdc\x18\n\x84\xb2V\xc7u\xbb\xb6\x82\x87\xa5.\xdb\xf9\xa2\xd3\xe9\xec\xe8\xf0_\xa4WC\x96\xa4\xec\x8b4\xbd\xba\x1a\x0e\x05x2\xf5Ga\xbe\xe8\xb3\xeeށ\x81\xfe=eH\xa2\xb0\x0f$K\x92\xdc\xcfy\x9fuX\x85$z\x02\x9cѓ*\x02<\bSz\x01\xd1h\xc3\xde\x1e\xeb\xf6\xd9(JF\x9f>\x87\x19wX\x1b>\xe1p\x18OU\xaa\xe4\xe2\x94\xf3\xa0ā\x82\a\x19\x9b\xf2\x94e|\x94ā(\x90\xa7~\x18A\x9f\x95\xd9\xfeb\x9c\xf3\xf4*J>C\x99\x11\x8fs\xffJV2\x9ef}\xd63ۄe\xbeNa\xa5\xa3U\xc2>_\xf3\x98Ͳ0\xbeb\xda\xfb[\xb2\a~\xa1Y\x8e\xf5\xf8S\x13\xd1\x0f\x19g>\x83\x9bE\xd9/\xf4\xb0/\x1b.\xe4\xd1u\xd9}\xf2P@\x9f\xed\bA\xd8\xc1¯\xce\xce(\x93\xe5\t\xf3\xb3,\xbc\x8a\xe1\x17\xf4\x8d\xd8]\x93mO\xa6}\xb6s\xd8y\xb4Sj\xc7\b\xb7\x8c\xd9\rO\xf3\x106\xe2\x17j(\x8d\xf3j\x89\xa2\xc0u\x92\x86\xbf$q\xae\x15ɮ\xfd \xf9\xdcg\x18\x12\xa3\x17\xf9\xd75ϯy\n\x94\xd1\x0e\x04\xf3\x05\xb0(y\xfd\xd9\x1f\x8dxT*j\x96\x9ce\x9c]\xfbi\xf0\xd9O9Cp.\xd6B\xad\t*\xac!g\xc3\xd9\xd5\xd5Br|*\xf73َ?̒h\x96sb\x9a\b\xb6T\x00a|\x85EVJ#ѳC\xccc*L\xb3x\x96\xf0\xe5\xe2M\xd0\xda\x19'Ɏ\xad\xe0E\x9f0\x0f\x17lg\xf4\x05k\x9a\xccv!\xafE\b\xed\a\xec\xc9ރ\x81\xb2\x97X+M\x92ܑ\xf1)6[>x\xc0\xd8\x1eh\xe1\xc9$\x89\xffq\x06\xe0\x8c\x85c&\x8d\xebI\x12\xcc\"\xce<\x8f\xedЪa\x87=~,R]>\x9f&i\x9e٥o\xe6\xc9\nZ\xb6\xc0\xff\xe2\xedk\x89\nk\x10\xcefU\r\x19\x8eX\x8d\xa4\x15+\xa2tן\x04\xb6\xf8ݒ\xb4\v\xcc/\xd3\xe4s\xc6Sv\x15%C?ҰCK\xdd3ŧ\x82\xa2\x15ڥ\x0e+\x98b\xe3T`A\x87gy\x1a\x8er\xeb\xc1\x03\xe2\xf34\xe5\xe3pΡI\xe7;\x9f\xf9\xf0S\x98\xef8l\xe7m\xf2\v\xfc3\xc9\xe0\xbf\xefw.\x80\x90\x7f\xf28H\xb4\x12O\xf6\x84X\xf8q8A\xb9\xc19g\x85\xec\x90I,\x9d\x81\xfe\xfd\xc4\x17<\x80Q\x98_\xf30Ž\x85\xa2\xfc,㯲\xecE\x81e\xefIYLaTj\xd5$\xa9\xa6\x10\nD\xd95\xe79V\xcf0 \x82\xbe\xf3\x84]'Q\x00U\xb3\xff\xf8\xc4\x17c\xd03\x80\xe2\x9fo\xbf%\xf2\\@\x81\xdc~\x02\x98\x9e\xb0\x1f\xf20\x02\xa5\xac8\x98'\x8c\xfc%R\td.{3fq\xc2r\xff\x8a\x9a\x13f\xec*\xbc\xe1\xb1C(|\xf6\xfa\xcd?!\x91\xca\x05.{\x8f+X\x18\xddlJ\x97a\x85<c#?\x86q6\xf5\xb3\x8c\a.\x96\x85樚e43k\xe5\xfe\x95\x83%m1\xb1Ӎ\xac\xfa\x982o\xa6\x06\xd2no\xd9N\x10ވ\x91\x05L\x8a\x1f\xe0\xcfq\x92\xb2V\xcc\xc2X\xa0\xe4\xd1y|\xc1<\xfc:\x8f/\x10F\xac\x1ax\xf4\x00\x86\xb2Π\x17\x18\r\x93\xb1\x11DO\xa7<f~\x1c\b\xf0\f\x19MO\x18״'\x8c3֢\\\xe8)D\xd0u\xe8ߞ\xc3\\\xd7}\xb2'\x1b\x884B+C汮\xc3b\xe61?\xbd\x9aQ\a\b\xef\x03\v\xd9)\x8b\a\f\\\xf1\xa2\x1c\x93\xd5\xeb!ު \x1c\x9f\x16\xdaIo%\x15\xa9\xb4\xf4\x15\xb24c~,\xe7p\xa6d\xc87\xa4\xdc\xe0A\x98g(\x16\xc4\x01v\x16\xc6#\xce&I\x96\xb3I2\f#\xce\xfe\x85c-c\xd7\xfe\rgy8\x81I.̲\x19\xcf\xd8\xe70\xbf.p\xb7\x03\x1e\xf9\v!U\x9f\xb9\x94ÌO\xfd\x14~\xd0\x00\x03V)#l/\xe3W\x93z\xf6\xe3\xd5ݒ\xea\x96\x1fM\xaf}1\x8d;,t\xb0t\xa6\xcb\x17\x8a6\xe8\x06\xd1\xf8\x1d\x05\xfd?\xffC\xc5\xd9\x13\x98\xff\xed\xa2\xf8\x05]+\xb1\xd3ք\x0e\xdf\xecd\x1e븝.\xdbe\xe1\x1eBRQ\x05\xf4\v\xf3\x98t\U00076eac\xcdZ\xdd6Va\xb3=\xaa\x95=a\xadn\xa7\xd3Ft\xb6\xc3(W! \xd5ļ\x8aFq\xb3\xd90\xa3W\x12;U}㢍\xf0~\xdc\xdaQi;\xb6\xed\xe6ɷ\xc9g\x9e\xbe\xf2\xe1\x96|\xbd\x0e\xe6ɚ\x1e?f;\xed\x1d\xb6+\xbfw\xf1\x13\xc6\xdc\x0e\xc9\x16\xcc\x00\x0f\v\xc5uN/\xc0+1E\x05%\xde<\xfe~\x16\xf1\x96Hgl\xe7?\x04Z\xc0)\x05.c\x90\x88]\xb2\xcbv\x96;l\xb7\x80\xef<ZJ\x1b\x13\x80~\x01\x88\x95\x0eA]\xb0\xcbvL@\xea\xc32p\v\xa1w\xa1\xbbl\xb3L\xb7\x0e\x0e;\xc7f\x8f\xa0;\xb7\xadb\xa7\xdb\xd9H\xf3\xcejGhuw\x94eߣ\xae\x16\xae\xc9\a\x02\xa8\xcc\\\xd0\x145\xa3\x1b\xf2*c\xfb\x03z|o\xfc4Lf\x19\xbb)\xcdoe\x95\x86\xd7Ѳl6\x05\x1b\x80\aR\x89/jF\x99@\xd5\xe2QUc\xc3$\xc9#\xba~\xbb\x90\xaa\xa9\xfa\x19\x12\xddPL\xa8cwt\xed\xa7/\xf2V\a\xdf\xfb\x9cN\xa5L\xa2\x80$SqmQ\xd7V\x12\x97\x9dC\xfa\x05{\xe8y\xac\xf0Y)E\x97&\xd3B\xbd\x82j\xed\x90\x12\x95-/4\xab\xa1R\xa7J\xee\xf19\xe6]\x85GU\xda\\\xe5T\x99\x83f\x0f\x9c\xf1<cxL`\x1aq\x9a\xb4\xf5\xc9\xd1\xcfY\x12\x8fxݴ\x98eU\xf6\xaa\xf9B\x9b\xd7$\xf9\x92\xe5\xe7\xd47X6\xb6a\xacV\xa6\xbdՃ\r\xb3\x1fl\x97eP\x85XS\x88\x9b\xcekȜ\xf0\xf4\x8a\xb3V2\xfcX?\xa7\x11\xe3k\xe64\x9d\xf3\xe2Z/}\xf2;\x0f/D\xa6\xd9怏\x8br\xd4/\xc9\xf0#\xce\xecf\xc7\xc8T(!\xdb-\xed\xf5\x95\xde\xfcd\xf8\xb1\xd2\xfe\xef\xb5Q\x81\x8by\\\f\xb3q\x9aL0\r\xed F\n\x97%)\xf3\xd1\v_\xe5\x0e\xbe\xbc\x0f%[\x88\xc0aa0\x97\xe4\x8b\xea\x85\xf9L\x15\x80\xf5LXw\xd8s\x91֧\x7f\xcf\xc3`\xce\x1e\xd1o\xc1\xc5\vE\xf7\x1e{9\v\xa3\xbc]\xf4X&\xed_\xf9\xad\xbc%\xf7\xf4\x95\xdc\xcbSrW?ɯ\xf1\x92\xfcZ\x1f\xc9\xdd<$w\xf2\x8f\xdc\xdd;r'\xdfȯ\xf5\x8c\xdc\xd1/rw\xaf\xc8o\xe4\x13\xf9\x15\x1e\x91_\xeb\x0f\xb9\xa37\xe4ξ\x90\xfbzB~\a?ȝ\xbd Ru\xe2\x10\x1d%q\x96\xa73X\xa9\x97t\xa1\\ȷ\x12\xa9\x01\xe9\x956\xf2\xe4\xe2<\xd2J`\xbaZ\xae\x1c\t\xacn\xeau\x94\x1e\xb35\xa5\xf7w\xf2\x18\xc8,\x96_\xfb9\x8e\xf24\f8\xf6\xe0P\xa8\xc5\f\\\xc7e\xa4\xb8\x98\aTT\xb9\xccV\xa7M\x1cA\xa7\x98\x16pY\x18\x04\x99\xae7\xa4\xa4Є |@Blp\x01\rmdaLO\xa9\xb20c~\x94r?XH\x84\x88'\x8c\xaf\x1c\x16\xe6\x98=˓\x89/$\x89\xd1Ʉ\x80&\x9e0\xcf\x18\xbc菶\x9c\xa8j\xc8\x00Pz\xa3\x00_\x9eL[6\xdd\x1a\x86KqW\xe4\x90\v\x01\xaa\xebk~\x13BS̨\xe2\x8c\n\xe0x\xa0M\xce\x10\x0f\xcc<\xcc} E\xcca\t\xf30\a\xfbPK\xe7\x91\xcc\xc0_r\xcdN\xf1(l\xa9\x8dĤ\xb8\xbbd\xa5*\x1ce\x19\x1a/\xc5,_\bd\xe2\xca\xdfʜ\x143\x8c\xb6\xca\x12J\"q\xe9\x97ʠ1\x99\xe0\xd3\x02*\x11\xc7u\xe2\xe6\xca\xd4+\b\t\xc7U\x061\xc1y\xb1\xa6y\xc9\xc7Iʑ\\\x91\x8eF4.\xc8\xd1\xf4\x82\xb7\x18\x94\xed\xa1Yj\xfa9\xa1\x9d4\x898\xf8\xa1\xa6ir\x95\xf2,\x1b\xfa\xa9ZZ\x16/Ca-\x8a\xdf\x06\x95\x0f\xcb+>\x9d\xe0\xbd=\xf6.1\x1dL\xd2\xc8ǥ\xa2\xa1fQV\xb9\x1f\xa8\xd2Ғ\xeb\xa8\x14}\xb9\xdbJ\x886\xd6f]\x1b\x17\xaf\xac\xcd\x12W\xcdG\xb0\xb0\xed\x19%q\xadd\xa4\x8c\xa70\x12\x13w<\xcd\xcct\xf0\xf9M3\xb6\xc7\x12\x17'(#7\xc9r\x0e\x06\xbb\xa8QL\xb0P_k̞@\x8f\xe2zz\x0f\xd7\xee&\x05\xa2\xe4\x181ӌ\xab\x004O+pKz\x16\xe5_\xb8\xbb\xab})\xd3\xf4#\xad1>\xb2S\x89q\xc0>\xea\x16.\xfda\xdb+>\x80\x90\xed\xea|\xfc\b|D\x1aa\xbd\x89m\x81\x0fGk\xe4\x03\x03\xab\x10\t\xccB\x19\xf9Ȟ\xe8}\xc0v\xa9\xbb\x04\xef\x1d\x96\xe8\xfcX=(a\xcaI\x14\xb4!\xfc\xf8\xb1.\"\xc0\x16t\x8e\xc0\xce,ۃ.\xb2\v\x84+\xbbe?01\xab\xb3\xe9\xd1X_}hZ\xf5,O\xa6r9\n\n\x8fT\xac\xd4ǚ\x02sP\xbf\xf5+\x8e_\xc3Y(N\x11iÃGzO\x8c\"\ue9f25*\x06#\x99嶱\xac\xe0\x91K\x1e3x\xe6\xd2fƧ82F~7\x1e\x15\x05E\xddL[\x8a\xd4sC\xa9\xd2*7\xde\b\xdd-nA\xa3Y\r\xac\xf0L\x98\xbdAx\x13\x063?\"k\xd1e\xff\n\xa3\b\xa6p\x98\xf9>\xa7a\x9e\xf3X\xe2\nct\x02\x8f\xfd(\x82\xd7\x01\xc1\x83\xcfِG\xc9g\x93\xadbQP\xf0\x15\xe4(1\x99kj\x81\xfb\xea\x00PaWR\x80U}\x10\x1c\xa8\x16Id\xdd\xe8]&\x98\x063\x83\x9aMl\xc7\x18]uF\x8bV\xa9\x98%\x12z\x16\x18\xa8L\xc4*\x8a\xed\xb2\x84^\x06CG\xd0t\xae\x17\xa3\xebK\xf5r\x02\xb6\nZ\xbc\xbe(Vlz^2?\x13\xf6\x9d\xb6\x89\xa5\xb6\x18\xe9\xbc\xd7\xfb4\xbc\x82\xd9y\a槝:\x88>ۡeA\v\xbcI\xff\xf3?\xad\xfd\xa3Ξ\xe0\xfe\x93\x10\x1bB\xf9ؒ\x80_\xd9T6\x92ED3\x9e$\xaeXJQ+\xe0\x1f\xa7c\x1b\xad\xc1\xf3}ߋ\xd5X+q\xc5\xe2\x88=\x91H4^<{ƺ\x15\xe6\xad*\xf3\x1e\xaaKr\b(-\x19\x9aZ2\xe3W\xcc\xfb5\xfd\x8c\xea\x01<\xb0\xffӪҹ\xc7z5}\xac\xb17q\x859͞\xb3\x1dź\xfd\xa0\x05w\x13w\xec\x1d\xd6\a\xc7gQT-\xeb\x94n\xd62\xd5lۯn\x00=~l8\xaa[\xaa\xbc#g.G\xf93CS\x9d;\x92y\xd8\x14t\x98v\x8b9\x12\xd22\x1c\xcex\x8cb\x1cơΠ\x95\xfd\xc0Pr\x89+\a[\x18\xc3\xc1\xda+\a\x99\x8f\x81д\xb2u\xd8N\x87u\xd8\xc1t\xce\xf0\x1b\xba\x83\xd6B\xbd\xe9|g\xa5\xa9~\xc0\x00jCaB,\xd2\x05\x82\"D>\x10[\xe0\xecN\xe7,\xbd\x1a\xfa\xc4]\xc7\xed\xda;\xb6\xdd0{\b}\xbe\xa5\xb6\U001033f3,'}\x99\x14+l\x9f\xc1\xb22\xa2e\xb6T\x80u\xeask\xbd\xa9\x04\xc0Ԝ\xa1\x03\x1e\xb3B\xb0\x81\xd5 \xf6<rq\x1f\xe8\x1d^\xfc(\x1c\xbdf*\x04Q\xd3˅\x92p\x0fp\x15\xad\x87.4\xf7\x9b\xc2\x1chm\x89\xbd`\xe0κ\xfd=\x1f[\x96\xfbWrM\xa0`n&r\x13\xce\xcf\xf3\xb4\xa0^j_9\"wNA\xe8\x00\x03\b\xe0|\x12\xc1\rF\xb34\xeeg\xa3k>\xf1\xb3\xf6$\x1c\xa5I\x96\x8c\xf19\xe9\xfe\xcd$\xb2h\x85\xedY\xb0\xfehC³\x1dQ\x8bѭ\xcaRUc2c\xc3Y\x8e\x14+\x93\xd5\x0f\x02\xe6#\x14nJ\x8d\xc5F\xa7ܷ\xa4 !\xf2\xa9\xfbA\x80\xbb\x0e;\xae\xac\x18\xe4yH\x0f\xb7\xa6\xfdY\x1a\xb5\xbe\x10\xab\xc1/\xfe\xf9\xf6[{G\f\x8f\xcaRP\xccp\xde\xfa\x192E;v\xdd\f\xa3O\x83\x06p\x8f=aieZ\xbcJ\xa7\xa6\x05\xaaM\x84*\x8dAǵv\xf0&\x96\x1d\xa7dr\x8e\x92$\r\xc0\xe1\xd5g\x99\xd2\x17\xa6\x9d\x8d \x89\x98|ک\x82j\xa7\xba\x99\xa8\x93\xbe\x94\xd3iV̐\x99fIV4?]\xf4\x9e^\x851\xf3X\xbbU̡\x89\x1a\x04O\ffT\x94\xb4\x9c\x16\xae\xd2)\xce\buӀ\xd0\xfeT\x91\\\xeeїA~X\xe13L;\xad\xd0a\xc1\x1c\xb5V\xceS\x9d\xe9\xa0\xd0\x1e\x14\xac\xb8\xd2Q\x99Y̠1\x15\x9e\xba>\xdb?\xea\x14\x8b\r0\xcb\xc4\xfc\xbc#\xa9\xfc\x9f\xff\t\xe6+s\x99RA\xcd\xcaݮu>\xda\x1d0A\x80\x00\xf8\xe9\x88z\\\xcd\xda\x06\xeaR\x1f\xa6\x15\x8ck̞\x1aX\xb9\xaa. ɶ\xa8\x01\xc5\xeei\xd7\xdb\x0f5\xe0\xd4\x13}\xf1o\x05`UJ1\xdb\xe8\x10c`\x0e\x02\x9e\bom\xfdlT\x9d\xc6W\xb5\xc8\xe8\xf2\x0e@\xa7JtV6\xa8-\xd4Wbӟ\xa0X\x9e0\xd81\xc5j\xd80\xe2\x1cn\xf8a\xb3iR쳏\xae\xfd\xf8\x8a?hj\x84\xdd<\xa0\x8c\xb9\xfb\x81\xb1\x1e-\xb6]\xbcF3\v\r-\x10\xf8vO\xb8\x1d\u00a0\xff\xfa\xc77\x13\xff\x8a\x7f\x90\xaa\xd7}\xab\xd4\xf8\xcbh\x96\xb6\xa6\xe1\x9cGԷ^ϙ\xf8\x9f8\xd5\xefu\x1d\xfa!\x9a\xe5\xb9\xfb\xf6\x8e\xb6\"|\xf0`+\xea\x90\"ۜr\xa4IqeL\x13U\xed\\L\x95u\xf3pEO\x8fh\x87\xb2pՈ\xbc\x84T26\x06L49`ooYGc\xfc\b\xf2\xd0\xdcf\xa7lT7\xa3k\x8bM\xe6\x19 \xe7X\xeeb@\x19\x80h\xa4\xd1ѐl\x98l#\x9b\x8djl\x03}o\v\xf9\xa4\xc7f\x89\xf0\x19ܼ\xdcQ+\xd3\x1dI(N\x96\xb0\x92[\xbb\xae.\xe6\x7f\xb4O`\x18\x00~\xb0\x89s>\xcf\xf7FY\xb6\xa3\xc6\r\xf4\\M$\\\xf6r\xf1\xc1\xbf\xc2\xe3{;\xd7\xdc\x0fv\xe0\t\x13\x87\xf1\xa8\xd4\xf1\xe0\"C\xa2no\xd5\xc6\xe6\x19$P\x1b[\xb6\x98\xa8)\xc2+\x19\xf2\xf2\xaaAL\x87\xa0\x86\xd5t\xcfv\xaa\x13\xbe4\x88ы&vN\x11\xa1#\x16\x000\x12vl\xe8\x11Lv\xfd\xe0\xa3-m.\xe1\xea\xc0`\xb5\x8a\x89\xef\xb1\x12:\xbf\x88\xbd\x90}$Z+\x04\xfa\xc1\x038i\xf7\u0bc3\x05\x7f\xfd\xfd\xf5\xf7\xd7ߟ\xfe\xef\xff?\x00>\xa60I\x00d\x05\x00")
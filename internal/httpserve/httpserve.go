@@ -3,8 +3,11 @@
 package httpserve
 
 import (
+	"fmt"
+	"hash/fnv"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -34,3 +37,62 @@ func NeverExpires(w http.ResponseWriter) {
 	header.Add("Cache-Control", "max-age="+maxCacheControlAgeValue)
 	header.Add("Expires", maxExpiresValue)
 }
+
+// WeakETag returns a weak ETag for the given data, using
+// its fnv64a hash.
+func WeakETag(data []byte) string {
+	h := fnv.New64a()
+	h.Write(data)
+	return fmt.Sprintf("W/\"%016x\"", h.Sum64())
+}
+
+// etagMatches returns true iff the etag matches any of the
+// ones in the given If-None-Match header value, using the
+// weak comparison function.
+func etagMatches(header string, etag string) bool {
+	header = strings.TrimSpace(header)
+	if header == "*" {
+		return true
+	}
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, v := range strings.Split(header, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(v), "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// NotModified returns true iff the conditional headers in the given
+// request (If-None-Match and If-Modified-Since) indicate that the client
+// already has an up to date copy of a response with the given ETag and
+// modification time. Either etag or modified might be empty. As stated by
+// RFC 7232, If-Modified-Since is ignored when If-None-Match is present.
+// Only GET and HEAD requests are considered, for any other method this
+// function always returns false.
+func NotModified(r *http.Request, etag string, modified time.Time) bool {
+	if r == nil || (r.Method != "GET" && r.Method != "HEAD") {
+		return false
+	}
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		return etag != "" && etagMatches(inm, etag)
+	}
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" && !modified.IsZero() {
+		t, err := http.ParseTime(ims)
+		return err == nil && !modified.Truncate(time.Second).After(t)
+	}
+	return false
+}
+
+// WriteNotModified responds with a 304 (Not Modified), removing the
+// headers which must not be sent in a response without a body.
+func WriteNotModified(w http.ResponseWriter) {
+	header := w.Header()
+	delete(header, "Content-Type")
+	delete(header, "Content-Length")
+	delete(header, "Content-Encoding")
+	if header.Get("ETag") != "" {
+		delete(header, "Last-Modified")
+	}
+	w.WriteHeader(http.StatusNotModified)
+}
@@ -2,6 +2,8 @@ package internal
 
 import (
 	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -15,6 +17,23 @@ const (
 	LayerServedFromCacheKey = "___gondola_layer_served_from_cache"
 )
 
+// CompressWriter is implemented by the http.ResponseWriter used by
+// gnd.la/app.Compress, so gnd.la/cache/layer can store the compressed
+// responses.
+type CompressWriter interface {
+	http.ResponseWriter
+	// Encoding returns the encoding negotiated with the client.
+	Encoding() string
+	// Capture makes the writer copy everything it sends to the
+	// client, after compressing it, to w. Passing nil stops
+	// capturing. The writer also stops capturing when the
+	// response is flushed.
+	Capture(w io.Writer)
+	// Finish writes any pending data. After calling it, no more
+	// data can be written.
+	Finish() error
+}
+
 var (
 	inTest      bool
 	goRun       bool
@@ -178,3 +178,29 @@ func PanicLocation() (file string, line int, ok bool) {
 	}
 	return
 }
+
+// PanicFrames returns up to n frames of the call stack, starting
+// at the function which caused the uppermost panic in the call
+// stack. Frames belonging to the runtime (e.g. runtime.gopanic or
+// runtime.sigpanic) are omitted. If there's no panic in the call
+// stack, it returns nil.
+func PanicFrames(n int) []runtime.Frame {
+	callers := make([]uintptr, 64)
+	callers = callers[:runtime.Callers(1, callers)]
+	frames := runtime.CallersFrames(callers)
+	var ret []runtime.Frame
+	inPanic := false
+	for len(ret) < n {
+		frame, more := frames.Next()
+		isRuntime := strings.HasPrefix(frame.Function, "runtime.")
+		if !inPanic {
+			inPanic = isRuntime && strings.Contains(strings.ToLower(frame.Function), "panic")
+		} else if len(ret) > 0 || !isRuntime {
+			ret = append(ret, frame)
+		}
+		if !more {
+			break
+		}
+	}
+	return ret
+}
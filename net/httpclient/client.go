@@ -1,6 +1,7 @@
 package httpclient
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"net/url"
@@ -32,10 +33,15 @@ type logger interface {
 	Logger() log.Interface
 }
 
+type contexter interface {
+	Context() context.Context
+}
+
 type Client struct {
 	transport *transport
 	c         *http.Client
 	logger    log.Interface
+	ctx       context.Context
 }
 
 // New returns a new *Client. The ctx parameter will usually be
@@ -43,6 +49,11 @@ type Client struct {
 // function. Note that passing a nil ctx will work under some circunstances
 // but will fail when running on App Engine, so it's advisable to always
 // pass an *app.Context to this function, for portability.
+//
+// If ctx has a Context() context.Context method (like *app.Context does),
+// the returned context.Context is attached to all the requests made by
+// the Client, so they're aborted when the handler request is cancelled
+// or times out. See also Client.SetContext.
 func New(ctx Context) *Client {
 	tr := newTransport(ctx)
 	client := &Client{
@@ -54,6 +65,9 @@ func New(ctx Context) *Client {
 	if log, ok := ctx.(logger); ok {
 		client.logger = log.Logger()
 	}
+	if c, ok := ctx.(contexter); ok {
+		client.ctx = c.Context()
+	}
 	return client
 }
 
@@ -74,6 +88,9 @@ func (c *Client) Clone(ctx Context) *Client {
 	} else {
 		cp.logger = nil
 	}
+	if c, ok := ctx.(contexter); ok {
+		cp.ctx = c.Context()
+	}
 	return cp
 }
 
@@ -105,7 +122,26 @@ func (c *Client) SetTimeout(timeout time.Duration) *Client {
 	return c
 }
 
-// HTTPClient returns the *http.Client used by this Client.
+// Context returns the context.Context attached to the requests
+// made by this Client. If the Client has no context.Context,
+// it returns nil.
+func (c *Client) Context() context.Context {
+	return c.ctx
+}
+
+// SetContext sets the context.Context attached to the requests made
+// by this Client. Requests passed to Do, Trip or Iter which already
+// have a context.Context (see http.Request.WithContext) keep their
+// own. Setting it to nil disables attaching a context.Context to
+// the requests.
+func (c *Client) SetContext(ctx context.Context) *Client {
+	c.ctx = ctx
+	return c
+}
+
+// HTTPClient returns the *http.Client used by this Client. Note
+// that requests made directly with the *http.Client don't have
+// the Client context.Context attached to them.
 func (c *Client) HTTPClient() *http.Client {
 	return c.c
 }
@@ -122,7 +158,11 @@ func (c *Client) Get(url string) (*Response, error) {
 		defer profile.Start(profileName).Note("GET", url).End()
 	}
 	c.debugf("GET %s", url)
-	return makeResponse(c.c.Get(url))
+	req, err := c.newRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return makeResponse(c.c.Do(req))
 }
 
 // Head is a wrapper around http.Client.Head, returning a Response rather than an
@@ -132,7 +172,11 @@ func (c *Client) Head(url string) (*Response, error) {
 		defer profile.Start(profileName).Note("HEAD", url).End()
 	}
 	c.debugf("HEAD %s", url)
-	return makeResponse(c.c.Head(url))
+	req, err := c.newRequest("HEAD", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return makeResponse(c.c.Do(req))
 }
 
 // GetForm appends the given data to the given url and performs a GET
@@ -156,7 +200,12 @@ func (c *Client) Post(url string, bodyType string, body io.Reader) (*Response, e
 		defer profile.Start(profileName).Note("POST", url).End()
 	}
 	c.debugf("POST %s", url)
-	return makeResponse(c.c.Post(url, bodyType, body))
+	req, err := c.newRequest("POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", bodyType)
+	return makeResponse(c.c.Do(req))
 }
 
 // PostForm is a wrapper around http.Client.PostForm, returning a Response rather than an
@@ -172,7 +221,7 @@ func (c *Client) Do(req *http.Request) (*Response, error) {
 		defer profile.Start(profileName).Note(req.Method, req.URL.String()).End()
 	}
 	c.debugf("DO %s %s", req.Method, req.URL)
-	return makeResponse(c.c.Do(req))
+	return makeResponse(c.c.Do(c.withContext(req)))
 }
 
 // Trip performs a roundtrip with the given http.Request, without following
@@ -183,7 +232,7 @@ func (c *Client) Trip(req *http.Request) (*Response, error) {
 		defer profile.Start(profileName).Note("TRIP-"+req.Method, req.URL.String()).End()
 	}
 	c.debugf("TRIP %s %s", req.Method, req.URL)
-	return makeResponse(c.transport.RoundTrip(req))
+	return makeResponse(c.transport.RoundTrip(c.withContext(req)))
 }
 
 // Proxy returns the Proxy function for this client, if any. Note that
@@ -220,6 +269,23 @@ func (c *Client) Iter(req *http.Request) *Iter {
 	return &Iter{c: c, req: req}
 }
 
+func (c *Client) newRequest(method string, url string, body io.Reader) (*http.Request, error) {
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return http.NewRequestWithContext(ctx, method, url, body)
+}
+
+// withContext returns req with the Client context.Context attached,
+// unless req already has its own context.Context.
+func (c *Client) withContext(req *http.Request) *http.Request {
+	if c.ctx != nil && req.Context() == context.Background() {
+		return req.WithContext(c.ctx)
+	}
+	return req
+}
+
 func (c *Client) debugf(format string, args ...interface{}) {
 	if c.logger != nil {
 		c.logger.Debugf("[httpclient] "+format, args...)
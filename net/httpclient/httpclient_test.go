@@ -1,7 +1,9 @@
 package httpclient_test
 
 import (
+	"context"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"path"
 	"reflect"
@@ -188,3 +190,38 @@ func TestProxy(t *testing.T) {
 		t.Errorf("expecting 2 proxy request, got %d instead", count)
 	}
 }
+
+type testContext struct {
+	ctx context.Context
+}
+
+func (c *testContext) Request() *http.Request   { return nil }
+func (c *testContext) Context() context.Context { return c.ctx }
+
+func TestContext(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	c := httpclient.New(&testContext{ctx: ctx})
+	if c.Context() != ctx {
+		t.Fatal("client context is not the one provided by the Context")
+	}
+	start := time.Now()
+	if _, err := c.Get(srv.URL); err == nil {
+		t.Error("expecting an error when the context expires")
+	}
+	if _, err := c.Clone(nil).SetContext(ctx).Post(srv.URL, "text/plain", strings.NewReader("foo")); err == nil {
+		t.Error("expecting an error when posting with an expired context")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("requests were not cancelled, took %s", elapsed)
+	}
+}
@@ -1,6 +1,7 @@
 package driver
 
 import (
+	"context"
 	"reflect"
 
 	"gnd.la/config"
@@ -28,6 +29,22 @@ type Driver interface {
 	HasFunc(fname string, retType reflect.Type) bool
 }
 
+// Pinger is an optional interface which might be implemented by
+// drivers which can check the connectivity with their database.
+type Pinger interface {
+	// Ping returns an error if the database can't be reached.
+	Ping() error
+}
+
+// ContextDriver is an optional interface which might be implemented
+// by drivers (and their transactions) which support cancellation and
+// deadlines via context.Context.
+type ContextDriver interface {
+	// WithContext returns a copy of the Driver which uses ctx for all
+	// its operations, including the transactions started from it.
+	WithContext(ctx context.Context) Driver
+}
+
 func Register(name string, opener Opener) {
 	registry[name] = opener
 }
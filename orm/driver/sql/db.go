@@ -2,6 +2,7 @@ package sql
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"errors"
 	"hash/crc32"
@@ -27,8 +28,9 @@ type Executor interface {
 }
 
 type queryExecutor interface {
-	Queryier
-	Executor
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
 }
 
 type cacheEntry struct {
@@ -36,9 +38,16 @@ type cacheEntry struct {
 	stmt *sql.Stmt
 }
 
+type stmtCache struct {
+	mu      sync.RWMutex
+	entries map[uint32]cacheEntry
+}
+
 type DB struct {
 	// database/sql.DB
 	sqlDb *sql.DB
+	// used for all the operations, might be nil
+	ctx context.Context
 	// non-nil only when in transaction
 	tx     *sql.Tx
 	txDone bool
@@ -47,8 +56,31 @@ type DB struct {
 	conn                 queryExecutor
 	driver               *Driver
 	replacesPlaceholders bool
-	mu                   sync.RWMutex
-	cache                map[uint32]cacheEntry
+	// shared by all the copies returned by WithContext
+	cache *stmtCache
+}
+
+func (d *DB) context() context.Context {
+	if d.ctx != nil {
+		return d.ctx
+	}
+	return context.Background()
+}
+
+// WithContext returns a copy of the DB which uses the given
+// context.Context for all its operations, including the
+// transactions started from it.
+func (d *DB) WithContext(ctx context.Context) *DB {
+	return &DB{
+		sqlDb:                d.sqlDb,
+		ctx:                  ctx,
+		tx:                   d.tx,
+		txDone:               d.txDone,
+		conn:                 d.conn,
+		driver:               d.driver,
+		replacesPlaceholders: d.replacesPlaceholders,
+		cache:                d.cache,
+	}
 }
 
 func (d *DB) replacePlaceholders(query string) string {
@@ -89,10 +121,10 @@ func (d *DB) Exec(query string, args ...interface{}) (sql.Result, error) {
 	d.driver.debugq(query, args)
 	if len(args) > 0 {
 		if stmt := d.preparedStmt(query); stmt != nil {
-			return stmt.Exec(args...)
+			return stmt.ExecContext(d.context(), args...)
 		}
 	}
-	return d.conn.Exec(query, args...)
+	return d.conn.ExecContext(d.context(), query, args...)
 }
 
 func (d *DB) Query(query string, args ...interface{}) (*sql.Rows, error) {
@@ -102,10 +134,10 @@ func (d *DB) Query(query string, args ...interface{}) (*sql.Rows, error) {
 	d.driver.debugq(query, args)
 	if len(args) > 0 {
 		if stmt := d.preparedStmt(query); stmt != nil {
-			return stmt.Query(args...)
+			return stmt.QueryContext(d.context(), args...)
 		}
 	}
-	return d.conn.Query(query, args...)
+	return d.conn.QueryContext(d.context(), query, args...)
 }
 
 func (d *DB) QueryRow(query string, args ...interface{}) *sql.Row {
@@ -116,22 +148,23 @@ func (d *DB) QueryRow(query string, args ...interface{}) *sql.Row {
 	d.driver.debugq(query, args)
 	if len(args) > 0 {
 		if stmt := d.preparedStmt(query); stmt != nil {
-			return stmt.QueryRow(args...)
+			return stmt.QueryRowContext(d.context(), args...)
 		}
 	}
-	return d.conn.QueryRow(query, args...)
+	return d.conn.QueryRowContext(d.context(), query, args...)
 }
 
 func (d *DB) Begin() (*DB, error) {
 	if d.tx != nil {
 		return nil, driver.ErrInTransaction
 	}
-	tx, err := d.sqlDb.Begin()
+	tx, err := d.sqlDb.BeginTx(d.context(), nil)
 	if err != nil {
 		return nil, err
 	}
 	return &DB{
 		sqlDb:                d.sqlDb,
+		ctx:                  d.ctx,
 		tx:                   tx,
 		conn:                 tx,
 		driver:               d.driver,
@@ -208,9 +241,9 @@ func (d *DB) preparedStmt(s string) *sql.Stmt {
 		return nil
 	}
 	key := crc32.ChecksumIEEE(internal.StringToBytes(s))
-	d.mu.RLock()
-	cached, ok := d.cache[key]
-	d.mu.RUnlock()
+	d.cache.mu.RLock()
+	cached, ok := d.cache.entries[key]
+	d.cache.mu.RUnlock()
 	if ok && cached.sql == s {
 		if d.tx != nil {
 			return d.tx.Stmt(cached.stmt)
@@ -222,12 +255,12 @@ func (d *DB) preparedStmt(s string) *sql.Stmt {
 		// Let the non-prepared method report the error
 		return nil
 	}
-	d.mu.Lock()
-	if d.cache == nil {
-		d.cache = make(map[uint32]cacheEntry)
+	d.cache.mu.Lock()
+	if d.cache.entries == nil {
+		d.cache.entries = make(map[uint32]cacheEntry)
 	}
-	d.cache[key] = cacheEntry{sql: s, stmt: stmt}
-	d.mu.Unlock()
+	d.cache.entries[key] = cacheEntry{sql: s, stmt: stmt}
+	d.cache.mu.Unlock()
 	if d.tx != nil {
 		return d.tx.Stmt(stmt)
 	}
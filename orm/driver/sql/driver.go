@@ -2,6 +2,7 @@ package sql
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"fmt"
 	"reflect"
@@ -44,6 +45,10 @@ func (d *Driver) Check() error {
 	return d.backend.Check(d.db)
 }
 
+func (d *Driver) Ping() error {
+	return d.db.sqlDb.PingContext(d.db.context())
+}
+
 func (d *Driver) Initialize(ms []driver.Model) error {
 	// Create tables
 	for _, v := range ms {
@@ -930,6 +935,14 @@ func (d *Driver) Begin() (driver.Tx, error) {
 	return &drv, nil
 }
 
+// WithContext implements gnd.la/orm/driver.ContextDriver.
+func (d *Driver) WithContext(ctx context.Context) driver.Driver {
+	drv := *d
+	drv.db = d.db.WithContext(ctx)
+	drv.db.driver = &drv
+	return &drv
+}
+
 func (d *Driver) Commit() error {
 	return d.db.Commit()
 }
@@ -978,7 +991,7 @@ func NewDriver(b Backend, url *config.URL) (*Driver, error) {
 		}
 	}
 	driver := &Driver{backend: b, transforms: transforms}
-	driver.db = &DB{sqlDb: conn, conn: conn, driver: driver, replacesPlaceholders: b.Placeholder(0) != "?"}
+	driver.db = &DB{sqlDb: conn, conn: conn, driver: driver, replacesPlaceholders: b.Placeholder(0) != "?", cache: &stmtCache{}}
 	return driver, nil
 }
 
@@ -103,5 +103,6 @@ func (i *Iter) exec() driver.Iter {
 	if i.limit != nil {
 		opts.Limit = *i.limit
 	}
+	i.q.orm.countQuery()
 	return i.q.orm.conn.Query(i.model, i.q.q, opts)
 }
@@ -15,6 +15,7 @@ func (o *Orm) Operate(table *Table, q query.Q, ops ...*operation.Operation) (Res
 	if len(ops) == 0 {
 		return nil, errNoOperations
 	}
+	o.countQuery()
 	return o.conn.Operate(table.model, q, ops)
 }
 
@@ -1,10 +1,12 @@
 package orm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
 	"strings"
+	"sync/atomic"
 
 	"gnd.la/app/profile"
 	"gnd.la/config"
@@ -40,6 +42,9 @@ type Orm struct {
 	logger       *log.Logger
 	tags         string
 	typeRegistry typeRegistry
+	// queries is shared with the transactions
+	// started from this Orm.
+	queries *uint64
 	// these fields are non-nil iff the ORM driver uses database/sql
 	db *sql.DB
 }
@@ -166,6 +171,7 @@ func (o *Orm) insert(m *model, obj interface{}) (Result, error) {
 			}
 		}
 	}
+	o.countQuery()
 	res, err := o.conn.Insert(m, obj)
 	if err == nil && pkVal.IsValid() && pkVal.Int() == 0 {
 		id, err := res.LastInsertId()
@@ -206,6 +212,7 @@ func (o *Orm) update(m *model, q query.Q, obj interface{}) (Result, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("update", m.name).End()
 	}
+	o.countQuery()
 	return o.conn.Update(m, q, obj)
 }
 
@@ -226,6 +233,7 @@ func (o *Orm) Upsert(q query.Q, obj interface{}) (Result, error) {
 		if profile.On && profile.Profiling() {
 			defer profile.Start(orm).Note("upsert", "").End()
 		}
+		o.countQuery()
 		return o.conn.Upsert(m, q, obj)
 	}
 	res, err := o.update(m, q, obj)
@@ -378,6 +386,7 @@ func (o *Orm) delete(m *model, q query.Q) (Result, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("delete", m.name).End()
 	}
+	o.countQuery()
 	return o.conn.Delete(m, q)
 }
 
@@ -475,6 +484,48 @@ func (o *Orm) Driver() driver.Driver {
 	return o.driver
 }
 
+// Ping checks the connectivity with the database. Drivers which
+// don't implement gnd.la/orm/driver.Pinger are assumed to be
+// always reachable.
+func (o *Orm) Ping() error {
+	if p, ok := o.driver.(driver.Pinger); ok {
+		return p.Ping()
+	}
+	return nil
+}
+
+// WithContext returns a copy of the Orm which uses the given
+// context.Context for all its operations, so they're aborted
+// when ctx is cancelled or its deadline expires. Transactions
+// started from the returned Orm are also bound to ctx. Drivers
+// which don't implement gnd.la/orm/driver.ContextDriver ignore
+// ctx. Note that gnd.la/app.Context.Orm already returns an Orm
+// bound to the request context.
+func (o *Orm) WithContext(ctx context.Context) *Orm {
+	cpy := *o
+	if cd, ok := o.driver.(driver.ContextDriver); ok {
+		cpy.driver = cd.WithContext(ctx)
+	}
+	if o.conn == o.driver {
+		cpy.conn = cpy.driver
+	} else if cd, ok := o.conn.(driver.ContextDriver); ok {
+		// Transaction
+		cpy.conn = cd.WithContext(ctx)
+	}
+	return &cpy
+}
+
+// QueryCount returns the number of queries performed by this
+// Orm since it was created, including the ones performed from
+// transactions started from it.
+func (o *Orm) QueryCount() uint64 {
+	return atomic.LoadUint64(o.queries)
+}
+
+func (o *Orm) countQuery() {
+	atomic.AddUint64(o.queries, 1)
+}
+
 // SqlDB returns the underlying database connection iff the
 // ORM driver is using database/sql. Otherwise, it
 // returns nil. Note that the returned value isn't of type
@@ -653,6 +704,7 @@ func New(url *config.URL) (*Orm, error) {
 		driver:       drv,
 		tags:         tags,
 		typeRegistry: typeRegistry,
+		queries:      new(uint64),
 	}
 	if db, ok := drv.Connection().(*sql.DB); ok {
 		o.db = db
@@ -2,6 +2,7 @@ package orm
 
 import (
 	"bytes"
+	"context"
 	"flag"
 	"os"
 	"reflect"
@@ -443,6 +444,35 @@ func testFuncTransactions(t *testing.T, o *Orm) {
 	}
 }
 
+func testContext(t *testing.T, o *Orm) {
+	if _, ok := o.Driver().(driver.ContextDriver); !ok {
+		t.Log("skipping context test")
+		return
+	}
+	table := o.mustRegister((*AutoIncrement)(nil), &Options{
+		Table: "test_context",
+	})
+	o.mustInitialize()
+	ctx, cancel := context.WithCancel(context.Background())
+	co := o.WithContext(ctx)
+	obj := &AutoIncrement{}
+	co.MustInsert(obj)
+	cancel()
+	if _, err := co.Insert(&AutoIncrement{}); err != context.Canceled {
+		t.Errorf("expecting context.Canceled when inserting, got %v", err)
+	}
+	if _, err := co.Exists(table, Eq("Id", obj.Id)); err != context.Canceled {
+		t.Errorf("expecting context.Canceled when querying, got %v", err)
+	}
+	if _, err := co.Begin(); err != context.Canceled {
+		t.Errorf("expecting context.Canceled when beginning a transaction, got %v", err)
+	}
+	// The original Orm must not be affected
+	if !o.MustExists(table, Eq("Id", obj.Id)) {
+		t.Error("object inserted with context does not exist")
+	}
+}
+
 func testCompositePrimaryKey(t *testing.T, o *Orm) {
 	if o.Driver().Capabilities()&driver.CAP_COMPOSITE_PK == 0 {
 		t.Log("skipping composite pk test")
@@ -36,6 +36,10 @@ func TestFuncTransactions(t *testing.T) {
 	runTest(t, testFuncTransactions)
 }
 
+func TestContext(t *testing.T) {
+	runTest(t, testContext)
+}
+
 func TestQueryAll(t *testing.T) {
 	runTest(t, testQueryAll)
 }
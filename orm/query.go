@@ -145,6 +145,7 @@ func (q *Query) Exists() (bool, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("exists", q.model.String()).End()
 	}
+	q.orm.countQuery()
 	return q.orm.driver.Exists(q.model, q.q)
 }
 
@@ -220,6 +221,7 @@ func (q *Query) Count() (uint64, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("count", q.model.String()).End()
 	}
+	q.orm.countQuery()
 	return q.orm.driver.Count("", model, q.q, q.opts)
 }
 
@@ -240,6 +242,7 @@ func (q *Query) CountField(field string) (uint64, error) {
 	if profile.On && profile.Profiling() {
 		defer profile.Start(orm).Note("count-field", q.model.String()).End()
 	}
+	q.orm.countQuery()
 	return q.orm.driver.Count(field, model, q.q, q.opts)
 }
 
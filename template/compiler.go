@@ -895,6 +895,27 @@ func (p *program) addWB(b []byte) {
 	p.inst(opWB, val)
 }
 
+// addAssets writes the given assets HTML, inserting a call to
+// the nonce function after every <script> and <style> tag name,
+// so inline elements can be allowed by a Content-Security-Policy.
+func (p *program) addAssets(b []byte) {
+	fn := p.tmpl.funcMap[nonceAttrFuncName]
+	for fn != nil {
+		pos := nonceInsertionPoint(b)
+		if pos < 0 {
+			break
+		}
+		p.addWB(b[:pos])
+		p.inst(opCONTEXT, 0)
+		p.inst(opFUNC, encodeVal(1, p.addFunc(fn)))
+		p.inst(opPRINT, 0)
+		b = b[pos:]
+	}
+	if len(b) > 0 {
+		p.addWB(b)
+	}
+}
+
 func (p *program) addSTRING(s string) {
 	p.inst(opSTRING, p.addString(s))
 }
@@ -1115,7 +1136,7 @@ func (p *program) walk(n parse.Node) error {
 				b = p.tmpl.bottomAssets
 			}
 			if len(b) > 0 {
-				p.addWB(b)
+				p.addAssets(b)
 			}
 			p.s.noPrint = true
 			break
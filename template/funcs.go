@@ -393,6 +393,10 @@ var templateFuncs = makeFuncMap([]*Func{
 	// Return the value of the given variable, or an empty
 	// value if no such variable exists.
 	makeNamedFunc(getVar, "var", FuncTraitState),
+	// Returns the Content-Security-Policy nonce for the current
+	// request or an empty string if there's none. See NonceProvider.
+	makeNamedFunc(cspNonce, "csp_nonce", FuncTraitContext),
+	makeNamedFunc(nonceAttr, nonceAttrFuncName, FuncTraitContext),
 
 	// !Go builtins
 	makeFunc(call, 0),
@@ -0,0 +1,67 @@
+package template
+
+import (
+	"bytes"
+	"html"
+)
+
+const (
+	nonceAttrFuncName = "_gondola_nonce_attr"
+)
+
+// NonceProvider is implemented by Context types which provide a
+// Content-Security-Policy nonce for the current execution. When
+// a template is executed with a Context implementing NonceProvider
+// and its CSPNonce method returns a non-empty string, the nonce is
+// added as an attribute to every inline <script> and <style> element
+// generated by the template assets. Templates might also retrieve it
+// using the "csp_nonce" function.
+type NonceProvider interface {
+	CSPNonce() string
+}
+
+func cspNonce(ctx interface{}) string {
+	if np, ok := ctx.(NonceProvider); ok {
+		return np.CSPNonce()
+	}
+	return ""
+}
+
+func nonceAttr(ctx interface{}) string {
+	if nonce := cspNonce(ctx); nonce != "" {
+		return " nonce=\"" + html.EscapeString(nonce) + "\""
+	}
+	return ""
+}
+
+var (
+	nonceTags = [][]byte{[]byte("<script"), []byte("<style")}
+)
+
+// nonceInsertionPoint returns the position in b where the next
+// nonce attribute should be inserted (right after the tag name
+// of a <script> or <style> opening tag), or -1 if there are none.
+func nonceInsertionPoint(b []byte) int {
+	lower := bytes.ToLower(b)
+	pos := -1
+	for _, tag := range nonceTags {
+		start := 0
+		for {
+			idx := bytes.Index(lower[start:], tag)
+			if idx < 0 {
+				break
+			}
+			end := start + idx + len(tag)
+			if end < len(lower) {
+				if c := lower[end]; c == '>' || c == '/' || c == ' ' || c == '\t' || c == '\n' || c == '\r' {
+					if pos < 0 || end < pos {
+						pos = end
+					}
+					break
+				}
+			}
+			start = end
+		}
+	}
+	return pos
+}
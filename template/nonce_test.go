@@ -0,0 +1,72 @@
+package template
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"gnd.la/template/assets"
+
+	"github.com/rainycape/vfs"
+)
+
+type nonceContext string
+
+func (n nonceContext) CSPNonce() string {
+	return string(n)
+}
+
+func TestNonceInsertionPoint(t *testing.T) {
+	tests := []struct {
+		html string
+		pos  int
+	}{
+		{"", -1},
+		{"<div></div>", -1},
+		{"<script></script>", 7},
+		{"<SCRIPT src=\"foo.js\"></SCRIPT>", 7},
+		{"<link rel=\"stylesheet\"><style>", 29},
+		{"<scripts><style type=\"text/css\">", 15},
+		{"<script", -1},
+	}
+	for _, v := range tests {
+		if pos := nonceInsertionPoint([]byte(v.html)); pos != v.pos {
+			t.Errorf("expecting nonce insertion point %d in %q, got %d", v.pos, v.html, pos)
+		}
+	}
+}
+
+func TestAssetsNonce(t *testing.T) {
+	const text = `{{/*
+  scripts|top: https://example.com/a.js, https://example.com/b.js
+*/}}<html><head></head><body>{{ csp_nonce }}</body></html>`
+	fs, err := vfs.Map(map[string]*vfs.File{"nonce.html": &vfs.File{Data: []byte(text)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := New(fs, assets.New(fs, ""))
+	if err := tmpl.Parse("nonce.html"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmpl.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteContext(&buf, nil, nonceContext("abc"), nil); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if c := strings.Count(out, `<script nonce="abc" `); c != 2 {
+		t.Errorf("expecting 2 scripts with a nonce, got %d in %q", c, out)
+	}
+	if !strings.Contains(out, "<body>abc</body>") {
+		t.Errorf("csp_nonce did not return the nonce in %q", out)
+	}
+	buf.Reset()
+	if err := tmpl.ExecuteContext(&buf, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if out := buf.String(); strings.Contains(out, "nonce") {
+		t.Errorf("unexpected nonce without a NonceProvider in %q", out)
+	}
+}